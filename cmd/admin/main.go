@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/igor/chronotask-api/cmd/api/container"
+	"github.com/igor/chronotask-api/config"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+)
+
+// Comandos administrativos (executados fora da API)
+// Uso:
+//
+//	go run ./cmd/admin recompute-xp -character <id> [-fix]
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	switch os.Args[1] {
+	case "recompute-xp":
+		recomputeXp(os.Args[2:])
+	default:
+		usage()
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: admin recompute-xp -character <id> [-fix]")
+	os.Exit(2)
+}
+
+// recomputeXp reconstrói nível/XP de um personagem a partir do ledger de XP e reporta divergências
+// Com -fix, o ledger é considerado a fonte da verdade e o personagem é corrigido
+func recomputeXp(args []string) {
+	flags := flag.NewFlagSet("recompute-xp", flag.ExitOnError)
+	characterID := flags.String("character", "", "ID of the character to check")
+	fix := flags.Bool("fix", false, "overwrite the character's progress with the ledger's when they drifted apart")
+	flags.Parse(args)
+
+	if *characterID == "" {
+		usage()
+	}
+
+	// Carregar configuração
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("Failed to load configuration: %v", err)
+	}
+
+	// Apenas Infraestrutura e Aplicação são necessárias (sem HTTP nem scheduler)
+	infra, err := container.NewInfrastructure(cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize infrastructure: %v", err)
+	}
+	defer infra.Close()
+
+	app, err := container.NewApplication(infra, cfg)
+	if err != nil {
+		log.Fatalf("Failed to initialize application: %v", err)
+	}
+
+	output, err := app.RecomputeCharacterXpUseCase.Execute(context.Background(), usecase.RecomputeCharacterXpInput{
+		CharacterID: *characterID,
+		Fix:         *fix,
+	})
+	if err != nil {
+		log.Fatalf("Failed to recompute character xp: %v", err)
+	}
+
+	fmt.Printf("character:    %s (%d xp transactions)\n", output.CharacterID, output.Transactions)
+	fmt.Printf("stored:       level %d, %d xp, %d total xp\n", output.Stored.Level, output.Stored.CurrentXp, output.Stored.TotalXp)
+	fmt.Printf("ledger:       level %d, %d xp, %d total xp\n", output.Ledger.Level, output.Ledger.CurrentXp, output.Ledger.TotalXp)
+
+	switch {
+	case !output.Drift:
+		fmt.Println("status:       ok")
+	case output.Fixed:
+		fmt.Println("status:       drift fixed")
+	default:
+		fmt.Println("status:       DRIFT (run with -fix to repair)")
+		os.Exit(1)
+	}
+}
@@ -1,7 +1,13 @@
 package container
 
 import (
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/config"
 	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
 )
 
 // Application contém todas as dependências da camada de aplicação
@@ -14,10 +20,40 @@ type Application struct {
 	// DeleteUserUseCase *usecase.DeleteUserUseCase   // Exemplo futuro
 	// GetUserUseCase    *usecase.GetUserUseCase      // Exemplo futuro
 
-	// Habit Use Cases (futuro)
-	// CreateHabitUseCase *usecase.CreateHabitUseCase
-	// UpdateHabitUseCase *usecase.UpdateHabitUseCase
-	// ListHabitsUseCase  *usecase.ListHabitsUseCase
+	// User Preferences Use Cases
+	GetUserPreferencesUseCase    *usecase.GetUserPreferencesUseCase
+	UpdateUserPreferencesUseCase *usecase.UpdateUserPreferencesUseCase
+
+	// Habit Use Cases
+	CreateHabitUseCase         *usecase.CreateHabitUseCase
+	ListHabitsUseCase          *usecase.ListHabitsUseCase
+	GetHabitUseCase            *usecase.GetHabitUseCase
+	UpdateHabitUseCase         *usecase.UpdateHabitUseCase
+	DeleteHabitUseCase         *usecase.DeleteHabitUseCase
+	CompleteHabitUseCase       *usecase.CompleteHabitUseCase
+	UndoHabitCompletionUseCase *usecase.UndoHabitCompletionUseCase
+	GetDueHabitsUseCase        *usecase.GetDueHabitsUseCase
+	UseStreakFreezeUseCase     *usecase.UseStreakFreezeUseCase
+
+	// Day End Use Cases (executados pelo scheduler)
+	PenalizeMissedHabitsUseCase *usecase.PenalizeMissedHabitsUseCase
+	ProcessDayEndUseCase        *usecase.ProcessDayEndUseCase
+
+	// Focus Session Use Cases
+	StartFocusSessionUseCase     *usecase.StartFocusSessionUseCase
+	PauseFocusSessionUseCase     *usecase.PauseFocusSessionUseCase
+	ResumeFocusSessionUseCase    *usecase.ResumeFocusSessionUseCase
+	StopFocusSessionUseCase      *usecase.StopFocusSessionUseCase
+	ListFocusSessionsUseCase     *usecase.ListFocusSessionsUseCase
+	GetActiveFocusSessionUseCase *usecase.GetActiveFocusSessionUseCase
+
+	// Task Use Cases
+	CreateTaskUseCase    *usecase.CreateTaskUseCase
+	ListTasksUseCase     *usecase.ListTasksUseCase
+	GetTaskUseCase       *usecase.GetTaskUseCase
+	CompleteTaskUseCase  *usecase.CompleteTaskUseCase
+	ReopenTaskUseCase    *usecase.ReopenTaskUseCase
+	CheckTaskItemUseCase *usecase.CheckTaskItemUseCase
 
 	// Character Use Cases
 	CreateCharacterUseCase    *usecase.CreateCharacterUseCase
@@ -25,7 +61,54 @@ type Application struct {
 	// LevelUpCharacterUseCase *usecase.LevelUpCharacterUseCase
 
 	// Character Attribute Use Cases
-	GetCharacterAttributesUseCase *usecase.GetCharacterAttributesUseCase
+	GetCharacterAttributesUseCase  *usecase.GetCharacterAttributesUseCase
+	AllocateAttributePointsUseCase *usecase.AllocateAttributePointsUseCase
+
+	// XP Ledger Use Cases
+	GetXpHistoryUseCase         *usecase.GetXpHistoryUseCase
+	RecomputeCharacterXpUseCase *usecase.RecomputeCharacterXpUseCase // Comando administrativo (cmd/admin)
+
+	// Level Curve Use Cases
+	GetXpTableUseCase *usecase.GetXpTableUseCase
+
+	// Character Class Use Cases
+	ListCharacterClassesUseCase *usecase.ListCharacterClassesUseCase
+
+	// Character Stats Use Cases
+	GetCharacterStatsUseCase *usecase.GetCharacterStatsUseCase
+
+	// Battle Use Cases
+	FightMonsterUseCase           *usecase.FightMonsterUseCase
+	CreateBattleChallengeUseCase  *usecase.CreateBattleChallengeUseCase
+	AcceptBattleChallengeUseCase  *usecase.AcceptBattleChallengeUseCase
+	DeclineBattleChallengeUseCase *usecase.DeclineBattleChallengeUseCase
+	ListBattleChallengesUseCase   *usecase.ListBattleChallengesUseCase
+	GetBattleReplayUseCase        *usecase.GetBattleReplayUseCase
+	ListMonstersUseCase           *usecase.ListMonstersUseCase
+
+	// Rating Use Cases
+	GetCharacterRatingUseCase *usecase.GetCharacterRatingUseCase
+	GetLeaderboardUseCase     *usecase.GetLeaderboardUseCase
+
+	// Energy Use Cases
+	GetCharacterEnergyUseCase *usecase.GetCharacterEnergyUseCase
+
+	// Dungeon Use Cases
+	StartDungeonUseCase   *usecase.StartDungeonUseCase
+	AdvanceDungeonUseCase *usecase.AdvanceDungeonUseCase
+	AbandonDungeonUseCase *usecase.AbandonDungeonUseCase
+
+	// Guild Use Cases
+	CreateGuildUseCase  *usecase.CreateGuildUseCase
+	JoinGuildUseCase    *usecase.JoinGuildUseCase
+	StartRaidUseCase    *usecase.StartRaidUseCase
+	GetGuildRaidUseCase *usecase.GetGuildRaidUseCase
+
+	// Tournament Use Cases
+	CreateTournamentUseCase     *usecase.CreateTournamentUseCase
+	JoinTournamentUseCase       *usecase.JoinTournamentUseCase
+	GetTournamentUseCase        *usecase.GetTournamentUseCase
+	PlayTournamentRoundsUseCase *usecase.PlayTournamentRoundsUseCase
 }
 
 // NewApplication inicializa toda a camada de aplicação
@@ -33,7 +116,37 @@ type Application struct {
 // 1. Adicione o campo no struct acima
 // 2. Inicialize aqui (1-3 linhas):
 //    app.NovoUseCase = usecase.NewNovoUseCase(infra.Repo1, infra.Service1)
-func NewApplication(infra *Infrastructure) *Application {
+func NewApplication(infra *Infrastructure, cfg *config.Config) (*Application, error) {
+	// Penalidade aplicada aos hábitos não cumpridos no fim do dia
+	missedHabitPenalty, err := valueobject.NewMissedHabitPenalty(
+		cfg.Scheduler.MissedHabitXpPenaltyPercent,
+		cfg.Scheduler.MissedHabitAttributePenalty,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("invalid missed habit penalty: %w", err)
+	}
+
+	// Curva de XP e nível máximo de todos os personagens
+	levelCurve, err := newLevelCurve(cfg.LevelCurve)
+	if err != nil {
+		return nil, fmt.Errorf("invalid level curve: %w", err)
+	}
+	if err := entity.SetLevelCurve(levelCurve); err != nil {
+		return nil, fmt.Errorf("invalid level curve: %w", err)
+	}
+
+	// Prazo para o desafiado responder a um desafio PvP
+	challengeExpiration, err := time.ParseDuration(cfg.Battle.ChallengeExpiration)
+	if err != nil {
+		return nil, fmt.Errorf("invalid battle challenge expiration: %w", err)
+	}
+
+	// Período de rating (Glicko-2): a incerteza do rating cresce a cada período sem batalhas ranqueadas
+	ratingPeriod, err := time.ParseDuration(cfg.Battle.RatingPeriod)
+	if err != nil || ratingPeriod <= 0 {
+		return nil, fmt.Errorf("invalid battle rating period: %q", cfg.Battle.RatingPeriod)
+	}
+
 	app := &Application{
 		// User Use Cases
 		CreateUserUseCase: usecase.NewCreateUserUseCase(
@@ -51,16 +164,150 @@ func NewApplication(infra *Infrastructure) *Application {
 		// DeleteUserUseCase: usecase.NewDeleteUserUseCase(infra.UserRepository),
 		// GetUserUseCase: usecase.NewGetUserUseCase(infra.UserRepository),
 
+		// User Preferences Use Cases
+		GetUserPreferencesUseCase: usecase.NewGetUserPreferencesUseCase(
+			infra.UserPreferencesRepository,
+		),
+		UpdateUserPreferencesUseCase: usecase.NewUpdateUserPreferencesUseCase(
+			infra.UserPreferencesRepository,
+		),
+
 		// Habit Use Cases
-		// CreateHabitUseCase: usecase.NewCreateHabitUseCase(
-		//     infra.HabitRepository,
-		//     infra.UserRepository,
-		// ),
+		CreateHabitUseCase: usecase.NewCreateHabitUseCase(
+			infra.HabitRepository,
+			infra.CharacterRepository,
+			infra.CharacterAttributeRepository,
+		),
+		ListHabitsUseCase: usecase.NewListHabitsUseCase(
+			infra.HabitRepository,
+			infra.HabitCompletionRepository,
+			infra.StreakFreezeRepository,
+			infra.UserPreferencesRepository,
+		),
+		GetHabitUseCase: usecase.NewGetHabitUseCase(
+			infra.HabitRepository,
+			infra.HabitCompletionRepository,
+			infra.StreakFreezeRepository,
+			infra.UserPreferencesRepository,
+		),
+		UpdateHabitUseCase: usecase.NewUpdateHabitUseCase(
+			infra.HabitRepository,
+			infra.CharacterAttributeRepository,
+		),
+		DeleteHabitUseCase: usecase.NewDeleteHabitUseCase(
+			infra.HabitRepository,
+		),
+		CompleteHabitUseCase: usecase.NewCompleteHabitUseCase(
+			infra.HabitRepository,
+			infra.HabitCompletionRepository,
+			infra.StreakFreezeRepository,
+			infra.CharacterRepository,
+			infra.CharacterAttributeRepository,
+			infra.UserPreferencesRepository,
+			infra.GuildRepository,
+			infra.RaidRepository,
+			infra.CharacterEnergyRepository,
+			infra.UnitOfWork,
+		),
+		UndoHabitCompletionUseCase: usecase.NewUndoHabitCompletionUseCase(
+			infra.HabitRepository,
+			infra.HabitCompletionRepository,
+			infra.StreakFreezeRepository,
+			infra.CharacterRepository,
+			infra.CharacterAttributeRepository,
+			infra.GuildRepository,
+			infra.RaidRepository,
+			infra.CharacterEnergyRepository,
+			infra.UnitOfWork,
+		),
+		GetDueHabitsUseCase: usecase.NewGetDueHabitsUseCase(
+			infra.HabitRepository,
+			infra.HabitCompletionRepository,
+			infra.UserPreferencesRepository,
+		),
+		UseStreakFreezeUseCase: usecase.NewUseStreakFreezeUseCase(
+			infra.HabitRepository,
+			infra.HabitCompletionRepository,
+			infra.StreakFreezeRepository,
+			infra.UserPreferencesRepository,
+		),
+
+		// Day End Use Cases
+		PenalizeMissedHabitsUseCase: usecase.NewPenalizeMissedHabitsUseCase(
+			infra.HabitRepository,
+			infra.HabitCompletionRepository,
+			infra.StreakFreezeRepository,
+			infra.HabitPenaltyRepository,
+			infra.DayEndRunRepository,
+			infra.CharacterRepository,
+			infra.CharacterAttributeRepository,
+			infra.UserPreferencesRepository,
+			infra.LockService,
+			missedHabitPenalty,
+			infra.UnitOfWork,
+		),
+
+		// Focus Session Use Cases
+		StartFocusSessionUseCase: usecase.NewStartFocusSessionUseCase(
+			infra.FocusSessionRepository,
+			infra.HabitRepository,
+			infra.TaskRepository,
+		),
+		PauseFocusSessionUseCase: usecase.NewPauseFocusSessionUseCase(
+			infra.FocusSessionRepository,
+		),
+		ResumeFocusSessionUseCase: usecase.NewResumeFocusSessionUseCase(
+			infra.FocusSessionRepository,
+		),
+		StopFocusSessionUseCase: usecase.NewStopFocusSessionUseCase(
+			infra.FocusSessionRepository,
+			infra.CharacterRepository,
+			infra.UnitOfWork,
+		),
+		ListFocusSessionsUseCase: usecase.NewListFocusSessionsUseCase(
+			infra.FocusSessionRepository,
+			infra.UserPreferencesRepository,
+		),
+		GetActiveFocusSessionUseCase: usecase.NewGetActiveFocusSessionUseCase(
+			infra.FocusSessionRepository,
+		),
+
+		// Task Use Cases
+		CreateTaskUseCase: usecase.NewCreateTaskUseCase(
+			infra.TaskRepository,
+			infra.CharacterRepository,
+			infra.UserPreferencesRepository,
+		),
+		ListTasksUseCase: usecase.NewListTasksUseCase(
+			infra.TaskRepository,
+			infra.UserPreferencesRepository,
+		),
+		GetTaskUseCase: usecase.NewGetTaskUseCase(
+			infra.TaskRepository,
+			infra.UserPreferencesRepository,
+		),
+		CompleteTaskUseCase: usecase.NewCompleteTaskUseCase(
+			infra.TaskRepository,
+			infra.CharacterRepository,
+			infra.UserPreferencesRepository,
+			infra.UnitOfWork,
+		),
+		ReopenTaskUseCase: usecase.NewReopenTaskUseCase(
+			infra.TaskRepository,
+			infra.CharacterRepository,
+			infra.UserPreferencesRepository,
+			infra.UnitOfWork,
+		),
+		CheckTaskItemUseCase: usecase.NewCheckTaskItemUseCase(
+			infra.TaskRepository,
+			infra.UserPreferencesRepository,
+		),
 
 		// Character Use Cases
 		CreateCharacterUseCase: usecase.NewCreateCharacterUseCase(
 			infra.CharacterRepository,
 			infra.CharacterAttributeRepository,
+			infra.UnitOfWork,
 		),
 		GetUserCharactersUseCase: usecase.NewGetUserCharactersUseCase(
 			infra.CharacterRepository,
@@ -71,7 +318,173 @@ func NewApplication(infra *Infrastructure) *Application {
 			infra.CharacterRepository,
 			infra.CharacterAttributeRepository,
 		),
+		AllocateAttributePointsUseCase: usecase.NewAllocateAttributePointsUseCase(
+			infra.CharacterRepository,
+			infra.CharacterAttributeRepository,
+			infra.UnitOfWork,
+		),
+
+		// XP Ledger Use Cases
+		GetXpHistoryUseCase: usecase.NewGetXpHistoryUseCase(
+			infra.CharacterRepository,
+			infra.XpTransactionRepository,
+		),
+		RecomputeCharacterXpUseCase: usecase.NewRecomputeCharacterXpUseCase(
+			infra.CharacterRepository,
+			infra.XpTransactionRepository,
+			infra.UnitOfWork,
+		),
+
+		// Level Curve Use Cases
+		GetXpTableUseCase: usecase.NewGetXpTableUseCase(
+			levelCurve,
+		),
+
+		// Character Class Use Cases
+		ListCharacterClassesUseCase: usecase.NewListCharacterClassesUseCase(),
+
+		// Character Stats Use Cases
+		GetCharacterStatsUseCase: usecase.NewGetCharacterStatsUseCase(
+			infra.CharacterRepository,
+			infra.CharacterAttributeRepository,
+		),
+
+		// Battle Use Cases
+		FightMonsterUseCase: usecase.NewFightMonsterUseCase(
+			infra.CharacterRepository,
+			infra.CharacterAttributeRepository,
+			infra.MonsterRepository,
+			infra.BattleRepository,
+			infra.CharacterEnergyRepository,
+			infra.UnitOfWork,
+		),
+		CreateBattleChallengeUseCase: usecase.NewCreateBattleChallengeUseCase(
+			infra.CharacterRepository,
+			infra.BattleChallengeRepository,
+			infra.CharacterEnergyRepository,
+			challengeExpiration,
+		),
+		AcceptBattleChallengeUseCase: usecase.NewAcceptBattleChallengeUseCase(
+			infra.CharacterRepository,
+			infra.CharacterAttributeRepository,
+			infra.BattleRepository,
+			infra.BattleChallengeRepository,
+			infra.CharacterRatingRepository,
+			infra.CharacterEnergyRepository,
+			infra.UnitOfWork,
+			ratingPeriod,
+		),
+		DeclineBattleChallengeUseCase: usecase.NewDeclineBattleChallengeUseCase(
+			infra.CharacterRepository,
+			infra.BattleChallengeRepository,
+		),
+		ListBattleChallengesUseCase: usecase.NewListBattleChallengesUseCase(
+			infra.CharacterRepository,
+			infra.BattleChallengeRepository,
+		),
+		GetBattleReplayUseCase: usecase.NewGetBattleReplayUseCase(
+			infra.BattleRepository,
+			infra.CharacterRepository,
+		),
+		ListMonstersUseCase: usecase.NewListMonstersUseCase(
+			infra.MonsterRepository,
+		),
+
+		// Rating Use Cases
+		GetCharacterRatingUseCase: usecase.NewGetCharacterRatingUseCase(
+			infra.CharacterRepository,
+			infra.CharacterRatingRepository,
+			ratingPeriod,
+		),
+		GetLeaderboardUseCase: usecase.NewGetLeaderboardUseCase(
+			infra.CharacterRepository,
+			infra.CharacterRatingRepository,
+			ratingPeriod,
+		),
+
+		// Energy Use Cases
+		GetCharacterEnergyUseCase: usecase.NewGetCharacterEnergyUseCase(
+			infra.CharacterRepository,
+			infra.CharacterEnergyRepository,
+		),
+
+		// Dungeon Use Cases
+		StartDungeonUseCase: usecase.NewStartDungeonUseCase(
+			infra.CharacterRepository,
+			infra.CharacterAttributeRepository,
+			infra.DungeonRepository,
+			infra.DungeonRunRepository,
+			infra.UnitOfWork,
+		),
+		AdvanceDungeonUseCase: usecase.NewAdvanceDungeonUseCase(
+			infra.CharacterRepository,
+			infra.CharacterAttributeRepository,
+			infra.MonsterRepository,
+			infra.BattleRepository,
+			infra.DungeonRepository,
+			infra.DungeonRunRepository,
+			infra.CharacterEnergyRepository,
+			infra.UnitOfWork,
+		),
+		AbandonDungeonUseCase: usecase.NewAbandonDungeonUseCase(
+			infra.CharacterRepository,
+			infra.CharacterAttributeRepository,
+			infra.DungeonRepository,
+			infra.DungeonRunRepository,
+			infra.UnitOfWork,
+		),
+
+		// Guild Use Cases
+		CreateGuildUseCase: usecase.NewCreateGuildUseCase(
+			infra.CharacterRepository,
+			infra.GuildRepository,
+		),
+		JoinGuildUseCase: usecase.NewJoinGuildUseCase(
+			infra.CharacterRepository,
+			infra.GuildRepository,
+		),
+		StartRaidUseCase: usecase.NewStartRaidUseCase(
+			infra.CharacterRepository,
+			infra.GuildRepository,
+			infra.RaidRepository,
+			infra.UnitOfWork,
+		),
+		GetGuildRaidUseCase: usecase.NewGetGuildRaidUseCase(
+			infra.CharacterRepository,
+			infra.GuildRepository,
+			infra.RaidRepository,
+		),
+
+		// Tournament Use Cases
+		CreateTournamentUseCase: usecase.NewCreateTournamentUseCase(
+			infra.CharacterRepository,
+			infra.GuildRepository,
+			infra.TournamentRepository,
+			cfg.Tournament.AdminUserIDs,
+		),
+		JoinTournamentUseCase: usecase.NewJoinTournamentUseCase(
+			infra.CharacterRepository,
+			infra.GuildRepository,
+			infra.TournamentRepository,
+			infra.UnitOfWork,
+		),
+		GetTournamentUseCase: usecase.NewGetTournamentUseCase(
+			infra.TournamentRepository,
+		),
+		PlayTournamentRoundsUseCase: usecase.NewPlayTournamentRoundsUseCase(
+			infra.CharacterRepository,
+			infra.CharacterAttributeRepository,
+			infra.CharacterRatingRepository,
+			infra.BattleRepository,
+			infra.TournamentRepository,
+			infra.UnitOfWork,
+		),
 	}
 
-	return app
+	app.ProcessDayEndUseCase = usecase.NewProcessDayEndUseCase(
+		infra.HabitRepository,
+		app.PenalizeMissedHabitsUseCase,
+	)
+
+	return app, nil
 }
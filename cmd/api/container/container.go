@@ -7,6 +7,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/igor/chronotask-api/config"
+	"github.com/igor/chronotask-api/internal/infrastructure/scheduler"
 )
 
 // Container é o container principal que orquestra todas as camadas
@@ -16,6 +17,7 @@ type Container struct {
 	Infrastructure *Infrastructure
 	Application    *Application
 	Delivery       *Delivery
+	Scheduler      *scheduler.Scheduler // nil quando desabilitado
 }
 
 // New cria e inicializa o container completo com todas as dependências
@@ -23,7 +25,8 @@ type Container struct {
 // 1. Inicializa a camada de Infraestrutura (DB, Repos, Services)
 // 2. Inicializa a camada de Aplicação (Use Cases)
 // 3. Inicializa a camada de Entrega (Handlers, Router)
-// 4. Verifica a saúde do sistema (DB health check)
+// 4. Inicializa os jobs em background (Scheduler)
+// 5. Verifica a saúde do sistema (DB health check)
 func New(cfg *config.Config) (*Container, error) {
 	// 1. Inicializar camada de Infraestrutura
 	infra, err := NewInfrastructure(cfg)
@@ -41,16 +44,28 @@ func New(cfg *config.Config) (*Container, error) {
 	}
 
 	// 2. Inicializar camada de Aplicação (depende da Infraestrutura)
-	app := NewApplication(infra)
+	app, err := NewApplication(infra, cfg)
+	if err != nil {
+		infra.Close()
+		return nil, fmt.Errorf("failed to initialize application: %w", err)
+	}
 
 	// 3. Inicializar camada de Entrega (depende da Aplicação e Infraestrutura)
 	delivery := NewDelivery(app, infra, cfg)
 
+	// 4. Inicializar jobs em background (depende da Aplicação)
+	sched, err := NewScheduler(app, cfg)
+	if err != nil {
+		infra.Close()
+		return nil, fmt.Errorf("failed to initialize scheduler: %w", err)
+	}
+
 	container := &Container{
 		Config:         cfg,
 		Infrastructure: infra,
 		Application:    app,
 		Delivery:       delivery,
+		Scheduler:      sched,
 	}
 
 	return container, nil
@@ -61,9 +76,20 @@ func (c *Container) GetEngine() *gin.Engine {
 	return c.Delivery.Engine
 }
 
+// StartScheduler inicia os jobs em background (no-op quando desabilitado)
+func (c *Container) StartScheduler(ctx context.Context) {
+	if c.Scheduler != nil {
+		c.Scheduler.Start(ctx)
+	}
+}
+
 // Close libera todos os recursos e encerra conexões
 // Deve ser chamado quando a aplicação for encerrada (defer container.Close())
 func (c *Container) Close() error {
+	// Parar os jobs antes de fechar o banco de dados
+	if c.Scheduler != nil {
+		c.Scheduler.Stop()
+	}
 	if c.Infrastructure != nil {
 		return c.Infrastructure.Close()
 	}
@@ -15,7 +15,21 @@ type Delivery struct {
 	UserHandler               *deliveryHttp.UserHandler
 	CharacterHandler          *deliveryHttp.CharacterHandler
 	CharacterAttributeHandler *deliveryHttp.CharacterAttributeHandler
-	// HabitHandler *deliveryHttp.HabitHandler // Exemplo futuro
+	HabitHandler              *deliveryHttp.HabitHandler
+	FocusSessionHandler       *deliveryHttp.FocusSessionHandler
+	TaskHandler               *deliveryHttp.TaskHandler
+	UserPreferencesHandler    *deliveryHttp.UserPreferencesHandler
+	XpHistoryHandler          *deliveryHttp.XpHistoryHandler
+	LevelCurveHandler         *deliveryHttp.LevelCurveHandler
+	CharacterClassHandler     *deliveryHttp.CharacterClassHandler
+	CharacterStatsHandler     *deliveryHttp.CharacterStatsHandler
+	BattleHandler             *deliveryHttp.BattleHandler
+	RatingHandler             *deliveryHttp.RatingHandler
+	MonsterHandler            *deliveryHttp.MonsterHandler
+	DungeonHandler            *deliveryHttp.DungeonHandler
+	GuildHandler              *deliveryHttp.GuildHandler
+	EnergyHandler             *deliveryHttp.EnergyHandler
+	TournamentHandler         *deliveryHttp.TournamentHandler
 
 	// Middleware
 	AuthMiddleware *middleware.AuthMiddleware
@@ -46,14 +60,102 @@ func NewDelivery(app *Application, infra *Infrastructure, cfg *config.Config) *D
 
 	characterAttributeHandler := deliveryHttp.NewCharacterAttributeHandler(
 		app.GetCharacterAttributesUseCase,
+		app.AllocateAttributePointsUseCase,
+	)
+
+	habitHandler := deliveryHttp.NewHabitHandler(
+		app.CreateHabitUseCase,
+		app.ListHabitsUseCase,
+		app.GetHabitUseCase,
+		app.UpdateHabitUseCase,
+		app.DeleteHabitUseCase,
+		app.CompleteHabitUseCase,
+		app.UndoHabitCompletionUseCase,
+		app.GetDueHabitsUseCase,
+		app.UseStreakFreezeUseCase,
+	)
+
+	focusSessionHandler := deliveryHttp.NewFocusSessionHandler(
+		app.StartFocusSessionUseCase,
+		app.PauseFocusSessionUseCase,
+		app.ResumeFocusSessionUseCase,
+		app.StopFocusSessionUseCase,
+		app.ListFocusSessionsUseCase,
+		app.GetActiveFocusSessionUseCase,
+	)
+
+	taskHandler := deliveryHttp.NewTaskHandler(
+		app.CreateTaskUseCase,
+		app.ListTasksUseCase,
+		app.GetTaskUseCase,
+		app.CompleteTaskUseCase,
+		app.ReopenTaskUseCase,
+		app.CheckTaskItemUseCase,
+	)
+
+	userPreferencesHandler := deliveryHttp.NewUserPreferencesHandler(
+		app.GetUserPreferencesUseCase,
+		app.UpdateUserPreferencesUseCase,
+	)
+
+	xpHistoryHandler := deliveryHttp.NewXpHistoryHandler(
+		app.GetXpHistoryUseCase,
+	)
+
+	levelCurveHandler := deliveryHttp.NewLevelCurveHandler(
+		app.GetXpTableUseCase,
+	)
+
+	characterClassHandler := deliveryHttp.NewCharacterClassHandler(
+		app.ListCharacterClassesUseCase,
+	)
+
+	characterStatsHandler := deliveryHttp.NewCharacterStatsHandler(
+		app.GetCharacterStatsUseCase,
+	)
+
+	battleHandler := deliveryHttp.NewBattleHandler(
+		app.FightMonsterUseCase,
+		app.CreateBattleChallengeUseCase,
+		app.AcceptBattleChallengeUseCase,
+		app.DeclineBattleChallengeUseCase,
+		app.ListBattleChallengesUseCase,
+		app.GetBattleReplayUseCase,
+	)
+
+	ratingHandler := deliveryHttp.NewRatingHandler(
+		app.GetCharacterRatingUseCase,
+		app.GetLeaderboardUseCase,
+	)
+
+	monsterHandler := deliveryHttp.NewMonsterHandler(
+		app.ListMonstersUseCase,
+	)
+
+	dungeonHandler := deliveryHttp.NewDungeonHandler(
+		app.StartDungeonUseCase,
+		app.AdvanceDungeonUseCase,
+		app.AbandonDungeonUseCase,
+	)
+
+	guildHandler := deliveryHttp.NewGuildHandler(
+		app.CreateGuildUseCase,
+		app.JoinGuildUseCase,
+		app.StartRaidUseCase,
+		app.GetGuildRaidUseCase,
+	)
+
+	energyHandler := deliveryHttp.NewEnergyHandler(
+		app.GetCharacterEnergyUseCase,
+	)
+
+	tournamentHandler := deliveryHttp.NewTournamentHandler(
+		app.CreateTournamentUseCase,
+		app.JoinTournamentUseCase,
+		app.GetTournamentUseCase,
 	)
 
 	// Futuro: adicionar novos handlers aqui
-	// habitHandler := deliveryHttp.NewHabitHandler(
-	//     app.CreateHabitUseCase,
-	//     app.UpdateHabitUseCase,
-	//     app.ListHabitsUseCase,
-	// )
 
 	// Inicializar middleware
 	authMiddleware := middleware.NewAuthMiddleware(infra.JWTService)
@@ -67,7 +169,21 @@ func NewDelivery(app *Application, infra *Infrastructure, cfg *config.Config) *D
 		corsMiddleware,
 		characterHandler,
 		characterAttributeHandler,
-		// habitHandler, // Adicionar quando criar
+		habitHandler,
+		focusSessionHandler,
+		taskHandler,
+		userPreferencesHandler,
+		xpHistoryHandler,
+		levelCurveHandler,
+		characterClassHandler,
+		characterStatsHandler,
+		battleHandler,
+		ratingHandler,
+		monsterHandler,
+		dungeonHandler,
+		guildHandler,
+		energyHandler,
+		tournamentHandler,
 	)
 
 	// Setup routes
@@ -78,11 +194,25 @@ func NewDelivery(app *Application, infra *Infrastructure, cfg *config.Config) *D
 		UserHandler:               userHandler,
 		CharacterHandler:          characterHandler,
 		CharacterAttributeHandler: characterAttributeHandler,
+		HabitHandler:              habitHandler,
+		FocusSessionHandler:       focusSessionHandler,
+		TaskHandler:               taskHandler,
+		UserPreferencesHandler:    userPreferencesHandler,
+		XpHistoryHandler:          xpHistoryHandler,
+		LevelCurveHandler:         levelCurveHandler,
+		CharacterClassHandler:     characterClassHandler,
+		CharacterStatsHandler:     characterStatsHandler,
+		BattleHandler:             battleHandler,
+		RatingHandler:             ratingHandler,
+		MonsterHandler:            monsterHandler,
+		DungeonHandler:            dungeonHandler,
+		GuildHandler:              guildHandler,
+		EnergyHandler:             energyHandler,
+		TournamentHandler:         tournamentHandler,
 		AuthMiddleware:            authMiddleware,
 		CORSMiddleware:            corsMiddleware,
-		// HabitHandler: habitHandler,
-		Router: router,
-		Engine: engine,
+		Router:                    router,
+		Engine:                    engine,
 	}
 
 	return delivery
@@ -20,13 +20,33 @@ type Infrastructure struct {
 	// External Services
 	HasherService port.HasherService
 	JWTService    port.JWTService
+	LockService   port.LockService
+	UnitOfWork    port.UnitOfWork
 
 	// Repositories
 	// Adicione novos repositórios aqui conforme necessário
 	UserRepository               repository.UserRepository
 	CharacterRepository          repository.CharacterRepository
 	CharacterAttributeRepository repository.CharacterAttributeRepository
-	// HabitRepository repository.HabitRepository // Exemplo futuro
+	HabitRepository              repository.HabitRepository
+	HabitCompletionRepository    repository.HabitCompletionRepository
+	StreakFreezeRepository       repository.StreakFreezeRepository
+	FocusSessionRepository       repository.FocusSessionRepository
+	HabitPenaltyRepository       repository.HabitPenaltyRepository
+	DayEndRunRepository          repository.DayEndRunRepository
+	TaskRepository               repository.TaskRepository
+	UserPreferencesRepository    repository.UserPreferencesRepository
+	XpTransactionRepository      repository.XpTransactionRepository
+	MonsterRepository            repository.MonsterRepository
+	BattleRepository             repository.BattleRepository
+	BattleChallengeRepository    repository.BattleChallengeRepository
+	CharacterRatingRepository    repository.CharacterRatingRepository
+	DungeonRepository            repository.DungeonRepository
+	DungeonRunRepository         repository.DungeonRunRepository
+	GuildRepository              repository.GuildRepository
+	RaidRepository               repository.RaidRepository
+	CharacterEnergyRepository    repository.CharacterEnergyRepository
+	TournamentRepository         repository.TournamentRepository
 }
 
 // NewInfrastructure inicializa toda a camada de infraestrutura
@@ -52,22 +72,63 @@ func NewInfrastructure(cfg *config.Config) (*Infrastructure, error) {
 		return nil, fmt.Errorf("failed to initialize JWT service: %w", err)
 	}
 
+	lockService := persistence.NewPostgresAdvisoryLockService(db)
+	unitOfWork := persistence.NewPostgresUnitOfWork(db)
+
 	// Inicializar repositórios
 	userRepo := persistence.NewPostgresUserRepository(db)
 	characterRepo := persistence.NewPostgresCharacterRepository(db)
 	characterAttributeRepo := persistence.NewPostgresCharacterAttributeRepository(db)
+	habitRepo := persistence.NewPostgresHabitRepository(db)
+	habitCompletionRepo := persistence.NewPostgresHabitCompletionRepository(db)
+	streakFreezeRepo := persistence.NewPostgresStreakFreezeRepository(db)
+	focusSessionRepo := persistence.NewPostgresFocusSessionRepository(db)
+	habitPenaltyRepo := persistence.NewPostgresHabitPenaltyRepository(db)
+	dayEndRunRepo := persistence.NewPostgresDayEndRunRepository(db)
+	taskRepo := persistence.NewPostgresTaskRepository(db)
+	userPreferencesRepo := persistence.NewPostgresUserPreferencesRepository(db)
+	xpTransactionRepo := persistence.NewPostgresXpTransactionRepository(db)
+	monsterRepo := persistence.NewPostgresMonsterRepository(db)
+	battleRepo := persistence.NewPostgresBattleRepository(db)
+	battleChallengeRepo := persistence.NewPostgresBattleChallengeRepository(db)
+	characterRatingRepo := persistence.NewPostgresCharacterRatingRepository(db)
+	dungeonRepo := persistence.NewPostgresDungeonRepository(db)
+	dungeonRunRepo := persistence.NewPostgresDungeonRunRepository(db)
+	guildRepo := persistence.NewPostgresGuildRepository(db)
+	raidRepo := persistence.NewPostgresRaidRepository(db)
+	characterEnergyRepo := persistence.NewPostgresCharacterEnergyRepository(db)
+	tournamentRepo := persistence.NewPostgresTournamentRepository(db)
 
 	// Futuro: adicionar novos repositórios aqui
-	// habitRepo := persistence.NewPostgresHabitRepository(db)
 
 	infra := &Infrastructure{
 		DB:                           db,
 		HasherService:                hasherService,
 		JWTService:                   jwtService,
+		LockService:                  lockService,
+		UnitOfWork:                   unitOfWork,
 		UserRepository:               userRepo,
 		CharacterRepository:          characterRepo,
 		CharacterAttributeRepository: characterAttributeRepo,
-		// HabitRepository: habitRepo,
+		HabitRepository:              habitRepo,
+		HabitCompletionRepository:    habitCompletionRepo,
+		StreakFreezeRepository:       streakFreezeRepo,
+		FocusSessionRepository:       focusSessionRepo,
+		HabitPenaltyRepository:       habitPenaltyRepo,
+		DayEndRunRepository:          dayEndRunRepo,
+		TaskRepository:               taskRepo,
+		UserPreferencesRepository:    userPreferencesRepo,
+		XpTransactionRepository:      xpTransactionRepo,
+		MonsterRepository:            monsterRepo,
+		BattleRepository:             battleRepo,
+		BattleChallengeRepository:    battleChallengeRepo,
+		CharacterRatingRepository:    characterRatingRepo,
+		DungeonRepository:            dungeonRepo,
+		DungeonRunRepository:         dungeonRunRepo,
+		GuildRepository:              guildRepo,
+		RaidRepository:               raidRepo,
+		CharacterEnergyRepository:    characterEnergyRepo,
+		TournamentRepository:         tournamentRepo,
 	}
 
 	return infra, nil
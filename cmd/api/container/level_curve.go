@@ -0,0 +1,23 @@
+package container
+
+import (
+	"fmt"
+
+	"github.com/igor/chronotask-api/config"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// newLevelCurve cria a curva de XP selecionada na configuração
+// Trocar a curva ou o nível máximo rebalanceia a progressão sem mudanças de código
+func newLevelCurve(cfg config.LevelCurveConfig) (valueobject.LevelCurve, error) {
+	switch cfg.Type {
+	case valueobject.LevelCurvePolynomial:
+		return valueobject.NewPolynomialLevelCurve(cfg.BaseXp, cfg.Exponent, cfg.MaxLevel)
+	case valueobject.LevelCurveExponential:
+		return valueobject.NewExponentialLevelCurve(cfg.BaseXp, cfg.Growth, cfg.MaxLevel)
+	case valueobject.LevelCurveTable:
+		return valueobject.NewTableLevelCurve(cfg.Table, cfg.MaxLevel)
+	default:
+		return nil, fmt.Errorf("unknown level curve type: %s", cfg.Type)
+	}
+}
@@ -0,0 +1,67 @@
+package container
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/igor/chronotask-api/config"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/infrastructure/scheduler"
+)
+
+// NewScheduler inicializa os jobs em background
+// Retorna nil quando o scheduler está desabilitado (SCHEDULER_ENABLED=false)
+// Para adicionar um novo job: s.Register(scheduler.Job{Name, Interval, Run})
+func NewScheduler(app *Application, cfg *config.Config) (*scheduler.Scheduler, error) {
+	if !cfg.Scheduler.Enabled {
+		return nil, nil
+	}
+
+	interval, err := time.ParseDuration(cfg.Scheduler.Interval)
+	if err != nil || interval <= 0 {
+		return nil, fmt.Errorf("invalid scheduler interval %q", cfg.Scheduler.Interval)
+	}
+
+	s := scheduler.New()
+
+	// Fim do dia: penaliza os hábitos não cumpridos de cada usuário
+	// Idempotente por usuário/dia, então pode rodar em várias réplicas
+	s.Register(scheduler.Job{
+		Name:     "day-end",
+		Interval: interval,
+		Run: func(ctx context.Context) error {
+			output, err := app.ProcessDayEndUseCase.Execute(ctx, usecase.ProcessDayEndInput{Now: time.Now()})
+			if err != nil {
+				return err
+			}
+			if output.UsersProcessed > 0 || output.UsersFailed > 0 {
+				log.Printf("✓ Day end: %d users processed, %d penalties applied, %d users failed",
+					output.UsersProcessed, output.Penalties, output.UsersFailed)
+			}
+			return nil
+		},
+	})
+
+	// Torneios: sorteia as chaves no prazo de inscrição e disputa as rodadas agendadas
+	// Idempotente por torneio/rodada (o torneio fica bloqueado), então pode rodar em várias réplicas
+	s.Register(scheduler.Job{
+		Name:     "tournament-rounds",
+		Interval: interval,
+		Run: func(ctx context.Context) error {
+			output, err := app.PlayTournamentRoundsUseCase.Execute(ctx, usecase.PlayTournamentRoundsInput{Now: time.Now()})
+			if err != nil {
+				return err
+			}
+			if output.TournamentsStarted > 0 || output.TournamentsCancelled > 0 || output.RoundsPlayed > 0 || output.TournamentsFailed > 0 {
+				log.Printf("✓ Tournament rounds: %d started, %d cancelled, %d rounds played (%d battles), %d completed, %d failed",
+					output.TournamentsStarted, output.TournamentsCancelled, output.RoundsPlayed,
+					output.BattlesFought, output.TournamentsCompleted, output.TournamentsFailed)
+			}
+			return nil
+		},
+	})
+
+	return s, nil
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"log"
 	"os"
 	"os/signal"
@@ -38,6 +39,12 @@ func main() {
 	log.Printf("✓ All dependencies injected")
 	log.Printf("✓ Starting ChronoTask API server on port %s", cfg.Server.Port)
 
+	// Iniciar jobs em background (penalidade de hábitos no fim do dia)
+	if cfg.Scheduler.Enabled {
+		appContainer.StartScheduler(context.Background())
+		log.Printf("✓ Scheduler started (every %s)", cfg.Scheduler.Interval)
+	}
+
 	// Graceful shutdown
 	go func() {
 		if err := engine.Run(":" + cfg.Server.Port); err != nil {
@@ -3,16 +3,21 @@ package config
 import (
 	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/joho/godotenv"
 )
 
 // Config holds all application configuration
 type Config struct {
-	Server   ServerConfig
-	Database DatabaseConfig
-	JWT      JWTConfig
-	CORS     CORSConfig
+	Server    ServerConfig
+	Database  DatabaseConfig
+	JWT       JWTConfig
+	CORS      CORSConfig
+	Scheduler SchedulerConfig
+	LevelCurve LevelCurveConfig
+	Battle    BattleConfig
+	Tournament TournamentConfig
 }
 
 // ServerConfig holds server-specific configuration
@@ -42,11 +47,45 @@ type CORSConfig struct {
 	AllowedOrigins []string
 }
 
+// SchedulerConfig holds background jobs configuration
+type SchedulerConfig struct {
+	Enabled                     bool
+	Interval                    string // How often day-end processing runs, e.g., "5m"
+	MissedHabitXpPenaltyPercent int    // Share (0-100) of a habit's XP reward lost when it is missed
+	MissedHabitAttributePenalty int    // Attribute points lost when a habit is missed
+}
+
+// LevelCurveConfig holds the XP progression configuration (rebalanced without code changes)
+type LevelCurveConfig struct {
+	Type     string  // "polynomial", "exponential" or "table"
+	BaseXp   int     // XP needed to leave level 1 (polynomial and exponential)
+	Exponent float64 // Polynomial curve: baseXp * level^exponent
+	Growth   float64 // Exponential curve: baseXp * growth^(level-1)
+	Table    []int   // Table curve: XP needed to leave levels 1, 2, 3... (capped after the last entry)
+	MaxLevel int     // Level cap, 0 for none
+}
+
+// BattleConfig holds battle configuration
+type BattleConfig struct {
+	ChallengeExpiration string // How long a PvP challenge waits for an answer, e.g., "24h"
+	RatingPeriod        string // Glicko-2 rating period: the rating deviation grows for each one without ranked battles, e.g., "24h"
+}
+
+// TournamentConfig holds tournament configuration
+type TournamentConfig struct {
+	AdminUserIDs []string // Users allowed to create tournaments open to every character (guild leaders create their guild's)
+}
+
 // Load loads configuration from environment variables
 func Load() (*Config, error) {
 	// Load .env file if it exists (ignore error if not found)
 	_ = godotenv.Load()
 
+	levelCurveTable, err := getIntSliceEnv("LEVEL_CURVE_TABLE")
+	if err != nil {
+		return nil, err
+	}
+
 	config := &Config{
 		Server: ServerConfig{
 			Port: getEnv("SERVER_PORT", "8080"),
@@ -70,6 +109,27 @@ func Load() (*Config, error) {
 				"http://localhost:5173",
 			}),
 		},
+		Scheduler: SchedulerConfig{
+			Enabled:                     getBoolEnv("SCHEDULER_ENABLED", true),
+			Interval:                    getEnv("SCHEDULER_INTERVAL", "5m"),
+			MissedHabitXpPenaltyPercent: getIntEnv("MISSED_HABIT_XP_PENALTY_PERCENT", 50),
+			MissedHabitAttributePenalty: getIntEnv("MISSED_HABIT_ATTRIBUTE_PENALTY", 1),
+		},
+		LevelCurve: LevelCurveConfig{
+			Type:     getEnv("LEVEL_CURVE_TYPE", "polynomial"),
+			BaseXp:   getIntEnv("LEVEL_CURVE_BASE_XP", 100),
+			Exponent: getFloatEnv("LEVEL_CURVE_EXPONENT", 1.5),
+			Growth:   getFloatEnv("LEVEL_CURVE_GROWTH", 1.2),
+			Table:    levelCurveTable,
+			MaxLevel: getIntEnv("MAX_LEVEL", 0),
+		},
+		Battle: BattleConfig{
+			ChallengeExpiration: getEnv("BATTLE_CHALLENGE_EXPIRATION", "24h"),
+			RatingPeriod:        getEnv("BATTLE_RATING_PERIOD", "24h"),
+		},
+		Tournament: TournamentConfig{
+			AdminUserIDs: getSliceEnv("TOURNAMENT_ADMIN_USER_IDS", nil),
+		},
 	}
 
 	return config, nil
@@ -96,6 +156,49 @@ func getEnv(key, defaultValue string) string {
 	return defaultValue
 }
 
+// getIntEnv gets an integer environment variable or returns a default value
+func getIntEnv(key string, defaultValue int) int {
+	if value, err := strconv.Atoi(os.Getenv(key)); err == nil {
+		return value
+	}
+	return defaultValue
+}
+
+// getFloatEnv gets a float environment variable or returns a default value
+func getFloatEnv(key string, defaultValue float64) float64 {
+	if value, err := strconv.ParseFloat(os.Getenv(key), 64); err == nil {
+		return value
+	}
+	return defaultValue
+}
+
+// getIntSliceEnv gets a comma-separated list of integers (nil when unset)
+// Unlike the other getters a malformed value is an error: a silently dropped entry would shift the list
+func getIntSliceEnv(key string) ([]int, error) {
+	value := os.Getenv(key)
+	if value == "" {
+		return nil, nil
+	}
+
+	var result []int
+	for _, item := range splitAndTrim(value, ",") {
+		number, err := strconv.Atoi(item)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s entry %q: %w", key, item, err)
+		}
+		result = append(result, number)
+	}
+	return result, nil
+}
+
+// getBoolEnv gets a boolean environment variable or returns a default value
+func getBoolEnv(key string, defaultValue bool) bool {
+	if value, err := strconv.ParseBool(os.Getenv(key)); err == nil {
+		return value
+	}
+	return defaultValue
+}
+
 // getSliceEnv gets a comma-separated environment variable as a slice or returns default
 func getSliceEnv(key string, defaultValue []string) []string {
 	if value := os.Getenv(key); value != "" {
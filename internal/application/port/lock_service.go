@@ -0,0 +1,11 @@
+package port
+
+import "context"
+
+// LockService defines the interface for locks shared by every API replica (Port)
+// Background jobs use it so the same work isn't processed twice concurrently
+type LockService interface {
+	// TryLock tries to acquire the lock identified by key without waiting
+	// Returns acquired=false when the lock is held elsewhere; release must be called once acquired
+	TryLock(ctx context.Context, key string) (release func(), acquired bool, err error)
+}
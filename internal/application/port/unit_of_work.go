@@ -0,0 +1,11 @@
+package port
+
+import "context"
+
+// UnitOfWork defines the interface for running several writes atomically (Port)
+// Repositories called with the context handed to fn take part in the same transaction
+type UnitOfWork interface {
+	// Do runs fn in a transaction: it commits when fn returns nil and rolls back when it fails or panics
+	// Calls nested in fn join the enclosing transaction
+	Do(ctx context.Context, fn func(ctx context.Context) error) error
+}
@@ -0,0 +1,82 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/port"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+// AbandonDungeonUseCase handles giving up a dungeon run
+type AbandonDungeonUseCase struct {
+	characterRepo          repository.CharacterRepository
+	characterAttributeRepo repository.CharacterAttributeRepository
+	dungeonRepo            repository.DungeonRepository
+	dungeonRunRepo         repository.DungeonRunRepository
+	unitOfWork             port.UnitOfWork
+}
+
+// NewAbandonDungeonUseCase creates a new AbandonDungeonUseCase
+func NewAbandonDungeonUseCase(
+	characterRepo repository.CharacterRepository,
+	characterAttributeRepo repository.CharacterAttributeRepository,
+	dungeonRepo repository.DungeonRepository,
+	dungeonRunRepo repository.DungeonRunRepository,
+	unitOfWork port.UnitOfWork,
+) *AbandonDungeonUseCase {
+	return &AbandonDungeonUseCase{
+		characterRepo:          characterRepo,
+		characterAttributeRepo: characterAttributeRepo,
+		dungeonRepo:            dungeonRepo,
+		dungeonRunRepo:         dungeonRunRepo,
+		unitOfWork:             unitOfWork,
+	}
+}
+
+// Execute ends the character's run in the dungeon (the entry cost is not refunded)
+func (uc *AbandonDungeonUseCase) Execute(ctx context.Context, input DungeonRunInput) (*DungeonRunOutput, error) {
+	// 1. Validate character exists AND belongs to the authenticated user
+	character, err := uc.characterRepo.FindByIDAndUserID(ctx, input.CharacterID, input.UserID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+
+	dungeon, err := uc.dungeonRepo.FindByID(ctx, input.DungeonID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrDungeonNotFound, input.DungeonID)
+	}
+
+	challenger, err := characterCombatant(ctx, uc.characterAttributeRepo, character)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Abandon the run with it locked, so it can't be advanced at the same time
+	var output DungeonRunOutput
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		run, err := uc.dungeonRunRepo.FindInProgressByCharacterIDForUpdate(ctx, character.ID())
+		if err != nil {
+			return fmt.Errorf("failed to fetch dungeon run: %w", err)
+		}
+		if run == nil || run.DungeonID() != dungeon.ID() {
+			return fmt.Errorf("%w: %s", ErrDungeonRunNotFound, dungeon.ID())
+		}
+
+		if err := run.Abandon(time.Now().UTC()); err != nil {
+			return fmt.Errorf("failed to abandon dungeon run: %w", err)
+		}
+		if err := uc.dungeonRunRepo.Update(ctx, run); err != nil {
+			return fmt.Errorf("failed to save dungeon run: %w", err)
+		}
+
+		output = mapDungeonRunToOutput(run, dungeon, challenger.Stats().HP())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
@@ -0,0 +1,66 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+func TestAbandonDungeonUseCase_Execute(t *testing.T) {
+	fixture := newDungeonFixture(t, 10, 3, 50)
+	ctx := context.Background()
+
+	start, err := fixture.start.Execute(ctx, dungeonInput("toca-dos-ratos"))
+	if err != nil {
+		t.Fatalf("Start Execute() error = %v, want nil", err)
+	}
+
+	output, err := fixture.abandon.Execute(ctx, dungeonInput("toca-dos-ratos"))
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if output.ID != start.Run.ID || output.Status != entity.DungeonRunAbandoned || output.EndedAt == "" {
+		t.Errorf("output = %+v, want run %s abandoned", output, start.Run.ID)
+	}
+
+	// The entry cost is not refunded
+	if fixture.character.CurrentXp() != 40 {
+		t.Errorf("CurrentXp() = %d, want 40", fixture.character.CurrentXp())
+	}
+
+	for _, execute := range []func(context.Context, usecase.DungeonRunInput) error{
+		func(ctx context.Context, input usecase.DungeonRunInput) error {
+			_, err := fixture.advance.Execute(ctx, input)
+			return err
+		},
+		func(ctx context.Context, input usecase.DungeonRunInput) error {
+			_, err := fixture.abandon.Execute(ctx, input)
+			return err
+		},
+	} {
+		if err := execute(ctx, dungeonInput("toca-dos-ratos")); !errors.Is(err, usecase.ErrDungeonRunNotFound) {
+			t.Errorf("Execute() after abandoning error = %v, want %v", err, usecase.ErrDungeonRunNotFound)
+		}
+	}
+
+	// A new run can be started (and paid for) again
+	restart, err := fixture.start.Execute(ctx, dungeonInput("toca-dos-ratos"))
+	if err != nil {
+		t.Fatalf("Start Execute() error = %v, want nil", err)
+	}
+	if restart.Resumed || restart.Run.ID == start.Run.ID || fixture.character.CurrentXp() != 30 {
+		t.Errorf("restart = %+v, want a new paid run", restart)
+	}
+}
+
+func TestAbandonDungeonUseCase_Execute_NotOwned(t *testing.T) {
+	fixture := newDungeonFixture(t, 10, 3, 50)
+
+	_, err := fixture.abandon.Execute(context.Background(), usecase.DungeonRunInput{DungeonID: "toca-dos-ratos", CharacterID: "char-123", UserID: "user-456"})
+	if !errors.Is(err, usecase.ErrCharacterNotFound) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrCharacterNotFound)
+	}
+}
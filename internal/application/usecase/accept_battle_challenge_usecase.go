@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igor/chronotask-api/internal/application/port"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+// AcceptBattleChallengeOutput represents an accepted challenge and the battle it started
+type AcceptBattleChallengeOutput struct {
+	Challenge         BattleChallengeOutput
+	BattleID          string
+	Seed              int64
+	Challenger        BattleCombatantOutput
+	Opponent          BattleCombatantOutput
+	Winner            string // challenger or opponent, empty on a draw
+	WinnerCharacterID string // Empty on a draw
+	Rounds            int
+	ChallengerHP      int
+	OpponentHP        int
+	Actions           []BattleActionOutput
+	RatingChanges     []RatingChangeOutput // Challenger then opponent, empty for unranked battles
+	Energy            int                  // Energy the accepting character has left after the battle
+}
+
+// AcceptBattleChallengeUseCase handles accepting a PvP challenge and fighting the battle
+type AcceptBattleChallengeUseCase struct {
+	characterRepo          repository.CharacterRepository
+	characterAttributeRepo repository.CharacterAttributeRepository
+	battleRepo             repository.BattleRepository
+	battleChallengeRepo    repository.BattleChallengeRepository
+	characterRatingRepo    repository.CharacterRatingRepository
+	characterEnergyRepo    repository.CharacterEnergyRepository
+	unitOfWork             port.UnitOfWork
+	ratingPeriod           time.Duration // Inactive time that grows the deviation by one Glicko-2 period
+}
+
+// NewAcceptBattleChallengeUseCase creates a new AcceptBattleChallengeUseCase
+func NewAcceptBattleChallengeUseCase(
+	characterRepo repository.CharacterRepository,
+	characterAttributeRepo repository.CharacterAttributeRepository,
+	battleRepo repository.BattleRepository,
+	battleChallengeRepo repository.BattleChallengeRepository,
+	characterRatingRepo repository.CharacterRatingRepository,
+	characterEnergyRepo repository.CharacterEnergyRepository,
+	unitOfWork port.UnitOfWork,
+	ratingPeriod time.Duration,
+) *AcceptBattleChallengeUseCase {
+	return &AcceptBattleChallengeUseCase{
+		characterRepo:          characterRepo,
+		characterAttributeRepo: characterAttributeRepo,
+		battleRepo:             battleRepo,
+		battleChallengeRepo:    battleChallengeRepo,
+		characterRatingRepo:    characterRatingRepo,
+		characterEnergyRepo:    characterEnergyRepo,
+		unitOfWork:             unitOfWork,
+		ratingPeriod:           ratingPeriod,
+	}
+}
+
+// Execute accepts a pending challenge sent to one of the user's characters and fights the battle
+// Both characters enter the battle with their attributes at the time of the acceptance,
+// and both pay its energy (ErrNotEnoughEnergy when either doesn't have enough; the challenge stays pending)
+func (uc *AcceptBattleChallengeUseCase) Execute(ctx context.Context, input BattleChallengeInput) (*AcceptBattleChallengeOutput, error) {
+	// 1. Validate challenge exists AND was sent to a character of the authenticated user
+	challenge, opponent, err := findChallengeForOpponent(ctx, uc.battleChallengeRepo, uc.characterRepo, input)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if err := checkChallengeAnswerable(challenge, now); err != nil {
+		return nil, err
+	}
+
+	challenger, err := uc.characterRepo.FindByID(ctx, challenge.ChallengerCharacterID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch challenger: %w", err)
+	}
+
+	// 2. Snapshot both combatants with their current attributes
+	challengerCombatant, err := characterCombatant(ctx, uc.characterAttributeRepo, challenger)
+	if err != nil {
+		return nil, err
+	}
+
+	opponentCombatant, err := characterCombatant(ctx, uc.characterAttributeRepo, opponent)
+	if err != nil {
+		return nil, err
+	}
+
+	// 3. Fight the battle (the seed makes it reproducible)
+	battle, err := entity.NewBattle(uuid.New().String(), challengerCombatant, opponentCombatant, rand.Int64N(maxBattleSeed), now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create battle: %w", err)
+	}
+
+	if err := challenge.Accept(now, battle.ID()); err != nil {
+		return nil, fmt.Errorf("failed to accept battle challenge: %w", err)
+	}
+
+	// 4. Pay for the battle and persist it together with the answer (a challenge is only fought once)
+	// and, for ranked battles, both characters' new ratings
+	var ratingChanges []RatingChangeOutput
+	var energyLeft map[string]int
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		energyLeft, err = spendBattleEnergy(ctx, uc.characterEnergyRepo, now, challenger.ID(), opponent.ID())
+		if err != nil {
+			return err
+		}
+
+		if err := uc.battleRepo.Create(ctx, battle); err != nil {
+			return fmt.Errorf("failed to save battle: %w", err)
+		}
+		if err := uc.battleChallengeRepo.Respond(ctx, challenge); err != nil {
+			return fmt.Errorf("failed to save battle challenge: %w", err)
+		}
+		if !challenge.IsRanked() {
+			return nil
+		}
+
+		ratingChanges, err = uc.rateBattle(ctx, battle)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &AcceptBattleChallengeOutput{
+		Challenge:         mapBattleChallengeEntityToOutput(challenge, now),
+		BattleID:          battle.ID(),
+		Seed:              battle.Seed(),
+		Challenger:        mapCombatantToOutput(battle.Challenger()),
+		Opponent:          mapCombatantToOutput(battle.Opponent()),
+		Winner:            battle.Winner(),
+		WinnerCharacterID: battle.WinnerID(),
+		Rounds:            battle.Rounds(),
+		ChallengerHP:      battle.ChallengerHP(),
+		OpponentHP:        battle.OpponentHP(),
+		Actions:           mapBattleActionsToOutput(battle.Actions()),
+		RatingChanges:     ratingChanges,
+		Energy:            energyLeft[opponent.ID()],
+	}, nil
+}
+
+// rateBattle updates and saves both characters' ratings with the battle result (inside the unit of work)
+// The ratings are locked in a fixed order, so concurrent ranked battles can't deadlock or lose an update
+func (uc *AcceptBattleChallengeUseCase) rateBattle(ctx context.Context, battle *entity.Battle) ([]RatingChangeOutput, error) {
+	characterIDs := []string{battle.ChallengerID(), battle.OpponentID()}
+	slices.Sort(characterIDs)
+
+	ratings := make(map[string]*entity.CharacterRating, len(characterIDs))
+	for _, characterID := range characterIDs {
+		rating, err := lockCharacterRating(ctx, uc.characterRatingRepo, characterID)
+		if err != nil {
+			return nil, err
+		}
+		ratings[characterID] = rating
+	}
+
+	challenger, opponent := ratings[battle.ChallengerID()], ratings[battle.OpponentID()]
+	if err := entity.RateBattle(battle, challenger, opponent, uc.ratingPeriod); err != nil {
+		return nil, fmt.Errorf("failed to rate battle: %w", err)
+	}
+
+	outputs := make([]RatingChangeOutput, 0, 2)
+	for _, rating := range []*entity.CharacterRating{challenger, opponent} {
+		for _, change := range rating.PendingChanges() {
+			outputs = append(outputs, mapRatingChangeEntityToOutput(change))
+		}
+		if err := uc.characterRatingRepo.Save(ctx, rating); err != nil {
+			return nil, fmt.Errorf("failed to save character rating: %w", err)
+		}
+	}
+
+	return outputs, nil
+}
+
+// lockCharacterRating retrieves the rating of a character and locks it until the unit of work ends
+// The initial rating of a character without ranked battles is stored first, so there is always a row to lock
+// and concurrent first battles can't overwrite each other's result
+func lockCharacterRating(
+	ctx context.Context,
+	characterRatingRepo repository.CharacterRatingRepository,
+	characterID string,
+) (*entity.CharacterRating, error) {
+	rating, err := characterRatingRepo.FindByCharacterIDForUpdate(ctx, characterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch character rating: %w", err)
+	}
+	if rating != nil {
+		return rating, nil
+	}
+
+	rating, err = entity.NewCharacterRating(characterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create character rating: %w", err)
+	}
+	if err := characterRatingRepo.Create(ctx, rating); err != nil {
+		return nil, fmt.Errorf("failed to save character rating: %w", err)
+	}
+
+	rating, err = characterRatingRepo.FindByCharacterIDForUpdate(ctx, characterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch character rating: %w", err)
+	}
+	if rating == nil {
+		return nil, fmt.Errorf("character rating of %s was not saved", characterID)
+	}
+	return rating, nil
+}
@@ -0,0 +1,340 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Mock BattleRepository
+type mockBattleRepository struct {
+	battles   map[string]*entity.Battle
+	createErr error
+}
+
+func (m *mockBattleRepository) Create(ctx context.Context, battle *entity.Battle) error {
+	if m.createErr != nil {
+		return m.createErr
+	}
+	if m.battles == nil {
+		m.battles = map[string]*entity.Battle{}
+	}
+	m.battles[battle.ID()] = battle
+	return nil
+}
+
+func (m *mockBattleRepository) FindByID(ctx context.Context, id string) (*entity.Battle, error) {
+	if battle, ok := m.battles[id]; ok {
+		return battle, nil
+	}
+	return nil, errors.New("battle not found")
+}
+
+// newPendingChallenge creates a challenge from char-123 to char-456 sent createdAgo ago
+func newPendingChallenge(t *testing.T, createdAgo time.Duration, expiresIn time.Duration) *entity.BattleChallenge {
+	t.Helper()
+
+	challenge, err := entity.NewBattleChallenge("challenge-1", "char-123", "char-456", false, time.Now().UTC().Add(-createdAgo), expiresIn)
+	if err != nil {
+		t.Fatalf("NewBattleChallenge() error = %v, want nil", err)
+	}
+	return challenge
+}
+
+// newAcceptFixture builds an accept use case in which char-123 has stronger attributes than char-456
+func newAcceptFixture(challenge *entity.BattleChallenge) (*usecase.AcceptBattleChallengeUseCase, *mockBattleRepository, *mockBattleChallengeRepository, *mockCharacterRatingRepository, *mockUnitOfWork) {
+	return newAcceptFixtureWithEnergy(challenge, newMockCharacterEnergyRepository())
+}
+
+// newAcceptFixtureWithEnergy builds the same accept use case as newAcceptFixture, paying battles from energyRepo
+func newAcceptFixtureWithEnergy(challenge *entity.BattleChallenge, energyRepo *mockCharacterEnergyRepository) (*usecase.AcceptBattleChallengeUseCase, *mockBattleRepository, *mockBattleChallengeRepository, *mockCharacterRatingRepository, *mockUnitOfWork) {
+	attrRepo := &mockCharacterAttributeRepositoryGet{
+		findByCharacterIDFunc: func(ctx context.Context, characterID string) ([]*entity.CharacterAttribute, error) {
+			if characterID == "char-123" {
+				return newUniformAttributes(characterID, 40), nil
+			}
+			return newUniformAttributes(characterID, 1), nil
+		},
+	}
+
+	battleRepo := &mockBattleRepository{}
+	challengeRepo := newMockBattleChallengeRepository(challenge)
+	ratingRepo := newMockCharacterRatingRepository()
+	unitOfWork := &mockUnitOfWork{}
+
+	uc := usecase.NewAcceptBattleChallengeUseCase(newDuelistRepository(), attrRepo, battleRepo, challengeRepo, ratingRepo, energyRepo, unitOfWork, 24*time.Hour)
+	return uc, battleRepo, challengeRepo, ratingRepo, unitOfWork
+}
+
+func TestAcceptBattleChallengeUseCase_Execute_FightsAndPersistsBattle(t *testing.T) {
+	challenge := newPendingChallenge(t, time.Hour, 24*time.Hour)
+	uc, battleRepo, challengeRepo, ratingRepo, unitOfWork := newAcceptFixture(challenge)
+
+	output, err := uc.Execute(context.Background(), usecase.BattleChallengeInput{ChallengeID: "challenge-1", UserID: "user-456"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	// Both characters entered with their current attributes: the much stronger challenger wins
+	if output.Winner != entity.BattleSideChallenger || output.WinnerCharacterID != "char-123" {
+		t.Errorf("winner = %s (%s), want %s (char-123)", output.Winner, output.WinnerCharacterID, entity.BattleSideChallenger)
+	}
+
+	if output.Challenger.ID != "char-123" || output.Opponent.ID != "char-456" {
+		t.Errorf("combatants = %s vs %s, want char-123 vs char-456", output.Challenger.ID, output.Opponent.ID)
+	}
+
+	if output.Challenge.Status != entity.BattleChallengeAccepted || output.Challenge.BattleID != output.BattleID {
+		t.Errorf("challenge = %s (battle %s), want accepted (battle %s)", output.Challenge.Status, output.Challenge.BattleID, output.BattleID)
+	}
+
+	// The battle and the answer are saved together
+	battle, ok := battleRepo.battles[output.BattleID]
+	if !ok {
+		t.Fatal("battle was not saved")
+	}
+
+	if battle.Seed() != output.Seed || battle.Rounds() != output.Rounds || battle.Winner() != output.Winner {
+		t.Error("saved battle doesn't match the output")
+	}
+
+	if got := challengeRepo.challenges["challenge-1"].StoredStatus(); got != entity.BattleChallengeAccepted {
+		t.Errorf("saved challenge status = %v, want %v", got, entity.BattleChallengeAccepted)
+	}
+
+	if unitOfWork.commits != 1 {
+		t.Errorf("commits = %d, want 1", unitOfWork.commits)
+	}
+
+	// Unranked battles leave the ratings alone
+	if len(output.RatingChanges) != 0 || len(ratingRepo.ratings) != 0 {
+		t.Errorf("rating changes = %d, saved ratings = %d, want none for an unranked battle", len(output.RatingChanges), len(ratingRepo.ratings))
+	}
+
+	// The log ends on the final HP
+	if len(output.Actions) == 0 || output.Actions[len(output.Actions)-1].TargetHP != output.OpponentHP {
+		t.Error("last action should leave the opponent with its final HP")
+	}
+}
+
+func TestAcceptBattleChallengeUseCase_Execute_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		challenge func(t *testing.T) *entity.BattleChallenge
+		input     usecase.BattleChallengeInput
+		wantErr   error
+	}{
+		{
+			name:      "unknown challenge",
+			challenge: func(t *testing.T) *entity.BattleChallenge { return newPendingChallenge(t, time.Hour, 24*time.Hour) },
+			input:     usecase.BattleChallengeInput{ChallengeID: "challenge-2", UserID: "user-456"},
+			wantErr:   usecase.ErrBattleChallengeNotFound,
+		},
+		{
+			name:      "challenger can't accept its own challenge",
+			challenge: func(t *testing.T) *entity.BattleChallenge { return newPendingChallenge(t, time.Hour, 24*time.Hour) },
+			input:     usecase.BattleChallengeInput{ChallengeID: "challenge-1", UserID: "user-123"},
+			wantErr:   usecase.ErrBattleChallengeNotFound,
+		},
+		{
+			name:      "expired challenge",
+			challenge: func(t *testing.T) *entity.BattleChallenge { return newPendingChallenge(t, 2*time.Hour, time.Hour) },
+			input:     usecase.BattleChallengeInput{ChallengeID: "challenge-1", UserID: "user-456"},
+			wantErr:   usecase.ErrBattleChallengeExpired,
+		},
+		{
+			name: "declined challenge",
+			challenge: func(t *testing.T) *entity.BattleChallenge {
+				challenge := newPendingChallenge(t, time.Hour, 24*time.Hour)
+				if err := challenge.Decline(time.Now().UTC()); err != nil {
+					t.Fatalf("Decline() error = %v, want nil", err)
+				}
+				return challenge
+			},
+			input:   usecase.BattleChallengeInput{ChallengeID: "challenge-1", UserID: "user-456"},
+			wantErr: usecase.ErrBattleChallengeAnswered,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, battleRepo, _, _, _ := newAcceptFixture(tt.challenge(t))
+
+			_, err := uc.Execute(context.Background(), tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Execute() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if len(battleRepo.battles) != 0 {
+				t.Error("no battle should be fought")
+			}
+		})
+	}
+}
+
+func TestAcceptBattleChallengeUseCase_Execute_RollsBackWhenAnswerFails(t *testing.T) {
+	challenge := newPendingChallenge(t, time.Hour, 24*time.Hour)
+	uc, _, challengeRepo, _, unitOfWork := newAcceptFixture(challenge)
+
+	// Another request answered the challenge first
+	challengeRepo.respondErr = errors.New("battle challenge not found or already answered")
+
+	if _, err := uc.Execute(context.Background(), usecase.BattleChallengeInput{ChallengeID: "challenge-1", UserID: "user-456"}); err == nil {
+		t.Fatal("Execute() error = nil, want error")
+	}
+
+	if unitOfWork.rollbacks != 1 || unitOfWork.commits != 0 {
+		t.Errorf("commits/rollbacks = %d/%d, want 0/1", unitOfWork.commits, unitOfWork.rollbacks)
+	}
+}
+
+func TestAcceptBattleChallengeUseCase_Execute_BothDuelistsSpendEnergy(t *testing.T) {
+	challenge := newPendingChallenge(t, time.Hour, 24*time.Hour)
+	energyRepo := newMockCharacterEnergyRepository()
+	energyRepo.setEnergy("char-456", 30, time.Now().UTC())
+	uc, _, _, _, _ := newAcceptFixtureWithEnergy(challenge, energyRepo)
+
+	output, err := uc.Execute(context.Background(), usecase.BattleChallengeInput{ChallengeID: "challenge-1", UserID: "user-456"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	// The accepting character sees the energy it has left
+	if output.Energy != 30-entity.BattleEnergyCost {
+		t.Errorf("output.Energy = %d, want %d", output.Energy, 30-entity.BattleEnergyCost)
+	}
+
+	now := time.Now().UTC()
+	if got := energyRepo.energyAt("char-123", now); got != entity.MaxEnergy-entity.BattleEnergyCost {
+		t.Errorf("challenger energy = %d, want %d", got, entity.MaxEnergy-entity.BattleEnergyCost)
+	}
+
+	// The pools are locked in a fixed order
+	if len(energyRepo.locked) < 2 || energyRepo.locked[0] != "char-123" || energyRepo.locked[len(energyRepo.locked)-1] != "char-456" {
+		t.Errorf("locked = %v, want char-123 before char-456", energyRepo.locked)
+	}
+}
+
+func TestAcceptBattleChallengeUseCase_Execute_NotEnoughEnergy(t *testing.T) {
+	challenge := newPendingChallenge(t, time.Hour, 24*time.Hour)
+	energyRepo := newMockCharacterEnergyRepository()
+	energyRepo.setEnergy("char-123", entity.BattleEnergyCost-1, time.Now().UTC())
+	uc, battleRepo, _, _, unitOfWork := newAcceptFixtureWithEnergy(challenge, energyRepo)
+
+	// The challenger spent its energy since it sent the challenge
+	_, err := uc.Execute(context.Background(), usecase.BattleChallengeInput{ChallengeID: "challenge-1", UserID: "user-456"})
+	if !errors.Is(err, usecase.ErrNotEnoughEnergy) {
+		t.Fatalf("Execute() error = %v, want %v", err, usecase.ErrNotEnoughEnergy)
+	}
+
+	// Nothing is saved, so the challenge can still be accepted once the challenger rested
+	if len(battleRepo.battles) != 0 || unitOfWork.rollbacks != 1 {
+		t.Errorf("battles = %d, rollbacks = %d, want no battle and a rollback", len(battleRepo.battles), unitOfWork.rollbacks)
+	}
+
+}
+
+func TestAcceptBattleChallengeUseCase_Execute_RankedUpdatesBothRatings(t *testing.T) {
+	challenge, err := entity.NewBattleChallenge("challenge-1", "char-123", "char-456", true, time.Now().UTC().Add(-time.Hour), 24*time.Hour)
+	if err != nil {
+		t.Fatalf("NewBattleChallenge() error = %v, want nil", err)
+	}
+	uc, _, _, ratingRepo, unitOfWork := newAcceptFixture(challenge)
+
+	// char-456 already has a rating; char-123 fights its first ranked battle
+	stored, _ := valueobject.NewGlickoRating(1600, 80, 0.06)
+	lastPlayedAt := time.Now().UTC().Add(-48 * time.Hour)
+	ratingRepo.ratings["char-456"] = entity.ReconstituteCharacterRating("char-456", stored, 3, 1, 0, &lastPlayedAt)
+
+	output, err := uc.Execute(context.Background(), usecase.BattleChallengeInput{ChallengeID: "challenge-1", UserID: "user-456"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if len(output.RatingChanges) != 2 {
+		t.Fatalf("len(RatingChanges) = %d, want 2", len(output.RatingChanges))
+	}
+
+	winner, loser := output.RatingChanges[0], output.RatingChanges[1]
+	if winner.CharacterID != "char-123" || winner.Result != usecase.RatingResultWin || winner.RatingBefore != 1500 || winner.Delta <= 0 {
+		t.Errorf("challenger change = %+v, want a win from 1500", winner)
+	}
+	if loser.CharacterID != "char-456" || loser.Result != usecase.RatingResultLoss || loser.RatingBefore != 1600 || loser.Delta >= 0 {
+		t.Errorf("opponent change = %+v, want a loss from 1600", loser)
+	}
+	if winner.BattleID != output.BattleID || loser.OpponentCharacterID != "char-123" {
+		t.Errorf("changes = %+v, want them tied to battle %s", output.RatingChanges, output.BattleID)
+	}
+
+	// Two days of inactivity grew the opponent's deviation before the battle
+	if change := ratingRepo.changes[len(ratingRepo.changes)-1]; change.Before().Deviation() <= stored.Deviation() {
+		t.Errorf("deviation before the battle = %v, want more than the stored %v", change.Before().Deviation(), stored.Deviation())
+	}
+
+	// Both ratings and their history were saved in the unit of work, locked in a fixed order
+	if got := ratingRepo.ratings["char-123"]; got == nil || got.Wins() != 1 || int(math.Round(got.Rating().Rating())) != winner.RatingAfter {
+		t.Errorf("saved challenger rating = %+v, want one win at %d", got, winner.RatingAfter)
+	}
+	if got := ratingRepo.ratings["char-456"]; got.Losses() != 2 || got.Games() != 5 {
+		t.Errorf("saved opponent rating has %d losses in %d games, want 2 in 5", got.Losses(), got.Games())
+	}
+	if len(ratingRepo.changes) != 2 {
+		t.Errorf("saved history entries = %d, want 2", len(ratingRepo.changes))
+	}
+	// char-123 had no rating yet: its initial rating is stored, then locked
+	if want := []string{"char-123", "char-123", "char-456"}; !slices.Equal(ratingRepo.locked, want) {
+		t.Errorf("locked ratings = %v, want %v", ratingRepo.locked, want)
+	}
+	if unitOfWork.commits != 1 {
+		t.Errorf("commits = %d, want 1", unitOfWork.commits)
+	}
+}
+
+func TestAcceptBattleChallengeUseCase_Execute_RollsBackWhenRatingFails(t *testing.T) {
+	challenge, err := entity.NewBattleChallenge("challenge-1", "char-123", "char-456", true, time.Now().UTC().Add(-time.Hour), 24*time.Hour)
+	if err != nil {
+		t.Fatalf("NewBattleChallenge() error = %v, want nil", err)
+	}
+	uc, _, _, ratingRepo, unitOfWork := newAcceptFixture(challenge)
+	ratingRepo.saveErr = errors.New("database unavailable")
+
+	if _, err := uc.Execute(context.Background(), usecase.BattleChallengeInput{ChallengeID: "challenge-1", UserID: "user-456"}); err == nil {
+		t.Fatal("Execute() error = nil, want error")
+	}
+
+	if unitOfWork.rollbacks != 1 || unitOfWork.commits != 0 {
+		t.Errorf("commits/rollbacks = %d/%d, want 0/1", unitOfWork.commits, unitOfWork.rollbacks)
+	}
+}
+
+func TestDeclineBattleChallengeUseCase_Execute(t *testing.T) {
+	challengeRepo := newMockBattleChallengeRepository(newPendingChallenge(t, time.Hour, 24*time.Hour))
+	uc := usecase.NewDeclineBattleChallengeUseCase(newDuelistRepository(), challengeRepo)
+
+	// Only the opponent can answer
+	_, err := uc.Execute(context.Background(), usecase.BattleChallengeInput{ChallengeID: "challenge-1", UserID: "user-123"})
+	if !errors.Is(err, usecase.ErrBattleChallengeNotFound) {
+		t.Errorf("Execute() by challenger error = %v, want %v", err, usecase.ErrBattleChallengeNotFound)
+	}
+
+	output, err := uc.Execute(context.Background(), usecase.BattleChallengeInput{ChallengeID: "challenge-1", UserID: "user-456"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.Status != entity.BattleChallengeDeclined || output.BattleID != "" || output.RespondedAt == "" {
+		t.Errorf("output = %+v, want declined without battle", output)
+	}
+
+	_, err = uc.Execute(context.Background(), usecase.BattleChallengeInput{ChallengeID: "challenge-1", UserID: "user-456"})
+	if !errors.Is(err, usecase.ErrBattleChallengeAnswered) {
+		t.Errorf("Execute() twice error = %v, want %v", err, usecase.ErrBattleChallengeAnswered)
+	}
+}
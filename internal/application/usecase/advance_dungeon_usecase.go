@@ -0,0 +1,217 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igor/chronotask-api/internal/application/port"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// AdvanceDungeonOutput represents the battle of one dungeon stage and the run after it
+type AdvanceDungeonOutput struct {
+	Run          DungeonRunOutput
+	BattleID     string
+	Seed         int64
+	Challenger   BattleCombatantOutput // HP is what the character carried into the stage
+	Opponent     BattleCombatantOutput
+	Winner       string // challenger or opponent, empty on a draw
+	Victory      bool
+	Rounds       int
+	ChallengerHP int
+	OpponentHP   int
+	Actions      []BattleActionOutput
+	Retreated    bool // The defeat sent the run back to its checkpoint
+	Energy       int  // Energy the character has left after the battle
+
+	// Final reward, once the last stage is cleared
+	XpAwarded    int
+	LevelsGained int
+	Loot         []LootDropOutput
+}
+
+// AdvanceDungeonUseCase handles fighting the next stage of a dungeon run
+type AdvanceDungeonUseCase struct {
+	characterRepo          repository.CharacterRepository
+	characterAttributeRepo repository.CharacterAttributeRepository
+	monsterRepo            repository.MonsterRepository
+	battleRepo             repository.BattleRepository
+	dungeonRepo            repository.DungeonRepository
+	dungeonRunRepo         repository.DungeonRunRepository
+	characterEnergyRepo    repository.CharacterEnergyRepository
+	unitOfWork             port.UnitOfWork
+}
+
+// NewAdvanceDungeonUseCase creates a new AdvanceDungeonUseCase
+func NewAdvanceDungeonUseCase(
+	characterRepo repository.CharacterRepository,
+	characterAttributeRepo repository.CharacterAttributeRepository,
+	monsterRepo repository.MonsterRepository,
+	battleRepo repository.BattleRepository,
+	dungeonRepo repository.DungeonRepository,
+	dungeonRunRepo repository.DungeonRunRepository,
+	characterEnergyRepo repository.CharacterEnergyRepository,
+	unitOfWork port.UnitOfWork,
+) *AdvanceDungeonUseCase {
+	return &AdvanceDungeonUseCase{
+		characterRepo:          characterRepo,
+		characterAttributeRepo: characterAttributeRepo,
+		monsterRepo:            monsterRepo,
+		battleRepo:             battleRepo,
+		dungeonRepo:            dungeonRepo,
+		dungeonRunRepo:         dungeonRunRepo,
+		characterEnergyRepo:    characterEnergyRepo,
+		unitOfWork:             unitOfWork,
+	}
+}
+
+// Execute fights the next stage with the HP the character carries and saves the run
+// Clearing the last stage awards the dungeon's XP and loot
+// Each stage is a battle that costs the character energy (ErrNotEnoughEnergy when it doesn't have enough)
+func (uc *AdvanceDungeonUseCase) Execute(ctx context.Context, input DungeonRunInput) (*AdvanceDungeonOutput, error) {
+	// 1. Validate character exists AND belongs to the authenticated user
+	character, err := uc.characterRepo.FindByIDAndUserID(ctx, input.CharacterID, input.UserID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+
+	dungeon, err := uc.dungeonRepo.FindByID(ctx, input.DungeonID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrDungeonNotFound, input.DungeonID)
+	}
+
+	challenger, err := characterCombatant(ctx, uc.characterAttributeRepo, character)
+	if err != nil {
+		return nil, err
+	}
+	maxHP := challenger.Stats().HP()
+
+	// 2. Fight the stage with the run locked, so concurrent requests can't fight it twice
+	var output *AdvanceDungeonOutput
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		run, err := uc.dungeonRunRepo.FindInProgressByCharacterIDForUpdate(ctx, character.ID())
+		if err != nil {
+			return fmt.Errorf("failed to fetch dungeon run: %w", err)
+		}
+		if run == nil || run.DungeonID() != dungeon.ID() {
+			return fmt.Errorf("%w: %s", ErrDungeonRunNotFound, dungeon.ID())
+		}
+
+		// The character is locked after its run and before its energy, as clearing the dungeon rewards it
+		character, err = uc.characterRepo.FindByIDForUpdate(ctx, character.ID())
+		if err != nil {
+			return ErrCharacterNotFound
+		}
+
+		stage, ok := dungeon.Stage(run.Stage())
+		if !ok {
+			return fmt.Errorf("dungeon %s has no stage %d", dungeon.ID(), run.Stage()+1)
+		}
+		monster, err := uc.monsterRepo.FindByID(ctx, stage.MonsterID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch stage monster %s: %w", stage.MonsterID, err)
+		}
+		opponent, err := monster.Combatant(character.Level())
+		if err != nil {
+			return fmt.Errorf("failed to prepare monster for battle: %w", err)
+		}
+
+		now := time.Now().UTC()
+		energyLeft, err := spendBattleEnergy(ctx, uc.characterEnergyRepo, now, character.ID())
+		if err != nil {
+			return err
+		}
+
+		battle, err := entity.NewBattle(uuid.New().String(), challenger.Wounded(run.HP()), opponent, rand.Int64N(maxBattleSeed), now)
+		if err != nil {
+			return fmt.Errorf("failed to create battle: %w", err)
+		}
+		if err := uc.battleRepo.Create(ctx, battle); err != nil {
+			return fmt.Errorf("failed to save battle: %w", err)
+		}
+
+		output = &AdvanceDungeonOutput{
+			BattleID:     battle.ID(),
+			Seed:         battle.Seed(),
+			Challenger:   mapCombatantToOutput(battle.Challenger()),
+			Opponent:     mapCombatantToOutput(battle.Opponent()),
+			Winner:       battle.Winner(),
+			Victory:      battle.Winner() == entity.BattleSideChallenger,
+			Rounds:       battle.Rounds(),
+			ChallengerHP: battle.ChallengerHP(),
+			OpponentHP:   battle.OpponentHP(),
+			Actions:      mapBattleActionsToOutput(battle.Actions()),
+			Energy:       energyLeft[character.ID()],
+		}
+
+		// 3. Move the run forward (or back to its checkpoint)
+		if output.Victory {
+			if err := run.RecordVictory(dungeon, battle.ChallengerHP(), maxHP, now); err != nil {
+				return fmt.Errorf("failed to record stage: %w", err)
+			}
+		} else {
+			output.Retreated, err = run.RecordDefeat(dungeon, maxHP, now)
+			if err != nil {
+				return fmt.Errorf("failed to record stage: %w", err)
+			}
+		}
+
+		// 4. Clearing the dungeon is rewarded with its XP (recorded in the ledger) and loot
+		if run.Status() == entity.DungeonRunCompleted {
+			if err := uc.reward(ctx, character, dungeon, run, battle, output); err != nil {
+				return err
+			}
+		}
+
+		if err := uc.dungeonRunRepo.Update(ctx, run); err != nil {
+			return fmt.Errorf("failed to save dungeon run: %w", err)
+		}
+
+		output.Run = mapDungeonRunToOutput(run, dungeon, maxHP)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// reward awards the final reward of a completed run to the character and saves it
+func (uc *AdvanceDungeonUseCase) reward(
+	ctx context.Context,
+	character *entity.Character,
+	dungeon *entity.Dungeon,
+	run *entity.DungeonRun,
+	battle *entity.Battle,
+	output *AdvanceDungeonOutput,
+) error {
+	output.Loot = mapLootDropsToOutput(dungeon.RollRewardLoot(battle.Seed()))
+
+	if dungeon.RewardXp() == 0 {
+		return nil
+	}
+
+	source, err := valueobject.NewXpSource(valueobject.XpSourceDungeonCompletion, run.ID())
+	if err != nil {
+		return fmt.Errorf("failed to create xp source: %w", err)
+	}
+
+	levelsGained, err := character.AddXpFrom(dungeon.RewardXp(), source)
+	if err != nil {
+		return fmt.Errorf("failed to add xp: %w", err)
+	}
+
+	if err := uc.characterRepo.Update(ctx, character); err != nil {
+		return fmt.Errorf("failed to save character: %w", err)
+	}
+
+	output.XpAwarded = dungeon.RewardXp()
+	output.LevelsGained = levelsGained
+	return nil
+}
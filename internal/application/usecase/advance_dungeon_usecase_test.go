@@ -0,0 +1,170 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+func TestAdvanceDungeonUseCase_Execute_ClearsDungeon(t *testing.T) {
+	fixture := newDungeonFixture(t, 10, 3, 50)
+	ctx := context.Background()
+
+	start, err := fixture.start.Execute(ctx, dungeonInput("toca-dos-ratos"))
+	if err != nil {
+		t.Fatalf("Start Execute() error = %v, want nil", err)
+	}
+	maxHP := start.Run.MaxHP
+
+	// Stage 1: the character enters at full HP and carries the HP left into stage 2
+	first, err := fixture.advance.Execute(ctx, dungeonInput("toca-dos-ratos"))
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if !first.Victory || first.Challenger.HP != maxHP || first.Run.StagesCleared != 1 || first.Run.HP != first.ChallengerHP {
+		t.Fatalf("first = %+v, want stage 1 cleared carrying %d HP", first.Run, first.ChallengerHP)
+	}
+
+	// Stage 2 is a checkpoint: clearing it rests the character to full HP
+	second, err := fixture.advance.Execute(ctx, dungeonInput("toca-dos-ratos"))
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if second.Challenger.HP != first.Run.HP {
+		t.Errorf("stage 2 Challenger.HP = %d, want the %d HP carried from stage 1", second.Challenger.HP, first.Run.HP)
+	}
+	if second.Run.StagesCleared != 2 || second.Run.Checkpoint != 2 || second.Run.HP != maxHP {
+		t.Errorf("second = %+v, want a checkpoint at stage 2 and full HP", second.Run)
+	}
+	if second.XpAwarded != 0 || second.Loot != nil {
+		t.Error("the reward should only be awarded once the dungeon is cleared")
+	}
+
+	// Stage 3 clears the dungeon and awards its XP and loot
+	third, err := fixture.advance.Execute(ctx, dungeonInput("toca-dos-ratos"))
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if third.Run.Status != entity.DungeonRunCompleted || third.Run.EndedAt == "" || third.Run.NextMonsterID != "" {
+		t.Errorf("third = %+v, want a completed run", third.Run)
+	}
+	if third.XpAwarded != 80 || len(third.Loot) != 1 || third.Loot[0].Item != "Moeda de Prata" {
+		t.Errorf("reward = %d XP and %+v, want 80 XP and a silver coin", third.XpAwarded, third.Loot)
+	}
+
+	// The entry cost and the reward are both in the ledger
+	pending := fixture.character.PendingXpTransactions()
+	if len(pending) != 2 || pending[1].Amount() != 80 || pending[1].Source().Type() != valueobject.XpSourceDungeonCompletion || pending[1].Source().ID() != start.Run.ID {
+		t.Errorf("pending xp transactions = %+v, want the entry cost and the completion reward", pending)
+	}
+	if len(fixture.battleRepo.battles) != 3 || fixture.runRepo.locked != 3 {
+		t.Errorf("battles = %d, locks = %d, want 3 stages fought with the run locked", len(fixture.battleRepo.battles), fixture.runRepo.locked)
+	}
+
+	// The run is over
+	_, err = fixture.advance.Execute(ctx, dungeonInput("toca-dos-ratos"))
+	if !errors.Is(err, usecase.ErrDungeonRunNotFound) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrDungeonRunNotFound)
+	}
+}
+
+func TestAdvanceDungeonUseCase_Execute_DefeatGoesBackToCheckpoint(t *testing.T) {
+	fixture := newDungeonFixture(t, 10, 3, 0)
+	ctx := context.Background()
+
+	if _, err := fixture.start.Execute(ctx, dungeonInput("covil-do-ogro")); err != nil {
+		t.Fatalf("Start Execute() error = %v, want nil", err)
+	}
+	if _, err := fixture.advance.Execute(ctx, dungeonInput("covil-do-ogro")); err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	// The first defeat against the ogre spends the checkpoint
+	retreat, err := fixture.advance.Execute(ctx, dungeonInput("covil-do-ogro"))
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if retreat.Victory || !retreat.Retreated {
+		t.Fatalf("retreat = %+v, want a defeat sending the run back", retreat)
+	}
+	if run := retreat.Run; run.Status != entity.DungeonRunInProgress || run.StagesCleared != 1 || run.Checkpoint != 0 || run.HP != run.MaxHP || run.NextMonsterID != "ogro" {
+		t.Errorf("run = %+v, want the run back at the ogre at full HP without a checkpoint", run)
+	}
+
+	// The second one ends the run
+	failed, err := fixture.advance.Execute(ctx, dungeonInput("covil-do-ogro"))
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if failed.Retreated || failed.Run.Status != entity.DungeonRunFailed || failed.XpAwarded != 0 {
+		t.Errorf("failed = %+v, want a failed run without reward", failed.Run)
+	}
+
+	_, err = fixture.advance.Execute(ctx, dungeonInput("covil-do-ogro"))
+	if !errors.Is(err, usecase.ErrDungeonRunNotFound) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrDungeonRunNotFound)
+	}
+}
+
+func TestAdvanceDungeonUseCase_Execute_WithoutRun(t *testing.T) {
+	fixture := newDungeonFixture(t, 10, 3, 50)
+	ctx := context.Background()
+
+	_, err := fixture.advance.Execute(ctx, dungeonInput("toca-dos-ratos"))
+	if !errors.Is(err, usecase.ErrDungeonRunNotFound) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrDungeonRunNotFound)
+	}
+
+	// A run in another dungeon doesn't count
+	if _, err := fixture.start.Execute(ctx, dungeonInput("covil-do-ogro")); err != nil {
+		t.Fatalf("Start Execute() error = %v, want nil", err)
+	}
+	_, err = fixture.advance.Execute(ctx, dungeonInput("toca-dos-ratos"))
+	if !errors.Is(err, usecase.ErrDungeonRunNotFound) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrDungeonRunNotFound)
+	}
+	if len(fixture.battleRepo.battles) != 0 || fixture.unitOfWork.rollbacks != 2 {
+		t.Error("no battle should be fought without a run")
+	}
+}
+
+func TestAdvanceDungeonUseCase_Execute_EachStageCostsEnergy(t *testing.T) {
+	fixture := newDungeonFixture(t, 10, 3, 50)
+	ctx := context.Background()
+
+	if _, err := fixture.start.Execute(ctx, dungeonInput("toca-dos-ratos")); err != nil {
+		t.Fatalf("Start Execute() error = %v, want nil", err)
+	}
+
+	first, err := fixture.advance.Execute(ctx, dungeonInput("toca-dos-ratos"))
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if first.Energy != entity.MaxEnergy-entity.BattleEnergyCost {
+		t.Errorf("first.Energy = %d, want %d", first.Energy, entity.MaxEnergy-entity.BattleEnergyCost)
+	}
+
+	// Without the energy for the next stage the run waits where it is
+	fixture.energyRepo.setEnergy("char-123", entity.BattleEnergyCost-1, time.Now().UTC())
+	_, err = fixture.advance.Execute(ctx, dungeonInput("toca-dos-ratos"))
+	if !errors.Is(err, usecase.ErrNotEnoughEnergy) {
+		t.Fatalf("Execute() error = %v, want %v", err, usecase.ErrNotEnoughEnergy)
+	}
+	if len(fixture.battleRepo.battles) != 1 {
+		t.Errorf("battles = %d, want only the first stage fought", len(fixture.battleRepo.battles))
+	}
+
+	fixture.energyRepo.setEnergy("char-123", entity.BattleEnergyCost, time.Now().UTC())
+	second, err := fixture.advance.Execute(ctx, dungeonInput("toca-dos-ratos"))
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if second.Run.StagesCleared != 2 || second.Energy != 0 {
+		t.Errorf("second = %d stages cleared with %d energy, want 2 with 0", second.Run.StagesCleared, second.Energy)
+	}
+}
@@ -0,0 +1,148 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/igor/chronotask-api/internal/application/port"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+var (
+	// ErrInvalidAllocation is returned when an allocation is empty or assigns a non-positive number of points
+	ErrInvalidAllocation = errors.New("invalid attribute point allocation")
+
+	// ErrNotEnoughAttributePoints is returned when an allocation spends more points than the character has
+	ErrNotEnoughAttributePoints = errors.New("not enough unspent attribute points")
+)
+
+// AllocateAttributePointsInput represents the input for distributing unspent attribute points
+type AllocateAttributePointsInput struct {
+	CharacterID string
+	UserID      string         // User ID from authentication token
+	Points      map[string]int // Points to add, by attribute name
+}
+
+// AllocateAttributePointsOutput represents the character's attributes after the allocation
+type AllocateAttributePointsOutput struct {
+	CharacterID            string
+	PointsSpent            int
+	UnspentAttributePoints int
+	Attributes             []CharacterAttributeOutput
+}
+
+// AllocateAttributePointsUseCase handles distributing the attribute points received on level-up
+type AllocateAttributePointsUseCase struct {
+	characterRepo          repository.CharacterRepository
+	characterAttributeRepo repository.CharacterAttributeRepository
+	unitOfWork             port.UnitOfWork
+}
+
+// NewAllocateAttributePointsUseCase creates a new AllocateAttributePointsUseCase
+func NewAllocateAttributePointsUseCase(
+	characterRepo repository.CharacterRepository,
+	characterAttributeRepo repository.CharacterAttributeRepository,
+	unitOfWork port.UnitOfWork,
+) *AllocateAttributePointsUseCase {
+	return &AllocateAttributePointsUseCase{
+		characterRepo:          characterRepo,
+		characterAttributeRepo: characterAttributeRepo,
+		unitOfWork:             unitOfWork,
+	}
+}
+
+// Execute adds the requested points to the character's attributes, all or none
+func (uc *AllocateAttributePointsUseCase) Execute(ctx context.Context, input AllocateAttributePointsInput) (*AllocateAttributePointsOutput, error) {
+	if len(input.Points) == 0 {
+		return nil, fmt.Errorf("%w: at least one attribute is required", ErrInvalidAllocation)
+	}
+
+	pointsSpent := 0
+	for name, points := range input.Points {
+		if points <= 0 {
+			return nil, fmt.Errorf("%w: points for '%s' must be positive", ErrInvalidAllocation, name)
+		}
+		pointsSpent += points
+	}
+
+	// 1. Validate character exists AND belongs to the authenticated user
+	owned, err := uc.characterRepo.FindByIDAndUserID(ctx, input.CharacterID, input.UserID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+
+	// 2. Spend the points and grow the attributes atomically
+	// The character and its attributes are locked, so concurrent level-ups and allocations can't be lost
+	var (
+		character  *entity.Character
+		attributes []*entity.CharacterAttribute
+	)
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		character, err = uc.characterRepo.FindByIDForUpdate(ctx, owned.ID())
+		if err != nil {
+			return ErrCharacterNotFound
+		}
+
+		// Every attribute must belong to the character's own attribute set
+		attributes, err = uc.characterAttributeRepo.FindByCharacterIDForUpdate(ctx, character.ID())
+		if err != nil {
+			return fmt.Errorf("failed to fetch character attributes: %w", err)
+		}
+
+		attributesByName := make(map[string]*entity.CharacterAttribute, len(attributes))
+		for _, attribute := range attributes {
+			attributesByName[attribute.AttributeName()] = attribute
+		}
+
+		// Sorted names keep the order of the writes (and of the error reported) deterministic
+		names := make([]string, 0, len(input.Points))
+		for name := range input.Points {
+			if _, ok := attributesByName[name]; !ok {
+				return fmt.Errorf("%w: %s", ErrAttributeNotFound, name)
+			}
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		// Domain rules guard the balance, checked again on the locked character
+		if pointsSpent > character.UnspentAttributePoints() {
+			return fmt.Errorf("%w: %d requested, %d available", ErrNotEnoughAttributePoints, pointsSpent, character.UnspentAttributePoints())
+		}
+		if err := character.SpendAttributePoints(pointsSpent); err != nil {
+			return fmt.Errorf("failed to spend attribute points: %w", err)
+		}
+
+		// 3. Persist every increment together with the spent points
+		for _, name := range names {
+			attribute := attributesByName[name]
+			if err := attribute.IncrementValue(input.Points[name]); err != nil {
+				return fmt.Errorf("failed to increment attribute '%s': %w", name, err)
+			}
+			if err := uc.characterAttributeRepo.Update(ctx, attribute); err != nil {
+				return fmt.Errorf("failed to save attribute '%s': %w", name, err)
+			}
+		}
+		if err := uc.characterRepo.Update(ctx, character); err != nil {
+			return fmt.Errorf("failed to save character: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	attributeOutputs := make([]CharacterAttributeOutput, len(attributes))
+	for i, attribute := range attributes {
+		attributeOutputs[i] = mapEntityToOutput(attribute, character)
+	}
+
+	return &AllocateAttributePointsOutput{
+		CharacterID:            character.ID(),
+		PointsSpent:            pointsSpent,
+		UnspentAttributePoints: character.UnspentAttributePoints(),
+		Attributes:             attributeOutputs,
+	}, nil
+}
@@ -0,0 +1,173 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// allocationFixture wires a level 3 character (char-123, owned by user-123) with 6 unspent points
+type allocationFixture struct {
+	character  *entity.Character
+	attributes map[string]*entity.CharacterAttribute
+	saved      map[string]int // Attribute values written, by name
+	unitOfWork *mockUnitOfWork
+	useCase    *usecase.AllocateAttributePointsUseCase
+}
+
+func newAllocationFixture(failUpdateOf string) *allocationFixture {
+	f := &allocationFixture{
+		character: entity.ReconstituteCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), 3, 10, 393, 6, "user-123", time.Now()),
+		attributes: map[string]*entity.CharacterAttribute{
+			"Força":    entity.ReconstituteCharacterAttribute(1, "Força", 5, "char-123", time.Now()),
+			"Destreza": entity.ReconstituteCharacterAttribute(2, "Destreza", 5, "char-123", time.Now()),
+		},
+		saved:      map[string]int{},
+		unitOfWork: &mockUnitOfWork{},
+	}
+
+	charRepo := &mockCharacterRepositoryForHabits{
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Character, error) {
+			if id == "char-123" && userID == "user-123" {
+				return f.character, nil
+			}
+			return nil, errors.New("character not found or does not belong to user")
+		},
+		findByIDFunc: func(ctx context.Context, id string) (*entity.Character, error) {
+			return f.character, nil
+		},
+	}
+
+	attrRepo := &mockCharacterAttributeRepository{
+		findByCharacterIDFunc: func(ctx context.Context, characterID string) ([]*entity.CharacterAttribute, error) {
+			return []*entity.CharacterAttribute{f.attributes["Força"], f.attributes["Destreza"]}, nil
+		},
+		updateFunc: func(ctx context.Context, attribute *entity.CharacterAttribute) error {
+			if !inUnitOfWork(ctx) {
+				return errors.New("attribute saved outside the unit of work")
+			}
+			if attribute.AttributeName() == failUpdateOf {
+				return errors.New("connection reset")
+			}
+			f.saved[attribute.AttributeName()] = attribute.Value()
+			return nil
+		},
+	}
+
+	f.useCase = usecase.NewAllocateAttributePointsUseCase(charRepo, attrRepo, f.unitOfWork)
+	return f
+}
+
+func TestAllocateAttributePointsUseCase_Execute_Success(t *testing.T) {
+	f := newAllocationFixture("")
+
+	output, err := f.useCase.Execute(context.Background(), usecase.AllocateAttributePointsInput{
+		CharacterID: "char-123",
+		UserID:      "user-123",
+		Points:      map[string]int{"Força": 2, "Destreza": 1},
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.PointsSpent != 3 || output.UnspentAttributePoints != 3 {
+		t.Errorf("spent/unspent = %d/%d, want 3/3", output.PointsSpent, output.UnspentAttributePoints)
+	}
+
+	if f.saved["Força"] != 7 || f.saved["Destreza"] != 6 {
+		t.Errorf("saved attributes = %v, want Força 7 and Destreza 6", f.saved)
+	}
+
+	if f.unitOfWork.commits != 1 {
+		t.Errorf("unit of work commits = %d, want 1", f.unitOfWork.commits)
+	}
+}
+
+func TestAllocateAttributePointsUseCase_Execute_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		userID  string
+		points  map[string]int
+		wantErr error
+	}{
+		{"another user's character", "user-456", map[string]int{"Força": 1}, usecase.ErrCharacterNotFound},
+		{"empty allocation", "user-123", map[string]int{}, usecase.ErrInvalidAllocation},
+		{"non-positive points", "user-123", map[string]int{"Força": 2, "Destreza": -1}, usecase.ErrInvalidAllocation},
+		{"attribute outside the character's set", "user-123", map[string]int{"Sorte": 1}, usecase.ErrAttributeNotFound},
+		{"more points than unspent", "user-123", map[string]int{"Força": 4, "Destreza": 3}, usecase.ErrNotEnoughAttributePoints},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newAllocationFixture("")
+
+			_, err := f.useCase.Execute(context.Background(), usecase.AllocateAttributePointsInput{
+				CharacterID: "char-123",
+				UserID:      tt.userID,
+				Points:      tt.points,
+			})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Execute() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if len(f.saved) != 0 || f.character.UnspentAttributePoints() != 6 {
+				t.Errorf("rejected allocation changed state: saved = %v, unspent = %d", f.saved, f.character.UnspentAttributePoints())
+			}
+		})
+	}
+}
+
+func TestAllocateAttributePointsUseCase_Execute_FailedWriteRollsBack(t *testing.T) {
+	f := newAllocationFixture("Força")
+
+	_, err := f.useCase.Execute(context.Background(), usecase.AllocateAttributePointsInput{
+		CharacterID: "char-123",
+		UserID:      "user-123",
+		Points:      map[string]int{"Força": 2, "Destreza": 1},
+	})
+	if err == nil {
+		t.Fatal("Execute() error = nil, want error for failed attribute write")
+	}
+
+	if f.unitOfWork.rollbacks != 1 || f.unitOfWork.commits != 0 {
+		t.Errorf("unit of work commits = %d, rollbacks = %d, want 0 and 1", f.unitOfWork.commits, f.unitOfWork.rollbacks)
+	}
+}
+
+func TestAllocateAttributePointsUseCase_Execute_RechecksLockedBalance(t *testing.T) {
+	f := newAllocationFixture("")
+	// A concurrent allocation spent 5 points after the ownership check read the character
+	locked := entity.ReconstituteCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), 3, 10, 393, 1, "user-123", time.Now())
+	charRepo := &mockCharacterRepositoryForHabits{
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Character, error) {
+			return f.character, nil
+		},
+		findByIDFunc: func(ctx context.Context, id string) (*entity.Character, error) {
+			return locked, nil
+		},
+	}
+	attrRepo := &mockCharacterAttributeRepository{
+		findByCharacterIDFunc: func(ctx context.Context, characterID string) ([]*entity.CharacterAttribute, error) {
+			return []*entity.CharacterAttribute{f.attributes["Força"], f.attributes["Destreza"]}, nil
+		},
+	}
+	useCase := usecase.NewAllocateAttributePointsUseCase(charRepo, attrRepo, f.unitOfWork)
+
+	_, err := useCase.Execute(context.Background(), usecase.AllocateAttributePointsInput{
+		CharacterID: "char-123",
+		UserID:      "user-123",
+		Points:      map[string]int{"Força": 2},
+	})
+	if !errors.Is(err, usecase.ErrNotEnoughAttributePoints) {
+		t.Fatalf("Execute() error = %v, want %v", err, usecase.ErrNotEnoughAttributePoints)
+	}
+
+	if len(charRepo.locked) != 1 || charRepo.locked[0] != "char-123" {
+		t.Errorf("locked characters = %v, want [char-123]", charRepo.locked)
+	}
+}
@@ -0,0 +1,124 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+// ErrNotEnoughEnergy is returned when a character doesn't have the energy to fight a battle
+var ErrNotEnoughEnergy = errors.New("not enough energy")
+
+// currentCharacterEnergy retrieves the energy of a character without locking it
+// A character that never spent energy has a full pool
+func currentCharacterEnergy(
+	ctx context.Context,
+	characterEnergyRepo repository.CharacterEnergyRepository,
+	characterID string,
+	at time.Time,
+) (*entity.CharacterEnergy, error) {
+	energy, err := characterEnergyRepo.FindByCharacterID(ctx, characterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch character energy: %w", err)
+	}
+	if energy != nil {
+		return energy, nil
+	}
+
+	energy, err = entity.NewCharacterEnergy(characterID, at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create character energy: %w", err)
+	}
+	return energy, nil
+}
+
+// lockCharacterEnergy retrieves the energy of a character and locks it until the unit of work ends
+// The full pool of a character that never spent energy is stored first, so there is always a row to lock
+// and concurrent first spends can't overwrite each other
+func lockCharacterEnergy(
+	ctx context.Context,
+	characterEnergyRepo repository.CharacterEnergyRepository,
+	characterID string,
+	at time.Time,
+) (*entity.CharacterEnergy, error) {
+	energy, err := characterEnergyRepo.FindByCharacterIDForUpdate(ctx, characterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch character energy: %w", err)
+	}
+	if energy != nil {
+		return energy, nil
+	}
+
+	energy, err = entity.NewCharacterEnergy(characterID, at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create character energy: %w", err)
+	}
+	if err := characterEnergyRepo.Create(ctx, energy); err != nil {
+		return nil, fmt.Errorf("failed to save character energy: %w", err)
+	}
+
+	energy, err = characterEnergyRepo.FindByCharacterIDForUpdate(ctx, characterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch character energy: %w", err)
+	}
+	if energy == nil {
+		return nil, fmt.Errorf("character energy of %s was not saved", characterID)
+	}
+	return energy, nil
+}
+
+// checkBattleEnergy returns ErrNotEnoughEnergy when the energy can't pay for a battle at the given time
+func checkBattleEnergy(energy *entity.CharacterEnergy, at time.Time) error {
+	current := energy.CurrentAt(at)
+	if current >= entity.BattleEnergyCost {
+		return nil
+	}
+
+	missing := entity.BattleEnergyCost - current
+	readyAt := energy.NextPointAt(at).Add(time.Duration(missing-1) * entity.EnergyRegenInterval)
+	return fmt.Errorf("%w: character %s has %d of the %d energy a battle costs, enough again at %s",
+		ErrNotEnoughEnergy, energy.CharacterID(), current, entity.BattleEnergyCost, readyAt.Format("2006-01-02T15:04:05Z07:00"))
+}
+
+// spendBattleEnergy makes each character pay for a battle and saves their energy (inside the unit of work)
+// The pools are locked in a fixed order, so concurrent battles can't deadlock or spend the same energy twice
+// Returns the energy each character has left
+func spendBattleEnergy(
+	ctx context.Context,
+	characterEnergyRepo repository.CharacterEnergyRepository,
+	at time.Time,
+	characterIDs ...string,
+) (map[string]int, error) {
+	locked := slices.Clone(characterIDs)
+	slices.Sort(locked)
+
+	energies := make([]*entity.CharacterEnergy, 0, len(locked))
+	for _, characterID := range locked {
+		energy, err := lockCharacterEnergy(ctx, characterEnergyRepo, characterID, at)
+		if err != nil {
+			return nil, err
+		}
+		if err := checkBattleEnergy(energy, at); err != nil {
+			return nil, err
+		}
+		energies = append(energies, energy)
+	}
+
+	left := make(map[string]int, len(energies))
+	for _, energy := range energies {
+		if err := energy.Spend(entity.BattleEnergyCost, at); err != nil {
+			return nil, fmt.Errorf("failed to spend energy: %w", err)
+		}
+		if err := characterEnergyRepo.Update(ctx, energy); err != nil {
+			return nil, fmt.Errorf("failed to save character energy: %w", err)
+		}
+		left[energy.CharacterID()] = energy.CurrentAt(at)
+	}
+
+	return left, nil
+}
@@ -0,0 +1,55 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+// CheckTaskItemInput represents the input for checking (or unchecking) a task checklist item
+type CheckTaskItemInput struct {
+	TaskID string
+	ItemID string
+	UserID string // User ID from authentication token
+	Done   bool
+}
+
+// CheckTaskItemUseCase handles updating the checklist of a task
+type CheckTaskItemUseCase struct {
+	taskRepo        repository.TaskRepository
+	preferencesRepo repository.UserPreferencesRepository
+}
+
+// NewCheckTaskItemUseCase creates a new CheckTaskItemUseCase
+func NewCheckTaskItemUseCase(taskRepo repository.TaskRepository, preferencesRepo repository.UserPreferencesRepository) *CheckTaskItemUseCase {
+	return &CheckTaskItemUseCase{
+		taskRepo:        taskRepo,
+		preferencesRepo: preferencesRepo,
+	}
+}
+
+// Execute marks a checklist item of a task owned by the user as done (or not done)
+// Checklist items don't award XP: only completing the task does
+func (uc *CheckTaskItemUseCase) Execute(ctx context.Context, input CheckTaskItemInput) (*TaskOutput, error) {
+	task, err := uc.taskRepo.FindByIDAndUserID(ctx, input.TaskID, input.UserID)
+	if err != nil {
+		return nil, ErrTaskNotFound
+	}
+
+	if err := task.CheckItem(input.ItemID, input.Done); err != nil {
+		return nil, ErrChecklistItemNotFound
+	}
+
+	if err := uc.taskRepo.Update(ctx, task); err != nil {
+		return nil, fmt.Errorf("failed to save task: %w", err)
+	}
+
+	today, err := userToday(ctx, uc.preferencesRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := mapTaskEntityToOutput(task, today)
+	return &output, nil
+}
@@ -0,0 +1,369 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igor/chronotask-api/internal/application/port"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// attributeGainPerCompletion is how much the linked attribute grows on each completion
+// (or shrinks, for negative habits)
+const attributeGainPerCompletion = 1
+
+var (
+	// ErrHabitInactive is returned when trying to complete a deactivated habit
+	ErrHabitInactive = errors.New("habit is not active")
+
+	// ErrHabitAlreadyCompleted is returned when the habit already has all the completions its schedule
+	// allows for the day (or, for N-times-per-period habits, for the period)
+	ErrHabitAlreadyCompleted = errors.New("habit was already completed for this day or period")
+)
+
+// CompleteHabitInput represents the input for completing a habit
+type CompleteHabitInput struct {
+	HabitID string
+	UserID  string // User ID from authentication token
+}
+
+// CompleteHabitOutput represents the rewards granted by a habit completion
+type CompleteHabitOutput struct {
+	CompletionID   string
+	HabitID        string
+	CharacterID    string
+	XpGained       int // Includes StreakBonusXp; negative when a negative habit drained XP
+	StreakBonusXp  int // Bonus granted when the completion reaches a streak milestone
+	LevelsGained   int // Negative when a negative habit caused de-leveling
+	Level          int
+	CurrentXp      int
+	TotalXp        int
+	XpForNextLevel int
+	AttributeName  string
+	AttributeValue int
+	CurrentStreak  int
+	LongestStreak  int
+	FreezesEarned  int // Streak freeze tokens earned by this completion
+	CompletedAt    string
+	Raid           *RaidStrikeOutput // Damage dealt to the guild's raid boss; nil when the character isn't raiding
+	EnergyRestored int               // Battle energy restored (capped by the pool's size; 0 after the habit's first completion of the day)
+}
+
+// CompleteHabitUseCase handles logging a habit completion and rewarding the character
+type CompleteHabitUseCase struct {
+	habitRepo              repository.HabitRepository
+	habitCompletionRepo    repository.HabitCompletionRepository
+	streakFreezeRepo       repository.StreakFreezeRepository
+	characterRepo          repository.CharacterRepository
+	characterAttributeRepo repository.CharacterAttributeRepository
+	preferencesRepo        repository.UserPreferencesRepository
+	characterEnergyRepo    repository.CharacterEnergyRepository
+	raidStriker            raidStriker
+	unitOfWork             port.UnitOfWork
+}
+
+// NewCompleteHabitUseCase creates a new CompleteHabitUseCase
+func NewCompleteHabitUseCase(
+	habitRepo repository.HabitRepository,
+	habitCompletionRepo repository.HabitCompletionRepository,
+	streakFreezeRepo repository.StreakFreezeRepository,
+	characterRepo repository.CharacterRepository,
+	characterAttributeRepo repository.CharacterAttributeRepository,
+	preferencesRepo repository.UserPreferencesRepository,
+	guildRepo repository.GuildRepository,
+	raidRepo repository.RaidRepository,
+	characterEnergyRepo repository.CharacterEnergyRepository,
+	unitOfWork port.UnitOfWork,
+) *CompleteHabitUseCase {
+	return &CompleteHabitUseCase{
+		habitRepo:              habitRepo,
+		habitCompletionRepo:    habitCompletionRepo,
+		streakFreezeRepo:       streakFreezeRepo,
+		characterRepo:          characterRepo,
+		characterAttributeRepo: characterAttributeRepo,
+		preferencesRepo:        preferencesRepo,
+		characterEnergyRepo:    characterEnergyRepo,
+		raidStriker: raidStriker{
+			characterRepo:          characterRepo,
+			characterAttributeRepo: characterAttributeRepo,
+			guildRepo:              guildRepo,
+			raidRepo:               raidRepo,
+		},
+		unitOfWork: unitOfWork,
+	}
+}
+
+// Execute records a completion, awards XP to the character and grows the linked attribute
+// A habit takes one completion a day (N per period for N-times-per-period habits); slips are not limited
+// Reaching a streak milestone grants bonus XP and a streak freeze token
+// Negative habits drain the linked attribute (and XP, when configured) instead
+// The habit's first regular completion of the day also strikes the active raid boss of the character's
+// guild and restores battle energy
+func (uc *CompleteHabitUseCase) Execute(ctx context.Context, input CompleteHabitInput) (*CompleteHabitOutput, error) {
+	// 1. Validate habit exists AND belongs to the authenticated user
+	habit, err := uc.habitRepo.FindByIDAndUserID(ctx, input.HabitID, input.UserID)
+	if err != nil {
+		return nil, ErrHabitNotFound
+	}
+	if !habit.Active() {
+		return nil, ErrHabitInactive
+	}
+
+	// 2. The user's clock defines the days of the streak
+	clock, err := loadDayClock(ctx, uc.preferencesRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+
+	// 3. Apply and persist the changes atomically
+	var (
+		character      *entity.Character
+		attribute      *entity.CharacterAttribute
+		completion     *entity.HabitCompletion
+		streak         valueobject.Streak
+		streakBonusXp  int
+		freezesEarned  []*entity.StreakFreeze
+		raid           *RaidStrikeOutput
+		energyRestored int
+	)
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		// The raid is locked before the character (see raidStriker)
+		var (
+			raidToStrike *entity.Raid
+			err          error
+		)
+		if !habit.IsNegative() {
+			raidToStrike, err = uc.raidStriker.lockRaid(ctx, habit.CharacterID(), now)
+			if err != nil {
+				return err
+			}
+		}
+
+		// 3a. Lock the character and the linked attribute, so concurrent completions add up
+		character, err = uc.characterRepo.FindByIDForUpdate(ctx, habit.CharacterID())
+		if err != nil {
+			return fmt.Errorf("failed to find character: %w", err)
+		}
+
+		attribute, err = uc.characterAttributeRepo.FindByCharacterIDAndNameForUpdate(ctx, habit.CharacterID(), habit.AttributeName())
+		if err != nil {
+			return ErrAttributeNotFound
+		}
+
+		// 3b. Check the schedule's quota and evaluate the streak with this completion (milestones grant bonus XP)
+		// The history is read under the character's lock, so concurrent completions can't both get past
+		// the quota or reach a milestone
+		history, err := uc.habitCompletionRepo.FindByHabitID(ctx, habit.ID())
+		if err != nil {
+			return fmt.Errorf("failed to fetch habit completions: %w", err)
+		}
+
+		freezes, err := uc.streakFreezeRepo.FindByHabitID(ctx, habit.ID())
+		if err != nil {
+			return fmt.Errorf("failed to fetch streak freezes: %w", err)
+		}
+
+		if habit.IsQuotaMetOn(clock.Day(now), history, clock) {
+			return ErrHabitAlreadyCompleted
+		}
+
+		firstToday := !habit.IsCompletedOn(clock.Day(now), history, clock)
+
+		streakBefore := habit.CalculateStreak(history, freezes, now, clock)
+		streak = habit.StreakWithCompletionAt(history, freezes, now, clock)
+
+		milestoneReached := streak.Current() > streakBefore.Current() && streak.IsMilestone()
+		if milestoneReached {
+			streakBonusXp = streak.MilestoneBonusXp()
+		}
+
+		// 3c. Apply rewards (or, for negative habits, drain the character) and record what changed
+		if habit.IsNegative() {
+			completion, err = applyHabitDrain(habit, character, attribute)
+		} else {
+			completion, err = applyHabitReward(habit, character, attribute, streakBonusXp)
+		}
+		if err != nil {
+			return err
+		}
+
+		// 3d. Milestones also grant a streak freeze token (revoked if the completion is undone)
+		if milestoneReached {
+			freeze, err := entity.NewStreakFreeze(uuid.New().String(), character.ID(), completion.ID())
+			if err != nil {
+				return fmt.Errorf("failed to create streak freeze: %w", err)
+			}
+			freezesEarned = append(freezesEarned, freeze)
+		}
+
+		// 3e. Regular completions strike the raid boss and restore battle energy, once a day per habit
+		if !habit.IsNegative() {
+			raid, err = uc.raidStriker.strike(ctx, raidToStrike, character, attribute, completion, clock.Day(now))
+			if err != nil {
+				return err
+			}
+		}
+		if !habit.IsNegative() && firstToday {
+			energyRestored, err = uc.restoreEnergy(ctx, character.ID(), completion.CompletedAt())
+			if err != nil {
+				return err
+			}
+			// Recorded so undoing the completion drains exactly what it gave
+			if err := completion.RecordEnergyRestored(energyRestored); err != nil {
+				return fmt.Errorf("failed to record energy restored: %w", err)
+			}
+		}
+
+		if err := uc.habitCompletionRepo.Create(ctx, completion); err != nil {
+			return fmt.Errorf("failed to save habit completion: %w", err)
+		}
+		if err := uc.characterRepo.Update(ctx, character); err != nil {
+			return fmt.Errorf("failed to save character: %w", err)
+		}
+		if err := uc.characterAttributeRepo.Update(ctx, attribute); err != nil {
+			return fmt.Errorf("failed to save attribute: %w", err)
+		}
+		for _, freeze := range freezesEarned {
+			if err := uc.streakFreezeRepo.Create(ctx, freeze); err != nil {
+				return fmt.Errorf("failed to save streak freeze: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CompleteHabitOutput{
+		CompletionID:   completion.ID(),
+		HabitID:        habit.ID(),
+		CharacterID:    character.ID(),
+		XpGained:       completion.XpGained(),
+		StreakBonusXp:  streakBonusXp,
+		LevelsGained:   completion.LevelsGained(),
+		Level:          character.Level(),
+		CurrentXp:      character.CurrentXp(),
+		TotalXp:        character.TotalXp(),
+		XpForNextLevel: character.XpForNextLevel(),
+		AttributeName:  attribute.AttributeName(),
+		AttributeValue: attribute.Value(),
+		CurrentStreak:  streak.Current(),
+		LongestStreak:  streak.Longest(),
+		FreezesEarned:  len(freezesEarned),
+		CompletedAt:    completion.CompletedAt().Format("2006-01-02T15:04:05Z07:00"),
+		Raid:           raid,
+		EnergyRestored: energyRestored,
+	}, nil
+}
+
+// restoreEnergy gives the character the battle energy of a completion and saves it (inside the unit of work)
+// Returns the energy actually restored
+func (uc *CompleteHabitUseCase) restoreEnergy(ctx context.Context, characterID string, at time.Time) (int, error) {
+	energy, err := lockCharacterEnergy(ctx, uc.characterEnergyRepo, characterID, at)
+	if err != nil {
+		return 0, err
+	}
+
+	restored, err := energy.Restore(entity.HabitCompletionEnergy, at)
+	if err != nil {
+		return 0, fmt.Errorf("failed to restore energy: %w", err)
+	}
+	if err := uc.characterEnergyRepo.Update(ctx, energy); err != nil {
+		return 0, fmt.Errorf("failed to save character energy: %w", err)
+	}
+
+	return restored, nil
+}
+
+// applyHabitReward grows the character for a regular habit and records what was awarded
+func applyHabitReward(
+	habit *entity.Habit,
+	character *entity.Character,
+	attribute *entity.CharacterAttribute,
+	streakBonusXp int,
+) (*entity.HabitCompletion, error) {
+	completionID := uuid.New().String()
+	source, err := valueobject.NewXpSource(valueobject.XpSourceHabitCompletion, completionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create xp source: %w", err)
+	}
+
+	// Domain rules handle level-ups
+	xpGained := habit.Difficulty().XpReward() + streakBonusXp
+	levelsGained, err := character.AddXpFrom(xpGained, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to add xp: %w", err)
+	}
+
+	if err := attribute.IncrementValue(attributeGainPerCompletion); err != nil {
+		return nil, fmt.Errorf("failed to increment attribute: %w", err)
+	}
+
+	completion, err := entity.NewHabitCompletion(
+		completionID,
+		habit.ID(),
+		character.ID(),
+		xpGained,
+		levelsGained,
+		attribute.AttributeName(),
+		attributeGainPerCompletion,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create habit completion: %w", err)
+	}
+
+	return completion, nil
+}
+
+// applyHabitDrain drains the character for a negative habit and records what was lost
+func applyHabitDrain(
+	habit *entity.Habit,
+	character *entity.Character,
+	attribute *entity.CharacterAttribute,
+) (*entity.HabitCompletion, error) {
+	slipID := uuid.New().String()
+	source, err := valueobject.NewXpSource(valueobject.XpSourceHabitCompletion, slipID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create xp source: %w", err)
+	}
+
+	// Domain rules handle de-leveling
+	xpLost, levelsLost := 0, 0
+	if habit.DrainsXp() {
+		xpLost, levelsLost, err = character.LoseXpFrom(habit.Difficulty().XpReward(), source)
+		if err != nil {
+			return nil, fmt.Errorf("failed to remove xp: %w", err)
+		}
+	}
+
+	// Attributes never drop below 0
+	attributeLoss := attributeGainPerCompletion
+	if attribute.Value() < attributeLoss {
+		attributeLoss = attribute.Value()
+	}
+
+	if err := attribute.DecrementValue(attributeLoss); err != nil {
+		return nil, fmt.Errorf("failed to decrement attribute: %w", err)
+	}
+
+	slip, err := entity.NewHabitSlip(
+		slipID,
+		habit.ID(),
+		character.ID(),
+		xpLost,
+		levelsLost,
+		attribute.AttributeName(),
+		attributeLoss,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create habit completion: %w", err)
+	}
+
+	return slip, nil
+}
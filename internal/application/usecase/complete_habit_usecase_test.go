@@ -0,0 +1,680 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Mock HabitCompletionRepository
+type mockHabitCompletionRepository struct {
+	createFunc        func(ctx context.Context, completion *entity.HabitCompletion) error
+	findByIDFunc      func(ctx context.Context, id string) (*entity.HabitCompletion, error)
+	findByHabitIDFunc func(ctx context.Context, habitID string) ([]*entity.HabitCompletion, error)
+	findBetweenFunc   func(ctx context.Context, userID string, from time.Time, to time.Time) ([]*entity.HabitCompletion, error)
+	deleteFunc        func(ctx context.Context, id string) error
+}
+
+func (m *mockHabitCompletionRepository) Create(ctx context.Context, completion *entity.HabitCompletion) error {
+	if m.createFunc != nil {
+		return m.createFunc(ctx, completion)
+	}
+	return nil
+}
+
+func (m *mockHabitCompletionRepository) FindByID(ctx context.Context, id string) (*entity.HabitCompletion, error) {
+	if m.findByIDFunc != nil {
+		return m.findByIDFunc(ctx, id)
+	}
+	return nil, errors.New("habit completion not found")
+}
+
+func (m *mockHabitCompletionRepository) FindByHabitID(ctx context.Context, habitID string) ([]*entity.HabitCompletion, error) {
+	if m.findByHabitIDFunc != nil {
+		return m.findByHabitIDFunc(ctx, habitID)
+	}
+	return []*entity.HabitCompletion{}, nil
+}
+
+func (m *mockHabitCompletionRepository) FindByUserIDBetween(ctx context.Context, userID string, from time.Time, to time.Time) ([]*entity.HabitCompletion, error) {
+	if m.findBetweenFunc != nil {
+		return m.findBetweenFunc(ctx, userID, from, to)
+	}
+	return []*entity.HabitCompletion{}, nil
+}
+
+func (m *mockHabitCompletionRepository) Delete(ctx context.Context, id string) error {
+	if m.deleteFunc != nil {
+		return m.deleteFunc(ctx, id)
+	}
+	return nil
+}
+
+// Mock CharacterRepository for habit reward tests
+type mockCharacterRepositoryForHabits struct {
+	findByIDFunc          func(ctx context.Context, id string) (*entity.Character, error)
+	findByIDAndUserIDFunc func(ctx context.Context, id string, userID string) (*entity.Character, error)
+	updateFunc            func(ctx context.Context, character *entity.Character) error
+	locked                []string // IDs of the characters loaded with FindByIDForUpdate
+}
+
+func (m *mockCharacterRepositoryForHabits) Create(ctx context.Context, character *entity.Character) error {
+	return errors.New("not implemented")
+}
+
+func (m *mockCharacterRepositoryForHabits) FindByID(ctx context.Context, id string) (*entity.Character, error) {
+	if m.findByIDFunc != nil {
+		return m.findByIDFunc(ctx, id)
+	}
+	return nil, errors.New("character not found")
+}
+
+func (m *mockCharacterRepositoryForHabits) FindByIDForUpdate(ctx context.Context, id string) (*entity.Character, error) {
+	if !inUnitOfWork(ctx) {
+		return nil, errors.New("character locked outside a unit of work")
+	}
+	m.locked = append(m.locked, id)
+	return m.FindByID(ctx, id)
+}
+
+func (m *mockCharacterRepositoryForHabits) FindByIDAndUserID(ctx context.Context, id string, userID string) (*entity.Character, error) {
+	if m.findByIDAndUserIDFunc != nil {
+		return m.findByIDAndUserIDFunc(ctx, id, userID)
+	}
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockCharacterRepositoryForHabits) FindByUserID(ctx context.Context, userID string) (*entity.Character, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockCharacterRepositoryForHabits) FindAllByUserID(ctx context.Context, userID string) ([]*entity.Character, error) {
+	return []*entity.Character{}, errors.New("not implemented")
+}
+
+func (m *mockCharacterRepositoryForHabits) Update(ctx context.Context, character *entity.Character) error {
+	if m.updateFunc != nil {
+		return m.updateFunc(ctx, character)
+	}
+	return nil
+}
+
+func (m *mockCharacterRepositoryForHabits) Delete(ctx context.Context, id string) error {
+	return errors.New("not implemented")
+}
+
+func (m *mockCharacterRepositoryForHabits) ExistsByUserID(ctx context.Context, userID string) (bool, error) {
+	return false, errors.New("not implemented")
+}
+
+// habitRewardFixture wires the mocks needed to complete habit-123 (owned by user-123)
+type habitRewardFixture struct {
+	habit       *entity.Habit
+	character   *entity.Character
+	attribute   *entity.CharacterAttribute
+	completions []*entity.HabitCompletion
+	habitRepo   *mockHabitRepository
+	compRepo    *mockHabitCompletionRepository
+	freezeRepo  *mockStreakFreezeRepository
+	charRepo    *mockCharacterRepositoryForHabits
+	attrRepo    *mockCharacterAttributeRepository
+	guildRepo   *mockGuildRepository
+	raidRepo    *mockRaidRepository
+	energyRepo  *mockCharacterEnergyRepository
+}
+
+func newHabitRewardFixture(difficulty string, level, currentXp, totalXp int) *habitRewardFixture {
+	f := &habitRewardFixture{}
+
+	d, _ := valueobject.NewDifficulty(difficulty)
+	f.habit = entity.ReconstituteHabit("habit-123", "Push-ups", "", "char-123", "Força", d, valueobject.NewDailyRecurrence(), false, false, true, time.Now(), time.Now())
+	f.character = entity.ReconstituteCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), level, currentXp, totalXp, 0, "user-123", time.Now())
+	f.attribute = entity.ReconstituteCharacterAttribute(1, "Força", 5, "char-123", time.Now())
+
+	f.habitRepo = &mockHabitRepository{
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Habit, error) {
+			if id == "habit-123" && userID == "user-123" {
+				return f.habit, nil
+			}
+			return nil, errors.New("habit not found or does not belong to user")
+		},
+	}
+	f.compRepo = &mockHabitCompletionRepository{
+		createFunc: func(ctx context.Context, completion *entity.HabitCompletion) error {
+			f.completions = append(f.completions, completion)
+			return nil
+		},
+	}
+	f.freezeRepo = &mockStreakFreezeRepository{}
+	f.charRepo = &mockCharacterRepositoryForHabits{
+		findByIDFunc: func(ctx context.Context, id string) (*entity.Character, error) {
+			return f.character, nil
+		},
+	}
+	f.attrRepo = &mockCharacterAttributeRepository{
+		findByCharacterIDAndNameFunc: func(ctx context.Context, characterID string, attributeName string) (*entity.CharacterAttribute, error) {
+			if attributeName == "Força" {
+				return f.attribute, nil
+			}
+			return nil, errors.New("character attribute not found")
+		},
+		updateFunc: func(ctx context.Context, attribute *entity.CharacterAttribute) error {
+			return nil
+		},
+	}
+	f.guildRepo = newMockGuildRepository()
+	f.raidRepo = newMockRaidRepository()
+	f.energyRepo = newMockCharacterEnergyRepository()
+
+	return f
+}
+
+func TestCompleteHabitUseCase_Execute_Success(t *testing.T) {
+	f := newHabitRewardFixture("medium", 1, 0, 0)
+	useCase := usecase.NewCompleteHabitUseCase(f.habitRepo, f.compRepo, f.freezeRepo, f.charRepo, f.attrRepo, &mockUserPreferencesRepository{}, f.guildRepo, f.raidRepo, f.energyRepo, &mockUnitOfWork{})
+
+	output, err := useCase.Execute(context.Background(), usecase.CompleteHabitInput{
+		HabitID: "habit-123",
+		UserID:  "user-123",
+	})
+
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.XpGained != 20 {
+		t.Errorf("output.XpGained = %v, want %v", output.XpGained, 20)
+	}
+
+	if output.LevelsGained != 0 {
+		t.Errorf("output.LevelsGained = %v, want %v", output.LevelsGained, 0)
+	}
+
+	if output.CurrentXp != 20 {
+		t.Errorf("output.CurrentXp = %v, want %v", output.CurrentXp, 20)
+	}
+
+	if output.AttributeValue != 6 {
+		t.Errorf("output.AttributeValue = %v, want %v", output.AttributeValue, 6)
+	}
+
+	if len(f.completions) != 1 {
+		t.Fatalf("recorded completions = %v, want %v", len(f.completions), 1)
+	}
+
+	if f.completions[0].XpGained() != 20 || f.completions[0].AttributeGain() != 1 {
+		t.Errorf("completion rewards = (%v, %v), want (20, 1)", f.completions[0].XpGained(), f.completions[0].AttributeGain())
+	}
+	// The reward is recorded in the XP ledger with the completion as its source
+	ledger := f.character.PendingXpTransactions()
+	if len(ledger) != 1 || ledger[0].Amount() != 20 || ledger[0].Source().Type() != valueobject.XpSourceHabitCompletion || ledger[0].Source().ID() != output.CompletionID {
+		t.Errorf("xp ledger = %+v, want one 20 XP habit_completion entry for %v", ledger, output.CompletionID)
+	}
+	// The character is changed only after it was locked inside the unit of work
+	if len(f.charRepo.locked) != 1 || f.charRepo.locked[0] != "char-123" {
+		t.Errorf("locked characters = %v, want [char-123]", f.charRepo.locked)
+	}
+}
+
+func TestCompleteHabitUseCase_Execute_LevelUp(t *testing.T) {
+	// Level 1 needs 100 XP; 70 + 40 (hard) crosses the threshold
+	f := newHabitRewardFixture("hard", 1, 70, 70)
+	useCase := usecase.NewCompleteHabitUseCase(f.habitRepo, f.compRepo, f.freezeRepo, f.charRepo, f.attrRepo, &mockUserPreferencesRepository{}, f.guildRepo, f.raidRepo, f.energyRepo, &mockUnitOfWork{})
+
+	output, err := useCase.Execute(context.Background(), usecase.CompleteHabitInput{
+		HabitID: "habit-123",
+		UserID:  "user-123",
+	})
+
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.LevelsGained != 1 {
+		t.Errorf("output.LevelsGained = %v, want %v", output.LevelsGained, 1)
+	}
+
+	if output.Level != 2 {
+		t.Errorf("output.Level = %v, want %v", output.Level, 2)
+	}
+
+	if output.CurrentXp != 10 {
+		t.Errorf("output.CurrentXp = %v, want %v", output.CurrentXp, 10)
+	}
+
+	if f.completions[0].LevelsGained() != 1 {
+		t.Errorf("completion.LevelsGained() = %v, want %v", f.completions[0].LevelsGained(), 1)
+	}
+}
+
+func TestCompleteHabitUseCase_Execute_NotOwned(t *testing.T) {
+	f := newHabitRewardFixture("easy", 1, 0, 0)
+	useCase := usecase.NewCompleteHabitUseCase(f.habitRepo, f.compRepo, f.freezeRepo, f.charRepo, f.attrRepo, &mockUserPreferencesRepository{}, f.guildRepo, f.raidRepo, f.energyRepo, &mockUnitOfWork{})
+
+	_, err := useCase.Execute(context.Background(), usecase.CompleteHabitInput{
+		HabitID: "habit-123",
+		UserID:  "other-user",
+	})
+
+	if !errors.Is(err, usecase.ErrHabitNotFound) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrHabitNotFound)
+	}
+}
+
+func TestCompleteHabitUseCase_Execute_InactiveHabit(t *testing.T) {
+	f := newHabitRewardFixture("easy", 1, 0, 0)
+	f.habit.Deactivate()
+	useCase := usecase.NewCompleteHabitUseCase(f.habitRepo, f.compRepo, f.freezeRepo, f.charRepo, f.attrRepo, &mockUserPreferencesRepository{}, f.guildRepo, f.raidRepo, f.energyRepo, &mockUnitOfWork{})
+
+	_, err := useCase.Execute(context.Background(), usecase.CompleteHabitInput{
+		HabitID: "habit-123",
+		UserID:  "user-123",
+	})
+
+	if !errors.Is(err, usecase.ErrHabitInactive) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrHabitInactive)
+	}
+
+	if len(f.completions) != 0 {
+		t.Errorf("recorded completions = %v, want 0", len(f.completions))
+	}
+}
+
+func TestCompleteHabitUseCase_Execute_StreakMilestone(t *testing.T) {
+	f := newHabitRewardFixture("medium", 1, 0, 0)
+
+	// Daily habit completed on each of the last 6 days: today's completion makes a 7-day streak
+	now := time.Now().UTC()
+	d, _ := valueobject.NewDifficulty("medium")
+	f.habit = entity.ReconstituteHabit("habit-123", "Push-ups", "", "char-123", "Força", d, valueobject.NewDailyRecurrence(), false, false, true, now.AddDate(0, 0, -10), now.AddDate(0, 0, -10))
+
+	var history []*entity.HabitCompletion
+	for daysAgo := 1; daysAgo <= 6; daysAgo++ {
+		history = append(history, entity.ReconstituteHabitCompletion("comp", "habit-123", "char-123", 20, 0, "Força", 1, 0, now.AddDate(0, 0, -daysAgo)))
+	}
+	f.compRepo.findByHabitIDFunc = func(ctx context.Context, habitID string) ([]*entity.HabitCompletion, error) {
+		// Read under the character's lock, so concurrent completions can't both reach the milestone
+		if !inUnitOfWork(ctx) {
+			return nil, errors.New("habit history read outside a unit of work")
+		}
+		return history, nil
+	}
+
+	var earned []*entity.StreakFreeze
+	f.freezeRepo.createFunc = func(ctx context.Context, freeze *entity.StreakFreeze) error {
+		earned = append(earned, freeze)
+		return nil
+	}
+
+	useCase := usecase.NewCompleteHabitUseCase(f.habitRepo, f.compRepo, f.freezeRepo, f.charRepo, f.attrRepo, &mockUserPreferencesRepository{}, f.guildRepo, f.raidRepo, f.energyRepo, &mockUnitOfWork{})
+
+	output, err := useCase.Execute(context.Background(), usecase.CompleteHabitInput{
+		HabitID: "habit-123",
+		UserID:  "user-123",
+	})
+
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.CurrentStreak != 7 {
+		t.Errorf("output.CurrentStreak = %v, want %v", output.CurrentStreak, 7)
+	}
+
+	// 20 (medium) + 50 (7-day milestone)
+	if output.StreakBonusXp != 50 || output.XpGained != 70 {
+		t.Errorf("output xp = (bonus %v, total %v), want (50, 70)", output.StreakBonusXp, output.XpGained)
+	}
+
+	if f.completions[0].XpGained() != 70 {
+		t.Errorf("completion.XpGained() = %v, want %v", f.completions[0].XpGained(), 70)
+	}
+
+	if output.FreezesEarned != 1 || len(earned) != 1 {
+		t.Errorf("freezes earned = (%v, %v saved), want (1, 1 saved)", output.FreezesEarned, len(earned))
+	}
+}
+
+func TestCompleteHabitUseCase_Execute_AlreadyCompletedToday(t *testing.T) {
+	f := newHabitRewardFixture("medium", 1, 0, 0)
+
+	now := time.Now().UTC()
+	d, _ := valueobject.NewDifficulty("medium")
+	f.habit = entity.ReconstituteHabit("habit-123", "Push-ups", "", "char-123", "Força", d, valueobject.NewDailyRecurrence(), false, false, true, now.AddDate(0, 0, -10), now.AddDate(0, 0, -10))
+
+	// The 7-day streak was already reached earlier today: completing again can't repeat the milestone
+	var history []*entity.HabitCompletion
+	for daysAgo := 0; daysAgo <= 6; daysAgo++ {
+		history = append(history, entity.ReconstituteHabitCompletion("comp", "habit-123", "char-123", 20, 0, "Força", 1, 0, now.AddDate(0, 0, -daysAgo)))
+	}
+	f.compRepo.findByHabitIDFunc = func(ctx context.Context, habitID string) ([]*entity.HabitCompletion, error) {
+		return history, nil
+	}
+
+	unitOfWork := &mockUnitOfWork{}
+	useCase := usecase.NewCompleteHabitUseCase(f.habitRepo, f.compRepo, f.freezeRepo, f.charRepo, f.attrRepo, &mockUserPreferencesRepository{}, f.guildRepo, f.raidRepo, f.energyRepo, unitOfWork)
+
+	_, err := useCase.Execute(context.Background(), usecase.CompleteHabitInput{
+		HabitID: "habit-123",
+		UserID:  "user-123",
+	})
+
+	if !errors.Is(err, usecase.ErrHabitAlreadyCompleted) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrHabitAlreadyCompleted)
+	}
+
+	if len(f.completions) != 0 || f.character.TotalXp() != 0 || unitOfWork.rollbacks != 1 {
+		t.Errorf("completion recorded despite the quota (completions %v, xp %v, rollbacks %v)", len(f.completions), f.character.TotalXp(), unitOfWork.rollbacks)
+	}
+}
+
+func TestCompleteHabitUseCase_Execute_TimesPerPeriodQuota(t *testing.T) {
+	f := newHabitRewardFixture("medium", 1, 0, 0)
+
+	now := time.Now().UTC()
+	d, _ := valueobject.NewDifficulty("medium")
+	twicePerMonth, _ := valueobject.NewTimesPerPeriodRecurrence(2, "month")
+	f.habit = entity.ReconstituteHabit("habit-123", "Push-ups", "", "char-123", "Força", d, twicePerMonth, false, false, true, now.AddDate(0, -2, 0), now.AddDate(0, -2, 0))
+	f.compRepo.findByHabitIDFunc = func(ctx context.Context, habitID string) ([]*entity.HabitCompletion, error) {
+		return f.completions, nil
+	}
+
+	useCase := usecase.NewCompleteHabitUseCase(f.habitRepo, f.compRepo, f.freezeRepo, f.charRepo, f.attrRepo, &mockUserPreferencesRepository{}, f.guildRepo, f.raidRepo, f.energyRepo, &mockUnitOfWork{})
+
+	// Both completions of the month are accepted, even on the same day; a third one is not
+	for i := 1; i <= 3; i++ {
+		_, err := useCase.Execute(context.Background(), usecase.CompleteHabitInput{
+			HabitID: "habit-123",
+			UserID:  "user-123",
+		})
+
+		if i <= 2 && err != nil {
+			t.Fatalf("completion %d: Execute() error = %v, want nil", i, err)
+		}
+		if i == 3 && !errors.Is(err, usecase.ErrHabitAlreadyCompleted) {
+			t.Errorf("completion %d: Execute() error = %v, want %v", i, err, usecase.ErrHabitAlreadyCompleted)
+		}
+	}
+
+	if len(f.completions) != 2 {
+		t.Errorf("recorded completions = %v, want 2", len(f.completions))
+	}
+}
+
+func TestCompleteHabitUseCase_Execute_NegativeHabitDrainsXp(t *testing.T) {
+	// Level 3 with 10 XP; losing 40 (hard) drops back to level 2 (needs 283 XP) with 253 XP
+	f := newHabitRewardFixture("hard", 3, 10, 393)
+	f.habit.MakeNegative(true)
+	useCase := usecase.NewCompleteHabitUseCase(f.habitRepo, f.compRepo, f.freezeRepo, f.charRepo, f.attrRepo, &mockUserPreferencesRepository{}, f.guildRepo, f.raidRepo, f.energyRepo, &mockUnitOfWork{})
+
+	output, err := useCase.Execute(context.Background(), usecase.CompleteHabitInput{
+		HabitID: "habit-123",
+		UserID:  "user-123",
+	})
+
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.XpGained != -40 || output.LevelsGained != -1 {
+		t.Errorf("output = (xp %v, levels %v), want (-40, -1)", output.XpGained, output.LevelsGained)
+	}
+
+	if output.Level != 2 || output.CurrentXp != 253 || output.TotalXp != 353 {
+		t.Errorf("character = (level %v, xp %v, total %v), want (2, 253, 353)", output.Level, output.CurrentXp, output.TotalXp)
+	}
+
+	if output.AttributeValue != 4 {
+		t.Errorf("output.AttributeValue = %v, want %v", output.AttributeValue, 4)
+	}
+
+	slip := f.completions[0]
+	if slip.XpGained() != -40 || slip.LevelsGained() != -1 || slip.AttributeGain() != -1 {
+		t.Errorf("slip = (xp %v, levels %v, attribute %v), want (-40, -1, -1)", slip.XpGained(), slip.LevelsGained(), slip.AttributeGain())
+	}
+
+	if output.StreakBonusXp != 0 || output.CurrentStreak != 0 {
+		t.Errorf("output = (bonus %v, streak %v), want (0, 0)", output.StreakBonusXp, output.CurrentStreak)
+	}
+}
+
+func TestCompleteHabitUseCase_Execute_NegativeHabitWithoutXpDrain(t *testing.T) {
+	f := newHabitRewardFixture("hard", 2, 50, 150)
+	f.habit.MakeNegative(false)
+	f.attribute = entity.ReconstituteCharacterAttribute(1, "Força", 0, "char-123", time.Now())
+	useCase := usecase.NewCompleteHabitUseCase(f.habitRepo, f.compRepo, f.freezeRepo, f.charRepo, f.attrRepo, &mockUserPreferencesRepository{}, f.guildRepo, f.raidRepo, f.energyRepo, &mockUnitOfWork{})
+
+	output, err := useCase.Execute(context.Background(), usecase.CompleteHabitInput{
+		HabitID: "habit-123",
+		UserID:  "user-123",
+	})
+
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.XpGained != 0 || output.Level != 2 || output.CurrentXp != 50 {
+		t.Errorf("output = (xp %v, level %v, current %v), want (0, 2, 50)", output.XpGained, output.Level, output.CurrentXp)
+	}
+
+	// Attributes never drop below 0
+	if output.AttributeValue != 0 || f.completions[0].AttributeGain() != 0 {
+		t.Errorf("attribute = (value %v, change %v), want (0, 0)", output.AttributeValue, f.completions[0].AttributeGain())
+	}
+}
+
+// joinGuildRaid puts char-123 in guild-123 with char-789 (the returned ally), fighting Hidra do Pântano
+// (600 HP, 300 XP reward) down to hp; the ally already dealt the missing HP
+func (f *habitRewardFixture) joinGuildRaid(hp int) (*entity.Raid, *entity.Character) {
+	f.guildRepo = newRaidGuildRepository()
+
+	boss, _ := valueobject.NewRaidBoss("hidra-do-pantano")
+	startedAt := time.Now().UTC().Add(-time.Hour)
+	raid := entity.ReconstituteRaid("raid-123", "guild-123", boss, 600, hp, 300, entity.RaidActive, startedAt, startedAt.Add(boss.Duration()), nil)
+	f.raidRepo.raids[raid.ID()] = raid
+	if hp < 600 {
+		f.raidRepo.hits["ally-completion"] = entity.ReconstituteRaidHit("ally-completion", raid.ID(), "char-789", "ally-habit", startedAt, 600-hp, startedAt)
+	}
+
+	// The stored attributes don't have the completion's growth yet
+	f.attrRepo.findByCharacterIDFunc = func(ctx context.Context, characterID string) ([]*entity.CharacterAttribute, error) {
+		return []*entity.CharacterAttribute{entity.ReconstituteCharacterAttribute(1, "Força", 5, characterID, time.Now())}, nil
+	}
+
+	ally := entity.ReconstituteCharacter("char-789", "Ally", valueobject.DefaultCharacterClass(), 1, 0, 0, 0, "user-789", time.Now())
+	f.charRepo.findByIDFunc = func(ctx context.Context, id string) (*entity.Character, error) {
+		if id == ally.ID() {
+			return ally, nil
+		}
+		return f.character, nil
+	}
+
+	return raid, ally
+}
+
+func TestCompleteHabitUseCase_Execute_StrikesGuildRaid(t *testing.T) {
+	f := newHabitRewardFixture("easy", 1, 0, 0)
+	raid, _ := f.joinGuildRaid(600)
+	useCase := usecase.NewCompleteHabitUseCase(f.habitRepo, f.compRepo, f.freezeRepo, f.charRepo, f.attrRepo, &mockUserPreferencesRepository{}, f.guildRepo, f.raidRepo, f.energyRepo, &mockUnitOfWork{})
+
+	output, err := useCase.Execute(context.Background(), usecase.CompleteHabitInput{HabitID: "habit-123", UserID: "user-123"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	// Força 6 after the completion: attack 6*2 + level 1 = 13
+	if output.Raid == nil || output.Raid.Damage != 13 || output.Raid.BossHP != 587 || output.Raid.Defeated {
+		t.Fatalf("output.Raid = %+v, want 13 damage leaving 587 HP", output.Raid)
+	}
+	if raid.HP() != 587 || f.raidRepo.locked != 1 {
+		t.Errorf("raid HP = %d, locked = %d, want 587 and 1", raid.HP(), f.raidRepo.locked)
+	}
+
+	hit := f.raidRepo.hits[output.CompletionID]
+	if hit == nil || hit.Damage() != 13 || hit.CharacterID() != "char-123" {
+		t.Errorf("hit = %+v, want 13 damage by char-123", hit)
+	}
+}
+
+func TestCompleteHabitUseCase_Execute_StrikesGuildRaidOncePerDay(t *testing.T) {
+	f := newHabitRewardFixture("easy", 1, 0, 0)
+	raid, _ := f.joinGuildRaid(600)
+
+	now := time.Now().UTC()
+	d, _ := valueobject.NewDifficulty("easy")
+	twicePerWeek, _ := valueobject.NewTimesPerPeriodRecurrence(2, "week")
+	f.habit = entity.ReconstituteHabit("habit-123", "Push-ups", "", "char-123", "Força", d, twicePerWeek, false, false, true, now.AddDate(0, -2, 0), now.AddDate(0, -2, 0))
+	f.compRepo.findByHabitIDFunc = func(ctx context.Context, habitID string) ([]*entity.HabitCompletion, error) {
+		return f.completions, nil
+	}
+
+	useCase := usecase.NewCompleteHabitUseCase(f.habitRepo, f.compRepo, f.freezeRepo, f.charRepo, f.attrRepo, &mockUserPreferencesRepository{}, f.guildRepo, f.raidRepo, f.energyRepo, &mockUnitOfWork{})
+
+	// Both completions of the week are accepted on the same day, but only the first strikes the boss
+	first, err := useCase.Execute(context.Background(), usecase.CompleteHabitInput{HabitID: "habit-123", UserID: "user-123"})
+	if err != nil {
+		t.Fatalf("first Execute() error = %v, want nil", err)
+	}
+	second, err := useCase.Execute(context.Background(), usecase.CompleteHabitInput{HabitID: "habit-123", UserID: "user-123"})
+	if err != nil {
+		t.Fatalf("second Execute() error = %v, want nil", err)
+	}
+
+	if first.Raid == nil || second.Raid != nil {
+		t.Errorf("output.Raid = %+v, then %+v, want a strike then nil", first.Raid, second.Raid)
+	}
+	if len(f.raidRepo.hits) != 1 || raid.HP() != 600-first.Raid.Damage {
+		t.Errorf("raid hits = %d, raid HP = %d, want a single hit", len(f.raidRepo.hits), raid.HP())
+	}
+}
+
+func TestCompleteHabitUseCase_Execute_KillingBlowSplitsReward(t *testing.T) {
+	f := newHabitRewardFixture("easy", 1, 0, 0)
+	raid, ally := f.joinGuildRaid(10)
+	var updated []*entity.Character
+	f.charRepo.updateFunc = func(ctx context.Context, character *entity.Character) error {
+		updated = append(updated, character)
+		return nil
+	}
+	useCase := usecase.NewCompleteHabitUseCase(f.habitRepo, f.compRepo, f.freezeRepo, f.charRepo, f.attrRepo, &mockUserPreferencesRepository{}, f.guildRepo, f.raidRepo, f.energyRepo, &mockUnitOfWork{})
+
+	output, err := useCase.Execute(context.Background(), usecase.CompleteHabitInput{HabitID: "habit-123", UserID: "user-123"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	// Only the 10 HP left count: 10/600 of the 300 XP reward is 5, the ally's 590/600 is 295
+	if output.Raid == nil || output.Raid.Damage != 10 || !output.Raid.Defeated || output.Raid.XpAwarded != 5 {
+		t.Fatalf("output.Raid = %+v, want the killing blow for 10 damage and 5 XP", output.Raid)
+	}
+	if raid.Status() != entity.RaidDefeated || raid.HP() != 0 {
+		t.Errorf("raid = (%q, %d HP), want defeated at 0 HP", raid.Status(), raid.HP())
+	}
+	if f.character.TotalXp() != 15 || output.TotalXp != 15 {
+		t.Errorf("character total xp = %d, want 15 (10 from the habit, 5 from the raid)", f.character.TotalXp())
+	}
+	if ally.TotalXp() != 295 {
+		t.Errorf("ally total xp = %d, want 295", ally.TotalXp())
+	}
+	if len(updated) != 2 || updated[0] != ally || updated[1] != f.character {
+		t.Errorf("updated = %d characters, want the ally then the completing character", len(updated))
+	}
+	// The ally is locked after the completing character, before its reward
+	if len(f.charRepo.locked) != 2 || f.charRepo.locked[1] != "char-789" {
+		t.Errorf("locked characters = %v, want [char-123 char-789]", f.charRepo.locked)
+	}
+}
+
+func TestCompleteHabitUseCase_Execute_NegativeHabitDoesNotStrike(t *testing.T) {
+	f := newHabitRewardFixture("easy", 1, 0, 0)
+	f.habit.MakeNegative(false)
+	raid, _ := f.joinGuildRaid(600)
+	useCase := usecase.NewCompleteHabitUseCase(f.habitRepo, f.compRepo, f.freezeRepo, f.charRepo, f.attrRepo, &mockUserPreferencesRepository{}, f.guildRepo, f.raidRepo, f.energyRepo, &mockUnitOfWork{})
+
+	output, err := useCase.Execute(context.Background(), usecase.CompleteHabitInput{HabitID: "habit-123", UserID: "user-123"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if output.Raid != nil || raid.HP() != 600 {
+		t.Errorf("output.Raid = %+v, raid HP = %d, want no strike", output.Raid, raid.HP())
+	}
+}
+
+func TestCompleteHabitUseCase_Execute_RestoresEnergy(t *testing.T) {
+	f := newHabitRewardFixture("easy", 1, 0, 0)
+	f.energyRepo.setEnergy("char-123", 50, time.Now().UTC())
+	useCase := usecase.NewCompleteHabitUseCase(f.habitRepo, f.compRepo, f.freezeRepo, f.charRepo, f.attrRepo, &mockUserPreferencesRepository{}, f.guildRepo, f.raidRepo, f.energyRepo, &mockUnitOfWork{})
+
+	output, err := useCase.Execute(context.Background(), usecase.CompleteHabitInput{HabitID: "habit-123", UserID: "user-123"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.EnergyRestored != entity.HabitCompletionEnergy {
+		t.Errorf("output.EnergyRestored = %d, want %d", output.EnergyRestored, entity.HabitCompletionEnergy)
+	}
+	if got := f.energyRepo.energyAt("char-123", time.Now().UTC()); got != 50+entity.HabitCompletionEnergy {
+		t.Errorf("energy = %d, want %d", got, 50+entity.HabitCompletionEnergy)
+	}
+
+	// A full pool can't grow any further
+	f.energyRepo.setEnergy("char-123", entity.MaxEnergy, time.Now().UTC())
+	output, err = useCase.Execute(context.Background(), usecase.CompleteHabitInput{HabitID: "habit-123", UserID: "user-123"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if output.EnergyRestored != 0 {
+		t.Errorf("output.EnergyRestored = %d, want 0", output.EnergyRestored)
+	}
+}
+
+func TestCompleteHabitUseCase_Execute_RestoresEnergyOncePerDay(t *testing.T) {
+	f := newHabitRewardFixture("easy", 1, 0, 0)
+	twicePerWeek, _ := valueobject.NewTimesPerPeriodRecurrence(2, "week")
+	f.habit.ChangeRecurrence(twicePerWeek)
+	f.compRepo.findByHabitIDFunc = func(ctx context.Context, habitID string) ([]*entity.HabitCompletion, error) {
+		return f.completions, nil
+	}
+	f.energyRepo.setEnergy("char-123", 50, time.Now().UTC())
+	useCase := usecase.NewCompleteHabitUseCase(f.habitRepo, f.compRepo, f.freezeRepo, f.charRepo, f.attrRepo, &mockUserPreferencesRepository{}, f.guildRepo, f.raidRepo, f.energyRepo, &mockUnitOfWork{})
+
+	// Both completions of the week are rewarded, but only the first one of the day restores energy
+	var restored []int
+	for i := 0; i < 2; i++ {
+		output, err := useCase.Execute(context.Background(), usecase.CompleteHabitInput{HabitID: "habit-123", UserID: "user-123"})
+		if err != nil {
+			t.Fatalf("Execute() error = %v, want nil", err)
+		}
+		restored = append(restored, output.EnergyRestored)
+	}
+
+	if restored[0] != entity.HabitCompletionEnergy || restored[1] != 0 {
+		t.Errorf("energy restored = %v, want [%d 0]", restored, entity.HabitCompletionEnergy)
+	}
+	if f.completions[0].EnergyRestored() != entity.HabitCompletionEnergy || f.completions[1].EnergyRestored() != 0 {
+		t.Errorf("recorded energy restored = [%d %d], want [%d 0]", f.completions[0].EnergyRestored(), f.completions[1].EnergyRestored(), entity.HabitCompletionEnergy)
+	}
+	if got := f.energyRepo.energyAt("char-123", time.Now().UTC()); got != 50+entity.HabitCompletionEnergy {
+		t.Errorf("energy = %d, want %d", got, 50+entity.HabitCompletionEnergy)
+	}
+}
+
+func TestCompleteHabitUseCase_Execute_NegativeHabitDoesNotRestoreEnergy(t *testing.T) {
+	f := newHabitRewardFixture("easy", 1, 0, 0)
+	f.habit.MakeNegative(false)
+	f.energyRepo.setEnergy("char-123", 50, time.Now().UTC())
+	useCase := usecase.NewCompleteHabitUseCase(f.habitRepo, f.compRepo, f.freezeRepo, f.charRepo, f.attrRepo, &mockUserPreferencesRepository{}, f.guildRepo, f.raidRepo, f.energyRepo, &mockUnitOfWork{})
+
+	output, err := useCase.Execute(context.Background(), usecase.CompleteHabitInput{HabitID: "habit-123", UserID: "user-123"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if output.EnergyRestored != 0 || f.energyRepo.energyAt("char-123", time.Now().UTC()) != 50 {
+		t.Errorf("output.EnergyRestored = %d, want the pool untouched", output.EnergyRestored)
+	}
+}
@@ -0,0 +1,117 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/port"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// TaskRewardOutput represents a task after completing (or reopening) it, with the character's progress
+// XP and level changes are negative when a reopened task takes its reward back
+type TaskRewardOutput struct {
+	Task           TaskOutput
+	XpGained       int
+	LevelsGained   int
+	Level          int
+	CurrentXp      int
+	TotalXp        int
+	XpForNextLevel int
+}
+
+// CompleteTaskUseCase handles completing a task and rewarding the character
+type CompleteTaskUseCase struct {
+	taskRepo        repository.TaskRepository
+	characterRepo   repository.CharacterRepository
+	preferencesRepo repository.UserPreferencesRepository
+	unitOfWork      port.UnitOfWork
+}
+
+// NewCompleteTaskUseCase creates a new CompleteTaskUseCase
+func NewCompleteTaskUseCase(
+	taskRepo repository.TaskRepository,
+	characterRepo repository.CharacterRepository,
+	preferencesRepo repository.UserPreferencesRepository,
+	unitOfWork port.UnitOfWork,
+) *CompleteTaskUseCase {
+	return &CompleteTaskUseCase{
+		taskRepo:        taskRepo,
+		characterRepo:   characterRepo,
+		preferencesRepo: preferencesRepo,
+		unitOfWork:      unitOfWork,
+	}
+}
+
+// Execute completes an open task owned by the user and awards the XP of its difficulty
+func (uc *CompleteTaskUseCase) Execute(ctx context.Context, input TaskInput) (*TaskRewardOutput, error) {
+	// 1. Validate task exists AND belongs to the authenticated user
+	task, err := uc.taskRepo.FindByIDAndUserID(ctx, input.TaskID, input.UserID)
+	if err != nil {
+		return nil, ErrTaskNotFound
+	}
+	if task.IsCompleted() {
+		return nil, ErrTaskAlreadyCompleted
+	}
+
+	today, err := userToday(ctx, uc.preferencesRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Complete the task (domain rules compute the reward)
+	now := time.Now().UTC()
+	if err := task.Complete(now); err != nil {
+		return nil, fmt.Errorf("failed to complete task: %w", err)
+	}
+
+	source, err := valueobject.NewXpSource(valueobject.XpSourceTaskCompletion, task.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create xp source: %w", err)
+	}
+
+	// 3. Save the completion and reward the locked character atomically
+	var (
+		character    *entity.Character
+		levelsGained int
+	)
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		// Fails when a concurrent request already completed the task
+		if err := uc.taskRepo.UpdateCompletion(ctx, task); err != nil {
+			if errors.Is(err, repository.ErrTaskCompletionChanged) {
+				return ErrTaskAlreadyCompleted
+			}
+			return fmt.Errorf("failed to save task: %w", err)
+		}
+
+		character, err = uc.characterRepo.FindByIDForUpdate(ctx, task.CharacterID())
+		if err != nil {
+			return ErrCharacterNotFound
+		}
+		levelsGained, err = character.AddXpFrom(task.XpAwarded(), source)
+		if err != nil {
+			return fmt.Errorf("failed to add xp: %w", err)
+		}
+		if err := uc.characterRepo.Update(ctx, character); err != nil {
+			return fmt.Errorf("failed to save character: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &TaskRewardOutput{
+		Task:           mapTaskEntityToOutput(task, today),
+		XpGained:       task.XpAwarded(),
+		LevelsGained:   levelsGained,
+		Level:          character.Level(),
+		CurrentXp:      character.CurrentXp(),
+		TotalXp:        character.TotalXp(),
+		XpForNextLevel: character.XpForNextLevel(),
+	}, nil
+}
@@ -0,0 +1,206 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Mock TaskRepository
+type mockTaskRepository struct {
+	createFunc            func(ctx context.Context, task *entity.Task) error
+	findByIDAndUserIDFunc func(ctx context.Context, id string, userID string) (*entity.Task, error)
+	findByUserIDFunc      func(ctx context.Context, userID string, filter repository.TaskFilter) ([]*entity.Task, error)
+	updateFunc            func(ctx context.Context, task *entity.Task) error
+	updateCompletionFunc  func(ctx context.Context, task *entity.Task) error
+}
+
+func (m *mockTaskRepository) Create(ctx context.Context, task *entity.Task) error {
+	if m.createFunc != nil {
+		return m.createFunc(ctx, task)
+	}
+	return nil
+}
+
+func (m *mockTaskRepository) FindByIDAndUserID(ctx context.Context, id string, userID string) (*entity.Task, error) {
+	if m.findByIDAndUserIDFunc != nil {
+		return m.findByIDAndUserIDFunc(ctx, id, userID)
+	}
+	return nil, errors.New("task not found or does not belong to user")
+}
+
+func (m *mockTaskRepository) FindByUserID(ctx context.Context, userID string, filter repository.TaskFilter) ([]*entity.Task, error) {
+	if m.findByUserIDFunc != nil {
+		return m.findByUserIDFunc(ctx, userID, filter)
+	}
+	return []*entity.Task{}, nil
+}
+
+func (m *mockTaskRepository) Update(ctx context.Context, task *entity.Task) error {
+	if m.updateFunc != nil {
+		return m.updateFunc(ctx, task)
+	}
+	return nil
+}
+
+func (m *mockTaskRepository) UpdateCompletion(ctx context.Context, task *entity.Task) error {
+	if m.updateCompletionFunc != nil {
+		return m.updateCompletionFunc(ctx, task)
+	}
+	return nil
+}
+
+// newTaskRewardFixture creates a hard task-123 (owned by user-123) and its character
+func newTaskRewardFixture(level, currentXp, totalXp int) (*mockTaskRepository, *mockCharacterRepositoryForHabits, *entity.Task, *entity.Character) {
+	difficulty, _ := valueobject.NewDifficulty("hard")
+	priority, _ := valueobject.NewPriority("high")
+	task, _ := entity.NewTask("task-123", "char-123", "File taxes", "", difficulty, priority, nil, nil)
+	character := entity.ReconstituteCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), level, currentXp, totalXp, 0, "user-123", time.Now())
+
+	taskRepo := &mockTaskRepository{
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Task, error) {
+			if id == "task-123" && userID == "user-123" {
+				return task, nil
+			}
+			return nil, errors.New("task not found or does not belong to user")
+		},
+	}
+	charRepo := &mockCharacterRepositoryForHabits{
+		findByIDFunc: func(ctx context.Context, id string) (*entity.Character, error) {
+			return character, nil
+		},
+	}
+	return taskRepo, charRepo, task, character
+}
+
+func TestCompleteTaskUseCase_AwardsXpAndLevelsUp(t *testing.T) {
+	taskRepo, charRepo, _, _ := newTaskRewardFixture(1, 80, 80)
+	uc := usecase.NewCompleteTaskUseCase(taskRepo, charRepo, &mockUserPreferencesRepository{}, &mockUnitOfWork{})
+
+	output, err := uc.Execute(context.Background(), usecase.TaskInput{TaskID: "task-123", UserID: "user-123"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	// 80 + 40 XP crosses the 100 XP needed for level 2
+	if output.XpGained != 40 || output.LevelsGained != 1 {
+		t.Errorf("XpGained, LevelsGained = %v, %v, want 40, 1", output.XpGained, output.LevelsGained)
+	}
+	if output.Level != 2 || output.CurrentXp != 20 {
+		t.Errorf("Level, CurrentXp = %v, %v, want 2, 20", output.Level, output.CurrentXp)
+	}
+	if output.Task.Status != entity.TaskCompleted {
+		t.Errorf("Task.Status = %v, want %v", output.Task.Status, entity.TaskCompleted)
+	}
+	if len(charRepo.locked) != 1 || charRepo.locked[0] != "char-123" {
+		t.Errorf("locked characters = %v, want [char-123]", charRepo.locked)
+	}
+
+	// Completing twice is rejected
+	if _, err := uc.Execute(context.Background(), usecase.TaskInput{TaskID: "task-123", UserID: "user-123"}); !errors.Is(err, usecase.ErrTaskAlreadyCompleted) {
+		t.Errorf("second Execute() error = %v, want %v", err, usecase.ErrTaskAlreadyCompleted)
+	}
+}
+
+func TestCompleteTaskUseCase_TaskNotOwned(t *testing.T) {
+	taskRepo, charRepo, _, _ := newTaskRewardFixture(1, 0, 0)
+	uc := usecase.NewCompleteTaskUseCase(taskRepo, charRepo, &mockUserPreferencesRepository{}, &mockUnitOfWork{})
+
+	_, err := uc.Execute(context.Background(), usecase.TaskInput{TaskID: "task-123", UserID: "other-user"})
+	if !errors.Is(err, usecase.ErrTaskNotFound) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrTaskNotFound)
+	}
+}
+
+func TestCompleteTaskUseCase_ConcurrentCompletion(t *testing.T) {
+	taskRepo, charRepo, _, character := newTaskRewardFixture(1, 80, 80)
+	// Another request completed the task after it was loaded
+	taskRepo.updateCompletionFunc = func(ctx context.Context, task *entity.Task) error {
+		return repository.ErrTaskCompletionChanged
+	}
+	uc := usecase.NewCompleteTaskUseCase(taskRepo, charRepo, &mockUserPreferencesRepository{}, &mockUnitOfWork{})
+
+	_, err := uc.Execute(context.Background(), usecase.TaskInput{TaskID: "task-123", UserID: "user-123"})
+	if !errors.Is(err, usecase.ErrTaskAlreadyCompleted) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrTaskAlreadyCompleted)
+	}
+	if character.TotalXp() != 80 {
+		t.Errorf("TotalXp() = %v, want 80", character.TotalXp())
+	}
+}
+
+func TestReopenTaskUseCase_TakesXpBack(t *testing.T) {
+	taskRepo, charRepo, task, character := newTaskRewardFixture(1, 80, 80)
+
+	if _, err := usecase.NewReopenTaskUseCase(taskRepo, charRepo, &mockUserPreferencesRepository{}, &mockUnitOfWork{}).Execute(context.Background(), usecase.TaskInput{TaskID: "task-123", UserID: "user-123"}); !errors.Is(err, usecase.ErrTaskNotCompleted) {
+		t.Errorf("Execute() on open task error = %v, want %v", err, usecase.ErrTaskNotCompleted)
+	}
+
+	usecase.NewCompleteTaskUseCase(taskRepo, charRepo, &mockUserPreferencesRepository{}, &mockUnitOfWork{}).Execute(context.Background(), usecase.TaskInput{TaskID: "task-123", UserID: "user-123"})
+
+	output, err := usecase.NewReopenTaskUseCase(taskRepo, charRepo, &mockUserPreferencesRepository{}, &mockUnitOfWork{}).Execute(context.Background(), usecase.TaskInput{TaskID: "task-123", UserID: "user-123"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	// The level gained by the completion is lost again
+	if output.XpGained != -40 || output.LevelsGained != -1 {
+		t.Errorf("XpGained, LevelsGained = %v, %v, want -40, -1", output.XpGained, output.LevelsGained)
+	}
+	if character.Level() != 1 || character.CurrentXp() != 80 || character.TotalXp() != 80 {
+		t.Errorf("character = level %v, %v/%v XP, want level 1, 80/80 XP", character.Level(), character.CurrentXp(), character.TotalXp())
+	}
+	if task.IsCompleted() {
+		t.Error("IsCompleted() = true, want false")
+	}
+}
+
+func TestListTasksUseCase_OverdueFilter(t *testing.T) {
+	var got repository.TaskFilter
+	taskRepo := &mockTaskRepository{
+		findByUserIDFunc: func(ctx context.Context, userID string, filter repository.TaskFilter) ([]*entity.Task, error) {
+			got = filter
+			return []*entity.Task{}, nil
+		},
+	}
+	uc := usecase.NewListTasksUseCase(taskRepo, &mockUserPreferencesRepository{})
+
+	if _, err := uc.Execute(context.Background(), usecase.ListTasksInput{UserID: "user-123", Status: "overdue"}); err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	// Overdue tasks are open tasks due before today
+	now := time.Now().UTC()
+	yesterday := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+	if got.Status != entity.TaskOpen || got.DueTo == nil || !got.DueTo.Equal(yesterday) {
+		t.Errorf("filter = %+v, want open tasks due until %v", got, yesterday)
+	}
+
+	if _, err := uc.Execute(context.Background(), usecase.ListTasksInput{UserID: "user-123", Status: "done"}); !errors.Is(err, usecase.ErrInvalidTaskStatus) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrInvalidTaskStatus)
+	}
+}
+
+func TestReopenTaskUseCase_ConcurrentReopen(t *testing.T) {
+	taskRepo, charRepo, _, character := newTaskRewardFixture(1, 80, 80)
+	usecase.NewCompleteTaskUseCase(taskRepo, charRepo, &mockUserPreferencesRepository{}, &mockUnitOfWork{}).Execute(context.Background(), usecase.TaskInput{TaskID: "task-123", UserID: "user-123"})
+
+	// Another request reopened the task after it was loaded
+	taskRepo.updateCompletionFunc = func(ctx context.Context, task *entity.Task) error {
+		return repository.ErrTaskCompletionChanged
+	}
+
+	_, err := usecase.NewReopenTaskUseCase(taskRepo, charRepo, &mockUserPreferencesRepository{}, &mockUnitOfWork{}).Execute(context.Background(), usecase.TaskInput{TaskID: "task-123", UserID: "user-123"})
+	if !errors.Is(err, usecase.ErrTaskNotCompleted) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrTaskNotCompleted)
+	}
+	if character.TotalXp() != 120 {
+		t.Errorf("TotalXp() = %v, want 120", character.TotalXp())
+	}
+}
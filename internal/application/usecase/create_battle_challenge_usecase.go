@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+var (
+	// ErrOpponentNotFound is returned when the challenged character doesn't exist
+	ErrOpponentNotFound = errors.New("opponent character not found")
+
+	// ErrInvalidBattleChallenge is returned when a character challenges itself or another character of the same user
+	ErrInvalidBattleChallenge = errors.New("invalid battle challenge")
+
+	// ErrBattleChallengeNotFound is returned when a challenge doesn't exist or wasn't sent to the user
+	ErrBattleChallengeNotFound = errors.New("battle challenge not found or not addressed to user")
+
+	// ErrBattleChallengeExpired is returned when answering a challenge after its expiration
+	ErrBattleChallengeExpired = errors.New("battle challenge has expired")
+
+	// ErrBattleChallengeAnswered is returned when answering a challenge that was already accepted or declined
+	ErrBattleChallengeAnswered = errors.New("battle challenge was already answered")
+)
+
+// CreateBattleChallengeInput represents the input for challenging another character
+type CreateBattleChallengeInput struct {
+	CharacterID         string // Challenger, owned by the authenticated user
+	UserID              string // User ID from authentication token
+	OpponentCharacterID string
+	Ranked              bool // Ranked battles update both characters' ratings
+}
+
+// BattleChallengeInput identifies a challenge answered by the authenticated user
+type BattleChallengeInput struct {
+	ChallengeID string
+	UserID      string // User ID from authentication token
+}
+
+// BattleChallengeOutput represents a challenge in the output of the challenge use cases
+type BattleChallengeOutput struct {
+	ID                    string
+	ChallengerCharacterID string
+	OpponentCharacterID   string
+	Ranked                bool
+	Status                string // pending, accepted, declined or expired
+	BattleID              string // Empty until accepted
+	CreatedAt             string
+	ExpiresAt             string
+	RespondedAt           string // Empty until answered
+}
+
+// CreateBattleChallengeUseCase handles challenging another character to a PvP battle
+type CreateBattleChallengeUseCase struct {
+	characterRepo       repository.CharacterRepository
+	battleChallengeRepo repository.BattleChallengeRepository
+	characterEnergyRepo repository.CharacterEnergyRepository
+	expiresIn           time.Duration // How long the opponent has to answer
+}
+
+// NewCreateBattleChallengeUseCase creates a new CreateBattleChallengeUseCase
+func NewCreateBattleChallengeUseCase(
+	characterRepo repository.CharacterRepository,
+	battleChallengeRepo repository.BattleChallengeRepository,
+	characterEnergyRepo repository.CharacterEnergyRepository,
+	expiresIn time.Duration,
+) *CreateBattleChallengeUseCase {
+	return &CreateBattleChallengeUseCase{
+		characterRepo:       characterRepo,
+		battleChallengeRepo: battleChallengeRepo,
+		characterEnergyRepo: characterEnergyRepo,
+		expiresIn:           expiresIn,
+	}
+}
+
+// Execute creates a pending challenge the opponent can accept or decline until it expires
+func (uc *CreateBattleChallengeUseCase) Execute(ctx context.Context, input CreateBattleChallengeInput) (*BattleChallengeOutput, error) {
+	// 1. Validate challenger exists AND belongs to the authenticated user
+	challenger, err := uc.characterRepo.FindByIDAndUserID(ctx, input.CharacterID, input.UserID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+
+	// 2. Validate opponent exists and belongs to someone else
+	if input.OpponentCharacterID == challenger.ID() {
+		return nil, fmt.Errorf("%w: a character cannot challenge itself", ErrInvalidBattleChallenge)
+	}
+
+	opponent, err := uc.characterRepo.FindByID(ctx, input.OpponentCharacterID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrOpponentNotFound, input.OpponentCharacterID)
+	}
+	if opponent.UserID() == challenger.UserID() {
+		return nil, fmt.Errorf("%w: characters of the same user cannot challenge each other", ErrInvalidBattleChallenge)
+	}
+
+	// 3. The challenger must be able to pay for the battle (it pays when the challenge is accepted)
+	now := time.Now().UTC()
+	energy, err := currentCharacterEnergy(ctx, uc.characterEnergyRepo, challenger.ID(), now)
+	if err != nil {
+		return nil, err
+	}
+	if err := checkBattleEnergy(energy, now); err != nil {
+		return nil, err
+	}
+
+	// 4. Create and persist the challenge
+	challenge, err := entity.NewBattleChallenge(uuid.New().String(), challenger.ID(), opponent.ID(), input.Ranked, now, uc.expiresIn)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create battle challenge: %w", err)
+	}
+
+	if err := uc.battleChallengeRepo.Create(ctx, challenge); err != nil {
+		return nil, fmt.Errorf("failed to save battle challenge: %w", err)
+	}
+
+	output := mapBattleChallengeEntityToOutput(challenge, challenge.CreatedAt())
+	return &output, nil
+}
+
+// findChallengeForOpponent retrieves a challenge and validates it was sent to one of the user's characters
+func findChallengeForOpponent(
+	ctx context.Context,
+	battleChallengeRepo repository.BattleChallengeRepository,
+	characterRepo repository.CharacterRepository,
+	input BattleChallengeInput,
+) (*entity.BattleChallenge, *entity.Character, error) {
+	challenge, err := battleChallengeRepo.FindByID(ctx, input.ChallengeID)
+	if err != nil {
+		return nil, nil, ErrBattleChallengeNotFound
+	}
+
+	opponent, err := characterRepo.FindByIDAndUserID(ctx, challenge.OpponentCharacterID(), input.UserID)
+	if err != nil {
+		return nil, nil, ErrBattleChallengeNotFound
+	}
+
+	return challenge, opponent, nil
+}
+
+// checkChallengeAnswerable maps a challenge that can no longer be answered to its error
+func checkChallengeAnswerable(challenge *entity.BattleChallenge, now time.Time) error {
+	switch challenge.Status(now) {
+	case entity.BattleChallengeExpired:
+		return ErrBattleChallengeExpired
+	case entity.BattleChallengeAccepted, entity.BattleChallengeDeclined:
+		return ErrBattleChallengeAnswered
+	}
+	return nil
+}
+
+// mapBattleChallengeEntityToOutput converts a BattleChallenge entity to output format
+// now is used to report pending challenges past their expiration as expired
+func mapBattleChallengeEntityToOutput(challenge *entity.BattleChallenge, now time.Time) BattleChallengeOutput {
+	output := BattleChallengeOutput{
+		ID:                    challenge.ID(),
+		ChallengerCharacterID: challenge.ChallengerCharacterID(),
+		OpponentCharacterID:   challenge.OpponentCharacterID(),
+		Ranked:                challenge.IsRanked(),
+		Status:                challenge.Status(now),
+		BattleID:              challenge.BattleID(),
+		CreatedAt:             challenge.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		ExpiresAt:             challenge.ExpiresAt().Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if respondedAt := challenge.RespondedAt(); respondedAt != nil {
+		output.RespondedAt = respondedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	return output
+}
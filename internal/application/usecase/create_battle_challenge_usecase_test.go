@@ -0,0 +1,208 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Mock BattleChallengeRepository: keeps challenges in memory and answers only pending ones
+type mockBattleChallengeRepository struct {
+	challenges map[string]*entity.BattleChallenge
+	respondErr error
+}
+
+func newMockBattleChallengeRepository(challenges ...*entity.BattleChallenge) *mockBattleChallengeRepository {
+	repo := &mockBattleChallengeRepository{challenges: map[string]*entity.BattleChallenge{}}
+	for _, challenge := range challenges {
+		repo.challenges[challenge.ID()] = challenge
+	}
+	return repo
+}
+
+func (m *mockBattleChallengeRepository) Create(ctx context.Context, challenge *entity.BattleChallenge) error {
+	m.challenges[challenge.ID()] = challenge
+	return nil
+}
+
+func (m *mockBattleChallengeRepository) FindByID(ctx context.Context, id string) (*entity.BattleChallenge, error) {
+	if challenge, ok := m.challenges[id]; ok {
+		return challenge, nil
+	}
+	return nil, errors.New("battle challenge not found")
+}
+
+func (m *mockBattleChallengeRepository) FindPendingByCharacterID(ctx context.Context, characterID string, now time.Time) ([]*entity.BattleChallenge, error) {
+	var challenges []*entity.BattleChallenge
+	for _, challenge := range m.challenges {
+		if challenge.IsParticipant(characterID) && challenge.Status(now) == entity.BattleChallengePending {
+			challenges = append(challenges, challenge)
+		}
+	}
+	return challenges, nil
+}
+
+func (m *mockBattleChallengeRepository) Respond(ctx context.Context, challenge *entity.BattleChallenge) error {
+	if m.respondErr != nil {
+		return m.respondErr
+	}
+	m.challenges[challenge.ID()] = challenge
+	return nil
+}
+
+// newDuelistRepository returns a character repository with char-123 (user-123) and char-456 (user-456),
+// plus char-789, a second character of user-123
+func newDuelistRepository() *mockCharacterRepositoryForHabits {
+	characters := map[string]*entity.Character{
+		"char-123": entity.ReconstituteCharacter("char-123", "Hero", valueobject.DefaultCharacterClass(), 3, 0, 0, 0, "user-123", time.Now()),
+		"char-456": entity.ReconstituteCharacter("char-456", "Rival", valueobject.DefaultCharacterClass(), 3, 0, 0, 0, "user-456", time.Now()),
+		"char-789": entity.ReconstituteCharacter("char-789", "Alt", valueobject.DefaultCharacterClass(), 1, 0, 0, 0, "user-123", time.Now()),
+	}
+
+	return &mockCharacterRepositoryForHabits{
+		findByIDFunc: func(ctx context.Context, id string) (*entity.Character, error) {
+			if character, ok := characters[id]; ok {
+				return character, nil
+			}
+			return nil, errors.New("character not found")
+		},
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Character, error) {
+			if character, ok := characters[id]; ok && character.UserID() == userID {
+				return character, nil
+			}
+			return nil, errors.New("character not found or does not belong to user")
+		},
+	}
+}
+
+func TestCreateBattleChallengeUseCase_Execute_Success(t *testing.T) {
+	challengeRepo := newMockBattleChallengeRepository()
+	uc := usecase.NewCreateBattleChallengeUseCase(newDuelistRepository(), challengeRepo, newMockCharacterEnergyRepository(), 2*time.Hour)
+
+	output, err := uc.Execute(context.Background(), usecase.CreateBattleChallengeInput{
+		CharacterID:         "char-123",
+		UserID:              "user-123",
+		OpponentCharacterID: "char-456",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.Status != entity.BattleChallengePending {
+		t.Errorf("Status = %v, want %v", output.Status, entity.BattleChallengePending)
+	}
+
+	if output.ChallengerCharacterID != "char-123" || output.OpponentCharacterID != "char-456" {
+		t.Errorf("challenge = %s vs %s, want char-123 vs char-456", output.ChallengerCharacterID, output.OpponentCharacterID)
+	}
+
+	saved, ok := challengeRepo.challenges[output.ID]
+	if !ok {
+		t.Fatal("challenge was not saved")
+	}
+
+	// The answer window comes from configuration
+	if got := saved.ExpiresAt().Sub(saved.CreatedAt()); got != 2*time.Hour {
+		t.Errorf("answer window = %v, want %v", got, 2*time.Hour)
+	}
+}
+
+func TestCreateBattleChallengeUseCase_Execute_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   usecase.CreateBattleChallengeInput
+		wantErr error
+	}{
+		{
+			name:    "challenger of another user",
+			input:   usecase.CreateBattleChallengeInput{CharacterID: "char-456", UserID: "user-123", OpponentCharacterID: "char-123"},
+			wantErr: usecase.ErrCharacterNotFound,
+		},
+		{
+			name:    "unknown opponent",
+			input:   usecase.CreateBattleChallengeInput{CharacterID: "char-123", UserID: "user-123", OpponentCharacterID: "char-000"},
+			wantErr: usecase.ErrOpponentNotFound,
+		},
+		{
+			name:    "self challenge",
+			input:   usecase.CreateBattleChallengeInput{CharacterID: "char-123", UserID: "user-123", OpponentCharacterID: "char-123"},
+			wantErr: usecase.ErrInvalidBattleChallenge,
+		},
+		{
+			name:    "character of the same user",
+			input:   usecase.CreateBattleChallengeInput{CharacterID: "char-123", UserID: "user-123", OpponentCharacterID: "char-789"},
+			wantErr: usecase.ErrInvalidBattleChallenge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			challengeRepo := newMockBattleChallengeRepository()
+			uc := usecase.NewCreateBattleChallengeUseCase(newDuelistRepository(), challengeRepo, newMockCharacterEnergyRepository(), time.Hour)
+
+			_, err := uc.Execute(context.Background(), tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Execute() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if len(challengeRepo.challenges) != 0 {
+				t.Error("no challenge should be saved")
+			}
+		})
+	}
+}
+
+func TestCreateBattleChallengeUseCase_Execute_NotEnoughEnergy(t *testing.T) {
+	energyRepo := newMockCharacterEnergyRepository()
+	energyRepo.setEnergy("char-123", 0, time.Now().UTC())
+	challengeRepo := newMockBattleChallengeRepository()
+	uc := usecase.NewCreateBattleChallengeUseCase(newDuelistRepository(), challengeRepo, energyRepo, time.Hour)
+
+	_, err := uc.Execute(context.Background(), usecase.CreateBattleChallengeInput{
+		CharacterID:         "char-123",
+		UserID:              "user-123",
+		OpponentCharacterID: "char-456",
+	})
+	if !errors.Is(err, usecase.ErrNotEnoughEnergy) {
+		t.Fatalf("Execute() error = %v, want %v", err, usecase.ErrNotEnoughEnergy)
+	}
+
+	if len(challengeRepo.challenges) != 0 {
+		t.Error("no challenge should be sent")
+	}
+}
+
+func TestListBattleChallengesUseCase_Execute_SkipsExpired(t *testing.T) {
+	now := time.Now().UTC()
+
+	sent, _ := entity.NewBattleChallenge("challenge-sent", "char-123", "char-456", false, now.Add(-time.Hour), 24*time.Hour)
+	received, _ := entity.NewBattleChallenge("challenge-received", "char-456", "char-123", false, now.Add(-time.Hour), 24*time.Hour)
+	expired, _ := entity.NewBattleChallenge("challenge-expired", "char-456", "char-123", false, now.Add(-2*time.Hour), time.Hour)
+
+	uc := usecase.NewListBattleChallengesUseCase(newDuelistRepository(), newMockBattleChallengeRepository(sent, received, expired))
+
+	outputs, err := uc.Execute(context.Background(), usecase.ListBattleChallengesInput{CharacterID: "char-123", UserID: "user-123"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if len(outputs) != 2 {
+		t.Fatalf("Execute() returned %d challenges, want 2", len(outputs))
+	}
+
+	for _, output := range outputs {
+		if output.ID == "challenge-expired" {
+			t.Error("expired challenge should not be listed")
+		}
+	}
+
+	_, err = uc.Execute(context.Background(), usecase.ListBattleChallengesInput{CharacterID: "char-123", UserID: "user-456"})
+	if !errors.Is(err, usecase.ErrCharacterNotFound) {
+		t.Errorf("Execute() for another user error = %v, want %v", err, usecase.ErrCharacterNotFound)
+	}
+}
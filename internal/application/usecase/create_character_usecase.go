@@ -5,27 +5,16 @@ import (
 	"fmt"
 
 	"github.com/google/uuid"
+	"github.com/igor/chronotask-api/internal/application/port"
 	"github.com/igor/chronotask-api/internal/domain/entity"
 	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
 )
 
-// Base attributes that every new character starts with
-var baseAttributes = []struct {
-	name  string
-	value int
-}{
-	{"Força", 5},
-	{"Constituição", 5},
-	{"Vontade", 5},
-	{"Sabedoria", 5},
-	{"Inteligência", 5},
-	{"Carisma", 5},
-	{"Destreza", 5},
-}
-
 // CreateCharacterInput represents the input for creating a character
 type CreateCharacterInput struct {
 	Name   string
+	Class  string // Optional, defaults to the balanced adventurer class
 	UserID string
 }
 
@@ -33,6 +22,7 @@ type CreateCharacterInput struct {
 type CreateCharacterOutput struct {
 	ID        string
 	Name      string
+	Class     string
 	Level     int
 	CurrentXp int
 	TotalXp   int
@@ -44,16 +34,19 @@ type CreateCharacterOutput struct {
 type CreateCharacterUseCase struct {
 	characterRepo          repository.CharacterRepository
 	characterAttributeRepo repository.CharacterAttributeRepository
+	unitOfWork             port.UnitOfWork
 }
 
 // NewCreateCharacterUseCase creates a new CreateCharacterUseCase
 func NewCreateCharacterUseCase(
 	characterRepo repository.CharacterRepository,
 	characterAttributeRepo repository.CharacterAttributeRepository,
+	unitOfWork port.UnitOfWork,
 ) *CreateCharacterUseCase {
 	return &CreateCharacterUseCase{
 		characterRepo:          characterRepo,
 		characterAttributeRepo: characterAttributeRepo,
+		unitOfWork:             unitOfWork,
 	}
 }
 
@@ -68,6 +61,15 @@ func (uc *CreateCharacterUseCase) Execute(ctx context.Context, input CreateChara
 		return nil, fmt.Errorf("user already has a character")
 	}
 
+	// Resolve the class (characters created without one are adventurers)
+	class := valueobject.DefaultCharacterClass()
+	if input.Class != "" {
+		class, err = valueobject.NewCharacterClass(input.Class)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create character: %w", err)
+		}
+	}
+
 	// Generate unique ID
 	characterID := uuid.New().String()
 
@@ -75,37 +77,50 @@ func (uc *CreateCharacterUseCase) Execute(ctx context.Context, input CreateChara
 	character, err := entity.NewCharacter(
 		characterID,
 		input.Name,
+		class,
 		input.UserID,
 	)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create character: %w", err)
 	}
 
-	// Persist character
-	if err := uc.characterRepo.Create(ctx, character); err != nil {
-		return nil, fmt.Errorf("failed to save character: %w", err)
-	}
-
-	// Create base attributes for the new character
+	// Create the base attributes of the character's class
+	baseAttributes := class.Attributes()
+	attributes := make([]*entity.CharacterAttribute, 0, len(baseAttributes))
 	for _, baseAttr := range baseAttributes {
 		attribute, err := entity.NewCharacterAttribute(
-			baseAttr.name,
-			baseAttr.value,
+			baseAttr.Name,
+			baseAttr.Base,
 			character.ID(),
 		)
 		if err != nil {
-			return nil, fmt.Errorf("failed to create base attribute '%s': %w", baseAttr.name, err)
+			return nil, fmt.Errorf("failed to create base attribute '%s': %w", baseAttr.Name, err)
+		}
+		attributes = append(attributes, attribute)
+	}
+
+	// Persist character and attributes together: a character is never left with partial attributes
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		if err := uc.characterRepo.Create(ctx, character); err != nil {
+			return fmt.Errorf("failed to save character: %w", err)
 		}
 
-		if err := uc.characterAttributeRepo.Create(ctx, attribute); err != nil {
-			return nil, fmt.Errorf("failed to save base attribute '%s': %w", baseAttr.name, err)
+		for _, attribute := range attributes {
+			if err := uc.characterAttributeRepo.Create(ctx, attribute); err != nil {
+				return fmt.Errorf("failed to save base attribute '%s': %w", attribute.AttributeName(), err)
+			}
 		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
 	// Return output
 	return &CreateCharacterOutput{
 		ID:        character.ID(),
 		Name:      character.Name(),
+		Class:     character.Class().Value(),
 		Level:     character.Level(),
 		CurrentXp: character.CurrentXp(),
 		TotalXp:   character.TotalXp(),
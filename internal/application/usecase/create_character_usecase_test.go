@@ -54,6 +54,20 @@ func (m *mockCharacterAttributeRepository) FindByCharacterIDAndName(ctx context.
 	return nil, errors.New("not implemented")
 }
 
+func (m *mockCharacterAttributeRepository) FindByCharacterIDForUpdate(ctx context.Context, characterID string) ([]*entity.CharacterAttribute, error) {
+	if !inUnitOfWork(ctx) {
+		return nil, errors.New("attributes locked outside a unit of work")
+	}
+	return m.FindByCharacterID(ctx, characterID)
+}
+
+func (m *mockCharacterAttributeRepository) FindByCharacterIDAndNameForUpdate(ctx context.Context, characterID string, attributeName string) (*entity.CharacterAttribute, error) {
+	if !inUnitOfWork(ctx) {
+		return nil, errors.New("attribute locked outside a unit of work")
+	}
+	return m.FindByCharacterIDAndName(ctx, characterID, attributeName)
+}
+
 func (m *mockCharacterAttributeRepository) Update(ctx context.Context, attribute *entity.CharacterAttribute) error {
 	if m.updateFunc != nil {
 		return m.updateFunc(ctx, attribute)
@@ -86,6 +100,10 @@ func (m *mockCharacterRepository) FindByID(ctx context.Context, id string) (*ent
 	return nil, errors.New("not implemented")
 }
 
+func (m *mockCharacterRepository) FindByIDForUpdate(ctx context.Context, id string) (*entity.Character, error) {
+	return nil, errors.New("not implemented")
+}
+
 func (m *mockCharacterRepository) FindByIDAndUserID(ctx context.Context, id string, userID string) (*entity.Character, error) {
 	return nil, errors.New("not implemented")
 }
@@ -113,6 +131,29 @@ func (m *mockCharacterRepository) ExistsByUserID(ctx context.Context, userID str
 	return false, nil
 }
 
+// unitOfWorkContextKey marks the contexts handed out by mockUnitOfWork
+type unitOfWorkContextKey struct{}
+
+// Mock UnitOfWork: runs fn directly and records whether the work was committed or rolled back
+type mockUnitOfWork struct {
+	commits   int
+	rollbacks int
+}
+
+func (m *mockUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	if err := fn(context.WithValue(ctx, unitOfWorkContextKey{}, m)); err != nil {
+		m.rollbacks++
+		return err
+	}
+	m.commits++
+	return nil
+}
+
+// inUnitOfWork reports whether ctx belongs to a running unit of work
+func inUnitOfWork(ctx context.Context) bool {
+	return ctx.Value(unitOfWorkContextKey{}) != nil
+}
+
 func TestCreateCharacterUseCase_Execute_Success(t *testing.T) {
 	mockRepo := &mockCharacterRepository{
 		existsByUserIDFunc: func(ctx context.Context, userID string) (bool, error) {
@@ -129,7 +170,7 @@ func TestCreateCharacterUseCase_Execute_Success(t *testing.T) {
 		},
 	}
 
-	useCase := usecase.NewCreateCharacterUseCase(mockRepo, mockAttrRepo)
+	useCase := usecase.NewCreateCharacterUseCase(mockRepo, mockAttrRepo, &mockUnitOfWork{})
 
 	input := usecase.CreateCharacterInput{
 		Name:   "Warrior King",
@@ -184,7 +225,7 @@ func TestCreateCharacterUseCase_Execute_UserAlreadyHasCharacter(t *testing.T) {
 
 	mockAttrRepo := &mockCharacterAttributeRepository{}
 
-	useCase := usecase.NewCreateCharacterUseCase(mockRepo, mockAttrRepo)
+	useCase := usecase.NewCreateCharacterUseCase(mockRepo, mockAttrRepo, &mockUnitOfWork{})
 
 	input := usecase.CreateCharacterInput{
 		Name:   "Warrior King",
@@ -216,7 +257,7 @@ func TestCreateCharacterUseCase_Execute_ExistsByUserIDError(t *testing.T) {
 
 	mockAttrRepo := &mockCharacterAttributeRepository{}
 
-	useCase := usecase.NewCreateCharacterUseCase(mockRepo, mockAttrRepo)
+	useCase := usecase.NewCreateCharacterUseCase(mockRepo, mockAttrRepo, &mockUnitOfWork{})
 
 	input := usecase.CreateCharacterInput{
 		Name:   "Warrior King",
@@ -243,7 +284,7 @@ func TestCreateCharacterUseCase_Execute_InvalidCharacterName(t *testing.T) {
 
 	mockAttrRepo := &mockCharacterAttributeRepository{}
 
-	useCase := usecase.NewCreateCharacterUseCase(mockRepo, mockAttrRepo)
+	useCase := usecase.NewCreateCharacterUseCase(mockRepo, mockAttrRepo, &mockUnitOfWork{})
 
 	tests := []struct {
 		name          string
@@ -286,7 +327,7 @@ func TestCreateCharacterUseCase_Execute_CreateRepositoryError(t *testing.T) {
 
 	mockAttrRepo := &mockCharacterAttributeRepository{}
 
-	useCase := usecase.NewCreateCharacterUseCase(mockRepo, mockAttrRepo)
+	useCase := usecase.NewCreateCharacterUseCase(mockRepo, mockAttrRepo, &mockUnitOfWork{})
 
 	input := usecase.CreateCharacterInput{
 		Name:   "Warrior King",
@@ -313,7 +354,7 @@ func TestCreateCharacterUseCase_Execute_ContextCancellation(t *testing.T) {
 
 	mockAttrRepo := &mockCharacterAttributeRepository{}
 
-	useCase := usecase.NewCreateCharacterUseCase(mockRepo, mockAttrRepo)
+	useCase := usecase.NewCreateCharacterUseCase(mockRepo, mockAttrRepo, &mockUnitOfWork{})
 
 	input := usecase.CreateCharacterInput{
 		Name:   "Warrior King",
@@ -375,7 +416,7 @@ func TestCreateCharacterUseCase_Execute_CreatesBaseAttributes(t *testing.T) {
 		},
 	}
 
-	useCase := usecase.NewCreateCharacterUseCase(mockRepo, mockAttrRepo)
+	useCase := usecase.NewCreateCharacterUseCase(mockRepo, mockAttrRepo, &mockUnitOfWork{})
 
 	input := usecase.CreateCharacterInput{
 		Name:   "Warrior King",
@@ -415,7 +456,7 @@ func TestCreateCharacterUseCase_Execute_AttributeCreationError(t *testing.T) {
 		},
 	}
 
-	useCase := usecase.NewCreateCharacterUseCase(mockRepo, mockAttrRepo)
+	useCase := usecase.NewCreateCharacterUseCase(mockRepo, mockAttrRepo, &mockUnitOfWork{})
 
 	input := usecase.CreateCharacterInput{
 		Name:   "Warrior King",
@@ -432,3 +473,147 @@ func TestCreateCharacterUseCase_Execute_AttributeCreationError(t *testing.T) {
 		t.Errorf("Execute() output = %v, want nil", output)
 	}
 }
+
+func TestCreateCharacterUseCase_Execute_AttributeCreationErrorRollsBack(t *testing.T) {
+	writesOutsideUnitOfWork := 0
+	mockRepo := &mockCharacterRepository{
+		existsByUserIDFunc: func(ctx context.Context, userID string) (bool, error) {
+			return false, nil
+		},
+		createFunc: func(ctx context.Context, character *entity.Character) error {
+			if !inUnitOfWork(ctx) {
+				writesOutsideUnitOfWork++
+			}
+			return nil
+		},
+	}
+
+	// The fourth attribute fails after the character and three attributes were written
+	attributesCreated := 0
+	mockAttrRepo := &mockCharacterAttributeRepository{
+		createFunc: func(ctx context.Context, attribute *entity.CharacterAttribute) error {
+			if !inUnitOfWork(ctx) {
+				writesOutsideUnitOfWork++
+			}
+			attributesCreated++
+			if attributesCreated == 4 {
+				return errors.New("connection reset")
+			}
+			return nil
+		},
+	}
+
+	unitOfWork := &mockUnitOfWork{}
+	useCase := usecase.NewCreateCharacterUseCase(mockRepo, mockAttrRepo, unitOfWork)
+
+	_, err := useCase.Execute(context.Background(), usecase.CreateCharacterInput{
+		Name:   "Warrior King",
+		UserID: "user-123",
+	})
+
+	if err == nil {
+		t.Fatal("Execute() error = nil, want error for attribute creation failure")
+	}
+
+	if writesOutsideUnitOfWork != 0 {
+		t.Errorf("%d writes happened outside the unit of work, want 0", writesOutsideUnitOfWork)
+	}
+
+	if unitOfWork.rollbacks != 1 || unitOfWork.commits != 0 {
+		t.Errorf("unit of work commits = %d, rollbacks = %d, want 0 and 1", unitOfWork.commits, unitOfWork.rollbacks)
+	}
+}
+
+func TestCreateCharacterUseCase_Execute_WithClass(t *testing.T) {
+	mockRepo := &mockCharacterRepository{
+		existsByUserIDFunc: func(ctx context.Context, userID string) (bool, error) {
+			return false, nil
+		},
+		createFunc: func(ctx context.Context, character *entity.Character) error {
+			return nil
+		},
+	}
+
+	// A mage starts strong in Inteligência and weak in Força
+	attributes := map[string]int{}
+	mockAttrRepo := &mockCharacterAttributeRepository{
+		createFunc: func(ctx context.Context, attribute *entity.CharacterAttribute) error {
+			attributes[attribute.AttributeName()] = attribute.Value()
+			return nil
+		},
+	}
+
+	useCase := usecase.NewCreateCharacterUseCase(mockRepo, mockAttrRepo, &mockUnitOfWork{})
+
+	output, err := useCase.Execute(context.Background(), usecase.CreateCharacterInput{
+		Name:   "Merlin",
+		Class:  "Mago",
+		UserID: "user-123",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.Class != "mago" {
+		t.Errorf("output.Class = %v, want mago", output.Class)
+	}
+	if len(attributes) != 7 {
+		t.Fatalf("Created %d attributes, want 7", len(attributes))
+	}
+	if attributes["Inteligência"] != 8 || attributes["Força"] != 3 {
+		t.Errorf("Inteligência = %d, Força = %d, want 8 and 3", attributes["Inteligência"], attributes["Força"])
+	}
+}
+
+func TestCreateCharacterUseCase_Execute_DefaultsToAdventurer(t *testing.T) {
+	mockRepo := &mockCharacterRepository{
+		existsByUserIDFunc: func(ctx context.Context, userID string) (bool, error) {
+			return false, nil
+		},
+		createFunc: func(ctx context.Context, character *entity.Character) error {
+			return nil
+		},
+	}
+
+	useCase := usecase.NewCreateCharacterUseCase(mockRepo, &mockCharacterAttributeRepository{}, &mockUnitOfWork{})
+
+	output, err := useCase.Execute(context.Background(), usecase.CreateCharacterInput{
+		Name:   "Warrior King",
+		UserID: "user-123",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.Class != "aventureiro" {
+		t.Errorf("output.Class = %v, want aventureiro", output.Class)
+	}
+}
+
+func TestCreateCharacterUseCase_Execute_InvalidClass(t *testing.T) {
+	createCalled := false
+	mockRepo := &mockCharacterRepository{
+		existsByUserIDFunc: func(ctx context.Context, userID string) (bool, error) {
+			return false, nil
+		},
+		createFunc: func(ctx context.Context, character *entity.Character) error {
+			createCalled = true
+			return nil
+		},
+	}
+
+	useCase := usecase.NewCreateCharacterUseCase(mockRepo, &mockCharacterAttributeRepository{}, &mockUnitOfWork{})
+
+	_, err := useCase.Execute(context.Background(), usecase.CreateCharacterInput{
+		Name:   "Warrior King",
+		Class:  "necromante",
+		UserID: "user-123",
+	})
+	if err == nil {
+		t.Fatal("Execute() error = nil, want error")
+	}
+
+	if createCalled {
+		t.Error("Create() should not be called for an invalid class")
+	}
+}
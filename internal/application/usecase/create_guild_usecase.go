@@ -0,0 +1,104 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+var (
+	// ErrInvalidGuild is returned when a guild fails validation (e.g. its name)
+	ErrInvalidGuild = errors.New("invalid guild")
+
+	// ErrGuildNotFound is returned when a guild does not exist
+	ErrGuildNotFound = errors.New("guild not found")
+
+	// ErrAlreadyInGuild is returned when the character already belongs to a guild
+	ErrAlreadyInGuild = errors.New("character already belongs to a guild")
+
+	// ErrNotGuildMember is returned when the character doesn't belong to the guild
+	ErrNotGuildMember = errors.New("character is not a member of the guild")
+
+	// ErrNotGuildLeader is returned when a guild action is reserved to its leader
+	ErrNotGuildLeader = errors.New("character is not the leader of the guild")
+)
+
+// CreateGuildInput represents the input for founding a guild
+type CreateGuildInput struct {
+	CharacterID string // Founding character, which leads the guild
+	UserID      string // User ID from authentication token
+	Name        string
+}
+
+// GuildOutput represents a guild
+type GuildOutput struct {
+	ID                string
+	Name              string
+	LeaderCharacterID string
+	Members           int
+	CreatedAt         string
+}
+
+// CreateGuildUseCase handles founding a guild
+type CreateGuildUseCase struct {
+	characterRepo repository.CharacterRepository
+	guildRepo     repository.GuildRepository
+}
+
+// NewCreateGuildUseCase creates a new CreateGuildUseCase
+func NewCreateGuildUseCase(
+	characterRepo repository.CharacterRepository,
+	guildRepo repository.GuildRepository,
+) *CreateGuildUseCase {
+	return &CreateGuildUseCase{
+		characterRepo: characterRepo,
+		guildRepo:     guildRepo,
+	}
+}
+
+// Execute founds a guild led by the character, which becomes its first member
+func (uc *CreateGuildUseCase) Execute(ctx context.Context, input CreateGuildInput) (*GuildOutput, error) {
+	// 1. Validate character exists AND belongs to the authenticated user
+	character, err := uc.characterRepo.FindByIDAndUserID(ctx, input.CharacterID, input.UserID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+
+	// 2. A character belongs to one guild at a time
+	current, err := uc.guildRepo.FindByCharacterID(ctx, character.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch guild of character: %w", err)
+	}
+	if current != nil {
+		return nil, fmt.Errorf("%w: %s", ErrAlreadyInGuild, current.Name())
+	}
+
+	// 3. Create the guild (domain validates the name)
+	guild, err := entity.NewGuild(uuid.New().String(), input.Name, character.ID(), time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidGuild, err)
+	}
+
+	if err := uc.guildRepo.Create(ctx, guild); err != nil {
+		return nil, fmt.Errorf("failed to save guild: %w", err)
+	}
+
+	output := mapGuildToOutput(guild, 1)
+	return &output, nil
+}
+
+// mapGuildToOutput converts a Guild entity to output format
+func mapGuildToOutput(guild *entity.Guild, members int) GuildOutput {
+	return GuildOutput{
+		ID:                guild.ID(),
+		Name:              guild.Name(),
+		LeaderCharacterID: guild.LeaderCharacterID(),
+		Members:           members,
+		CreatedAt:         guild.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
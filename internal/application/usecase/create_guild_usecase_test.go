@@ -0,0 +1,145 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Mock GuildRepository
+type mockGuildRepository struct {
+	guilds  map[string]*entity.Guild
+	members map[string]string // Character ID -> guild ID
+}
+
+func newMockGuildRepository() *mockGuildRepository {
+	return &mockGuildRepository{guilds: map[string]*entity.Guild{}, members: map[string]string{}}
+}
+
+func (m *mockGuildRepository) Create(ctx context.Context, guild *entity.Guild) error {
+	m.guilds[guild.ID()] = guild
+	return m.AddMember(ctx, guild.ID(), guild.LeaderCharacterID(), guild.CreatedAt())
+}
+
+func (m *mockGuildRepository) FindByID(ctx context.Context, id string) (*entity.Guild, error) {
+	if guild, ok := m.guilds[id]; ok {
+		return guild, nil
+	}
+	return nil, errors.New("guild not found")
+}
+
+func (m *mockGuildRepository) FindByCharacterID(ctx context.Context, characterID string) (*entity.Guild, error) {
+	if guildID, ok := m.members[characterID]; ok {
+		return m.guilds[guildID], nil
+	}
+	return nil, nil
+}
+
+func (m *mockGuildRepository) AddMember(ctx context.Context, guildID string, characterID string, joinedAt time.Time) error {
+	if _, ok := m.members[characterID]; ok {
+		return errors.New("character already belongs to a guild")
+	}
+	m.members[characterID] = guildID
+	return nil
+}
+
+func (m *mockGuildRepository) CountMembers(ctx context.Context, guildID string) (int, error) {
+	count := 0
+	for _, id := range m.members {
+		if id == guildID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// newGuildCharacterRepository serves char-123 and char-456 (user-123) and char-789 (user-789)
+func newGuildCharacterRepository() *mockCharacterRepositoryForHabits {
+	owners := map[string]string{"char-123": "user-123", "char-456": "user-123", "char-789": "user-789"}
+	return &mockCharacterRepositoryForHabits{
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Character, error) {
+			if owner, ok := owners[id]; ok && owner == userID {
+				return entity.ReconstituteCharacter(id, "Hero", valueobject.DefaultCharacterClass(), 1, 0, 0, 0, userID, time.Now()), nil
+			}
+			return nil, errors.New("character not found or does not belong to user")
+		},
+	}
+}
+
+func TestCreateGuildUseCase_Execute(t *testing.T) {
+	guildRepo := newMockGuildRepository()
+	useCase := usecase.NewCreateGuildUseCase(newGuildCharacterRepository(), guildRepo)
+
+	output, err := useCase.Execute(context.Background(), usecase.CreateGuildInput{CharacterID: "char-123", UserID: "user-123", Name: "  Ordem da Aurora  "})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if output.Name != "Ordem da Aurora" || output.LeaderCharacterID != "char-123" || output.Members != 1 {
+		t.Errorf("Execute() = %+v, want Ordem da Aurora led by char-123 with 1 member", output)
+	}
+	if guildRepo.members["char-123"] != output.ID {
+		t.Errorf("leader guild = %q, want %q", guildRepo.members["char-123"], output.ID)
+	}
+
+	// A character belongs to one guild at a time
+	_, err = useCase.Execute(context.Background(), usecase.CreateGuildInput{CharacterID: "char-123", UserID: "user-123", Name: "Outra Guilda"})
+	if !errors.Is(err, usecase.ErrAlreadyInGuild) {
+		t.Errorf("Execute() twice error = %v, want ErrAlreadyInGuild", err)
+	}
+}
+
+func TestCreateGuildUseCase_Execute_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   usecase.CreateGuildInput
+		wantErr error
+	}{
+		{"character of another user", usecase.CreateGuildInput{CharacterID: "char-789", UserID: "user-123", Name: "Ordem da Aurora"}, usecase.ErrCharacterNotFound},
+		{"name too short", usecase.CreateGuildInput{CharacterID: "char-123", UserID: "user-123", Name: "Ab"}, usecase.ErrInvalidGuild},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase := usecase.NewCreateGuildUseCase(newGuildCharacterRepository(), newMockGuildRepository())
+
+			_, err := useCase.Execute(context.Background(), tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Execute() error = %v, want %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestJoinGuildUseCase_Execute(t *testing.T) {
+	charRepo := newGuildCharacterRepository()
+	guildRepo := newMockGuildRepository()
+	guild, err := usecase.NewCreateGuildUseCase(charRepo, guildRepo).Execute(context.Background(), usecase.CreateGuildInput{CharacterID: "char-123", UserID: "user-123", Name: "Ordem da Aurora"})
+	if err != nil {
+		t.Fatalf("CreateGuild Execute() error = %v, want nil", err)
+	}
+
+	useCase := usecase.NewJoinGuildUseCase(charRepo, guildRepo)
+
+	output, err := useCase.Execute(context.Background(), usecase.JoinGuildInput{GuildID: guild.ID, CharacterID: "char-789", UserID: "user-789"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if output.Members != 2 {
+		t.Errorf("Members = %d, want 2", output.Members)
+	}
+
+	_, err = useCase.Execute(context.Background(), usecase.JoinGuildInput{GuildID: guild.ID, CharacterID: "char-789", UserID: "user-789"})
+	if !errors.Is(err, usecase.ErrAlreadyInGuild) {
+		t.Errorf("Execute() twice error = %v, want ErrAlreadyInGuild", err)
+	}
+
+	_, err = useCase.Execute(context.Background(), usecase.JoinGuildInput{GuildID: "missing", CharacterID: "char-456", UserID: "user-123"})
+	if !errors.Is(err, usecase.ErrGuildNotFound) {
+		t.Errorf("Execute() unknown guild error = %v, want ErrGuildNotFound", err)
+	}
+}
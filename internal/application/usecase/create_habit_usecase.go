@@ -0,0 +1,203 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+var (
+	// ErrCharacterNotFound is returned when a character doesn't exist or doesn't belong to the user
+	ErrCharacterNotFound = errors.New("character not found or does not belong to user")
+
+	// ErrHabitNotFound is returned when a habit doesn't exist or doesn't belong to the user
+	ErrHabitNotFound = errors.New("habit not found or does not belong to user")
+
+	// ErrAttributeNotFound is returned when the linked attribute doesn't exist for the character
+	ErrAttributeNotFound = errors.New("attribute not found for character")
+)
+
+// CreateHabitInput represents the input for creating a habit
+type CreateHabitInput struct {
+	UserID        string // User ID from authentication token
+	CharacterID   string
+	Title         string
+	Description   string
+	AttributeName string
+	Difficulty    string
+	Recurrence    *RecurrenceInput // Optional, defaults to daily
+	Negative      bool             // Bad habit: logging it drains the linked attribute
+	DrainsXp      bool             // Negative habits only: logging it also costs XP
+}
+
+// RecurrenceInput represents a habit schedule as received from the client
+// Only the fields relevant to Type are used
+type RecurrenceInput struct {
+	Type     string   // daily, weekly, interval, times_per_period
+	Weekdays []string // weekly: mon, tue, ...
+	Interval int      // interval: every N days
+	Times    int      // times_per_period: N times...
+	Period   string   // times_per_period: ...per week or month
+}
+
+// RecurrenceOutput represents a habit schedule in the output of the habit use cases
+type RecurrenceOutput struct {
+	Type     string
+	Weekdays []string
+	Interval int
+	Times    int
+	Period   string
+	Rule     string // RRULE-style representation
+}
+
+// StreakOutput represents the current and longest streaks of a habit
+type StreakOutput struct {
+	Current int
+	Longest int
+}
+
+// HabitOutput represents a single habit in the output of the habit use cases
+type HabitOutput struct {
+	ID            string
+	CharacterID   string
+	Title         string
+	Description   string
+	AttributeName string
+	Difficulty    string
+	Recurrence    RecurrenceOutput
+	Streak        *StreakOutput // Only filled when reading habits (list and get)
+	Negative      bool
+	DrainsXp      bool
+	Active        bool
+	CreatedAt     string
+	UpdatedAt     string
+}
+
+// CreateHabitUseCase handles the creation of new habits
+type CreateHabitUseCase struct {
+	habitRepo              repository.HabitRepository
+	characterRepo          repository.CharacterRepository
+	characterAttributeRepo repository.CharacterAttributeRepository
+}
+
+// NewCreateHabitUseCase creates a new CreateHabitUseCase
+func NewCreateHabitUseCase(
+	habitRepo repository.HabitRepository,
+	characterRepo repository.CharacterRepository,
+	characterAttributeRepo repository.CharacterAttributeRepository,
+) *CreateHabitUseCase {
+	return &CreateHabitUseCase{
+		habitRepo:              habitRepo,
+		characterRepo:          characterRepo,
+		characterAttributeRepo: characterAttributeRepo,
+	}
+}
+
+// Execute creates a new habit for one of the user's characters
+func (uc *CreateHabitUseCase) Execute(ctx context.Context, input CreateHabitInput) (*HabitOutput, error) {
+	// Validate character exists AND belongs to the authenticated user
+	if _, err := uc.characterRepo.FindByIDAndUserID(ctx, input.CharacterID, input.UserID); err != nil {
+		return nil, ErrCharacterNotFound
+	}
+
+	// Validate the linked attribute exists for the character
+	exists, err := uc.characterAttributeRepo.ExistsByCharacterIDAndName(ctx, input.CharacterID, input.AttributeName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check if attribute exists: %w", err)
+	}
+	if !exists {
+		return nil, ErrAttributeNotFound
+	}
+
+	// Validate difficulty
+	difficulty, err := valueobject.NewDifficulty(input.Difficulty)
+	if err != nil {
+		return nil, fmt.Errorf("invalid difficulty: %w", err)
+	}
+
+	// Validate recurrence (daily when not informed)
+	recurrence := valueobject.NewDailyRecurrence()
+	if input.Recurrence != nil {
+		recurrence, err = buildRecurrence(*input.Recurrence)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Create habit entity (with domain validation)
+	habit, err := entity.NewHabit(
+		uuid.New().String(),
+		input.Title,
+		input.Description,
+		input.CharacterID,
+		input.AttributeName,
+		difficulty,
+		recurrence,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create habit: %w", err)
+	}
+	if input.Negative {
+		habit.MakeNegative(input.DrainsXp)
+	}
+
+	// Persist habit
+	if err := uc.habitRepo.Create(ctx, habit); err != nil {
+		return nil, fmt.Errorf("failed to save habit: %w", err)
+	}
+
+	output := mapHabitEntityToOutput(habit)
+	return &output, nil
+}
+
+// mapHabitEntityToOutput converts a Habit entity to output format
+func mapHabitEntityToOutput(habit *entity.Habit) HabitOutput {
+	return HabitOutput{
+		ID:            habit.ID(),
+		CharacterID:   habit.CharacterID(),
+		Title:         habit.Title(),
+		Description:   habit.Description(),
+		AttributeName: habit.AttributeName(),
+		Difficulty:    habit.Difficulty().Value(),
+		Recurrence:    mapRecurrenceToOutput(habit.Recurrence()),
+		Negative:      habit.IsNegative(),
+		DrainsXp:      habit.DrainsXp(),
+		Active:        habit.Active(),
+		CreatedAt:     habit.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:     habit.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
+
+// buildRecurrence converts client input into a Recurrence value object
+func buildRecurrence(input RecurrenceInput) (valueobject.Recurrence, error) {
+	recurrence, err := valueobject.NewRecurrence(input.Type, input.Weekdays, input.Interval, input.Times, input.Period)
+	if err != nil {
+		return valueobject.Recurrence{}, fmt.Errorf("invalid recurrence: %w", err)
+	}
+	return recurrence, nil
+}
+
+// mapRecurrenceToOutput converts a Recurrence value object to output format
+func mapRecurrenceToOutput(recurrence valueobject.Recurrence) RecurrenceOutput {
+	return RecurrenceOutput{
+		Type:     recurrence.Type(),
+		Weekdays: recurrence.WeekdayNames(),
+		Interval: recurrence.Interval(),
+		Times:    recurrence.Times(),
+		Period:   recurrence.Period(),
+		Rule:     recurrence.Rule(),
+	}
+}
+
+// mapStreakToOutput converts a Streak value object to output format
+func mapStreakToOutput(streak valueobject.Streak) *StreakOutput {
+	return &StreakOutput{
+		Current: streak.Current(),
+		Longest: streak.Longest(),
+	}
+}
@@ -0,0 +1,273 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Mock HabitRepository
+type mockHabitRepository struct {
+	createFunc            func(ctx context.Context, habit *entity.Habit) error
+	findByIDAndUserIDFunc func(ctx context.Context, id string, userID string) (*entity.Habit, error)
+	findAllByUserIDFunc   func(ctx context.Context, userID string) ([]*entity.Habit, error)
+	findUserIDsFunc       func(ctx context.Context) ([]string, error)
+	updateFunc            func(ctx context.Context, habit *entity.Habit) error
+	deleteFunc            func(ctx context.Context, id string) error
+}
+
+func (m *mockHabitRepository) Create(ctx context.Context, habit *entity.Habit) error {
+	if m.createFunc != nil {
+		return m.createFunc(ctx, habit)
+	}
+	return nil
+}
+
+func (m *mockHabitRepository) FindByID(ctx context.Context, id string) (*entity.Habit, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (m *mockHabitRepository) FindByIDAndUserID(ctx context.Context, id string, userID string) (*entity.Habit, error) {
+	if m.findByIDAndUserIDFunc != nil {
+		return m.findByIDAndUserIDFunc(ctx, id, userID)
+	}
+	return nil, errors.New("habit not found or does not belong to user")
+}
+
+func (m *mockHabitRepository) FindAllByUserID(ctx context.Context, userID string) ([]*entity.Habit, error) {
+	if m.findAllByUserIDFunc != nil {
+		return m.findAllByUserIDFunc(ctx, userID)
+	}
+	return []*entity.Habit{}, nil
+}
+
+func (m *mockHabitRepository) FindUserIDsWithActiveHabits(ctx context.Context) ([]string, error) {
+	if m.findUserIDsFunc != nil {
+		return m.findUserIDsFunc(ctx)
+	}
+	return []string{}, nil
+}
+
+func (m *mockHabitRepository) Update(ctx context.Context, habit *entity.Habit) error {
+	if m.updateFunc != nil {
+		return m.updateFunc(ctx, habit)
+	}
+	return nil
+}
+
+func (m *mockHabitRepository) Delete(ctx context.Context, id string) error {
+	if m.deleteFunc != nil {
+		return m.deleteFunc(ctx, id)
+	}
+	return nil
+}
+
+// newOwnedCharacterRepo returns a character repository mock where char-123 belongs to user-123
+func newOwnedCharacterRepo() *mockCharacterRepositoryForAttributes {
+	mockCharacter := entity.ReconstituteCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), 1, 0, 0, 0, "user-123", time.Now())
+
+	return &mockCharacterRepositoryForAttributes{
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Character, error) {
+			if id == "char-123" && userID == "user-123" {
+				return mockCharacter, nil
+			}
+			return nil, errors.New("character not found or does not belong to user")
+		},
+	}
+}
+
+// newTestHabitEntity creates a persisted-looking habit owned by char-123
+func newTestHabitEntity() *entity.Habit {
+	difficulty, _ := valueobject.NewDifficulty("medium")
+	return entity.ReconstituteHabit(
+		"habit-123",
+		"Push-ups",
+		"3 sets of 20",
+		"char-123",
+		"Força",
+		difficulty,
+		valueobject.NewDailyRecurrence(),
+		false,
+		false,
+		true,
+		time.Now(),
+		time.Now(),
+	)
+}
+
+func TestCreateHabitUseCase_Execute_Success(t *testing.T) {
+	var savedHabit *entity.Habit
+
+	habitRepo := &mockHabitRepository{
+		createFunc: func(ctx context.Context, habit *entity.Habit) error {
+			savedHabit = habit
+			return nil
+		},
+	}
+
+	attrRepo := &mockCharacterAttributeRepository{
+		existsByCharacterIDAndNameFunc: func(ctx context.Context, characterID string, attributeName string) (bool, error) {
+			return characterID == "char-123" && attributeName == "Força", nil
+		},
+	}
+
+	useCase := usecase.NewCreateHabitUseCase(habitRepo, newOwnedCharacterRepo(), attrRepo)
+
+	output, err := useCase.Execute(context.Background(), usecase.CreateHabitInput{
+		UserID:        "user-123",
+		CharacterID:   "char-123",
+		Title:         "Push-ups",
+		Description:   "3 sets of 20",
+		AttributeName: "Força",
+		Difficulty:    "Hard",
+	})
+
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if savedHabit == nil {
+		t.Fatal("habit was not persisted")
+	}
+
+	if output.ID == "" {
+		t.Error("output.ID should not be empty")
+	}
+
+	if output.Title != "Push-ups" {
+		t.Errorf("output.Title = %v, want %v", output.Title, "Push-ups")
+	}
+
+	if output.Difficulty != "hard" {
+		t.Errorf("output.Difficulty = %v, want %v", output.Difficulty, "hard")
+	}
+
+	if !output.Active {
+		t.Error("output.Active = false, want true")
+	}
+
+	// Habits without an explicit schedule are daily
+	if output.Recurrence.Type != valueobject.RecurrenceDaily {
+		t.Errorf("output.Recurrence.Type = %v, want %v", output.Recurrence.Type, valueobject.RecurrenceDaily)
+	}
+}
+
+func TestCreateHabitUseCase_Execute_InvalidRecurrence(t *testing.T) {
+	attrRepo := &mockCharacterAttributeRepository{
+		existsByCharacterIDAndNameFunc: func(ctx context.Context, characterID string, attributeName string) (bool, error) {
+			return true, nil
+		},
+	}
+
+	useCase := usecase.NewCreateHabitUseCase(&mockHabitRepository{}, newOwnedCharacterRepo(), attrRepo)
+
+	_, err := useCase.Execute(context.Background(), usecase.CreateHabitInput{
+		UserID:        "user-123",
+		CharacterID:   "char-123",
+		Title:         "Gym",
+		AttributeName: "Força",
+		Difficulty:    "hard",
+		Recurrence:    &usecase.RecurrenceInput{Type: "weekly"},
+	})
+
+	if err == nil {
+		t.Fatal("Execute() error = nil, want error for weekly recurrence without weekdays")
+	}
+}
+
+func TestCreateHabitUseCase_Execute_CharacterNotOwned(t *testing.T) {
+	useCase := usecase.NewCreateHabitUseCase(&mockHabitRepository{}, newOwnedCharacterRepo(), &mockCharacterAttributeRepository{})
+
+	_, err := useCase.Execute(context.Background(), usecase.CreateHabitInput{
+		UserID:        "other-user",
+		CharacterID:   "char-123",
+		Title:         "Push-ups",
+		AttributeName: "Força",
+		Difficulty:    "easy",
+	})
+
+	if !errors.Is(err, usecase.ErrCharacterNotFound) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrCharacterNotFound)
+	}
+}
+
+func TestCreateHabitUseCase_Execute_UnknownAttribute(t *testing.T) {
+	attrRepo := &mockCharacterAttributeRepository{
+		existsByCharacterIDAndNameFunc: func(ctx context.Context, characterID string, attributeName string) (bool, error) {
+			return false, nil
+		},
+	}
+
+	useCase := usecase.NewCreateHabitUseCase(&mockHabitRepository{}, newOwnedCharacterRepo(), attrRepo)
+
+	_, err := useCase.Execute(context.Background(), usecase.CreateHabitInput{
+		UserID:        "user-123",
+		CharacterID:   "char-123",
+		Title:         "Push-ups",
+		AttributeName: "Sorte",
+		Difficulty:    "easy",
+	})
+
+	if !errors.Is(err, usecase.ErrAttributeNotFound) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrAttributeNotFound)
+	}
+}
+
+func TestCreateHabitUseCase_Execute_InvalidDifficulty(t *testing.T) {
+	attrRepo := &mockCharacterAttributeRepository{
+		existsByCharacterIDAndNameFunc: func(ctx context.Context, characterID string, attributeName string) (bool, error) {
+			return true, nil
+		},
+	}
+
+	useCase := usecase.NewCreateHabitUseCase(&mockHabitRepository{}, newOwnedCharacterRepo(), attrRepo)
+
+	output, err := useCase.Execute(context.Background(), usecase.CreateHabitInput{
+		UserID:        "user-123",
+		CharacterID:   "char-123",
+		Title:         "Push-ups",
+		AttributeName: "Força",
+		Difficulty:    "legendary",
+	})
+
+	if err == nil {
+		t.Fatal("Execute() error = nil, want error for invalid difficulty")
+	}
+
+	if output != nil {
+		t.Errorf("Execute() output = %v, want nil", output)
+	}
+}
+
+func TestCreateHabitUseCase_Execute_RepositoryError(t *testing.T) {
+	habitRepo := &mockHabitRepository{
+		createFunc: func(ctx context.Context, habit *entity.Habit) error {
+			return errors.New("database error")
+		},
+	}
+
+	attrRepo := &mockCharacterAttributeRepository{
+		existsByCharacterIDAndNameFunc: func(ctx context.Context, characterID string, attributeName string) (bool, error) {
+			return true, nil
+		},
+	}
+
+	useCase := usecase.NewCreateHabitUseCase(habitRepo, newOwnedCharacterRepo(), attrRepo)
+
+	_, err := useCase.Execute(context.Background(), usecase.CreateHabitInput{
+		UserID:        "user-123",
+		CharacterID:   "char-123",
+		Title:         "Push-ups",
+		AttributeName: "Força",
+		Difficulty:    "easy",
+	})
+
+	if err == nil {
+		t.Error("Execute() error = nil, want error when repository fails")
+	}
+}
@@ -0,0 +1,184 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+var (
+	// ErrTaskNotFound is returned when a task doesn't exist or doesn't belong to the user
+	ErrTaskNotFound = errors.New("task not found or does not belong to user")
+
+	// ErrTaskAlreadyCompleted is returned when completing a task that is already done
+	ErrTaskAlreadyCompleted = errors.New("task is already completed")
+
+	// ErrTaskNotCompleted is returned when reopening a task that is still open
+	ErrTaskNotCompleted = errors.New("task is not completed")
+
+	// ErrChecklistItemNotFound is returned when a checklist item doesn't exist in the task
+	ErrChecklistItemNotFound = errors.New("checklist item not found in task")
+)
+
+// CreateTaskInput represents the input for creating a task
+type CreateTaskInput struct {
+	UserID      string // User ID from authentication token
+	CharacterID string
+	Title       string
+	Description string
+	Difficulty  string     // Defines the XP reward
+	Priority    string     // Optional, defaults to medium
+	DueDate     *time.Time // Optional calendar date
+	Checklist   []string   // Optional checklist item titles
+}
+
+// TaskInput identifies a task of the authenticated user
+type TaskInput struct {
+	TaskID string
+	UserID string // User ID from authentication token
+}
+
+// TaskChecklistItemOutput represents a checklist item in the output of the task use cases
+type TaskChecklistItemOutput struct {
+	ID    string
+	Title string
+	Done  bool
+}
+
+// TaskOutput represents a single task in the output of the task use cases
+type TaskOutput struct {
+	ID          string
+	CharacterID string
+	Title       string
+	Description string
+	Difficulty  string
+	Priority    string
+	DueDate     string // YYYY-MM-DD, empty when the task has no due date
+	Checklist   []TaskChecklistItemOutput
+	Status      string // open, completed
+	Overdue     bool   // Still open after its due date
+	CompletedAt string // Empty while open
+	XpAwarded   int
+	CreatedAt   string
+	UpdatedAt   string
+}
+
+// CreateTaskUseCase handles the creation of new tasks
+type CreateTaskUseCase struct {
+	taskRepo        repository.TaskRepository
+	characterRepo   repository.CharacterRepository
+	preferencesRepo repository.UserPreferencesRepository
+}
+
+// NewCreateTaskUseCase creates a new CreateTaskUseCase
+func NewCreateTaskUseCase(
+	taskRepo repository.TaskRepository,
+	characterRepo repository.CharacterRepository,
+	preferencesRepo repository.UserPreferencesRepository,
+) *CreateTaskUseCase {
+	return &CreateTaskUseCase{
+		taskRepo:        taskRepo,
+		characterRepo:   characterRepo,
+		preferencesRepo: preferencesRepo,
+	}
+}
+
+// Execute creates a new task for one of the user's characters
+func (uc *CreateTaskUseCase) Execute(ctx context.Context, input CreateTaskInput) (*TaskOutput, error) {
+	// Validate character exists AND belongs to the authenticated user
+	if _, err := uc.characterRepo.FindByIDAndUserID(ctx, input.CharacterID, input.UserID); err != nil {
+		return nil, ErrCharacterNotFound
+	}
+
+	// Validate difficulty and priority (medium when not informed)
+	difficulty, err := valueobject.NewDifficulty(input.Difficulty)
+	if err != nil {
+		return nil, fmt.Errorf("invalid difficulty: %w", err)
+	}
+
+	if input.Priority == "" {
+		input.Priority = valueobject.PriorityMedium
+	}
+	priority, err := valueobject.NewPriority(input.Priority)
+	if err != nil {
+		return nil, fmt.Errorf("invalid priority: %w", err)
+	}
+
+	// Build checklist items
+	checklist := make([]*entity.TaskChecklistItem, len(input.Checklist))
+	for i, title := range input.Checklist {
+		checklist[i], err = entity.NewTaskChecklistItem(uuid.New().String(), title)
+		if err != nil {
+			return nil, fmt.Errorf("invalid checklist item: %w", err)
+		}
+	}
+
+	// Create task entity (with domain validation)
+	task, err := entity.NewTask(
+		uuid.New().String(),
+		input.CharacterID,
+		input.Title,
+		input.Description,
+		difficulty,
+		priority,
+		input.DueDate,
+		checklist,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create task: %w", err)
+	}
+
+	// Persist task
+	if err := uc.taskRepo.Create(ctx, task); err != nil {
+		return nil, fmt.Errorf("failed to save task: %w", err)
+	}
+
+	today, err := userToday(ctx, uc.preferencesRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := mapTaskEntityToOutput(task, today)
+	return &output, nil
+}
+
+// mapTaskEntityToOutput converts a Task entity to output format
+// today (the user's current calendar day) is used to flag overdue tasks
+func mapTaskEntityToOutput(task *entity.Task, today time.Time) TaskOutput {
+	output := TaskOutput{
+		ID:          task.ID(),
+		CharacterID: task.CharacterID(),
+		Title:       task.Title(),
+		Description: task.Description(),
+		Difficulty:  task.Difficulty().Value(),
+		Priority:    task.Priority().Value(),
+		Checklist:   make([]TaskChecklistItemOutput, len(task.Checklist())),
+		Status:      task.Status(),
+		Overdue:     task.IsOverdue(today),
+		XpAwarded:   task.XpAwarded(),
+		CreatedAt:   task.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:   task.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if task.DueDate() != nil {
+		output.DueDate = task.DueDate().Format("2006-01-02")
+	}
+	if task.CompletedAt() != nil {
+		output.CompletedAt = task.CompletedAt().Format("2006-01-02T15:04:05Z07:00")
+	}
+	for i, item := range task.Checklist() {
+		output.Checklist[i] = TaskChecklistItemOutput{
+			ID:    item.ID(),
+			Title: item.Title(),
+			Done:  item.Done(),
+		}
+	}
+
+	return output
+}
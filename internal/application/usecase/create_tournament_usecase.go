@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+var (
+	// ErrInvalidTournament is returned when a tournament fails validation (e.g. its format or deadline)
+	ErrInvalidTournament = errors.New("invalid tournament")
+
+	// ErrNotTournamentOrganizer is returned when a user who is neither an admin nor a guild leader creates a tournament
+	ErrNotTournamentOrganizer = errors.New("only admins and guild leaders can create tournaments")
+
+	// ErrTournamentNotFound is returned when a tournament does not exist
+	ErrTournamentNotFound = errors.New("tournament not found")
+
+	// ErrTournamentClosed is returned when joining a tournament after its deadline
+	ErrTournamentClosed = errors.New("tournament is closed to new entrants")
+
+	// ErrTournamentFull is returned when joining a tournament that reached its maximum of entrants
+	ErrTournamentFull = errors.New("tournament is full")
+
+	// ErrAlreadyInTournament is returned when the character already joined the tournament
+	ErrAlreadyInTournament = errors.New("character already joined the tournament")
+)
+
+// CreateTournamentInput represents the input for creating a tournament
+// Admins can create tournaments open to every character; guild leaders create tournaments for their guild's members
+type CreateTournamentInput struct {
+	UserID               string // User ID from authentication token
+	CharacterID          string // Guild leader creating a guild tournament (ignored otherwise)
+	GuildID              string // Empty for a tournament open to every character
+	Name                 string
+	Format               string // single_elimination or round_robin
+	Seeding              string // level or rating
+	JoinDeadline         string // RFC3339; the first round is fought at the deadline
+	RoundIntervalMinutes int
+	MaxEntrants          int
+	PrizeXp              int // Won by the champion; the runner-up wins half
+}
+
+// TournamentOutput represents a tournament
+type TournamentOutput struct {
+	ID                   string
+	Name                 string
+	Format               string
+	Seeding              string
+	GuildID              string // Empty when open to every character
+	OrganizerUserID      string
+	MaxEntrants          int
+	Entrants             int
+	PrizeXp              int
+	JoinDeadline         string
+	RoundIntervalMinutes int
+	Status               string // open, running, completed or cancelled
+	TotalRounds          int    // 0 until the bracket is seeded
+	RoundsPlayed         int
+	NextRoundAt          string // Empty once the tournament is over
+	ChampionCharacterID  string // Empty until completed
+	RunnerUpCharacterID  string // Empty until completed
+	CreatedAt            string
+	EndedAt              string // Empty until completed or cancelled
+}
+
+// CreateTournamentUseCase handles an admin or a guild leader creating a tournament
+type CreateTournamentUseCase struct {
+	characterRepo  repository.CharacterRepository
+	guildRepo      repository.GuildRepository
+	tournamentRepo repository.TournamentRepository
+	adminUserIDs   []string // Users allowed to create tournaments open to every character
+}
+
+// NewCreateTournamentUseCase creates a new CreateTournamentUseCase
+func NewCreateTournamentUseCase(
+	characterRepo repository.CharacterRepository,
+	guildRepo repository.GuildRepository,
+	tournamentRepo repository.TournamentRepository,
+	adminUserIDs []string,
+) *CreateTournamentUseCase {
+	return &CreateTournamentUseCase{
+		characterRepo:  characterRepo,
+		guildRepo:      guildRepo,
+		tournamentRepo: tournamentRepo,
+		adminUserIDs:   adminUserIDs,
+	}
+}
+
+// Execute creates a tournament characters can join until its deadline
+func (uc *CreateTournamentUseCase) Execute(ctx context.Context, input CreateTournamentInput) (*TournamentOutput, error) {
+	// 1. Validate the organizer: admins create any tournament, guild leaders only their guild's
+	isAdmin := slices.Contains(uc.adminUserIDs, input.UserID)
+	if input.GuildID == "" && !isAdmin {
+		return nil, ErrNotTournamentOrganizer
+	}
+
+	if input.GuildID != "" {
+		guild, err := uc.guildRepo.FindByID(ctx, input.GuildID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s", ErrGuildNotFound, input.GuildID)
+		}
+
+		if !isAdmin {
+			character, err := uc.characterRepo.FindByIDAndUserID(ctx, input.CharacterID, input.UserID)
+			if err != nil {
+				return nil, ErrCharacterNotFound
+			}
+			if !guild.IsLeader(character.ID()) {
+				return nil, ErrNotGuildLeader
+			}
+		}
+	}
+
+	// 2. Create the tournament (domain validates format, seeding, limits and schedule)
+	joinDeadline, err := time.Parse(time.RFC3339, input.JoinDeadline)
+	if err != nil {
+		return nil, fmt.Errorf("%w: join deadline must be an RFC3339 timestamp", ErrInvalidTournament)
+	}
+
+	tournament, err := entity.NewTournament(
+		uuid.New().String(),
+		input.Name,
+		input.Format,
+		input.Seeding,
+		input.GuildID,
+		input.UserID,
+		input.MaxEntrants,
+		input.PrizeXp,
+		joinDeadline.UTC(),
+		time.Duration(input.RoundIntervalMinutes)*time.Minute,
+		time.Now().UTC(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidTournament, err)
+	}
+
+	if err := uc.tournamentRepo.Create(ctx, tournament); err != nil {
+		return nil, fmt.Errorf("failed to save tournament: %w", err)
+	}
+
+	output := mapTournamentToOutput(tournament, 0)
+	return &output, nil
+}
+
+// mapTournamentToOutput converts a Tournament entity to output format
+func mapTournamentToOutput(tournament *entity.Tournament, entrants int) TournamentOutput {
+	output := TournamentOutput{
+		ID:                   tournament.ID(),
+		Name:                 tournament.Name(),
+		Format:               tournament.Format(),
+		Seeding:              tournament.Seeding(),
+		GuildID:              tournament.GuildID(),
+		OrganizerUserID:      tournament.OrganizerUserID(),
+		MaxEntrants:          tournament.MaxEntrants(),
+		Entrants:             entrants,
+		PrizeXp:              tournament.PrizeXp(),
+		JoinDeadline:         tournament.JoinDeadline().Format("2006-01-02T15:04:05Z07:00"),
+		RoundIntervalMinutes: int(tournament.RoundInterval() / time.Minute),
+		Status:               tournament.Status(),
+		TotalRounds:          tournament.TotalRounds(),
+		RoundsPlayed:         tournament.RoundsPlayed(),
+		ChampionCharacterID:  tournament.ChampionID(),
+		RunnerUpCharacterID:  tournament.RunnerUpID(),
+		CreatedAt:            tournament.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if next := tournament.NextRoundAt(); !next.IsZero() {
+		output.NextRoundAt = next.Format("2006-01-02T15:04:05Z07:00")
+	}
+	if endedAt := tournament.EndedAt(); endedAt != nil {
+		output.EndedAt = endedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	return output
+}
@@ -0,0 +1,305 @@
+package usecase_test
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+// Mock TournamentRepository
+type mockTournamentRepository struct {
+	tournaments map[string]*entity.Tournament
+	entrants    map[string][]*entity.TournamentEntrant
+	matches     map[string][]*entity.TournamentMatch
+	locked      []string // Tournaments locked, in order
+}
+
+func newMockTournamentRepository(tournaments ...*entity.Tournament) *mockTournamentRepository {
+	m := &mockTournamentRepository{
+		tournaments: map[string]*entity.Tournament{},
+		entrants:    map[string][]*entity.TournamentEntrant{},
+		matches:     map[string][]*entity.TournamentMatch{},
+	}
+	for _, tournament := range tournaments {
+		m.tournaments[tournament.ID()] = tournament
+	}
+	return m
+}
+
+func (m *mockTournamentRepository) Create(ctx context.Context, tournament *entity.Tournament) error {
+	m.tournaments[tournament.ID()] = tournament
+	return nil
+}
+
+func (m *mockTournamentRepository) Update(ctx context.Context, tournament *entity.Tournament) error {
+	if _, ok := m.tournaments[tournament.ID()]; !ok {
+		return errors.New("tournament not found")
+	}
+	m.tournaments[tournament.ID()] = tournament
+	return nil
+}
+
+func (m *mockTournamentRepository) FindByID(ctx context.Context, id string) (*entity.Tournament, error) {
+	if tournament, ok := m.tournaments[id]; ok {
+		return tournament, nil
+	}
+	return nil, errors.New("tournament not found")
+}
+
+func (m *mockTournamentRepository) FindByIDForUpdate(ctx context.Context, id string) (*entity.Tournament, error) {
+	m.locked = append(m.locked, id)
+	return m.FindByID(ctx, id)
+}
+
+func (m *mockTournamentRepository) FindDueIDs(ctx context.Context, at time.Time) ([]string, error) {
+	var ids []string
+	for id, tournament := range m.tournaments {
+		if tournament.IsDueAt(at) {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	return ids, nil
+}
+
+func (m *mockTournamentRepository) AddEntrant(ctx context.Context, entrant *entity.TournamentEntrant) error {
+	for _, existing := range m.entrants[entrant.TournamentID()] {
+		if existing.CharacterID() == entrant.CharacterID() {
+			return errors.New("character already joined the tournament")
+		}
+	}
+	m.entrants[entrant.TournamentID()] = append(m.entrants[entrant.TournamentID()], entrant)
+	return nil
+}
+
+func (m *mockTournamentRepository) UpdateEntrant(ctx context.Context, entrant *entity.TournamentEntrant) error {
+	return nil
+}
+
+func (m *mockTournamentRepository) FindEntrants(ctx context.Context, tournamentID string) ([]*entity.TournamentEntrant, error) {
+	entrants := slices.Clone(m.entrants[tournamentID])
+	slices.SortFunc(entrants, func(a, b *entity.TournamentEntrant) int {
+		return cmp.Or(cmp.Compare(a.Seed(), b.Seed()), a.JoinedAt().Compare(b.JoinedAt()))
+	})
+	return entrants, nil
+}
+
+func (m *mockTournamentRepository) CreateMatch(ctx context.Context, match *entity.TournamentMatch) error {
+	for _, existing := range m.matches[match.TournamentID()] {
+		if existing.Round() == match.Round() && existing.Slot() == match.Slot() {
+			return errors.New("match already recorded")
+		}
+	}
+	m.matches[match.TournamentID()] = append(m.matches[match.TournamentID()], match)
+	return nil
+}
+
+func (m *mockTournamentRepository) FindMatches(ctx context.Context, tournamentID string) ([]*entity.TournamentMatch, error) {
+	return slices.Clone(m.matches[tournamentID]), nil
+}
+
+// newTournamentGuild founds a guild led by char-123 that char-456 also joined
+func newTournamentGuild(t *testing.T) (*mockGuildRepository, *entity.Guild) {
+	t.Helper()
+
+	guildRepo := newMockGuildRepository()
+	guild, _ := entity.NewGuild("guild-1", "Ordem da Aurora", "char-123", time.Now())
+	if err := guildRepo.Create(context.Background(), guild); err != nil {
+		t.Fatalf("Failed to save guild: %v", err)
+	}
+	guildRepo.AddMember(context.Background(), guild.ID(), "char-456", time.Now())
+	return guildRepo, guild
+}
+
+// newTournamentInput creates the input of an open single-elimination tournament with a deadline in one day
+func newTournamentInput(userID string, characterID string, guildID string) usecase.CreateTournamentInput {
+	return usecase.CreateTournamentInput{
+		UserID:               userID,
+		CharacterID:          characterID,
+		GuildID:              guildID,
+		Name:                 "Copa do Hábito",
+		Format:               entity.TournamentSingleElimination,
+		Seeding:              entity.TournamentSeedByLevel,
+		JoinDeadline:         time.Now().Add(24 * time.Hour).Format(time.RFC3339),
+		RoundIntervalMinutes: 60,
+		MaxEntrants:          8,
+		PrizeXp:              200,
+	}
+}
+
+func TestCreateTournamentUseCase_Execute(t *testing.T) {
+	guildRepo, guild := newTournamentGuild(t)
+	tournamentRepo := newMockTournamentRepository()
+	useCase := usecase.NewCreateTournamentUseCase(newGuildCharacterRepository(), guildRepo, tournamentRepo, []string{"admin-1"})
+
+	// Admins create tournaments open to every character
+	output, err := useCase.Execute(context.Background(), newTournamentInput("admin-1", "", ""))
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if output.Status != entity.TournamentOpen || output.GuildID != "" || output.OrganizerUserID != "admin-1" || output.NextRoundAt != output.JoinDeadline || output.RoundIntervalMinutes != 60 {
+		t.Errorf("Execute() = %+v, want an open tournament organized by admin-1", output)
+	}
+	if _, ok := tournamentRepo.tournaments[output.ID]; !ok {
+		t.Error("tournament was not saved")
+	}
+
+	// Guild leaders create tournaments for their guild
+	output, err = useCase.Execute(context.Background(), newTournamentInput("user-123", "char-123", guild.ID()))
+	if err != nil {
+		t.Fatalf("Execute() for the guild leader error = %v, want nil", err)
+	}
+	if output.GuildID != guild.ID() {
+		t.Errorf("GuildID = %q, want %q", output.GuildID, guild.ID())
+	}
+}
+
+func TestCreateTournamentUseCase_Execute_Errors(t *testing.T) {
+	invalidDeadline := newTournamentInput("admin-1", "", "")
+	invalidDeadline.JoinDeadline = "tomorrow"
+	pastDeadline := newTournamentInput("admin-1", "", "")
+	pastDeadline.JoinDeadline = time.Now().Add(-time.Hour).Format(time.RFC3339)
+	unknownFormat := newTournamentInput("admin-1", "", "")
+	unknownFormat.Format = "swiss"
+
+	tests := []struct {
+		name    string
+		input   usecase.CreateTournamentInput
+		wantErr error
+	}{
+		{"open tournament by a player", newTournamentInput("user-123", "char-123", ""), usecase.ErrNotTournamentOrganizer},
+		{"guild tournament by a member", newTournamentInput("user-123", "char-456", "guild-1"), usecase.ErrNotGuildLeader},
+		{"guild tournament with another user's character", newTournamentInput("user-789", "char-123", "guild-1"), usecase.ErrCharacterNotFound},
+		{"unknown guild", newTournamentInput("user-123", "char-123", "guild-404"), usecase.ErrGuildNotFound},
+		{"invalid deadline", invalidDeadline, usecase.ErrInvalidTournament},
+		{"deadline in the past", pastDeadline, usecase.ErrInvalidTournament},
+		{"unknown format", unknownFormat, usecase.ErrInvalidTournament},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			guildRepo, _ := newTournamentGuild(t)
+			tournamentRepo := newMockTournamentRepository()
+			useCase := usecase.NewCreateTournamentUseCase(newGuildCharacterRepository(), guildRepo, tournamentRepo, []string{"admin-1"})
+
+			_, err := useCase.Execute(context.Background(), tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Execute() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(tournamentRepo.tournaments) != 0 {
+				t.Error("no tournament should be saved")
+			}
+		})
+	}
+}
+
+// newOpenTournament creates tournament-1, open until deadline for up to maxEntrants characters
+func newOpenTournament(t *testing.T, guildID string, maxEntrants int, deadline time.Time) *entity.Tournament {
+	t.Helper()
+
+	tournament, err := entity.NewTournament("tournament-1", "Copa do Hábito", entity.TournamentSingleElimination, entity.TournamentSeedByLevel,
+		guildID, "admin-1", maxEntrants, 100, deadline, time.Hour, deadline.Add(-48*time.Hour))
+	if err != nil {
+		t.Fatalf("NewTournament() error = %v, want nil", err)
+	}
+	return tournament
+}
+
+func TestJoinTournamentUseCase_Execute(t *testing.T) {
+	tournamentRepo := newMockTournamentRepository(newOpenTournament(t, "", 2, time.Now().Add(time.Hour)))
+	unitOfWork := &mockUnitOfWork{}
+	useCase := usecase.NewJoinTournamentUseCase(newGuildCharacterRepository(), newMockGuildRepository(), tournamentRepo, unitOfWork)
+
+	output, err := useCase.Execute(context.Background(), usecase.JoinTournamentInput{TournamentID: "tournament-1", CharacterID: "char-123", UserID: "user-123"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if output.Entrants != 1 || len(tournamentRepo.entrants["tournament-1"]) != 1 || len(tournamentRepo.locked) != 1 {
+		t.Errorf("Execute() = %+v, want 1 entrant saved with the tournament locked", output)
+	}
+
+	_, err = useCase.Execute(context.Background(), usecase.JoinTournamentInput{TournamentID: "tournament-1", CharacterID: "char-123", UserID: "user-123"})
+	if !errors.Is(err, usecase.ErrAlreadyInTournament) {
+		t.Errorf("Execute() twice error = %v, want ErrAlreadyInTournament", err)
+	}
+
+	if _, err := useCase.Execute(context.Background(), usecase.JoinTournamentInput{TournamentID: "tournament-1", CharacterID: "char-789", UserID: "user-789"}); err != nil {
+		t.Fatalf("Execute() for a second character error = %v, want nil", err)
+	}
+
+	_, err = useCase.Execute(context.Background(), usecase.JoinTournamentInput{TournamentID: "tournament-1", CharacterID: "char-456", UserID: "user-123"})
+	if !errors.Is(err, usecase.ErrTournamentFull) {
+		t.Errorf("Execute() on a full tournament error = %v, want ErrTournamentFull", err)
+	}
+	if len(tournamentRepo.entrants["tournament-1"]) != 2 || unitOfWork.rollbacks != 2 {
+		t.Errorf("entrants = %d, rollbacks = %d, want 2 and 2", len(tournamentRepo.entrants["tournament-1"]), unitOfWork.rollbacks)
+	}
+}
+
+func TestJoinTournamentUseCase_Execute_Errors(t *testing.T) {
+	tests := []struct {
+		name       string
+		tournament func(t *testing.T) *entity.Tournament
+		input      usecase.JoinTournamentInput
+		wantErr    error
+	}{
+		{
+			"character of another user",
+			func(t *testing.T) *entity.Tournament { return newOpenTournament(t, "", 8, time.Now().Add(time.Hour)) },
+			usecase.JoinTournamentInput{TournamentID: "tournament-1", CharacterID: "char-789", UserID: "user-123"},
+			usecase.ErrCharacterNotFound,
+		},
+		{
+			"unknown tournament",
+			func(t *testing.T) *entity.Tournament { return newOpenTournament(t, "", 8, time.Now().Add(time.Hour)) },
+			usecase.JoinTournamentInput{TournamentID: "tournament-404", CharacterID: "char-123", UserID: "user-123"},
+			usecase.ErrTournamentNotFound,
+		},
+		{
+			"deadline passed",
+			func(t *testing.T) *entity.Tournament {
+				return newOpenTournament(t, "", 8, time.Now().Add(-time.Minute))
+			},
+			usecase.JoinTournamentInput{TournamentID: "tournament-1", CharacterID: "char-123", UserID: "user-123"},
+			usecase.ErrTournamentClosed,
+		},
+		{
+			"not a member of the guild",
+			func(t *testing.T) *entity.Tournament {
+				return newOpenTournament(t, "guild-1", 8, time.Now().Add(time.Hour))
+			},
+			usecase.JoinTournamentInput{TournamentID: "tournament-1", CharacterID: "char-789", UserID: "user-789"},
+			usecase.ErrNotGuildMember,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			guildRepo, _ := newTournamentGuild(t)
+			tournamentRepo := newMockTournamentRepository(tt.tournament(t))
+			useCase := usecase.NewJoinTournamentUseCase(newGuildCharacterRepository(), guildRepo, tournamentRepo, &mockUnitOfWork{})
+
+			_, err := useCase.Execute(context.Background(), tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Execute() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(tournamentRepo.entrants["tournament-1"]) != 0 {
+				t.Error("no entrant should be saved")
+			}
+		})
+	}
+
+	// Members of the guild can join its tournament
+	guildRepo, _ := newTournamentGuild(t)
+	tournamentRepo := newMockTournamentRepository(newOpenTournament(t, "guild-1", 8, time.Now().Add(time.Hour)))
+	useCase := usecase.NewJoinTournamentUseCase(newGuildCharacterRepository(), guildRepo, tournamentRepo, &mockUnitOfWork{})
+	if _, err := useCase.Execute(context.Background(), usecase.JoinTournamentInput{TournamentID: "tournament-1", CharacterID: "char-456", UserID: "user-123"}); err != nil {
+		t.Errorf("Execute() for a guild member error = %v, want nil", err)
+	}
+}
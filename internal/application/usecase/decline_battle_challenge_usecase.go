@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+// DeclineBattleChallengeUseCase handles refusing a PvP challenge
+type DeclineBattleChallengeUseCase struct {
+	characterRepo       repository.CharacterRepository
+	battleChallengeRepo repository.BattleChallengeRepository
+}
+
+// NewDeclineBattleChallengeUseCase creates a new DeclineBattleChallengeUseCase
+func NewDeclineBattleChallengeUseCase(
+	characterRepo repository.CharacterRepository,
+	battleChallengeRepo repository.BattleChallengeRepository,
+) *DeclineBattleChallengeUseCase {
+	return &DeclineBattleChallengeUseCase{
+		characterRepo:       characterRepo,
+		battleChallengeRepo: battleChallengeRepo,
+	}
+}
+
+// Execute declines a pending challenge sent to one of the user's characters
+func (uc *DeclineBattleChallengeUseCase) Execute(ctx context.Context, input BattleChallengeInput) (*BattleChallengeOutput, error) {
+	// 1. Validate challenge exists AND was sent to a character of the authenticated user
+	challenge, _, err := findChallengeForOpponent(ctx, uc.battleChallengeRepo, uc.characterRepo, input)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	if err := checkChallengeAnswerable(challenge, now); err != nil {
+		return nil, err
+	}
+
+	// 2. Record the answer
+	if err := challenge.Decline(now); err != nil {
+		return nil, fmt.Errorf("failed to decline battle challenge: %w", err)
+	}
+
+	if err := uc.battleChallengeRepo.Respond(ctx, challenge); err != nil {
+		return nil, fmt.Errorf("failed to save battle challenge: %w", err)
+	}
+
+	output := mapBattleChallengeEntityToOutput(challenge, now)
+	return &output, nil
+}
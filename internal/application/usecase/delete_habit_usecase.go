@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+// DeleteHabitInput represents the input for deleting a habit
+type DeleteHabitInput struct {
+	HabitID string
+	UserID  string // User ID from authentication token
+}
+
+// DeleteHabitUseCase handles deleting habits
+type DeleteHabitUseCase struct {
+	habitRepo repository.HabitRepository
+}
+
+// NewDeleteHabitUseCase creates a new DeleteHabitUseCase
+func NewDeleteHabitUseCase(
+	habitRepo repository.HabitRepository,
+) *DeleteHabitUseCase {
+	return &DeleteHabitUseCase{
+		habitRepo: habitRepo,
+	}
+}
+
+// Execute deletes a habit owned by the user
+func (uc *DeleteHabitUseCase) Execute(ctx context.Context, input DeleteHabitInput) error {
+	// Validate habit exists AND belongs to the authenticated user
+	habit, err := uc.habitRepo.FindByIDAndUserID(ctx, input.HabitID, input.UserID)
+	if err != nil {
+		return ErrHabitNotFound
+	}
+
+	if err := uc.habitRepo.Delete(ctx, habit.ID()); err != nil {
+		return fmt.Errorf("failed to delete habit: %w", err)
+	}
+
+	return nil
+}
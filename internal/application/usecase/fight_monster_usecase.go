@@ -0,0 +1,253 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igor/chronotask-api/internal/application/port"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// maxBattleSeed keeps battle seeds exact for JSON clients (integers up to 2^53)
+const maxBattleSeed = 1 << 53
+
+// ErrMonsterNotFound is returned when a monster is not in the catalog
+var ErrMonsterNotFound = errors.New("monster not found")
+
+// FightMonsterInput represents the input for a PvE battle
+type FightMonsterInput struct {
+	CharacterID string
+	UserID      string // User ID from authentication token
+	MonsterID   string
+}
+
+// BattleCombatantOutput represents a combatant as it entered the battle
+type BattleCombatantOutput struct {
+	Kind       string // character or monster
+	ID         string
+	Name       string
+	Level      int
+	HP         int
+	Attack     int
+	Magic      int
+	Defense    int
+	Evasion    int
+	Initiative int
+}
+
+// BattleActionOutput represents one strike of the battle log
+type BattleActionOutput struct {
+	Round    int
+	Actor    string // challenger or opponent
+	Attack   string // physical or magic
+	Dodged   bool
+	Critical bool
+	Damage   int
+	TargetHP int
+}
+
+// FightMonsterOutput represents the outcome of a PvE battle
+type FightMonsterOutput struct {
+	BattleID     string
+	Seed         int64
+	Challenger   BattleCombatantOutput
+	Opponent     BattleCombatantOutput
+	Winner       string // challenger or opponent, empty on a draw
+	Victory      bool
+	Rounds       int
+	ChallengerHP int
+	OpponentHP   int
+	XpAwarded    int
+	LevelsGained int
+	Loot         []LootDropOutput // Items dropped by the defeated monster
+	Actions      []BattleActionOutput
+	Energy       int // Energy the character has left after the battle
+}
+
+// FightMonsterUseCase handles PvE battles between a character and a monster of the catalog
+type FightMonsterUseCase struct {
+	characterRepo          repository.CharacterRepository
+	characterAttributeRepo repository.CharacterAttributeRepository
+	monsterRepo            repository.MonsterRepository
+	battleRepo             repository.BattleRepository
+	characterEnergyRepo    repository.CharacterEnergyRepository
+	unitOfWork             port.UnitOfWork
+}
+
+// NewFightMonsterUseCase creates a new FightMonsterUseCase
+func NewFightMonsterUseCase(
+	characterRepo repository.CharacterRepository,
+	characterAttributeRepo repository.CharacterAttributeRepository,
+	monsterRepo repository.MonsterRepository,
+	battleRepo repository.BattleRepository,
+	characterEnergyRepo repository.CharacterEnergyRepository,
+	unitOfWork port.UnitOfWork,
+) *FightMonsterUseCase {
+	return &FightMonsterUseCase{
+		characterRepo:          characterRepo,
+		characterAttributeRepo: characterAttributeRepo,
+		monsterRepo:            monsterRepo,
+		battleRepo:             battleRepo,
+		characterEnergyRepo:    characterEnergyRepo,
+		unitOfWork:             unitOfWork,
+	}
+}
+
+// Execute simulates a battle against the monster, scaled to the character's level,
+// and rewards the character's victory with XP and the monster's loot
+// The battle costs the character energy (ErrNotEnoughEnergy when it doesn't have enough)
+func (uc *FightMonsterUseCase) Execute(ctx context.Context, input FightMonsterInput) (*FightMonsterOutput, error) {
+	// 1. Validate character exists AND belongs to the authenticated user
+	character, err := uc.characterRepo.FindByIDAndUserID(ctx, input.CharacterID, input.UserID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+
+	monster, err := uc.monsterRepo.FindByID(ctx, input.MonsterID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrMonsterNotFound, input.MonsterID)
+	}
+
+	// 2. Snapshot both combatants
+	challenger, err := characterCombatant(ctx, uc.characterAttributeRepo, character)
+	if err != nil {
+		return nil, err
+	}
+
+	opponent, err := monster.Combatant(character.Level())
+	if err != nil {
+		return nil, fmt.Errorf("failed to prepare monster for battle: %w", err)
+	}
+
+	// 3. Fight the battle (the seed makes it reproducible)
+	battle, err := entity.NewBattle(uuid.New().String(), challenger, opponent, rand.Int64N(maxBattleSeed), time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create battle: %w", err)
+	}
+
+	output := &FightMonsterOutput{
+		BattleID:     battle.ID(),
+		Seed:         battle.Seed(),
+		Challenger:   mapCombatantToOutput(battle.Challenger()),
+		Opponent:     mapCombatantToOutput(battle.Opponent()),
+		Winner:       battle.Winner(),
+		Victory:      battle.Winner() == entity.BattleSideChallenger,
+		Rounds:       battle.Rounds(),
+		ChallengerHP: battle.ChallengerHP(),
+		OpponentHP:   battle.OpponentHP(),
+		Actions:      mapBattleActionsToOutput(battle.Actions()),
+	}
+
+	// 4. Victories are rewarded with the monster's XP at its battle level (recorded in the ledger)
+	// and its loot, rolled from the battle seed
+	xpReward := monster.XpRewardAt(opponent.Level())
+	if output.Victory {
+		output.Loot = mapLootDropsToOutput(monster.RollLoot(battle.Seed()))
+	}
+	rewarded := output.Victory && xpReward > 0
+
+	source, err := valueobject.NewXpSource(valueobject.XpSourceBattleVictory, battle.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create xp source: %w", err)
+	}
+
+	// 5. Pay for the battle and persist it (for its replay) together with the reward
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		// The rewarded character is locked before its energy (see CharacterRepository.FindByIDForUpdate)
+		if rewarded {
+			locked, err := uc.characterRepo.FindByIDForUpdate(ctx, character.ID())
+			if err != nil {
+				return ErrCharacterNotFound
+			}
+			levelsGained, err := locked.AddXpFrom(xpReward, source)
+			if err != nil {
+				return fmt.Errorf("failed to add xp: %w", err)
+			}
+			character = locked
+
+			output.XpAwarded = xpReward
+			output.LevelsGained = levelsGained
+		}
+
+		energyLeft, err := spendBattleEnergy(ctx, uc.characterEnergyRepo, battle.FoughtAt(), character.ID())
+		if err != nil {
+			return err
+		}
+		output.Energy = energyLeft[character.ID()]
+
+		if err := uc.battleRepo.Create(ctx, battle); err != nil {
+			return fmt.Errorf("failed to save battle: %w", err)
+		}
+		if rewarded {
+			if err := uc.characterRepo.Update(ctx, character); err != nil {
+				return fmt.Errorf("failed to save character: %w", err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
+// characterCombatant prepares a character for battle with its current attributes
+func characterCombatant(
+	ctx context.Context,
+	characterAttributeRepo repository.CharacterAttributeRepository,
+	character *entity.Character,
+) (valueobject.Combatant, error) {
+	attributes, err := characterAttributeRepo.FindByCharacterID(ctx, character.ID())
+	if err != nil {
+		return valueobject.Combatant{}, fmt.Errorf("failed to fetch character attributes: %w", err)
+	}
+
+	combatant, err := character.Combatant(attributes)
+	if err != nil {
+		return valueobject.Combatant{}, fmt.Errorf("failed to prepare character for battle: %w", err)
+	}
+
+	return combatant, nil
+}
+
+// mapCombatantToOutput converts a Combatant snapshot to output format
+func mapCombatantToOutput(combatant valueobject.Combatant) BattleCombatantOutput {
+	stats := combatant.Stats()
+
+	return BattleCombatantOutput{
+		Kind:       combatant.Kind(),
+		ID:         combatant.ID(),
+		Name:       combatant.Name(),
+		Level:      combatant.Level(),
+		HP:         stats.HP(),
+		Attack:     stats.Attack(),
+		Magic:      stats.Magic(),
+		Defense:    stats.Defense(),
+		Evasion:    stats.Evasion(),
+		Initiative: stats.Initiative(),
+	}
+}
+
+// mapBattleActionsToOutput converts a battle log to output format
+func mapBattleActionsToOutput(actions []entity.BattleAction) []BattleActionOutput {
+	outputs := make([]BattleActionOutput, len(actions))
+	for i, action := range actions {
+		outputs[i] = BattleActionOutput{
+			Round:    action.Round,
+			Actor:    action.Actor,
+			Attack:   action.Attack,
+			Dodged:   action.Dodged,
+			Critical: action.Critical,
+			Damage:   action.Damage,
+			TargetHP: action.TargetHP,
+		}
+	}
+	return outputs
+}
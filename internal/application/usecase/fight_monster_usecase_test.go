@@ -0,0 +1,307 @@
+package usecase_test
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Mock MonsterRepository
+type mockMonsterRepository struct {
+	monsters map[string]*entity.Monster
+}
+
+func (m *mockMonsterRepository) FindByID(ctx context.Context, id string) (*entity.Monster, error) {
+	if monster, ok := m.monsters[id]; ok {
+		return monster, nil
+	}
+	return nil, errors.New("monster not found")
+}
+
+func (m *mockMonsterRepository) FindAll(ctx context.Context) ([]*entity.Monster, error) {
+	return m.find(func(monster *entity.Monster) bool { return true }), nil
+}
+
+func (m *mockMonsterRepository) FindByLevel(ctx context.Context, level int) ([]*entity.Monster, error) {
+	return m.find(func(monster *entity.Monster) bool {
+		return monster.MinLevel() <= level && monster.MaxLevel() >= level
+	}), nil
+}
+
+// find returns the monsters matching the filter, ordered by minimum level and ID
+func (m *mockMonsterRepository) find(filter func(*entity.Monster) bool) []*entity.Monster {
+	var monsters []*entity.Monster
+	for _, monster := range m.monsters {
+		if filter(monster) {
+			monsters = append(monsters, monster)
+		}
+	}
+	slices.SortFunc(monsters, func(a, b *entity.Monster) int {
+		return cmp.Or(cmp.Compare(a.MinLevel(), b.MinLevel()), cmp.Compare(a.ID(), b.ID()))
+	})
+	return monsters
+}
+
+// newTestMonsterCatalog builds a catalog of three monsters:
+// a level 1 rat that always drops its tail, a level 3-8 wolf and a level 30 ogre
+func newTestMonsterCatalog() *mockMonsterRepository {
+	tail, _ := valueobject.NewLootDrop("Rabo de Rato", 100, 1)
+	pelt, _ := valueobject.NewLootDrop("Pele de Lobo", 50, 1)
+
+	wolfAttributes := map[string]int{}
+	ogreAttributes := map[string]int{}
+	for _, profile := range valueobject.DefaultCharacterClass().Attributes() {
+		wolfAttributes[profile.Name] = 4
+		ogreAttributes[profile.Name] = 30
+	}
+
+	return &mockMonsterRepository{monsters: map[string]*entity.Monster{
+		"rato-gigante":  entity.ReconstituteMonster("rato-gigante", "Rato Gigante", 1, 1, map[string]int{valueobject.AttributeStrength: 1}, []valueobject.LootDrop{tail}, 15),
+		"lobo-cinzento": entity.ReconstituteMonster("lobo-cinzento", "Lobo Cinzento", 3, 8, wolfAttributes, []valueobject.LootDrop{pelt}, 40),
+		"ogro":          entity.ReconstituteMonster("ogro", "Ogro", 30, 30, ogreAttributes, nil, 180),
+	}}
+}
+
+// newUniformAttributes builds the seven attributes of a character, all with the same value
+func newUniformAttributes(characterID string, value int) []*entity.CharacterAttribute {
+	var attributes []*entity.CharacterAttribute
+	for i, profile := range valueobject.DefaultCharacterClass().Attributes() {
+		attributes = append(attributes, entity.ReconstituteCharacterAttribute(i+1, profile.Name, value, characterID, time.Now()))
+	}
+	return attributes
+}
+
+// newBattleFixture builds a PvE use case for char-123 (attributes at attributeValue) against newTestMonsterCatalog
+// It returns the use case, the character, the characters saved and the battle repository
+func newBattleFixture(attributeValue int, level int) (*usecase.FightMonsterUseCase, *entity.Character, *[]*entity.Character, *mockBattleRepository) {
+	return newBattleFixtureWithEnergy(attributeValue, level, newMockCharacterEnergyRepository())
+}
+
+// newBattleFixtureWithEnergy builds the same PvE use case as newBattleFixture, paying battles from energyRepo
+func newBattleFixtureWithEnergy(attributeValue int, level int, energyRepo *mockCharacterEnergyRepository) (*usecase.FightMonsterUseCase, *entity.Character, *[]*entity.Character, *mockBattleRepository) {
+	character := entity.ReconstituteCharacter("char-123", "Hero", valueobject.DefaultCharacterClass(), level, 0, 0, 0, "user-123", time.Now())
+	var updated []*entity.Character
+
+	charRepo := &mockCharacterRepositoryForHabits{
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Character, error) {
+			if id == "char-123" && userID == "user-123" {
+				return character, nil
+			}
+			return nil, errors.New("character not found or does not belong to user")
+		},
+		findByIDFunc: func(ctx context.Context, id string) (*entity.Character, error) {
+			return character, nil
+		},
+		updateFunc: func(ctx context.Context, character *entity.Character) error {
+			updated = append(updated, character)
+			return nil
+		},
+	}
+
+	attrRepo := &mockCharacterAttributeRepositoryGet{
+		findByCharacterIDFunc: func(ctx context.Context, characterID string) ([]*entity.CharacterAttribute, error) {
+			return newUniformAttributes(characterID, attributeValue), nil
+		},
+	}
+
+	monsterRepo := newTestMonsterCatalog()
+	battleRepo := &mockBattleRepository{}
+
+	return usecase.NewFightMonsterUseCase(charRepo, attrRepo, monsterRepo, battleRepo, energyRepo, &mockUnitOfWork{}), character, &updated, battleRepo
+}
+
+func TestFightMonsterUseCase_Execute_VictoryAwardsXp(t *testing.T) {
+	useCase, character, updated, battleRepo := newBattleFixture(10, 5)
+
+	output, err := useCase.Execute(context.Background(), usecase.FightMonsterInput{
+		CharacterID: "char-123",
+		UserID:      "user-123",
+		MonsterID:   "rato-gigante",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if !output.Victory || output.Winner != entity.BattleSideChallenger {
+		t.Fatalf("output = %+v, want a victory", output)
+	}
+	if output.XpAwarded != 15 {
+		t.Errorf("output.XpAwarded = %v, want 15", output.XpAwarded)
+	}
+	if len(output.Loot) != 1 || output.Loot[0].Item != "Rabo de Rato" || output.Loot[0].Quantity != 1 {
+		t.Errorf("output.Loot = %+v, want the rat's tail", output.Loot)
+	}
+	if output.Challenger.ID != "char-123" || output.Opponent.ID != "rato-gigante" {
+		t.Errorf("combatants = (%v, %v), want (char-123, rato-gigante)", output.Challenger.ID, output.Opponent.ID)
+	}
+
+	// The XP is saved and recorded in the ledger against the battle
+	if len(*updated) != 1 {
+		t.Fatalf("Update() called %d times, want 1", len(*updated))
+	}
+	if character.TotalXp() != 15 {
+		t.Errorf("TotalXp() = %v, want 15", character.TotalXp())
+	}
+	pending := character.PendingXpTransactions()
+	if len(pending) != 1 || pending[0].Source().Type() != valueobject.XpSourceBattleVictory || pending[0].Source().ID() != output.BattleID {
+		t.Errorf("pending xp transactions = %+v, want one battle victory for %s", pending, output.BattleID)
+	}
+
+	// The battle is saved for its replay
+	if _, ok := battleRepo.battles[output.BattleID]; !ok {
+		t.Error("battle was not saved")
+	}
+}
+
+func TestFightMonsterUseCase_Execute_DefeatAwardsNothing(t *testing.T) {
+	useCase, character, updated, battleRepo := newBattleFixture(1, 1)
+
+	output, err := useCase.Execute(context.Background(), usecase.FightMonsterInput{
+		CharacterID: "char-123",
+		UserID:      "user-123",
+		MonsterID:   "ogro",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.Victory || output.XpAwarded != 0 || len(output.Loot) != 0 {
+		t.Errorf("output = %+v, want a defeat without XP or loot", output)
+	}
+	if len(*updated) != 0 || character.TotalXp() != 0 {
+		t.Error("a defeat should not change the character")
+	}
+
+	// Defeats are saved too
+	if _, ok := battleRepo.battles[output.BattleID]; !ok {
+		t.Error("battle was not saved")
+	}
+}
+
+func TestFightMonsterUseCase_Execute_IsReproducibleFromSeed(t *testing.T) {
+	useCase, _, _, battleRepo := newBattleFixture(5, 3)
+
+	output, err := useCase.Execute(context.Background(), usecase.FightMonsterInput{
+		CharacterID: "char-123",
+		UserID:      "user-123",
+		MonsterID:   "ogro",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	// Re-simulating the snapshots with the returned seed gives the same log
+	character := entity.ReconstituteCharacter("char-123", "Hero", valueobject.DefaultCharacterClass(), 3, 0, 0, 0, "user-123", time.Now())
+	challenger, _ := character.Combatant(newUniformAttributes("char-123", 5))
+
+	opponent, _ := newTestMonsterCatalog().monsters["ogro"].Combatant(3)
+
+	outcome := entity.SimulateBattle(challenger, opponent, output.Seed)
+	if outcome.Winner != output.Winner || outcome.Rounds != output.Rounds || len(outcome.Actions) != len(output.Actions) {
+		t.Fatalf("re-simulated outcome differs from the battle output")
+	}
+	for i, action := range outcome.Actions {
+		want := usecase.BattleActionOutput{
+			Round:    action.Round,
+			Actor:    action.Actor,
+			Attack:   action.Attack,
+			Dodged:   action.Dodged,
+			Critical: action.Critical,
+			Damage:   action.Damage,
+			TargetHP: action.TargetHP,
+		}
+		if output.Actions[i] != want {
+			t.Fatalf("action %d = %+v, want %+v", i, output.Actions[i], want)
+		}
+	}
+
+	// The saved battle replays the same log as well
+	if battle, ok := battleRepo.battles[output.BattleID]; !ok || !battle.IsReproducible() {
+		t.Error("saved battle should be reproducible from its snapshots and seed")
+	}
+}
+
+func TestFightMonsterUseCase_Execute_ScalesMonsterToCharacterLevel(t *testing.T) {
+	useCase, _, _, _ := newBattleFixture(30, 5)
+
+	output, err := useCase.Execute(context.Background(), usecase.FightMonsterInput{
+		CharacterID: "char-123",
+		UserID:      "user-123",
+		MonsterID:   "lobo-cinzento",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	// The level 3-8 wolf fights the level 5 character at level 5
+	wolf := newTestMonsterCatalog().monsters["lobo-cinzento"]
+	if stats := wolf.CombatStatsAt(5); output.Opponent.Level != 5 || output.Opponent.HP != stats.HP() || output.Opponent.Attack != stats.Attack() {
+		t.Errorf("output.Opponent = %+v, want the wolf scaled to level 5 (%+v)", output.Opponent, stats)
+	}
+
+	// Its XP reward grows 10% per level above its minimum
+	if !output.Victory || output.XpAwarded != 48 {
+		t.Errorf("output = %+v, want a victory worth 48 XP", output)
+	}
+}
+
+func TestFightMonsterUseCase_Execute_SpendsEnergy(t *testing.T) {
+	energyRepo := newMockCharacterEnergyRepository()
+	useCase, _, _, _ := newBattleFixtureWithEnergy(10, 5, energyRepo)
+
+	output, err := useCase.Execute(context.Background(), usecase.FightMonsterInput{CharacterID: "char-123", UserID: "user-123", MonsterID: "rato-gigante"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if want := entity.MaxEnergy - entity.BattleEnergyCost; output.Energy != want || energyRepo.energyAt("char-123", time.Now().UTC()) != want {
+		t.Errorf("output.Energy = %d, want %d saved", output.Energy, want)
+	}
+}
+
+func TestFightMonsterUseCase_Execute_NotEnoughEnergy(t *testing.T) {
+	energyRepo := newMockCharacterEnergyRepository()
+	energyRepo.setEnergy("char-123", entity.BattleEnergyCost-1, time.Now().UTC())
+	useCase, _, updated, battleRepo := newBattleFixtureWithEnergy(10, 5, energyRepo)
+
+	_, err := useCase.Execute(context.Background(), usecase.FightMonsterInput{CharacterID: "char-123", UserID: "user-123", MonsterID: "rato-gigante"})
+	if !errors.Is(err, usecase.ErrNotEnoughEnergy) {
+		t.Fatalf("Execute() error = %v, want %v", err, usecase.ErrNotEnoughEnergy)
+	}
+
+	if len(battleRepo.battles) != 0 || len(*updated) != 0 {
+		t.Error("no battle should be fought")
+	}
+}
+
+func TestFightMonsterUseCase_Execute_Errors(t *testing.T) {
+	useCase, _, _, battleRepo := newBattleFixture(5, 1)
+
+	tests := []struct {
+		name  string
+		input usecase.FightMonsterInput
+		want  error
+	}{
+		{"character of another user", usecase.FightMonsterInput{CharacterID: "char-123", UserID: "user-456", MonsterID: "ogro"}, usecase.ErrCharacterNotFound},
+		{"unknown monster", usecase.FightMonsterInput{CharacterID: "char-123", UserID: "user-123", MonsterID: "dragao"}, usecase.ErrMonsterNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := useCase.Execute(context.Background(), tt.input); !errors.Is(err, tt.want) {
+				t.Errorf("Execute() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+
+	if len(battleRepo.battles) != 0 {
+		t.Error("no battle should be saved")
+	}
+}
@@ -0,0 +1,44 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+// GetActiveFocusSessionInput represents the input for fetching the user's open focus session
+type GetActiveFocusSessionInput struct {
+	UserID string // User ID from authentication token
+}
+
+// GetActiveFocusSessionUseCase handles fetching the running or paused session of a user
+// Clients use it to restore their timer after a restart
+type GetActiveFocusSessionUseCase struct {
+	focusSessionRepo repository.FocusSessionRepository
+}
+
+// NewGetActiveFocusSessionUseCase creates a new GetActiveFocusSessionUseCase
+func NewGetActiveFocusSessionUseCase(
+	focusSessionRepo repository.FocusSessionRepository,
+) *GetActiveFocusSessionUseCase {
+	return &GetActiveFocusSessionUseCase{
+		focusSessionRepo: focusSessionRepo,
+	}
+}
+
+// Execute retrieves the open focus session of the user
+// Returns ErrFocusSessionNotFound when the user has no open session
+func (uc *GetActiveFocusSessionUseCase) Execute(ctx context.Context, input GetActiveFocusSessionInput) (*FocusSessionOutput, error) {
+	session, err := uc.focusSessionRepo.FindOpenByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch focus session: %w", err)
+	}
+	if session == nil {
+		return nil, ErrFocusSessionNotFound
+	}
+
+	output := mapFocusSessionEntityToOutput(session, time.Now().UTC())
+	return &output, nil
+}
@@ -0,0 +1,80 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// ErrBattleNotFound is returned when a battle doesn't exist or none of the user's characters fought it
+var ErrBattleNotFound = errors.New("battle not found or not fought by user")
+
+// GetBattleReplayInput represents the input for fetching a battle replay
+type GetBattleReplayInput struct {
+	BattleID string
+	UserID   string // User ID from authentication token
+}
+
+// GetBattleReplayOutput represents a battle with its replay log
+type GetBattleReplayOutput struct {
+	BattleID string
+	Kind     string // pvp or pve
+	FoughtAt string
+	Log      []byte // Versioned JSON event log (see entity.BattleLogVersion)
+}
+
+// GetBattleReplayUseCase handles fetching the replay log of a battle
+type GetBattleReplayUseCase struct {
+	battleRepo    repository.BattleRepository
+	characterRepo repository.CharacterRepository
+}
+
+// NewGetBattleReplayUseCase creates a new GetBattleReplayUseCase
+func NewGetBattleReplayUseCase(
+	battleRepo repository.BattleRepository,
+	characterRepo repository.CharacterRepository,
+) *GetBattleReplayUseCase {
+	return &GetBattleReplayUseCase{
+		battleRepo:    battleRepo,
+		characterRepo: characterRepo,
+	}
+}
+
+// Execute returns the replay log of a battle fought by one of the user's characters
+func (uc *GetBattleReplayUseCase) Execute(ctx context.Context, input GetBattleReplayInput) (*GetBattleReplayOutput, error) {
+	battle, err := uc.battleRepo.FindByID(ctx, input.BattleID)
+	if err != nil {
+		return nil, ErrBattleNotFound
+	}
+
+	// 1. Validate one of the combatants belongs to the authenticated user
+	fought := false
+	for _, combatant := range []valueobject.Combatant{battle.Challenger(), battle.Opponent()} {
+		if combatant.Kind() != valueobject.CombatantCharacter {
+			continue
+		}
+		if _, err := uc.characterRepo.FindByIDAndUserID(ctx, combatant.ID(), input.UserID); err == nil {
+			fought = true
+			break
+		}
+	}
+	if !fought {
+		return nil, ErrBattleNotFound
+	}
+
+	// 2. Encode the replay log
+	log, err := battle.EncodeLog()
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode battle log: %w", err)
+	}
+
+	return &GetBattleReplayOutput{
+		BattleID: battle.ID(),
+		Kind:     battle.Kind(),
+		FoughtAt: battle.FoughtAt().Format("2006-01-02T15:04:05Z07:00"),
+		Log:      log,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package usecase_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+func TestGetBattleReplayUseCase_Execute(t *testing.T) {
+	charRepo := newDuelistRepository()
+
+	hero, _ := charRepo.FindByID(context.Background(), "char-123")
+	rival, _ := charRepo.FindByID(context.Background(), "char-456")
+	challenger, _ := hero.Combatant(newUniformAttributes("char-123", 8))
+	opponent, _ := rival.Combatant(newUniformAttributes("char-456", 8))
+
+	battle, err := entity.NewBattle("battle-1", challenger, opponent, 31337, time.Now().UTC())
+	if err != nil {
+		t.Fatalf("NewBattle() error = %v, want nil", err)
+	}
+
+	battleRepo := &mockBattleRepository{battles: map[string]*entity.Battle{"battle-1": battle}}
+	uc := usecase.NewGetBattleReplayUseCase(battleRepo, charRepo)
+
+	// Both sides of the battle can replay it
+	for _, userID := range []string{"user-123", "user-456"} {
+		output, err := uc.Execute(context.Background(), usecase.GetBattleReplayInput{BattleID: "battle-1", UserID: userID})
+		if err != nil {
+			t.Fatalf("Execute() for %s error = %v, want nil", userID, err)
+		}
+
+		want, _ := battle.EncodeLog()
+		if output.Kind != entity.BattleKindPvP || !bytes.Equal(output.Log, want) {
+			t.Errorf("Execute() for %s = (%v, %s), want (pvp, %s)", userID, output.Kind, output.Log, want)
+		}
+	}
+
+	tests := []struct {
+		name  string
+		input usecase.GetBattleReplayInput
+	}{
+		{"unknown battle", usecase.GetBattleReplayInput{BattleID: "battle-2", UserID: "user-123"}},
+		{"user who didn't fight", usecase.GetBattleReplayInput{BattleID: "battle-1", UserID: "user-999"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := uc.Execute(context.Background(), tt.input); !errors.Is(err, usecase.ErrBattleNotFound) {
+				t.Errorf("Execute() error = %v, want %v", err, usecase.ErrBattleNotFound)
+			}
+		})
+	}
+}
@@ -16,11 +16,13 @@ type GetCharacterAttributesInput struct {
 
 // CharacterAttributeOutput represents a single character attribute in the output
 type CharacterAttributeOutput struct {
-	ID            int
-	AttributeName string
-	Value         int
-	CharacterID   string
-	CreatedAt     string
+	ID             int
+	AttributeName  string
+	Value          int // Starting value plus the points allocated to it
+	ClassBonus     int // Growth of the character's class at its current level
+	EffectiveValue int // Value + ClassBonus
+	CharacterID    string
+	CreatedAt      string
 }
 
 // GetCharacterAttributesOutput represents the output after getting character attributes
@@ -49,7 +51,7 @@ func NewGetCharacterAttributesUseCase(
 // Execute retrieves all attributes for a character
 func (uc *GetCharacterAttributesUseCase) Execute(ctx context.Context, input GetCharacterAttributesInput) (*GetCharacterAttributesOutput, error) {
 	// Validate character exists AND belongs to the authenticated user (in one query)
-	_, err := uc.characterRepo.FindByIDAndUserID(ctx, input.CharacterID, input.UserID)
+	character, err := uc.characterRepo.FindByIDAndUserID(ctx, input.CharacterID, input.UserID)
 	if err != nil {
 		return nil, fmt.Errorf("character not found or does not belong to user: %w", err)
 	}
@@ -63,7 +65,7 @@ func (uc *GetCharacterAttributesUseCase) Execute(ctx context.Context, input GetC
 	// Convert entities to output
 	attributeOutputs := make([]CharacterAttributeOutput, len(attributes))
 	for i, attr := range attributes {
-		attributeOutputs[i] = mapEntityToOutput(attr)
+		attributeOutputs[i] = mapEntityToOutput(attr, character)
 	}
 
 	return &GetCharacterAttributesOutput{
@@ -72,13 +74,17 @@ func (uc *GetCharacterAttributesUseCase) Execute(ctx context.Context, input GetC
 	}, nil
 }
 
-// mapEntityToOutput converts a CharacterAttribute entity to output format
-func mapEntityToOutput(attr *entity.CharacterAttribute) CharacterAttributeOutput {
+// mapEntityToOutput converts a CharacterAttribute entity to output format (with its character's class bonus)
+func mapEntityToOutput(attr *entity.CharacterAttribute, character *entity.Character) CharacterAttributeOutput {
+	classBonus := character.AttributeGrowthBonus(attr.AttributeName())
+
 	return CharacterAttributeOutput{
-		ID:            attr.ID(),
-		AttributeName: attr.AttributeName(),
-		Value:         attr.Value(),
-		CharacterID:   attr.CharacterID(),
-		CreatedAt:     attr.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		ID:             attr.ID(),
+		AttributeName:  attr.AttributeName(),
+		Value:          attr.Value(),
+		ClassBonus:     classBonus,
+		EffectiveValue: attr.Value() + classBonus,
+		CharacterID:    attr.CharacterID(),
+		CreatedAt:      attr.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
 	}
 }
@@ -9,6 +9,7 @@ import (
 
 	"github.com/igor/chronotask-api/internal/application/usecase"
 	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
 )
 
 // Mock CharacterAttributeRepository for GetCharacterAttributes tests
@@ -35,6 +36,14 @@ func (m *mockCharacterAttributeRepositoryGet) FindByCharacterIDAndName(ctx conte
 	return nil, errors.New("not implemented")
 }
 
+func (m *mockCharacterAttributeRepositoryGet) FindByCharacterIDForUpdate(ctx context.Context, characterID string) ([]*entity.CharacterAttribute, error) {
+	return m.FindByCharacterID(ctx, characterID)
+}
+
+func (m *mockCharacterAttributeRepositoryGet) FindByCharacterIDAndNameForUpdate(ctx context.Context, characterID string, attributeName string) (*entity.CharacterAttribute, error) {
+	return nil, errors.New("not implemented")
+}
+
 func (m *mockCharacterAttributeRepositoryGet) Update(ctx context.Context, attribute *entity.CharacterAttribute) error {
 	return errors.New("not implemented")
 }
@@ -64,6 +73,10 @@ func (m *mockCharacterRepositoryForAttributes) FindByID(ctx context.Context, id
 	return nil, errors.New("not found")
 }
 
+func (m *mockCharacterRepositoryForAttributes) FindByIDForUpdate(ctx context.Context, id string) (*entity.Character, error) {
+	return m.FindByID(ctx, id)
+}
+
 func (m *mockCharacterRepositoryForAttributes) FindByIDAndUserID(ctx context.Context, id string, userID string) (*entity.Character, error) {
 	if m.findByIDAndUserIDFunc != nil {
 		return m.findByIDAndUserIDFunc(ctx, id, userID)
@@ -95,9 +108,11 @@ func TestGetCharacterAttributesUseCase_Execute_Success(t *testing.T) {
 	mockCharacter := entity.ReconstituteCharacter(
 		"char-123",
 		"Warrior King",
+		valueobject.DefaultCharacterClass(),
 		5,
 		50,
 		500,
+		0,
 		"user-123",
 		time.Now(),
 	)
@@ -191,9 +206,11 @@ func TestGetCharacterAttributesUseCase_Execute_EmptyAttributes(t *testing.T) {
 	mockCharacter := entity.ReconstituteCharacter(
 		"char-123",
 		"Warrior King",
+		valueobject.DefaultCharacterClass(),
 		1,
 		0,
 		0,
+		0,
 		"user-123",
 		time.Now(),
 	)
@@ -239,9 +256,11 @@ func TestGetCharacterAttributesUseCase_Execute_RepositoryError(t *testing.T) {
 	mockCharacter := entity.ReconstituteCharacter(
 		"char-123",
 		"Warrior King",
+		valueobject.DefaultCharacterClass(),
 		1,
 		0,
 		0,
+		0,
 		"user-123",
 		time.Now(),
 	)
@@ -314,9 +333,11 @@ func TestGetCharacterAttributesUseCase_Execute_UnauthorizedAccess(t *testing.T)
 	mockCharacter := entity.ReconstituteCharacter(
 		"char-123",
 		"Warrior King",
+		valueobject.DefaultCharacterClass(),
 		5,
 		50,
 		500,
+		0,
 		"user-123", // Owner
 		time.Now(),
 	)
@@ -356,3 +377,44 @@ func TestGetCharacterAttributesUseCase_Execute_UnauthorizedAccess(t *testing.T)
 		t.Errorf("error message = %v, want authorization error", err.Error())
 	}
 }
+
+func TestGetCharacterAttributesUseCase_Execute_ClassBonus(t *testing.T) {
+	rogue, _ := valueobject.NewCharacterClass(valueobject.ClassRogue)
+	mockCharacter := entity.ReconstituteCharacter("char-123", "Shadow", rogue, 4, 0, 0, 0, "user-123", time.Now())
+
+	mockCharRepo := &mockCharacterRepositoryForAttributes{
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Character, error) {
+			return mockCharacter, nil
+		},
+	}
+
+	mockAttrRepo := &mockCharacterAttributeRepositoryGet{
+		findByCharacterIDFunc: func(ctx context.Context, characterID string) ([]*entity.CharacterAttribute, error) {
+			return []*entity.CharacterAttribute{
+				entity.ReconstituteCharacterAttribute(1, "Destreza", 10, "char-123", time.Now()),
+				entity.ReconstituteCharacterAttribute(2, "Força", 4, "char-123", time.Now()),
+			}, nil
+		},
+	}
+
+	useCase := usecase.NewGetCharacterAttributesUseCase(mockCharRepo, mockAttrRepo)
+
+	output, err := useCase.Execute(context.Background(), usecase.GetCharacterAttributesInput{
+		CharacterID: "char-123",
+		UserID:      "user-123",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	// A level 4 rogue grows Destreza by 1 per level above 1
+	dexterity := output.Attributes[0]
+	if dexterity.Value != 10 || dexterity.ClassBonus != 3 || dexterity.EffectiveValue != 13 {
+		t.Errorf("Destreza = %+v, want value 10, class bonus 3, effective 13", dexterity)
+	}
+
+	strength := output.Attributes[1]
+	if strength.ClassBonus != 0 || strength.EffectiveValue != 4 {
+		t.Errorf("Força = %+v, want no class bonus", strength)
+	}
+}
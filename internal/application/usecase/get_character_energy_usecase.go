@@ -0,0 +1,75 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+// GetCharacterEnergyInput represents the input for fetching a character's battle energy
+type GetCharacterEnergyInput struct {
+	CharacterID string
+	UserID      string // User ID from authentication token
+}
+
+// GetCharacterEnergyOutput represents a character's battle energy at the time of the request
+type GetCharacterEnergyOutput struct {
+	CharacterID          string
+	Energy               int
+	MaxEnergy            int
+	BattleCost           int    // Energy spent by each battle
+	HabitCompletionBonus int    // Energy restored by each habit completion
+	RegenIntervalSeconds int    // Time to regenerate one point
+	NextPointAt          string // Empty when the pool is full
+	FullAt               string
+}
+
+// GetCharacterEnergyUseCase handles fetching the battle energy of a character
+type GetCharacterEnergyUseCase struct {
+	characterRepo       repository.CharacterRepository
+	characterEnergyRepo repository.CharacterEnergyRepository
+}
+
+// NewGetCharacterEnergyUseCase creates a new GetCharacterEnergyUseCase
+func NewGetCharacterEnergyUseCase(
+	characterRepo repository.CharacterRepository,
+	characterEnergyRepo repository.CharacterEnergyRepository,
+) *GetCharacterEnergyUseCase {
+	return &GetCharacterEnergyUseCase{
+		characterRepo:       characterRepo,
+		characterEnergyRepo: characterEnergyRepo,
+	}
+}
+
+// Execute retrieves the energy of a character owned by the user, with the regeneration up to now
+func (uc *GetCharacterEnergyUseCase) Execute(ctx context.Context, input GetCharacterEnergyInput) (*GetCharacterEnergyOutput, error) {
+	// 1. Validate character exists AND belongs to the authenticated user (in one query)
+	character, err := uc.characterRepo.FindByIDAndUserID(ctx, input.CharacterID, input.UserID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+
+	// 2. Derive the current energy from the stored one
+	now := time.Now().UTC()
+	energy, err := currentCharacterEnergy(ctx, uc.characterEnergyRepo, character.ID(), now)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &GetCharacterEnergyOutput{
+		CharacterID:          character.ID(),
+		Energy:               energy.CurrentAt(now),
+		MaxEnergy:            entity.MaxEnergy,
+		BattleCost:           entity.BattleEnergyCost,
+		HabitCompletionBonus: entity.HabitCompletionEnergy,
+		RegenIntervalSeconds: int(entity.EnergyRegenInterval / time.Second),
+		FullAt:               energy.FullAt(now).Format("2006-01-02T15:04:05Z07:00"),
+	}
+	if next := energy.NextPointAt(now); !next.IsZero() {
+		output.NextPointAt = next.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	return output, nil
+}
@@ -0,0 +1,111 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+// Mock CharacterEnergyRepository (characters without a stored pool have a full one)
+type mockCharacterEnergyRepository struct {
+	energies map[string]*entity.CharacterEnergy
+	locked   []string // Characters whose energy was locked, in order
+}
+
+func newMockCharacterEnergyRepository() *mockCharacterEnergyRepository {
+	return &mockCharacterEnergyRepository{energies: map[string]*entity.CharacterEnergy{}}
+}
+
+func (m *mockCharacterEnergyRepository) FindByCharacterID(ctx context.Context, characterID string) (*entity.CharacterEnergy, error) {
+	return m.energies[characterID], nil
+}
+
+func (m *mockCharacterEnergyRepository) FindByCharacterIDForUpdate(ctx context.Context, characterID string) (*entity.CharacterEnergy, error) {
+	m.locked = append(m.locked, characterID)
+	return m.energies[characterID], nil
+}
+
+func (m *mockCharacterEnergyRepository) Create(ctx context.Context, energy *entity.CharacterEnergy) error {
+	if _, ok := m.energies[energy.CharacterID()]; !ok {
+		m.energies[energy.CharacterID()] = energy
+	}
+	return nil
+}
+
+func (m *mockCharacterEnergyRepository) Update(ctx context.Context, energy *entity.CharacterEnergy) error {
+	m.energies[energy.CharacterID()] = energy
+	return nil
+}
+
+// setEnergy stores a pool with the given energy, last settled at the given time
+func (m *mockCharacterEnergyRepository) setEnergy(characterID string, energy int, updatedAt time.Time) {
+	m.energies[characterID] = entity.ReconstituteCharacterEnergy(characterID, energy, updatedAt)
+}
+
+// energyAt returns the energy a character has at the given time (a full pool when none is stored)
+func (m *mockCharacterEnergyRepository) energyAt(characterID string, at time.Time) int {
+	energy, ok := m.energies[characterID]
+	if !ok {
+		return entity.MaxEnergy
+	}
+	return energy.CurrentAt(at)
+}
+
+func TestGetCharacterEnergyUseCase_Execute_FullWhenNeverSpent(t *testing.T) {
+	energyRepo := newMockCharacterEnergyRepository()
+	uc := usecase.NewGetCharacterEnergyUseCase(newDuelistRepository(), energyRepo)
+
+	output, err := uc.Execute(context.Background(), usecase.GetCharacterEnergyInput{CharacterID: "char-123", UserID: "user-123"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.Energy != entity.MaxEnergy || output.MaxEnergy != entity.MaxEnergy {
+		t.Errorf("Energy = %d/%d, want %d/%d", output.Energy, output.MaxEnergy, entity.MaxEnergy, entity.MaxEnergy)
+	}
+
+	if output.NextPointAt != "" {
+		t.Errorf("NextPointAt = %q, want empty for a full pool", output.NextPointAt)
+	}
+
+	if output.BattleCost != entity.BattleEnergyCost || output.HabitCompletionBonus != entity.HabitCompletionEnergy {
+		t.Errorf("costs = %d/%d, want %d/%d", output.BattleCost, output.HabitCompletionBonus, entity.BattleEnergyCost, entity.HabitCompletionEnergy)
+	}
+
+	// Reading the energy doesn't store a pool
+	if len(energyRepo.energies) != 0 {
+		t.Errorf("stored pools = %d, want 0", len(energyRepo.energies))
+	}
+}
+
+func TestGetCharacterEnergyUseCase_Execute_RegeneratesSinceLastUpdate(t *testing.T) {
+	energyRepo := newMockCharacterEnergyRepository()
+	energyRepo.setEnergy("char-123", 20, time.Now().UTC().Add(-3*entity.EnergyRegenInterval-time.Second))
+	uc := usecase.NewGetCharacterEnergyUseCase(newDuelistRepository(), energyRepo)
+
+	output, err := uc.Execute(context.Background(), usecase.GetCharacterEnergyInput{CharacterID: "char-123", UserID: "user-123"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.Energy != 23 {
+		t.Errorf("Energy = %d, want 23", output.Energy)
+	}
+
+	if output.NextPointAt == "" || output.FullAt == "" {
+		t.Errorf("NextPointAt = %q, FullAt = %q, want both set", output.NextPointAt, output.FullAt)
+	}
+}
+
+func TestGetCharacterEnergyUseCase_Execute_CharacterOfAnotherUser(t *testing.T) {
+	uc := usecase.NewGetCharacterEnergyUseCase(newDuelistRepository(), newMockCharacterEnergyRepository())
+
+	_, err := uc.Execute(context.Background(), usecase.GetCharacterEnergyInput{CharacterID: "char-456", UserID: "user-123"})
+	if !errors.Is(err, usecase.ErrCharacterNotFound) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrCharacterNotFound)
+	}
+}
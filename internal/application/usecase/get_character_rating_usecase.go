@@ -0,0 +1,157 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Number of rating history entries returned with a character's rating
+const ratingHistoryLimit = 20
+
+// Results of a ranked battle in the rating history
+const (
+	RatingResultWin  = "win"
+	RatingResultDraw = "draw"
+	RatingResultLoss = "loss"
+)
+
+// GetCharacterRatingInput represents the input for fetching a character's competitive rating
+type GetCharacterRatingInput struct {
+	CharacterID string
+	UserID      string // User ID from authentication token
+}
+
+// RatingChangeOutput represents the rating change of a character in one ranked battle
+type RatingChangeOutput struct {
+	CharacterID         string
+	BattleID            string
+	OpponentCharacterID string
+	Result              string // win, draw or loss
+	RatingBefore        int
+	RatingAfter         int
+	Delta               int // RatingAfter - RatingBefore
+	DeviationAfter      int
+	CreatedAt           string
+}
+
+// GetCharacterRatingOutput represents a character's current rating and its latest changes
+type GetCharacterRatingOutput struct {
+	CharacterID  string
+	Rating       int
+	Deviation    int // Grows while the character doesn't fight ranked battles
+	Volatility   float64
+	Wins         int
+	Losses       int
+	Draws        int
+	Games        int
+	LastPlayedAt string // Empty until the first ranked battle
+	History      []RatingChangeOutput
+}
+
+// GetCharacterRatingUseCase handles fetching the competitive rating of a character
+type GetCharacterRatingUseCase struct {
+	characterRepo       repository.CharacterRepository
+	characterRatingRepo repository.CharacterRatingRepository
+	ratingPeriod        time.Duration // Inactive time that grows the deviation by one Glicko-2 period
+}
+
+// NewGetCharacterRatingUseCase creates a new GetCharacterRatingUseCase
+func NewGetCharacterRatingUseCase(
+	characterRepo repository.CharacterRepository,
+	characterRatingRepo repository.CharacterRatingRepository,
+	ratingPeriod time.Duration,
+) *GetCharacterRatingUseCase {
+	return &GetCharacterRatingUseCase{
+		characterRepo:       characterRepo,
+		characterRatingRepo: characterRatingRepo,
+		ratingPeriod:        ratingPeriod,
+	}
+}
+
+// Execute retrieves the current rating of a character owned by the user, with its latest changes
+// A character that never fought a ranked battle has the initial rating
+func (uc *GetCharacterRatingUseCase) Execute(ctx context.Context, input GetCharacterRatingInput) (*GetCharacterRatingOutput, error) {
+	// 1. Validate character exists AND belongs to the authenticated user (in one query)
+	character, err := uc.characterRepo.FindByIDAndUserID(ctx, input.CharacterID, input.UserID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+
+	// 2. Fetch the rating (or start from the initial one)
+	rating, err := uc.characterRatingRepo.FindByCharacterID(ctx, character.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch character rating: %w", err)
+	}
+	if rating == nil {
+		rating, err = entity.NewCharacterRating(character.ID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create character rating: %w", err)
+		}
+	}
+
+	changes, err := uc.characterRatingRepo.FindRecentChangesByCharacterID(ctx, character.ID(), ratingHistoryLimit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch rating history: %w", err)
+	}
+
+	// 3. Report the deviation as of now (it grows while the character is inactive)
+	current := rating.CurrentRating(time.Now().UTC(), uc.ratingPeriod)
+
+	output := &GetCharacterRatingOutput{
+		CharacterID: character.ID(),
+		Rating:      roundRating(current.Rating()),
+		Deviation:   roundRating(current.Deviation()),
+		Volatility:  current.Volatility(),
+		Wins:        rating.Wins(),
+		Losses:      rating.Losses(),
+		Draws:       rating.Draws(),
+		Games:       rating.Games(),
+		History:     make([]RatingChangeOutput, len(changes)),
+	}
+
+	if lastPlayedAt := rating.LastPlayedAt(); lastPlayedAt != nil {
+		output.LastPlayedAt = lastPlayedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	for i, change := range changes {
+		output.History[i] = mapRatingChangeEntityToOutput(change)
+	}
+
+	return output, nil
+}
+
+// roundRating rounds a rating or deviation to the integer shown to players
+func roundRating(value float64) int {
+	return int(math.Round(value))
+}
+
+// mapRatingChangeEntityToOutput converts a RatingChange entity to output format
+func mapRatingChangeEntityToOutput(change *entity.RatingChange) RatingChangeOutput {
+	result := RatingResultDraw
+	switch change.Score() {
+	case valueobject.RatingScoreWin:
+		result = RatingResultWin
+	case valueobject.RatingScoreLoss:
+		result = RatingResultLoss
+	}
+
+	before, after := roundRating(change.Before().Rating()), roundRating(change.After().Rating())
+
+	return RatingChangeOutput{
+		CharacterID:         change.CharacterID(),
+		BattleID:            change.BattleID(),
+		OpponentCharacterID: change.OpponentCharacterID(),
+		Result:              result,
+		RatingBefore:        before,
+		RatingAfter:         after,
+		Delta:               after - before,
+		DeviationAfter:      roundRating(change.After().Deviation()),
+		CreatedAt:           change.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
@@ -0,0 +1,161 @@
+package usecase_test
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Mock CharacterRatingRepository (history entries are stored oldest first)
+type mockCharacterRatingRepository struct {
+	ratings map[string]*entity.CharacterRating
+	changes []*entity.RatingChange
+	locked  []string // Characters whose rating was locked, in order
+	saveErr error
+}
+
+func newMockCharacterRatingRepository() *mockCharacterRatingRepository {
+	return &mockCharacterRatingRepository{ratings: map[string]*entity.CharacterRating{}}
+}
+
+func (m *mockCharacterRatingRepository) FindByCharacterID(ctx context.Context, characterID string) (*entity.CharacterRating, error) {
+	return m.ratings[characterID], nil
+}
+
+func (m *mockCharacterRatingRepository) FindByCharacterIDForUpdate(ctx context.Context, characterID string) (*entity.CharacterRating, error) {
+	m.locked = append(m.locked, characterID)
+	return m.ratings[characterID], nil
+}
+
+func (m *mockCharacterRatingRepository) Create(ctx context.Context, rating *entity.CharacterRating) error {
+	if _, ok := m.ratings[rating.CharacterID()]; !ok {
+		m.ratings[rating.CharacterID()] = rating
+	}
+	return nil
+}
+
+func (m *mockCharacterRatingRepository) Save(ctx context.Context, rating *entity.CharacterRating) error {
+	if m.saveErr != nil {
+		return m.saveErr
+	}
+	m.ratings[rating.CharacterID()] = rating
+	m.changes = append(m.changes, rating.PendingChanges()...)
+	rating.ClearPendingChanges()
+	return nil
+}
+
+func (m *mockCharacterRatingRepository) FindLeaderboard(ctx context.Context, limit int, offset int) ([]*entity.CharacterRating, error) {
+	ratings := make([]*entity.CharacterRating, 0, len(m.ratings))
+	for _, rating := range m.ratings {
+		ratings = append(ratings, rating)
+	}
+	slices.SortFunc(ratings, func(a, b *entity.CharacterRating) int {
+		return cmp.Or(cmp.Compare(b.Rating().Rating(), a.Rating().Rating()), cmp.Compare(a.CharacterID(), b.CharacterID()))
+	})
+
+	if offset >= len(ratings) {
+		return nil, nil
+	}
+	return ratings[offset:min(offset+limit, len(ratings))], nil
+}
+
+func (m *mockCharacterRatingRepository) CountRated(ctx context.Context) (int, error) {
+	return len(m.ratings), nil
+}
+
+func (m *mockCharacterRatingRepository) FindRecentChangesByCharacterID(ctx context.Context, characterID string, limit int) ([]*entity.RatingChange, error) {
+	var changes []*entity.RatingChange
+	for i := len(m.changes) - 1; i >= 0 && len(changes) < limit; i-- {
+		if m.changes[i].CharacterID() == characterID {
+			changes = append(changes, m.changes[i])
+		}
+	}
+	return changes, nil
+}
+
+// newTestCharacterRating creates a rating last played lastPlayedAgo ago
+func newTestCharacterRating(t *testing.T, characterID string, rating float64, deviation float64, lastPlayedAgo time.Duration) *entity.CharacterRating {
+	t.Helper()
+
+	glicko, err := valueobject.NewGlickoRating(rating, deviation, valueobject.InitialRatingVolatility)
+	if err != nil {
+		t.Fatalf("NewGlickoRating() error = %v, want nil", err)
+	}
+	lastPlayedAt := time.Now().UTC().Add(-lastPlayedAgo)
+	return entity.ReconstituteCharacterRating(characterID, glicko, 2, 1, 0, &lastPlayedAt)
+}
+
+func TestGetCharacterRatingUseCase_Execute_WithHistory(t *testing.T) {
+	ratingRepo := newMockCharacterRatingRepository()
+	uc := usecase.NewGetCharacterRatingUseCase(newDuelistRepository(), ratingRepo, 24*time.Hour)
+
+	// Three ranked battles against char-456, the last one 10 days ago
+	rating, err := entity.NewCharacterRating("char-123")
+	if err != nil {
+		t.Fatalf("NewCharacterRating() error = %v, want nil", err)
+	}
+	opponent := valueobject.InitialGlickoRating()
+	playedAt := time.Now().UTC().Add(-12 * 24 * time.Hour)
+	for i, score := range []float64{valueobject.RatingScoreWin, valueobject.RatingScoreDraw, valueobject.RatingScoreLoss} {
+		if err := rating.RecordResult(fmt.Sprintf("battle-%d", i+1), "char-456", opponent, score, playedAt.Add(time.Duration(i)*24*time.Hour), 24*time.Hour); err != nil {
+			t.Fatalf("RecordResult() error = %v, want nil", err)
+		}
+	}
+	if err := ratingRepo.Save(context.Background(), rating); err != nil {
+		t.Fatalf("Save() error = %v, want nil", err)
+	}
+
+	output, err := uc.Execute(context.Background(), usecase.GetCharacterRatingInput{CharacterID: "char-123", UserID: "user-123"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.Wins != 1 || output.Draws != 1 || output.Losses != 1 || output.Games != 3 || output.LastPlayedAt == "" {
+		t.Errorf("output = %+v, want 1 win, 1 draw and 1 loss", output)
+	}
+
+	// Ten days without ranked battles grew the deviation
+	if stored := int(math.Round(rating.Rating().Deviation())); output.Deviation <= stored {
+		t.Errorf("Deviation = %d, want more than the stored %d", output.Deviation, stored)
+	}
+
+	// Most recent first
+	if len(output.History) != 3 || output.History[0].BattleID != "battle-3" || output.History[0].Result != usecase.RatingResultLoss ||
+		output.History[2].Result != usecase.RatingResultWin {
+		t.Errorf("history = %+v, want battle-3 (loss) to battle-1 (win)", output.History)
+	}
+	if got := output.History[0]; got.Delta != got.RatingAfter-got.RatingBefore || got.Delta >= 0 {
+		t.Errorf("history[0] = %+v, want a negative delta matching the ratings", got)
+	}
+}
+
+func TestGetCharacterRatingUseCase_Execute_NeverRanked(t *testing.T) {
+	uc := usecase.NewGetCharacterRatingUseCase(newDuelistRepository(), newMockCharacterRatingRepository(), 24*time.Hour)
+
+	output, err := uc.Execute(context.Background(), usecase.GetCharacterRatingInput{CharacterID: "char-123", UserID: "user-123"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.Rating != 1500 || output.Deviation != 350 || output.Games != 0 || output.LastPlayedAt != "" || len(output.History) != 0 {
+		t.Errorf("output = %+v, want the initial rating without history", output)
+	}
+}
+
+func TestGetCharacterRatingUseCase_Execute_NotOwned(t *testing.T) {
+	uc := usecase.NewGetCharacterRatingUseCase(newDuelistRepository(), newMockCharacterRatingRepository(), 24*time.Hour)
+
+	_, err := uc.Execute(context.Background(), usecase.GetCharacterRatingInput{CharacterID: "char-456", UserID: "user-123"})
+	if !errors.Is(err, usecase.ErrCharacterNotFound) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrCharacterNotFound)
+	}
+}
@@ -0,0 +1,73 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+// GetCharacterStatsInput represents the input for getting a character's combat stats
+type GetCharacterStatsInput struct {
+	CharacterID string
+	UserID      string // User ID from authentication token
+}
+
+// GetCharacterStatsOutput represents the combat stats derived from a character's attributes and level
+type GetCharacterStatsOutput struct {
+	CharacterID string
+	Class       string
+	Level       int
+	HP          int
+	Attack      int
+	Magic       int
+	Defense     int
+	Evasion     int // Chance (%) of dodging an attack
+	Initiative  int
+}
+
+// GetCharacterStatsUseCase handles computing the combat stats of a character
+type GetCharacterStatsUseCase struct {
+	characterRepo          repository.CharacterRepository
+	characterAttributeRepo repository.CharacterAttributeRepository
+}
+
+// NewGetCharacterStatsUseCase creates a new GetCharacterStatsUseCase
+func NewGetCharacterStatsUseCase(
+	characterRepo repository.CharacterRepository,
+	characterAttributeRepo repository.CharacterAttributeRepository,
+) *GetCharacterStatsUseCase {
+	return &GetCharacterStatsUseCase{
+		characterRepo:          characterRepo,
+		characterAttributeRepo: characterAttributeRepo,
+	}
+}
+
+// Execute derives the combat stats of a character owned by the user
+func (uc *GetCharacterStatsUseCase) Execute(ctx context.Context, input GetCharacterStatsInput) (*GetCharacterStatsOutput, error) {
+	// Validate character exists AND belongs to the authenticated user (in one query)
+	character, err := uc.characterRepo.FindByIDAndUserID(ctx, input.CharacterID, input.UserID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+
+	attributes, err := uc.characterAttributeRepo.FindByCharacterID(ctx, character.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch character attributes: %w", err)
+	}
+
+	// Formulas live in the domain (valueobject.CombatStats)
+	stats := character.CombatStats(attributes)
+
+	return &GetCharacterStatsOutput{
+		CharacterID: character.ID(),
+		Class:       character.Class().Value(),
+		Level:       character.Level(),
+		HP:          stats.HP(),
+		Attack:      stats.Attack(),
+		Magic:       stats.Magic(),
+		Defense:     stats.Defense(),
+		Evasion:     stats.Evasion(),
+		Initiative:  stats.Initiative(),
+	}, nil
+}
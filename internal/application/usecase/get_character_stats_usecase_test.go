@@ -0,0 +1,69 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+func TestGetCharacterStatsUseCase_Execute_Success(t *testing.T) {
+	mage, _ := valueobject.NewCharacterClass(valueobject.ClassMage)
+	character := entity.ReconstituteCharacter("char-123", "Merlin", mage, 2, 0, 0, 0, "user-123", time.Now())
+
+	mockCharRepo := &mockCharacterRepositoryForAttributes{
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Character, error) {
+			return character, nil
+		},
+	}
+
+	mockAttrRepo := &mockCharacterAttributeRepositoryGet{
+		findByCharacterIDFunc: func(ctx context.Context, characterID string) ([]*entity.CharacterAttribute, error) {
+			return []*entity.CharacterAttribute{
+				entity.ReconstituteCharacterAttribute(1, valueobject.AttributeIntelligence, 8, "char-123", time.Now()),
+				entity.ReconstituteCharacterAttribute(2, valueobject.AttributeWisdom, 7, "char-123", time.Now()),
+			}, nil
+		},
+	}
+
+	useCase := usecase.NewGetCharacterStatsUseCase(mockCharRepo, mockAttrRepo)
+
+	output, err := useCase.Execute(context.Background(), usecase.GetCharacterStatsInput{
+		CharacterID: "char-123",
+		UserID:      "user-123",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.Class != "mago" || output.Level != 2 {
+		t.Errorf("output = %+v, want a level 2 mage", output)
+	}
+
+	// Level 2 mage: Inteligência 8+1, Sabedoria 7+1 -> magic 9*2 + 8/2 + 2
+	if output.Magic != 24 {
+		t.Errorf("output.Magic = %v, want 24", output.Magic)
+	}
+}
+
+func TestGetCharacterStatsUseCase_Execute_CharacterNotFound(t *testing.T) {
+	mockCharRepo := &mockCharacterRepositoryForAttributes{
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Character, error) {
+			return nil, errors.New("character not found or does not belong to user")
+		},
+	}
+
+	useCase := usecase.NewGetCharacterStatsUseCase(mockCharRepo, &mockCharacterAttributeRepositoryGet{})
+
+	_, err := useCase.Execute(context.Background(), usecase.GetCharacterStatsInput{
+		CharacterID: "char-123",
+		UserID:      "other-user",
+	})
+	if !errors.Is(err, usecase.ErrCharacterNotFound) {
+		t.Errorf("Execute() error = %v, want ErrCharacterNotFound", err)
+	}
+}
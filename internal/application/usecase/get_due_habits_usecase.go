@@ -0,0 +1,121 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+// GetDueHabitsInput represents the input for fetching the habits due on a day
+type GetDueHabitsInput struct {
+	UserID string    // User ID from authentication token
+	Date   time.Time // Calendar day to evaluate, zero for the user's today
+}
+
+// DueHabitOutput represents a habit scheduled for the requested day
+type DueHabitOutput struct {
+	Habit             HabitOutput
+	CompletedToday    bool // Whether the habit was already completed on the requested day
+	PeriodCompletions int  // Completions in the current period, including the requested day
+}
+
+// GetDueHabitsOutput represents the output after evaluating the user's schedules
+type GetDueHabitsOutput struct {
+	Date   string // Requested day (YYYY-MM-DD)
+	Habits []DueHabitOutput
+}
+
+// GetDueHabitsUseCase evaluates the user's habit schedules for a given day
+type GetDueHabitsUseCase struct {
+	habitRepo           repository.HabitRepository
+	habitCompletionRepo repository.HabitCompletionRepository
+	preferencesRepo     repository.UserPreferencesRepository
+}
+
+// NewGetDueHabitsUseCase creates a new GetDueHabitsUseCase
+func NewGetDueHabitsUseCase(
+	habitRepo repository.HabitRepository,
+	habitCompletionRepo repository.HabitCompletionRepository,
+	preferencesRepo repository.UserPreferencesRepository,
+) *GetDueHabitsUseCase {
+	return &GetDueHabitsUseCase{
+		habitRepo:           habitRepo,
+		habitCompletionRepo: habitCompletionRepo,
+		preferencesRepo:     preferencesRepo,
+	}
+}
+
+// Execute retrieves the active habits of a user that are due on the requested day
+func (uc *GetDueHabitsUseCase) Execute(ctx context.Context, input GetDueHabitsInput) (*GetDueHabitsOutput, error) {
+	// The user's preferences define the day and week boundaries
+	clock, err := loadDayClock(ctx, uc.preferencesRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	day := clock.Today(time.Now().UTC())
+	if !input.Date.IsZero() {
+		day = clock.Date(input.Date)
+	}
+	nextDay := day.AddDate(0, 0, 1)
+
+	habits, err := uc.habitRepo.FindAllByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user habits: %w", err)
+	}
+
+	// Load completions from the start of the longest period (week or month) up to the end of the day
+	from := day
+	for _, habit := range habits {
+		if start := habit.Recurrence().PeriodStart(day, clock.WeekStart()); start.Before(from) {
+			from = start
+		}
+	}
+
+	completions, err := uc.habitCompletionRepo.FindByUserIDBetween(ctx, input.UserID, clock.DayStart(from), clock.DayStart(nextDay))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch habit completions: %w", err)
+	}
+
+	dueHabits := make([]DueHabitOutput, 0, len(habits))
+	for _, habit := range habits {
+		periodStart := habit.Recurrence().PeriodStart(day, clock.WeekStart())
+
+		completionsBefore := 0
+		completedToday := false
+		for _, completion := range completions {
+			if completion.HabitID() != habit.ID() {
+				continue
+			}
+			completedDay := clock.Day(completion.CompletedAt())
+			switch {
+			case completedDay.Equal(day):
+				completedToday = true
+			case completedDay.Before(day) && !completedDay.Before(periodStart):
+				completionsBefore++
+			}
+		}
+
+		if !habit.IsDueOn(day, completionsBefore, clock) {
+			continue
+		}
+
+		periodCompletions := completionsBefore
+		if completedToday {
+			periodCompletions++
+		}
+
+		dueHabits = append(dueHabits, DueHabitOutput{
+			Habit:             mapHabitEntityToOutput(habit),
+			CompletedToday:    completedToday,
+			PeriodCompletions: periodCompletions,
+		})
+	}
+
+	return &GetDueHabitsOutput{
+		Date:   day.Format("2006-01-02"),
+		Habits: dueHabits,
+	}, nil
+}
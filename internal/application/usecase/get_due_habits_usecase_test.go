@@ -0,0 +1,169 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+func TestGetDueHabitsUseCase_Execute(t *testing.T) {
+	// 2024-01-10 is a Wednesday; every habit was created on Monday 2024-01-01
+	createdAt := time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+	today := time.Date(2024, 1, 10, 18, 0, 0, 0, time.UTC)
+	easy, _ := valueobject.NewDifficulty("easy")
+
+	newHabit := func(id string, recurrence valueobject.Recurrence, active bool) *entity.Habit {
+		return entity.ReconstituteHabit(id, id, "", "char-123", "Força", easy, recurrence, false, false, active, createdAt, createdAt)
+	}
+
+	monWedFri, _ := valueobject.NewRecurrence("weekly", []string{"mon", "wed", "fri"}, 0, 0, "")
+	tueThu, _ := valueobject.NewRecurrence("weekly", []string{"tue", "thu"}, 0, 0, "")
+	everyThreeDays, _ := valueobject.NewIntervalRecurrence(3) // Jan 1, 4, 7, 10...
+	everyTwoDays, _ := valueobject.NewIntervalRecurrence(2)   // Jan 1, 3, 5, 7, 9, 11...
+	twicePerWeek, _ := valueobject.NewTimesPerPeriodRecurrence(2, "week")
+	threePerWeek, _ := valueobject.NewTimesPerPeriodRecurrence(3, "week")
+
+	habits := []*entity.Habit{
+		newHabit("daily", valueobject.NewDailyRecurrence(), true),
+		newHabit("inactive", valueobject.NewDailyRecurrence(), false),
+		newHabit("mon-wed-fri", monWedFri, true),
+		newHabit("tue-thu", tueThu, true),
+		newHabit("every-3-days", everyThreeDays, true),
+		newHabit("every-2-days", everyTwoDays, true),
+		newHabit("twice-per-week", twicePerWeek, true),
+		newHabit("three-per-week", threePerWeek, true),
+	}
+
+	completions := []*entity.HabitCompletion{
+		// Quota met on Monday/Tuesday: not due anymore this week
+		entity.ReconstituteHabitCompletion("c1", "twice-per-week", "char-123", 10, 0, "Força", 1, 0, time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC)),
+		entity.ReconstituteHabitCompletion("c2", "twice-per-week", "char-123", 10, 0, "Força", 1, 0, time.Date(2024, 1, 9, 9, 0, 0, 0, time.UTC)),
+		// Last week's completion doesn't count, today's completion is reported
+		entity.ReconstituteHabitCompletion("c3", "three-per-week", "char-123", 10, 0, "Força", 1, 0, time.Date(2024, 1, 5, 9, 0, 0, 0, time.UTC)),
+		entity.ReconstituteHabitCompletion("c4", "three-per-week", "char-123", 10, 0, "Força", 1, 0, time.Date(2024, 1, 8, 9, 0, 0, 0, time.UTC)),
+		entity.ReconstituteHabitCompletion("c5", "three-per-week", "char-123", 10, 0, "Força", 1, 0, time.Date(2024, 1, 10, 7, 0, 0, 0, time.UTC)),
+		entity.ReconstituteHabitCompletion("c6", "daily", "char-123", 10, 0, "Força", 1, 0, time.Date(2024, 1, 10, 6, 0, 0, 0, time.UTC)),
+	}
+
+	var requestedFrom, requestedTo time.Time
+	habitRepo := &mockHabitRepository{
+		findAllByUserIDFunc: func(ctx context.Context, userID string) ([]*entity.Habit, error) {
+			return habits, nil
+		},
+	}
+	compRepo := &mockHabitCompletionRepository{
+		findBetweenFunc: func(ctx context.Context, userID string, from time.Time, to time.Time) ([]*entity.HabitCompletion, error) {
+			requestedFrom, requestedTo = from, to
+			return completions, nil
+		},
+	}
+
+	useCase := usecase.NewGetDueHabitsUseCase(habitRepo, compRepo, &mockUserPreferencesRepository{})
+
+	output, err := useCase.Execute(context.Background(), usecase.GetDueHabitsInput{
+		UserID: "user-123",
+		Date:   today,
+	})
+
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.Date != "2024-01-10" {
+		t.Errorf("output.Date = %v, want %v", output.Date, "2024-01-10")
+	}
+
+	// Completions are loaded from the start of the week up to the end of the day
+	if want := time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC); !requestedFrom.Equal(want) {
+		t.Errorf("completions from = %v, want %v", requestedFrom, want)
+	}
+	if want := time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC); !requestedTo.Equal(want) {
+		t.Errorf("completions to = %v, want %v", requestedTo, want)
+	}
+
+	due := map[string]usecase.DueHabitOutput{}
+	for _, habit := range output.Habits {
+		due[habit.Habit.ID] = habit
+	}
+
+	wantDue := []string{"daily", "mon-wed-fri", "every-3-days", "three-per-week"}
+	if len(due) != len(wantDue) {
+		t.Errorf("len(output.Habits) = %v, want %v", len(due), len(wantDue))
+	}
+	for _, id := range wantDue {
+		if _, ok := due[id]; !ok {
+			t.Errorf("habit %q should be due", id)
+		}
+	}
+
+	if !due["daily"].CompletedToday {
+		t.Error("daily CompletedToday = false, want true")
+	}
+
+	if due["mon-wed-fri"].CompletedToday {
+		t.Error("mon-wed-fri CompletedToday = true, want false")
+	}
+
+	if due["three-per-week"].PeriodCompletions != 2 {
+		t.Errorf("three-per-week PeriodCompletions = %v, want %v", due["three-per-week"].PeriodCompletions, 2)
+	}
+}
+
+func TestGetDueHabitsUseCase_UsesUserTimezone(t *testing.T) {
+	createdAt := time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+	easy, _ := valueobject.NewDifficulty("easy")
+	habit := entity.ReconstituteHabit("daily", "daily", "", "char-123", "Força", easy, valueobject.NewDailyRecurrence(), false, false, true, createdAt, createdAt)
+
+	// 01:30 UTC on the 10th: still the 9th in São Paulo, already the 10th in Lisbon
+	completion := entity.ReconstituteHabitCompletion("c1", "daily", "char-123", 10, 0, "Força", 1, 0, time.Date(2024, 1, 10, 1, 30, 0, 0, time.UTC))
+
+	tests := []struct {
+		timezone      string
+		wantFrom      time.Time // Start of the 9th in the user's time zone
+		wantCompleted bool
+	}{
+		{"America/Sao_Paulo", time.Date(2024, 1, 9, 3, 0, 0, 0, time.UTC), true},
+		{"Europe/Lisbon", time.Date(2024, 1, 9, 0, 0, 0, 0, time.UTC), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.timezone, func(t *testing.T) {
+			var requestedFrom time.Time
+			habitRepo := &mockHabitRepository{
+				findAllByUserIDFunc: func(ctx context.Context, userID string) ([]*entity.Habit, error) {
+					return []*entity.Habit{habit}, nil
+				},
+			}
+			compRepo := &mockHabitCompletionRepository{
+				findBetweenFunc: func(ctx context.Context, userID string, from time.Time, to time.Time) ([]*entity.HabitCompletion, error) {
+					requestedFrom = from
+					return []*entity.HabitCompletion{completion}, nil
+				},
+			}
+			preferencesRepo := &mockUserPreferencesRepository{}
+			preferencesRepo.save(t, "user-123", tt.timezone, 0)
+
+			output, err := usecase.NewGetDueHabitsUseCase(habitRepo, compRepo, preferencesRepo).Execute(context.Background(), usecase.GetDueHabitsInput{
+				UserID: "user-123",
+				Date:   time.Date(2024, 1, 9, 0, 0, 0, 0, time.UTC),
+			})
+			if err != nil {
+				t.Fatalf("Execute() error = %v, want nil", err)
+			}
+
+			if !requestedFrom.Equal(tt.wantFrom) {
+				t.Errorf("completions from = %v, want %v", requestedFrom, tt.wantFrom)
+			}
+			if len(output.Habits) != 1 {
+				t.Fatalf("len(output.Habits) = %v, want 1", len(output.Habits))
+			}
+			if output.Habits[0].CompletedToday != tt.wantCompleted {
+				t.Errorf("CompletedToday = %v, want %v", output.Habits[0].CompletedToday, tt.wantCompleted)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+// GetGuildRaidInput represents the input for viewing a guild's raid
+type GetGuildRaidInput struct {
+	GuildID     string
+	CharacterID string // Must belong to the guild
+	UserID      string // User ID from authentication token
+}
+
+// GetGuildRaidUseCase handles viewing the current (or last) raid of a guild
+type GetGuildRaidUseCase struct {
+	characterRepo repository.CharacterRepository
+	guildRepo     repository.GuildRepository
+	raidRepo      repository.RaidRepository
+}
+
+// NewGetGuildRaidUseCase creates a new GetGuildRaidUseCase
+func NewGetGuildRaidUseCase(
+	characterRepo repository.CharacterRepository,
+	guildRepo repository.GuildRepository,
+	raidRepo repository.RaidRepository,
+) *GetGuildRaidUseCase {
+	return &GetGuildRaidUseCase{
+		characterRepo: characterRepo,
+		guildRepo:     guildRepo,
+		raidRepo:      raidRepo,
+	}
+}
+
+// Execute retrieves the guild's latest raid with the damage dealt by each member
+// A raid that ran out of time is reported as expired, even before it is saved as such
+func (uc *GetGuildRaidUseCase) Execute(ctx context.Context, input GetGuildRaidInput) (*RaidOutput, error) {
+	// 1. Validate character exists AND belongs to the authenticated user
+	character, err := uc.characterRepo.FindByIDAndUserID(ctx, input.CharacterID, input.UserID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+
+	guild, err := uc.guildRepo.FindByID(ctx, input.GuildID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrGuildNotFound, input.GuildID)
+	}
+
+	// 2. Only members see the guild's raid
+	current, err := uc.guildRepo.FindByCharacterID(ctx, character.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch guild of character: %w", err)
+	}
+	if current == nil || current.ID() != guild.ID() {
+		return nil, ErrNotGuildMember
+	}
+
+	// 3. Load the raid and its contributions
+	raid, err := uc.raidRepo.FindLatestByGuildID(ctx, guild.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch raid: %w", err)
+	}
+	if raid == nil {
+		return nil, ErrRaidNotFound
+	}
+
+	contributions, err := uc.raidRepo.FindContributions(ctx, raid.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch raid contributions: %w", err)
+	}
+
+	if raid.IsOverdueAt(time.Now().UTC()) {
+		if err := raid.Expire(); err != nil {
+			return nil, fmt.Errorf("failed to expire raid: %w", err)
+		}
+	}
+
+	output := mapRaidToOutput(raid, contributions)
+	return &output, nil
+}
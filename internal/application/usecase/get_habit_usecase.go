@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+// GetHabitInput represents the input for getting a single habit
+type GetHabitInput struct {
+	HabitID string
+	UserID  string // User ID from authentication token
+}
+
+// GetHabitUseCase handles fetching a single habit
+type GetHabitUseCase struct {
+	habitRepo           repository.HabitRepository
+	habitCompletionRepo repository.HabitCompletionRepository
+	streakFreezeRepo    repository.StreakFreezeRepository
+	preferencesRepo     repository.UserPreferencesRepository
+}
+
+// NewGetHabitUseCase creates a new GetHabitUseCase
+func NewGetHabitUseCase(
+	habitRepo repository.HabitRepository,
+	habitCompletionRepo repository.HabitCompletionRepository,
+	streakFreezeRepo repository.StreakFreezeRepository,
+	preferencesRepo repository.UserPreferencesRepository,
+) *GetHabitUseCase {
+	return &GetHabitUseCase{
+		habitRepo:           habitRepo,
+		habitCompletionRepo: habitCompletionRepo,
+		streakFreezeRepo:    streakFreezeRepo,
+		preferencesRepo:     preferencesRepo,
+	}
+}
+
+// Execute retrieves a habit owned by the user (with its streaks)
+func (uc *GetHabitUseCase) Execute(ctx context.Context, input GetHabitInput) (*HabitOutput, error) {
+	// Validate habit exists AND belongs to the authenticated user (in one query)
+	habit, err := uc.habitRepo.FindByIDAndUserID(ctx, input.HabitID, input.UserID)
+	if err != nil {
+		return nil, ErrHabitNotFound
+	}
+
+	completions, err := uc.habitCompletionRepo.FindByHabitID(ctx, habit.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch habit completions: %w", err)
+	}
+
+	freezes, err := uc.streakFreezeRepo.FindByHabitID(ctx, habit.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch streak freezes: %w", err)
+	}
+
+	clock, err := loadDayClock(ctx, uc.preferencesRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := mapHabitEntityToOutput(habit)
+	output.Streak = mapStreakToOutput(habit.CalculateStreak(completions, freezes, time.Now().UTC(), clock))
+	return &output, nil
+}
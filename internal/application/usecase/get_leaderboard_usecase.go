@@ -0,0 +1,119 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+// Default and maximum number of characters per leaderboard page
+const (
+	defaultLeaderboardPageSize = 20
+	maxLeaderboardPageSize     = 50
+)
+
+// GetLeaderboardInput represents the input for listing the ranked leaderboard
+type GetLeaderboardInput struct {
+	Page     int // 1-based, defaults to 1
+	PageSize int // Defaults to 20, at most 50
+}
+
+// LeaderboardEntryOutput represents one character of the leaderboard
+type LeaderboardEntryOutput struct {
+	Rank        int
+	CharacterID string
+	Name        string
+	Class       string
+	Level       int
+	Rating      int
+	Deviation   int // Grows while the character doesn't fight ranked battles
+	Wins        int
+	Losses      int
+	Draws       int
+}
+
+// GetLeaderboardOutput represents a page of the leaderboard (highest rating first)
+type GetLeaderboardOutput struct {
+	Entries  []LeaderboardEntryOutput
+	Page     int
+	PageSize int
+	Total    int
+}
+
+// GetLeaderboardUseCase handles listing the characters with ranked battles by rating
+type GetLeaderboardUseCase struct {
+	characterRepo       repository.CharacterRepository
+	characterRatingRepo repository.CharacterRatingRepository
+	ratingPeriod        time.Duration // Inactive time that grows the deviation by one Glicko-2 period
+}
+
+// NewGetLeaderboardUseCase creates a new GetLeaderboardUseCase
+func NewGetLeaderboardUseCase(
+	characterRepo repository.CharacterRepository,
+	characterRatingRepo repository.CharacterRatingRepository,
+	ratingPeriod time.Duration,
+) *GetLeaderboardUseCase {
+	return &GetLeaderboardUseCase{
+		characterRepo:       characterRepo,
+		characterRatingRepo: characterRatingRepo,
+		ratingPeriod:        ratingPeriod,
+	}
+}
+
+// Execute retrieves a page of the leaderboard
+// Only characters with ranked battles are ranked; deviations are reported as of now
+func (uc *GetLeaderboardUseCase) Execute(ctx context.Context, input GetLeaderboardInput) (*GetLeaderboardOutput, error) {
+	page, pageSize := input.Page, input.PageSize
+	if page == 0 {
+		page = 1
+	}
+	if pageSize == 0 {
+		pageSize = defaultLeaderboardPageSize
+	}
+	if page < 1 || pageSize < 1 || pageSize > maxLeaderboardPageSize {
+		return nil, fmt.Errorf("%w: page must be at least 1 and page size between 1 and %d", ErrInvalidPagination, maxLeaderboardPageSize)
+	}
+
+	offset := (page - 1) * pageSize
+	ratings, err := uc.characterRatingRepo.FindLeaderboard(ctx, pageSize, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch leaderboard: %w", err)
+	}
+
+	total, err := uc.characterRatingRepo.CountRated(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count rated characters: %w", err)
+	}
+
+	now := time.Now().UTC()
+	entries := make([]LeaderboardEntryOutput, len(ratings))
+	for i, rating := range ratings {
+		character, err := uc.characterRepo.FindByID(ctx, rating.CharacterID())
+		if err != nil {
+			return nil, fmt.Errorf("failed to fetch character %s: %w", rating.CharacterID(), err)
+		}
+
+		current := rating.CurrentRating(now, uc.ratingPeriod)
+		entries[i] = LeaderboardEntryOutput{
+			Rank:        offset + i + 1,
+			CharacterID: character.ID(),
+			Name:        character.Name(),
+			Class:       character.Class().Value(),
+			Level:       character.Level(),
+			Rating:      roundRating(current.Rating()),
+			Deviation:   roundRating(current.Deviation()),
+			Wins:        rating.Wins(),
+			Losses:      rating.Losses(),
+			Draws:       rating.Draws(),
+		}
+	}
+
+	return &GetLeaderboardOutput{
+		Entries:  entries,
+		Page:     page,
+		PageSize: pageSize,
+		Total:    total,
+	}, nil
+}
@@ -0,0 +1,58 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+)
+
+func TestGetLeaderboardUseCase_Execute_RanksByRating(t *testing.T) {
+	ratingRepo := newMockCharacterRatingRepository()
+	ratingRepo.ratings["char-123"] = newTestCharacterRating(t, "char-123", 1620, 60, 0)
+	ratingRepo.ratings["char-456"] = newTestCharacterRating(t, "char-456", 1710, 60, 30*24*time.Hour)
+	ratingRepo.ratings["char-789"] = newTestCharacterRating(t, "char-789", 1480, 60, 0)
+	uc := usecase.NewGetLeaderboardUseCase(newDuelistRepository(), ratingRepo, 24*time.Hour)
+
+	output, err := uc.Execute(context.Background(), usecase.GetLeaderboardInput{Page: 1, PageSize: 2})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.Total != 3 || len(output.Entries) != 2 {
+		t.Fatalf("total = %d, entries = %d, want 3 and 2", output.Total, len(output.Entries))
+	}
+
+	first, second := output.Entries[0], output.Entries[1]
+	if first.Rank != 1 || first.CharacterID != "char-456" || first.Name != "Rival" || first.Rating != 1710 {
+		t.Errorf("first = %+v, want Rival (char-456) at 1710", first)
+	}
+	if second.Rank != 2 || second.CharacterID != "char-123" || second.Level != 3 || second.Wins != 2 || second.Losses != 1 {
+		t.Errorf("second = %+v, want char-123 with its record", second)
+	}
+
+	// A month without ranked battles grew the deviation (the rating doesn't move)
+	if first.Deviation <= 60 || second.Deviation != 60 {
+		t.Errorf("deviations = %d and %d, want more than 60 for the inactive character and 60", first.Deviation, second.Deviation)
+	}
+
+	output, err = uc.Execute(context.Background(), usecase.GetLeaderboardInput{Page: 2, PageSize: 2})
+	if err != nil {
+		t.Fatalf("Execute() page 2 error = %v, want nil", err)
+	}
+	if len(output.Entries) != 1 || output.Entries[0].Rank != 3 || output.Entries[0].CharacterID != "char-789" {
+		t.Errorf("page 2 = %+v, want char-789 ranked 3rd", output.Entries)
+	}
+}
+
+func TestGetLeaderboardUseCase_Execute_InvalidPagination(t *testing.T) {
+	uc := usecase.NewGetLeaderboardUseCase(newDuelistRepository(), newMockCharacterRatingRepository(), 24*time.Hour)
+
+	for _, input := range []usecase.GetLeaderboardInput{{Page: -1}, {PageSize: 51}} {
+		if _, err := uc.Execute(context.Background(), input); !errors.Is(err, usecase.ErrInvalidPagination) {
+			t.Errorf("Execute(%+v) error = %v, want %v", input, err, usecase.ErrInvalidPagination)
+		}
+	}
+}
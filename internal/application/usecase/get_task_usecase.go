@@ -0,0 +1,37 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+// GetTaskUseCase handles fetching a single task
+type GetTaskUseCase struct {
+	taskRepo        repository.TaskRepository
+	preferencesRepo repository.UserPreferencesRepository
+}
+
+// NewGetTaskUseCase creates a new GetTaskUseCase
+func NewGetTaskUseCase(taskRepo repository.TaskRepository, preferencesRepo repository.UserPreferencesRepository) *GetTaskUseCase {
+	return &GetTaskUseCase{
+		taskRepo:        taskRepo,
+		preferencesRepo: preferencesRepo,
+	}
+}
+
+// Execute retrieves a task owned by the user
+func (uc *GetTaskUseCase) Execute(ctx context.Context, input TaskInput) (*TaskOutput, error) {
+	task, err := uc.taskRepo.FindByIDAndUserID(ctx, input.TaskID, input.UserID)
+	if err != nil {
+		return nil, ErrTaskNotFound
+	}
+
+	today, err := userToday(ctx, uc.preferencesRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := mapTaskEntityToOutput(task, today)
+	return &output, nil
+}
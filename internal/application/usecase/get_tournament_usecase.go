@@ -0,0 +1,185 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+// GetTournamentInput represents the input for viewing a tournament's bracket
+type GetTournamentInput struct {
+	TournamentID string
+}
+
+// TournamentEntrantOutput represents a character that joined a tournament
+type TournamentEntrantOutput struct {
+	CharacterID string
+	Seed        int // 0 until the bracket is seeded
+	SeedScore   int // Level or rating the character was seeded by
+	JoinedAt    string
+}
+
+// TournamentMatchOutput represents a pairing of a round and, once fought, its result
+type TournamentMatchOutput struct {
+	Slot                int
+	CharacterID         string // Better seed
+	OpponentCharacterID string // Empty on a bye
+	Bye                 bool
+	BattleID            string // Empty on a bye or until fought
+	WinnerCharacterID   string // Empty until fought, or on a round-robin draw
+	PlayedAt            string // Empty until fought
+}
+
+// TournamentRoundOutput represents a round of a tournament
+type TournamentRoundOutput struct {
+	Round       int
+	ScheduledAt string
+	Played      bool
+	Matches     []TournamentMatchOutput
+}
+
+// TournamentStandingOutput represents an entrant's record in a tournament
+type TournamentStandingOutput struct {
+	Place             int
+	CharacterID       string
+	Seed              int
+	Wins              int
+	Draws             int
+	Losses            int
+	Points            int
+	EliminatedInRound int // Single-elimination: 0 while the character is still in the bracket
+}
+
+// TournamentPrizeOutput represents the XP a finisher was awarded
+type TournamentPrizeOutput struct {
+	CharacterID string
+	Place       int
+	Xp          int
+}
+
+// TournamentBracketOutput represents the state of a tournament's bracket
+type TournamentBracketOutput struct {
+	Tournament TournamentOutput
+	Entrants   []TournamentEntrantOutput  // By seed, then by join time
+	Rounds     []TournamentRoundOutput    // Fought rounds, then the next one while running
+	Standings  []TournamentStandingOutput // Empty until the bracket is seeded
+	Prizes     []TournamentPrizeOutput    // Empty until completed
+}
+
+// GetTournamentUseCase handles viewing the bracket of a tournament
+type GetTournamentUseCase struct {
+	tournamentRepo repository.TournamentRepository
+}
+
+// NewGetTournamentUseCase creates a new GetTournamentUseCase
+func NewGetTournamentUseCase(tournamentRepo repository.TournamentRepository) *GetTournamentUseCase {
+	return &GetTournamentUseCase{
+		tournamentRepo: tournamentRepo,
+	}
+}
+
+// Execute retrieves a tournament with its entrants, its rounds (including the pairings of the next one) and its standings
+func (uc *GetTournamentUseCase) Execute(ctx context.Context, input GetTournamentInput) (*TournamentBracketOutput, error) {
+	tournament, err := uc.tournamentRepo.FindByID(ctx, input.TournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrTournamentNotFound, input.TournamentID)
+	}
+
+	entrants, err := uc.tournamentRepo.FindEntrants(ctx, tournament.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch tournament entrants: %w", err)
+	}
+
+	matches, err := uc.tournamentRepo.FindMatches(ctx, tournament.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch tournament matches: %w", err)
+	}
+
+	output := &TournamentBracketOutput{
+		Tournament: mapTournamentToOutput(tournament, len(entrants)),
+		Entrants:   make([]TournamentEntrantOutput, 0, len(entrants)),
+		Rounds:     []TournamentRoundOutput{},
+		Standings:  []TournamentStandingOutput{},
+		Prizes:     []TournamentPrizeOutput{},
+	}
+
+	for _, entrant := range entrants {
+		output.Entrants = append(output.Entrants, TournamentEntrantOutput{
+			CharacterID: entrant.CharacterID(),
+			Seed:        entrant.Seed(),
+			SeedScore:   entrant.SeedScore(),
+			JoinedAt:    entrant.JoinedAt().Format("2006-01-02T15:04:05Z07:00"),
+		})
+	}
+
+	// Fought rounds, grouped from the matches (ordered by round and slot)
+	for _, match := range matches {
+		if len(output.Rounds) < match.Round() {
+			output.Rounds = append(output.Rounds, TournamentRoundOutput{
+				Round:       match.Round(),
+				ScheduledAt: tournament.RoundAt(match.Round()).Format("2006-01-02T15:04:05Z07:00"),
+				Played:      true,
+			})
+		}
+		round := &output.Rounds[len(output.Rounds)-1]
+		round.Matches = append(round.Matches, mapTournamentMatchToOutput(match))
+	}
+
+	// The pairings of the next round are known as soon as the previous one was fought
+	if tournament.Status() == entity.TournamentRunning {
+		pairings, err := tournament.Pairings(entrants, matches)
+		if err != nil {
+			return nil, fmt.Errorf("failed to pair next round: %w", err)
+		}
+
+		next := TournamentRoundOutput{
+			Round:       tournament.RoundsPlayed() + 1,
+			ScheduledAt: tournament.NextRoundAt().Format("2006-01-02T15:04:05Z07:00"),
+		}
+		for _, pairing := range pairings {
+			next.Matches = append(next.Matches, TournamentMatchOutput{
+				Slot:                pairing.Slot,
+				CharacterID:         pairing.CharacterID,
+				OpponentCharacterID: pairing.OpponentID,
+				Bye:                 pairing.IsBye(),
+			})
+		}
+		output.Rounds = append(output.Rounds, next)
+	}
+
+	if tournament.Status() == entity.TournamentRunning || tournament.Status() == entity.TournamentCompleted {
+		for i, standing := range tournament.Standings(entrants, matches) {
+			output.Standings = append(output.Standings, TournamentStandingOutput{
+				Place:             i + 1,
+				CharacterID:       standing.CharacterID,
+				Seed:              standing.Seed,
+				Wins:              standing.Wins,
+				Draws:             standing.Draws,
+				Losses:            standing.Losses,
+				Points:            standing.Points,
+				EliminatedInRound: standing.EliminatedInRound,
+			})
+		}
+	}
+
+	for _, prize := range tournament.Prizes() {
+		output.Prizes = append(output.Prizes, TournamentPrizeOutput{CharacterID: prize.CharacterID, Place: prize.Place, Xp: prize.Xp})
+	}
+
+	return output, nil
+}
+
+// mapTournamentMatchToOutput converts a fought TournamentMatch entity to output format
+func mapTournamentMatchToOutput(match *entity.TournamentMatch) TournamentMatchOutput {
+	return TournamentMatchOutput{
+		Slot:                match.Slot(),
+		CharacterID:         match.CharacterID(),
+		OpponentCharacterID: match.OpponentID(),
+		Bye:                 match.IsBye(),
+		BattleID:            match.BattleID(),
+		WinnerCharacterID:   match.WinnerID(),
+		PlayedAt:            match.PlayedAt().Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
@@ -15,13 +15,15 @@ type GetUserCharactersInput struct {
 
 // CharacterOutput represents a single character in the output
 type CharacterOutput struct {
-	ID        string
-	Name      string
-	Level     int
-	CurrentXp int
-	TotalXp   int
-	UserID    string
-	CreatedAt string
+	ID                     string
+	Name                   string
+	Class                  string
+	Level                  int
+	CurrentXp              int
+	TotalXp                int
+	UnspentAttributePoints int
+	UserID                 string
+	CreatedAt              string
 }
 
 // GetUserCharactersOutput represents the output after getting user's characters
@@ -65,12 +67,14 @@ func (uc *GetUserCharactersUseCase) Execute(ctx context.Context, input GetUserCh
 // mapCharacterEntityToOutput converts a Character entity to output format
 func mapCharacterEntityToOutput(char *entity.Character) CharacterOutput {
 	return CharacterOutput{
-		ID:        char.ID(),
-		Name:      char.Name(),
-		Level:     char.Level(),
-		CurrentXp: char.CurrentXp(),
-		TotalXp:   char.TotalXp(),
-		UserID:    char.UserID(),
-		CreatedAt: char.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+		ID:                     char.ID(),
+		Name:                   char.Name(),
+		Class:                  char.Class().Value(),
+		Level:                  char.Level(),
+		CurrentXp:              char.CurrentXp(),
+		TotalXp:                char.TotalXp(),
+		UnspentAttributePoints: char.UnspentAttributePoints(),
+		UserID:                 char.UserID(),
+		CreatedAt:              char.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
 	}
 }
@@ -8,6 +8,7 @@ import (
 
 	"github.com/igor/chronotask-api/internal/application/usecase"
 	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
 )
 
 // Mock CharacterRepository for list tests
@@ -23,6 +24,10 @@ func (m *mockCharacterRepositoryForList) FindByID(ctx context.Context, id string
 	return nil, errors.New("not implemented")
 }
 
+func (m *mockCharacterRepositoryForList) FindByIDForUpdate(ctx context.Context, id string) (*entity.Character, error) {
+	return nil, errors.New("not implemented")
+}
+
 func (m *mockCharacterRepositoryForList) FindByIDAndUserID(ctx context.Context, id string, userID string) (*entity.Character, error) {
 	return nil, errors.New("not implemented")
 }
@@ -54,9 +59,11 @@ func TestGetUserCharactersUseCase_Execute_SingleCharacter(t *testing.T) {
 	mockCharacter := entity.ReconstituteCharacter(
 		"char-123",
 		"Warrior King",
+		valueobject.DefaultCharacterClass(),
 		5,
 		50,
 		500,
+		0,
 		"user-123",
 		time.Now(),
 	)
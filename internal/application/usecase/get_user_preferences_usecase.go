@@ -0,0 +1,99 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// ErrInvalidPreferences is returned when the time zone, week start or rollover hour is not valid
+var ErrInvalidPreferences = errors.New("invalid user preferences")
+
+// GetUserPreferencesInput represents the input for fetching a user's preferences
+type GetUserPreferencesInput struct {
+	UserID string // User ID from authentication token
+}
+
+// UserPreferencesOutput represents a user's calendar preferences
+type UserPreferencesOutput struct {
+	Timezone        string // IANA time zone name
+	WeekStart       string // Lowercase weekday name (monday, sunday, ...)
+	DayRolloverHour int    // Local hour at which a new day starts
+	Today           string // The user's current day (YYYY-MM-DD)
+	UpdatedAt       string // Empty while the user keeps the defaults
+}
+
+// GetUserPreferencesUseCase handles fetching a user's preferences
+type GetUserPreferencesUseCase struct {
+	preferencesRepo repository.UserPreferencesRepository
+}
+
+// NewGetUserPreferencesUseCase creates a new GetUserPreferencesUseCase
+func NewGetUserPreferencesUseCase(preferencesRepo repository.UserPreferencesRepository) *GetUserPreferencesUseCase {
+	return &GetUserPreferencesUseCase{
+		preferencesRepo: preferencesRepo,
+	}
+}
+
+// Execute retrieves the user's preferences (the defaults when they were never saved)
+func (uc *GetUserPreferencesUseCase) Execute(ctx context.Context, input GetUserPreferencesInput) (*UserPreferencesOutput, error) {
+	preferences, err := findUserPreferences(ctx, uc.preferencesRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := mapUserPreferencesEntityToOutput(preferences, time.Now().UTC())
+	return &output, nil
+}
+
+// findUserPreferences loads the user's preferences, falling back to the defaults
+func findUserPreferences(ctx context.Context, preferencesRepo repository.UserPreferencesRepository, userID string) (*entity.UserPreferences, error) {
+	preferences, err := preferencesRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user preferences: %w", err)
+	}
+	if preferences == nil {
+		return entity.DefaultUserPreferences(userID), nil
+	}
+	return preferences, nil
+}
+
+// loadDayClock returns the clock that defines the user's days and weeks
+func loadDayClock(ctx context.Context, preferencesRepo repository.UserPreferencesRepository, userID string) (valueobject.DayClock, error) {
+	preferences, err := findUserPreferences(ctx, preferencesRepo, userID)
+	if err != nil {
+		return valueobject.DayClock{}, err
+	}
+	return preferences.Clock(), nil
+}
+
+// userToday returns the user's current calendar day
+func userToday(ctx context.Context, preferencesRepo repository.UserPreferencesRepository, userID string) (time.Time, error) {
+	clock, err := loadDayClock(ctx, preferencesRepo, userID)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return clock.Today(time.Now().UTC()), nil
+}
+
+// mapUserPreferencesEntityToOutput converts user preferences to the use case output
+func mapUserPreferencesEntityToOutput(preferences *entity.UserPreferences, now time.Time) UserPreferencesOutput {
+	clock := preferences.Clock()
+
+	output := UserPreferencesOutput{
+		Timezone:        clock.Timezone(),
+		WeekStart:       valueobject.WeekdayName(clock.WeekStart()),
+		DayRolloverHour: clock.RolloverHour(),
+		Today:           clock.Today(now).Format("2006-01-02"),
+	}
+	if !preferences.UpdatedAt().IsZero() {
+		output.UpdatedAt = preferences.UpdatedAt().Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	return output
+}
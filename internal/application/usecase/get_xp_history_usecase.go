@@ -0,0 +1,120 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+// Default and maximum number of XP transactions per history page
+const (
+	defaultXpHistoryPageSize = 20
+	maxXpHistoryPageSize     = 100
+)
+
+// ErrInvalidPagination is returned when a page or page size is out of range
+var ErrInvalidPagination = errors.New("invalid pagination")
+
+// GetXpHistoryInput represents the input for listing a character's XP ledger
+type GetXpHistoryInput struct {
+	CharacterID string
+	UserID      string // User ID from authentication token
+	Page        int    // 1-based, defaults to 1
+	PageSize    int    // Defaults to 20, at most 100
+}
+
+// XpTransactionOutput represents one XP change in the output
+type XpTransactionOutput struct {
+	ID               int64
+	Amount           int // Negative when XP was lost
+	SourceType       string
+	SourceID         string
+	ResultingLevel   int
+	ResultingTotalXp int
+	CreatedAt        string
+}
+
+// GetXpHistoryOutput represents a page of a character's XP ledger (most recent first)
+type GetXpHistoryOutput struct {
+	CharacterID  string
+	Transactions []XpTransactionOutput
+	Page         int
+	PageSize     int
+	Total        int
+}
+
+// GetXpHistoryUseCase handles fetching the XP ledger of a character
+type GetXpHistoryUseCase struct {
+	characterRepo     repository.CharacterRepository
+	xpTransactionRepo repository.XpTransactionRepository
+}
+
+// NewGetXpHistoryUseCase creates a new GetXpHistoryUseCase
+func NewGetXpHistoryUseCase(
+	characterRepo repository.CharacterRepository,
+	xpTransactionRepo repository.XpTransactionRepository,
+) *GetXpHistoryUseCase {
+	return &GetXpHistoryUseCase{
+		characterRepo:     characterRepo,
+		xpTransactionRepo: xpTransactionRepo,
+	}
+}
+
+// Execute retrieves a page of the XP changes of a character owned by the user
+func (uc *GetXpHistoryUseCase) Execute(ctx context.Context, input GetXpHistoryInput) (*GetXpHistoryOutput, error) {
+	page, pageSize := input.Page, input.PageSize
+	if page == 0 {
+		page = 1
+	}
+	if pageSize == 0 {
+		pageSize = defaultXpHistoryPageSize
+	}
+	if page < 1 || pageSize < 1 || pageSize > maxXpHistoryPageSize {
+		return nil, fmt.Errorf("%w: page must be at least 1 and page size between 1 and %d", ErrInvalidPagination, maxXpHistoryPageSize)
+	}
+
+	// Validate character exists AND belongs to the authenticated user (in one query)
+	character, err := uc.characterRepo.FindByIDAndUserID(ctx, input.CharacterID, input.UserID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+
+	transactions, err := uc.xpTransactionRepo.FindPageByCharacterID(ctx, character.ID(), pageSize, (page-1)*pageSize)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch xp transactions: %w", err)
+	}
+
+	total, err := uc.xpTransactionRepo.CountByCharacterID(ctx, character.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to count xp transactions: %w", err)
+	}
+
+	outputs := make([]XpTransactionOutput, len(transactions))
+	for i, transaction := range transactions {
+		outputs[i] = mapXpTransactionEntityToOutput(transaction)
+	}
+
+	return &GetXpHistoryOutput{
+		CharacterID:  character.ID(),
+		Transactions: outputs,
+		Page:         page,
+		PageSize:     pageSize,
+		Total:        total,
+	}, nil
+}
+
+// mapXpTransactionEntityToOutput converts an XpTransaction entity to output format
+func mapXpTransactionEntityToOutput(transaction *entity.XpTransaction) XpTransactionOutput {
+	return XpTransactionOutput{
+		ID:               transaction.ID(),
+		Amount:           transaction.Amount(),
+		SourceType:       transaction.Source().Type(),
+		SourceID:         transaction.Source().ID(),
+		ResultingLevel:   transaction.ResultingLevel(),
+		ResultingTotalXp: transaction.ResultingTotalXp(),
+		CreatedAt:        transaction.CreatedAt().Format("2006-01-02T15:04:05Z07:00"),
+	}
+}
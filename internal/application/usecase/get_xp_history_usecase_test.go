@@ -0,0 +1,121 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Mock XpTransactionRepository (transactions are stored oldest first)
+type mockXpTransactionRepository struct {
+	transactions []*entity.XpTransaction
+}
+
+func (m *mockXpTransactionRepository) FindPageByCharacterID(ctx context.Context, characterID string, limit int, offset int) ([]*entity.XpTransaction, error) {
+	var page []*entity.XpTransaction
+	for i := len(m.transactions) - 1 - offset; i >= 0 && len(page) < limit; i-- {
+		page = append(page, m.transactions[i])
+	}
+	return page, nil
+}
+
+func (m *mockXpTransactionRepository) CountByCharacterID(ctx context.Context, characterID string) (int, error) {
+	return len(m.transactions), nil
+}
+
+func (m *mockXpTransactionRepository) FindAllByCharacterID(ctx context.Context, characterID string) ([]*entity.XpTransaction, error) {
+	return m.transactions, nil
+}
+
+// newXpLedger records rewards of the given amounts for char-123 (ids 1, 2, ...)
+func newXpLedger(amounts ...int) *mockXpTransactionRepository {
+	repo := &mockXpTransactionRepository{}
+	totalXp := 0
+	for i, amount := range amounts {
+		totalXp += amount
+		source, _ := valueobject.NewXpSource(valueobject.XpSourceTaskCompletion, "task-123")
+		repo.transactions = append(repo.transactions, entity.ReconstituteXpTransaction(int64(i+1), "char-123", amount, source, 1, totalXp, time.Now()))
+	}
+	return repo
+}
+
+func newXpHistoryUseCase(ledger *mockXpTransactionRepository) *usecase.GetXpHistoryUseCase {
+	character := entity.ReconstituteCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), 1, 50, 50, 0, "user-123", time.Now())
+	charRepo := &mockCharacterRepositoryForAttributes{
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Character, error) {
+			if id == "char-123" && userID == "user-123" {
+				return character, nil
+			}
+			return nil, errors.New("character not found or does not belong to user")
+		},
+	}
+
+	return usecase.NewGetXpHistoryUseCase(charRepo, ledger)
+}
+
+func TestGetXpHistoryUseCase_Execute_Pagination(t *testing.T) {
+	uc := newXpHistoryUseCase(newXpLedger(5, 10, 15, 20, 25))
+
+	output, err := uc.Execute(context.Background(), usecase.GetXpHistoryInput{
+		CharacterID: "char-123",
+		UserID:      "user-123",
+		Page:        2,
+		PageSize:    2,
+	})
+
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.Total != 5 || output.Page != 2 || output.PageSize != 2 {
+		t.Errorf("output = (total %v, page %v, size %v), want (5, 2, 2)", output.Total, output.Page, output.PageSize)
+	}
+
+	// Most recent first: page 2 holds the 3rd and 2nd transactions
+	if len(output.Transactions) != 2 || output.Transactions[0].ID != 3 || output.Transactions[1].ID != 2 {
+		t.Fatalf("transactions = %+v, want ids [3 2]", output.Transactions)
+	}
+
+	if output.Transactions[0].Amount != 15 || output.Transactions[0].SourceType != valueobject.XpSourceTaskCompletion {
+		t.Errorf("transaction = %+v, want 15 XP from a task completion", output.Transactions[0])
+	}
+}
+
+func TestGetXpHistoryUseCase_Execute_Defaults(t *testing.T) {
+	uc := newXpHistoryUseCase(newXpLedger(5))
+
+	output, err := uc.Execute(context.Background(), usecase.GetXpHistoryInput{CharacterID: "char-123", UserID: "user-123"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.Page != 1 || output.PageSize != 20 || len(output.Transactions) != 1 {
+		t.Errorf("output = (page %v, size %v, %v transactions), want (1, 20, 1)", output.Page, output.PageSize, len(output.Transactions))
+	}
+}
+
+func TestGetXpHistoryUseCase_Execute_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		input usecase.GetXpHistoryInput
+		want  error
+	}{
+		{"character of another user", usecase.GetXpHistoryInput{CharacterID: "char-123", UserID: "user-456"}, usecase.ErrCharacterNotFound},
+		{"negative page", usecase.GetXpHistoryInput{CharacterID: "char-123", UserID: "user-123", Page: -1}, usecase.ErrInvalidPagination},
+		{"page too large", usecase.GetXpHistoryInput{CharacterID: "char-123", UserID: "user-123", PageSize: 500}, usecase.ErrInvalidPagination},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newXpHistoryUseCase(newXpLedger()).Execute(context.Background(), tt.input)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Execute() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Default and maximum number of levels in the XP table
+const (
+	defaultXpTableLevels = 50
+	maxXpTableLevels     = 500
+)
+
+// ErrInvalidXpTableRange is returned when the number of levels requested is out of range
+var ErrInvalidXpTableRange = errors.New("invalid xp table range")
+
+// GetXpTableInput represents the input for listing the XP needed by each level
+type GetXpTableInput struct {
+	Levels int // Levels 1..Levels, defaults to 50 (never beyond the level cap)
+}
+
+// XpTableLevelOutput represents one level of the XP table
+type XpTableLevelOutput struct {
+	Level           int
+	XpForNextLevel  int // 0 at the level cap
+	TotalXpRequired int // Total XP a character needs to reach this level
+}
+
+// GetXpTableOutput represents the XP table of the configured level curve
+type GetXpTableOutput struct {
+	CurveType string
+	MaxLevel  int // 0 when uncapped
+	Levels    []XpTableLevelOutput
+}
+
+// GetXpTableUseCase handles listing the XP table of the level curve (for the UI)
+type GetXpTableUseCase struct {
+	levelCurve valueobject.LevelCurve
+}
+
+// NewGetXpTableUseCase creates a new GetXpTableUseCase
+func NewGetXpTableUseCase(levelCurve valueobject.LevelCurve) *GetXpTableUseCase {
+	return &GetXpTableUseCase{
+		levelCurve: levelCurve,
+	}
+}
+
+// Execute computes the XP needed by levels 1..N
+func (uc *GetXpTableUseCase) Execute(ctx context.Context, input GetXpTableInput) (*GetXpTableOutput, error) {
+	levels := input.Levels
+	if levels == 0 {
+		levels = defaultXpTableLevels
+	}
+	if levels < 1 || levels > maxXpTableLevels {
+		return nil, fmt.Errorf("%w: levels must be between 1 and %d", ErrInvalidXpTableRange, maxXpTableLevels)
+	}
+
+	// Levels beyond the cap can't be reached
+	if maxLevel := uc.levelCurve.MaxLevel(); maxLevel > 0 && levels > maxLevel {
+		levels = maxLevel
+	}
+
+	table := make([]XpTableLevelOutput, levels)
+	totalXp := 0
+	for i := range table {
+		level := i + 1
+		xpForNextLevel := uc.levelCurve.XpForNextLevel(level)
+
+		table[i] = XpTableLevelOutput{
+			Level:           level,
+			XpForNextLevel:  xpForNextLevel,
+			TotalXpRequired: totalXp,
+		}
+		totalXp += xpForNextLevel
+	}
+
+	return &GetXpTableOutput{
+		CurveType: uc.levelCurve.Type(),
+		MaxLevel:  uc.levelCurve.MaxLevel(),
+		Levels:    table,
+	}, nil
+}
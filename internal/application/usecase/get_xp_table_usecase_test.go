@@ -0,0 +1,67 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+func TestGetXpTableUseCase_Execute_Defaults(t *testing.T) {
+	useCase := usecase.NewGetXpTableUseCase(valueobject.DefaultLevelCurve())
+
+	output, err := useCase.Execute(context.Background(), usecase.GetXpTableInput{})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.CurveType != valueobject.LevelCurvePolynomial {
+		t.Errorf("CurveType = %v, want %v", output.CurveType, valueobject.LevelCurvePolynomial)
+	}
+	if len(output.Levels) != 50 {
+		t.Fatalf("len(Levels) = %v, want 50", len(output.Levels))
+	}
+
+	// Level 1 needs 100 XP, level 2 283: reaching level 3 takes 383 XP in total
+	if output.Levels[0].XpForNextLevel != 100 || output.Levels[0].TotalXpRequired != 0 {
+		t.Errorf("Levels[0] = %+v, want 100 XP to level up from 0 total", output.Levels[0])
+	}
+	if output.Levels[2].Level != 3 || output.Levels[2].TotalXpRequired != 383 {
+		t.Errorf("Levels[2] = %+v, want level 3 at 383 total XP", output.Levels[2])
+	}
+}
+
+func TestGetXpTableUseCase_Execute_ClampsToMaxLevel(t *testing.T) {
+	curve, _ := valueobject.NewTableLevelCurve([]int{100, 200, 300}, 0)
+	useCase := usecase.NewGetXpTableUseCase(curve)
+
+	output, err := useCase.Execute(context.Background(), usecase.GetXpTableInput{Levels: 10})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.MaxLevel != 4 {
+		t.Errorf("MaxLevel = %v, want 4", output.MaxLevel)
+	}
+	if len(output.Levels) != 4 {
+		t.Fatalf("len(Levels) = %v, want 4", len(output.Levels))
+	}
+
+	last := output.Levels[3]
+	if last.Level != 4 || last.XpForNextLevel != 0 || last.TotalXpRequired != 600 {
+		t.Errorf("Levels[3] = %+v, want level 4 capped at 600 total XP", last)
+	}
+}
+
+func TestGetXpTableUseCase_Execute_InvalidRange(t *testing.T) {
+	useCase := usecase.NewGetXpTableUseCase(valueobject.DefaultLevelCurve())
+
+	for _, levels := range []int{-1, 501} {
+		_, err := useCase.Execute(context.Background(), usecase.GetXpTableInput{Levels: levels})
+		if !errors.Is(err, usecase.ErrInvalidXpTableRange) {
+			t.Errorf("Execute(%d) error = %v, want ErrInvalidXpTableRange", levels, err)
+		}
+	}
+}
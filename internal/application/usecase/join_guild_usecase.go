@@ -0,0 +1,69 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+// JoinGuildInput represents the input for joining a guild
+type JoinGuildInput struct {
+	GuildID     string
+	CharacterID string
+	UserID      string // User ID from authentication token
+}
+
+// JoinGuildUseCase handles a character joining a guild
+type JoinGuildUseCase struct {
+	characterRepo repository.CharacterRepository
+	guildRepo     repository.GuildRepository
+}
+
+// NewJoinGuildUseCase creates a new JoinGuildUseCase
+func NewJoinGuildUseCase(
+	characterRepo repository.CharacterRepository,
+	guildRepo repository.GuildRepository,
+) *JoinGuildUseCase {
+	return &JoinGuildUseCase{
+		characterRepo: characterRepo,
+		guildRepo:     guildRepo,
+	}
+}
+
+// Execute adds the character to the guild
+// A raid already in progress keeps the HP pool it started with; the new member strikes it all the same
+func (uc *JoinGuildUseCase) Execute(ctx context.Context, input JoinGuildInput) (*GuildOutput, error) {
+	// 1. Validate character exists AND belongs to the authenticated user
+	character, err := uc.characterRepo.FindByIDAndUserID(ctx, input.CharacterID, input.UserID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+
+	guild, err := uc.guildRepo.FindByID(ctx, input.GuildID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrGuildNotFound, input.GuildID)
+	}
+
+	// 2. A character belongs to one guild at a time
+	current, err := uc.guildRepo.FindByCharacterID(ctx, character.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch guild of character: %w", err)
+	}
+	if current != nil {
+		return nil, fmt.Errorf("%w: %s", ErrAlreadyInGuild, current.Name())
+	}
+
+	if err := uc.guildRepo.AddMember(ctx, guild.ID(), character.ID(), time.Now().UTC()); err != nil {
+		return nil, fmt.Errorf("failed to join guild: %w", err)
+	}
+
+	members, err := uc.guildRepo.CountMembers(ctx, guild.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to count guild members: %w", err)
+	}
+
+	output := mapGuildToOutput(guild, members)
+	return &output, nil
+}
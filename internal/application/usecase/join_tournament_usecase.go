@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/port"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+// JoinTournamentInput represents the input for a character joining a tournament
+type JoinTournamentInput struct {
+	TournamentID string
+	CharacterID  string
+	UserID       string // User ID from authentication token
+}
+
+// JoinTournamentUseCase handles a character joining a tournament before its deadline
+type JoinTournamentUseCase struct {
+	characterRepo  repository.CharacterRepository
+	guildRepo      repository.GuildRepository
+	tournamentRepo repository.TournamentRepository
+	unitOfWork     port.UnitOfWork
+}
+
+// NewJoinTournamentUseCase creates a new JoinTournamentUseCase
+func NewJoinTournamentUseCase(
+	characterRepo repository.CharacterRepository,
+	guildRepo repository.GuildRepository,
+	tournamentRepo repository.TournamentRepository,
+	unitOfWork port.UnitOfWork,
+) *JoinTournamentUseCase {
+	return &JoinTournamentUseCase{
+		characterRepo:  characterRepo,
+		guildRepo:      guildRepo,
+		tournamentRepo: tournamentRepo,
+		unitOfWork:     unitOfWork,
+	}
+}
+
+// Execute adds the character to the tournament's entrants
+// Guild tournaments only accept members of the guild
+func (uc *JoinTournamentUseCase) Execute(ctx context.Context, input JoinTournamentInput) (*TournamentOutput, error) {
+	// 1. Validate character exists AND belongs to the authenticated user
+	character, err := uc.characterRepo.FindByIDAndUserID(ctx, input.CharacterID, input.UserID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+
+	// 2. Join with the tournament locked, so simultaneous joins can't overfill it or race the seeding
+	now := time.Now().UTC()
+	var output TournamentOutput
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		tournament, err := uc.tournamentRepo.FindByIDForUpdate(ctx, input.TournamentID)
+		if err != nil {
+			return fmt.Errorf("%w: %s", ErrTournamentNotFound, input.TournamentID)
+		}
+		if !tournament.IsOpenAt(now) {
+			return ErrTournamentClosed
+		}
+
+		if tournament.IsGuildTournament() {
+			guild, err := uc.guildRepo.FindByCharacterID(ctx, character.ID())
+			if err != nil {
+				return fmt.Errorf("failed to fetch guild of character: %w", err)
+			}
+			if guild == nil || guild.ID() != tournament.GuildID() {
+				return ErrNotGuildMember
+			}
+		}
+
+		entrants, err := uc.tournamentRepo.FindEntrants(ctx, tournament.ID())
+		if err != nil {
+			return fmt.Errorf("failed to fetch tournament entrants: %w", err)
+		}
+		for _, entrant := range entrants {
+			if entrant.CharacterID() == character.ID() {
+				return ErrAlreadyInTournament
+			}
+		}
+		if len(entrants) >= tournament.MaxEntrants() {
+			return ErrTournamentFull
+		}
+
+		entrant, err := entity.NewTournamentEntrant(tournament.ID(), character.ID(), now)
+		if err != nil {
+			return fmt.Errorf("failed to create tournament entrant: %w", err)
+		}
+		if err := uc.tournamentRepo.AddEntrant(ctx, entrant); err != nil {
+			return fmt.Errorf("failed to save tournament entrant: %w", err)
+		}
+
+		output = mapTournamentToOutput(tournament, len(entrants)+1)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &output, nil
+}
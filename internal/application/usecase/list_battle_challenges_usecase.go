@@ -0,0 +1,56 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+// ListBattleChallengesInput represents the input for listing a character's pending challenges
+type ListBattleChallengesInput struct {
+	CharacterID string
+	UserID      string // User ID from authentication token
+}
+
+// ListBattleChallengesUseCase handles listing the challenges waiting for an answer
+type ListBattleChallengesUseCase struct {
+	characterRepo       repository.CharacterRepository
+	battleChallengeRepo repository.BattleChallengeRepository
+}
+
+// NewListBattleChallengesUseCase creates a new ListBattleChallengesUseCase
+func NewListBattleChallengesUseCase(
+	characterRepo repository.CharacterRepository,
+	battleChallengeRepo repository.BattleChallengeRepository,
+) *ListBattleChallengesUseCase {
+	return &ListBattleChallengesUseCase{
+		characterRepo:       characterRepo,
+		battleChallengeRepo: battleChallengeRepo,
+	}
+}
+
+// Execute returns the pending challenges sent or received by one of the user's characters
+// Expired challenges are left out
+func (uc *ListBattleChallengesUseCase) Execute(ctx context.Context, input ListBattleChallengesInput) ([]BattleChallengeOutput, error) {
+	// 1. Validate character exists AND belongs to the authenticated user
+	character, err := uc.characterRepo.FindByIDAndUserID(ctx, input.CharacterID, input.UserID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+
+	// 2. Fetch the challenges still waiting for an answer
+	now := time.Now().UTC()
+	challenges, err := uc.battleChallengeRepo.FindPendingByCharacterID(ctx, character.ID(), now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch battle challenges: %w", err)
+	}
+
+	outputs := make([]BattleChallengeOutput, len(challenges))
+	for i, challenge := range challenges {
+		outputs[i] = mapBattleChallengeEntityToOutput(challenge, now)
+	}
+
+	return outputs, nil
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// ClassAttributeOutput represents how a class starts and grows one attribute
+type ClassAttributeOutput struct {
+	Name           string
+	Base           int
+	GrowthPerLevel int
+}
+
+// CharacterClassOutput represents a class of the catalog with its attribute profile
+type CharacterClassOutput struct {
+	Class       string // Value to send when creating a character
+	DisplayName string
+	Description string
+	Attributes  []ClassAttributeOutput
+}
+
+// ListCharacterClassesOutput represents the classes a character can be created with
+type ListCharacterClassesOutput struct {
+	Classes []CharacterClassOutput
+}
+
+// ListCharacterClassesUseCase handles listing the class catalog
+type ListCharacterClassesUseCase struct{}
+
+// NewListCharacterClassesUseCase creates a new ListCharacterClassesUseCase
+func NewListCharacterClassesUseCase() *ListCharacterClassesUseCase {
+	return &ListCharacterClassesUseCase{}
+}
+
+// Execute lists every class with its starting attributes and growth per level
+func (uc *ListCharacterClassesUseCase) Execute(ctx context.Context) (*ListCharacterClassesOutput, error) {
+	classes := valueobject.CharacterClasses()
+
+	outputs := make([]CharacterClassOutput, len(classes))
+	for i, class := range classes {
+		profiles := class.Attributes()
+		attributes := make([]ClassAttributeOutput, len(profiles))
+		for j, profile := range profiles {
+			attributes[j] = ClassAttributeOutput{
+				Name:           profile.Name,
+				Base:           profile.Base,
+				GrowthPerLevel: profile.GrowthPerLevel,
+			}
+		}
+
+		outputs[i] = CharacterClassOutput{
+			Class:       class.Value(),
+			DisplayName: class.DisplayName(),
+			Description: class.Description(),
+			Attributes:  attributes,
+		}
+	}
+
+	return &ListCharacterClassesOutput{
+		Classes: outputs,
+	}, nil
+}
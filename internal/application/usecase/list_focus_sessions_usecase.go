@@ -0,0 +1,92 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+// maxFocusSessionRangeDays limits how many days a single focus session query can span
+const maxFocusSessionRangeDays = 366
+
+// ErrInvalidDateRange is returned when a date range is reversed or too long
+var ErrInvalidDateRange = errors.New("invalid date range")
+
+// ListFocusSessionsInput represents the input for listing focus sessions in a date range
+type ListFocusSessionsInput struct {
+	UserID string    // User ID from authentication token
+	From   time.Time // First calendar day (inclusive), zero for the user's today
+	To     time.Time // Last calendar day (inclusive), zero for the user's today
+}
+
+// ListFocusSessionsOutput represents the focus sessions started in a date range
+type ListFocusSessionsOutput struct {
+	From           string // YYYY-MM-DD
+	To             string // YYYY-MM-DD
+	Sessions       []FocusSessionOutput
+	TrackedMinutes int // Sum of the tracked minutes of all sessions
+	XpAwarded      int // Sum of the XP awarded by all sessions
+}
+
+// ListFocusSessionsUseCase handles fetching the user's focus sessions by date range
+type ListFocusSessionsUseCase struct {
+	focusSessionRepo repository.FocusSessionRepository
+	preferencesRepo  repository.UserPreferencesRepository
+}
+
+// NewListFocusSessionsUseCase creates a new ListFocusSessionsUseCase
+func NewListFocusSessionsUseCase(
+	focusSessionRepo repository.FocusSessionRepository,
+	preferencesRepo repository.UserPreferencesRepository,
+) *ListFocusSessionsUseCase {
+	return &ListFocusSessionsUseCase{
+		focusSessionRepo: focusSessionRepo,
+		preferencesRepo:  preferencesRepo,
+	}
+}
+
+// Execute retrieves the focus sessions of a user started between two days (inclusive)
+func (uc *ListFocusSessionsUseCase) Execute(ctx context.Context, input ListFocusSessionsInput) (*ListFocusSessionsOutput, error) {
+	// The user's preferences define the day boundaries
+	clock, err := loadDayClock(ctx, uc.preferencesRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	from, to := clock.Today(now), clock.Today(now)
+	if !input.From.IsZero() {
+		from = clock.Date(input.From)
+	}
+	if !input.To.IsZero() {
+		to = clock.Date(input.To)
+	}
+
+	if to.Before(from) {
+		return nil, fmt.Errorf("%w: end date is before start date", ErrInvalidDateRange)
+	}
+	if to.Sub(from) > maxFocusSessionRangeDays*24*time.Hour {
+		return nil, fmt.Errorf("%w: range cannot exceed %d days", ErrInvalidDateRange, maxFocusSessionRangeDays)
+	}
+
+	sessions, err := uc.focusSessionRepo.FindByUserIDBetween(ctx, input.UserID, clock.DayStart(from), clock.DayStart(to.AddDate(0, 0, 1)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch focus sessions: %w", err)
+	}
+
+	output := &ListFocusSessionsOutput{
+		From:     from.Format("2006-01-02"),
+		To:       to.Format("2006-01-02"),
+		Sessions: make([]FocusSessionOutput, len(sessions)),
+	}
+	for i, session := range sessions {
+		output.Sessions[i] = mapFocusSessionEntityToOutput(session, now)
+		output.TrackedMinutes += output.Sessions[i].TrackedMinutes
+		output.XpAwarded += session.XpAwarded()
+	}
+
+	return output, nil
+}
@@ -0,0 +1,69 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+func TestListFocusSessionsUseCase_Execute(t *testing.T) {
+	start := time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)
+	first, _ := entity.NewFocusSession("focus-1", "char-123", "habit-123", start)
+	first.Stop(start.Add(30 * time.Minute))
+	second, _ := entity.NewFocusSession("focus-2", "char-123", "habit-123", start.AddDate(0, 0, 1))
+	second.Stop(start.AddDate(0, 0, 1).Add(45 * time.Minute))
+
+	var requestedFrom, requestedTo time.Time
+	sessionRepo := &mockFocusSessionRepository{
+		findBetweenFunc: func(ctx context.Context, userID string, from time.Time, to time.Time) ([]*entity.FocusSession, error) {
+			requestedFrom, requestedTo = from, to
+			return []*entity.FocusSession{first, second}, nil
+		},
+	}
+
+	useCase := usecase.NewListFocusSessionsUseCase(sessionRepo, &mockUserPreferencesRepository{})
+
+	output, err := useCase.Execute(context.Background(), usecase.ListFocusSessionsInput{
+		UserID: "user-123",
+		From:   time.Date(2024, 1, 10, 15, 0, 0, 0, time.UTC),
+		To:     time.Date(2024, 1, 11, 0, 0, 0, 0, time.UTC),
+	})
+
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	// Both days are inclusive
+	if want := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC); !requestedFrom.Equal(want) {
+		t.Errorf("sessions from = %v, want %v", requestedFrom, want)
+	}
+	if want := time.Date(2024, 1, 12, 0, 0, 0, 0, time.UTC); !requestedTo.Equal(want) {
+		t.Errorf("sessions to = %v, want %v", requestedTo, want)
+	}
+
+	if len(output.Sessions) != 2 {
+		t.Fatalf("len(output.Sessions) = %v, want %v", len(output.Sessions), 2)
+	}
+
+	if output.TrackedMinutes != 75 || output.XpAwarded != 75 {
+		t.Errorf("totals = (minutes %v, xp %v), want (75, 75)", output.TrackedMinutes, output.XpAwarded)
+	}
+}
+
+func TestListFocusSessionsUseCase_Execute_InvalidRange(t *testing.T) {
+	useCase := usecase.NewListFocusSessionsUseCase(&mockFocusSessionRepository{}, &mockUserPreferencesRepository{})
+
+	_, err := useCase.Execute(context.Background(), usecase.ListFocusSessionsInput{
+		UserID: "user-123",
+		From:   time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC),
+		To:     time.Date(2024, 1, 9, 0, 0, 0, 0, time.UTC),
+	})
+
+	if !errors.Is(err, usecase.ErrInvalidDateRange) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrInvalidDateRange)
+	}
+}
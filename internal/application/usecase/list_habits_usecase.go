@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+// ListHabitsInput represents the input for listing the user's habits
+type ListHabitsInput struct {
+	UserID string // User ID from authentication token
+}
+
+// ListHabitsOutput represents the output after listing the user's habits
+type ListHabitsOutput struct {
+	Habits []HabitOutput
+}
+
+// ListHabitsUseCase handles fetching all habits for a user
+type ListHabitsUseCase struct {
+	habitRepo           repository.HabitRepository
+	habitCompletionRepo repository.HabitCompletionRepository
+	streakFreezeRepo    repository.StreakFreezeRepository
+	preferencesRepo     repository.UserPreferencesRepository
+}
+
+// NewListHabitsUseCase creates a new ListHabitsUseCase
+func NewListHabitsUseCase(
+	habitRepo repository.HabitRepository,
+	habitCompletionRepo repository.HabitCompletionRepository,
+	streakFreezeRepo repository.StreakFreezeRepository,
+	preferencesRepo repository.UserPreferencesRepository,
+) *ListHabitsUseCase {
+	return &ListHabitsUseCase{
+		habitRepo:           habitRepo,
+		habitCompletionRepo: habitCompletionRepo,
+		streakFreezeRepo:    streakFreezeRepo,
+		preferencesRepo:     preferencesRepo,
+	}
+}
+
+// Execute retrieves all habits for a user (with their streaks)
+func (uc *ListHabitsUseCase) Execute(ctx context.Context, input ListHabitsInput) (*ListHabitsOutput, error) {
+	habits, err := uc.habitRepo.FindAllByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch user habits: %w", err)
+	}
+
+	if len(habits) == 0 {
+		return &ListHabitsOutput{Habits: []HabitOutput{}}, nil
+	}
+
+	// Load the whole history once (since the oldest habit was created)
+	now := time.Now().UTC()
+	oldest := now
+	for _, habit := range habits {
+		if habit.CreatedAt().Before(oldest) {
+			oldest = habit.CreatedAt()
+		}
+	}
+
+	completions, err := uc.habitCompletionRepo.FindByUserIDBetween(ctx, input.UserID, oldest, now.AddDate(0, 0, 1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch habit completions: %w", err)
+	}
+
+	freezes, err := uc.streakFreezeRepo.FindUsedByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch streak freezes: %w", err)
+	}
+
+	clock, err := loadDayClock(ctx, uc.preferencesRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Convert entities to output
+	habitOutputs := make([]HabitOutput, len(habits))
+	for i, habit := range habits {
+		habitOutputs[i] = mapHabitEntityToOutput(habit)
+		habitOutputs[i].Streak = mapStreakToOutput(habit.CalculateStreak(completions, freezes, now, clock))
+	}
+
+	return &ListHabitsOutput{
+		Habits: habitOutputs,
+	}, nil
+}
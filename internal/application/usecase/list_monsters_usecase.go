@@ -0,0 +1,125 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// ErrInvalidMonsterLevel is returned when the catalog is filtered by an invalid level
+var ErrInvalidMonsterLevel = errors.New("invalid monster level")
+
+// ListMonstersInput represents the input for listing the monster catalog
+type ListMonstersInput struct {
+	Level int // Optional: only monsters fighting at this level, scaled to it (0 lists every monster at its minimum level)
+}
+
+// LootDropOutput represents an item a monster drops, with its chance (%) of dropping
+type LootDropOutput struct {
+	Item     string
+	Chance   int
+	Quantity int
+}
+
+// MonsterOutput represents a monster of the catalog as it fights at Level
+type MonsterOutput struct {
+	ID         string
+	Name       string
+	MinLevel   int
+	MaxLevel   int
+	Level      int
+	Attributes map[string]int
+	HP         int
+	Attack     int
+	Magic      int
+	Defense    int
+	Evasion    int
+	Initiative int
+	XpReward   int
+	Loot       []LootDropOutput
+}
+
+// ListMonstersOutput represents the monsters of the catalog (lowest level first)
+type ListMonstersOutput struct {
+	Monsters []MonsterOutput
+}
+
+// ListMonstersUseCase handles listing the monster catalog
+type ListMonstersUseCase struct {
+	monsterRepo repository.MonsterRepository
+}
+
+// NewListMonstersUseCase creates a new ListMonstersUseCase
+func NewListMonstersUseCase(monsterRepo repository.MonsterRepository) *ListMonstersUseCase {
+	return &ListMonstersUseCase{
+		monsterRepo: monsterRepo,
+	}
+}
+
+// Execute lists the monsters, optionally only those a character of the given level fights
+func (uc *ListMonstersUseCase) Execute(ctx context.Context, input ListMonstersInput) (*ListMonstersOutput, error) {
+	if input.Level < 0 {
+		return nil, fmt.Errorf("%w: level must be at least 1, got %d", ErrInvalidMonsterLevel, input.Level)
+	}
+
+	var (
+		monsters []*entity.Monster
+		err      error
+	)
+	if input.Level == 0 {
+		monsters, err = uc.monsterRepo.FindAll(ctx)
+	} else {
+		monsters, err = uc.monsterRepo.FindByLevel(ctx, input.Level)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch monsters: %w", err)
+	}
+
+	outputs := make([]MonsterOutput, len(monsters))
+	for i, monster := range monsters {
+		outputs[i] = mapMonsterToOutput(monster, monster.LevelFor(input.Level))
+	}
+
+	return &ListMonstersOutput{
+		Monsters: outputs,
+	}, nil
+}
+
+// mapMonsterToOutput converts a Monster entity scaled to a level to output format
+func mapMonsterToOutput(monster *entity.Monster, level int) MonsterOutput {
+	stats := monster.CombatStatsAt(level)
+
+	return MonsterOutput{
+		ID:         monster.ID(),
+		Name:       monster.Name(),
+		MinLevel:   monster.MinLevel(),
+		MaxLevel:   monster.MaxLevel(),
+		Level:      level,
+		Attributes: monster.AttributesAt(level),
+		HP:         stats.HP(),
+		Attack:     stats.Attack(),
+		Magic:      stats.Magic(),
+		Defense:    stats.Defense(),
+		Evasion:    stats.Evasion(),
+		Initiative: stats.Initiative(),
+		XpReward:   monster.XpRewardAt(level),
+		Loot:       mapLootDropsToOutput(monster.Loot()),
+	}
+}
+
+// mapLootDropsToOutput converts loot drops to output format
+func mapLootDropsToOutput(loot []valueobject.LootDrop) []LootDropOutput {
+	outputs := make([]LootDropOutput, len(loot))
+	for i, drop := range loot {
+		outputs[i] = LootDropOutput{
+			Item:     drop.Item(),
+			Chance:   drop.Chance(),
+			Quantity: drop.Quantity(),
+		}
+	}
+	return outputs
+}
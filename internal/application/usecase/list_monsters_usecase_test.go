@@ -0,0 +1,63 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+)
+
+func TestListMonstersUseCase_Execute_All(t *testing.T) {
+	uc := usecase.NewListMonstersUseCase(newTestMonsterCatalog())
+
+	output, err := uc.Execute(context.Background(), usecase.ListMonstersInput{})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if len(output.Monsters) != 3 || output.Monsters[0].ID != "rato-gigante" || output.Monsters[2].ID != "ogro" {
+		t.Fatalf("monsters = %+v, want the catalog from the rat to the ogre", output.Monsters)
+	}
+
+	// Without a level, monsters are shown at their minimum level
+	wolf := output.Monsters[1]
+	if wolf.MinLevel != 3 || wolf.MaxLevel != 8 || wolf.Level != 3 || wolf.XpReward != 40 || wolf.Attributes["Força"] != 4 {
+		t.Errorf("wolf = %+v, want the level 3 wolf", wolf)
+	}
+	if len(wolf.Loot) != 1 || wolf.Loot[0].Item != "Pele de Lobo" || wolf.Loot[0].Chance != 50 {
+		t.Errorf("wolf.Loot = %+v, want its pelt at 50%%", wolf.Loot)
+	}
+}
+
+func TestListMonstersUseCase_Execute_ByLevel(t *testing.T) {
+	uc := usecase.NewListMonstersUseCase(newTestMonsterCatalog())
+
+	output, err := uc.Execute(context.Background(), usecase.ListMonstersInput{Level: 6})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	// Only the wolf fights at level 6, scaled to it
+	if len(output.Monsters) != 1 {
+		t.Fatalf("monsters = %+v, want only the wolf", output.Monsters)
+	}
+	wolf := output.Monsters[0]
+	if wolf.ID != "lobo-cinzento" || wolf.Level != 6 || wolf.XpReward != 52 || wolf.Attributes["Força"] != 5 {
+		t.Errorf("wolf = %+v, want the wolf scaled to level 6", wolf)
+	}
+
+	want := newTestMonsterCatalog().monsters["lobo-cinzento"].CombatStatsAt(6)
+	if wolf.HP != want.HP() || wolf.Defense != want.Defense() || wolf.Initiative != want.Initiative() {
+		t.Errorf("wolf stats = %+v, want %+v", wolf, want)
+	}
+}
+
+func TestListMonstersUseCase_Execute_InvalidLevel(t *testing.T) {
+	uc := usecase.NewListMonstersUseCase(newTestMonsterCatalog())
+
+	_, err := uc.Execute(context.Background(), usecase.ListMonstersInput{Level: -1})
+	if !errors.Is(err, usecase.ErrInvalidMonsterLevel) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrInvalidMonsterLevel)
+	}
+}
@@ -0,0 +1,89 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+// TaskStatusOverdue filters open tasks whose due date has passed
+const TaskStatusOverdue = "overdue"
+
+// ErrInvalidTaskStatus is returned when listing tasks with an unknown status filter
+var ErrInvalidTaskStatus = errors.New("invalid task status: must be one of open, completed, overdue")
+
+// ListTasksInput represents the input for listing the user's tasks
+type ListTasksInput struct {
+	UserID  string     // User ID from authentication token
+	Status  string     // Optional: open, completed or overdue
+	DueFrom *time.Time // Optional first due date (inclusive)
+	DueTo   *time.Time // Optional last due date (inclusive)
+}
+
+// ListTasksOutput represents the user's tasks
+type ListTasksOutput struct {
+	Tasks []TaskOutput
+}
+
+// ListTasksUseCase handles fetching the user's tasks
+type ListTasksUseCase struct {
+	taskRepo        repository.TaskRepository
+	preferencesRepo repository.UserPreferencesRepository
+}
+
+// NewListTasksUseCase creates a new ListTasksUseCase
+func NewListTasksUseCase(taskRepo repository.TaskRepository, preferencesRepo repository.UserPreferencesRepository) *ListTasksUseCase {
+	return &ListTasksUseCase{
+		taskRepo:        taskRepo,
+		preferencesRepo: preferencesRepo,
+	}
+}
+
+// Execute retrieves the tasks of all characters owned by the user, filtered by status and due window
+func (uc *ListTasksUseCase) Execute(ctx context.Context, input ListTasksInput) (*ListTasksOutput, error) {
+	filter := repository.TaskFilter{
+		DueFrom: input.DueFrom,
+		DueTo:   input.DueTo,
+	}
+
+	if input.DueFrom != nil && input.DueTo != nil && input.DueTo.Before(*input.DueFrom) {
+		return nil, fmt.Errorf("%w: end date is before start date", ErrInvalidDateRange)
+	}
+
+	today, err := userToday(ctx, uc.preferencesRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	switch input.Status {
+	case "", entity.TaskOpen, entity.TaskCompleted:
+		filter.Status = input.Status
+	case TaskStatusOverdue:
+		// Overdue tasks are open tasks due before today
+		filter.Status = entity.TaskOpen
+		yesterday := time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+		if filter.DueTo == nil || filter.DueTo.After(yesterday) {
+			filter.DueTo = &yesterday
+		}
+	default:
+		return nil, ErrInvalidTaskStatus
+	}
+
+	tasks, err := uc.taskRepo.FindByUserID(ctx, input.UserID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch tasks: %w", err)
+	}
+
+	output := &ListTasksOutput{
+		Tasks: make([]TaskOutput, len(tasks)),
+	}
+	for i, task := range tasks {
+		output.Tasks[i] = mapTaskEntityToOutput(task, today)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+// PauseFocusSessionUseCase handles pausing a running focus session
+type PauseFocusSessionUseCase struct {
+	focusSessionRepo repository.FocusSessionRepository
+}
+
+// NewPauseFocusSessionUseCase creates a new PauseFocusSessionUseCase
+func NewPauseFocusSessionUseCase(
+	focusSessionRepo repository.FocusSessionRepository,
+) *PauseFocusSessionUseCase {
+	return &PauseFocusSessionUseCase{
+		focusSessionRepo: focusSessionRepo,
+	}
+}
+
+// Execute pauses a running focus session owned by the user
+func (uc *PauseFocusSessionUseCase) Execute(ctx context.Context, input FocusSessionInput) (*FocusSessionOutput, error) {
+	// Validate session exists AND belongs to the authenticated user
+	session, err := uc.focusSessionRepo.FindByIDAndUserID(ctx, input.SessionID, input.UserID)
+	if err != nil {
+		return nil, ErrFocusSessionNotFound
+	}
+
+	switch session.Status() {
+	case entity.FocusSessionCompleted:
+		return nil, ErrFocusSessionEnded
+	case entity.FocusSessionPaused:
+		return nil, ErrFocusSessionNotRunning
+	}
+
+	now := time.Now().UTC()
+	if err := session.Pause(now); err != nil {
+		return nil, fmt.Errorf("failed to pause focus session: %w", err)
+	}
+
+	if err := uc.focusSessionRepo.Update(ctx, session); err != nil {
+		return nil, fmt.Errorf("failed to save focus session: %w", err)
+	}
+
+	output := mapFocusSessionEntityToOutput(session, now)
+	return &output, nil
+}
@@ -0,0 +1,320 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igor/chronotask-api/internal/application/port"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// maxDayEndCatchUpDays is how many finished days a single run can process for a user
+// Days missed by the job beyond that window (e.g. during a long outage) are never penalized
+const maxDayEndCatchUpDays = 7
+
+// PenalizeMissedHabitsInput represents the input for penalizing the habits a user missed on their finished days
+type PenalizeMissedHabitsInput struct {
+	UserID string
+	Now    time.Time // The user's finished days before Now that weren't processed yet are evaluated
+}
+
+// HabitPenaltyOutput represents a penalty applied for a missed habit
+type HabitPenaltyOutput struct {
+	HabitID       string
+	CharacterID   string
+	Day           string // YYYY-MM-DD
+	XpLost        int
+	LevelsLost    int
+	AttributeName string
+	AttributeLoss int
+}
+
+// PenalizeMissedHabitsOutput represents the output after processing a user's days
+type PenalizeMissedHabitsOutput struct {
+	UserID    string
+	Day       string   // User's last finished day (YYYY-MM-DD)
+	Days      []string // Days processed by this run, oldest first (YYYY-MM-DD)
+	Skipped   bool     // No day processed: all already were, or are being processed by another replica
+	Penalties []HabitPenaltyOutput
+}
+
+// PenalizeMissedHabitsUseCase applies the configured penalty for every scheduled habit a user missed on a day
+// It is idempotent per user/day and safe to run from several API replicas at once
+type PenalizeMissedHabitsUseCase struct {
+	habitRepo              repository.HabitRepository
+	habitCompletionRepo    repository.HabitCompletionRepository
+	streakFreezeRepo       repository.StreakFreezeRepository
+	habitPenaltyRepo       repository.HabitPenaltyRepository
+	dayEndRunRepo          repository.DayEndRunRepository
+	characterRepo          repository.CharacterRepository
+	characterAttributeRepo repository.CharacterAttributeRepository
+	preferencesRepo        repository.UserPreferencesRepository
+	lockService            port.LockService
+	penalty                valueobject.MissedHabitPenalty
+	unitOfWork             port.UnitOfWork
+}
+
+// NewPenalizeMissedHabitsUseCase creates a new PenalizeMissedHabitsUseCase
+func NewPenalizeMissedHabitsUseCase(
+	habitRepo repository.HabitRepository,
+	habitCompletionRepo repository.HabitCompletionRepository,
+	streakFreezeRepo repository.StreakFreezeRepository,
+	habitPenaltyRepo repository.HabitPenaltyRepository,
+	dayEndRunRepo repository.DayEndRunRepository,
+	characterRepo repository.CharacterRepository,
+	characterAttributeRepo repository.CharacterAttributeRepository,
+	preferencesRepo repository.UserPreferencesRepository,
+	lockService port.LockService,
+	penalty valueobject.MissedHabitPenalty,
+	unitOfWork port.UnitOfWork,
+) *PenalizeMissedHabitsUseCase {
+	return &PenalizeMissedHabitsUseCase{
+		habitRepo:              habitRepo,
+		habitCompletionRepo:    habitCompletionRepo,
+		streakFreezeRepo:       streakFreezeRepo,
+		habitPenaltyRepo:       habitPenaltyRepo,
+		dayEndRunRepo:          dayEndRunRepo,
+		characterRepo:          characterRepo,
+		characterAttributeRepo: characterAttributeRepo,
+		preferencesRepo:        preferencesRepo,
+		lockService:            lockService,
+		penalty:                penalty,
+		unitOfWork:             unitOfWork,
+	}
+}
+
+// Execute penalizes the user's missed habits of every finished day not processed yet
+// Days missed by the job since the user's last processed day are caught up, up to maxDayEndCatchUpDays
+func (uc *PenalizeMissedHabitsUseCase) Execute(ctx context.Context, input PenalizeMissedHabitsInput) (*PenalizeMissedHabitsOutput, error) {
+	// The user's preferences define when their day ends
+	clock, err := loadDayClock(ctx, uc.preferencesRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	lastFinishedDay := clock.Today(input.Now).AddDate(0, 0, -1)
+	output := &PenalizeMissedHabitsOutput{
+		UserID:    input.UserID,
+		Day:       lastFinishedDay.Format("2006-01-02"),
+		Days:      []string{},
+		Skipped:   true,
+		Penalties: []HabitPenaltyOutput{},
+	}
+
+	days, err := uc.unprocessedDays(ctx, input.UserID, lastFinishedDay, clock)
+	if err != nil {
+		return nil, err
+	}
+
+	// Days are processed oldest first: a failure leaves the later ones for the next run
+	for _, day := range days {
+		penalties, processed, err := uc.processDay(ctx, input.UserID, day, clock)
+		if err != nil {
+			return nil, err
+		}
+		if !processed {
+			continue
+		}
+
+		output.Skipped = false
+		output.Days = append(output.Days, day.Format("2006-01-02"))
+		output.Penalties = append(output.Penalties, penalties...)
+	}
+
+	return output, nil
+}
+
+// unprocessedDays lists the finished days after the user's last processed day, oldest first
+// A user's first run only evaluates their last finished day
+func (uc *PenalizeMissedHabitsUseCase) unprocessedDays(
+	ctx context.Context,
+	userID string,
+	lastFinishedDay time.Time,
+	clock valueobject.DayClock,
+) ([]time.Time, error) {
+	lastProcessed, err := uc.dayEndRunRepo.FindLastDay(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch last day end run: %w", err)
+	}
+
+	first := lastFinishedDay
+	if lastProcessed != nil {
+		first = clock.Date(*lastProcessed).AddDate(0, 0, 1)
+		if oldest := lastFinishedDay.AddDate(0, 0, 1-maxDayEndCatchUpDays); first.Before(oldest) {
+			first = oldest
+		}
+	}
+
+	days := []time.Time{}
+	for day := first; !day.After(lastFinishedDay); day = day.AddDate(0, 0, 1) {
+		days = append(days, day)
+	}
+	return days, nil
+}
+
+// processDay penalizes the user's missed habits of a day
+// Returns false when the day was already processed, or is being processed by another replica
+func (uc *PenalizeMissedHabitsUseCase) processDay(
+	ctx context.Context,
+	userID string,
+	day time.Time,
+	clock valueobject.DayClock,
+) ([]HabitPenaltyOutput, bool, error) {
+	// 1. Only one replica processes a user's day at a time
+	release, acquired, err := uc.lockService.TryLock(ctx, "day-end:"+userID+":"+day.Format("2006-01-02"))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to lock user day: %w", err)
+	}
+	if !acquired {
+		return nil, false, nil
+	}
+	defer release()
+
+	// 2. Each user's day is processed once
+	processed, err := uc.dayEndRunRepo.Exists(ctx, userID, day)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to check day end run: %w", err)
+	}
+	if processed {
+		return nil, false, nil
+	}
+
+	// 3. Find the habits that were missed
+	habits, err := uc.habitRepo.FindAllByUserID(ctx, userID)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to fetch user habits: %w", err)
+	}
+
+	// Load completions from the start of the longest period (week or month) up to the end of the day
+	from := day
+	for _, habit := range habits {
+		if start := habit.Recurrence().PeriodStart(day, clock.WeekStart()); start.Before(from) {
+			from = start
+		}
+	}
+
+	completions, err := uc.habitCompletionRepo.FindByUserIDBetween(ctx, userID, clock.DayStart(from), clock.DayStart(day.AddDate(0, 0, 1)))
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to fetch habit completions: %w", err)
+	}
+
+	freezes, err := uc.streakFreezeRepo.FindUsedByUserID(ctx, userID)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to fetch streak freezes: %w", err)
+	}
+
+	// 4. Apply the penalty to each missed habit
+	penalties := []HabitPenaltyOutput{}
+	for _, habit := range habits {
+		if uc.penalty.IsZero() || !habit.WasMissedOn(day, completions, freezes, clock) {
+			continue
+		}
+
+		// A penalty left behind by an interrupted run is not applied twice
+		penalized, err := uc.habitPenaltyRepo.ExistsByHabitIDAndDay(ctx, habit.ID(), day)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to check habit penalty: %w", err)
+		}
+		if penalized {
+			continue
+		}
+
+		penalty, err := uc.penalize(ctx, habit, day)
+		if err != nil {
+			return nil, false, err
+		}
+
+		penalties = append(penalties, HabitPenaltyOutput{
+			HabitID:       penalty.HabitID(),
+			CharacterID:   penalty.CharacterID(),
+			Day:           day.Format("2006-01-02"),
+			XpLost:        penalty.XpLost(),
+			LevelsLost:    penalty.LevelsLost(),
+			AttributeName: penalty.AttributeName(),
+			AttributeLoss: penalty.AttributeLoss(),
+		})
+	}
+
+	// 5. Mark the day as processed
+	if err := uc.dayEndRunRepo.Create(ctx, userID, day); err != nil {
+		return nil, false, fmt.Errorf("failed to save day end run: %w", err)
+	}
+
+	return penalties, true, nil
+}
+
+// penalize drains the character for a missed habit and records the penalty
+func (uc *PenalizeMissedHabitsUseCase) penalize(
+	ctx context.Context,
+	habit *entity.Habit,
+	day time.Time,
+) (*entity.HabitPenalty, error) {
+	penaltyID := uuid.New().String()
+	source, err := valueobject.NewXpSource(valueobject.XpSourceHabitPenalty, penaltyID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create xp source: %w", err)
+	}
+
+	var penalty *entity.HabitPenalty
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		// Lock the character and attribute so concurrent rewards aren't overwritten
+		character, err := uc.characterRepo.FindByIDForUpdate(ctx, habit.CharacterID())
+		if err != nil {
+			return fmt.Errorf("failed to fetch character: %w", err)
+		}
+		attribute, err := uc.characterAttributeRepo.FindByCharacterIDAndNameForUpdate(ctx, character.ID(), habit.AttributeName())
+		if err != nil {
+			return fmt.Errorf("failed to fetch attribute: %w", err)
+		}
+
+		// Domain rules handle de-leveling
+		xpLost, levelsLost, err := character.LoseXpFrom(uc.penalty.XpLoss(habit.Difficulty()), source)
+		if err != nil {
+			return fmt.Errorf("failed to remove xp: %w", err)
+		}
+
+		// Attributes never drop below 0
+		attributeLoss := uc.penalty.AttributeLoss()
+		if attribute.Value() < attributeLoss {
+			attributeLoss = attribute.Value()
+		}
+		if err := attribute.DecrementValue(attributeLoss); err != nil {
+			return fmt.Errorf("failed to decrement attribute: %w", err)
+		}
+
+		penalty, err = entity.NewHabitPenalty(
+			penaltyID,
+			habit.ID(),
+			character.ID(),
+			day,
+			xpLost,
+			levelsLost,
+			attribute.AttributeName(),
+			attributeLoss,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create habit penalty: %w", err)
+		}
+
+		// The penalty is recorded first: its (habit, day) uniqueness guards against applying it twice
+		if err := uc.habitPenaltyRepo.Create(ctx, penalty); err != nil {
+			return fmt.Errorf("failed to save habit penalty: %w", err)
+		}
+		if err := uc.characterRepo.Update(ctx, character); err != nil {
+			return fmt.Errorf("failed to save character: %w", err)
+		}
+		if err := uc.characterAttributeRepo.Update(ctx, attribute); err != nil {
+			return fmt.Errorf("failed to save attribute: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return penalty, nil
+}
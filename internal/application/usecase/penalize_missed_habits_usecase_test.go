@@ -0,0 +1,339 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Mock LockService
+type mockLockService struct {
+	held map[string]bool
+}
+
+func (m *mockLockService) TryLock(ctx context.Context, key string) (func(), bool, error) {
+	if m.held[key] {
+		return nil, false, nil
+	}
+	m.held[key] = true
+	return func() { delete(m.held, key) }, true, nil
+}
+
+// Mock HabitPenaltyRepository
+type mockHabitPenaltyRepository struct {
+	penalties []*entity.HabitPenalty
+}
+
+func (m *mockHabitPenaltyRepository) Create(ctx context.Context, penalty *entity.HabitPenalty) error {
+	m.penalties = append(m.penalties, penalty)
+	return nil
+}
+
+func (m *mockHabitPenaltyRepository) ExistsByHabitIDAndDay(ctx context.Context, habitID string, day time.Time) (bool, error) {
+	for _, penalty := range m.penalties {
+		if penalty.HabitID() == habitID && penalty.Day().Equal(day) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Mock DayEndRunRepository
+type mockDayEndRunRepository struct {
+	runs map[string]bool
+}
+
+func (m *mockDayEndRunRepository) Exists(ctx context.Context, userID string, day time.Time) (bool, error) {
+	return m.runs[userID+day.Format("2006-01-02")], nil
+}
+
+func (m *mockDayEndRunRepository) FindLastDay(ctx context.Context, userID string) (*time.Time, error) {
+	var last *time.Time
+	for key := range m.runs {
+		if !strings.HasPrefix(key, userID) {
+			continue
+		}
+		day, err := time.Parse("2006-01-02", strings.TrimPrefix(key, userID))
+		if err != nil {
+			return nil, err
+		}
+		if last == nil || day.After(*last) {
+			last = &day
+		}
+	}
+	return last, nil
+}
+
+func (m *mockDayEndRunRepository) Create(ctx context.Context, userID string, day time.Time) error {
+	m.runs[userID+day.Format("2006-01-02")] = true
+	return nil
+}
+
+// penaltyFixture wires a hard daily habit (created 10 days ago) of a level 2 character owned by user-123
+type penaltyFixture struct {
+	habit       *entity.Habit
+	character   *entity.Character
+	attribute   *entity.CharacterAttribute
+	completions []*entity.HabitCompletion
+	preferences *mockUserPreferencesRepository
+	lockService *mockLockService
+	penaltyRepo *mockHabitPenaltyRepository
+	runRepo     *mockDayEndRunRepository
+	useCase     *usecase.PenalizeMissedHabitsUseCase
+}
+
+func newPenaltyFixture(t *testing.T) *penaltyFixture {
+	t.Helper()
+	f := &penaltyFixture{}
+
+	createdAt := time.Now().UTC().AddDate(0, 0, -10)
+	d, _ := valueobject.NewDifficulty("hard")
+	f.habit = entity.ReconstituteHabit("habit-123", "Push-ups", "", "char-123", "Força", d, valueobject.NewDailyRecurrence(), false, false, true, createdAt, createdAt)
+	f.character = entity.ReconstituteCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), 2, 10, 110, 0, "user-123", createdAt)
+	f.attribute = entity.ReconstituteCharacterAttribute(1, "Força", 5, "char-123", createdAt)
+
+	penalty, err := valueobject.NewMissedHabitPenalty(100, 2)
+	if err != nil {
+		t.Fatalf("NewMissedHabitPenalty() error = %v", err)
+	}
+
+	habitRepo := &mockHabitRepository{
+		findAllByUserIDFunc: func(ctx context.Context, userID string) ([]*entity.Habit, error) {
+			return []*entity.Habit{f.habit}, nil
+		},
+	}
+	compRepo := &mockHabitCompletionRepository{
+		findBetweenFunc: func(ctx context.Context, userID string, from time.Time, to time.Time) ([]*entity.HabitCompletion, error) {
+			return f.completions, nil
+		},
+	}
+	charRepo := &mockCharacterRepositoryForHabits{
+		findByIDFunc: func(ctx context.Context, id string) (*entity.Character, error) {
+			return f.character, nil
+		},
+	}
+	attrRepo := &mockCharacterAttributeRepository{
+		findByCharacterIDAndNameFunc: func(ctx context.Context, characterID string, attributeName string) (*entity.CharacterAttribute, error) {
+			if attributeName == "Força" {
+				return f.attribute, nil
+			}
+			return nil, errors.New("character attribute not found")
+		},
+		updateFunc: func(ctx context.Context, attribute *entity.CharacterAttribute) error {
+			return nil
+		},
+	}
+
+	f.preferences = &mockUserPreferencesRepository{}
+	f.lockService = &mockLockService{held: map[string]bool{}}
+	f.penaltyRepo = &mockHabitPenaltyRepository{}
+	f.runRepo = &mockDayEndRunRepository{runs: map[string]bool{}}
+	f.useCase = usecase.NewPenalizeMissedHabitsUseCase(
+		habitRepo,
+		compRepo,
+		&mockStreakFreezeRepository{},
+		f.penaltyRepo,
+		f.runRepo,
+		charRepo,
+		attrRepo,
+		f.preferences,
+		f.lockService,
+		penalty,
+		&mockUnitOfWork{},
+	)
+	return f
+}
+
+func yesterdayUTC() time.Time {
+	return time.Now().UTC().AddDate(0, 0, -1)
+}
+
+func TestPenalizeMissedHabitsUseCase_MissedHabitDrainsCharacter(t *testing.T) {
+	f := newPenaltyFixture(t)
+
+	output, err := f.useCase.Execute(context.Background(), usecase.PenalizeMissedHabitsInput{
+		UserID: "user-123",
+		Now:    time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.Skipped {
+		t.Fatal("Skipped = true, want false")
+	}
+	if len(output.Penalties) != 1 {
+		t.Fatalf("len(Penalties) = %v, want 1", len(output.Penalties))
+	}
+
+	// 100% of a hard habit's 40 XP: level 2 (10 XP) drops back to level 1
+	penalty := output.Penalties[0]
+	if penalty.XpLost != 40 || penalty.LevelsLost != 1 {
+		t.Errorf("XpLost, LevelsLost = %v, %v, want 40, 1", penalty.XpLost, penalty.LevelsLost)
+	}
+	if f.character.Level() != 1 || f.character.TotalXp() != 70 {
+		t.Errorf("character level, totalXp = %v, %v, want 1, 70", f.character.Level(), f.character.TotalXp())
+	}
+	if penalty.AttributeLoss != 2 || f.attribute.Value() != 3 {
+		t.Errorf("AttributeLoss = %v (value %v), want 2 (value 3)", penalty.AttributeLoss, f.attribute.Value())
+	}
+	if len(f.penaltyRepo.penalties) != 1 {
+		t.Errorf("saved penalties = %v, want 1", len(f.penaltyRepo.penalties))
+	}
+}
+
+func TestPenalizeMissedHabitsUseCase_CompletedHabitIsNotPenalized(t *testing.T) {
+	f := newPenaltyFixture(t)
+	f.completions = []*entity.HabitCompletion{
+		entity.ReconstituteHabitCompletion("completion-1", "habit-123", "char-123", 40, 0, "Força", 1, 0, yesterdayUTC()),
+	}
+
+	output, err := f.useCase.Execute(context.Background(), usecase.PenalizeMissedHabitsInput{
+		UserID: "user-123",
+		Now:    time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if len(output.Penalties) != 0 {
+		t.Errorf("len(Penalties) = %v, want 0", len(output.Penalties))
+	}
+	if f.character.TotalXp() != 110 {
+		t.Errorf("TotalXp() = %v, want 110", f.character.TotalXp())
+	}
+}
+
+func TestPenalizeMissedHabitsUseCase_DayIsProcessedOnce(t *testing.T) {
+	f := newPenaltyFixture(t)
+	input := usecase.PenalizeMissedHabitsInput{UserID: "user-123", Now: time.Now()}
+
+	if _, err := f.useCase.Execute(context.Background(), input); err != nil {
+		t.Fatalf("first Execute() error = %v, want nil", err)
+	}
+
+	output, err := f.useCase.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("second Execute() error = %v, want nil", err)
+	}
+
+	if !output.Skipped {
+		t.Error("Skipped = false, want true")
+	}
+	if len(f.penaltyRepo.penalties) != 1 {
+		t.Errorf("saved penalties = %v, want 1", len(f.penaltyRepo.penalties))
+	}
+	if f.character.TotalXp() != 70 {
+		t.Errorf("TotalXp() = %v, want 70", f.character.TotalXp())
+	}
+}
+
+func TestPenalizeMissedHabitsUseCase_SkipsWhenLockedByAnotherReplica(t *testing.T) {
+	f := newPenaltyFixture(t)
+	day := yesterdayUTC()
+	f.lockService.held["day-end:user-123:"+day.Format("2006-01-02")] = true
+
+	output, err := f.useCase.Execute(context.Background(), usecase.PenalizeMissedHabitsInput{
+		UserID: "user-123",
+		Now:    time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if !output.Skipped {
+		t.Error("Skipped = false, want true")
+	}
+	if len(f.penaltyRepo.penalties) != 0 || len(f.runRepo.runs) != 0 {
+		t.Error("locked day was processed, want skipped")
+	}
+}
+
+func TestPenalizeMissedHabitsUseCase_UsesUserTimezone(t *testing.T) {
+	now := time.Now()
+
+	// UTC+14 and UTC-11: the users' last finished days are always different
+	for _, timezone := range []string{"Pacific/Kiritimati", "Pacific/Pago_Pago"} {
+		t.Run(timezone, func(t *testing.T) {
+			f := newPenaltyFixture(t)
+			f.preferences.save(t, "user-123", timezone, 0)
+
+			output, err := f.useCase.Execute(context.Background(), usecase.PenalizeMissedHabitsInput{
+				UserID: "user-123",
+				Now:    now,
+			})
+			if err != nil {
+				t.Fatalf("Execute() error = %v, want nil", err)
+			}
+
+			location, _ := time.LoadLocation(timezone)
+			if want := now.In(location).AddDate(0, 0, -1).Format("2006-01-02"); output.Day != want {
+				t.Errorf("output.Day = %v, want %v", output.Day, want)
+			}
+			if len(output.Penalties) != 1 {
+				t.Errorf("len(Penalties) = %v, want 1", len(output.Penalties))
+			}
+		})
+	}
+}
+
+func TestPenalizeMissedHabitsUseCase_CatchesUpMissedDays(t *testing.T) {
+	f := newPenaltyFixture(t)
+	// The job last ran 4 days ago: the 3 days since are each evaluated once
+	f.runRepo.runs["user-123"+time.Now().UTC().AddDate(0, 0, -4).Format("2006-01-02")] = true
+
+	output, err := f.useCase.Execute(context.Background(), usecase.PenalizeMissedHabitsInput{
+		UserID: "user-123",
+		Now:    time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	wantDays := []string{
+		time.Now().UTC().AddDate(0, 0, -3).Format("2006-01-02"),
+		time.Now().UTC().AddDate(0, 0, -2).Format("2006-01-02"),
+		yesterdayUTC().Format("2006-01-02"),
+	}
+	if strings.Join(output.Days, ",") != strings.Join(wantDays, ",") {
+		t.Errorf("output.Days = %v, want %v", output.Days, wantDays)
+	}
+	if len(output.Penalties) != 3 {
+		t.Errorf("len(Penalties) = %v, want 3", len(output.Penalties))
+	}
+	for _, day := range wantDays {
+		if !f.runRepo.runs["user-123"+day] {
+			t.Errorf("day %v was not marked as processed", day)
+		}
+	}
+}
+
+func TestPenalizeMissedHabitsUseCase_LimitsCatchUpWindow(t *testing.T) {
+	f := newPenaltyFixture(t)
+	// The habit is 10 days old, but only the last 7 finished days are caught up
+	f.runRepo.runs["user-123"+time.Now().UTC().AddDate(0, 0, -30).Format("2006-01-02")] = true
+
+	output, err := f.useCase.Execute(context.Background(), usecase.PenalizeMissedHabitsInput{
+		UserID: "user-123",
+		Now:    time.Now(),
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if len(output.Days) != 7 {
+		t.Fatalf("len(Days) = %v, want 7", len(output.Days))
+	}
+	if want := time.Now().UTC().AddDate(0, 0, -7).Format("2006-01-02"); output.Days[0] != want {
+		t.Errorf("oldest day = %v, want %v", output.Days[0], want)
+	}
+	if len(output.Penalties) != 7 {
+		t.Errorf("len(Penalties) = %v, want 7", len(output.Penalties))
+	}
+}
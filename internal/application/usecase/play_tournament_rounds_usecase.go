@@ -0,0 +1,336 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"log"
+	"math"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igor/chronotask-api/internal/application/port"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// PlayTournamentRoundsInput represents the input for a tournament round run
+type PlayTournamentRoundsInput struct {
+	Now time.Time
+}
+
+// PlayTournamentRoundsOutput summarizes a tournament round run
+type PlayTournamentRoundsOutput struct {
+	TournamentsStarted   int // Brackets seeded at their deadline
+	TournamentsCancelled int // Deadlines reached without enough entrants
+	TournamentsCompleted int // Final rounds fought and prizes awarded
+	TournamentsFailed    int
+	RoundsPlayed         int
+	BattlesFought        int
+}
+
+// tournamentRun is what a run did to one tournament
+type tournamentRun struct {
+	started   bool
+	cancelled bool
+	completed bool
+	rounds    int
+	battles   int
+}
+
+// PlayTournamentRoundsUseCase seeds the brackets and fights the rounds of every tournament that is due
+// It is meant to be run periodically by the scheduler: each tournament is processed with its row locked
+// and only while a round is due, so repeated or concurrent runs never fight a round twice
+type PlayTournamentRoundsUseCase struct {
+	characterRepo          repository.CharacterRepository
+	characterAttributeRepo repository.CharacterAttributeRepository
+	characterRatingRepo    repository.CharacterRatingRepository
+	battleRepo             repository.BattleRepository
+	tournamentRepo         repository.TournamentRepository
+	unitOfWork             port.UnitOfWork
+}
+
+// NewPlayTournamentRoundsUseCase creates a new PlayTournamentRoundsUseCase
+func NewPlayTournamentRoundsUseCase(
+	characterRepo repository.CharacterRepository,
+	characterAttributeRepo repository.CharacterAttributeRepository,
+	characterRatingRepo repository.CharacterRatingRepository,
+	battleRepo repository.BattleRepository,
+	tournamentRepo repository.TournamentRepository,
+	unitOfWork port.UnitOfWork,
+) *PlayTournamentRoundsUseCase {
+	return &PlayTournamentRoundsUseCase{
+		characterRepo:          characterRepo,
+		characterAttributeRepo: characterAttributeRepo,
+		characterRatingRepo:    characterRatingRepo,
+		battleRepo:             battleRepo,
+		tournamentRepo:         tournamentRepo,
+		unitOfWork:             unitOfWork,
+	}
+}
+
+// Execute processes every tournament with a bracket to seed or a round to fight
+// A failure for one tournament is logged and doesn't stop the others
+func (uc *PlayTournamentRoundsUseCase) Execute(ctx context.Context, input PlayTournamentRoundsInput) (*PlayTournamentRoundsOutput, error) {
+	now := input.Now.UTC()
+
+	tournamentIDs, err := uc.tournamentRepo.FindDueIDs(ctx, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch due tournaments: %w", err)
+	}
+
+	output := &PlayTournamentRoundsOutput{}
+	for _, tournamentID := range tournamentIDs {
+		if ctx.Err() != nil {
+			return output, ctx.Err()
+		}
+
+		run, err := uc.play(ctx, tournamentID, now)
+		if err != nil {
+			log.Printf("tournament rounds: failed to process tournament %s: %v", tournamentID, err)
+			output.TournamentsFailed++
+			continue
+		}
+
+		if run.started {
+			output.TournamentsStarted++
+		}
+		if run.cancelled {
+			output.TournamentsCancelled++
+		}
+		if run.completed {
+			output.TournamentsCompleted++
+		}
+		output.RoundsPlayed += run.rounds
+		output.BattlesFought += run.battles
+	}
+
+	return output, nil
+}
+
+// play seeds the tournament if its deadline passed and fights every round due by now
+// Matches, battles, the tournament's progress and the prizes are saved in a single unit of work
+func (uc *PlayTournamentRoundsUseCase) play(ctx context.Context, tournamentID string, now time.Time) (tournamentRun, error) {
+	var run tournamentRun
+	err := uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		run = tournamentRun{}
+
+		// 1. Lock the tournament; another run may have processed it since it was listed
+		tournament, err := uc.tournamentRepo.FindByIDForUpdate(ctx, tournamentID)
+		if err != nil {
+			return fmt.Errorf("failed to fetch tournament: %w", err)
+		}
+		if !tournament.IsDueAt(now) {
+			return nil
+		}
+
+		entrants, err := uc.tournamentRepo.FindEntrants(ctx, tournament.ID())
+		if err != nil {
+			return fmt.Errorf("failed to fetch tournament entrants: %w", err)
+		}
+
+		// 2. Seed the bracket at the deadline (or cancel the tournament without enough entrants)
+		if tournament.Status() == entity.TournamentOpen {
+			if len(entrants) < entity.MinTournamentEntrants {
+				if err := tournament.Cancel(now); err != nil {
+					return fmt.Errorf("failed to cancel tournament: %w", err)
+				}
+				run.cancelled = true
+				return uc.saveTournament(ctx, tournament)
+			}
+
+			if err := uc.seed(ctx, tournament, entrants); err != nil {
+				return err
+			}
+			run.started = true
+		}
+
+		matches, err := uc.tournamentRepo.FindMatches(ctx, tournament.ID())
+		if err != nil {
+			return fmt.Errorf("failed to fetch tournament matches: %w", err)
+		}
+
+		// 3. Fight every round due by now (more than one when runs were missed)
+		combatants := map[string]valueobject.Combatant{}
+		for tournament.Status() == entity.TournamentRunning && tournament.IsDueAt(now) {
+			fought, err := uc.fightRound(ctx, tournament, entrants, matches, combatants, now)
+			if err != nil {
+				return err
+			}
+			matches = append(matches, fought...)
+			run.rounds++
+			for _, match := range fought {
+				if !match.IsBye() {
+					run.battles++
+				}
+			}
+
+			// 4. Crown the champion after the last round and award the prizes
+			if tournament.IsLastRoundPlayed() {
+				if err := tournament.Finish(entrants, matches, now); err != nil {
+					return fmt.Errorf("failed to finish tournament: %w", err)
+				}
+				if err := uc.awardPrizes(ctx, tournament); err != nil {
+					return err
+				}
+				run.completed = true
+			}
+		}
+
+		return uc.saveTournament(ctx, tournament)
+	})
+	if err != nil {
+		return tournamentRun{}, err
+	}
+
+	return run, nil
+}
+
+// seed closes the registrations, seeding the entrants by level or rating (inside the unit of work)
+// Characters that never fought a ranked battle are seeded with the initial rating
+func (uc *PlayTournamentRoundsUseCase) seed(ctx context.Context, tournament *entity.Tournament, entrants []*entity.TournamentEntrant) error {
+	scores := make(map[string]int, len(entrants))
+	for _, entrant := range entrants {
+		if tournament.Seeding() == entity.TournamentSeedByRating {
+			rating, err := uc.characterRatingRepo.FindByCharacterID(ctx, entrant.CharacterID())
+			if err != nil {
+				return fmt.Errorf("failed to fetch character rating: %w", err)
+			}
+			glicko := valueobject.InitialGlickoRating()
+			if rating != nil {
+				glicko = rating.Rating()
+			}
+			scores[entrant.CharacterID()] = int(math.Round(glicko.Rating()))
+			continue
+		}
+
+		character, err := uc.characterRepo.FindByID(ctx, entrant.CharacterID())
+		if err != nil {
+			return fmt.Errorf("failed to find entrant %s: %w", entrant.CharacterID(), err)
+		}
+		scores[entrant.CharacterID()] = character.Level()
+	}
+
+	if err := tournament.Start(entrants, scores); err != nil {
+		return fmt.Errorf("failed to seed tournament: %w", err)
+	}
+
+	for _, entrant := range entrants {
+		if err := uc.tournamentRepo.UpdateEntrant(ctx, entrant); err != nil {
+			return fmt.Errorf("failed to save tournament entrant: %w", err)
+		}
+	}
+	return nil
+}
+
+// fightRound fights the battles of the next round and records its matches (inside the unit of work)
+// Tournament battles cost no energy; the characters fight with their attributes at the time of the round
+func (uc *PlayTournamentRoundsUseCase) fightRound(
+	ctx context.Context,
+	tournament *entity.Tournament,
+	entrants []*entity.TournamentEntrant,
+	matches []*entity.TournamentMatch,
+	combatants map[string]valueobject.Combatant,
+	now time.Time,
+) ([]*entity.TournamentMatch, error) {
+	pairings, err := tournament.Pairings(entrants, matches)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pair round: %w", err)
+	}
+
+	fought := make([]*entity.TournamentMatch, 0, len(pairings))
+	for _, pairing := range pairings {
+		var battle *entity.Battle
+		if !pairing.IsBye() {
+			character, err := uc.combatant(ctx, combatants, pairing.CharacterID)
+			if err != nil {
+				return nil, err
+			}
+			opponent, err := uc.combatant(ctx, combatants, pairing.OpponentID)
+			if err != nil {
+				return nil, err
+			}
+
+			battle, err = entity.NewBattle(uuid.New().String(), character, opponent, rand.Int64N(maxBattleSeed), now)
+			if err != nil {
+				return nil, fmt.Errorf("failed to create battle: %w", err)
+			}
+			if err := uc.battleRepo.Create(ctx, battle); err != nil {
+				return nil, fmt.Errorf("failed to save battle: %w", err)
+			}
+		}
+
+		match, err := tournament.RecordMatch(pairing, battle, now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to record match: %w", err)
+		}
+		if err := uc.tournamentRepo.CreateMatch(ctx, match); err != nil {
+			return nil, fmt.Errorf("failed to save tournament match: %w", err)
+		}
+		fought = append(fought, match)
+	}
+
+	if err := tournament.CompleteRound(); err != nil {
+		return nil, fmt.Errorf("failed to complete round: %w", err)
+	}
+	return fought, nil
+}
+
+// combatant snapshots an entrant for battle, once per run
+func (uc *PlayTournamentRoundsUseCase) combatant(ctx context.Context, combatants map[string]valueobject.Combatant, characterID string) (valueobject.Combatant, error) {
+	if combatant, ok := combatants[characterID]; ok {
+		return combatant, nil
+	}
+
+	character, err := uc.characterRepo.FindByID(ctx, characterID)
+	if err != nil {
+		return valueobject.Combatant{}, fmt.Errorf("failed to find entrant %s: %w", characterID, err)
+	}
+
+	combatant, err := characterCombatant(ctx, uc.characterAttributeRepo, character)
+	if err != nil {
+		return valueobject.Combatant{}, err
+	}
+
+	combatants[characterID] = combatant
+	return combatant, nil
+}
+
+// awardPrizes adds the prize XP to the champion and the runner-up (recorded in the ledger)
+// The winners are locked in ID order, so concurrent rewards can't deadlock or be overwritten
+func (uc *PlayTournamentRoundsUseCase) awardPrizes(ctx context.Context, tournament *entity.Tournament) error {
+	source, err := valueobject.NewXpSource(valueobject.XpSourceTournamentPrize, tournament.ID())
+	if err != nil {
+		return fmt.Errorf("failed to create xp source: %w", err)
+	}
+
+	prizes := tournament.Prizes()
+	slices.SortFunc(prizes, func(a, b entity.TournamentPrize) int {
+		return cmp.Compare(a.CharacterID, b.CharacterID)
+	})
+
+	for _, prize := range prizes {
+		character, err := uc.characterRepo.FindByIDForUpdate(ctx, prize.CharacterID)
+		if err != nil {
+			return fmt.Errorf("failed to find prize winner %s: %w", prize.CharacterID, err)
+		}
+		if _, err := character.AddXpFrom(prize.Xp, source); err != nil {
+			return fmt.Errorf("failed to add xp: %w", err)
+		}
+		if err := uc.characterRepo.Update(ctx, character); err != nil {
+			return fmt.Errorf("failed to save prize winner %s: %w", prize.CharacterID, err)
+		}
+	}
+	return nil
+}
+
+// saveTournament saves the tournament's progress (inside the unit of work)
+func (uc *PlayTournamentRoundsUseCase) saveTournament(ctx context.Context, tournament *entity.Tournament) error {
+	if err := uc.tournamentRepo.Update(ctx, tournament); err != nil {
+		return fmt.Errorf("failed to save tournament: %w", err)
+	}
+	return nil
+}
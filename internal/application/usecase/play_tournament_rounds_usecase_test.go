@@ -0,0 +1,233 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+var tournamentDeadline = time.Date(2024, 5, 1, 18, 0, 0, 0, time.UTC)
+
+// tournamentFixture wires a round run over char-1 (level 10, by far the strongest), char-2 (level 8) and char-3 (level 6, the weakest)
+type tournamentFixture struct {
+	characters     map[string]*entity.Character
+	tournamentRepo *mockTournamentRepository
+	ratingRepo     *mockCharacterRatingRepository
+	battleRepo     *mockBattleRepository
+	unitOfWork     *mockUnitOfWork
+	charRepo       *mockCharacterRepositoryForHabits
+	useCase        *usecase.PlayTournamentRoundsUseCase
+}
+
+func newTournamentFixture() *tournamentFixture {
+	f := &tournamentFixture{
+		characters:     map[string]*entity.Character{},
+		tournamentRepo: newMockTournamentRepository(),
+		ratingRepo:     newMockCharacterRatingRepository(),
+		battleRepo:     &mockBattleRepository{},
+		unitOfWork:     &mockUnitOfWork{},
+	}
+
+	strength := map[string]int{"char-1": 60, "char-2": 20, "char-3": 1}
+	for id, level := range map[string]int{"char-1": 10, "char-2": 8, "char-3": 6} {
+		f.characters[id] = entity.ReconstituteCharacter(id, "Hero "+id, valueobject.DefaultCharacterClass(), level, 0, 0, 0, "user-"+id, time.Now())
+	}
+
+	f.charRepo = &mockCharacterRepositoryForHabits{
+		findByIDFunc: func(ctx context.Context, id string) (*entity.Character, error) {
+			if character, ok := f.characters[id]; ok {
+				return character, nil
+			}
+			return nil, errors.New("character not found")
+		},
+	}
+	attrRepo := &mockCharacterAttributeRepositoryGet{
+		findByCharacterIDFunc: func(ctx context.Context, characterID string) ([]*entity.CharacterAttribute, error) {
+			return newUniformAttributes(characterID, strength[characterID]), nil
+		},
+	}
+
+	f.useCase = usecase.NewPlayTournamentRoundsUseCase(f.charRepo, attrRepo, f.ratingRepo, f.battleRepo, f.tournamentRepo, f.unitOfWork)
+	return f
+}
+
+// addTournament saves an open tournament with the characters joined, in order
+func (f *tournamentFixture) addTournament(t *testing.T, id string, format string, seeding string, characterIDs ...string) *entity.Tournament {
+	t.Helper()
+
+	tournament, err := entity.NewTournament(id, "Copa do Hábito", format, seeding, "", "admin-1", 8, 100, tournamentDeadline, time.Hour, tournamentDeadline.Add(-48*time.Hour))
+	if err != nil {
+		t.Fatalf("NewTournament() error = %v, want nil", err)
+	}
+	f.tournamentRepo.Create(context.Background(), tournament)
+
+	for i, characterID := range characterIDs {
+		entrant, _ := entity.NewTournamentEntrant(id, characterID, tournamentDeadline.Add(time.Duration(i-24)*time.Hour))
+		f.tournamentRepo.AddEntrant(context.Background(), entrant)
+	}
+	return tournament
+}
+
+func (f *tournamentFixture) run(t *testing.T, now time.Time) *usecase.PlayTournamentRoundsOutput {
+	t.Helper()
+
+	output, err := f.useCase.Execute(context.Background(), usecase.PlayTournamentRoundsInput{Now: now})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	return output
+}
+
+func TestPlayTournamentRoundsUseCase_Execute_SingleElimination(t *testing.T) {
+	f := newTournamentFixture()
+	tournament := f.addTournament(t, "tournament-1", entity.TournamentSingleElimination, entity.TournamentSeedByLevel, "char-3", "char-2", "char-1")
+
+	// Nothing to do before the deadline
+	if output := f.run(t, tournamentDeadline.Add(-time.Second)); *output != (usecase.PlayTournamentRoundsOutput{}) {
+		t.Errorf("Execute() before the deadline = %+v, want nothing done", output)
+	}
+
+	// At the deadline the bracket is seeded by level and the first round fought: char-1 gets the bye
+	output := f.run(t, tournamentDeadline)
+	if output.TournamentsStarted != 1 || output.RoundsPlayed != 1 || output.BattlesFought != 1 || output.TournamentsCompleted != 0 {
+		t.Fatalf("Execute() at the deadline = %+v, want the bracket seeded and 1 battle fought", output)
+	}
+	matches := f.tournamentRepo.matches[tournament.ID()]
+	if len(matches) != 2 || !matches[0].IsBye() || matches[0].CharacterID() != "char-1" || matches[1].WinnerID() != "char-2" {
+		t.Fatalf("round 1 = %v, want a bye for char-1 and char-2 beating char-3", matches)
+	}
+	if tournament.Status() != entity.TournamentRunning || !tournament.NextRoundAt().Equal(tournamentDeadline.Add(time.Hour)) {
+		t.Errorf("tournament = %s, next round at %v, want running with the final in one hour", tournament.Status(), tournament.NextRoundAt())
+	}
+
+	// A rerun of the same round does nothing
+	if output := f.run(t, tournamentDeadline.Add(time.Minute)); output.RoundsPlayed != 0 || len(f.battleRepo.battles) != 1 {
+		t.Errorf("Execute() rerun = %+v with %d battles, want nothing fought again", output, len(f.battleRepo.battles))
+	}
+
+	// The final crowns the champion, who wins the prize; the runner-up wins half of it
+	output = f.run(t, tournamentDeadline.Add(time.Hour))
+	if output.RoundsPlayed != 1 || output.TournamentsCompleted != 1 || output.TournamentsFailed != 0 {
+		t.Fatalf("Execute() for the final = %+v, want the tournament completed", output)
+	}
+	if tournament.ChampionID() != "char-1" || tournament.RunnerUpID() != "char-2" || tournament.Status() != entity.TournamentCompleted {
+		t.Errorf("champion = %s, runner-up = %s, want char-1 and char-2", tournament.ChampionID(), tournament.RunnerUpID())
+	}
+
+	for characterID, xp := range map[string]int{"char-1": 100, "char-2": 50} {
+		pending := f.characters[characterID].PendingXpTransactions()
+		if len(pending) != 1 || pending[0].Amount() != xp || pending[0].Source().Type() != valueobject.XpSourceTournamentPrize || pending[0].Source().ID() != tournament.ID() {
+			t.Errorf("%s ledger = %v, want %d XP from the tournament prize", characterID, pending, xp)
+		}
+	}
+	if pending := f.characters["char-3"].PendingXpTransactions(); len(pending) != 0 {
+		t.Errorf("char-3 ledger = %v, want no prize", pending)
+	}
+	if len(f.charRepo.locked) != 2 || f.charRepo.locked[0] != "char-1" || f.charRepo.locked[1] != "char-2" {
+		t.Errorf("locked characters = %v, want the winners in ID order", f.charRepo.locked)
+	}
+
+	// Prizes are awarded once
+	if output := f.run(t, tournamentDeadline.Add(24*time.Hour)); *output != (usecase.PlayTournamentRoundsOutput{}) || len(f.characters["char-1"].PendingXpTransactions()) != 1 {
+		t.Errorf("Execute() after the final = %+v, want nothing done", output)
+	}
+}
+
+func TestPlayTournamentRoundsUseCase_Execute_RoundRobinCatchesUp(t *testing.T) {
+	f := newTournamentFixture()
+	tournament := f.addTournament(t, "tournament-1", entity.TournamentRoundRobin, entity.TournamentSeedByRating, "char-1", "char-2", "char-3")
+
+	// char-3 has the best rating; char-1 never fought a ranked battle and is seeded with the initial rating
+	strong, _ := valueobject.NewGlickoRating(1800, 80, 0.06)
+	weak, _ := valueobject.NewGlickoRating(1200, 80, 0.06)
+	f.ratingRepo.ratings["char-3"] = entity.ReconstituteCharacterRating("char-3", strong, 10, 0, 0, nil)
+	f.ratingRepo.ratings["char-2"] = entity.ReconstituteCharacterRating("char-2", weak, 0, 10, 0, nil)
+
+	// Every round is overdue: they're all fought in one run
+	output := f.run(t, tournamentDeadline.Add(5*time.Hour))
+	if output.TournamentsStarted != 1 || output.RoundsPlayed != 3 || output.BattlesFought != 3 || output.TournamentsCompleted != 1 {
+		t.Fatalf("Execute() = %+v, want 3 rounds of 1 battle and the tournament completed", output)
+	}
+
+	entrants, _ := f.tournamentRepo.FindEntrants(context.Background(), tournament.ID())
+	if got := fmt.Sprintf("%s %d %s %d", entrants[0].CharacterID(), entrants[0].SeedScore(), entrants[1].CharacterID(), entrants[1].SeedScore()); got != "char-3 1800 char-1 1500" {
+		t.Errorf("seeds = %s, want char-3 (1800) then char-1 (1500)", got)
+	}
+
+	met := map[string]bool{}
+	for _, match := range f.tournamentRepo.matches[tournament.ID()] {
+		met[match.CharacterID()+" "+match.OpponentID()] = true
+	}
+	if len(met) != 3 {
+		t.Errorf("pairs met = %v, want every pair once", met)
+	}
+	if tournament.ChampionID() != "char-1" || tournament.RunnerUpID() != "char-2" {
+		t.Errorf("champion = %s, runner-up = %s, want char-1 and char-2", tournament.ChampionID(), tournament.RunnerUpID())
+	}
+}
+
+func TestPlayTournamentRoundsUseCase_Execute_CancelsWithoutEnoughEntrants(t *testing.T) {
+	f := newTournamentFixture()
+	tournament := f.addTournament(t, "tournament-1", entity.TournamentSingleElimination, entity.TournamentSeedByLevel, "char-1")
+
+	output := f.run(t, tournamentDeadline)
+	if output.TournamentsCancelled != 1 || output.TournamentsStarted != 0 || tournament.Status() != entity.TournamentCancelled {
+		t.Errorf("Execute() = %+v, status %s, want the tournament cancelled", output, tournament.Status())
+	}
+}
+
+func TestPlayTournamentRoundsUseCase_Execute_FailureDoesNotStopOthers(t *testing.T) {
+	f := newTournamentFixture()
+	f.addTournament(t, "tournament-1", entity.TournamentSingleElimination, entity.TournamentSeedByLevel, "char-1", "char-2")
+	f.addTournament(t, "tournament-2", entity.TournamentSingleElimination, entity.TournamentSeedByLevel, "char-3", "char-404")
+
+	output := f.run(t, tournamentDeadline)
+	if output.TournamentsFailed != 1 || output.TournamentsStarted != 1 || output.TournamentsCompleted != 1 || f.unitOfWork.rollbacks != 1 {
+		t.Errorf("Execute() = %+v, rollbacks = %d, want tournament-2 failed and tournament-1 completed", output, f.unitOfWork.rollbacks)
+	}
+	if len(f.tournamentRepo.matches["tournament-2"]) != 0 {
+		t.Error("the failed tournament should have no match")
+	}
+}
+
+func TestGetTournamentUseCase_Execute(t *testing.T) {
+	f := newTournamentFixture()
+	f.addTournament(t, "tournament-1", entity.TournamentSingleElimination, entity.TournamentSeedByLevel, "char-3", "char-2", "char-1")
+	useCase := usecase.NewGetTournamentUseCase(f.tournamentRepo)
+
+	// Before the deadline: entrants in join order, no round and no standing yet
+	output, err := useCase.Execute(context.Background(), usecase.GetTournamentInput{TournamentID: "tournament-1"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if output.Tournament.Entrants != 3 || output.Entrants[0].CharacterID != "char-3" || len(output.Rounds) != 0 || len(output.Standings) != 0 {
+		t.Errorf("Execute() before the deadline = %+v, want 3 unseeded entrants", output)
+	}
+
+	// After the first round: the fought round and the pairing of the final
+	f.run(t, tournamentDeadline)
+	output, err = useCase.Execute(context.Background(), usecase.GetTournamentInput{TournamentID: "tournament-1"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if len(output.Rounds) != 2 || !output.Rounds[0].Played || len(output.Rounds[0].Matches) != 2 || !output.Rounds[0].Matches[0].Bye {
+		t.Fatalf("Rounds = %+v, want round 1 fought with a bye", output.Rounds)
+	}
+	final := output.Rounds[1]
+	if final.Played || final.ScheduledAt != output.Tournament.NextRoundAt || len(final.Matches) != 1 || final.Matches[0].CharacterID != "char-1" || final.Matches[0].OpponentCharacterID != "char-2" {
+		t.Errorf("next round = %+v, want char-1 against char-2 at %s", final, output.Tournament.NextRoundAt)
+	}
+	if output.Entrants[0].CharacterID != "char-1" || output.Entrants[0].Seed != 1 || len(output.Standings) != 3 || output.Standings[2].CharacterID != "char-3" {
+		t.Errorf("entrants = %+v, standings = %+v, want char-1 seeded first and char-3 last", output.Entrants, output.Standings)
+	}
+
+	if _, err := useCase.Execute(context.Background(), usecase.GetTournamentInput{TournamentID: "tournament-404"}); !errors.Is(err, usecase.ErrTournamentNotFound) {
+		t.Errorf("Execute() error = %v, want ErrTournamentNotFound", err)
+	}
+}
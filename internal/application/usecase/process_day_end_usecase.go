@@ -0,0 +1,78 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+// ProcessDayEndInput represents the input for a day-end run
+type ProcessDayEndInput struct {
+	Now time.Time
+}
+
+// ProcessDayEndOutput summarizes a day-end run
+type ProcessDayEndOutput struct {
+	UsersProcessed int // Users whose day was processed by this run
+	UsersSkipped   int // Users already processed (or being processed by another replica)
+	UsersFailed    int
+	Penalties      int
+}
+
+// ProcessDayEndUseCase runs the day-end processing for every user whose day has ended
+// It is meant to be run periodically by the scheduler: each run processes the last finished
+// day of each user, so repeated runs only do work right after a user's day boundary
+type ProcessDayEndUseCase struct {
+	habitRepo                   repository.HabitRepository
+	penalizeMissedHabitsUseCase *PenalizeMissedHabitsUseCase
+}
+
+// NewProcessDayEndUseCase creates a new ProcessDayEndUseCase
+func NewProcessDayEndUseCase(
+	habitRepo repository.HabitRepository,
+	penalizeMissedHabitsUseCase *PenalizeMissedHabitsUseCase,
+) *ProcessDayEndUseCase {
+	return &ProcessDayEndUseCase{
+		habitRepo:                   habitRepo,
+		penalizeMissedHabitsUseCase: penalizeMissedHabitsUseCase,
+	}
+}
+
+// Execute penalizes the missed habits of the unprocessed finished days of every user with active habits
+// A failure for one user is logged and doesn't stop the others
+func (uc *ProcessDayEndUseCase) Execute(ctx context.Context, input ProcessDayEndInput) (*ProcessDayEndOutput, error) {
+	userIDs, err := uc.habitRepo.FindUserIDsWithActiveHabits(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch users with active habits: %w", err)
+	}
+
+	output := &ProcessDayEndOutput{}
+	for _, userID := range userIDs {
+		if ctx.Err() != nil {
+			return output, ctx.Err()
+		}
+
+		// Each user's day ends according to their own preferences
+		result, err := uc.penalizeMissedHabitsUseCase.Execute(ctx, PenalizeMissedHabitsInput{
+			UserID: userID,
+			Now:    input.Now,
+		})
+		if err != nil {
+			log.Printf("day end: failed to process user %s: %v", userID, err)
+			output.UsersFailed++
+			continue
+		}
+
+		if result.Skipped {
+			output.UsersSkipped++
+			continue
+		}
+		output.UsersProcessed++
+		output.Penalties += len(result.Penalties)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,218 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// RaidStrikeOutput represents the damage a habit completion dealt to the guild's raid boss
+type RaidStrikeOutput struct {
+	RaidID    string
+	Damage    int
+	BossHP    int  // HP left after the strike
+	Defeated  bool // The strike dealt the killing blow
+	XpAwarded int  // The completing character's share of the reward, when the boss died
+}
+
+// raidStriker turns habit completions into damage against the active raid of the character's guild
+// It must run inside a unit of work, before the character is locked: lockRaid locks the raid row first,
+// so simultaneous completions of a guild decrement the boss's HP one after another and always
+// take their locks in the same order (raid, then characters) when the killing blow rewards everyone.
+type raidStriker struct {
+	characterRepo          repository.CharacterRepository
+	characterAttributeRepo repository.CharacterAttributeRepository
+	guildRepo              repository.GuildRepository
+	raidRepo               repository.RaidRepository
+}
+
+// lockRaid locks the active raid of the character's guild, if it is fighting one
+// An overdue raid is expired instead
+// Returns nil when the character isn't raiding
+func (s raidStriker) lockRaid(ctx context.Context, characterID string, at time.Time) (*entity.Raid, error) {
+	guild, err := s.guildRepo.FindByCharacterID(ctx, characterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch guild of character: %w", err)
+	}
+	if guild == nil {
+		return nil, nil
+	}
+
+	raid, err := s.raidRepo.FindActiveByGuildIDForUpdate(ctx, guild.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch active raid: %w", err)
+	}
+	if raid == nil {
+		return nil, nil
+	}
+	if raid.IsOverdueAt(at) {
+		return nil, expireRaid(ctx, s.raidRepo, raid)
+	}
+
+	return raid, nil
+}
+
+// strike deals the damage of a completion to raid, locked by lockRaid
+// character is locked and attribute is the attribute the completion grew; neither is saved yet
+// day is the completion's calendar day in the user's clock: each habit strikes once a day
+// When the boss dies the reward is split among the contributors; the completing character's share is
+// added to character, which the caller saves
+// Returns nil when raid is nil (the character isn't raiding) or the habit already struck it that day
+func (s raidStriker) strike(
+	ctx context.Context,
+	raid *entity.Raid,
+	character *entity.Character,
+	attribute *entity.CharacterAttribute,
+	completion *entity.HabitCompletion,
+	day time.Time,
+) (*RaidStrikeOutput, error) {
+	if raid == nil {
+		return nil, nil
+	}
+
+	// The raid is locked, so concurrent completions of the habit can't both strike
+	struck, err := s.raidRepo.ExistsHitByHabitOnDay(ctx, raid.ID(), completion.HabitID(), day)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check raid hits: %w", err)
+	}
+	if struck {
+		return nil, nil
+	}
+
+	// 1. The damage follows the character's attributes, including what this completion grew
+	attributes, err := s.characterAttributeRepo.FindByCharacterID(ctx, character.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch character attributes: %w", err)
+	}
+	for i, current := range attributes {
+		if current.AttributeName() == attribute.AttributeName() {
+			attributes[i] = attribute
+		}
+	}
+
+	at := completion.CompletedAt()
+	dealt, err := raid.Strike(entity.RaidStrikeDamage(character.CombatStats(attributes)), at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to strike raid boss: %w", err)
+	}
+
+	hit, err := entity.NewRaidHit(completion.ID(), raid.ID(), character.ID(), completion.HabitID(), day, dealt, at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create raid hit: %w", err)
+	}
+	if err := s.raidRepo.CreateHit(ctx, hit); err != nil {
+		return nil, fmt.Errorf("failed to save raid hit: %w", err)
+	}
+	if err := s.raidRepo.Update(ctx, raid); err != nil {
+		return nil, fmt.Errorf("failed to save raid: %w", err)
+	}
+
+	output := &RaidStrikeOutput{
+		RaidID:   raid.ID(),
+		Damage:   dealt,
+		BossHP:   raid.HP(),
+		Defeated: raid.Status() == entity.RaidDefeated,
+	}
+
+	// 2. The killing blow splits the reward among everyone who dealt damage
+	if output.Defeated {
+		output.XpAwarded, err = s.reward(ctx, raid, character)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
+// reward awards every contributor its share of a defeated boss's reward (recorded in the ledger)
+// Other contributors are locked (in ID order, so concurrent rewards can't deadlock) and saved here;
+// the completing character, already locked, is left for the caller to save
+// Returns the completing character's share
+func (s raidStriker) reward(ctx context.Context, raid *entity.Raid, character *entity.Character) (int, error) {
+	contributions, err := s.raidRepo.FindContributions(ctx, raid.ID())
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch raid contributions: %w", err)
+	}
+
+	source, err := valueobject.NewXpSource(valueobject.XpSourceRaidReward, raid.ID())
+	if err != nil {
+		return 0, fmt.Errorf("failed to create xp source: %w", err)
+	}
+
+	rewards := raid.Rewards(contributions)
+	slices.SortFunc(rewards, func(a, b entity.RaidReward) int {
+		return cmp.Compare(a.CharacterID, b.CharacterID)
+	})
+
+	awarded := 0
+	for _, reward := range rewards {
+		if reward.Xp == 0 {
+			continue
+		}
+
+		if reward.CharacterID == character.ID() {
+			if _, err := character.AddXpFrom(reward.Xp, source); err != nil {
+				return 0, fmt.Errorf("failed to add xp: %w", err)
+			}
+			awarded = reward.Xp
+			continue
+		}
+
+		contributor, err := s.characterRepo.FindByIDForUpdate(ctx, reward.CharacterID)
+		if err != nil {
+			return 0, fmt.Errorf("failed to find raid contributor %s: %w", reward.CharacterID, err)
+		}
+		if _, err := contributor.AddXpFrom(reward.Xp, source); err != nil {
+			return 0, fmt.Errorf("failed to add xp: %w", err)
+		}
+		if err := s.characterRepo.Update(ctx, contributor); err != nil {
+			return 0, fmt.Errorf("failed to save raid contributor %s: %w", reward.CharacterID, err)
+		}
+	}
+
+	return awarded, nil
+}
+
+// takeBack heals the boss by the damage a completion dealt, when the completion is undone
+// Damage dealt in a raid that expired stands; a hit in a defeated raid can't be taken back at all,
+// since the reward was already paid out for it (ErrRaidAlreadyDefeated)
+// Returns the damage taken back
+func (s raidStriker) takeBack(ctx context.Context, completionID string, at time.Time) (int, error) {
+	hit, err := s.raidRepo.FindHitByCompletionID(ctx, completionID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch raid hit: %w", err)
+	}
+	if hit == nil {
+		return 0, nil
+	}
+
+	raid, err := s.raidRepo.FindByIDForUpdate(ctx, hit.RaidID())
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch raid: %w", err)
+	}
+	if raid.Status() == entity.RaidDefeated {
+		return 0, ErrRaidAlreadyDefeated
+	}
+	if !raid.IsActive() || raid.IsOverdueAt(at) {
+		return 0, nil
+	}
+
+	if err := raid.Heal(hit.Damage()); err != nil {
+		return 0, fmt.Errorf("failed to heal raid boss: %w", err)
+	}
+	if err := s.raidRepo.DeleteHit(ctx, completionID); err != nil {
+		return 0, fmt.Errorf("failed to delete raid hit: %w", err)
+	}
+	if err := s.raidRepo.Update(ctx, raid); err != nil {
+		return 0, fmt.Errorf("failed to save raid: %w", err)
+	}
+
+	return hit.Damage(), nil
+}
@@ -0,0 +1,115 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/igor/chronotask-api/internal/application/port"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+// RecomputeCharacterXpInput represents the input for checking a character's progress against its XP ledger
+type RecomputeCharacterXpInput struct {
+	CharacterID string
+	Fix         bool // Overwrite the stored progress with the ledger's when they drifted apart
+}
+
+// CharacterProgressOutput represents a character's level and XP
+type CharacterProgressOutput struct {
+	Level     int
+	CurrentXp int
+	TotalXp   int
+}
+
+// RecomputeCharacterXpOutput compares the stored progress of a character with the one its ledger adds up to
+type RecomputeCharacterXpOutput struct {
+	CharacterID  string
+	Transactions int
+	Stored       CharacterProgressOutput
+	Ledger       CharacterProgressOutput
+	Drift        bool
+	Fixed        bool
+}
+
+// RecomputeCharacterXpUseCase rebuilds a character's level and XP from its XP ledger to detect (and repair) drift
+// This is an administrative operation: it is not bound to a user
+type RecomputeCharacterXpUseCase struct {
+	characterRepo     repository.CharacterRepository
+	xpTransactionRepo repository.XpTransactionRepository
+	unitOfWork        port.UnitOfWork
+}
+
+// NewRecomputeCharacterXpUseCase creates a new RecomputeCharacterXpUseCase
+func NewRecomputeCharacterXpUseCase(
+	characterRepo repository.CharacterRepository,
+	xpTransactionRepo repository.XpTransactionRepository,
+	unitOfWork port.UnitOfWork,
+) *RecomputeCharacterXpUseCase {
+	return &RecomputeCharacterXpUseCase{
+		characterRepo:     characterRepo,
+		xpTransactionRepo: xpTransactionRepo,
+		unitOfWork:        unitOfWork,
+	}
+}
+
+// Execute replays the character's ledger and reports whether the stored progress matches it
+// A repair locks the character first, so XP earned meanwhile is neither lost nor missing from the replay
+func (uc *RecomputeCharacterXpUseCase) Execute(ctx context.Context, input RecomputeCharacterXpInput) (*RecomputeCharacterXpOutput, error) {
+	var output *RecomputeCharacterXpOutput
+	err := uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		// 1. Load the character and its whole ledger
+		findCharacter := uc.characterRepo.FindByID
+		if input.Fix {
+			findCharacter = uc.characterRepo.FindByIDForUpdate
+		}
+		character, err := findCharacter(ctx, input.CharacterID)
+		if err != nil {
+			return ErrCharacterNotFound
+		}
+
+		transactions, err := uc.xpTransactionRepo.FindAllByCharacterID(ctx, character.ID())
+		if err != nil {
+			return fmt.Errorf("failed to fetch xp transactions: %w", err)
+		}
+
+		// 2. Replay the ledger (domain rules handle level-ups and de-leveling)
+		level, currentXp, totalXp, err := entity.ReplayXpTransactions(transactions)
+		if err != nil {
+			return err
+		}
+
+		output = &RecomputeCharacterXpOutput{
+			CharacterID:  character.ID(),
+			Transactions: len(transactions),
+			Stored: CharacterProgressOutput{
+				Level:     character.Level(),
+				CurrentXp: character.CurrentXp(),
+				TotalXp:   character.TotalXp(),
+			},
+			Ledger: CharacterProgressOutput{
+				Level:     level,
+				CurrentXp: currentXp,
+				TotalXp:   totalXp,
+			},
+		}
+		output.Drift = output.Stored != output.Ledger
+
+		// 3. The ledger is the source of truth when repairing drift
+		if output.Drift && input.Fix {
+			if err := character.CorrectXp(level, currentXp, totalXp); err != nil {
+				return fmt.Errorf("failed to correct character xp: %w", err)
+			}
+			if err := uc.characterRepo.Update(ctx, character); err != nil {
+				return fmt.Errorf("failed to save character: %w", err)
+			}
+			output.Fixed = true
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
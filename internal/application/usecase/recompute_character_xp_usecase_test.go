@@ -0,0 +1,79 @@
+package usecase_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+func newRecomputeFixture(level, currentXp, totalXp int) (*entity.Character, *mockCharacterRepositoryForHabits, *int) {
+	character := entity.ReconstituteCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), level, currentXp, totalXp, 0, "user-123", time.Now())
+	updates := 0
+	charRepo := &mockCharacterRepositoryForHabits{
+		findByIDFunc: func(ctx context.Context, id string) (*entity.Character, error) {
+			return character, nil
+		},
+		updateFunc: func(ctx context.Context, c *entity.Character) error {
+			updates++
+			return nil
+		},
+	}
+	return character, charRepo, &updates
+}
+
+func TestRecomputeCharacterXpUseCase_Execute_NoDrift(t *testing.T) {
+	// 100 + 30 XP: level 2 with 30 XP
+	_, charRepo, updates := newRecomputeFixture(2, 30, 130)
+	uc := usecase.NewRecomputeCharacterXpUseCase(charRepo, newXpLedger(100, 30), &mockUnitOfWork{})
+
+	output, err := uc.Execute(context.Background(), usecase.RecomputeCharacterXpInput{CharacterID: "char-123", Fix: true})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.Drift || output.Fixed || *updates != 0 {
+		t.Errorf("output = (drift %v, fixed %v, %v updates), want no drift and no update", output.Drift, output.Fixed, *updates)
+	}
+	if output.Transactions != 2 {
+		t.Errorf("output.Transactions = %v, want %v", output.Transactions, 2)
+	}
+}
+
+func TestRecomputeCharacterXpUseCase_Execute_DetectsAndFixesDrift(t *testing.T) {
+	// The stored character kept a reward the ledger never recorded
+	character, charRepo, updates := newRecomputeFixture(2, 70, 170)
+	ledger := newXpLedger(100, 30)
+
+	// Detection only
+	output, err := usecase.NewRecomputeCharacterXpUseCase(charRepo, ledger, &mockUnitOfWork{}).Execute(context.Background(), usecase.RecomputeCharacterXpInput{CharacterID: "char-123"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if !output.Drift || output.Fixed || *updates != 0 {
+		t.Errorf("output = (drift %v, fixed %v, %v updates), want drift without fixing", output.Drift, output.Fixed, *updates)
+	}
+	if output.Ledger != (usecase.CharacterProgressOutput{Level: 2, CurrentXp: 30, TotalXp: 130}) {
+		t.Errorf("output.Ledger = %+v, want level 2 with 30/130 XP", output.Ledger)
+	}
+
+	// Repair
+	output, err = usecase.NewRecomputeCharacterXpUseCase(charRepo, ledger, &mockUnitOfWork{}).Execute(context.Background(), usecase.RecomputeCharacterXpInput{CharacterID: "char-123", Fix: true})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if !output.Fixed || *updates != 1 {
+		t.Errorf("output = (fixed %v, %v updates), want fixed with 1 update", output.Fixed, *updates)
+	}
+	if len(charRepo.locked) != 1 {
+		t.Errorf("locked characters = %v, want the character locked only by the repair", charRepo.locked)
+	}
+	if character.Level() != 2 || character.CurrentXp() != 30 || character.TotalXp() != 130 {
+		t.Errorf("character = (level %v, xp %v, total %v), want (2, 30, 130)", character.Level(), character.CurrentXp(), character.TotalXp())
+	}
+}
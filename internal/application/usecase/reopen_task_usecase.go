@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/igor/chronotask-api/internal/application/port"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// ReopenTaskUseCase handles reopening a completed task
+type ReopenTaskUseCase struct {
+	taskRepo        repository.TaskRepository
+	characterRepo   repository.CharacterRepository
+	preferencesRepo repository.UserPreferencesRepository
+	unitOfWork      port.UnitOfWork
+}
+
+// NewReopenTaskUseCase creates a new ReopenTaskUseCase
+func NewReopenTaskUseCase(
+	taskRepo repository.TaskRepository,
+	characterRepo repository.CharacterRepository,
+	preferencesRepo repository.UserPreferencesRepository,
+	unitOfWork port.UnitOfWork,
+) *ReopenTaskUseCase {
+	return &ReopenTaskUseCase{
+		taskRepo:        taskRepo,
+		characterRepo:   characterRepo,
+		preferencesRepo: preferencesRepo,
+		unitOfWork:      unitOfWork,
+	}
+}
+
+// Execute reopens a completed task owned by the user
+// The XP awarded by the completion is taken back, so a task can't be farmed by completing it repeatedly
+func (uc *ReopenTaskUseCase) Execute(ctx context.Context, input TaskInput) (*TaskRewardOutput, error) {
+	// 1. Validate task exists AND belongs to the authenticated user
+	task, err := uc.taskRepo.FindByIDAndUserID(ctx, input.TaskID, input.UserID)
+	if err != nil {
+		return nil, ErrTaskNotFound
+	}
+	if !task.IsCompleted() {
+		return nil, ErrTaskNotCompleted
+	}
+
+	today, err := userToday(ctx, uc.preferencesRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Reopen the task
+	xpAwarded, err := task.Reopen()
+	if err != nil {
+		return nil, fmt.Errorf("failed to reopen task: %w", err)
+	}
+
+	source, err := valueobject.NewXpSource(valueobject.XpSourceTaskReopen, task.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create xp source: %w", err)
+	}
+
+	// 3. Save the reopening and take the reward back from the locked character atomically
+	var (
+		character  *entity.Character
+		xpLost     int
+		levelsLost int
+	)
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		// Fails when a concurrent request already reopened the task
+		if err := uc.taskRepo.UpdateCompletion(ctx, task); err != nil {
+			if errors.Is(err, repository.ErrTaskCompletionChanged) {
+				return ErrTaskNotCompleted
+			}
+			return fmt.Errorf("failed to save task: %w", err)
+		}
+
+		character, err = uc.characterRepo.FindByIDForUpdate(ctx, task.CharacterID())
+		if err != nil {
+			return ErrCharacterNotFound
+		}
+		// Domain rules handle de-leveling
+		xpLost, levelsLost, err = character.LoseXpFrom(xpAwarded, source)
+		if err != nil {
+			return fmt.Errorf("failed to remove xp: %w", err)
+		}
+		if err := uc.characterRepo.Update(ctx, character); err != nil {
+			return fmt.Errorf("failed to save character: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &TaskRewardOutput{
+		Task:           mapTaskEntityToOutput(task, today),
+		XpGained:       -xpLost,
+		LevelsGained:   -levelsLost,
+		Level:          character.Level(),
+		CurrentXp:      character.CurrentXp(),
+		TotalXp:        character.TotalXp(),
+		XpForNextLevel: character.XpForNextLevel(),
+	}, nil
+}
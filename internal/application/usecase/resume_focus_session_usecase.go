@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+// ResumeFocusSessionUseCase handles resuming a paused focus session
+type ResumeFocusSessionUseCase struct {
+	focusSessionRepo repository.FocusSessionRepository
+}
+
+// NewResumeFocusSessionUseCase creates a new ResumeFocusSessionUseCase
+func NewResumeFocusSessionUseCase(
+	focusSessionRepo repository.FocusSessionRepository,
+) *ResumeFocusSessionUseCase {
+	return &ResumeFocusSessionUseCase{
+		focusSessionRepo: focusSessionRepo,
+	}
+}
+
+// Execute resumes a paused focus session owned by the user
+func (uc *ResumeFocusSessionUseCase) Execute(ctx context.Context, input FocusSessionInput) (*FocusSessionOutput, error) {
+	// Validate session exists AND belongs to the authenticated user
+	session, err := uc.focusSessionRepo.FindByIDAndUserID(ctx, input.SessionID, input.UserID)
+	if err != nil {
+		return nil, ErrFocusSessionNotFound
+	}
+
+	switch session.Status() {
+	case entity.FocusSessionCompleted:
+		return nil, ErrFocusSessionEnded
+	case entity.FocusSessionRunning:
+		return nil, ErrFocusSessionNotPaused
+	}
+
+	now := time.Now().UTC()
+	if err := session.Resume(now); err != nil {
+		return nil, fmt.Errorf("failed to resume focus session: %w", err)
+	}
+
+	if err := uc.focusSessionRepo.Update(ctx, session); err != nil {
+		return nil, fmt.Errorf("failed to save focus session: %w", err)
+	}
+
+	output := mapFocusSessionEntityToOutput(session, now)
+	return &output, nil
+}
@@ -0,0 +1,212 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igor/chronotask-api/internal/application/port"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+var (
+	// ErrDungeonNotFound is returned when a dungeon is not in the catalog
+	ErrDungeonNotFound = errors.New("dungeon not found")
+
+	// ErrDungeonLevelTooLow is returned when the character is below the dungeon's minimum level
+	ErrDungeonLevelTooLow = errors.New("character level is too low for this dungeon")
+
+	// ErrNotEnoughXpForEntry is returned when the character can't pay the dungeon's entry cost
+	ErrNotEnoughXpForEntry = errors.New("not enough xp to pay the dungeon entry cost")
+
+	// ErrDungeonRunInProgress is returned when starting a dungeon while running another one
+	ErrDungeonRunInProgress = errors.New("character is already running another dungeon")
+
+	// ErrDungeonRunNotFound is returned when the character has no run in progress in the dungeon
+	ErrDungeonRunNotFound = errors.New("no dungeon run in progress for character")
+)
+
+// DungeonRunInput identifies the dungeon run of one of the authenticated user's characters
+type DungeonRunInput struct {
+	DungeonID   string
+	CharacterID string
+	UserID      string // User ID from authentication token
+}
+
+// DungeonRunOutput represents the progress of a dungeon run
+type DungeonRunOutput struct {
+	ID            string
+	DungeonID     string
+	DungeonName   string
+	CharacterID   string
+	Status        string // in_progress, completed, failed or abandoned
+	StagesCleared int
+	TotalStages   int
+	HP            int    // HP carried into the next stage
+	MaxHP         int    // Character's full HP
+	Checkpoint    int    // Stage a defeat sends the run back to (0 when no checkpoint is saved)
+	NextMonsterID string // Empty once the run is over
+	StartedAt     string
+	UpdatedAt     string
+	EndedAt       string // Empty while in progress
+}
+
+// StartDungeonOutput represents a started (or resumed) dungeon run
+type StartDungeonOutput struct {
+	Run       DungeonRunOutput
+	Resumed   bool // The character was already running the dungeon: nothing was charged
+	EntryCost int  // XP paid to enter
+}
+
+// StartDungeonUseCase handles entering a dungeon (or resuming the run in progress)
+type StartDungeonUseCase struct {
+	characterRepo          repository.CharacterRepository
+	characterAttributeRepo repository.CharacterAttributeRepository
+	dungeonRepo            repository.DungeonRepository
+	dungeonRunRepo         repository.DungeonRunRepository
+	unitOfWork             port.UnitOfWork
+}
+
+// NewStartDungeonUseCase creates a new StartDungeonUseCase
+func NewStartDungeonUseCase(
+	characterRepo repository.CharacterRepository,
+	characterAttributeRepo repository.CharacterAttributeRepository,
+	dungeonRepo repository.DungeonRepository,
+	dungeonRunRepo repository.DungeonRunRepository,
+	unitOfWork port.UnitOfWork,
+) *StartDungeonUseCase {
+	return &StartDungeonUseCase{
+		characterRepo:          characterRepo,
+		characterAttributeRepo: characterAttributeRepo,
+		dungeonRepo:            dungeonRepo,
+		dungeonRunRepo:         dungeonRunRepo,
+		unitOfWork:             unitOfWork,
+	}
+}
+
+// Execute pays the entry cost and starts a run at the first stage with the character at full HP
+// A character already running this dungeon resumes its run instead
+func (uc *StartDungeonUseCase) Execute(ctx context.Context, input DungeonRunInput) (*StartDungeonOutput, error) {
+	// 1. Validate character exists AND belongs to the authenticated user
+	character, err := uc.characterRepo.FindByIDAndUserID(ctx, input.CharacterID, input.UserID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+
+	dungeon, err := uc.dungeonRepo.FindByID(ctx, input.DungeonID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrDungeonNotFound, input.DungeonID)
+	}
+
+	challenger, err := characterCombatant(ctx, uc.characterAttributeRepo, character)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. One dungeon at a time: the run in progress of this dungeon is resumed
+	current, err := uc.dungeonRunRepo.FindInProgressByCharacterID(ctx, character.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch dungeon run: %w", err)
+	}
+	if current != nil {
+		if current.DungeonID() != dungeon.ID() {
+			return nil, fmt.Errorf("%w: %s", ErrDungeonRunInProgress, current.DungeonID())
+		}
+		return &StartDungeonOutput{
+			Run:     mapDungeonRunToOutput(current, dungeon, challenger.Stats().HP()),
+			Resumed: true,
+		}, nil
+	}
+
+	// 3. Validate the character may enter and can pay for it
+	if err := checkDungeonEntry(dungeon, character); err != nil {
+		return nil, err
+	}
+
+	// 4. Create the run, then pay the entry cost (recorded in the ledger) from the locked character
+	run, err := entity.NewDungeonRun(uuid.New().String(), dungeon.ID(), character.ID(), challenger.Stats().HP(), time.Now().UTC())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create dungeon run: %w", err)
+	}
+
+	source, err := valueobject.NewXpSource(valueobject.XpSourceDungeonEntry, run.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create xp source: %w", err)
+	}
+
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		if err := uc.dungeonRunRepo.Create(ctx, run); err != nil {
+			return fmt.Errorf("failed to save dungeon run: %w", err)
+		}
+
+		// Checked again: a concurrent request may have spent the character's XP
+		locked, err := uc.characterRepo.FindByIDForUpdate(ctx, character.ID())
+		if err != nil {
+			return ErrCharacterNotFound
+		}
+		if err := checkDungeonEntry(dungeon, locked); err != nil {
+			return err
+		}
+		if dungeon.EntryCost() == 0 {
+			return nil
+		}
+
+		if _, _, err := locked.LoseXpFrom(dungeon.EntryCost(), source); err != nil {
+			return fmt.Errorf("failed to pay entry cost: %w", err)
+		}
+		if err := uc.characterRepo.Update(ctx, locked); err != nil {
+			return fmt.Errorf("failed to save character: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &StartDungeonOutput{
+		Run:       mapDungeonRunToOutput(run, dungeon, challenger.Stats().HP()),
+		EntryCost: dungeon.EntryCost(),
+	}, nil
+}
+
+// checkDungeonEntry validates the character's level and that it can pay the entry cost
+func checkDungeonEntry(dungeon *entity.Dungeon, character *entity.Character) error {
+	if !dungeon.AllowsLevel(character.Level()) {
+		return fmt.Errorf("%w: requires level %d", ErrDungeonLevelTooLow, dungeon.MinLevel())
+	}
+	if character.CurrentXp() < dungeon.EntryCost() {
+		return fmt.Errorf("%w: costs %d xp, character has %d", ErrNotEnoughXpForEntry, dungeon.EntryCost(), character.CurrentXp())
+	}
+	return nil
+}
+
+// mapDungeonRunToOutput converts a DungeonRun entity to output format
+func mapDungeonRunToOutput(run *entity.DungeonRun, dungeon *entity.Dungeon, maxHP int) DungeonRunOutput {
+	output := DungeonRunOutput{
+		ID:            run.ID(),
+		DungeonID:     dungeon.ID(),
+		DungeonName:   dungeon.Name(),
+		CharacterID:   run.CharacterID(),
+		Status:        run.Status(),
+		StagesCleared: run.Stage(),
+		TotalStages:   dungeon.StageCount(),
+		HP:            min(run.HP(), maxHP),
+		MaxHP:         maxHP,
+		Checkpoint:    run.Checkpoint(),
+		StartedAt:     run.StartedAt().Format("2006-01-02T15:04:05Z07:00"),
+		UpdatedAt:     run.UpdatedAt().Format("2006-01-02T15:04:05Z07:00"),
+	}
+
+	if stage, ok := dungeon.Stage(run.Stage()); ok && run.IsInProgress() {
+		output.NextMonsterID = stage.MonsterID
+	}
+	if endedAt := run.EndedAt(); endedAt != nil {
+		output.EndedAt = endedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	return output
+}
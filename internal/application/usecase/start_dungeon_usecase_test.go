@@ -0,0 +1,239 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Mock DungeonRepository
+type mockDungeonRepository struct {
+	dungeons map[string]*entity.Dungeon
+}
+
+func (m *mockDungeonRepository) FindByID(ctx context.Context, id string) (*entity.Dungeon, error) {
+	if dungeon, ok := m.dungeons[id]; ok {
+		return dungeon, nil
+	}
+	return nil, errors.New("dungeon not found")
+}
+
+// Mock DungeonRunRepository
+type mockDungeonRunRepository struct {
+	runs   map[string]*entity.DungeonRun
+	locked int // Runs fetched for update
+}
+
+func (m *mockDungeonRunRepository) Create(ctx context.Context, run *entity.DungeonRun) error {
+	if existing, _ := m.FindInProgressByCharacterID(ctx, run.CharacterID()); existing != nil {
+		return errors.New("character already has a dungeon run in progress")
+	}
+	m.runs[run.ID()] = run
+	return nil
+}
+
+func (m *mockDungeonRunRepository) Update(ctx context.Context, run *entity.DungeonRun) error {
+	if _, ok := m.runs[run.ID()]; !ok {
+		return errors.New("dungeon run not found")
+	}
+	m.runs[run.ID()] = run
+	return nil
+}
+
+func (m *mockDungeonRunRepository) FindInProgressByCharacterID(ctx context.Context, characterID string) (*entity.DungeonRun, error) {
+	for _, run := range m.runs {
+		if run.CharacterID() == characterID && run.IsInProgress() {
+			return run, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockDungeonRunRepository) FindInProgressByCharacterIDForUpdate(ctx context.Context, characterID string) (*entity.DungeonRun, error) {
+	m.locked++
+	return m.FindInProgressByCharacterID(ctx, characterID)
+}
+
+// dungeonFixture holds the dungeon use cases of char-123 (user-123) and their repositories
+type dungeonFixture struct {
+	start      *usecase.StartDungeonUseCase
+	advance    *usecase.AdvanceDungeonUseCase
+	abandon    *usecase.AbandonDungeonUseCase
+	character  *entity.Character
+	updated    *[]*entity.Character
+	runRepo    *mockDungeonRunRepository
+	battleRepo *mockBattleRepository
+	energyRepo *mockCharacterEnergyRepository
+	unitOfWork *mockUnitOfWork
+}
+
+// newDungeonFixture builds the dungeon use cases for char-123 (attributes at attributeValue) with currentXp XP
+// Dungeons (monsters from newTestMonsterCatalog):
+//   - toca-dos-ratos: 3 rats with a checkpoint at the 2nd, costs 10 XP and rewards 80 XP and a silver coin
+//   - covil-do-ogro: a rat with a checkpoint, then the level 30 ogre; free, no reward
+//   - cripta-esquecida: from level 5
+func newDungeonFixture(t *testing.T, attributeValue int, level int, currentXp int) *dungeonFixture {
+	t.Helper()
+
+	character := entity.ReconstituteCharacter("char-123", "Hero", valueobject.DefaultCharacterClass(), level, currentXp, currentXp, 0, "user-123", time.Now())
+	var updated []*entity.Character
+
+	charRepo := &mockCharacterRepositoryForHabits{
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Character, error) {
+			if id == "char-123" && userID == "user-123" {
+				return character, nil
+			}
+			return nil, errors.New("character not found or does not belong to user")
+		},
+		findByIDFunc: func(ctx context.Context, id string) (*entity.Character, error) {
+			return character, nil
+		},
+		updateFunc: func(ctx context.Context, character *entity.Character) error {
+			updated = append(updated, character)
+			return nil
+		},
+	}
+
+	attrRepo := &mockCharacterAttributeRepositoryGet{
+		findByCharacterIDFunc: func(ctx context.Context, characterID string) ([]*entity.CharacterAttribute, error) {
+			return newUniformAttributes(characterID, attributeValue), nil
+		},
+	}
+
+	coin, err := valueobject.NewLootDrop("Moeda de Prata", 100, 1)
+	if err != nil {
+		t.Fatalf("NewLootDrop() error = %v, want nil", err)
+	}
+	dungeonRepo := &mockDungeonRepository{dungeons: map[string]*entity.Dungeon{
+		"toca-dos-ratos": entity.ReconstituteDungeon("toca-dos-ratos", "Toca dos Ratos", 1, 10, 80, []valueobject.LootDrop{coin}, []entity.DungeonStage{
+			{MonsterID: "rato-gigante"},
+			{MonsterID: "rato-gigante", Checkpoint: true},
+			{MonsterID: "rato-gigante"},
+		}),
+		"covil-do-ogro": entity.ReconstituteDungeon("covil-do-ogro", "Covil do Ogro", 1, 0, 0, nil, []entity.DungeonStage{
+			{MonsterID: "rato-gigante", Checkpoint: true},
+			{MonsterID: "ogro"},
+		}),
+		"cripta-esquecida": entity.ReconstituteDungeon("cripta-esquecida", "Cripta Esquecida", 5, 40, 300, nil, []entity.DungeonStage{
+			{MonsterID: "rato-gigante"},
+		}),
+	}}
+
+	runRepo := &mockDungeonRunRepository{runs: map[string]*entity.DungeonRun{}}
+	battleRepo := &mockBattleRepository{}
+	energyRepo := newMockCharacterEnergyRepository()
+	unitOfWork := &mockUnitOfWork{}
+
+	return &dungeonFixture{
+		start:      usecase.NewStartDungeonUseCase(charRepo, attrRepo, dungeonRepo, runRepo, unitOfWork),
+		advance:    usecase.NewAdvanceDungeonUseCase(charRepo, attrRepo, newTestMonsterCatalog(), battleRepo, dungeonRepo, runRepo, energyRepo, unitOfWork),
+		abandon:    usecase.NewAbandonDungeonUseCase(charRepo, attrRepo, dungeonRepo, runRepo, unitOfWork),
+		character:  character,
+		updated:    &updated,
+		runRepo:    runRepo,
+		battleRepo: battleRepo,
+		energyRepo: energyRepo,
+		unitOfWork: unitOfWork,
+	}
+}
+
+func dungeonInput(dungeonID string) usecase.DungeonRunInput {
+	return usecase.DungeonRunInput{DungeonID: dungeonID, CharacterID: "char-123", UserID: "user-123"}
+}
+
+func TestStartDungeonUseCase_Execute_PaysEntryCost(t *testing.T) {
+	fixture := newDungeonFixture(t, 10, 3, 50)
+
+	output, err := fixture.start.Execute(context.Background(), dungeonInput("toca-dos-ratos"))
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	run := output.Run
+	if output.Resumed || output.EntryCost != 10 {
+		t.Errorf("output = %+v, want a new run costing 10 XP", output)
+	}
+	if run.Status != entity.DungeonRunInProgress || run.StagesCleared != 0 || run.TotalStages != 3 || run.NextMonsterID != "rato-gigante" {
+		t.Errorf("run = %+v, want a run at the first of 3 stages", run)
+	}
+
+	// The character enters at full HP
+	wantHP := valueobject.NewCombatStats(map[string]int{valueobject.AttributeConstitution: 10}, 3).HP()
+	if run.HP != wantHP || run.MaxHP != wantHP {
+		t.Errorf("run HP = %d/%d, want %d/%d", run.HP, run.MaxHP, wantHP, wantHP)
+	}
+
+	// The entry cost is paid and recorded in the ledger against the run
+	if fixture.character.CurrentXp() != 40 || len(*fixture.updated) != 1 {
+		t.Errorf("CurrentXp() = %d after %d updates, want 40 after 1", fixture.character.CurrentXp(), len(*fixture.updated))
+	}
+	pending := fixture.character.PendingXpTransactions()
+	if len(pending) != 1 || pending[0].Amount() != -10 || pending[0].Source().Type() != valueobject.XpSourceDungeonEntry || pending[0].Source().ID() != run.ID {
+		t.Errorf("pending xp transactions = %+v, want the entry cost of run %s", pending, run.ID)
+	}
+
+	if _, ok := fixture.runRepo.runs[run.ID]; !ok || fixture.unitOfWork.commits != 1 {
+		t.Error("run should be saved in one unit of work")
+	}
+}
+
+func TestStartDungeonUseCase_Execute_ResumesRunInProgress(t *testing.T) {
+	fixture := newDungeonFixture(t, 10, 3, 50)
+
+	first, err := fixture.start.Execute(context.Background(), dungeonInput("toca-dos-ratos"))
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	// Starting the same dungeon again resumes the run without paying again
+	second, err := fixture.start.Execute(context.Background(), dungeonInput("toca-dos-ratos"))
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if !second.Resumed || second.EntryCost != 0 || second.Run.ID != first.Run.ID {
+		t.Errorf("second = %+v, want run %s resumed for free", second, first.Run.ID)
+	}
+	if fixture.character.CurrentXp() != 40 {
+		t.Errorf("CurrentXp() = %d, want 40 (charged once)", fixture.character.CurrentXp())
+	}
+
+	// ...but another dungeon can't be started meanwhile
+	_, err = fixture.start.Execute(context.Background(), dungeonInput("covil-do-ogro"))
+	if !errors.Is(err, usecase.ErrDungeonRunInProgress) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrDungeonRunInProgress)
+	}
+}
+
+func TestStartDungeonUseCase_Execute_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		level     int
+		currentXp int
+		input     usecase.DungeonRunInput
+		want      error
+	}{
+		{"character of another user", 5, 50, usecase.DungeonRunInput{DungeonID: "toca-dos-ratos", CharacterID: "char-123", UserID: "user-456"}, usecase.ErrCharacterNotFound},
+		{"unknown dungeon", 5, 50, dungeonInput("masmorra"), usecase.ErrDungeonNotFound},
+		{"level too low", 4, 50, dungeonInput("cripta-esquecida"), usecase.ErrDungeonLevelTooLow},
+		{"not enough xp", 5, 39, dungeonInput("cripta-esquecida"), usecase.ErrNotEnoughXpForEntry},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fixture := newDungeonFixture(t, 10, tt.level, tt.currentXp)
+
+			_, err := fixture.start.Execute(context.Background(), tt.input)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Execute() error = %v, want %v", err, tt.want)
+			}
+			if len(fixture.runRepo.runs) != 0 || fixture.character.CurrentXp() != tt.currentXp {
+				t.Error("a rejected start should neither create a run nor charge the character")
+			}
+		})
+	}
+}
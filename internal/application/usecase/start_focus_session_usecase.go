@@ -0,0 +1,174 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+var (
+	// ErrFocusSessionNotFound is returned when a focus session doesn't exist or doesn't belong to the user
+	ErrFocusSessionNotFound = errors.New("focus session not found or does not belong to user")
+
+	// ErrFocusSessionInProgress is returned when starting a session while another one is still open
+	ErrFocusSessionInProgress = errors.New("another focus session is already in progress")
+
+	// ErrFocusSessionNotRunning is returned when pausing a session that isn't running
+	ErrFocusSessionNotRunning = errors.New("focus session is not running")
+
+	// ErrFocusSessionNotPaused is returned when resuming a session that isn't paused
+	ErrFocusSessionNotPaused = errors.New("focus session is not paused")
+
+	// ErrFocusSessionEnded is returned when changing a session that was already stopped
+	ErrFocusSessionEnded = errors.New("focus session has already ended")
+
+	// ErrInvalidFocusSessionTarget is returned when a session isn't started against exactly one habit or task
+	ErrInvalidFocusSessionTarget = errors.New("focus session must be tracked against either a habit or a task")
+)
+
+// StartFocusSessionInput represents the input for starting a focus session
+// Exactly one of HabitID and TaskID must be set
+type StartFocusSessionInput struct {
+	UserID  string // User ID from authentication token
+	HabitID string // Habit the session is tracked against
+	TaskID  string // Task the session is tracked against
+}
+
+// FocusSessionInput identifies a focus session of the authenticated user
+type FocusSessionInput struct {
+	SessionID string
+	UserID    string // User ID from authentication token
+}
+
+// FocusSessionOutput represents a focus session in the output of the focus session use cases
+type FocusSessionOutput struct {
+	ID             string
+	CharacterID    string
+	HabitID        string // Empty for task sessions
+	TaskID         string // Empty for habit sessions
+	Status         string // running, paused, completed
+	StartedAt      string
+	PausedAt       string // Empty unless paused
+	EndedAt        string // Empty until stopped
+	TrackedMinutes int    // Focused minutes so far, excluding pauses
+	XpAwarded      int
+}
+
+// StartFocusSessionUseCase handles starting a timed focus session
+type StartFocusSessionUseCase struct {
+	focusSessionRepo repository.FocusSessionRepository
+	habitRepo        repository.HabitRepository
+	taskRepo         repository.TaskRepository
+}
+
+// NewStartFocusSessionUseCase creates a new StartFocusSessionUseCase
+func NewStartFocusSessionUseCase(
+	focusSessionRepo repository.FocusSessionRepository,
+	habitRepo repository.HabitRepository,
+	taskRepo repository.TaskRepository,
+) *StartFocusSessionUseCase {
+	return &StartFocusSessionUseCase{
+		focusSessionRepo: focusSessionRepo,
+		habitRepo:        habitRepo,
+		taskRepo:         taskRepo,
+	}
+}
+
+// Execute starts a focus session against one of the user's habits or open tasks
+// A user can only have one open (running or paused) session at a time
+func (uc *StartFocusSessionUseCase) Execute(ctx context.Context, input StartFocusSessionInput) (*FocusSessionOutput, error) {
+	if (input.HabitID == "") == (input.TaskID == "") {
+		return nil, ErrInvalidFocusSessionTarget
+	}
+
+	// 1. Validate the habit or task exists AND belongs to the authenticated user
+	characterID, err := uc.findTargetCharacter(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	// 2. Sessions can't overlap (the repository also rejects concurrent starts)
+	open, err := uc.focusSessionRepo.FindOpenByUserID(ctx, input.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to check open focus sessions: %w", err)
+	}
+	if open != nil {
+		return nil, ErrFocusSessionInProgress
+	}
+
+	// 3. Create focus session entity (with domain validation)
+	now := time.Now().UTC()
+	var session *entity.FocusSession
+	if input.TaskID != "" {
+		session, err = entity.NewTaskFocusSession(uuid.New().String(), characterID, input.TaskID, now)
+	} else {
+		session, err = entity.NewFocusSession(uuid.New().String(), characterID, input.HabitID, now)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to create focus session: %w", err)
+	}
+
+	// 4. Persist focus session
+	if err := uc.focusSessionRepo.Create(ctx, session); err != nil {
+		if errors.Is(err, repository.ErrFocusSessionOpen) {
+			return nil, ErrFocusSessionInProgress
+		}
+		return nil, fmt.Errorf("failed to save focus session: %w", err)
+	}
+
+	output := mapFocusSessionEntityToOutput(session, now)
+	return &output, nil
+}
+
+// findTargetCharacter validates the habit or task a session is started against
+// Returns the ID of the character that owns it
+func (uc *StartFocusSessionUseCase) findTargetCharacter(ctx context.Context, input StartFocusSessionInput) (string, error) {
+	if input.TaskID != "" {
+		task, err := uc.taskRepo.FindByIDAndUserID(ctx, input.TaskID, input.UserID)
+		if err != nil {
+			return "", ErrTaskNotFound
+		}
+		if task.IsCompleted() {
+			return "", ErrTaskAlreadyCompleted
+		}
+		return task.CharacterID(), nil
+	}
+
+	habit, err := uc.habitRepo.FindByIDAndUserID(ctx, input.HabitID, input.UserID)
+	if err != nil {
+		return "", ErrHabitNotFound
+	}
+	if !habit.Active() {
+		return "", ErrHabitInactive
+	}
+	return habit.CharacterID(), nil
+}
+
+// mapFocusSessionEntityToOutput converts a FocusSession entity to output format
+// now is used to compute the tracked time of running sessions
+func mapFocusSessionEntityToOutput(session *entity.FocusSession, now time.Time) FocusSessionOutput {
+	output := FocusSessionOutput{
+		ID:             session.ID(),
+		CharacterID:    session.CharacterID(),
+		HabitID:        session.HabitID(),
+		TaskID:         session.TaskID(),
+		Status:         session.Status(),
+		StartedAt:      session.StartedAt().Format("2006-01-02T15:04:05Z07:00"),
+		TrackedMinutes: session.TrackedMinutes(now),
+		XpAwarded:      session.XpAwarded(),
+	}
+
+	if pausedAt := session.PausedAt(); pausedAt != nil {
+		output.PausedAt = pausedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+	if endedAt := session.EndedAt(); endedAt != nil {
+		output.EndedAt = endedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	return output
+}
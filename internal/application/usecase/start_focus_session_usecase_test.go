@@ -0,0 +1,249 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Mock FocusSessionRepository
+type mockFocusSessionRepository struct {
+	createFunc            func(ctx context.Context, session *entity.FocusSession) error
+	findByIDAndUserIDFunc func(ctx context.Context, id string, userID string) (*entity.FocusSession, error)
+	findOpenFunc          func(ctx context.Context, userID string) (*entity.FocusSession, error)
+	findBetweenFunc       func(ctx context.Context, userID string, from time.Time, to time.Time) ([]*entity.FocusSession, error)
+	updateFunc            func(ctx context.Context, session *entity.FocusSession) error
+}
+
+func (m *mockFocusSessionRepository) Create(ctx context.Context, session *entity.FocusSession) error {
+	if m.createFunc != nil {
+		return m.createFunc(ctx, session)
+	}
+	return nil
+}
+
+func (m *mockFocusSessionRepository) FindByIDAndUserID(ctx context.Context, id string, userID string) (*entity.FocusSession, error) {
+	if m.findByIDAndUserIDFunc != nil {
+		return m.findByIDAndUserIDFunc(ctx, id, userID)
+	}
+	return nil, errors.New("focus session not found or does not belong to user")
+}
+
+func (m *mockFocusSessionRepository) FindOpenByUserID(ctx context.Context, userID string) (*entity.FocusSession, error) {
+	if m.findOpenFunc != nil {
+		return m.findOpenFunc(ctx, userID)
+	}
+	return nil, nil
+}
+
+func (m *mockFocusSessionRepository) FindByUserIDBetween(ctx context.Context, userID string, from time.Time, to time.Time) ([]*entity.FocusSession, error) {
+	if m.findBetweenFunc != nil {
+		return m.findBetweenFunc(ctx, userID, from, to)
+	}
+	return []*entity.FocusSession{}, nil
+}
+
+func (m *mockFocusSessionRepository) Update(ctx context.Context, session *entity.FocusSession) error {
+	if m.updateFunc != nil {
+		return m.updateFunc(ctx, session)
+	}
+	return nil
+}
+
+// newFocusHabitRepository returns a habit repository holding habit-123 (owned by user-123)
+func newFocusHabitRepository() *mockHabitRepository {
+	easy, _ := valueobject.NewDifficulty("easy")
+	habit := entity.ReconstituteHabit("habit-123", "Study", "", "char-123", "Inteligência", easy, valueobject.NewDailyRecurrence(), false, false, true, time.Now(), time.Now())
+
+	return &mockHabitRepository{
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Habit, error) {
+			if id == "habit-123" && userID == "user-123" {
+				return habit, nil
+			}
+			return nil, errors.New("habit not found or does not belong to user")
+		},
+	}
+}
+
+// newFocusTaskRepository returns a task repository holding task-123 (owned by user-123)
+func newFocusTaskRepository(completed bool) *mockTaskRepository {
+	difficulty, _ := valueobject.NewDifficulty("medium")
+	priority, _ := valueobject.NewPriority("high")
+	task, _ := entity.NewTask("task-123", "char-123", "File taxes", "", difficulty, priority, nil, nil)
+	if completed {
+		task.Complete(time.Now())
+	}
+
+	return &mockTaskRepository{
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Task, error) {
+			if id == "task-123" && userID == "user-123" {
+				return task, nil
+			}
+			return nil, errors.New("task not found or does not belong to user")
+		},
+	}
+}
+
+func TestStartFocusSessionUseCase_Execute_Success(t *testing.T) {
+	var created *entity.FocusSession
+	sessionRepo := &mockFocusSessionRepository{
+		createFunc: func(ctx context.Context, session *entity.FocusSession) error {
+			created = session
+			return nil
+		},
+	}
+
+	useCase := usecase.NewStartFocusSessionUseCase(sessionRepo, newFocusHabitRepository(), newFocusTaskRepository(false))
+
+	output, err := useCase.Execute(context.Background(), usecase.StartFocusSessionInput{
+		UserID:  "user-123",
+		HabitID: "habit-123",
+	})
+
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if created == nil {
+		t.Fatal("focus session was not persisted")
+	}
+
+	if output.Status != entity.FocusSessionRunning {
+		t.Errorf("output.Status = %v, want %v", output.Status, entity.FocusSessionRunning)
+	}
+
+	if output.CharacterID != "char-123" {
+		t.Errorf("output.CharacterID = %v, want %v", output.CharacterID, "char-123")
+	}
+}
+
+func TestStartFocusSessionUseCase_Execute_RejectsOverlap(t *testing.T) {
+	open, _ := entity.NewFocusSession("focus-1", "char-123", "habit-123", time.Now().Add(-10*time.Minute))
+	sessionRepo := &mockFocusSessionRepository{
+		findOpenFunc: func(ctx context.Context, userID string) (*entity.FocusSession, error) {
+			return open, nil
+		},
+		createFunc: func(ctx context.Context, session *entity.FocusSession) error {
+			t.Error("Create() should not be called while another session is open")
+			return nil
+		},
+	}
+
+	useCase := usecase.NewStartFocusSessionUseCase(sessionRepo, newFocusHabitRepository(), newFocusTaskRepository(false))
+
+	_, err := useCase.Execute(context.Background(), usecase.StartFocusSessionInput{
+		UserID:  "user-123",
+		HabitID: "habit-123",
+	})
+
+	if !errors.Is(err, usecase.ErrFocusSessionInProgress) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrFocusSessionInProgress)
+	}
+}
+
+func TestStartFocusSessionUseCase_Execute_RejectsConcurrentStart(t *testing.T) {
+	// Another request started a session after the overlap check: the insert is rejected
+	sessionRepo := &mockFocusSessionRepository{
+		createFunc: func(ctx context.Context, session *entity.FocusSession) error {
+			return repository.ErrFocusSessionOpen
+		},
+	}
+
+	useCase := usecase.NewStartFocusSessionUseCase(sessionRepo, newFocusHabitRepository(), newFocusTaskRepository(false))
+
+	_, err := useCase.Execute(context.Background(), usecase.StartFocusSessionInput{
+		UserID:  "user-123",
+		HabitID: "habit-123",
+	})
+
+	if !errors.Is(err, usecase.ErrFocusSessionInProgress) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrFocusSessionInProgress)
+	}
+}
+
+func TestStartFocusSessionUseCase_Execute_HabitNotOwned(t *testing.T) {
+	useCase := usecase.NewStartFocusSessionUseCase(&mockFocusSessionRepository{}, newFocusHabitRepository(), newFocusTaskRepository(false))
+
+	_, err := useCase.Execute(context.Background(), usecase.StartFocusSessionInput{
+		UserID:  "other-user",
+		HabitID: "habit-123",
+	})
+
+	if !errors.Is(err, usecase.ErrHabitNotFound) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrHabitNotFound)
+	}
+}
+
+func TestStartFocusSessionUseCase_Execute_TaskSession(t *testing.T) {
+	var created *entity.FocusSession
+	sessionRepo := &mockFocusSessionRepository{
+		createFunc: func(ctx context.Context, session *entity.FocusSession) error {
+			created = session
+			return nil
+		},
+	}
+
+	useCase := usecase.NewStartFocusSessionUseCase(sessionRepo, newFocusHabitRepository(), newFocusTaskRepository(false))
+
+	output, err := useCase.Execute(context.Background(), usecase.StartFocusSessionInput{
+		UserID: "user-123",
+		TaskID: "task-123",
+	})
+
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if created == nil || created.TaskID() != "task-123" || created.HabitID() != "" {
+		t.Fatalf("persisted session = %+v, want a session tracked against task-123", created)
+	}
+
+	if output.TaskID != "task-123" {
+		t.Errorf("output.TaskID = %v, want %v", output.TaskID, "task-123")
+	}
+
+	if output.CharacterID != "char-123" {
+		t.Errorf("output.CharacterID = %v, want %v", output.CharacterID, "char-123")
+	}
+}
+
+func TestStartFocusSessionUseCase_Execute_CompletedTask(t *testing.T) {
+	useCase := usecase.NewStartFocusSessionUseCase(&mockFocusSessionRepository{}, newFocusHabitRepository(), newFocusTaskRepository(true))
+
+	_, err := useCase.Execute(context.Background(), usecase.StartFocusSessionInput{
+		UserID: "user-123",
+		TaskID: "task-123",
+	})
+
+	if !errors.Is(err, usecase.ErrTaskAlreadyCompleted) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrTaskAlreadyCompleted)
+	}
+}
+
+func TestStartFocusSessionUseCase_Execute_InvalidTarget(t *testing.T) {
+	tests := []struct {
+		name  string
+		input usecase.StartFocusSessionInput
+	}{
+		{"no target", usecase.StartFocusSessionInput{UserID: "user-123"}},
+		{"habit and task", usecase.StartFocusSessionInput{UserID: "user-123", HabitID: "habit-123", TaskID: "task-123"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase := usecase.NewStartFocusSessionUseCase(&mockFocusSessionRepository{}, newFocusHabitRepository(), newFocusTaskRepository(false))
+
+			_, err := useCase.Execute(context.Background(), tt.input)
+
+			if !errors.Is(err, usecase.ErrInvalidFocusSessionTarget) {
+				t.Errorf("Execute() error = %v, want %v", err, usecase.ErrInvalidFocusSessionTarget)
+			}
+		})
+	}
+}
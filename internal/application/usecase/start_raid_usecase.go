@@ -0,0 +1,190 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/igor/chronotask-api/internal/application/port"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+var (
+	// ErrInvalidRaidBoss is returned when the boss is not in the raid catalog
+	ErrInvalidRaidBoss = errors.New("invalid raid boss")
+
+	// ErrRaidInProgress is returned when starting a raid while the guild is fighting another boss
+	ErrRaidInProgress = errors.New("guild is already fighting a raid boss")
+
+	// ErrRaidNotFound is returned when the guild never raided
+	ErrRaidNotFound = errors.New("guild has no raid")
+)
+
+// StartRaidInput represents the input for starting a guild raid
+type StartRaidInput struct {
+	GuildID     string
+	CharacterID string // Must lead the guild
+	UserID      string // User ID from authentication token
+	Boss        string
+}
+
+// RaidContributionOutput represents the damage one member dealt to a raid boss
+type RaidContributionOutput struct {
+	CharacterID string
+	Damage      int
+	Hits        int
+	RewardXp    int // Share of the reward, once the boss is defeated
+}
+
+// RaidOutput represents a guild raid and its contributors (most damage first)
+type RaidOutput struct {
+	ID            string
+	GuildID       string
+	Boss          string
+	BossName      string
+	MaxHP         int
+	HP            int
+	RewardXp      int    // Split among the contributors when the boss dies
+	Status        string // active, defeated or expired
+	StartedAt     string
+	EndsAt        string
+	EndedAt       string // Empty while active
+	Contributions []RaidContributionOutput
+}
+
+// StartRaidUseCase handles a guild leader starting a raid against a boss
+type StartRaidUseCase struct {
+	characterRepo repository.CharacterRepository
+	guildRepo     repository.GuildRepository
+	raidRepo      repository.RaidRepository
+	unitOfWork    port.UnitOfWork
+}
+
+// NewStartRaidUseCase creates a new StartRaidUseCase
+func NewStartRaidUseCase(
+	characterRepo repository.CharacterRepository,
+	guildRepo repository.GuildRepository,
+	raidRepo repository.RaidRepository,
+	unitOfWork port.UnitOfWork,
+) *StartRaidUseCase {
+	return &StartRaidUseCase{
+		characterRepo: characterRepo,
+		guildRepo:     guildRepo,
+		raidRepo:      raidRepo,
+		unitOfWork:    unitOfWork,
+	}
+}
+
+// Execute starts a raid of the guild, with the boss's HP pool and reward scaled to its members
+// A previous raid that ran out of time is expired first
+func (uc *StartRaidUseCase) Execute(ctx context.Context, input StartRaidInput) (*RaidOutput, error) {
+	// 1. Validate character exists AND belongs to the authenticated user
+	character, err := uc.characterRepo.FindByIDAndUserID(ctx, input.CharacterID, input.UserID)
+	if err != nil {
+		return nil, ErrCharacterNotFound
+	}
+
+	guild, err := uc.guildRepo.FindByID(ctx, input.GuildID)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrGuildNotFound, input.GuildID)
+	}
+	if !guild.IsLeader(character.ID()) {
+		return nil, ErrNotGuildLeader
+	}
+
+	boss, err := valueobject.NewRaidBoss(input.Boss)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidRaidBoss, err)
+	}
+
+	members, err := uc.guildRepo.CountMembers(ctx, guild.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to count guild members: %w", err)
+	}
+
+	// 2. Start the raid with the current one locked, so two raids can't start at once
+	now := time.Now().UTC()
+	var raid *entity.Raid
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		current, err := uc.raidRepo.FindActiveByGuildIDForUpdate(ctx, guild.ID())
+		if err != nil {
+			return fmt.Errorf("failed to fetch active raid: %w", err)
+		}
+		if current != nil {
+			if !current.IsOverdueAt(now) {
+				return fmt.Errorf("%w: %s", ErrRaidInProgress, current.Boss().Name())
+			}
+			if err := expireRaid(ctx, uc.raidRepo, current); err != nil {
+				return err
+			}
+		}
+
+		raid, err = entity.NewRaid(uuid.New().String(), guild.ID(), boss, members, now)
+		if err != nil {
+			return fmt.Errorf("failed to create raid: %w", err)
+		}
+		if err := uc.raidRepo.Create(ctx, raid); err != nil {
+			return fmt.Errorf("failed to save raid: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	output := mapRaidToOutput(raid, nil)
+	return &output, nil
+}
+
+// expireRaid ends a raid that ran out of time and saves it
+func expireRaid(ctx context.Context, raidRepo repository.RaidRepository, raid *entity.Raid) error {
+	if err := raid.Expire(); err != nil {
+		return fmt.Errorf("failed to expire raid: %w", err)
+	}
+	if err := raidRepo.Update(ctx, raid); err != nil {
+		return fmt.Errorf("failed to save raid: %w", err)
+	}
+	return nil
+}
+
+// mapRaidToOutput converts a Raid entity and its contributions to output format
+// The reward shares are only set once the boss is defeated
+func mapRaidToOutput(raid *entity.Raid, contributions []entity.RaidContribution) RaidOutput {
+	output := RaidOutput{
+		ID:            raid.ID(),
+		GuildID:       raid.GuildID(),
+		Boss:          raid.Boss().Value(),
+		BossName:      raid.Boss().Name(),
+		MaxHP:         raid.MaxHP(),
+		HP:            raid.HP(),
+		RewardXp:      raid.RewardXp(),
+		Status:        raid.Status(),
+		StartedAt:     raid.StartedAt().Format("2006-01-02T15:04:05Z07:00"),
+		EndsAt:        raid.EndsAt().Format("2006-01-02T15:04:05Z07:00"),
+		Contributions: make([]RaidContributionOutput, len(contributions)),
+	}
+	if endedAt := raid.EndedAt(); endedAt != nil {
+		output.EndedAt = endedAt.Format("2006-01-02T15:04:05Z07:00")
+	}
+
+	rewards := map[string]int{}
+	if raid.Status() == entity.RaidDefeated {
+		for _, reward := range raid.Rewards(contributions) {
+			rewards[reward.CharacterID] = reward.Xp
+		}
+	}
+	for i, contribution := range contributions {
+		output.Contributions[i] = RaidContributionOutput{
+			CharacterID: contribution.CharacterID,
+			Damage:      contribution.Damage,
+			Hits:        contribution.Hits,
+			RewardXp:    rewards[contribution.CharacterID],
+		}
+	}
+
+	return output
+}
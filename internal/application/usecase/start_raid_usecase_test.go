@@ -0,0 +1,274 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Mock RaidRepository
+type mockRaidRepository struct {
+	raids  map[string]*entity.Raid
+	hits   map[string]*entity.RaidHit // Completion ID -> hit
+	locked int                        // Raids fetched for update
+}
+
+func newMockRaidRepository() *mockRaidRepository {
+	return &mockRaidRepository{raids: map[string]*entity.Raid{}, hits: map[string]*entity.RaidHit{}}
+}
+
+func (m *mockRaidRepository) Create(ctx context.Context, raid *entity.Raid) error {
+	for _, existing := range m.raids {
+		if existing.GuildID() == raid.GuildID() && existing.IsActive() {
+			return errors.New("guild already has an active raid")
+		}
+	}
+	m.raids[raid.ID()] = raid
+	return nil
+}
+
+func (m *mockRaidRepository) Update(ctx context.Context, raid *entity.Raid) error {
+	if _, ok := m.raids[raid.ID()]; !ok {
+		return errors.New("raid not found")
+	}
+	m.raids[raid.ID()] = raid
+	return nil
+}
+
+func (m *mockRaidRepository) FindByIDForUpdate(ctx context.Context, id string) (*entity.Raid, error) {
+	m.locked++
+	if raid, ok := m.raids[id]; ok {
+		return raid, nil
+	}
+	return nil, errors.New("raid not found")
+}
+
+func (m *mockRaidRepository) FindActiveByGuildIDForUpdate(ctx context.Context, guildID string) (*entity.Raid, error) {
+	m.locked++
+	for _, raid := range m.raids {
+		if raid.GuildID() == guildID && raid.IsActive() {
+			return raid, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockRaidRepository) FindLatestByGuildID(ctx context.Context, guildID string) (*entity.Raid, error) {
+	var latest *entity.Raid
+	for _, raid := range m.raids {
+		if raid.GuildID() == guildID && (latest == nil || raid.StartedAt().After(latest.StartedAt())) {
+			latest = raid
+		}
+	}
+	return latest, nil
+}
+
+func (m *mockRaidRepository) CreateHit(ctx context.Context, hit *entity.RaidHit) error {
+	m.hits[hit.CompletionID()] = hit
+	return nil
+}
+
+func (m *mockRaidRepository) FindHitByCompletionID(ctx context.Context, completionID string) (*entity.RaidHit, error) {
+	return m.hits[completionID], nil
+}
+
+func (m *mockRaidRepository) ExistsHitByHabitOnDay(ctx context.Context, raidID, habitID string, day time.Time) (bool, error) {
+	for _, hit := range m.hits {
+		if hit.RaidID() == raidID && hit.HabitID() == habitID && hit.Day().Equal(day) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *mockRaidRepository) DeleteHit(ctx context.Context, completionID string) error {
+	delete(m.hits, completionID)
+	return nil
+}
+
+func (m *mockRaidRepository) FindContributions(ctx context.Context, raidID string) ([]entity.RaidContribution, error) {
+	byCharacter := map[string]*entity.RaidContribution{}
+	for _, hit := range m.hits {
+		if hit.RaidID() != raidID {
+			continue
+		}
+		contribution, ok := byCharacter[hit.CharacterID()]
+		if !ok {
+			contribution = &entity.RaidContribution{CharacterID: hit.CharacterID()}
+			byCharacter[hit.CharacterID()] = contribution
+		}
+		contribution.Damage += hit.Damage()
+		contribution.Hits++
+	}
+
+	contributions := make([]entity.RaidContribution, 0, len(byCharacter))
+	for _, contribution := range byCharacter {
+		contributions = append(contributions, *contribution)
+	}
+	sort.Slice(contributions, func(i, j int) bool {
+		if contributions[i].Damage != contributions[j].Damage {
+			return contributions[i].Damage > contributions[j].Damage
+		}
+		return contributions[i].CharacterID < contributions[j].CharacterID
+	})
+	return contributions, nil
+}
+
+// newRaidGuildRepository holds guild-123, led by char-123 with char-789 as a member (char-456 is guildless)
+func newRaidGuildRepository() *mockGuildRepository {
+	guildRepo := newMockGuildRepository()
+	guildRepo.guilds["guild-123"] = entity.ReconstituteGuild("guild-123", "Ordem da Aurora", "char-123", time.Now())
+	guildRepo.members["char-123"] = "guild-123"
+	guildRepo.members["char-789"] = "guild-123"
+	return guildRepo
+}
+
+func TestStartRaidUseCase_Execute(t *testing.T) {
+	raidRepo := newMockRaidRepository()
+	unitOfWork := &mockUnitOfWork{}
+	useCase := usecase.NewStartRaidUseCase(newGuildCharacterRepository(), newRaidGuildRepository(), raidRepo, unitOfWork)
+	input := usecase.StartRaidInput{GuildID: "guild-123", CharacterID: "char-123", UserID: "user-123", Boss: "hidra-do-pantano"}
+
+	output, err := useCase.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	// Hidra do Pântano: 300 HP and 150 XP per member, for 3 days
+	if output.MaxHP != 600 || output.HP != 600 || output.RewardXp != 300 {
+		t.Errorf("HP = %d/%d, RewardXp = %d, want 600/600 and 300", output.HP, output.MaxHP, output.RewardXp)
+	}
+	if output.Status != entity.RaidActive || output.BossName != "Hidra do Pântano" || output.EndedAt != "" {
+		t.Errorf("Execute() = %+v, want an active raid against Hidra do Pântano", output)
+	}
+	if unitOfWork.commits != 1 || raidRepo.locked != 1 {
+		t.Errorf("commits = %d, locked = %d, want 1 and 1", unitOfWork.commits, raidRepo.locked)
+	}
+
+	// The guild fights one boss at a time
+	_, err = useCase.Execute(context.Background(), input)
+	if !errors.Is(err, usecase.ErrRaidInProgress) {
+		t.Errorf("Execute() twice error = %v, want ErrRaidInProgress", err)
+	}
+}
+
+func TestStartRaidUseCase_Execute_ExpiresOverdueRaid(t *testing.T) {
+	raidRepo := newMockRaidRepository()
+	boss, _ := valueobject.NewRaidBoss("hidra-do-pantano")
+	startedAt := time.Now().UTC().Add(-4 * 24 * time.Hour)
+	overdue := entity.ReconstituteRaid("raid-old", "guild-123", boss, 600, 250, 300, entity.RaidActive, startedAt, startedAt.Add(boss.Duration()), nil)
+	raidRepo.raids[overdue.ID()] = overdue
+
+	useCase := usecase.NewStartRaidUseCase(newGuildCharacterRepository(), newRaidGuildRepository(), raidRepo, &mockUnitOfWork{})
+
+	output, err := useCase.Execute(context.Background(), usecase.StartRaidInput{GuildID: "guild-123", CharacterID: "char-123", UserID: "user-123", Boss: "dragao-anciao"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if output.Boss != "dragao-anciao" {
+		t.Errorf("Boss = %q, want dragao-anciao", output.Boss)
+	}
+	if overdue.Status() != entity.RaidExpired || overdue.EndedAt() == nil || !overdue.EndedAt().Equal(overdue.EndsAt()) {
+		t.Errorf("overdue raid status = %q, ended at %v, want expired at its end", overdue.Status(), overdue.EndedAt())
+	}
+}
+
+func TestStartRaidUseCase_Execute_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   usecase.StartRaidInput
+		wantErr error
+	}{
+		{"character of another user", usecase.StartRaidInput{GuildID: "guild-123", CharacterID: "char-789", UserID: "user-123", Boss: "rei-lich"}, usecase.ErrCharacterNotFound},
+		{"unknown guild", usecase.StartRaidInput{GuildID: "missing", CharacterID: "char-123", UserID: "user-123", Boss: "rei-lich"}, usecase.ErrGuildNotFound},
+		{"member is not the leader", usecase.StartRaidInput{GuildID: "guild-123", CharacterID: "char-789", UserID: "user-789", Boss: "rei-lich"}, usecase.ErrNotGuildLeader},
+		{"unknown boss", usecase.StartRaidInput{GuildID: "guild-123", CharacterID: "char-123", UserID: "user-123", Boss: "kraken"}, usecase.ErrInvalidRaidBoss},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raidRepo := newMockRaidRepository()
+			useCase := usecase.NewStartRaidUseCase(newGuildCharacterRepository(), newRaidGuildRepository(), raidRepo, &mockUnitOfWork{})
+
+			_, err := useCase.Execute(context.Background(), tt.input)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("Execute() error = %v, want %v", err, tt.wantErr)
+			}
+			if len(raidRepo.raids) != 0 {
+				t.Errorf("raids = %d, want 0", len(raidRepo.raids))
+			}
+		})
+	}
+}
+
+func TestGetGuildRaidUseCase_Execute(t *testing.T) {
+	raidRepo := newMockRaidRepository()
+	useCase := usecase.NewGetGuildRaidUseCase(newGuildCharacterRepository(), newRaidGuildRepository(), raidRepo)
+	input := usecase.GetGuildRaidInput{GuildID: "guild-123", CharacterID: "char-789", UserID: "user-789"}
+
+	if _, err := useCase.Execute(context.Background(), input); !errors.Is(err, usecase.ErrRaidNotFound) {
+		t.Errorf("Execute() without raid error = %v, want ErrRaidNotFound", err)
+	}
+
+	// A defeated boss: char-123 dealt 400 of 600 damage, char-789 200
+	boss, _ := valueobject.NewRaidBoss("hidra-do-pantano")
+	startedAt := time.Now().UTC().Add(-time.Hour)
+	endedAt := startedAt.Add(30 * time.Minute)
+	raidRepo.raids["raid-123"] = entity.ReconstituteRaid("raid-123", "guild-123", boss, 600, 0, 300, entity.RaidDefeated, startedAt, startedAt.Add(boss.Duration()), &endedAt)
+	raidRepo.hits["completion-1"] = entity.ReconstituteRaidHit("completion-1", "raid-123", "char-123", "habit-1", startedAt, 400, startedAt)
+	raidRepo.hits["completion-2"] = entity.ReconstituteRaidHit("completion-2", "raid-123", "char-789", "habit-2", startedAt, 150, startedAt)
+	raidRepo.hits["completion-3"] = entity.ReconstituteRaidHit("completion-3", "raid-123", "char-789", "habit-2", endedAt, 50, endedAt)
+
+	output, err := useCase.Execute(context.Background(), input)
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if output.Status != entity.RaidDefeated || output.HP != 0 {
+		t.Errorf("Status = %q, HP = %d, want defeated at 0 HP", output.Status, output.HP)
+	}
+
+	want := []usecase.RaidContributionOutput{
+		{CharacterID: "char-123", Damage: 400, Hits: 1, RewardXp: 200},
+		{CharacterID: "char-789", Damage: 200, Hits: 2, RewardXp: 100},
+	}
+	if len(output.Contributions) != len(want) {
+		t.Fatalf("Contributions = %+v, want %+v", output.Contributions, want)
+	}
+	for i := range want {
+		if output.Contributions[i] != want[i] {
+			t.Errorf("Contributions[%d] = %+v, want %+v", i, output.Contributions[i], want[i])
+		}
+	}
+}
+
+func TestGetGuildRaidUseCase_Execute_ReportsOverdueRaidAsExpired(t *testing.T) {
+	raidRepo := newMockRaidRepository()
+	boss, _ := valueobject.NewRaidBoss("hidra-do-pantano")
+	startedAt := time.Now().UTC().Add(-4 * 24 * time.Hour)
+	raidRepo.raids["raid-123"] = entity.ReconstituteRaid("raid-123", "guild-123", boss, 600, 250, 300, entity.RaidActive, startedAt, startedAt.Add(boss.Duration()), nil)
+
+	useCase := usecase.NewGetGuildRaidUseCase(newGuildCharacterRepository(), newRaidGuildRepository(), raidRepo)
+
+	output, err := useCase.Execute(context.Background(), usecase.GetGuildRaidInput{GuildID: "guild-123", CharacterID: "char-123", UserID: "user-123"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+	if output.Status != entity.RaidExpired || output.EndedAt == "" {
+		t.Errorf("Status = %q, EndedAt = %q, want expired", output.Status, output.EndedAt)
+	}
+}
+
+func TestGetGuildRaidUseCase_Execute_NotMember(t *testing.T) {
+	useCase := usecase.NewGetGuildRaidUseCase(newGuildCharacterRepository(), newRaidGuildRepository(), newMockRaidRepository())
+
+	_, err := useCase.Execute(context.Background(), usecase.GetGuildRaidInput{GuildID: "guild-123", CharacterID: "char-456", UserID: "user-123"})
+	if !errors.Is(err, usecase.ErrNotGuildMember) {
+		t.Errorf("Execute() error = %v, want ErrNotGuildMember", err)
+	}
+}
@@ -0,0 +1,107 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/port"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// StopFocusSessionOutput represents the output after stopping a focus session
+type StopFocusSessionOutput struct {
+	Session        FocusSessionOutput
+	XpGained       int
+	LevelsGained   int
+	Level          int
+	CurrentXp      int
+	TotalXp        int
+	XpForNextLevel int
+}
+
+// StopFocusSessionUseCase handles stopping a focus session and rewarding the tracked time
+type StopFocusSessionUseCase struct {
+	focusSessionRepo repository.FocusSessionRepository
+	characterRepo    repository.CharacterRepository
+	unitOfWork       port.UnitOfWork
+}
+
+// NewStopFocusSessionUseCase creates a new StopFocusSessionUseCase
+func NewStopFocusSessionUseCase(
+	focusSessionRepo repository.FocusSessionRepository,
+	characterRepo repository.CharacterRepository,
+	unitOfWork port.UnitOfWork,
+) *StopFocusSessionUseCase {
+	return &StopFocusSessionUseCase{
+		focusSessionRepo: focusSessionRepo,
+		characterRepo:    characterRepo,
+		unitOfWork:       unitOfWork,
+	}
+}
+
+// Execute stops an open focus session owned by the user and awards XP proportional to the tracked minutes
+func (uc *StopFocusSessionUseCase) Execute(ctx context.Context, input FocusSessionInput) (*StopFocusSessionOutput, error) {
+	// 1. Validate session exists AND belongs to the authenticated user
+	session, err := uc.focusSessionRepo.FindByIDAndUserID(ctx, input.SessionID, input.UserID)
+	if err != nil {
+		return nil, ErrFocusSessionNotFound
+	}
+	if !session.IsOpen() {
+		return nil, ErrFocusSessionEnded
+	}
+
+	// 2. Stop the session (domain rules compute the reward)
+	now := time.Now().UTC()
+	if err := session.Stop(now); err != nil {
+		return nil, fmt.Errorf("failed to stop focus session: %w", err)
+	}
+
+	source, err := valueobject.NewXpSource(valueobject.XpSourceFocusSession, session.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create xp source: %w", err)
+	}
+
+	// 3. Persist the stop and apply the reward atomically
+	var (
+		character    *entity.Character
+		levelsGained int
+	)
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		// Fails when a concurrent request already stopped the session, so XP is awarded once
+		if err := uc.focusSessionRepo.Update(ctx, session); err != nil {
+			return fmt.Errorf("failed to save focus session: %w", err)
+		}
+
+		var err error
+		character, err = uc.characterRepo.FindByIDForUpdate(ctx, session.CharacterID())
+		if err != nil {
+			return ErrCharacterNotFound
+		}
+
+		levelsGained, err = character.AddXpFrom(session.XpAwarded(), source)
+		if err != nil {
+			return fmt.Errorf("failed to add xp: %w", err)
+		}
+
+		if err := uc.characterRepo.Update(ctx, character); err != nil {
+			return fmt.Errorf("failed to save character: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &StopFocusSessionOutput{
+		Session:        mapFocusSessionEntityToOutput(session, now),
+		XpGained:       session.XpAwarded(),
+		LevelsGained:   levelsGained,
+		Level:          character.Level(),
+		CurrentXp:      character.CurrentXp(),
+		TotalXp:        character.TotalXp(),
+		XpForNextLevel: character.XpForNextLevel(),
+	}, nil
+}
@@ -0,0 +1,114 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// newStopFocusSessionFixture returns a use case stopping focus-1, started minutesAgo by user-123, and its session repository
+func newStopFocusSessionFixture(minutesAgo int, level, currentXp, totalXp int) (*usecase.StopFocusSessionUseCase, *entity.FocusSession, *entity.Character, *mockFocusSessionRepository) {
+	session, _ := entity.NewFocusSession("focus-1", "char-123", "habit-123", time.Now().UTC().Add(-time.Duration(minutesAgo)*time.Minute-30*time.Second))
+	character := entity.ReconstituteCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), level, currentXp, totalXp, 0, "user-123", time.Now())
+
+	sessionRepo := &mockFocusSessionRepository{
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.FocusSession, error) {
+			if id == "focus-1" && userID == "user-123" {
+				return session, nil
+			}
+			return nil, errors.New("focus session not found or does not belong to user")
+		},
+	}
+	charRepo := &mockCharacterRepositoryForHabits{
+		findByIDFunc: func(ctx context.Context, id string) (*entity.Character, error) {
+			return character, nil
+		},
+	}
+
+	return usecase.NewStopFocusSessionUseCase(sessionRepo, charRepo, &mockUnitOfWork{}), session, character, sessionRepo
+}
+
+func TestStopFocusSessionUseCase_Execute_AwardsXpPerMinute(t *testing.T) {
+	// Level 1 needs 100 XP; 80 + 25 minutes crosses the threshold
+	useCase, session, _, _ := newStopFocusSessionFixture(25, 1, 80, 80)
+
+	output, err := useCase.Execute(context.Background(), usecase.FocusSessionInput{
+		SessionID: "focus-1",
+		UserID:    "user-123",
+	})
+
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.XpGained != 25 || output.Session.TrackedMinutes != 25 {
+		t.Errorf("output = (xp %v, minutes %v), want (25, 25)", output.XpGained, output.Session.TrackedMinutes)
+	}
+
+	if output.LevelsGained != 1 || output.Level != 2 || output.CurrentXp != 5 {
+		t.Errorf("character = (levels %v, level %v, xp %v), want (1, 2, 5)", output.LevelsGained, output.Level, output.CurrentXp)
+	}
+
+	if session.Status() != entity.FocusSessionCompleted {
+		t.Errorf("session.Status() = %v, want %v", session.Status(), entity.FocusSessionCompleted)
+	}
+}
+
+func TestStopFocusSessionUseCase_Execute_AlreadyStopped(t *testing.T) {
+	useCase, session, character, _ := newStopFocusSessionFixture(25, 1, 0, 0)
+	session.Stop(time.Now())
+
+	_, err := useCase.Execute(context.Background(), usecase.FocusSessionInput{
+		SessionID: "focus-1",
+		UserID:    "user-123",
+	})
+
+	if !errors.Is(err, usecase.ErrFocusSessionEnded) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrFocusSessionEnded)
+	}
+
+	// XP is only awarded once
+	if character.TotalXp() != 0 {
+		t.Errorf("character.TotalXp() = %v, want %v", character.TotalXp(), 0)
+	}
+}
+
+func TestStopFocusSessionUseCase_Execute_ConcurrentStop(t *testing.T) {
+	// Another request stopped the session after it was loaded: the guarded update fails
+	useCase, _, character, sessionRepo := newStopFocusSessionFixture(25, 1, 0, 0)
+	sessionRepo.updateFunc = func(ctx context.Context, session *entity.FocusSession) error {
+		return errors.New("focus session not found or already ended")
+	}
+
+	_, err := useCase.Execute(context.Background(), usecase.FocusSessionInput{
+		SessionID: "focus-1",
+		UserID:    "user-123",
+	})
+
+	if err == nil {
+		t.Fatal("Execute() error = nil, want error")
+	}
+
+	// XP is only awarded by the request that stopped the session
+	if character.TotalXp() != 0 {
+		t.Errorf("character.TotalXp() = %v, want %v", character.TotalXp(), 0)
+	}
+}
+
+func TestStopFocusSessionUseCase_Execute_NotOwned(t *testing.T) {
+	useCase, _, _, _ := newStopFocusSessionFixture(25, 1, 0, 0)
+
+	_, err := useCase.Execute(context.Background(), usecase.FocusSessionInput{
+		SessionID: "focus-1",
+		UserID:    "other-user",
+	})
+
+	if !errors.Is(err, usecase.ErrFocusSessionNotFound) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrFocusSessionNotFound)
+	}
+}
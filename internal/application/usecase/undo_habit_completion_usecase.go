@@ -0,0 +1,271 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/port"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+var (
+	// ErrHabitCompletionNotFound is returned when a completion does not exist or does not belong to the habit
+	ErrHabitCompletionNotFound = errors.New("habit completion not found")
+
+	// ErrStreakFreezeAlreadyUsed is returned when undoing a completion whose streak freeze token was already spent
+	ErrStreakFreezeAlreadyUsed = errors.New("streak freeze earned by this completion was already used")
+
+	// ErrRaidAlreadyDefeated is returned when undoing a completion that struck a raid boss defeated since
+	// (its reward was paid out to every contributor)
+	ErrRaidAlreadyDefeated = errors.New("raid boss struck by this completion was already defeated")
+)
+
+// UndoHabitCompletionInput represents the input for undoing a habit completion
+type UndoHabitCompletionInput struct {
+	HabitID      string
+	CompletionID string
+	UserID       string // User ID from authentication token
+}
+
+// UndoHabitCompletionOutput represents the character after a completion was undone
+type UndoHabitCompletionOutput struct {
+	CompletionID     string
+	HabitID          string
+	CharacterID      string
+	XpReverted       int // XP removed from the character; negative when a slip's drain was given back
+	LevelsReverted   int // Levels lost; negative when a slip's de-leveling was given back
+	Level            int
+	CurrentXp        int
+	TotalXp          int
+	XpForNextLevel   int
+	AttributeName    string
+	AttributeValue   int
+	FreezesRevoked   int // Streak freeze tokens earned by the completion that were taken back
+	RaidDamageHealed int // Damage the completion dealt to a raid boss that is still fighting
+	EnergyDrained    int // Battle energy taken back (never below 0)
+}
+
+// UndoHabitCompletionUseCase handles removing a mistaken habit completion
+// The character ends up as if the completion never happened
+type UndoHabitCompletionUseCase struct {
+	habitRepo              repository.HabitRepository
+	habitCompletionRepo    repository.HabitCompletionRepository
+	streakFreezeRepo       repository.StreakFreezeRepository
+	characterRepo          repository.CharacterRepository
+	characterAttributeRepo repository.CharacterAttributeRepository
+	characterEnergyRepo    repository.CharacterEnergyRepository
+	raidStriker            raidStriker
+	unitOfWork             port.UnitOfWork
+}
+
+// NewUndoHabitCompletionUseCase creates a new UndoHabitCompletionUseCase
+func NewUndoHabitCompletionUseCase(
+	habitRepo repository.HabitRepository,
+	habitCompletionRepo repository.HabitCompletionRepository,
+	streakFreezeRepo repository.StreakFreezeRepository,
+	characterRepo repository.CharacterRepository,
+	characterAttributeRepo repository.CharacterAttributeRepository,
+	guildRepo repository.GuildRepository,
+	raidRepo repository.RaidRepository,
+	characterEnergyRepo repository.CharacterEnergyRepository,
+	unitOfWork port.UnitOfWork,
+) *UndoHabitCompletionUseCase {
+	return &UndoHabitCompletionUseCase{
+		habitRepo:              habitRepo,
+		habitCompletionRepo:    habitCompletionRepo,
+		streakFreezeRepo:       streakFreezeRepo,
+		characterRepo:          characterRepo,
+		characterAttributeRepo: characterAttributeRepo,
+		characterEnergyRepo:    characterEnergyRepo,
+		raidStriker: raidStriker{
+			characterRepo:          characterRepo,
+			characterAttributeRepo: characterAttributeRepo,
+			guildRepo:              guildRepo,
+			raidRepo:               raidRepo,
+		},
+		unitOfWork: unitOfWork,
+	}
+}
+
+// Execute deletes a completion and reverts the XP, levels, attribute change and streak freeze it granted
+// Damage dealt to a raid boss is healed while the raid is still active; after it expires the damage stands,
+// and a completion that struck a boss defeated since can't be undone
+// The battle energy the completion restored is taken back, as far as the character still has it
+func (uc *UndoHabitCompletionUseCase) Execute(ctx context.Context, input UndoHabitCompletionInput) (*UndoHabitCompletionOutput, error) {
+	// 1. Validate habit exists AND belongs to the authenticated user
+	habit, err := uc.habitRepo.FindByIDAndUserID(ctx, input.HabitID, input.UserID)
+	if err != nil {
+		return nil, ErrHabitNotFound
+	}
+
+	// 2. The completion must belong to that habit
+	completion, err := uc.habitCompletionRepo.FindByID(ctx, input.CompletionID)
+	if err != nil || completion.HabitID() != habit.ID() {
+		return nil, ErrHabitCompletionNotFound
+	}
+
+	// 3. Tokens earned by the completion are taken back (a spent token cannot be)
+	freezes, err := uc.streakFreezeRepo.FindByCompletionID(ctx, completion.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch streak freezes: %w", err)
+	}
+	for _, freeze := range freezes {
+		if freeze.IsUsed() {
+			return nil, ErrStreakFreezeAlreadyUsed
+		}
+	}
+
+	// 4. Revert and persist the changes atomically
+	var (
+		character      *entity.Character
+		attribute      *entity.CharacterAttribute
+		xpReverted     int
+		levelsReverted int
+	)
+	raidDamageHealed, energyDrained := 0, 0
+	err = uc.unitOfWork.Do(ctx, func(ctx context.Context) error {
+		// The raid is locked before the character (see raidStriker)
+		now := time.Now().UTC()
+		var err error
+		raidDamageHealed, err = uc.raidStriker.takeBack(ctx, completion.ID(), now)
+		if err != nil {
+			return err
+		}
+
+		// 4a. Lock the character and the attribute the completion changed, so concurrent changes add up
+		character, err = uc.characterRepo.FindByIDForUpdate(ctx, completion.CharacterID())
+		if err != nil {
+			return fmt.Errorf("failed to find character: %w", err)
+		}
+
+		attribute, err = uc.characterAttributeRepo.FindByCharacterIDAndNameForUpdate(ctx, completion.CharacterID(), completion.AttributeName())
+		if err != nil {
+			return ErrAttributeNotFound
+		}
+
+		// 4b. Revert what the completion recorded
+		xpReverted, levelsReverted, err = revertHabitCompletion(completion, character, attribute)
+		if err != nil {
+			return err
+		}
+
+		if completion.EnergyRestored() > 0 {
+			energyDrained, err = uc.drainEnergy(ctx, completion.CharacterID(), completion.EnergyRestored(), now)
+			if err != nil {
+				return err
+			}
+		}
+		for _, freeze := range freezes {
+			if err := uc.streakFreezeRepo.Delete(ctx, freeze.ID()); err != nil {
+				// A concurrent request spent the token after it was read
+				if errors.Is(err, repository.ErrStreakFreezeUsed) {
+					return ErrStreakFreezeAlreadyUsed
+				}
+				return fmt.Errorf("failed to delete streak freeze: %w", err)
+			}
+		}
+		// Fails when a concurrent undo already deleted the completion, rolling this one back
+		if err := uc.habitCompletionRepo.Delete(ctx, completion.ID()); err != nil {
+			return fmt.Errorf("failed to delete habit completion: %w", err)
+		}
+		if err := uc.characterRepo.Update(ctx, character); err != nil {
+			return fmt.Errorf("failed to save character: %w", err)
+		}
+		if err := uc.characterAttributeRepo.Update(ctx, attribute); err != nil {
+			return fmt.Errorf("failed to save attribute: %w", err)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &UndoHabitCompletionOutput{
+		CompletionID:     completion.ID(),
+		HabitID:          habit.ID(),
+		CharacterID:      character.ID(),
+		XpReverted:       xpReverted,
+		LevelsReverted:   levelsReverted,
+		Level:            character.Level(),
+		CurrentXp:        character.CurrentXp(),
+		TotalXp:          character.TotalXp(),
+		XpForNextLevel:   character.XpForNextLevel(),
+		AttributeName:    attribute.AttributeName(),
+		AttributeValue:   attribute.Value(),
+		FreezesRevoked:   len(freezes),
+		RaidDamageHealed: raidDamageHealed,
+		EnergyDrained:    energyDrained,
+	}, nil
+}
+
+// drainEnergy takes back the battle energy of a completion and saves it (inside the unit of work)
+// Returns the energy actually drained
+func (uc *UndoHabitCompletionUseCase) drainEnergy(ctx context.Context, characterID string, amount int, at time.Time) (int, error) {
+	energy, err := lockCharacterEnergy(ctx, uc.characterEnergyRepo, characterID, at)
+	if err != nil {
+		return 0, err
+	}
+
+	drained, err := energy.Drain(amount, at)
+	if err != nil {
+		return 0, fmt.Errorf("failed to drain energy: %w", err)
+	}
+	if err := uc.characterEnergyRepo.Update(ctx, energy); err != nil {
+		return 0, fmt.Errorf("failed to save character energy: %w", err)
+	}
+
+	return drained, nil
+}
+
+// revertHabitCompletion applies the inverse of a recorded completion to the character
+// Rewards are removed with LoseXpFrom (the inverse of the AddXp level loop); the losses of a slip are given back
+// Returns the XP and levels removed (negative when a slip's losses were given back)
+func revertHabitCompletion(
+	completion *entity.HabitCompletion,
+	character *entity.Character,
+	attribute *entity.CharacterAttribute,
+) (int, int, error) {
+	source, err := valueobject.NewXpSource(valueobject.XpSourceHabitCompletionUndo, completion.ID())
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to create xp source: %w", err)
+	}
+
+	xpReverted, levelsReverted := 0, 0
+
+	if completion.XpGained() >= 0 {
+		// Domain rules handle de-leveling
+		xpLost, levelsLost, err := character.LoseXpFrom(completion.XpGained(), source)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to remove xp: %w", err)
+		}
+		xpReverted, levelsReverted = xpLost, levelsLost
+	} else {
+		// Domain rules handle level-ups
+		levelsGained, err := character.AddXpFrom(-completion.XpGained(), source)
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to add xp: %w", err)
+		}
+		xpReverted, levelsReverted = completion.XpGained(), -levelsGained
+	}
+
+	if completion.AttributeGain() >= 0 {
+		// Attributes never drop below 0
+		attributeLoss := completion.AttributeGain()
+		if attribute.Value() < attributeLoss {
+			attributeLoss = attribute.Value()
+		}
+		if err := attribute.DecrementValue(attributeLoss); err != nil {
+			return 0, 0, fmt.Errorf("failed to decrement attribute: %w", err)
+		}
+	} else {
+		if err := attribute.IncrementValue(-completion.AttributeGain()); err != nil {
+			return 0, 0, fmt.Errorf("failed to increment attribute: %w", err)
+		}
+	}
+
+	return xpReverted, levelsReverted, nil
+}
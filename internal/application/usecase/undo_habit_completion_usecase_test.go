@@ -0,0 +1,326 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+)
+
+// completeAndTrack completes habit-123 through the fixture and makes its completions findable and deletable
+// Returns the ID of the recorded completion and the IDs deleted afterwards
+func completeAndTrack(t *testing.T, f *habitRewardFixture) (string, *[]string) {
+	t.Helper()
+
+	complete := usecase.NewCompleteHabitUseCase(f.habitRepo, f.compRepo, f.freezeRepo, f.charRepo, f.attrRepo, &mockUserPreferencesRepository{}, f.guildRepo, f.raidRepo, f.energyRepo, &mockUnitOfWork{})
+	output, err := complete.Execute(context.Background(), usecase.CompleteHabitInput{HabitID: "habit-123", UserID: "user-123"})
+	if err != nil {
+		t.Fatalf("CompleteHabit Execute() error = %v, want nil", err)
+	}
+
+	f.compRepo.findByIDFunc = func(ctx context.Context, id string) (*entity.HabitCompletion, error) {
+		for _, completion := range f.completions {
+			if completion.ID() == id {
+				return completion, nil
+			}
+		}
+		return nil, errors.New("habit completion not found")
+	}
+
+	deleted := &[]string{}
+	f.compRepo.deleteFunc = func(ctx context.Context, id string) error {
+		*deleted = append(*deleted, id)
+		return nil
+	}
+
+	return output.CompletionID, deleted
+}
+
+func newUndoHabitCompletionUseCase(f *habitRewardFixture) *usecase.UndoHabitCompletionUseCase {
+	return usecase.NewUndoHabitCompletionUseCase(f.habitRepo, f.compRepo, f.freezeRepo, f.charRepo, f.attrRepo, f.guildRepo, f.raidRepo, f.energyRepo, &mockUnitOfWork{})
+}
+
+func TestUndoHabitCompletionUseCase_Execute_RevertsLevelUp(t *testing.T) {
+	// Level 1 with 70 XP; 40 (hard) levels up to 2, undoing goes back to level 1 with 70 XP
+	f := newHabitRewardFixture("hard", 1, 70, 70)
+	completionID, deleted := completeAndTrack(t, f)
+
+	if f.character.Level() != 2 {
+		t.Fatalf("level after completion = %v, want %v", f.character.Level(), 2)
+	}
+
+	output, err := newUndoHabitCompletionUseCase(f).Execute(context.Background(), usecase.UndoHabitCompletionInput{
+		HabitID:      "habit-123",
+		CompletionID: completionID,
+		UserID:       "user-123",
+	})
+
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.XpReverted != 40 || output.LevelsReverted != 1 {
+		t.Errorf("reverted = (xp %v, levels %v), want (40, 1)", output.XpReverted, output.LevelsReverted)
+	}
+
+	if output.Level != 1 || output.CurrentXp != 70 || output.TotalXp != 70 {
+		t.Errorf("character = (level %v, xp %v, total %v), want (1, 70, 70)", output.Level, output.CurrentXp, output.TotalXp)
+	}
+
+	if output.AttributeValue != 5 {
+		t.Errorf("output.AttributeValue = %v, want %v", output.AttributeValue, 5)
+	}
+
+	if len(*deleted) != 1 || (*deleted)[0] != completionID {
+		t.Errorf("deleted completions = %v, want [%v]", *deleted, completionID)
+	}
+
+	// Completing and undoing both lock the character inside their unit of work
+	if len(f.charRepo.locked) != 2 {
+		t.Errorf("locked characters = %v, want 2 locks", f.charRepo.locked)
+	}
+}
+
+func TestUndoHabitCompletionUseCase_Execute_GivesBackSlipLosses(t *testing.T) {
+	// Level 3 with 10 XP; the slip drops to level 2 with 253 XP, undoing restores level 3 with 10 XP
+	f := newHabitRewardFixture("hard", 3, 10, 393)
+	f.habit.MakeNegative(true)
+	completionID, _ := completeAndTrack(t, f)
+
+	output, err := newUndoHabitCompletionUseCase(f).Execute(context.Background(), usecase.UndoHabitCompletionInput{
+		HabitID:      "habit-123",
+		CompletionID: completionID,
+		UserID:       "user-123",
+	})
+
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.XpReverted != -40 || output.LevelsReverted != -1 {
+		t.Errorf("reverted = (xp %v, levels %v), want (-40, -1)", output.XpReverted, output.LevelsReverted)
+	}
+
+	if output.Level != 3 || output.CurrentXp != 10 || output.TotalXp != 393 {
+		t.Errorf("character = (level %v, xp %v, total %v), want (3, 10, 393)", output.Level, output.CurrentXp, output.TotalXp)
+	}
+
+	if output.AttributeValue != 5 {
+		t.Errorf("output.AttributeValue = %v, want %v", output.AttributeValue, 5)
+	}
+}
+
+func TestUndoHabitCompletionUseCase_Execute_RevokesEarnedFreeze(t *testing.T) {
+	f := newHabitRewardFixture("medium", 1, 0, 0)
+	completionID, _ := completeAndTrack(t, f)
+
+	earned, _ := entity.NewStreakFreeze("freeze-1", "char-123", completionID)
+	f.freezeRepo.findByCompletionFunc = func(ctx context.Context, id string) ([]*entity.StreakFreeze, error) {
+		if id == completionID {
+			return []*entity.StreakFreeze{earned}, nil
+		}
+		return []*entity.StreakFreeze{}, nil
+	}
+
+	var revoked []string
+	f.freezeRepo.deleteFunc = func(ctx context.Context, id string) error {
+		revoked = append(revoked, id)
+		return nil
+	}
+
+	output, err := newUndoHabitCompletionUseCase(f).Execute(context.Background(), usecase.UndoHabitCompletionInput{
+		HabitID:      "habit-123",
+		CompletionID: completionID,
+		UserID:       "user-123",
+	})
+
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.FreezesRevoked != 1 || len(revoked) != 1 || revoked[0] != "freeze-1" {
+		t.Errorf("freezes revoked = (%v, %v deleted), want (1, [freeze-1])", output.FreezesRevoked, revoked)
+	}
+}
+
+func TestUndoHabitCompletionUseCase_Execute_SpentFreeze(t *testing.T) {
+	f := newHabitRewardFixture("medium", 1, 0, 0)
+	completionID, deleted := completeAndTrack(t, f)
+
+	spent, _ := entity.NewStreakFreeze("freeze-1", "char-123", completionID)
+	spent.Use("habit-123", time.Now().UTC().AddDate(0, 0, -3))
+	f.freezeRepo.findByCompletionFunc = func(ctx context.Context, id string) ([]*entity.StreakFreeze, error) {
+		return []*entity.StreakFreeze{spent}, nil
+	}
+
+	_, err := newUndoHabitCompletionUseCase(f).Execute(context.Background(), usecase.UndoHabitCompletionInput{
+		HabitID:      "habit-123",
+		CompletionID: completionID,
+		UserID:       "user-123",
+	})
+
+	if !errors.Is(err, usecase.ErrStreakFreezeAlreadyUsed) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrStreakFreezeAlreadyUsed)
+	}
+
+	if len(*deleted) != 0 || f.character.CurrentXp() != 20 {
+		t.Errorf("completion undone despite the error (deleted %v, xp %v)", *deleted, f.character.CurrentXp())
+	}
+}
+
+func TestUndoHabitCompletionUseCase_Execute_FreezeSpentConcurrently(t *testing.T) {
+	f := newHabitRewardFixture("medium", 1, 0, 0)
+	completionID, deleted := completeAndTrack(t, f)
+
+	// Unused when read, but spent by another request before the undo deletes it
+	earned, _ := entity.NewStreakFreeze("freeze-1", "char-123", completionID)
+	f.freezeRepo.findByCompletionFunc = func(ctx context.Context, id string) ([]*entity.StreakFreeze, error) {
+		return []*entity.StreakFreeze{earned}, nil
+	}
+	f.freezeRepo.deleteFunc = func(ctx context.Context, id string) error {
+		return repository.ErrStreakFreezeUsed
+	}
+
+	_, err := newUndoHabitCompletionUseCase(f).Execute(context.Background(), usecase.UndoHabitCompletionInput{
+		HabitID:      "habit-123",
+		CompletionID: completionID,
+		UserID:       "user-123",
+	})
+
+	if !errors.Is(err, usecase.ErrStreakFreezeAlreadyUsed) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrStreakFreezeAlreadyUsed)
+	}
+
+	if len(*deleted) != 0 {
+		t.Errorf("deleted completions = %v, want none", *deleted)
+	}
+}
+
+func TestUndoHabitCompletionUseCase_Execute_NotFound(t *testing.T) {
+	f := newHabitRewardFixture("medium", 1, 0, 0)
+	completionID, _ := completeAndTrack(t, f)
+
+	other := entity.ReconstituteHabitCompletion("comp-other", "habit-456", "char-123", 20, 0, "Força", 1, 0, time.Now())
+	f.completions = append(f.completions, other)
+
+	tests := []struct {
+		name  string
+		input usecase.UndoHabitCompletionInput
+		want  error
+	}{
+		{"habit of another user", usecase.UndoHabitCompletionInput{HabitID: "habit-123", CompletionID: completionID, UserID: "user-456"}, usecase.ErrHabitNotFound},
+		{"unknown completion", usecase.UndoHabitCompletionInput{HabitID: "habit-123", CompletionID: "missing", UserID: "user-123"}, usecase.ErrHabitCompletionNotFound},
+		{"completion of another habit", usecase.UndoHabitCompletionInput{HabitID: "habit-123", CompletionID: "comp-other", UserID: "user-123"}, usecase.ErrHabitCompletionNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newUndoHabitCompletionUseCase(f).Execute(context.Background(), tt.input)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("Execute() error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestUndoHabitCompletionUseCase_Execute_HealsRaidBoss(t *testing.T) {
+	f := newHabitRewardFixture("easy", 1, 0, 0)
+	raid, _ := f.joinGuildRaid(600)
+	completionID, _ := completeAndTrack(t, f)
+
+	output, err := newUndoHabitCompletionUseCase(f).Execute(context.Background(), usecase.UndoHabitCompletionInput{
+		HabitID:      "habit-123",
+		CompletionID: completionID,
+		UserID:       "user-123",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.RaidDamageHealed != 13 || raid.HP() != 600 {
+		t.Errorf("healed = %d, raid HP = %d, want 13 and 600", output.RaidDamageHealed, raid.HP())
+	}
+	if _, ok := f.raidRepo.hits[completionID]; ok {
+		t.Error("raid hit of the undone completion was not deleted")
+	}
+}
+
+func TestUndoHabitCompletionUseCase_Execute_DefeatedRaid(t *testing.T) {
+	// The completion dealt the killing blow and its reward was paid out: it can't be undone
+	f := newHabitRewardFixture("easy", 1, 0, 0)
+	raid, _ := f.joinGuildRaid(10)
+	completionID, deleted := completeAndTrack(t, f)
+	totalXp := f.character.TotalXp()
+
+	_, err := newUndoHabitCompletionUseCase(f).Execute(context.Background(), usecase.UndoHabitCompletionInput{
+		HabitID:      "habit-123",
+		CompletionID: completionID,
+		UserID:       "user-123",
+	})
+	if !errors.Is(err, usecase.ErrRaidAlreadyDefeated) {
+		t.Fatalf("Execute() error = %v, want %v", err, usecase.ErrRaidAlreadyDefeated)
+	}
+
+	if len(*deleted) != 0 {
+		t.Errorf("deleted completions = %v, want none", *deleted)
+	}
+	if _, ok := f.raidRepo.hits[completionID]; !ok {
+		t.Error("raid hit of the completion was deleted")
+	}
+	if raid.Status() != entity.RaidDefeated || raid.HP() != 0 || f.character.TotalXp() != totalXp {
+		t.Errorf("raid = (%q, %d HP), total xp = %d, want the boss to stay defeated and the xp kept", raid.Status(), raid.HP(), f.character.TotalXp())
+	}
+}
+
+func TestUndoHabitCompletionUseCase_Execute_DrainsEnergy(t *testing.T) {
+	f := newHabitRewardFixture("easy", 1, 0, 0)
+	f.energyRepo.setEnergy("char-123", 50, time.Now().UTC())
+	completionID, _ := completeAndTrack(t, f)
+
+	output, err := newUndoHabitCompletionUseCase(f).Execute(context.Background(), usecase.UndoHabitCompletionInput{
+		HabitID:      "habit-123",
+		CompletionID: completionID,
+		UserID:       "user-123",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.EnergyDrained != entity.HabitCompletionEnergy {
+		t.Errorf("output.EnergyDrained = %d, want %d", output.EnergyDrained, entity.HabitCompletionEnergy)
+	}
+	if got := f.energyRepo.energyAt("char-123", time.Now().UTC()); got != 50 {
+		t.Errorf("energy = %d, want 50", got)
+	}
+}
+
+func TestUndoHabitCompletionUseCase_Execute_DrainsOnlyWhatWasRestored(t *testing.T) {
+	// The pool was 2 points short of full: the completion restored only those 2
+	f := newHabitRewardFixture("easy", 1, 0, 0)
+	f.energyRepo.setEnergy("char-123", entity.MaxEnergy-2, time.Now().UTC())
+	completionID, _ := completeAndTrack(t, f)
+
+	if got := f.completions[0].EnergyRestored(); got != 2 {
+		t.Fatalf("recorded energy restored = %d, want 2", got)
+	}
+
+	output, err := newUndoHabitCompletionUseCase(f).Execute(context.Background(), usecase.UndoHabitCompletionInput{
+		HabitID:      "habit-123",
+		CompletionID: completionID,
+		UserID:       "user-123",
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.EnergyDrained != 2 {
+		t.Errorf("output.EnergyDrained = %d, want 2", output.EnergyDrained)
+	}
+	if got := f.energyRepo.energyAt("char-123", time.Now().UTC()); got != entity.MaxEnergy-2 {
+		t.Errorf("energy = %d, want %d", got, entity.MaxEnergy-2)
+	}
+}
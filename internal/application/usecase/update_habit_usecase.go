@@ -0,0 +1,110 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// UpdateHabitInput represents the input for updating a habit (full replacement)
+type UpdateHabitInput struct {
+	HabitID       string
+	UserID        string // User ID from authentication token
+	Title         string
+	Description   string
+	AttributeName string
+	Difficulty    string
+	Recurrence    *RecurrenceInput // Optional, keeps the current schedule when nil
+	Negative      bool
+	DrainsXp      bool // Negative habits only
+	Active        bool
+}
+
+// UpdateHabitUseCase handles updating existing habits
+type UpdateHabitUseCase struct {
+	habitRepo              repository.HabitRepository
+	characterAttributeRepo repository.CharacterAttributeRepository
+}
+
+// NewUpdateHabitUseCase creates a new UpdateHabitUseCase
+func NewUpdateHabitUseCase(
+	habitRepo repository.HabitRepository,
+	characterAttributeRepo repository.CharacterAttributeRepository,
+) *UpdateHabitUseCase {
+	return &UpdateHabitUseCase{
+		habitRepo:              habitRepo,
+		characterAttributeRepo: characterAttributeRepo,
+	}
+}
+
+// Execute updates a habit owned by the user
+func (uc *UpdateHabitUseCase) Execute(ctx context.Context, input UpdateHabitInput) (*HabitOutput, error) {
+	// Validate habit exists AND belongs to the authenticated user
+	habit, err := uc.habitRepo.FindByIDAndUserID(ctx, input.HabitID, input.UserID)
+	if err != nil {
+		return nil, ErrHabitNotFound
+	}
+
+	// Validate the linked attribute exists for the habit's character
+	if input.AttributeName != habit.AttributeName() {
+		exists, err := uc.characterAttributeRepo.ExistsByCharacterIDAndName(ctx, habit.CharacterID(), input.AttributeName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check if attribute exists: %w", err)
+		}
+		if !exists {
+			return nil, ErrAttributeNotFound
+		}
+	}
+
+	// Validate difficulty
+	difficulty, err := valueobject.NewDifficulty(input.Difficulty)
+	if err != nil {
+		return nil, fmt.Errorf("invalid difficulty: %w", err)
+	}
+
+	// Validate recurrence (keeps the current schedule when not informed)
+	recurrence := habit.Recurrence()
+	if input.Recurrence != nil {
+		recurrence, err = buildRecurrence(*input.Recurrence)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Apply changes (with domain validation)
+	if err := habit.UpdateTitle(input.Title); err != nil {
+		return nil, fmt.Errorf("failed to update habit: %w", err)
+	}
+	if err := habit.UpdateDescription(input.Description); err != nil {
+		return nil, fmt.Errorf("failed to update habit: %w", err)
+	}
+	if err := habit.LinkAttribute(input.AttributeName); err != nil {
+		return nil, fmt.Errorf("failed to update habit: %w", err)
+	}
+	if err := habit.ChangeDifficulty(difficulty); err != nil {
+		return nil, fmt.Errorf("failed to update habit: %w", err)
+	}
+	if err := habit.ChangeRecurrence(recurrence); err != nil {
+		return nil, fmt.Errorf("failed to update habit: %w", err)
+	}
+	if input.Negative {
+		habit.MakeNegative(input.DrainsXp)
+	} else {
+		habit.MakePositive()
+	}
+	if input.Active {
+		habit.Activate()
+	} else {
+		habit.Deactivate()
+	}
+
+	// Persist changes
+	if err := uc.habitRepo.Update(ctx, habit); err != nil {
+		return nil, fmt.Errorf("failed to save habit: %w", err)
+	}
+
+	output := mapHabitEntityToOutput(habit)
+	return &output, nil
+}
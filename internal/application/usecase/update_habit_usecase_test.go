@@ -0,0 +1,115 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+func TestUpdateHabitUseCase_Execute_Success(t *testing.T) {
+	habit := newTestHabitEntity()
+
+	habitRepo := &mockHabitRepository{
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Habit, error) {
+			if id == "habit-123" && userID == "user-123" {
+				return habit, nil
+			}
+			return nil, errors.New("habit not found or does not belong to user")
+		},
+	}
+
+	attrRepo := &mockCharacterAttributeRepository{
+		existsByCharacterIDAndNameFunc: func(ctx context.Context, characterID string, attributeName string) (bool, error) {
+			return attributeName == "Destreza", nil
+		},
+	}
+
+	useCase := usecase.NewUpdateHabitUseCase(habitRepo, attrRepo)
+
+	output, err := useCase.Execute(context.Background(), usecase.UpdateHabitInput{
+		HabitID:       "habit-123",
+		UserID:        "user-123",
+		Title:         "Burpees",
+		Description:   "",
+		AttributeName: "Destreza",
+		Difficulty:    "hard",
+		Recurrence:    &usecase.RecurrenceInput{Type: "times_per_period", Times: 3, Period: "week"},
+		Active:        false,
+	})
+
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.Title != "Burpees" {
+		t.Errorf("output.Title = %v, want %v", output.Title, "Burpees")
+	}
+
+	if output.AttributeName != "Destreza" {
+		t.Errorf("output.AttributeName = %v, want %v", output.AttributeName, "Destreza")
+	}
+
+	if output.Difficulty != "hard" {
+		t.Errorf("output.Difficulty = %v, want %v", output.Difficulty, "hard")
+	}
+
+	if output.Recurrence.Rule != "FREQ=WEEKLY;TIMES=3" {
+		t.Errorf("output.Recurrence.Rule = %v, want %v", output.Recurrence.Rule, "FREQ=WEEKLY;TIMES=3")
+	}
+
+	if output.Active {
+		t.Error("output.Active = true, want false")
+	}
+}
+
+func TestUpdateHabitUseCase_Execute_NotOwned(t *testing.T) {
+	useCase := usecase.NewUpdateHabitUseCase(&mockHabitRepository{}, &mockCharacterAttributeRepository{})
+
+	_, err := useCase.Execute(context.Background(), usecase.UpdateHabitInput{
+		HabitID:       "habit-123",
+		UserID:        "other-user",
+		Title:         "Burpees",
+		AttributeName: "Força",
+		Difficulty:    "easy",
+	})
+
+	if !errors.Is(err, usecase.ErrHabitNotFound) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrHabitNotFound)
+	}
+}
+
+func TestUpdateHabitUseCase_Execute_UnknownAttribute(t *testing.T) {
+	habitRepo := &mockHabitRepository{
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Habit, error) {
+			return newTestHabitEntity(), nil
+		},
+		updateFunc: func(ctx context.Context, habit *entity.Habit) error {
+			t.Error("Update() should not be called for an unknown attribute")
+			return nil
+		},
+	}
+
+	attrRepo := &mockCharacterAttributeRepository{
+		existsByCharacterIDAndNameFunc: func(ctx context.Context, characterID string, attributeName string) (bool, error) {
+			return false, nil
+		},
+	}
+
+	useCase := usecase.NewUpdateHabitUseCase(habitRepo, attrRepo)
+
+	_, err := useCase.Execute(context.Background(), usecase.UpdateHabitInput{
+		HabitID:       "habit-123",
+		UserID:        "user-123",
+		Title:         "Push-ups",
+		AttributeName: "Sorte",
+		Difficulty:    "easy",
+		Active:        true,
+	})
+
+	if !errors.Is(err, usecase.ErrAttributeNotFound) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrAttributeNotFound)
+	}
+}
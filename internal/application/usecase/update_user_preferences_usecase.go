@@ -0,0 +1,59 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// UpdateUserPreferencesInput represents the input for changing a user's preferences
+type UpdateUserPreferencesInput struct {
+	UserID          string // User ID from authentication token
+	Timezone        string // IANA time zone name (e.g. Europe/Lisbon)
+	WeekStart       string // Weekday name (monday, sunday, ...)
+	DayRolloverHour int    // Local hour at which a new day starts
+}
+
+// UpdateUserPreferencesUseCase handles changing a user's preferences
+type UpdateUserPreferencesUseCase struct {
+	preferencesRepo repository.UserPreferencesRepository
+}
+
+// NewUpdateUserPreferencesUseCase creates a new UpdateUserPreferencesUseCase
+func NewUpdateUserPreferencesUseCase(preferencesRepo repository.UserPreferencesRepository) *UpdateUserPreferencesUseCase {
+	return &UpdateUserPreferencesUseCase{
+		preferencesRepo: preferencesRepo,
+	}
+}
+
+// Execute validates and saves the user's preferences
+func (uc *UpdateUserPreferencesUseCase) Execute(ctx context.Context, input UpdateUserPreferencesInput) (*UserPreferencesOutput, error) {
+	// 1. Validate the new clock
+	weekStart, err := valueobject.ParseWeekday(input.WeekStart)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPreferences, err)
+	}
+
+	clock, err := valueobject.NewDayClock(input.Timezone, input.DayRolloverHour, weekStart)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPreferences, err)
+	}
+
+	// 2. Apply it to the current preferences
+	preferences, err := findUserPreferences(ctx, uc.preferencesRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+	preferences.ChangeClock(clock)
+
+	// 3. Persist
+	if err := uc.preferencesRepo.Save(ctx, preferences); err != nil {
+		return nil, fmt.Errorf("failed to save user preferences: %w", err)
+	}
+
+	output := mapUserPreferencesEntityToOutput(preferences, time.Now().UTC())
+	return &output, nil
+}
@@ -0,0 +1,112 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Mock UserPreferencesRepository (users without saved preferences get the defaults)
+type mockUserPreferencesRepository struct {
+	preferences map[string]*entity.UserPreferences
+}
+
+func (m *mockUserPreferencesRepository) FindByUserID(ctx context.Context, userID string) (*entity.UserPreferences, error) {
+	return m.preferences[userID], nil
+}
+
+func (m *mockUserPreferencesRepository) Save(ctx context.Context, preferences *entity.UserPreferences) error {
+	if m.preferences == nil {
+		m.preferences = map[string]*entity.UserPreferences{}
+	}
+	m.preferences[preferences.UserID()] = preferences
+	return nil
+}
+
+// save stores preferences for a user (weeks start on Monday)
+func (m *mockUserPreferencesRepository) save(t *testing.T, userID string, timezone string, rolloverHour int) {
+	t.Helper()
+
+	clock, err := valueobject.NewDayClock(timezone, rolloverHour, time.Monday)
+	if err != nil {
+		t.Fatalf("NewDayClock() error = %v, want nil", err)
+	}
+	preferences, _ := entity.NewUserPreferences(userID, clock)
+	m.Save(context.Background(), preferences)
+}
+
+func TestGetUserPreferencesUseCase_Defaults(t *testing.T) {
+	uc := usecase.NewGetUserPreferencesUseCase(&mockUserPreferencesRepository{})
+
+	output, err := uc.Execute(context.Background(), usecase.GetUserPreferencesInput{UserID: "user-123"})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.Timezone != "UTC" || output.WeekStart != "monday" || output.DayRolloverHour != 0 {
+		t.Errorf("output = (%v, %v, %v), want (UTC, monday, 0)", output.Timezone, output.WeekStart, output.DayRolloverHour)
+	}
+	if output.UpdatedAt != "" {
+		t.Errorf("UpdatedAt = %v, want empty", output.UpdatedAt)
+	}
+	if want := time.Now().UTC().Format("2006-01-02"); output.Today != want {
+		t.Errorf("Today = %v, want %v", output.Today, want)
+	}
+}
+
+func TestUpdateUserPreferencesUseCase_Execute(t *testing.T) {
+	repo := &mockUserPreferencesRepository{}
+	uc := usecase.NewUpdateUserPreferencesUseCase(repo)
+
+	output, err := uc.Execute(context.Background(), usecase.UpdateUserPreferencesInput{
+		UserID:          "user-123",
+		Timezone:        "America/Sao_Paulo",
+		WeekStart:       "Sunday",
+		DayRolloverHour: 4,
+	})
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if output.Timezone != "America/Sao_Paulo" || output.WeekStart != "sunday" || output.DayRolloverHour != 4 {
+		t.Errorf("output = (%v, %v, %v), want (America/Sao_Paulo, sunday, 4)", output.Timezone, output.WeekStart, output.DayRolloverHour)
+	}
+
+	saved := repo.preferences["user-123"]
+	if saved == nil {
+		t.Fatal("preferences were not saved")
+	}
+	if clock := saved.Clock(); clock.Timezone() != "America/Sao_Paulo" || clock.WeekStart() != time.Sunday || clock.RolloverHour() != 4 {
+		t.Errorf("saved clock = (%v, %v, %v), want (America/Sao_Paulo, Sunday, 4)", clock.Timezone(), clock.WeekStart(), clock.RolloverHour())
+	}
+}
+
+func TestUpdateUserPreferencesUseCase_Invalid(t *testing.T) {
+	tests := []struct {
+		name  string
+		input usecase.UpdateUserPreferencesInput
+	}{
+		{"unknown timezone", usecase.UpdateUserPreferencesInput{UserID: "user-123", Timezone: "Lisbon", WeekStart: "monday"}},
+		{"unknown weekday", usecase.UpdateUserPreferencesInput{UserID: "user-123", Timezone: "Europe/Lisbon", WeekStart: "someday"}},
+		{"rollover out of range", usecase.UpdateUserPreferencesInput{UserID: "user-123", Timezone: "Europe/Lisbon", WeekStart: "monday", DayRolloverHour: 18}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := &mockUserPreferencesRepository{}
+
+			_, err := usecase.NewUpdateUserPreferencesUseCase(repo).Execute(context.Background(), tt.input)
+			if !errors.Is(err, usecase.ErrInvalidPreferences) {
+				t.Errorf("Execute() error = %v, want %v", err, usecase.ErrInvalidPreferences)
+			}
+			if len(repo.preferences) != 0 {
+				t.Error("invalid preferences were saved")
+			}
+		})
+	}
+}
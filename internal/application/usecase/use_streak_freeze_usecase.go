@@ -0,0 +1,150 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+var (
+	// ErrNoStreakFreezes is returned when the character has no streak freeze tokens left
+	ErrNoStreakFreezes = errors.New("no streak freezes available")
+
+	// ErrStreakFreezeNotNeeded is returned when the day doesn't need protection
+	// (not a past scheduled day, already completed or already frozen)
+	ErrStreakFreezeNotNeeded = errors.New("streak freeze not needed for this day")
+)
+
+// UseStreakFreezeInput represents the input for spending a streak freeze token
+type UseStreakFreezeInput struct {
+	HabitID string
+	UserID  string    // User ID from authentication token
+	Date    time.Time // Missed calendar day to protect
+}
+
+// UseStreakFreezeOutput represents the output after spending a streak freeze token
+type UseStreakFreezeOutput struct {
+	FreezeID         string
+	HabitID          string
+	FrozenDate       string // YYYY-MM-DD
+	RemainingFreezes int
+	CurrentStreak    int
+	LongestStreak    int
+}
+
+// UseStreakFreezeUseCase handles spending a streak freeze token to protect a missed day
+type UseStreakFreezeUseCase struct {
+	habitRepo           repository.HabitRepository
+	habitCompletionRepo repository.HabitCompletionRepository
+	streakFreezeRepo    repository.StreakFreezeRepository
+	preferencesRepo     repository.UserPreferencesRepository
+}
+
+// NewUseStreakFreezeUseCase creates a new UseStreakFreezeUseCase
+func NewUseStreakFreezeUseCase(
+	habitRepo repository.HabitRepository,
+	habitCompletionRepo repository.HabitCompletionRepository,
+	streakFreezeRepo repository.StreakFreezeRepository,
+	preferencesRepo repository.UserPreferencesRepository,
+) *UseStreakFreezeUseCase {
+	return &UseStreakFreezeUseCase{
+		habitRepo:           habitRepo,
+		habitCompletionRepo: habitCompletionRepo,
+		streakFreezeRepo:    streakFreezeRepo,
+		preferencesRepo:     preferencesRepo,
+	}
+}
+
+// Execute spends the oldest available token of the habit's character on a missed day
+func (uc *UseStreakFreezeUseCase) Execute(ctx context.Context, input UseStreakFreezeInput) (*UseStreakFreezeOutput, error) {
+	// 1. Validate habit exists AND belongs to the authenticated user
+	habit, err := uc.habitRepo.FindByIDAndUserID(ctx, input.HabitID, input.UserID)
+	if err != nil {
+		return nil, ErrHabitNotFound
+	}
+	if !habit.Active() {
+		return nil, ErrHabitInactive
+	}
+
+	// Negative habits have no schedule to protect: their streak only breaks on slips
+	if habit.IsNegative() {
+		return nil, ErrStreakFreezeNotNeeded
+	}
+
+	// 2. Only past days since the habit was created can be frozen (in the user's calendar)
+	clock, err := loadDayClock(ctx, uc.preferencesRepo, input.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	today := clock.Today(now)
+	day := clock.Date(input.Date)
+	createdDay := habit.CreatedDay(clock)
+
+	if !day.Before(today) || day.Before(createdDay) {
+		return nil, ErrStreakFreezeNotNeeded
+	}
+
+	// Day-based schedules can only freeze days the habit was due
+	recurrence := habit.Recurrence()
+	if recurrence.Type() != valueobject.RecurrenceTimesPerPeriod && !recurrence.IsDueOn(day, createdDay, 0) {
+		return nil, ErrStreakFreezeNotNeeded
+	}
+
+	// 3. The day must have been missed and not frozen yet
+	completions, err := uc.habitCompletionRepo.FindByHabitID(ctx, habit.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch habit completions: %w", err)
+	}
+	for _, completion := range completions {
+		if clock.Day(completion.CompletedAt()).Equal(day) {
+			return nil, ErrStreakFreezeNotNeeded
+		}
+	}
+
+	freezes, err := uc.streakFreezeRepo.FindByHabitID(ctx, habit.ID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch streak freezes: %w", err)
+	}
+	for _, freeze := range freezes {
+		if freeze.FrozenDate().Format("2006-01-02") == day.Format("2006-01-02") {
+			return nil, ErrStreakFreezeNotNeeded
+		}
+	}
+
+	// 4. Spend the oldest available token
+	available, err := uc.streakFreezeRepo.FindAvailableByCharacterID(ctx, habit.CharacterID())
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch streak freezes: %w", err)
+	}
+	if len(available) == 0 {
+		return nil, ErrNoStreakFreezes
+	}
+
+	freeze := available[0]
+	if err := freeze.Use(habit.ID(), day); err != nil {
+		return nil, fmt.Errorf("failed to use streak freeze: %w", err)
+	}
+
+	// Fails when a concurrent request already spent the same token
+	if err := uc.streakFreezeRepo.Update(ctx, freeze); err != nil {
+		return nil, fmt.Errorf("failed to save streak freeze: %w", err)
+	}
+
+	// 5. Recalculate the streak with the protected day
+	streak := habit.CalculateStreak(completions, append(freezes, freeze), now, clock)
+
+	return &UseStreakFreezeOutput{
+		FreezeID:         freeze.ID(),
+		HabitID:          habit.ID(),
+		FrozenDate:       freeze.FrozenDate().Format("2006-01-02"),
+		RemainingFreezes: len(available) - 1,
+		CurrentStreak:    streak.Current(),
+		LongestStreak:    streak.Longest(),
+	}, nil
+}
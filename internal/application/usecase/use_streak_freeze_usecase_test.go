@@ -0,0 +1,193 @@
+package usecase_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Mock StreakFreezeRepository
+type mockStreakFreezeRepository struct {
+	createFunc           func(ctx context.Context, freeze *entity.StreakFreeze) error
+	findAvailableFunc    func(ctx context.Context, characterID string) ([]*entity.StreakFreeze, error)
+	findByHabitIDFunc    func(ctx context.Context, habitID string) ([]*entity.StreakFreeze, error)
+	findUsedByUserIDFunc func(ctx context.Context, userID string) ([]*entity.StreakFreeze, error)
+	findByCompletionFunc func(ctx context.Context, completionID string) ([]*entity.StreakFreeze, error)
+	updateFunc           func(ctx context.Context, freeze *entity.StreakFreeze) error
+	deleteFunc           func(ctx context.Context, id string) error
+}
+
+func (m *mockStreakFreezeRepository) Create(ctx context.Context, freeze *entity.StreakFreeze) error {
+	if m.createFunc != nil {
+		return m.createFunc(ctx, freeze)
+	}
+	return nil
+}
+
+func (m *mockStreakFreezeRepository) FindAvailableByCharacterID(ctx context.Context, characterID string) ([]*entity.StreakFreeze, error) {
+	if m.findAvailableFunc != nil {
+		return m.findAvailableFunc(ctx, characterID)
+	}
+	return []*entity.StreakFreeze{}, nil
+}
+
+func (m *mockStreakFreezeRepository) FindByHabitID(ctx context.Context, habitID string) ([]*entity.StreakFreeze, error) {
+	if m.findByHabitIDFunc != nil {
+		return m.findByHabitIDFunc(ctx, habitID)
+	}
+	return []*entity.StreakFreeze{}, nil
+}
+
+func (m *mockStreakFreezeRepository) FindUsedByUserID(ctx context.Context, userID string) ([]*entity.StreakFreeze, error) {
+	if m.findUsedByUserIDFunc != nil {
+		return m.findUsedByUserIDFunc(ctx, userID)
+	}
+	return []*entity.StreakFreeze{}, nil
+}
+
+func (m *mockStreakFreezeRepository) FindByCompletionID(ctx context.Context, completionID string) ([]*entity.StreakFreeze, error) {
+	if m.findByCompletionFunc != nil {
+		return m.findByCompletionFunc(ctx, completionID)
+	}
+	return []*entity.StreakFreeze{}, nil
+}
+
+func (m *mockStreakFreezeRepository) Update(ctx context.Context, freeze *entity.StreakFreeze) error {
+	if m.updateFunc != nil {
+		return m.updateFunc(ctx, freeze)
+	}
+	return nil
+}
+
+func (m *mockStreakFreezeRepository) Delete(ctx context.Context, id string) error {
+	if m.deleteFunc != nil {
+		return m.deleteFunc(ctx, id)
+	}
+	return nil
+}
+
+// newStreakFreezeFixture creates a daily habit (created 10 days ago) completed every day except 2 days ago
+func newStreakFreezeFixture(availableFreezes int) (*usecase.UseStreakFreezeUseCase, *mockStreakFreezeRepository) {
+	now := time.Now().UTC()
+	difficulty, _ := valueobject.NewDifficulty("easy")
+	habit := entity.ReconstituteHabit("habit-123", "Read", "", "char-123", "Inteligência", difficulty, valueobject.NewDailyRecurrence(), false, false, true, now.AddDate(0, 0, -10), now.AddDate(0, 0, -10))
+
+	habitRepo := &mockHabitRepository{
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Habit, error) {
+			if id == "habit-123" && userID == "user-123" {
+				return habit, nil
+			}
+			return nil, errors.New("habit not found or does not belong to user")
+		},
+	}
+
+	var history []*entity.HabitCompletion
+	for daysAgo := 0; daysAgo <= 10; daysAgo++ {
+		if daysAgo != 2 {
+			history = append(history, entity.ReconstituteHabitCompletion("comp", "habit-123", "char-123", 10, 0, "Inteligência", 1, 0, now.AddDate(0, 0, -daysAgo)))
+		}
+	}
+	compRepo := &mockHabitCompletionRepository{
+		findByHabitIDFunc: func(ctx context.Context, habitID string) ([]*entity.HabitCompletion, error) {
+			return history, nil
+		},
+	}
+
+	var available []*entity.StreakFreeze
+	for i := 0; i < availableFreezes; i++ {
+		freeze, _ := entity.NewStreakFreeze("freeze", "char-123", "comp")
+		available = append(available, freeze)
+	}
+	freezeRepo := &mockStreakFreezeRepository{
+		findAvailableFunc: func(ctx context.Context, characterID string) ([]*entity.StreakFreeze, error) {
+			return available, nil
+		},
+	}
+
+	return usecase.NewUseStreakFreezeUseCase(habitRepo, compRepo, freezeRepo, &mockUserPreferencesRepository{}), freezeRepo
+}
+
+func TestUseStreakFreezeUseCase_Execute_Success(t *testing.T) {
+	useCase, freezeRepo := newStreakFreezeFixture(2)
+
+	var saved *entity.StreakFreeze
+	freezeRepo.updateFunc = func(ctx context.Context, freeze *entity.StreakFreeze) error {
+		saved = freeze
+		return nil
+	}
+
+	missedDay := time.Now().UTC().AddDate(0, 0, -2)
+
+	output, err := useCase.Execute(context.Background(), usecase.UseStreakFreezeInput{
+		HabitID: "habit-123",
+		UserID:  "user-123",
+		Date:    missedDay,
+	})
+
+	if err != nil {
+		t.Fatalf("Execute() error = %v, want nil", err)
+	}
+
+	if saved == nil || !saved.IsUsed() || saved.HabitID() != "habit-123" {
+		t.Fatal("streak freeze was not spent on the habit")
+	}
+
+	if output.FrozenDate != missedDay.Format("2006-01-02") {
+		t.Errorf("output.FrozenDate = %v, want %v", output.FrozenDate, missedDay.Format("2006-01-02"))
+	}
+
+	if output.RemainingFreezes != 1 {
+		t.Errorf("output.RemainingFreezes = %v, want %v", output.RemainingFreezes, 1)
+	}
+
+	// The missed day no longer breaks the streak: 11 days in a row
+	if output.CurrentStreak != 11 {
+		t.Errorf("output.CurrentStreak = %v, want %v", output.CurrentStreak, 11)
+	}
+}
+
+func TestUseStreakFreezeUseCase_Execute_NoFreezes(t *testing.T) {
+	useCase, _ := newStreakFreezeFixture(0)
+
+	_, err := useCase.Execute(context.Background(), usecase.UseStreakFreezeInput{
+		HabitID: "habit-123",
+		UserID:  "user-123",
+		Date:    time.Now().UTC().AddDate(0, 0, -2),
+	})
+
+	if !errors.Is(err, usecase.ErrNoStreakFreezes) {
+		t.Errorf("Execute() error = %v, want %v", err, usecase.ErrNoStreakFreezes)
+	}
+}
+
+func TestUseStreakFreezeUseCase_Execute_NotNeeded(t *testing.T) {
+	tests := []struct {
+		name string
+		date time.Time
+	}{
+		{"completed day", time.Now().UTC().AddDate(0, 0, -1)},
+		{"today", time.Now().UTC()},
+		{"before habit creation", time.Now().UTC().AddDate(0, 0, -20)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useCase, _ := newStreakFreezeFixture(1)
+
+			_, err := useCase.Execute(context.Background(), usecase.UseStreakFreezeInput{
+				HabitID: "habit-123",
+				UserID:  "user-123",
+				Date:    tt.date,
+			})
+
+			if !errors.Is(err, usecase.ErrStreakFreezeNotNeeded) {
+				t.Errorf("Execute() error = %v, want %v", err, usecase.ErrStreakFreezeNotNeeded)
+			}
+		})
+	}
+}
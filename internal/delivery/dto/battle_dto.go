@@ -0,0 +1,109 @@
+package dto
+
+import "encoding/json"
+
+// FightMonsterRequest represents the request to battle a monster of the catalog
+type FightMonsterRequest struct {
+	CharacterID string `json:"characterId" binding:"required"`
+	MonsterID   string `json:"monsterId" binding:"required"`
+}
+
+// BattleCombatantResponse represents a combatant as it entered the battle
+type BattleCombatantResponse struct {
+	Kind       string `json:"kind"`
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Level      int    `json:"level"`
+	HP         int    `json:"hp"`
+	Attack     int    `json:"attack"`
+	Magic      int    `json:"magic"`
+	Defense    int    `json:"defense"`
+	Evasion    int    `json:"evasion"`
+	Initiative int    `json:"initiative"`
+}
+
+// BattleActionResponse represents one strike of the battle log
+type BattleActionResponse struct {
+	Round    int    `json:"round"`
+	Actor    string `json:"actor"`
+	Attack   string `json:"attack"`
+	Dodged   bool   `json:"dodged"`
+	Critical bool   `json:"critical"`
+	Damage   int    `json:"damage"`
+	TargetHP int    `json:"targetHp"`
+}
+
+// FightMonsterResponse represents the outcome of a PvE battle
+// winner is "challenger" (the character), "opponent" (the monster) or empty on a draw; energy is what the character has left
+type FightMonsterResponse struct {
+	BattleID     string                  `json:"battleId"`
+	Seed         int64                   `json:"seed"`
+	Challenger   BattleCombatantResponse `json:"challenger"`
+	Opponent     BattleCombatantResponse `json:"opponent"`
+	Winner       string                  `json:"winner"`
+	Victory      bool                    `json:"victory"`
+	Rounds       int                     `json:"rounds"`
+	ChallengerHP int                     `json:"challengerHp"`
+	OpponentHP   int                     `json:"opponentHp"`
+	XpAwarded    int                     `json:"xpAwarded"`
+	LevelsGained int                     `json:"levelsGained"`
+	Loot         []LootDropResponse      `json:"loot"`
+	Actions      []BattleActionResponse  `json:"actions"`
+	Energy       int                     `json:"energy"`
+}
+
+// CreateBattleChallengeRequest represents the request to challenge another character
+// ranked battles update both characters' ratings (defaults to false)
+type CreateBattleChallengeRequest struct {
+	CharacterID         string `json:"characterId" binding:"required"`
+	OpponentCharacterID string `json:"opponentCharacterId" binding:"required"`
+	Ranked              bool   `json:"ranked"`
+}
+
+// BattleChallengeResponse represents a PvP challenge
+// status is "pending", "accepted", "declined" or "expired"
+type BattleChallengeResponse struct {
+	ID                    string `json:"id"`
+	ChallengerCharacterID string `json:"challengerCharacterId"`
+	OpponentCharacterID   string `json:"opponentCharacterId"`
+	Ranked                bool   `json:"ranked"`
+	Status                string `json:"status"`
+	BattleID              string `json:"battleId,omitempty"`
+	CreatedAt             string `json:"createdAt"`
+	ExpiresAt             string `json:"expiresAt"`
+	RespondedAt           string `json:"respondedAt,omitempty"`
+}
+
+// GetBattleChallengesResponse represents the pending challenges of a character
+type GetBattleChallengesResponse struct {
+	CharacterID string                    `json:"characterId"`
+	Challenges  []BattleChallengeResponse `json:"challenges"`
+}
+
+// AcceptBattleChallengeResponse represents an accepted challenge and the outcome of its battle
+// winner is "challenger", "opponent" or empty on a draw; ratingChanges is only set for ranked battles
+// energy is what the accepting character has left
+type AcceptBattleChallengeResponse struct {
+	Challenge         BattleChallengeResponse `json:"challenge"`
+	BattleID          string                  `json:"battleId"`
+	Seed              int64                   `json:"seed"`
+	Challenger        BattleCombatantResponse `json:"challenger"`
+	Opponent          BattleCombatantResponse `json:"opponent"`
+	Winner            string                  `json:"winner"`
+	WinnerCharacterID string                  `json:"winnerCharacterId,omitempty"`
+	Rounds            int                     `json:"rounds"`
+	ChallengerHP      int                     `json:"challengerHp"`
+	OpponentHP        int                     `json:"opponentHp"`
+	Actions           []BattleActionResponse  `json:"actions"`
+	RatingChanges     []RatingChangeResponse  `json:"ratingChanges,omitempty"`
+	Energy            int                     `json:"energy"`
+}
+
+// BattleReplayResponse represents a battle with its replay log
+// log is the versioned JSON event log: both combatant snapshots, the seed and every action
+type BattleReplayResponse struct {
+	BattleID string          `json:"battleId"`
+	Kind     string          `json:"kind"`
+	FoughtAt string          `json:"foughtAt"`
+	Log      json.RawMessage `json:"log"`
+}
@@ -1,12 +1,15 @@
 package dto
 
 // CharacterAttributeResponse represents a character attribute in the response
+// effectiveValue is the value plus the growth bonus of the character's class at its current level
 type CharacterAttributeResponse struct {
-	ID            int    `json:"id"`
-	AttributeName string `json:"attributeName"`
-	Value         int    `json:"value"`
-	CharacterID   string `json:"characterId"`
-	CreatedAt     string `json:"createdAt"`
+	ID             int    `json:"id"`
+	AttributeName  string `json:"attributeName"`
+	Value          int    `json:"value"`
+	ClassBonus     int    `json:"classBonus"`
+	EffectiveValue int    `json:"effectiveValue"`
+	CharacterID    string `json:"characterId"`
+	CreatedAt      string `json:"createdAt"`
 }
 
 // GetCharacterAttributesResponse represents the response when fetching all attributes
@@ -14,3 +17,15 @@ type GetCharacterAttributesResponse struct {
 	CharacterID string                       `json:"characterId"`
 	Attributes  []CharacterAttributeResponse `json:"attributes"`
 }
+
+// AllocateAttributePointsRequest represents the points to add to each attribute, by attribute name
+// Example: {"Força": 2, "Destreza": 1}
+type AllocateAttributePointsRequest map[string]int
+
+// AllocateAttributePointsResponse represents the character's attributes after allocating points
+type AllocateAttributePointsResponse struct {
+	CharacterID            string                       `json:"characterId"`
+	PointsSpent            int                          `json:"pointsSpent"`
+	UnspentAttributePoints int                          `json:"unspentAttributePoints"`
+	Attributes             []CharacterAttributeResponse `json:"attributes"`
+}
@@ -0,0 +1,22 @@
+package dto
+
+// ClassAttributeResponse represents how a class starts and grows one attribute
+type ClassAttributeResponse struct {
+	Name           string `json:"name"`
+	Base           int    `json:"base"`
+	GrowthPerLevel int    `json:"growthPerLevel"`
+}
+
+// CharacterClassResponse represents a class of the catalog
+// class is the value to send in CreateCharacterRequest
+type CharacterClassResponse struct {
+	Class       string                   `json:"class"`
+	DisplayName string                   `json:"displayName"`
+	Description string                   `json:"description"`
+	Attributes  []ClassAttributeResponse `json:"attributes"`
+}
+
+// ListCharacterClassesResponse represents the response when listing the classes
+type ListCharacterClassesResponse struct {
+	Classes []CharacterClassResponse `json:"classes"`
+}
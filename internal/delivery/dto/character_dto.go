@@ -1,14 +1,17 @@
 package dto
 
 // CreateCharacterRequest represents the request to create a new character
+// class is optional: characters created without one are balanced adventurers (see GET /classes)
 type CreateCharacterRequest struct {
-	Name string `json:"name" binding:"required,min=2,max=50"`
+	Name  string `json:"name" binding:"required,min=2,max=50"`
+	Class string `json:"class" binding:"omitempty,max=20"`
 }
 
 // CreateCharacterResponse represents the response after creating a character
 type CreateCharacterResponse struct {
 	ID        string `json:"id"`
 	Name      string `json:"name"`
+	Class     string `json:"class"`
 	Level     int    `json:"level"`
 	CurrentXp int    `json:"currentXp"`
 	TotalXp   int    `json:"totalXp"`
@@ -18,12 +21,14 @@ type CreateCharacterResponse struct {
 
 // CharacterItemResponse represents a character in a list
 type CharacterItemResponse struct {
-	ID        string `json:"id"`
-	Name      string `json:"name"`
-	Level     int    `json:"level"`
-	CurrentXp int    `json:"currentXp"`
-	TotalXp   int    `json:"totalXp"`
-	CreatedAt string `json:"createdAt"`
+	ID                     string `json:"id"`
+	Name                   string `json:"name"`
+	Class                  string `json:"class"`
+	Level                  int    `json:"level"`
+	CurrentXp              int    `json:"currentXp"`
+	TotalXp                int    `json:"totalXp"`
+	UnspentAttributePoints int    `json:"unspentAttributePoints"`
+	CreatedAt              string `json:"createdAt"`
 }
 
 // GetUserCharactersResponse represents the response when fetching user's characters
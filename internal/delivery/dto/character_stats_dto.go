@@ -0,0 +1,15 @@
+package dto
+
+// CharacterStatsResponse represents the combat stats of a character
+// evasion is the chance (%) of dodging an attack
+type CharacterStatsResponse struct {
+	CharacterID string `json:"characterId"`
+	Class       string `json:"class"`
+	Level       int    `json:"level"`
+	HP          int    `json:"hp"`
+	Attack      int    `json:"attack"`
+	Magic       int    `json:"magic"`
+	Defense     int    `json:"defense"`
+	Evasion     int    `json:"evasion"`
+	Initiative  int    `json:"initiative"`
+}
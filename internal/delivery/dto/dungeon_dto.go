@@ -0,0 +1,55 @@
+package dto
+
+// DungeonRunRequest represents the request to start, advance or abandon a dungeon run
+type DungeonRunRequest struct {
+	CharacterID string `json:"characterId" binding:"required"`
+}
+
+// DungeonRunResponse represents a character's run through a dungeon
+// status is "in_progress", "completed", "failed" or "abandoned"; hp is what the character carries into the next stage
+type DungeonRunResponse struct {
+	ID            string `json:"id"`
+	DungeonID     string `json:"dungeonId"`
+	DungeonName   string `json:"dungeonName"`
+	CharacterID   string `json:"characterId"`
+	Status        string `json:"status"`
+	StagesCleared int    `json:"stagesCleared"`
+	TotalStages   int    `json:"totalStages"`
+	HP            int    `json:"hp"`
+	MaxHP         int    `json:"maxHp"`
+	Checkpoint    int    `json:"checkpoint"`
+	NextMonsterID string `json:"nextMonsterId,omitempty"`
+	StartedAt     string `json:"startedAt"`
+	UpdatedAt     string `json:"updatedAt"`
+	EndedAt       string `json:"endedAt,omitempty"`
+}
+
+// StartDungeonResponse represents a started (or resumed) dungeon run
+// entryCost is the XP paid to enter, 0 when an existing run was resumed
+type StartDungeonResponse struct {
+	Run       DungeonRunResponse `json:"run"`
+	Resumed   bool               `json:"resumed"`
+	EntryCost int                `json:"entryCost"`
+}
+
+// AdvanceDungeonResponse represents the battle of one dungeon stage and the run after it
+// retreated is true when the defeat sent the run back to its checkpoint; the reward is only set once the dungeon is cleared
+// energy is what the character has left after the stage's battle
+type AdvanceDungeonResponse struct {
+	Run          DungeonRunResponse      `json:"run"`
+	BattleID     string                  `json:"battleId"`
+	Seed         int64                   `json:"seed"`
+	Challenger   BattleCombatantResponse `json:"challenger"`
+	Opponent     BattleCombatantResponse `json:"opponent"`
+	Winner       string                  `json:"winner"`
+	Victory      bool                    `json:"victory"`
+	Rounds       int                     `json:"rounds"`
+	ChallengerHP int                     `json:"challengerHp"`
+	OpponentHP   int                     `json:"opponentHp"`
+	Retreated    bool                    `json:"retreated"`
+	XpAwarded    int                     `json:"xpAwarded"`
+	LevelsGained int                     `json:"levelsGained"`
+	Loot         []LootDropResponse      `json:"loot"`
+	Actions      []BattleActionResponse  `json:"actions"`
+	Energy       int                     `json:"energy"`
+}
@@ -0,0 +1,14 @@
+package dto
+
+// CharacterEnergyResponse represents a character's battle energy, regenerated up to the time of the request
+// nextPointAt is omitted when the pool is full; fullAt is when it will be full again
+type CharacterEnergyResponse struct {
+	CharacterID          string `json:"characterId"`
+	Energy               int    `json:"energy"`
+	MaxEnergy            int    `json:"maxEnergy"`
+	BattleCost           int    `json:"battleCost"`
+	HabitCompletionBonus int    `json:"habitCompletionBonus"`
+	RegenIntervalSeconds int    `json:"regenIntervalSeconds"`
+	NextPointAt          string `json:"nextPointAt,omitempty"`
+	FullAt               string `json:"fullAt"`
+}
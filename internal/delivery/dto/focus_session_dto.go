@@ -0,0 +1,42 @@
+package dto
+
+// StartFocusSessionRequest represents the request to start a focus session
+// Exactly one of habitId and taskId must be set
+type StartFocusSessionRequest struct {
+	HabitID string `json:"habitId"`
+	TaskID  string `json:"taskId"`
+}
+
+// FocusSessionResponse represents a focus session in the response
+type FocusSessionResponse struct {
+	ID             string `json:"id"`
+	CharacterID    string `json:"characterId"`
+	HabitID        string `json:"habitId,omitempty"`
+	TaskID         string `json:"taskId,omitempty"`
+	Status         string `json:"status"` // running, paused, completed
+	StartedAt      string `json:"startedAt"`
+	PausedAt       string `json:"pausedAt,omitempty"`
+	EndedAt        string `json:"endedAt,omitempty"`
+	TrackedMinutes int    `json:"trackedMinutes"`
+	XpAwarded      int    `json:"xpAwarded"`
+}
+
+// StopFocusSessionResponse represents the rewards granted by stopping a focus session
+type StopFocusSessionResponse struct {
+	Session        FocusSessionResponse `json:"session"`
+	XpGained       int                  `json:"xpGained"`
+	LevelsGained   int                  `json:"levelsGained"`
+	Level          int                  `json:"level"`
+	CurrentXp      int                  `json:"currentXp"`
+	TotalXp        int                  `json:"totalXp"`
+	XpForNextLevel int                  `json:"xpForNextLevel"`
+}
+
+// GetFocusSessionsResponse represents the focus sessions started in a date range
+type GetFocusSessionsResponse struct {
+	From           string                 `json:"from"`
+	To             string                 `json:"to"`
+	Sessions       []FocusSessionResponse `json:"sessions"`
+	TrackedMinutes int                    `json:"trackedMinutes"`
+	XpAwarded      int                    `json:"xpAwarded"`
+}
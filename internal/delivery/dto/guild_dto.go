@@ -0,0 +1,68 @@
+package dto
+
+// CreateGuildRequest represents the request to found a guild led by one of the user's characters
+type CreateGuildRequest struct {
+	CharacterID string `json:"characterId" binding:"required"`
+	Name        string `json:"name" binding:"required"`
+}
+
+// JoinGuildRequest represents the request for one of the user's characters to join a guild
+type JoinGuildRequest struct {
+	CharacterID string `json:"characterId" binding:"required"`
+}
+
+// GuildResponse represents a guild
+type GuildResponse struct {
+	ID                string `json:"id"`
+	Name              string `json:"name"`
+	LeaderCharacterID string `json:"leaderCharacterId"`
+	Members           int    `json:"members"`
+	CreatedAt         string `json:"createdAt"`
+}
+
+// StartRaidRequest represents the request of a guild leader to start a raid against a boss
+type StartRaidRequest struct {
+	CharacterID string `json:"characterId" binding:"required"`
+	Boss        string `json:"boss" binding:"required"`
+}
+
+// GuildRaidQuery represents the member whose view of the guild's raid is requested
+type GuildRaidQuery struct {
+	CharacterID string `form:"characterId" binding:"required"`
+}
+
+// RaidContributionResponse represents the damage one member dealt to a raid boss
+// rewardXp is the member's share of the reward, set once the boss is defeated
+type RaidContributionResponse struct {
+	CharacterID string `json:"characterId"`
+	Damage      int    `json:"damage"`
+	Hits        int    `json:"hits"`
+	RewardXp    int    `json:"rewardXp"`
+}
+
+// RaidResponse represents a guild raid and its contributors (most damage first)
+// status is "active", "defeated" or "expired"
+type RaidResponse struct {
+	ID            string                     `json:"id"`
+	GuildID       string                     `json:"guildId"`
+	Boss          string                     `json:"boss"`
+	BossName      string                     `json:"bossName"`
+	MaxHP         int                        `json:"maxHp"`
+	HP            int                        `json:"hp"`
+	RewardXp      int                        `json:"rewardXp"`
+	Status        string                     `json:"status"`
+	StartedAt     string                     `json:"startedAt"`
+	EndsAt        string                     `json:"endsAt"`
+	EndedAt       string                     `json:"endedAt,omitempty"`
+	Contributions []RaidContributionResponse `json:"contributions"`
+}
+
+// RaidStrikeResponse represents the damage a habit completion dealt to the guild's raid boss
+// xpAwarded is the character's share of the reward when the completion dealt the killing blow
+type RaidStrikeResponse struct {
+	RaidID    string `json:"raidId"`
+	Damage    int    `json:"damage"`
+	BossHP    int    `json:"bossHp"`
+	Defeated  bool   `json:"defeated"`
+	XpAwarded int    `json:"xpAwarded"`
+}
@@ -0,0 +1,146 @@
+package dto
+
+// CreateHabitRequest represents the request to create a new habit
+type CreateHabitRequest struct {
+	CharacterID   string             `json:"characterId" binding:"required"`
+	Title         string             `json:"title" binding:"required,min=2,max=100"`
+	Description   string             `json:"description" binding:"max=500"`
+	AttributeName string             `json:"attributeName" binding:"required"`
+	Difficulty    string             `json:"difficulty" binding:"required"` // trivial, easy, medium, hard
+	Recurrence    *RecurrenceRequest `json:"recurrence"`                    // Optional, defaults to daily
+	Negative      bool               `json:"negative"`                      // Bad habit: logging it drains the attribute
+	DrainsXp      bool               `json:"drainsXp"`                      // Negative habits only: logging it also costs XP
+}
+
+// RecurrenceRequest represents a habit schedule
+// Only the fields relevant to the type are used
+type RecurrenceRequest struct {
+	Type     string   `json:"type" binding:"required"` // daily, weekly, interval, times_per_period
+	Weekdays []string `json:"weekdays"`                // weekly: ["mon", "wed", "fri"]
+	Interval int      `json:"interval"`                // interval: every N days
+	Times    int      `json:"times"`                   // times_per_period: N times...
+	Period   string   `json:"period"`                  // times_per_period: ...per "week" or "month"
+}
+
+// UpdateHabitRequest represents the request to update a habit (full replacement)
+type UpdateHabitRequest struct {
+	Title         string             `json:"title" binding:"required,min=2,max=100"`
+	Description   string             `json:"description" binding:"max=500"`
+	AttributeName string             `json:"attributeName" binding:"required"`
+	Difficulty    string             `json:"difficulty" binding:"required"` // trivial, easy, medium, hard
+	Recurrence    *RecurrenceRequest `json:"recurrence"`                    // Optional, keeps the current schedule
+	Negative      bool               `json:"negative"`
+	DrainsXp      bool               `json:"drainsXp"` // Negative habits only
+	Active        *bool              `json:"active" binding:"required"`
+}
+
+// RecurrenceResponse represents a habit schedule in the response
+type RecurrenceResponse struct {
+	Type     string   `json:"type"`
+	Weekdays []string `json:"weekdays,omitempty"`
+	Interval int      `json:"interval,omitempty"`
+	Times    int      `json:"times,omitempty"`
+	Period   string   `json:"period,omitempty"`
+	Rule     string   `json:"rule"` // RRULE-style representation
+}
+
+// StreakResponse represents the current and longest streaks of a habit
+type StreakResponse struct {
+	Current int `json:"current"`
+	Longest int `json:"longest"`
+}
+
+// HabitResponse represents a habit in the response
+type HabitResponse struct {
+	ID            string             `json:"id"`
+	CharacterID   string             `json:"characterId"`
+	Title         string             `json:"title"`
+	Description   string             `json:"description"`
+	AttributeName string             `json:"attributeName"`
+	Difficulty    string             `json:"difficulty"`
+	Recurrence    RecurrenceResponse `json:"recurrence"`
+	Streak        *StreakResponse    `json:"streak,omitempty"` // Only present when reading habits
+	Negative      bool               `json:"negative"`
+	DrainsXp      bool               `json:"drainsXp"`
+	Active        bool               `json:"active"`
+	CreatedAt     string             `json:"createdAt"`
+	UpdatedAt     string             `json:"updatedAt"`
+}
+
+// GetHabitsResponse represents the response when fetching the user's habits
+type GetHabitsResponse struct {
+	Habits []HabitResponse `json:"habits"`
+}
+
+// DueHabitResponse represents a habit scheduled for the requested day
+type DueHabitResponse struct {
+	Habit             HabitResponse `json:"habit"`
+	CompletedToday    bool          `json:"completedToday"`
+	PeriodCompletions int           `json:"periodCompletions"`
+}
+
+// GetDueHabitsResponse represents the response when fetching the habits due on a day
+type GetDueHabitsResponse struct {
+	Date   string             `json:"date"`
+	Habits []DueHabitResponse `json:"habits"`
+}
+
+// CompleteHabitResponse represents the rewards granted by completing a habit
+// For negative habits xpGained and levelsGained are negative (what was lost)
+// raid is set when the completion struck the active raid boss of the character's guild
+// energyRestored is the battle energy restored (0 for negative habits or when the pool is full)
+type CompleteHabitResponse struct {
+	CompletionID   string              `json:"completionId"`
+	HabitID        string              `json:"habitId"`
+	CharacterID    string              `json:"characterId"`
+	XpGained       int                 `json:"xpGained"`
+	StreakBonusXp  int                 `json:"streakBonusXp"`
+	LevelsGained   int                 `json:"levelsGained"`
+	Level          int                 `json:"level"`
+	CurrentXp      int                 `json:"currentXp"`
+	TotalXp        int                 `json:"totalXp"`
+	XpForNextLevel int                 `json:"xpForNextLevel"`
+	AttributeName  string              `json:"attributeName"`
+	AttributeValue int                 `json:"attributeValue"`
+	CurrentStreak  int                 `json:"currentStreak"`
+	LongestStreak  int                 `json:"longestStreak"`
+	FreezesEarned  int                 `json:"freezesEarned"`
+	CompletedAt    string              `json:"completedAt"`
+	Raid           *RaidStrikeResponse `json:"raid,omitempty"`
+	EnergyRestored int                 `json:"energyRestored"`
+}
+
+// UndoHabitCompletionResponse represents the character after a habit completion was undone
+// For negative habits xpReverted and levelsReverted are negative (what was given back)
+// raidDamageHealed is the damage given back to a raid boss that is still fighting; energyDrained is the battle energy taken back
+type UndoHabitCompletionResponse struct {
+	CompletionID     string `json:"completionId"`
+	HabitID          string `json:"habitId"`
+	CharacterID      string `json:"characterId"`
+	XpReverted       int    `json:"xpReverted"`
+	LevelsReverted   int    `json:"levelsReverted"`
+	Level            int    `json:"level"`
+	CurrentXp        int    `json:"currentXp"`
+	TotalXp          int    `json:"totalXp"`
+	XpForNextLevel   int    `json:"xpForNextLevel"`
+	AttributeName    string `json:"attributeName"`
+	AttributeValue   int    `json:"attributeValue"`
+	FreezesRevoked   int    `json:"freezesRevoked"`
+	RaidDamageHealed int    `json:"raidDamageHealed"`
+	EnergyDrained    int    `json:"energyDrained"`
+}
+
+// UseStreakFreezeRequest represents the request to protect a missed day with a streak freeze
+type UseStreakFreezeRequest struct {
+	Date string `json:"date" binding:"required"` // YYYY-MM-DD
+}
+
+// UseStreakFreezeResponse represents the response after spending a streak freeze
+type UseStreakFreezeResponse struct {
+	FreezeID         string `json:"freezeId"`
+	HabitID          string `json:"habitId"`
+	FrozenDate       string `json:"frozenDate"`
+	RemainingFreezes int    `json:"remainingFreezes"`
+	CurrentStreak    int    `json:"currentStreak"`
+	LongestStreak    int    `json:"longestStreak"`
+}
@@ -0,0 +1,22 @@
+package dto
+
+// XpTableQuery represents how many levels of the XP table to return (query parameters)
+type XpTableQuery struct {
+	Levels int `form:"levels" binding:"omitempty,min=1,max=500"`
+}
+
+// XpTableLevelResponse represents one level of the XP table
+// xpForNextLevel is 0 at the level cap
+type XpTableLevelResponse struct {
+	Level           int `json:"level"`
+	XpForNextLevel  int `json:"xpForNextLevel"`
+	TotalXpRequired int `json:"totalXpRequired"`
+}
+
+// XpTableResponse represents the XP table of the configured level curve
+// maxLevel is 0 when there is no level cap
+type XpTableResponse struct {
+	CurveType string                 `json:"curveType"`
+	MaxLevel  int                    `json:"maxLevel"`
+	Levels    []XpTableLevelResponse `json:"levels"`
+}
@@ -0,0 +1,38 @@
+package dto
+
+// ListMonstersQuery represents the optional level filter of the monster catalog (query parameters)
+type ListMonstersQuery struct {
+	Level int `form:"level" binding:"omitempty,min=1"`
+}
+
+// LootDropResponse represents an item a monster drops
+// chance is the chance (%) of the item dropping when the monster is defeated
+type LootDropResponse struct {
+	Item     string `json:"item"`
+	Chance   int    `json:"chance"`
+	Quantity int    `json:"quantity"`
+}
+
+// MonsterResponse represents a monster of the catalog
+// attributes, stats and xpReward are the monster's at level: the requested level or its minimum level
+type MonsterResponse struct {
+	ID         string             `json:"id"`
+	Name       string             `json:"name"`
+	MinLevel   int                `json:"minLevel"`
+	MaxLevel   int                `json:"maxLevel"`
+	Level      int                `json:"level"`
+	Attributes map[string]int     `json:"attributes"`
+	HP         int                `json:"hp"`
+	Attack     int                `json:"attack"`
+	Magic      int                `json:"magic"`
+	Defense    int                `json:"defense"`
+	Evasion    int                `json:"evasion"`
+	Initiative int                `json:"initiative"`
+	XpReward   int                `json:"xpReward"`
+	Loot       []LootDropResponse `json:"loot"`
+}
+
+// ListMonstersResponse represents the response when listing the monster catalog (lowest level first)
+type ListMonstersResponse struct {
+	Monsters []MonsterResponse `json:"monsters"`
+}
@@ -0,0 +1,58 @@
+package dto
+
+// RatingChangeResponse represents the rating change of a character in one ranked battle
+// result is "win", "draw" or "loss"; delta is negative when rating was lost
+type RatingChangeResponse struct {
+	CharacterID         string `json:"characterId"`
+	BattleID            string `json:"battleId"`
+	OpponentCharacterID string `json:"opponentCharacterId"`
+	Result              string `json:"result"`
+	RatingBefore        int    `json:"ratingBefore"`
+	RatingAfter         int    `json:"ratingAfter"`
+	Delta               int    `json:"delta"`
+	DeviationAfter      int    `json:"deviationAfter"`
+	CreatedAt           string `json:"createdAt"`
+}
+
+// CharacterRatingResponse represents a character's competitive rating and its latest changes (most recent first)
+// deviation grows while the character doesn't fight ranked battles
+type CharacterRatingResponse struct {
+	CharacterID  string                 `json:"characterId"`
+	Rating       int                    `json:"rating"`
+	Deviation    int                    `json:"deviation"`
+	Volatility   float64                `json:"volatility"`
+	Wins         int                    `json:"wins"`
+	Losses       int                    `json:"losses"`
+	Draws        int                    `json:"draws"`
+	Games        int                    `json:"games"`
+	LastPlayedAt string                 `json:"lastPlayedAt,omitempty"`
+	History      []RatingChangeResponse `json:"history"`
+}
+
+// LeaderboardQuery represents the pagination of the leaderboard (query parameters)
+type LeaderboardQuery struct {
+	Page     int `form:"page" binding:"omitempty,min=1"`
+	PageSize int `form:"pageSize" binding:"omitempty,min=1,max=50"`
+}
+
+// LeaderboardEntryResponse represents one character of the leaderboard
+type LeaderboardEntryResponse struct {
+	Rank        int    `json:"rank"`
+	CharacterID string `json:"characterId"`
+	Name        string `json:"name"`
+	Class       string `json:"class"`
+	Level       int    `json:"level"`
+	Rating      int    `json:"rating"`
+	Deviation   int    `json:"deviation"`
+	Wins        int    `json:"wins"`
+	Losses      int    `json:"losses"`
+	Draws       int    `json:"draws"`
+}
+
+// LeaderboardResponse represents a page of the leaderboard (highest rating first)
+type LeaderboardResponse struct {
+	Entries  []LeaderboardEntryResponse `json:"entries"`
+	Page     int                        `json:"page"`
+	PageSize int                        `json:"pageSize"`
+	Total    int                        `json:"total"`
+}
@@ -0,0 +1,59 @@
+package dto
+
+// CreateTaskRequest represents the request to create a new task
+type CreateTaskRequest struct {
+	CharacterID string   `json:"characterId" binding:"required"`
+	Title       string   `json:"title" binding:"required,min=2,max=100"`
+	Description string   `json:"description" binding:"max=500"`
+	Difficulty  string   `json:"difficulty" binding:"required"` // trivial, easy, medium, hard
+	Priority    string   `json:"priority"`                      // low, medium, high (defaults to medium)
+	DueDate     string   `json:"dueDate"`                       // Optional, YYYY-MM-DD
+	Checklist   []string `json:"checklist" binding:"max=50,dive,min=1,max=100"`
+}
+
+// CheckTaskItemRequest represents the request to check (or uncheck) a checklist item
+type CheckTaskItemRequest struct {
+	Done *bool `json:"done" binding:"required"`
+}
+
+// TaskChecklistItemResponse represents a checklist item in the response
+type TaskChecklistItemResponse struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Done  bool   `json:"done"`
+}
+
+// TaskResponse represents a task in the response
+type TaskResponse struct {
+	ID          string                      `json:"id"`
+	CharacterID string                      `json:"characterId"`
+	Title       string                      `json:"title"`
+	Description string                      `json:"description"`
+	Difficulty  string                      `json:"difficulty"`
+	Priority    string                      `json:"priority"`
+	DueDate     string                      `json:"dueDate,omitempty"`
+	Checklist   []TaskChecklistItemResponse `json:"checklist"`
+	Status      string                      `json:"status"` // open, completed
+	Overdue     bool                        `json:"overdue"`
+	CompletedAt string                      `json:"completedAt,omitempty"`
+	XpAwarded   int                         `json:"xpAwarded"`
+	CreatedAt   string                      `json:"createdAt"`
+	UpdatedAt   string                      `json:"updatedAt"`
+}
+
+// GetTasksResponse represents the response when fetching the user's tasks
+type GetTasksResponse struct {
+	Tasks []TaskResponse `json:"tasks"`
+}
+
+// TaskRewardResponse represents a task after completing or reopening it, with the character's progress
+// XP and level changes are negative when reopening takes the reward back
+type TaskRewardResponse struct {
+	Task           TaskResponse `json:"task"`
+	XpGained       int          `json:"xpGained"`
+	LevelsGained   int          `json:"levelsGained"`
+	Level          int          `json:"level"`
+	CurrentXp      int          `json:"currentXp"`
+	TotalXp        int          `json:"totalXp"`
+	XpForNextLevel int          `json:"xpForNextLevel"`
+}
@@ -0,0 +1,103 @@
+package dto
+
+// CreateTournamentRequest represents the request to create a tournament
+// Admins create tournaments open to every character; a guild leader passes the guild and its leading character
+// format is "single_elimination" or "round_robin", seeding "level" or "rating", joinDeadline an RFC3339 timestamp
+type CreateTournamentRequest struct {
+	CharacterID          string `json:"characterId"`
+	GuildID              string `json:"guildId"`
+	Name                 string `json:"name" binding:"required"`
+	Format               string `json:"format" binding:"required"`
+	Seeding              string `json:"seeding" binding:"required"`
+	JoinDeadline         string `json:"joinDeadline" binding:"required"`
+	RoundIntervalMinutes int    `json:"roundIntervalMinutes" binding:"required"`
+	MaxEntrants          int    `json:"maxEntrants" binding:"required"`
+	PrizeXp              int    `json:"prizeXp"`
+}
+
+// JoinTournamentRequest represents the request for one of the user's characters to join a tournament
+type JoinTournamentRequest struct {
+	CharacterID string `json:"characterId" binding:"required"`
+}
+
+// TournamentResponse represents a tournament
+// status is "open", "running", "completed" or "cancelled"; nextRoundAt is omitted once the tournament is over
+type TournamentResponse struct {
+	ID                   string `json:"id"`
+	Name                 string `json:"name"`
+	Format               string `json:"format"`
+	Seeding              string `json:"seeding"`
+	GuildID              string `json:"guildId,omitempty"`
+	OrganizerUserID      string `json:"organizerUserId"`
+	MaxEntrants          int    `json:"maxEntrants"`
+	Entrants             int    `json:"entrants"`
+	PrizeXp              int    `json:"prizeXp"`
+	JoinDeadline         string `json:"joinDeadline"`
+	RoundIntervalMinutes int    `json:"roundIntervalMinutes"`
+	Status               string `json:"status"`
+	TotalRounds          int    `json:"totalRounds"`
+	RoundsPlayed         int    `json:"roundsPlayed"`
+	NextRoundAt          string `json:"nextRoundAt,omitempty"`
+	ChampionCharacterID  string `json:"championCharacterId,omitempty"`
+	RunnerUpCharacterID  string `json:"runnerUpCharacterId,omitempty"`
+	CreatedAt            string `json:"createdAt"`
+	EndedAt              string `json:"endedAt,omitempty"`
+}
+
+// TournamentEntrantResponse represents a character that joined a tournament
+// seed is 0 until the bracket is seeded at the join deadline
+type TournamentEntrantResponse struct {
+	CharacterID string `json:"characterId"`
+	Seed        int    `json:"seed"`
+	SeedScore   int    `json:"seedScore"`
+	JoinedAt    string `json:"joinedAt"`
+}
+
+// TournamentMatchResponse represents a pairing of a round and, once fought, its result
+// winnerCharacterId is empty until fought, or on a round-robin draw
+type TournamentMatchResponse struct {
+	Slot                int    `json:"slot"`
+	CharacterID         string `json:"characterId"`
+	OpponentCharacterID string `json:"opponentCharacterId,omitempty"`
+	Bye                 bool   `json:"bye"`
+	BattleID            string `json:"battleId,omitempty"`
+	WinnerCharacterID   string `json:"winnerCharacterId,omitempty"`
+	PlayedAt            string `json:"playedAt,omitempty"`
+}
+
+// TournamentRoundResponse represents a round of a tournament
+type TournamentRoundResponse struct {
+	Round       int                       `json:"round"`
+	ScheduledAt string                    `json:"scheduledAt"`
+	Played      bool                      `json:"played"`
+	Matches     []TournamentMatchResponse `json:"matches"`
+}
+
+// TournamentStandingResponse represents an entrant's record in a tournament
+type TournamentStandingResponse struct {
+	Place             int    `json:"place"`
+	CharacterID       string `json:"characterId"`
+	Seed              int    `json:"seed"`
+	Wins              int    `json:"wins"`
+	Draws             int    `json:"draws"`
+	Losses            int    `json:"losses"`
+	Points            int    `json:"points"`
+	EliminatedInRound int    `json:"eliminatedInRound,omitempty"`
+}
+
+// TournamentPrizeResponse represents the XP a finisher was awarded
+type TournamentPrizeResponse struct {
+	CharacterID string `json:"characterId"`
+	Place       int    `json:"place"`
+	Xp          int    `json:"xp"`
+}
+
+// TournamentBracketResponse represents the state of a tournament's bracket
+// rounds lists the fought rounds, then the pairings of the next one while the tournament is running
+type TournamentBracketResponse struct {
+	Tournament TournamentResponse           `json:"tournament"`
+	Entrants   []TournamentEntrantResponse  `json:"entrants"`
+	Rounds     []TournamentRoundResponse    `json:"rounds"`
+	Standings  []TournamentStandingResponse `json:"standings"`
+	Prizes     []TournamentPrizeResponse    `json:"prizes"`
+}
@@ -0,0 +1,17 @@
+package dto
+
+// UpdateUserPreferencesRequest represents the request to change the user's calendar preferences
+type UpdateUserPreferencesRequest struct {
+	Timezone        string `json:"timezone" binding:"required"`  // IANA time zone, e.g. Europe/Lisbon
+	WeekStart       string `json:"weekStart" binding:"required"` // Weekday name, e.g. monday
+	DayRolloverHour *int   `json:"dayRolloverHour" binding:"required,min=0,max=12"`
+}
+
+// UserPreferencesResponse represents the user's calendar preferences
+type UserPreferencesResponse struct {
+	Timezone        string `json:"timezone"`
+	WeekStart       string `json:"weekStart"`
+	DayRolloverHour int    `json:"dayRolloverHour"`
+	Today           string `json:"today"`               // The user's current day (YYYY-MM-DD)
+	UpdatedAt       string `json:"updatedAt,omitempty"` // Omitted while the user keeps the defaults
+}
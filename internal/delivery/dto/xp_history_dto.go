@@ -0,0 +1,28 @@
+package dto
+
+// XpHistoryQuery represents the pagination of the XP history (query parameters)
+type XpHistoryQuery struct {
+	Page     int `form:"page" binding:"omitempty,min=1"`
+	PageSize int `form:"pageSize" binding:"omitempty,min=1,max=100"`
+}
+
+// XpTransactionResponse represents one XP change in the response
+// amount is negative when XP was lost
+type XpTransactionResponse struct {
+	ID               int64  `json:"id"`
+	Amount           int    `json:"amount"`
+	SourceType       string `json:"sourceType"`
+	SourceID         string `json:"sourceId"`
+	ResultingLevel   int    `json:"resultingLevel"`
+	ResultingTotalXp int    `json:"resultingTotalXp"`
+	CreatedAt        string `json:"createdAt"`
+}
+
+// XpHistoryResponse represents a page of a character's XP history (most recent first)
+type XpHistoryResponse struct {
+	CharacterID  string                  `json:"characterId"`
+	Transactions []XpTransactionResponse `json:"transactions"`
+	Page         int                     `json:"page"`
+	PageSize     int                     `json:"pageSize"`
+	Total        int                     `json:"total"`
+}
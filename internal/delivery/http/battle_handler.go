@@ -0,0 +1,403 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
+)
+
+// BattleHandler handles battle-related HTTP requests
+type BattleHandler struct {
+	fightMonsterUseCase           *usecase.FightMonsterUseCase
+	createBattleChallengeUseCase  *usecase.CreateBattleChallengeUseCase
+	acceptBattleChallengeUseCase  *usecase.AcceptBattleChallengeUseCase
+	declineBattleChallengeUseCase *usecase.DeclineBattleChallengeUseCase
+	listBattleChallengesUseCase   *usecase.ListBattleChallengesUseCase
+	getBattleReplayUseCase        *usecase.GetBattleReplayUseCase
+}
+
+// NewBattleHandler creates a new BattleHandler
+func NewBattleHandler(
+	fightMonsterUseCase *usecase.FightMonsterUseCase,
+	createBattleChallengeUseCase *usecase.CreateBattleChallengeUseCase,
+	acceptBattleChallengeUseCase *usecase.AcceptBattleChallengeUseCase,
+	declineBattleChallengeUseCase *usecase.DeclineBattleChallengeUseCase,
+	listBattleChallengesUseCase *usecase.ListBattleChallengesUseCase,
+	getBattleReplayUseCase *usecase.GetBattleReplayUseCase,
+) *BattleHandler {
+	return &BattleHandler{
+		fightMonsterUseCase:           fightMonsterUseCase,
+		createBattleChallengeUseCase:  createBattleChallengeUseCase,
+		acceptBattleChallengeUseCase:  acceptBattleChallengeUseCase,
+		declineBattleChallengeUseCase: declineBattleChallengeUseCase,
+		listBattleChallengesUseCase:   listBattleChallengesUseCase,
+		getBattleReplayUseCase:        getBattleReplayUseCase,
+	}
+}
+
+// Pve handles POST /battle/pve - battles a monster of the catalog with one of the user's characters
+// This is a protected route that requires authentication
+func (h *BattleHandler) Pve(c *gin.Context) {
+	var req dto.FightMonsterRequest
+
+	// Bind and validate request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates character ownership)
+	output, err := h.fightMonsterUseCase.Execute(c.Request.Context(), usecase.FightMonsterInput{
+		CharacterID: req.CharacterID,
+		UserID:      userID,
+		MonsterID:   req.MonsterID,
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrCharacterNotFound):
+			c.JSON(http.StatusForbidden, dto.ErrorResponse{
+				Error:   "forbidden",
+				Message: "character not found or you are not authorized to access it",
+			})
+		case errors.Is(err, usecase.ErrMonsterNotFound):
+			c.JSON(http.StatusNotFound, dto.ErrorResponse{
+				Error:   "monster_not_found",
+				Message: err.Error(),
+			})
+		case errors.Is(err, usecase.ErrNotEnoughEnergy):
+			c.JSON(http.StatusTooManyRequests, dto.ErrorResponse{
+				Error:   "not_enough_energy",
+				Message: err.Error(),
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+				Error:   "battle_failed",
+				Message: err.Error(),
+			})
+		}
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, dto.FightMonsterResponse{
+		BattleID:     output.BattleID,
+		Seed:         output.Seed,
+		Challenger:   toBattleCombatantResponse(output.Challenger),
+		Opponent:     toBattleCombatantResponse(output.Opponent),
+		Winner:       output.Winner,
+		Victory:      output.Victory,
+		Rounds:       output.Rounds,
+		ChallengerHP: output.ChallengerHP,
+		OpponentHP:   output.OpponentHP,
+		XpAwarded:    output.XpAwarded,
+		LevelsGained: output.LevelsGained,
+		Loot:         toLootDropResponses(output.Loot),
+		Actions:      toBattleActionResponses(output.Actions),
+		Energy:       output.Energy,
+	})
+}
+
+// CreateChallenge handles POST /battle/challenge - challenges another character to a PvP battle
+// This is a protected route that requires authentication
+func (h *BattleHandler) CreateChallenge(c *gin.Context) {
+	var req dto.CreateBattleChallengeRequest
+
+	// Bind and validate request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates challenger ownership)
+	output, err := h.createBattleChallengeUseCase.Execute(c.Request.Context(), usecase.CreateBattleChallengeInput{
+		CharacterID:         req.CharacterID,
+		UserID:              userID,
+		OpponentCharacterID: req.OpponentCharacterID,
+		Ranked:              req.Ranked,
+	})
+
+	if err != nil {
+		respondBattleChallengeError(c, err, "battle_challenge_failed")
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusCreated, toBattleChallengeResponse(*output))
+}
+
+// AcceptChallenge handles POST /battle/challenge/:id/accept - accepts a challenge and fights the battle
+// This is a protected route that requires authentication
+func (h *BattleHandler) AcceptChallenge(c *gin.Context) {
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates the challenge was sent to the user)
+	output, err := h.acceptBattleChallengeUseCase.Execute(c.Request.Context(), usecase.BattleChallengeInput{
+		ChallengeID: c.Param("id"),
+		UserID:      userID,
+	})
+
+	if err != nil {
+		respondBattleChallengeError(c, err, "battle_failed")
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, dto.AcceptBattleChallengeResponse{
+		Challenge:         toBattleChallengeResponse(output.Challenge),
+		BattleID:          output.BattleID,
+		Seed:              output.Seed,
+		Challenger:        toBattleCombatantResponse(output.Challenger),
+		Opponent:          toBattleCombatantResponse(output.Opponent),
+		Winner:            output.Winner,
+		WinnerCharacterID: output.WinnerCharacterID,
+		Rounds:            output.Rounds,
+		ChallengerHP:      output.ChallengerHP,
+		OpponentHP:        output.OpponentHP,
+		Actions:           toBattleActionResponses(output.Actions),
+		RatingChanges:     toRatingChangeResponses(output.RatingChanges),
+		Energy:            output.Energy,
+	})
+}
+
+// DeclineChallenge handles POST /battle/challenge/:id/decline - refuses a challenge
+// This is a protected route that requires authentication
+func (h *BattleHandler) DeclineChallenge(c *gin.Context) {
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates the challenge was sent to the user)
+	output, err := h.declineBattleChallengeUseCase.Execute(c.Request.Context(), usecase.BattleChallengeInput{
+		ChallengeID: c.Param("id"),
+		UserID:      userID,
+	})
+
+	if err != nil {
+		respondBattleChallengeError(c, err, "battle_challenge_decline_failed")
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, toBattleChallengeResponse(*output))
+}
+
+// ListChallenges handles GET /character/:characterId/battle-challenges - lists the challenges waiting for an answer
+// This is a protected route that requires authentication
+func (h *BattleHandler) ListChallenges(c *gin.Context) {
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	characterID := c.Param("characterId")
+
+	// Execute use case (it validates character ownership)
+	outputs, err := h.listBattleChallengesUseCase.Execute(c.Request.Context(), usecase.ListBattleChallengesInput{
+		CharacterID: characterID,
+		UserID:      userID,
+	})
+
+	if err != nil {
+		respondBattleChallengeError(c, err, "failed_to_fetch_battle_challenges")
+		return
+	}
+
+	challenges := make([]dto.BattleChallengeResponse, len(outputs))
+	for i, output := range outputs {
+		challenges[i] = toBattleChallengeResponse(output)
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, dto.GetBattleChallengesResponse{
+		CharacterID: characterID,
+		Challenges:  challenges,
+	})
+}
+
+// Replay handles GET /battle/:id/replay - returns the replay log of a battle fought by one of the user's characters
+// This is a protected route that requires authentication
+func (h *BattleHandler) Replay(c *gin.Context) {
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates the user fought the battle)
+	output, err := h.getBattleReplayUseCase.Execute(c.Request.Context(), usecase.GetBattleReplayInput{
+		BattleID: c.Param("id"),
+		UserID:   userID,
+	})
+
+	if err != nil {
+		if errors.Is(err, usecase.ErrBattleNotFound) {
+			c.JSON(http.StatusNotFound, dto.ErrorResponse{
+				Error:   "battle_not_found",
+				Message: "battle not found or not fought by your characters",
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "failed_to_fetch_battle_replay",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, dto.BattleReplayResponse{
+		BattleID: output.BattleID,
+		Kind:     output.Kind,
+		FoughtAt: output.FoughtAt,
+		Log:      output.Log,
+	})
+}
+
+// respondBattleChallengeError maps challenge use case errors to HTTP responses
+func respondBattleChallengeError(c *gin.Context, err error, fallbackCode string) {
+	switch {
+	case errors.Is(err, usecase.ErrCharacterNotFound):
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{
+			Error:   "forbidden",
+			Message: "character not found or you are not authorized to access it",
+		})
+	case errors.Is(err, usecase.ErrOpponentNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "opponent_not_found",
+			Message: err.Error(),
+		})
+	case errors.Is(err, usecase.ErrInvalidBattleChallenge):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_challenge",
+			Message: err.Error(),
+		})
+	case errors.Is(err, usecase.ErrBattleChallengeNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "challenge_not_found",
+			Message: "battle challenge not found or not addressed to you",
+		})
+	case errors.Is(err, usecase.ErrBattleChallengeExpired):
+		c.JSON(http.StatusGone, dto.ErrorResponse{
+			Error:   "challenge_expired",
+			Message: err.Error(),
+		})
+	case errors.Is(err, usecase.ErrBattleChallengeAnswered):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "challenge_already_answered",
+			Message: err.Error(),
+		})
+	case errors.Is(err, usecase.ErrNotEnoughEnergy):
+		c.JSON(http.StatusTooManyRequests, dto.ErrorResponse{
+			Error:   "not_enough_energy",
+			Message: err.Error(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   fallbackCode,
+			Message: err.Error(),
+		})
+	}
+}
+
+// toBattleChallengeResponse converts a challenge output to its DTO
+func toBattleChallengeResponse(challenge usecase.BattleChallengeOutput) dto.BattleChallengeResponse {
+	return dto.BattleChallengeResponse{
+		ID:                    challenge.ID,
+		ChallengerCharacterID: challenge.ChallengerCharacterID,
+		OpponentCharacterID:   challenge.OpponentCharacterID,
+		Ranked:                challenge.Ranked,
+		Status:                challenge.Status,
+		BattleID:              challenge.BattleID,
+		CreatedAt:             challenge.CreatedAt,
+		ExpiresAt:             challenge.ExpiresAt,
+		RespondedAt:           challenge.RespondedAt,
+	}
+}
+
+// toBattleCombatantResponse converts a combatant output to its DTO
+func toBattleCombatantResponse(combatant usecase.BattleCombatantOutput) dto.BattleCombatantResponse {
+	return dto.BattleCombatantResponse{
+		Kind:       combatant.Kind,
+		ID:         combatant.ID,
+		Name:       combatant.Name,
+		Level:      combatant.Level,
+		HP:         combatant.HP,
+		Attack:     combatant.Attack,
+		Magic:      combatant.Magic,
+		Defense:    combatant.Defense,
+		Evasion:    combatant.Evasion,
+		Initiative: combatant.Initiative,
+	}
+}
+
+// toBattleActionResponses converts a battle log output to DTOs
+func toBattleActionResponses(actions []usecase.BattleActionOutput) []dto.BattleActionResponse {
+	responses := make([]dto.BattleActionResponse, len(actions))
+	for i, action := range actions {
+		responses[i] = dto.BattleActionResponse{
+			Round:    action.Round,
+			Actor:    action.Actor,
+			Attack:   action.Attack,
+			Dodged:   action.Dodged,
+			Critical: action.Critical,
+			Damage:   action.Damage,
+			TargetHP: action.TargetHP,
+		}
+	}
+	return responses
+}
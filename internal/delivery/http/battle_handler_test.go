@@ -0,0 +1,468 @@
+package http_test
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+	deliveryHttp "github.com/igor/chronotask-api/internal/delivery/http"
+	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Mock MonsterRepository
+type mockMonsterRepository struct {
+	monsters map[string]*entity.Monster
+}
+
+func (m *mockMonsterRepository) FindByID(ctx context.Context, id string) (*entity.Monster, error) {
+	if monster, ok := m.monsters[id]; ok {
+		return monster, nil
+	}
+	return nil, errors.New("monster not found")
+}
+
+func (m *mockMonsterRepository) FindAll(ctx context.Context) ([]*entity.Monster, error) {
+	return m.FindByLevel(ctx, 0)
+}
+
+// FindByLevel lists every monster when level is 0
+func (m *mockMonsterRepository) FindByLevel(ctx context.Context, level int) ([]*entity.Monster, error) {
+	var monsters []*entity.Monster
+	for _, monster := range m.monsters {
+		if level == 0 || (monster.MinLevel() <= level && monster.MaxLevel() >= level) {
+			monsters = append(monsters, monster)
+		}
+	}
+	slices.SortFunc(monsters, func(a, b *entity.Monster) int {
+		return cmp.Or(cmp.Compare(a.MinLevel(), b.MinLevel()), cmp.Compare(a.ID(), b.ID()))
+	})
+	return monsters, nil
+}
+
+// newTestRat creates the level 1 giant rat, which always drops its tail
+func newTestRat() *entity.Monster {
+	tail, _ := valueobject.NewLootDrop("Rabo de Rato", 100, 1)
+	return entity.ReconstituteMonster("rato-gigante", "Rato Gigante", 1, 1, map[string]int{valueobject.AttributeStrength: 1}, []valueobject.LootDrop{tail}, 15)
+}
+
+// Mock BattleRepository
+type mockBattleRepository struct {
+	battles map[string]*entity.Battle
+}
+
+func (m *mockBattleRepository) Create(ctx context.Context, battle *entity.Battle) error {
+	m.battles[battle.ID()] = battle
+	return nil
+}
+
+func (m *mockBattleRepository) FindByID(ctx context.Context, id string) (*entity.Battle, error) {
+	if battle, ok := m.battles[id]; ok {
+		return battle, nil
+	}
+	return nil, errors.New("battle not found")
+}
+
+// Mock BattleChallengeRepository
+type mockBattleChallengeRepository struct {
+	challenges map[string]*entity.BattleChallenge
+}
+
+func (m *mockBattleChallengeRepository) Create(ctx context.Context, challenge *entity.BattleChallenge) error {
+	m.challenges[challenge.ID()] = challenge
+	return nil
+}
+
+func (m *mockBattleChallengeRepository) FindByID(ctx context.Context, id string) (*entity.BattleChallenge, error) {
+	if challenge, ok := m.challenges[id]; ok {
+		return challenge, nil
+	}
+	return nil, errors.New("battle challenge not found")
+}
+
+func (m *mockBattleChallengeRepository) FindPendingByCharacterID(ctx context.Context, characterID string, now time.Time) ([]*entity.BattleChallenge, error) {
+	var challenges []*entity.BattleChallenge
+	for _, challenge := range m.challenges {
+		if challenge.IsParticipant(characterID) && challenge.Status(now) == entity.BattleChallengePending {
+			challenges = append(challenges, challenge)
+		}
+	}
+	return challenges, nil
+}
+
+func (m *mockBattleChallengeRepository) Respond(ctx context.Context, challenge *entity.BattleChallenge) error {
+	m.challenges[challenge.ID()] = challenge
+	return nil
+}
+
+// setupTestRouterForBattles builds the battle routes for test-user-123 (char-123, and char-321 that spent its energy)
+// Other users own char-456 and char-789; challenges holds the challenges already sent
+func setupTestRouterForBattles(challenges ...*entity.BattleChallenge) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.Default()
+
+	characters := map[string]*entity.Character{
+		"char-123": entity.ReconstituteCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), 10, 0, 0, 0, "test-user-123", time.Now()),
+		"char-456": entity.ReconstituteCharacter("char-456", "Rival", valueobject.DefaultCharacterClass(), 8, 0, 0, 0, "other-user-456", time.Now()),
+		"char-789": entity.ReconstituteCharacter("char-789", "Stranger", valueobject.DefaultCharacterClass(), 2, 0, 0, 0, "other-user-789", time.Now()),
+		"char-321": entity.ReconstituteCharacter("char-321", "Weary Knight", valueobject.DefaultCharacterClass(), 4, 0, 0, 0, "test-user-123", time.Now()),
+	}
+
+	charRepo := &mockCharacterRepositoryForAttributeTests{
+		findByIDFunc: func(ctx context.Context, id string) (*entity.Character, error) {
+			if character, ok := characters[id]; ok {
+				return character, nil
+			}
+			return nil, errors.New("character not found")
+		},
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Character, error) {
+			if character, ok := characters[id]; ok && character.UserID() == userID {
+				return character, nil
+			}
+			return nil, errors.New("character not found or does not belong to user")
+		},
+		updateFunc: func(ctx context.Context, character *entity.Character) error {
+			return nil
+		},
+	}
+
+	attrRepo := &mockCharacterAttributeRepository{
+		findByCharacterIDFunc: func(ctx context.Context, characterID string) ([]*entity.CharacterAttribute, error) {
+			var attributes []*entity.CharacterAttribute
+			for i, profile := range valueobject.DefaultCharacterClass().Attributes() {
+				attributes = append(attributes, entity.ReconstituteCharacterAttribute(i+1, profile.Name, 12, characterID, time.Now()))
+			}
+			return attributes, nil
+		},
+	}
+
+	monsterRepo := &mockMonsterRepository{monsters: map[string]*entity.Monster{
+		"rato-gigante": newTestRat(),
+	}}
+
+	battleRepo := &mockBattleRepository{battles: map[string]*entity.Battle{}}
+	challengeRepo := &mockBattleChallengeRepository{challenges: map[string]*entity.BattleChallenge{}}
+	for _, challenge := range challenges {
+		challengeRepo.challenges[challenge.ID()] = challenge
+	}
+	energyRepo := newMockCharacterEnergyRepository()
+	energyRepo.energies["char-321"] = entity.ReconstituteCharacterEnergy("char-321", 0, time.Now().UTC())
+
+	// Create handler
+	battleHandler := deliveryHttp.NewBattleHandler(
+		usecase.NewFightMonsterUseCase(charRepo, attrRepo, monsterRepo, battleRepo, energyRepo, &mockUnitOfWork{}),
+		usecase.NewCreateBattleChallengeUseCase(charRepo, challengeRepo, energyRepo, 24*time.Hour),
+		usecase.NewAcceptBattleChallengeUseCase(charRepo, attrRepo, battleRepo, challengeRepo, newMockCharacterRatingRepository(), energyRepo, &mockUnitOfWork{}, 24*time.Hour),
+		usecase.NewDeclineBattleChallengeUseCase(charRepo, challengeRepo),
+		usecase.NewListBattleChallengesUseCase(charRepo, challengeRepo),
+		usecase.NewGetBattleReplayUseCase(battleRepo, charRepo),
+	)
+
+	// Create auth middleware with mock JWT service
+	authMiddleware := middleware.NewAuthMiddleware(&mockJWTService{})
+
+	// Setup routes
+	v1 := router.Group("/api/v1")
+	{
+		authenticated := v1.Group("")
+		authenticated.Use(authMiddleware.RequireAuth())
+		{
+			authenticated.POST("/battle/pve", battleHandler.Pve)
+			authenticated.POST("/battle/challenge", battleHandler.CreateChallenge)
+			authenticated.POST("/battle/challenge/:id/accept", battleHandler.AcceptChallenge)
+			authenticated.POST("/battle/challenge/:id/decline", battleHandler.DeclineChallenge)
+			authenticated.GET("/character/:characterId/battle-challenges", battleHandler.ListChallenges)
+			authenticated.GET("/battle/:id/replay", battleHandler.Replay)
+		}
+	}
+
+	return router
+}
+
+func TestBattleHandler_Pve_Victory(t *testing.T) {
+	router := setupTestRouterForBattles()
+
+	w := performJSONRequest(router, "POST", "/api/v1/battle/pve", dto.FightMonsterRequest{CharacterID: "char-123", MonsterID: "rato-gigante"})
+	if w.Code != http.StatusOK {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response dto.FightMonsterResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	if !response.Victory || response.Winner != "challenger" || response.XpAwarded != 15 {
+		t.Errorf("response = %+v, want a victory worth 15 XP", response)
+	}
+	if len(response.Loot) != 1 || response.Loot[0].Item != "Rabo de Rato" {
+		t.Errorf("response.Loot = %+v, want the rat's tail", response.Loot)
+	}
+	if response.BattleID == "" || len(response.Actions) == 0 {
+		t.Error("response should carry the battle id and its log")
+	}
+	if response.Opponent.Kind != "monster" || response.Opponent.Name != "Rato Gigante" {
+		t.Errorf("response.Opponent = %+v, want the giant rat", response.Opponent)
+	}
+	if response.Energy != entity.MaxEnergy-entity.BattleEnergyCost {
+		t.Errorf("response.Energy = %d, want %d", response.Energy, entity.MaxEnergy-entity.BattleEnergyCost)
+	}
+}
+
+func TestBattleHandler_Pve_Errors(t *testing.T) {
+	router := setupTestRouterForBattles()
+
+	tests := []struct {
+		name string
+		body interface{}
+		want int
+	}{
+		{"missing monster", map[string]string{"characterId": "char-123"}, http.StatusBadRequest},
+		{"character of another user", dto.FightMonsterRequest{CharacterID: "char-456", MonsterID: "rato-gigante"}, http.StatusForbidden},
+		{"unknown monster", dto.FightMonsterRequest{CharacterID: "char-123", MonsterID: "dragao"}, http.StatusNotFound},
+		{"not enough energy", dto.FightMonsterRequest{CharacterID: "char-321", MonsterID: "rato-gigante"}, http.StatusTooManyRequests},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := performJSONRequest(router, "POST", "/api/v1/battle/pve", tt.body)
+			if w.Code != tt.want {
+				t.Errorf("Status code = %v, want %v (body: %s)", w.Code, tt.want, w.Body.String())
+			}
+		})
+	}
+}
+
+// newTestBattleChallenge creates a challenge sent an hour ago
+func newTestBattleChallenge(t *testing.T, id string, challengerID string, opponentID string, expiresIn time.Duration) *entity.BattleChallenge {
+	t.Helper()
+
+	challenge, err := entity.NewBattleChallenge(id, challengerID, opponentID, false, time.Now().UTC().Add(-time.Hour), expiresIn)
+	if err != nil {
+		t.Fatalf("NewBattleChallenge() error = %v, want nil", err)
+	}
+	return challenge
+}
+
+func TestBattleHandler_CreateChallenge_Success(t *testing.T) {
+	router := setupTestRouterForBattles()
+
+	w := performJSONRequest(router, "POST", "/api/v1/battle/challenge", dto.CreateBattleChallengeRequest{CharacterID: "char-123", OpponentCharacterID: "char-456", Ranked: true})
+	if w.Code != http.StatusCreated {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusCreated, w.Body.String())
+	}
+
+	var response dto.BattleChallengeResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	if response.ID == "" || response.Status != "pending" || response.OpponentCharacterID != "char-456" || !response.Ranked {
+		t.Errorf("response = %+v, want a pending ranked challenge to char-456", response)
+	}
+	if response.ExpiresAt == "" || response.BattleID != "" {
+		t.Errorf("response = %+v, want an expiration and no battle yet", response)
+	}
+}
+
+func TestBattleHandler_CreateChallenge_Errors(t *testing.T) {
+	router := setupTestRouterForBattles()
+
+	tests := []struct {
+		name string
+		body interface{}
+		want int
+	}{
+		{"missing opponent", map[string]string{"characterId": "char-123"}, http.StatusBadRequest},
+		{"character of another user", dto.CreateBattleChallengeRequest{CharacterID: "char-456", OpponentCharacterID: "char-789"}, http.StatusForbidden},
+		{"unknown opponent", dto.CreateBattleChallengeRequest{CharacterID: "char-123", OpponentCharacterID: "char-000"}, http.StatusNotFound},
+		{"self challenge", dto.CreateBattleChallengeRequest{CharacterID: "char-123", OpponentCharacterID: "char-123"}, http.StatusBadRequest},
+		{"not enough energy", dto.CreateBattleChallengeRequest{CharacterID: "char-321", OpponentCharacterID: "char-456"}, http.StatusTooManyRequests},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := performJSONRequest(router, "POST", "/api/v1/battle/challenge", tt.body)
+			if w.Code != tt.want {
+				t.Errorf("Status code = %v, want %v (body: %s)", w.Code, tt.want, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestBattleHandler_AcceptChallenge_Success(t *testing.T) {
+	router := setupTestRouterForBattles(newTestBattleChallenge(t, "challenge-1", "char-456", "char-123", 24*time.Hour))
+
+	w := performJSONRequest(router, "POST", "/api/v1/battle/challenge/challenge-1/accept", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response dto.AcceptBattleChallengeResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	if response.Challenge.Status != "accepted" || response.Challenge.BattleID != response.BattleID || response.BattleID == "" {
+		t.Errorf("response.Challenge = %+v, want accepted with the battle id", response.Challenge)
+	}
+	if response.Challenger.ID != "char-456" || response.Opponent.ID != "char-123" {
+		t.Errorf("combatants = %s vs %s, want char-456 vs char-123", response.Challenger.ID, response.Opponent.ID)
+	}
+	if response.Rounds == 0 || len(response.Actions) == 0 {
+		t.Error("response should carry the battle log")
+	}
+	if len(response.RatingChanges) != 0 {
+		t.Errorf("len(RatingChanges) = %d, want 0 for an unranked battle", len(response.RatingChanges))
+	}
+
+	// A challenge is only fought once
+	w = performJSONRequest(router, "POST", "/api/v1/battle/challenge/challenge-1/accept", nil)
+	if w.Code != http.StatusConflict {
+		t.Errorf("second accept status code = %v, want %v", w.Code, http.StatusConflict)
+	}
+}
+
+func TestBattleHandler_AcceptChallenge_Ranked(t *testing.T) {
+	challenge, err := entity.NewBattleChallenge("challenge-1", "char-456", "char-123", true, time.Now().UTC().Add(-time.Hour), 24*time.Hour)
+	if err != nil {
+		t.Fatalf("NewBattleChallenge() error = %v, want nil", err)
+	}
+	router := setupTestRouterForBattles(challenge)
+
+	w := performJSONRequest(router, "POST", "/api/v1/battle/challenge/challenge-1/accept", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response dto.AcceptBattleChallengeResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	if !response.Challenge.Ranked || len(response.RatingChanges) != 2 {
+		t.Fatalf("response = %+v, want a ranked challenge with 2 rating changes", response)
+	}
+
+	challenger, opponent := response.RatingChanges[0], response.RatingChanges[1]
+	if challenger.CharacterID != "char-456" || opponent.CharacterID != "char-123" || challenger.BattleID != response.BattleID {
+		t.Errorf("rating changes = %+v, want char-456 then char-123 for battle %s", response.RatingChanges, response.BattleID)
+	}
+	if challenger.RatingBefore != 1500 || opponent.RatingBefore != 1500 || challenger.Delta != -opponent.Delta {
+		t.Errorf("rating changes = %+v, want opposite deltas from 1500", response.RatingChanges)
+	}
+}
+
+func TestBattleHandler_AnswerChallenge_Errors(t *testing.T) {
+	router := setupTestRouterForBattles(
+		newTestBattleChallenge(t, "challenge-expired", "char-456", "char-123", 30*time.Minute),
+		newTestBattleChallenge(t, "challenge-between-others", "char-456", "char-789", 24*time.Hour),
+	)
+
+	tests := []struct {
+		name string
+		path string
+		want int
+	}{
+		{"accept unknown challenge", "/api/v1/battle/challenge/challenge-000/accept", http.StatusNotFound},
+		{"accept challenge sent to another user", "/api/v1/battle/challenge/challenge-between-others/accept", http.StatusNotFound},
+		{"accept expired challenge", "/api/v1/battle/challenge/challenge-expired/accept", http.StatusGone},
+		{"decline expired challenge", "/api/v1/battle/challenge/challenge-expired/decline", http.StatusGone},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := performJSONRequest(router, "POST", tt.path, nil)
+			if w.Code != tt.want {
+				t.Errorf("Status code = %v, want %v (body: %s)", w.Code, tt.want, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestBattleHandler_DeclineChallenge_Success(t *testing.T) {
+	router := setupTestRouterForBattles(newTestBattleChallenge(t, "challenge-1", "char-456", "char-123", 24*time.Hour))
+
+	w := performJSONRequest(router, "POST", "/api/v1/battle/challenge/challenge-1/decline", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response dto.BattleChallengeResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	if response.Status != "declined" || response.RespondedAt == "" || response.BattleID != "" {
+		t.Errorf("response = %+v, want declined without battle", response)
+	}
+}
+
+func TestBattleHandler_ListChallenges(t *testing.T) {
+	router := setupTestRouterForBattles(
+		newTestBattleChallenge(t, "challenge-1", "char-456", "char-123", 24*time.Hour),
+		newTestBattleChallenge(t, "challenge-expired", "char-789", "char-123", 30*time.Minute),
+	)
+
+	w := performJSONRequest(router, "GET", "/api/v1/character/char-123/battle-challenges", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response dto.GetBattleChallengesResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	if len(response.Challenges) != 1 || response.Challenges[0].ID != "challenge-1" {
+		t.Errorf("response.Challenges = %+v, want only challenge-1", response.Challenges)
+	}
+
+	w = performJSONRequest(router, "GET", "/api/v1/character/char-456/battle-challenges", nil)
+	if w.Code != http.StatusForbidden {
+		t.Errorf("Status code for another user's character = %v, want %v", w.Code, http.StatusForbidden)
+	}
+}
+
+func TestBattleHandler_Replay(t *testing.T) {
+	router := setupTestRouterForBattles(newTestBattleChallenge(t, "challenge-1", "char-456", "char-123", 24*time.Hour))
+
+	w := performJSONRequest(router, "POST", "/api/v1/battle/pve", dto.FightMonsterRequest{CharacterID: "char-123", MonsterID: "rato-gigante"})
+	var fight dto.FightMonsterResponse
+	json.Unmarshal(w.Body.Bytes(), &fight)
+
+	w = performJSONRequest(router, "GET", "/api/v1/battle/"+fight.BattleID+"/replay", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response struct {
+		dto.BattleReplayResponse
+		Log struct {
+			Version int     `json:"v"`
+			Seed    int64   `json:"seed"`
+			Actions [][]int `json:"actions"`
+		} `json:"log"`
+	}
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	if response.BattleID != fight.BattleID || response.Kind != "pve" {
+		t.Errorf("response = %+v, want the pve battle %s", response.BattleReplayResponse, fight.BattleID)
+	}
+	if response.Log.Version != 1 || response.Log.Seed != fight.Seed || len(response.Log.Actions) != len(fight.Actions) {
+		t.Errorf("log = %+v, want v1 with the seed and actions of the fight", response.Log)
+	}
+
+	// The rival who accepted a challenge can replay it too, strangers can't see any battle
+	w = performJSONRequest(router, "POST", "/api/v1/battle/challenge/challenge-1/accept", nil)
+	var accepted dto.AcceptBattleChallengeResponse
+	json.Unmarshal(w.Body.Bytes(), &accepted)
+
+	w = performJSONRequest(router, "GET", "/api/v1/battle/"+accepted.BattleID+"/replay", nil)
+	if w.Code != http.StatusOK {
+		t.Errorf("pvp replay status code = %v, want %v", w.Code, http.StatusOK)
+	}
+
+	w = performJSONRequest(router, "GET", "/api/v1/battle/battle-000/replay", nil)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("unknown battle status code = %v, want %v", w.Code, http.StatusNotFound)
+	}
+}
@@ -1,6 +1,7 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 	"strings"
 
@@ -12,15 +13,18 @@ import (
 
 // CharacterAttributeHandler handles character attribute-related HTTP requests
 type CharacterAttributeHandler struct {
-	getCharacterAttributesUseCase *usecase.GetCharacterAttributesUseCase
+	getCharacterAttributesUseCase  *usecase.GetCharacterAttributesUseCase
+	allocateAttributePointsUseCase *usecase.AllocateAttributePointsUseCase
 }
 
 // NewCharacterAttributeHandler creates a new CharacterAttributeHandler
 func NewCharacterAttributeHandler(
 	getCharacterAttributesUseCase *usecase.GetCharacterAttributesUseCase,
+	allocateAttributePointsUseCase *usecase.AllocateAttributePointsUseCase,
 ) *CharacterAttributeHandler {
 	return &CharacterAttributeHandler{
-		getCharacterAttributesUseCase: getCharacterAttributesUseCase,
+		getCharacterAttributesUseCase:  getCharacterAttributesUseCase,
+		allocateAttributePointsUseCase: allocateAttributePointsUseCase,
 	}
 }
 
@@ -71,21 +75,95 @@ func (h *CharacterAttributeHandler) GetByCharacterID(c *gin.Context) {
 		return
 	}
 
-	// Convert use case output to DTOs
-	attributeDTOs := make([]dto.CharacterAttributeResponse, len(output.Attributes))
-	for i, attr := range output.Attributes {
-		attributeDTOs[i] = dto.CharacterAttributeResponse{
-			ID:            attr.ID,
-			AttributeName: attr.AttributeName,
-			Value:         attr.Value,
-			CharacterID:   attr.CharacterID,
-			CreatedAt:     attr.CreatedAt,
+	// Return response
+	c.JSON(http.StatusOK, dto.GetCharacterAttributesResponse{
+		CharacterID: output.CharacterID,
+		Attributes:  toCharacterAttributeResponses(output.Attributes),
+	})
+}
+
+// Allocate handles POST /character/:characterId/attribute/allocate - distributes unspent attribute points
+// This is a protected route that requires authentication
+func (h *CharacterAttributeHandler) Allocate(c *gin.Context) {
+	var req dto.AllocateAttributePointsRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates character ownership and the attribute set)
+	output, err := h.allocateAttributePointsUseCase.Execute(c.Request.Context(), usecase.AllocateAttributePointsInput{
+		CharacterID: c.Param("characterId"),
+		UserID:      userID,
+		Points:      req,
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrCharacterNotFound):
+			c.JSON(http.StatusForbidden, dto.ErrorResponse{
+				Error:   "forbidden",
+				Message: "character not found or you are not authorized to access it",
+			})
+		case errors.Is(err, usecase.ErrInvalidAllocation):
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "invalid_request",
+				Message: err.Error(),
+			})
+		case errors.Is(err, usecase.ErrAttributeNotFound):
+			c.JSON(http.StatusUnprocessableEntity, dto.ErrorResponse{
+				Error:   "attribute_not_found",
+				Message: err.Error(),
+			})
+		case errors.Is(err, usecase.ErrNotEnoughAttributePoints):
+			c.JSON(http.StatusConflict, dto.ErrorResponse{
+				Error:   "not_enough_attribute_points",
+				Message: err.Error(),
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+				Error:   "failed_to_allocate_attribute_points",
+				Message: err.Error(),
+			})
 		}
+		return
 	}
 
 	// Return response
-	c.JSON(http.StatusOK, dto.GetCharacterAttributesResponse{
-		CharacterID: output.CharacterID,
-		Attributes:  attributeDTOs,
+	c.JSON(http.StatusOK, dto.AllocateAttributePointsResponse{
+		CharacterID:            output.CharacterID,
+		PointsSpent:            output.PointsSpent,
+		UnspentAttributePoints: output.UnspentAttributePoints,
+		Attributes:             toCharacterAttributeResponses(output.Attributes),
 	})
 }
+
+// toCharacterAttributeResponses converts use case attribute outputs to DTOs
+func toCharacterAttributeResponses(attributes []usecase.CharacterAttributeOutput) []dto.CharacterAttributeResponse {
+	attributeDTOs := make([]dto.CharacterAttributeResponse, len(attributes))
+	for i, attr := range attributes {
+		attributeDTOs[i] = dto.CharacterAttributeResponse{
+			ID:             attr.ID,
+			AttributeName:  attr.AttributeName,
+			Value:          attr.Value,
+			ClassBonus:     attr.ClassBonus,
+			EffectiveValue: attr.EffectiveValue,
+			CharacterID:    attr.CharacterID,
+			CreatedAt:      attr.CreatedAt,
+		}
+	}
+	return attributeDTOs
+}
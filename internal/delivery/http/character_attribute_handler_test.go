@@ -11,21 +11,30 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
 	deliveryHttp "github.com/igor/chronotask-api/internal/delivery/http"
 	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
 	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
 )
 
 // Mock CharacterAttributeRepository for E2E tests
 type mockCharacterAttributeRepository struct {
+	createFunc            func(ctx context.Context, attribute *entity.CharacterAttribute) error
 	findByCharacterIDFunc func(ctx context.Context, characterID string) ([]*entity.CharacterAttribute, error)
+	existsByNameFunc      func(ctx context.Context, characterID string, attributeName string) (bool, error)
+	findByNameFunc        func(ctx context.Context, characterID string, attributeName string) (*entity.CharacterAttribute, error)
+	updateFunc            func(ctx context.Context, attribute *entity.CharacterAttribute) error
 }
 
 func (m *mockCharacterAttributeRepository) Create(ctx context.Context, attribute *entity.CharacterAttribute) error {
+	if m.createFunc != nil {
+		return m.createFunc(ctx, attribute)
+	}
 	return errors.New("not implemented")
 }
 
-func (m *mockCharacterAttributeRepository) FindByID(ctx context.Context, id string) (*entity.CharacterAttribute, error) {
+func (m *mockCharacterAttributeRepository) FindByID(ctx context.Context, id int) (*entity.CharacterAttribute, error) {
 	return nil, errors.New("not implemented")
 }
 
@@ -37,18 +46,35 @@ func (m *mockCharacterAttributeRepository) FindByCharacterID(ctx context.Context
 }
 
 func (m *mockCharacterAttributeRepository) FindByCharacterIDAndName(ctx context.Context, characterID string, attributeName string) (*entity.CharacterAttribute, error) {
+	if m.findByNameFunc != nil {
+		return m.findByNameFunc(ctx, characterID, attributeName)
+	}
 	return nil, errors.New("not implemented")
 }
 
+func (m *mockCharacterAttributeRepository) FindByCharacterIDForUpdate(ctx context.Context, characterID string) ([]*entity.CharacterAttribute, error) {
+	return m.FindByCharacterID(ctx, characterID)
+}
+
+func (m *mockCharacterAttributeRepository) FindByCharacterIDAndNameForUpdate(ctx context.Context, characterID string, attributeName string) (*entity.CharacterAttribute, error) {
+	return m.FindByCharacterIDAndName(ctx, characterID, attributeName)
+}
+
 func (m *mockCharacterAttributeRepository) Update(ctx context.Context, attribute *entity.CharacterAttribute) error {
+	if m.updateFunc != nil {
+		return m.updateFunc(ctx, attribute)
+	}
 	return errors.New("not implemented")
 }
 
-func (m *mockCharacterAttributeRepository) Delete(ctx context.Context, id string) error {
+func (m *mockCharacterAttributeRepository) Delete(ctx context.Context, id int) error {
 	return errors.New("not implemented")
 }
 
 func (m *mockCharacterAttributeRepository) ExistsByCharacterIDAndName(ctx context.Context, characterID string, attributeName string) (bool, error) {
+	if m.existsByNameFunc != nil {
+		return m.existsByNameFunc(ctx, characterID, attributeName)
+	}
 	return false, errors.New("not implemented")
 }
 
@@ -56,6 +82,7 @@ func (m *mockCharacterAttributeRepository) ExistsByCharacterIDAndName(ctx contex
 type mockCharacterRepositoryForAttributeTests struct {
 	findByIDFunc          func(ctx context.Context, id string) (*entity.Character, error)
 	findByIDAndUserIDFunc func(ctx context.Context, id string, userID string) (*entity.Character, error)
+	updateFunc            func(ctx context.Context, character *entity.Character) error
 }
 
 func (m *mockCharacterRepositoryForAttributeTests) Create(ctx context.Context, character *entity.Character) error {
@@ -69,6 +96,10 @@ func (m *mockCharacterRepositoryForAttributeTests) FindByID(ctx context.Context,
 	return nil, errors.New("not found")
 }
 
+func (m *mockCharacterRepositoryForAttributeTests) FindByIDForUpdate(ctx context.Context, id string) (*entity.Character, error) {
+	return m.FindByID(ctx, id)
+}
+
 func (m *mockCharacterRepositoryForAttributeTests) FindByIDAndUserID(ctx context.Context, id string, userID string) (*entity.Character, error) {
 	if m.findByIDAndUserIDFunc != nil {
 		return m.findByIDAndUserIDFunc(ctx, id, userID)
@@ -81,6 +112,9 @@ func (m *mockCharacterRepositoryForAttributeTests) FindByUserID(ctx context.Cont
 }
 
 func (m *mockCharacterRepositoryForAttributeTests) Update(ctx context.Context, character *entity.Character) error {
+	if m.updateFunc != nil {
+		return m.updateFunc(ctx, character)
+	}
 	return errors.New("not implemented")
 }
 
@@ -102,11 +136,12 @@ func setupTestRouterForAttributes(charRepo *mockCharacterRepositoryForAttributeT
 
 	router := gin.Default()
 
-	// Create use case
+	// Create use cases
 	getAttributesUseCase := usecase.NewGetCharacterAttributesUseCase(charRepo, attrRepo)
+	allocatePointsUseCase := usecase.NewAllocateAttributePointsUseCase(charRepo, attrRepo, &mockUnitOfWork{})
 
 	// Create handler
-	attributeHandler := deliveryHttp.NewCharacterAttributeHandler(getAttributesUseCase)
+	attributeHandler := deliveryHttp.NewCharacterAttributeHandler(getAttributesUseCase, allocatePointsUseCase)
 
 	// Create auth middleware with mock JWT service
 	authMiddleware := middleware.NewAuthMiddleware(&mockJWTService{})
@@ -118,6 +153,7 @@ func setupTestRouterForAttributes(charRepo *mockCharacterRepositoryForAttributeT
 		authenticated.Use(authMiddleware.RequireAuth())
 		{
 			authenticated.GET("/character/:characterId/attribute", attributeHandler.GetByCharacterID)
+			authenticated.POST("/character/:characterId/attribute/allocate", attributeHandler.Allocate)
 		}
 	}
 
@@ -128,17 +164,19 @@ func TestCharacterAttributeHandler_GetByCharacterID_Success(t *testing.T) {
 	mockChar := entity.ReconstituteCharacter(
 		"char-123",
 		"Warrior King",
+		valueobject.DefaultCharacterClass(),
 		5,
 		50,
 		500,
+		0,
 		"test-user-123", // Must match JWT mock userID
 		time.Now(),
 	)
 
 	mockAttributes := []*entity.CharacterAttribute{
-		entity.ReconstituteCharacterAttribute("attr-1", "Strength", 10, "char-123", time.Now()),
-		entity.ReconstituteCharacterAttribute("attr-2", "Agility", 15, "char-123", time.Now()),
-		entity.ReconstituteCharacterAttribute("attr-3", "Intelligence", 20, "char-123", time.Now()),
+		entity.ReconstituteCharacterAttribute(1, "Strength", 10, "char-123", time.Now()),
+		entity.ReconstituteCharacterAttribute(2, "Agility", 15, "char-123", time.Now()),
+		entity.ReconstituteCharacterAttribute(3, "Intelligence", 20, "char-123", time.Now()),
 	}
 
 	mockCharRepo := &mockCharacterRepositoryForAttributeTests{
@@ -227,9 +265,11 @@ func TestCharacterAttributeHandler_GetByCharacterID_EmptyAttributes(t *testing.T
 	mockChar := entity.ReconstituteCharacter(
 		"char-123",
 		"Warrior King",
+		valueobject.DefaultCharacterClass(),
 		1,
 		0,
 		0,
+		0,
 		"test-user-123", // Must match JWT mock userID
 		time.Now(),
 	)
@@ -308,9 +348,11 @@ func TestCharacterAttributeHandler_GetByCharacterID_RepositoryError(t *testing.T
 	mockChar := entity.ReconstituteCharacter(
 		"char-123",
 		"Warrior King",
+		valueobject.DefaultCharacterClass(),
 		1,
 		0,
 		0,
+		0,
 		"test-user-123", // Same user as JWT token
 		time.Now(),
 	)
@@ -355,9 +397,11 @@ func TestCharacterAttributeHandler_GetByCharacterID_Forbidden(t *testing.T) {
 	mockChar := entity.ReconstituteCharacter(
 		"char-123",
 		"Warrior King",
+		valueobject.DefaultCharacterClass(),
 		1,
 		0,
 		0,
+		0,
 		"user-999", // Different user than token
 		time.Now(),
 	)
@@ -394,3 +438,86 @@ func TestCharacterAttributeHandler_GetByCharacterID_Forbidden(t *testing.T) {
 		t.Errorf("error = %v, want %v", response["error"], "forbidden")
 	}
 }
+
+// newAllocationRouter creates a test router where char-123 has 3 unspent points and Força/Destreza at 5
+func newAllocationRouter() *gin.Engine {
+	character := entity.ReconstituteCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), 2, 0, 100, 3, "test-user-123", time.Now())
+	attributes := []*entity.CharacterAttribute{
+		entity.ReconstituteCharacterAttribute(1, "Força", 5, "char-123", time.Now()),
+		entity.ReconstituteCharacterAttribute(2, "Destreza", 5, "char-123", time.Now()),
+	}
+
+	charRepo := &mockCharacterRepositoryForAttributeTests{
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Character, error) {
+			if id == "char-123" && userID == "test-user-123" {
+				return character, nil
+			}
+			return nil, errors.New("character not found or does not belong to user")
+		},
+		findByIDFunc: func(ctx context.Context, id string) (*entity.Character, error) {
+			return character, nil
+		},
+		updateFunc: func(ctx context.Context, character *entity.Character) error {
+			return nil
+		},
+	}
+
+	attrRepo := &mockCharacterAttributeRepository{
+		findByCharacterIDFunc: func(ctx context.Context, characterID string) ([]*entity.CharacterAttribute, error) {
+			return attributes, nil
+		},
+		updateFunc: func(ctx context.Context, attribute *entity.CharacterAttribute) error {
+			return nil
+		},
+	}
+
+	return setupTestRouterForAttributes(charRepo, attrRepo)
+}
+
+func TestCharacterAttributeHandler_Allocate_Success(t *testing.T) {
+	router := newAllocationRouter()
+
+	w := performJSONRequest(router, "POST", "/api/v1/character/char-123/attribute/allocate", map[string]int{"Força": 2, "Destreza": 1})
+	if w.Code != http.StatusOK {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response dto.AllocateAttributePointsResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	if response.PointsSpent != 3 || response.UnspentAttributePoints != 0 {
+		t.Errorf("spent/unspent = %d/%d, want 3/0", response.PointsSpent, response.UnspentAttributePoints)
+	}
+
+	values := map[string]int{}
+	for _, attr := range response.Attributes {
+		values[attr.AttributeName] = attr.Value
+	}
+	if values["Força"] != 7 || values["Destreza"] != 6 {
+		t.Errorf("attributes = %v, want Força 7 and Destreza 6", values)
+	}
+}
+
+func TestCharacterAttributeHandler_Allocate_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		body interface{}
+		want int
+	}{
+		{"character of another user", "/api/v1/character/char-456/attribute/allocate", map[string]int{"Força": 1}, http.StatusForbidden},
+		{"malformed body", "/api/v1/character/char-123/attribute/allocate", []string{"Força"}, http.StatusBadRequest},
+		{"negative points", "/api/v1/character/char-123/attribute/allocate", map[string]int{"Força": -1}, http.StatusBadRequest},
+		{"unknown attribute", "/api/v1/character/char-123/attribute/allocate", map[string]int{"Sorte": 1}, http.StatusUnprocessableEntity},
+		{"not enough points", "/api/v1/character/char-123/attribute/allocate", map[string]int{"Força": 4}, http.StatusConflict},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := performJSONRequest(newAllocationRouter(), "POST", tt.path, tt.body)
+			if w.Code != tt.want {
+				t.Errorf("Status code = %v, want %v (body: %s)", w.Code, tt.want, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+)
+
+// CharacterClassHandler handles character class-related HTTP requests
+type CharacterClassHandler struct {
+	listCharacterClassesUseCase *usecase.ListCharacterClassesUseCase
+}
+
+// NewCharacterClassHandler creates a new CharacterClassHandler
+func NewCharacterClassHandler(listCharacterClassesUseCase *usecase.ListCharacterClassesUseCase) *CharacterClassHandler {
+	return &CharacterClassHandler{
+		listCharacterClassesUseCase: listCharacterClassesUseCase,
+	}
+}
+
+// List handles GET /classes - lists the classes a character can be created with
+// This is a protected route that requires authentication
+func (h *CharacterClassHandler) List(c *gin.Context) {
+	// Execute use case
+	output, err := h.listCharacterClassesUseCase.Execute(c.Request.Context())
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "failed_to_fetch_classes",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Convert use case output to DTOs
+	classes := make([]dto.CharacterClassResponse, len(output.Classes))
+	for i, class := range output.Classes {
+		attributes := make([]dto.ClassAttributeResponse, len(class.Attributes))
+		for j, attribute := range class.Attributes {
+			attributes[j] = dto.ClassAttributeResponse{
+				Name:           attribute.Name,
+				Base:           attribute.Base,
+				GrowthPerLevel: attribute.GrowthPerLevel,
+			}
+		}
+
+		classes[i] = dto.CharacterClassResponse{
+			Class:       class.Class,
+			DisplayName: class.DisplayName,
+			Description: class.Description,
+			Attributes:  attributes,
+		}
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, dto.ListCharacterClassesResponse{
+		Classes: classes,
+	})
+}
@@ -0,0 +1,45 @@
+package http_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+	deliveryHttp "github.com/igor/chronotask-api/internal/delivery/http"
+	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
+)
+
+func TestCharacterClassHandler_List(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.Default()
+	characterClassHandler := deliveryHttp.NewCharacterClassHandler(usecase.NewListCharacterClassesUseCase())
+	authMiddleware := middleware.NewAuthMiddleware(&mockJWTService{})
+
+	authenticated := router.Group("/api/v1")
+	authenticated.Use(authMiddleware.RequireAuth())
+	authenticated.GET("/classes", characterClassHandler.List)
+
+	w := performJSONRequest(router, "GET", "/api/v1/classes", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response dto.ListCharacterClassesResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	if len(response.Classes) != 4 {
+		t.Fatalf("len(classes) = %v, want 4", len(response.Classes))
+	}
+
+	warrior := response.Classes[0]
+	if warrior.Class != "guerreiro" || warrior.DisplayName != "Guerreiro" || len(warrior.Attributes) != 7 {
+		t.Errorf("classes[0] = %+v, want the warrior with 7 attributes", warrior)
+	}
+	if warrior.Attributes[0].Name != "Força" || warrior.Attributes[0].Base != 8 || warrior.Attributes[0].GrowthPerLevel != 1 {
+		t.Errorf("warrior Força = %+v, want base 8 growing 1 per level", warrior.Attributes[0])
+	}
+}
@@ -53,6 +53,7 @@ func (h *CharacterHandler) Create(c *gin.Context) {
 	// Execute use case
 	output, err := h.createCharacterUseCase.Execute(c.Request.Context(), usecase.CreateCharacterInput{
 		Name:   req.Name,
+		Class:  req.Class,
 		UserID: userID,
 	})
 
@@ -78,6 +79,7 @@ func (h *CharacterHandler) Create(c *gin.Context) {
 	c.JSON(http.StatusCreated, dto.CreateCharacterResponse{
 		ID:        output.ID,
 		Name:      output.Name,
+		Class:     output.Class,
 		Level:     output.Level,
 		CurrentXp: output.CurrentXp,
 		TotalXp:   output.TotalXp,
@@ -116,12 +118,14 @@ func (h *CharacterHandler) GetList(c *gin.Context) {
 	characterDTOs := make([]dto.CharacterItemResponse, len(output.Characters))
 	for i, char := range output.Characters {
 		characterDTOs[i] = dto.CharacterItemResponse{
-			ID:        char.ID,
-			Name:      char.Name,
-			Level:     char.Level,
-			CurrentXp: char.CurrentXp,
-			TotalXp:   char.TotalXp,
-			CreatedAt: char.CreatedAt,
+			ID:                     char.ID,
+			Name:                   char.Name,
+			Class:                  char.Class,
+			Level:                  char.Level,
+			CurrentXp:              char.CurrentXp,
+			TotalXp:                char.TotalXp,
+			UnspentAttributePoints: char.UnspentAttributePoints,
+			CreatedAt:              char.CreatedAt,
 		}
 	}
 
@@ -13,9 +13,11 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/igor/chronotask-api/internal/application/port"
 	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
 	deliveryHttp "github.com/igor/chronotask-api/internal/delivery/http"
 	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
 	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
 )
 
 // Mock CharacterRepository for E2E tests
@@ -36,6 +38,10 @@ func (m *mockCharacterRepository) FindByID(ctx context.Context, id string) (*ent
 	return nil, errors.New("not implemented")
 }
 
+func (m *mockCharacterRepository) FindByIDForUpdate(ctx context.Context, id string) (*entity.Character, error) {
+	return nil, errors.New("not implemented")
+}
+
 func (m *mockCharacterRepository) FindByIDAndUserID(ctx context.Context, id string, userID string) (*entity.Character, error) {
 	return nil, errors.New("not implemented")
 }
@@ -66,6 +72,13 @@ func (m *mockCharacterRepository) ExistsByUserID(ctx context.Context, userID str
 	return false, nil
 }
 
+// Mock UnitOfWork for E2E tests (runs fn without a transaction)
+type mockUnitOfWork struct{}
+
+func (m *mockUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
 // Mock JWT Service for testing
 type mockJWTService struct{}
 
@@ -98,7 +111,12 @@ func setupTestRouter(charRepo *mockCharacterRepository) *gin.Engine {
 	router := gin.Default()
 
 	// Create use cases
-	createCharacterUseCase := usecase.NewCreateCharacterUseCase(charRepo)
+	attrRepo := &mockCharacterAttributeRepository{
+		createFunc: func(ctx context.Context, attribute *entity.CharacterAttribute) error {
+			return nil
+		},
+	}
+	createCharacterUseCase := usecase.NewCreateCharacterUseCase(charRepo, attrRepo, &mockUnitOfWork{})
 	getUserCharactersUseCase := usecase.NewGetUserCharactersUseCase(charRepo)
 
 	// Create handler
@@ -348,18 +366,22 @@ func TestCharacterHandler_GetList_Success_MultipleCharacters(t *testing.T) {
 	char1 := entity.ReconstituteCharacter(
 		"char-1",
 		"Warrior",
+		valueobject.DefaultCharacterClass(),
 		5,
 		50,
 		500,
+		0,
 		"test-user-123",
 		time.Now(),
 	)
 	char2 := entity.ReconstituteCharacter(
 		"char-2",
 		"Mage",
+		valueobject.DefaultCharacterClass(),
 		3,
 		30,
 		300,
+		0,
 		"test-user-123",
 		time.Now(),
 	)
@@ -507,3 +529,40 @@ func TestCharacterHandler_GetList_RepositoryError(t *testing.T) {
 		t.Errorf("error = %v, want %v", response["error"], "failed_to_fetch_characters")
 	}
 }
+
+func TestCharacterHandler_Create_WithClass(t *testing.T) {
+	mockRepo := &mockCharacterRepository{
+		existsByUserIDFunc: func(ctx context.Context, userID string) (bool, error) {
+			return false, nil
+		},
+	}
+
+	router := setupTestRouter(mockRepo)
+
+	w := performJSONRequest(router, "POST", "/api/v1/character", map[string]string{"name": "Conan", "class": "guerreiro"})
+	if w.Code != http.StatusCreated {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusCreated, w.Body.String())
+	}
+
+	var response dto.CreateCharacterResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	if response.Class != "guerreiro" {
+		t.Errorf("response class = %v, want guerreiro", response.Class)
+	}
+}
+
+func TestCharacterHandler_Create_InvalidClass(t *testing.T) {
+	mockRepo := &mockCharacterRepository{
+		existsByUserIDFunc: func(ctx context.Context, userID string) (bool, error) {
+			return false, nil
+		},
+	}
+
+	router := setupTestRouter(mockRepo)
+
+	w := performJSONRequest(router, "POST", "/api/v1/character", map[string]string{"name": "Conan", "class": "necromante"})
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("Status code = %v, want %v (body: %s)", w.Code, http.StatusUnprocessableEntity, w.Body.String())
+	}
+}
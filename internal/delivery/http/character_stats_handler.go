@@ -0,0 +1,72 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
+)
+
+// CharacterStatsHandler handles character combat stats HTTP requests
+type CharacterStatsHandler struct {
+	getCharacterStatsUseCase *usecase.GetCharacterStatsUseCase
+}
+
+// NewCharacterStatsHandler creates a new CharacterStatsHandler
+func NewCharacterStatsHandler(getCharacterStatsUseCase *usecase.GetCharacterStatsUseCase) *CharacterStatsHandler {
+	return &CharacterStatsHandler{
+		getCharacterStatsUseCase: getCharacterStatsUseCase,
+	}
+}
+
+// GetByCharacterID handles GET /character/:characterId/stats - derives the character's combat stats
+// This is a protected route that requires authentication
+func (h *CharacterStatsHandler) GetByCharacterID(c *gin.Context) {
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates character ownership)
+	output, err := h.getCharacterStatsUseCase.Execute(c.Request.Context(), usecase.GetCharacterStatsInput{
+		CharacterID: c.Param("characterId"),
+		UserID:      userID,
+	})
+
+	if err != nil {
+		if errors.Is(err, usecase.ErrCharacterNotFound) {
+			c.JSON(http.StatusForbidden, dto.ErrorResponse{
+				Error:   "forbidden",
+				Message: "character not found or you are not authorized to access it",
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "failed_to_fetch_character_stats",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, dto.CharacterStatsResponse{
+		CharacterID: output.CharacterID,
+		Class:       output.Class,
+		Level:       output.Level,
+		HP:          output.HP,
+		Attack:      output.Attack,
+		Magic:       output.Magic,
+		Defense:     output.Defense,
+		Evasion:     output.Evasion,
+		Initiative:  output.Initiative,
+	})
+}
@@ -0,0 +1,87 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+	deliveryHttp "github.com/igor/chronotask-api/internal/delivery/http"
+	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+func setupTestRouterForStats() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.Default()
+
+	charRepo := &mockCharacterRepositoryForAttributeTests{
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Character, error) {
+			if id == "char-123" && userID == "test-user-123" {
+				return entity.ReconstituteCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), 1, 0, 0, 0, "test-user-123", time.Now()), nil
+			}
+			return nil, errors.New("character not found or does not belong to user")
+		},
+	}
+
+	attrRepo := &mockCharacterAttributeRepository{
+		findByCharacterIDFunc: func(ctx context.Context, characterID string) ([]*entity.CharacterAttribute, error) {
+			return []*entity.CharacterAttribute{
+				entity.ReconstituteCharacterAttribute(1, valueobject.AttributeConstitution, 5, "char-123", time.Now()),
+			}, nil
+		},
+	}
+
+	// Create handler
+	characterStatsHandler := deliveryHttp.NewCharacterStatsHandler(usecase.NewGetCharacterStatsUseCase(charRepo, attrRepo))
+
+	// Create auth middleware with mock JWT service
+	authMiddleware := middleware.NewAuthMiddleware(&mockJWTService{})
+
+	// Setup routes
+	v1 := router.Group("/api/v1")
+	{
+		authenticated := v1.Group("")
+		authenticated.Use(authMiddleware.RequireAuth())
+		{
+			authenticated.GET("/character/:characterId/stats", characterStatsHandler.GetByCharacterID)
+		}
+	}
+
+	return router
+}
+
+func TestCharacterStatsHandler_GetByCharacterID(t *testing.T) {
+	router := setupTestRouterForStats()
+
+	w := performJSONRequest(router, "GET", "/api/v1/character/char-123/stats", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response dto.CharacterStatsResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	if response.CharacterID != "char-123" || response.Class != "aventureiro" || response.Level != 1 {
+		t.Errorf("response = %+v, want char-123 adventurer at level 1", response)
+	}
+	if response.HP != 105 {
+		t.Errorf("response.HP = %v, want 105", response.HP)
+	}
+}
+
+func TestCharacterStatsHandler_GetByCharacterID_OtherUsersCharacter(t *testing.T) {
+	router := setupTestRouterForStats()
+
+	w := performJSONRequest(router, "GET", "/api/v1/character/char-456/stats", nil)
+	if w.Code != http.StatusForbidden {
+		t.Errorf("Status code = %v, want %v", w.Code, http.StatusForbidden)
+	}
+}
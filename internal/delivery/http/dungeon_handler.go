@@ -0,0 +1,211 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
+)
+
+// DungeonHandler handles dungeon run-related HTTP requests
+type DungeonHandler struct {
+	startDungeonUseCase   *usecase.StartDungeonUseCase
+	advanceDungeonUseCase *usecase.AdvanceDungeonUseCase
+	abandonDungeonUseCase *usecase.AbandonDungeonUseCase
+}
+
+// NewDungeonHandler creates a new DungeonHandler
+func NewDungeonHandler(
+	startDungeonUseCase *usecase.StartDungeonUseCase,
+	advanceDungeonUseCase *usecase.AdvanceDungeonUseCase,
+	abandonDungeonUseCase *usecase.AbandonDungeonUseCase,
+) *DungeonHandler {
+	return &DungeonHandler{
+		startDungeonUseCase:   startDungeonUseCase,
+		advanceDungeonUseCase: advanceDungeonUseCase,
+		abandonDungeonUseCase: abandonDungeonUseCase,
+	}
+}
+
+// Start handles POST /dungeon/:id/start - enters a dungeon with one of the user's characters
+// Starting the dungeon the character is already in resumes its run (200) instead of creating one (201)
+// This is a protected route that requires authentication
+func (h *DungeonHandler) Start(c *gin.Context) {
+	input, ok := bindDungeonRunInput(c)
+	if !ok {
+		return
+	}
+
+	// Execute use case (it validates character ownership)
+	output, err := h.startDungeonUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		respondDungeonError(c, err, "failed_to_start_dungeon")
+		return
+	}
+
+	// Return response
+	status := http.StatusCreated
+	if output.Resumed {
+		status = http.StatusOK
+	}
+	c.JSON(status, dto.StartDungeonResponse{
+		Run:       toDungeonRunResponse(output.Run),
+		Resumed:   output.Resumed,
+		EntryCost: output.EntryCost,
+	})
+}
+
+// Advance handles POST /dungeon/:id/advance - fights the next stage of the character's run
+// This is a protected route that requires authentication
+func (h *DungeonHandler) Advance(c *gin.Context) {
+	input, ok := bindDungeonRunInput(c)
+	if !ok {
+		return
+	}
+
+	// Execute use case (it validates character ownership)
+	output, err := h.advanceDungeonUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		respondDungeonError(c, err, "failed_to_advance_dungeon")
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, dto.AdvanceDungeonResponse{
+		Run:          toDungeonRunResponse(output.Run),
+		BattleID:     output.BattleID,
+		Seed:         output.Seed,
+		Challenger:   toBattleCombatantResponse(output.Challenger),
+		Opponent:     toBattleCombatantResponse(output.Opponent),
+		Winner:       output.Winner,
+		Victory:      output.Victory,
+		Rounds:       output.Rounds,
+		ChallengerHP: output.ChallengerHP,
+		OpponentHP:   output.OpponentHP,
+		Retreated:    output.Retreated,
+		XpAwarded:    output.XpAwarded,
+		LevelsGained: output.LevelsGained,
+		Loot:         toLootDropResponses(output.Loot),
+		Actions:      toBattleActionResponses(output.Actions),
+		Energy:       output.Energy,
+	})
+}
+
+// Abandon handles POST /dungeon/:id/abandon - gives up the character's run (the entry cost is not refunded)
+// This is a protected route that requires authentication
+func (h *DungeonHandler) Abandon(c *gin.Context) {
+	input, ok := bindDungeonRunInput(c)
+	if !ok {
+		return
+	}
+
+	// Execute use case (it validates character ownership)
+	output, err := h.abandonDungeonUseCase.Execute(c.Request.Context(), input)
+	if err != nil {
+		respondDungeonError(c, err, "failed_to_abandon_dungeon")
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, toDungeonRunResponse(*output))
+}
+
+// bindDungeonRunInput binds the request and the authenticated user into a use case input
+// Writes the error response and returns false when the request can't be handled
+func bindDungeonRunInput(c *gin.Context) (usecase.DungeonRunInput, bool) {
+	var req dto.DungeonRunRequest
+
+	// Bind and validate request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return usecase.DungeonRunInput{}, false
+	}
+
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return usecase.DungeonRunInput{}, false
+	}
+
+	return usecase.DungeonRunInput{
+		DungeonID:   c.Param("id"),
+		CharacterID: req.CharacterID,
+		UserID:      userID,
+	}, true
+}
+
+// respondDungeonError maps dungeon use case errors to HTTP responses
+func respondDungeonError(c *gin.Context, err error, fallbackCode string) {
+	switch {
+	case errors.Is(err, usecase.ErrCharacterNotFound):
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{
+			Error:   "forbidden",
+			Message: "character not found or you are not authorized to access it",
+		})
+	case errors.Is(err, usecase.ErrDungeonNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "dungeon_not_found",
+			Message: err.Error(),
+		})
+	case errors.Is(err, usecase.ErrDungeonRunNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "dungeon_run_not_found",
+			Message: "the character has no run in progress in this dungeon",
+		})
+	case errors.Is(err, usecase.ErrDungeonLevelTooLow):
+		c.JSON(http.StatusUnprocessableEntity, dto.ErrorResponse{
+			Error:   "level_too_low",
+			Message: err.Error(),
+		})
+	case errors.Is(err, usecase.ErrNotEnoughXpForEntry):
+		c.JSON(http.StatusUnprocessableEntity, dto.ErrorResponse{
+			Error:   "not_enough_xp",
+			Message: err.Error(),
+		})
+	case errors.Is(err, usecase.ErrDungeonRunInProgress):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "dungeon_run_in_progress",
+			Message: err.Error(),
+		})
+	case errors.Is(err, usecase.ErrNotEnoughEnergy):
+		c.JSON(http.StatusTooManyRequests, dto.ErrorResponse{
+			Error:   "not_enough_energy",
+			Message: err.Error(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   fallbackCode,
+			Message: err.Error(),
+		})
+	}
+}
+
+// toDungeonRunResponse converts a dungeon run output to its DTO
+func toDungeonRunResponse(run usecase.DungeonRunOutput) dto.DungeonRunResponse {
+	return dto.DungeonRunResponse{
+		ID:            run.ID,
+		DungeonID:     run.DungeonID,
+		DungeonName:   run.DungeonName,
+		CharacterID:   run.CharacterID,
+		Status:        run.Status,
+		StagesCleared: run.StagesCleared,
+		TotalStages:   run.TotalStages,
+		HP:            run.HP,
+		MaxHP:         run.MaxHP,
+		Checkpoint:    run.Checkpoint,
+		NextMonsterID: run.NextMonsterID,
+		StartedAt:     run.StartedAt,
+		UpdatedAt:     run.UpdatedAt,
+		EndedAt:       run.EndedAt,
+	}
+}
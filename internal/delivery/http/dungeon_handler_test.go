@@ -0,0 +1,244 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+	deliveryHttp "github.com/igor/chronotask-api/internal/delivery/http"
+	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Mock DungeonRepository
+type mockDungeonRepository struct {
+	dungeons map[string]*entity.Dungeon
+}
+
+func (m *mockDungeonRepository) FindByID(ctx context.Context, id string) (*entity.Dungeon, error) {
+	if dungeon, ok := m.dungeons[id]; ok {
+		return dungeon, nil
+	}
+	return nil, errors.New("dungeon not found")
+}
+
+// Mock DungeonRunRepository
+type mockDungeonRunRepository struct {
+	runs map[string]*entity.DungeonRun
+}
+
+func (m *mockDungeonRunRepository) Create(ctx context.Context, run *entity.DungeonRun) error {
+	m.runs[run.ID()] = run
+	return nil
+}
+
+func (m *mockDungeonRunRepository) Update(ctx context.Context, run *entity.DungeonRun) error {
+	m.runs[run.ID()] = run
+	return nil
+}
+
+func (m *mockDungeonRunRepository) FindInProgressByCharacterID(ctx context.Context, characterID string) (*entity.DungeonRun, error) {
+	for _, run := range m.runs {
+		if run.CharacterID() == characterID && run.IsInProgress() {
+			return run, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockDungeonRunRepository) FindInProgressByCharacterIDForUpdate(ctx context.Context, characterID string) (*entity.DungeonRun, error) {
+	return m.FindInProgressByCharacterID(ctx, characterID)
+}
+
+// setupTestRouterForDungeons builds the dungeon routes for test-user-123 (char-123, level 10 with 100 XP)
+// toca-dos-ratos is two giant rats, costs 10 XP and rewards 50 XP; cripta-esquecida requires level 20
+func setupTestRouterForDungeons() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.Default()
+
+	characters := map[string]*entity.Character{
+		"char-123": entity.ReconstituteCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), 10, 100, 100, 0, "test-user-123", time.Now()),
+		"char-456": entity.ReconstituteCharacter("char-456", "Rival", valueobject.DefaultCharacterClass(), 8, 100, 100, 0, "other-user-456", time.Now()),
+	}
+
+	charRepo := &mockCharacterRepositoryForAttributeTests{
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Character, error) {
+			if character, ok := characters[id]; ok && character.UserID() == userID {
+				return character, nil
+			}
+			return nil, errors.New("character not found or does not belong to user")
+		},
+		findByIDFunc: func(ctx context.Context, id string) (*entity.Character, error) {
+			if character, ok := characters[id]; ok {
+				return character, nil
+			}
+			return nil, errors.New("character not found")
+		},
+		updateFunc: func(ctx context.Context, character *entity.Character) error {
+			return nil
+		},
+	}
+
+	attrRepo := &mockCharacterAttributeRepository{
+		findByCharacterIDFunc: func(ctx context.Context, characterID string) ([]*entity.CharacterAttribute, error) {
+			var attributes []*entity.CharacterAttribute
+			for i, profile := range valueobject.DefaultCharacterClass().Attributes() {
+				attributes = append(attributes, entity.ReconstituteCharacterAttribute(i+1, profile.Name, 12, characterID, time.Now()))
+			}
+			return attributes, nil
+		},
+	}
+
+	monsterRepo := &mockMonsterRepository{monsters: map[string]*entity.Monster{
+		"rato-gigante": newTestRat(),
+	}}
+
+	dungeonRepo := &mockDungeonRepository{dungeons: map[string]*entity.Dungeon{
+		"toca-dos-ratos": entity.ReconstituteDungeon("toca-dos-ratos", "Toca dos Ratos", 1, 10, 50, nil, []entity.DungeonStage{
+			{MonsterID: "rato-gigante", Checkpoint: true},
+			{MonsterID: "rato-gigante"},
+		}),
+		"cripta-esquecida": entity.ReconstituteDungeon("cripta-esquecida", "Cripta Esquecida", 20, 40, 300, nil, []entity.DungeonStage{
+			{MonsterID: "rato-gigante"},
+		}),
+	}}
+	runRepo := &mockDungeonRunRepository{runs: map[string]*entity.DungeonRun{}}
+
+	// Create handler
+	dungeonHandler := deliveryHttp.NewDungeonHandler(
+		usecase.NewStartDungeonUseCase(charRepo, attrRepo, dungeonRepo, runRepo, &mockUnitOfWork{}),
+		usecase.NewAdvanceDungeonUseCase(charRepo, attrRepo, monsterRepo, &mockBattleRepository{battles: map[string]*entity.Battle{}}, dungeonRepo, runRepo, newMockCharacterEnergyRepository(), &mockUnitOfWork{}),
+		usecase.NewAbandonDungeonUseCase(charRepo, attrRepo, dungeonRepo, runRepo, &mockUnitOfWork{}),
+	)
+
+	// Create auth middleware with mock JWT service
+	authMiddleware := middleware.NewAuthMiddleware(&mockJWTService{})
+
+	// Setup routes
+	v1 := router.Group("/api/v1")
+	{
+		authenticated := v1.Group("")
+		authenticated.Use(authMiddleware.RequireAuth())
+		{
+			authenticated.POST("/dungeon/:id/start", dungeonHandler.Start)
+			authenticated.POST("/dungeon/:id/advance", dungeonHandler.Advance)
+			authenticated.POST("/dungeon/:id/abandon", dungeonHandler.Abandon)
+		}
+	}
+
+	return router
+}
+
+func TestDungeonHandler_ClearDungeon(t *testing.T) {
+	router := setupTestRouterForDungeons()
+	body := dto.DungeonRunRequest{CharacterID: "char-123"}
+
+	w := performJSONRequest(router, "POST", "/api/v1/dungeon/toca-dos-ratos/start", body)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusCreated, w.Body.String())
+	}
+
+	var start dto.StartDungeonResponse
+	json.Unmarshal(w.Body.Bytes(), &start)
+
+	if start.Resumed || start.EntryCost != 10 || start.Run.Status != "in_progress" || start.Run.TotalStages != 2 || start.Run.NextMonsterID != "rato-gigante" {
+		t.Errorf("start = %+v, want a new run paying 10 XP", start)
+	}
+
+	// Starting it again resumes the run
+	w = performJSONRequest(router, "POST", "/api/v1/dungeon/toca-dos-ratos/start", body)
+	var resumed dto.StartDungeonResponse
+	json.Unmarshal(w.Body.Bytes(), &resumed)
+	if w.Code != http.StatusOK || !resumed.Resumed || resumed.Run.ID != start.Run.ID {
+		t.Errorf("Status code = %v, resumed = %+v, want run %s resumed", w.Code, resumed, start.Run.ID)
+	}
+
+	// First stage (a checkpoint)
+	w = performJSONRequest(router, "POST", "/api/v1/dungeon/toca-dos-ratos/advance", body)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+	var first dto.AdvanceDungeonResponse
+	json.Unmarshal(w.Body.Bytes(), &first)
+	if !first.Victory || first.BattleID == "" || first.Run.StagesCleared != 1 || first.Run.Checkpoint != 1 || len(first.Actions) == 0 {
+		t.Errorf("first = %+v, want stage 1 cleared", first.Run)
+	}
+
+	// Last stage
+	w = performJSONRequest(router, "POST", "/api/v1/dungeon/toca-dos-ratos/advance", body)
+	var last dto.AdvanceDungeonResponse
+	json.Unmarshal(w.Body.Bytes(), &last)
+	if w.Code != http.StatusOK || last.Run.Status != "completed" || last.XpAwarded != 50 || last.Run.EndedAt == "" {
+		t.Errorf("Status code = %v, last = %+v, want the dungeon cleared for 50 XP", w.Code, last)
+	}
+
+	// The run is over
+	w = performJSONRequest(router, "POST", "/api/v1/dungeon/toca-dos-ratos/advance", body)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Status code = %v, want %v", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestDungeonHandler_Abandon(t *testing.T) {
+	router := setupTestRouterForDungeons()
+	body := dto.DungeonRunRequest{CharacterID: "char-123"}
+
+	w := performJSONRequest(router, "POST", "/api/v1/dungeon/toca-dos-ratos/start", body)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusCreated, w.Body.String())
+	}
+
+	// Another dungeon can't be started meanwhile
+	w = performJSONRequest(router, "POST", "/api/v1/dungeon/cripta-esquecida/start", body)
+	if w.Code != http.StatusConflict {
+		t.Errorf("Status code = %v, want %v", w.Code, http.StatusConflict)
+	}
+
+	w = performJSONRequest(router, "POST", "/api/v1/dungeon/toca-dos-ratos/abandon", body)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+	var run dto.DungeonRunResponse
+	json.Unmarshal(w.Body.Bytes(), &run)
+	if run.Status != "abandoned" || run.EndedAt == "" {
+		t.Errorf("run = %+v, want an abandoned run", run)
+	}
+
+	w = performJSONRequest(router, "POST", "/api/v1/dungeon/toca-dos-ratos/abandon", body)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Status code = %v, want %v", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestDungeonHandler_Start_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		body interface{}
+		want int
+	}{
+		{"missing character", "/api/v1/dungeon/toca-dos-ratos/start", map[string]string{}, http.StatusBadRequest},
+		{"character of another user", "/api/v1/dungeon/toca-dos-ratos/start", dto.DungeonRunRequest{CharacterID: "char-456"}, http.StatusForbidden},
+		{"unknown dungeon", "/api/v1/dungeon/masmorra/start", dto.DungeonRunRequest{CharacterID: "char-123"}, http.StatusNotFound},
+		{"level too low", "/api/v1/dungeon/cripta-esquecida/start", dto.DungeonRunRequest{CharacterID: "char-123"}, http.StatusUnprocessableEntity},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := setupTestRouterForDungeons()
+
+			w := performJSONRequest(router, "POST", tt.path, tt.body)
+			if w.Code != tt.want {
+				t.Errorf("Status code = %v, want %v (body: %s)", w.Code, tt.want, w.Body.String())
+			}
+		})
+	}
+}
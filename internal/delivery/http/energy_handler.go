@@ -0,0 +1,71 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
+)
+
+// EnergyHandler handles battle energy-related HTTP requests
+type EnergyHandler struct {
+	getCharacterEnergyUseCase *usecase.GetCharacterEnergyUseCase
+}
+
+// NewEnergyHandler creates a new EnergyHandler
+func NewEnergyHandler(getCharacterEnergyUseCase *usecase.GetCharacterEnergyUseCase) *EnergyHandler {
+	return &EnergyHandler{
+		getCharacterEnergyUseCase: getCharacterEnergyUseCase,
+	}
+}
+
+// GetByCharacterID handles GET /character/:characterId/energy - gets a character's battle energy and when it regenerates
+// This is a protected route that requires authentication
+func (h *EnergyHandler) GetByCharacterID(c *gin.Context) {
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates character ownership)
+	output, err := h.getCharacterEnergyUseCase.Execute(c.Request.Context(), usecase.GetCharacterEnergyInput{
+		CharacterID: c.Param("characterId"),
+		UserID:      userID,
+	})
+
+	if err != nil {
+		if errors.Is(err, usecase.ErrCharacterNotFound) {
+			c.JSON(http.StatusForbidden, dto.ErrorResponse{
+				Error:   "forbidden",
+				Message: "character not found or you are not authorized to access it",
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "failed_to_fetch_energy",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, dto.CharacterEnergyResponse{
+		CharacterID:          output.CharacterID,
+		Energy:               output.Energy,
+		MaxEnergy:            output.MaxEnergy,
+		BattleCost:           output.BattleCost,
+		HabitCompletionBonus: output.HabitCompletionBonus,
+		RegenIntervalSeconds: output.RegenIntervalSeconds,
+		NextPointAt:          output.NextPointAt,
+		FullAt:               output.FullAt,
+	})
+}
@@ -0,0 +1,134 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+	deliveryHttp "github.com/igor/chronotask-api/internal/delivery/http"
+	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Mock CharacterEnergyRepository (characters without a stored pool have a full one)
+type mockCharacterEnergyRepository struct {
+	energies map[string]*entity.CharacterEnergy
+}
+
+func newMockCharacterEnergyRepository() *mockCharacterEnergyRepository {
+	return &mockCharacterEnergyRepository{energies: map[string]*entity.CharacterEnergy{}}
+}
+
+func (m *mockCharacterEnergyRepository) FindByCharacterID(ctx context.Context, characterID string) (*entity.CharacterEnergy, error) {
+	return m.energies[characterID], nil
+}
+
+func (m *mockCharacterEnergyRepository) FindByCharacterIDForUpdate(ctx context.Context, characterID string) (*entity.CharacterEnergy, error) {
+	return m.energies[characterID], nil
+}
+
+func (m *mockCharacterEnergyRepository) Create(ctx context.Context, energy *entity.CharacterEnergy) error {
+	if _, ok := m.energies[energy.CharacterID()]; !ok {
+		m.energies[energy.CharacterID()] = energy
+	}
+	return nil
+}
+
+func (m *mockCharacterEnergyRepository) Update(ctx context.Context, energy *entity.CharacterEnergy) error {
+	m.energies[energy.CharacterID()] = energy
+	return nil
+}
+
+// setupTestRouterForEnergy builds the energy routes for test-user-123
+// char-123 never fought (full pool), char-321 spent its energy; char-456 is owned by another user
+func setupTestRouterForEnergy() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.Default()
+
+	characters := map[string]*entity.Character{
+		"char-123": entity.ReconstituteCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), 10, 0, 0, 0, "test-user-123", time.Now()),
+		"char-321": entity.ReconstituteCharacter("char-321", "Weary Knight", valueobject.DefaultCharacterClass(), 4, 0, 0, 0, "test-user-123", time.Now()),
+		"char-456": entity.ReconstituteCharacter("char-456", "Rival", valueobject.DefaultCharacterClass(), 8, 0, 0, 0, "other-user-456", time.Now()),
+	}
+
+	charRepo := &mockCharacterRepositoryForAttributeTests{
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Character, error) {
+			if character, ok := characters[id]; ok && character.UserID() == userID {
+				return character, nil
+			}
+			return nil, errors.New("character not found or does not belong to user")
+		},
+	}
+
+	energyRepo := newMockCharacterEnergyRepository()
+	energyRepo.energies["char-321"] = entity.ReconstituteCharacterEnergy("char-321", 0, time.Now().UTC())
+
+	// Create handler
+	energyHandler := deliveryHttp.NewEnergyHandler(
+		usecase.NewGetCharacterEnergyUseCase(charRepo, energyRepo),
+	)
+
+	// Create auth middleware with mock JWT service
+	authMiddleware := middleware.NewAuthMiddleware(&mockJWTService{})
+
+	// Setup routes
+	v1 := router.Group("/api/v1")
+	{
+		authenticated := v1.Group("")
+		authenticated.Use(authMiddleware.RequireAuth())
+		{
+			authenticated.GET("/character/:characterId/energy", energyHandler.GetByCharacterID)
+		}
+	}
+
+	return router
+}
+
+func TestEnergyHandler_GetByCharacterID(t *testing.T) {
+	router := setupTestRouterForEnergy()
+
+	tests := []struct {
+		name          string
+		characterID   string
+		want          int
+		wantEnergy    int
+		wantNextPoint bool
+	}{
+		{"full pool", "char-123", http.StatusOK, entity.MaxEnergy, false},
+		{"spent pool", "char-321", http.StatusOK, 0, true},
+		{"character of another user", "char-456", http.StatusForbidden, 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := performJSONRequest(router, "GET", "/api/v1/character/"+tt.characterID+"/energy", nil)
+			if w.Code != tt.want {
+				t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, tt.want, w.Body.String())
+			}
+			if tt.want != http.StatusOK {
+				return
+			}
+
+			var response dto.CharacterEnergyResponse
+			json.Unmarshal(w.Body.Bytes(), &response)
+
+			if response.Energy != tt.wantEnergy || response.MaxEnergy != entity.MaxEnergy {
+				t.Errorf("energy = %d/%d, want %d/%d", response.Energy, response.MaxEnergy, tt.wantEnergy, entity.MaxEnergy)
+			}
+			if (response.NextPointAt != "") != tt.wantNextPoint {
+				t.Errorf("nextPointAt = %q, want set = %v", response.NextPointAt, tt.wantNextPoint)
+			}
+			if response.BattleCost != entity.BattleEnergyCost || response.RegenIntervalSeconds != int(entity.EnergyRegenInterval/time.Second) {
+				t.Errorf("response = %+v, want the battle cost and regen interval", response)
+			}
+		})
+	}
+}
@@ -0,0 +1,307 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
+)
+
+// FocusSessionHandler handles focus session (time tracking) HTTP requests
+type FocusSessionHandler struct {
+	startFocusSessionUseCase     *usecase.StartFocusSessionUseCase
+	pauseFocusSessionUseCase     *usecase.PauseFocusSessionUseCase
+	resumeFocusSessionUseCase    *usecase.ResumeFocusSessionUseCase
+	stopFocusSessionUseCase      *usecase.StopFocusSessionUseCase
+	listFocusSessionsUseCase     *usecase.ListFocusSessionsUseCase
+	getActiveFocusSessionUseCase *usecase.GetActiveFocusSessionUseCase
+}
+
+// NewFocusSessionHandler creates a new FocusSessionHandler
+func NewFocusSessionHandler(
+	startFocusSessionUseCase *usecase.StartFocusSessionUseCase,
+	pauseFocusSessionUseCase *usecase.PauseFocusSessionUseCase,
+	resumeFocusSessionUseCase *usecase.ResumeFocusSessionUseCase,
+	stopFocusSessionUseCase *usecase.StopFocusSessionUseCase,
+	listFocusSessionsUseCase *usecase.ListFocusSessionsUseCase,
+	getActiveFocusSessionUseCase *usecase.GetActiveFocusSessionUseCase,
+) *FocusSessionHandler {
+	return &FocusSessionHandler{
+		startFocusSessionUseCase:     startFocusSessionUseCase,
+		pauseFocusSessionUseCase:     pauseFocusSessionUseCase,
+		resumeFocusSessionUseCase:    resumeFocusSessionUseCase,
+		stopFocusSessionUseCase:      stopFocusSessionUseCase,
+		listFocusSessionsUseCase:     listFocusSessionsUseCase,
+		getActiveFocusSessionUseCase: getActiveFocusSessionUseCase,
+	}
+}
+
+// Start handles POST /focus-session - starts a focus session against one of the user's habits or tasks
+// This is a protected route that requires authentication
+func (h *FocusSessionHandler) Start(c *gin.Context) {
+	var req dto.StartFocusSessionRequest
+
+	// Bind and validate request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates habit or task ownership and overlapping sessions)
+	output, err := h.startFocusSessionUseCase.Execute(c.Request.Context(), usecase.StartFocusSessionInput{
+		UserID:  userID,
+		HabitID: req.HabitID,
+		TaskID:  req.TaskID,
+	})
+
+	if err != nil {
+		respondFocusSessionError(c, err, "focus_session_start_failed")
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusCreated, mapFocusSessionOutputToResponse(*output))
+}
+
+// Active handles GET /focus-session/active - returns the user's running or paused session
+// This is a protected route that requires authentication
+func (h *FocusSessionHandler) Active(c *gin.Context) {
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case
+	output, err := h.getActiveFocusSessionUseCase.Execute(c.Request.Context(), usecase.GetActiveFocusSessionInput{
+		UserID: userID,
+	})
+
+	if err != nil {
+		respondFocusSessionError(c, err, "failed_to_fetch_focus_session")
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, mapFocusSessionOutputToResponse(*output))
+}
+
+// List handles GET /focus-session?from=YYYY-MM-DD&to=YYYY-MM-DD - lists sessions started in a date range
+// Both dates are inclusive and default to the user's today (in their time zone)
+// This is a protected route that requires authentication
+func (h *FocusSessionHandler) List(c *gin.Context) {
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Zero dates are resolved by the use case
+	from, fromErr := parseDateQuery(c, "from", time.Time{})
+	to, toErr := parseDateQuery(c, "to", time.Time{})
+	if fromErr != nil || toErr != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "from and to must be in the YYYY-MM-DD format",
+		})
+		return
+	}
+
+	// Execute use case
+	output, err := h.listFocusSessionsUseCase.Execute(c.Request.Context(), usecase.ListFocusSessionsInput{
+		UserID: userID,
+		From:   from,
+		To:     to,
+	})
+
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidDateRange) {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "invalid_request",
+				Message: err.Error(),
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "failed_to_fetch_focus_sessions",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Convert use case output to DTOs
+	sessionDTOs := make([]dto.FocusSessionResponse, len(output.Sessions))
+	for i, session := range output.Sessions {
+		sessionDTOs[i] = mapFocusSessionOutputToResponse(session)
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, dto.GetFocusSessionsResponse{
+		From:           output.From,
+		To:             output.To,
+		Sessions:       sessionDTOs,
+		TrackedMinutes: output.TrackedMinutes,
+		XpAwarded:      output.XpAwarded,
+	})
+}
+
+// Pause handles POST /focus-session/:id/pause - pauses a running session
+// This is a protected route that requires authentication
+func (h *FocusSessionHandler) Pause(c *gin.Context) {
+	h.transition(c, h.pauseFocusSessionUseCase.Execute, "focus_session_pause_failed")
+}
+
+// Resume handles POST /focus-session/:id/resume - resumes a paused session
+// This is a protected route that requires authentication
+func (h *FocusSessionHandler) Resume(c *gin.Context) {
+	h.transition(c, h.resumeFocusSessionUseCase.Execute, "focus_session_resume_failed")
+}
+
+// Stop handles POST /focus-session/:id/stop - stops a session and awards XP for the tracked minutes
+// This is a protected route that requires authentication
+func (h *FocusSessionHandler) Stop(c *gin.Context) {
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates session ownership)
+	output, err := h.stopFocusSessionUseCase.Execute(c.Request.Context(), usecase.FocusSessionInput{
+		SessionID: c.Param("id"),
+		UserID:    userID,
+	})
+
+	if err != nil {
+		respondFocusSessionError(c, err, "focus_session_stop_failed")
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, dto.StopFocusSessionResponse{
+		Session:        mapFocusSessionOutputToResponse(output.Session),
+		XpGained:       output.XpGained,
+		LevelsGained:   output.LevelsGained,
+		Level:          output.Level,
+		CurrentXp:      output.CurrentXp,
+		TotalXp:        output.TotalXp,
+		XpForNextLevel: output.XpForNextLevel,
+	})
+}
+
+// transition runs a pause/resume use case for the session in the URL and writes the updated session
+func (h *FocusSessionHandler) transition(
+	c *gin.Context,
+	execute func(ctx context.Context, input usecase.FocusSessionInput) (*usecase.FocusSessionOutput, error),
+	fallbackCode string,
+) {
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates session ownership)
+	output, err := execute(c.Request.Context(), usecase.FocusSessionInput{
+		SessionID: c.Param("id"),
+		UserID:    userID,
+	})
+
+	if err != nil {
+		respondFocusSessionError(c, err, fallbackCode)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, mapFocusSessionOutputToResponse(*output))
+}
+
+// respondFocusSessionError maps focus session use case errors to HTTP responses
+func respondFocusSessionError(c *gin.Context, err error, fallbackCode string) {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidFocusSessionTarget):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+	case errors.Is(err, usecase.ErrFocusSessionNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "focus_session_not_found",
+			Message: "focus session not found or you are not authorized to access it",
+		})
+	case errors.Is(err, usecase.ErrFocusSessionInProgress):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "focus_session_in_progress",
+			Message: "stop the current focus session before starting a new one",
+		})
+	case errors.Is(err, usecase.ErrFocusSessionNotRunning),
+		errors.Is(err, usecase.ErrFocusSessionNotPaused),
+		errors.Is(err, usecase.ErrFocusSessionEnded):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "invalid_focus_session_state",
+			Message: err.Error(),
+		})
+	default:
+		// Task and habit errors (not found, inactive, ...) share their mappings
+		respondTaskError(c, err, fallbackCode)
+	}
+}
+
+// parseDateQuery parses an optional YYYY-MM-DD query parameter, returning fallback when absent
+func parseDateQuery(c *gin.Context, name string, fallback time.Time) (time.Time, error) {
+	value := c.Query(name)
+	if value == "" {
+		return fallback, nil
+	}
+	return time.Parse("2006-01-02", value)
+}
+
+// mapFocusSessionOutputToResponse converts a focus session use case output to its DTO
+func mapFocusSessionOutputToResponse(session usecase.FocusSessionOutput) dto.FocusSessionResponse {
+	return dto.FocusSessionResponse{
+		ID:             session.ID,
+		CharacterID:    session.CharacterID,
+		HabitID:        session.HabitID,
+		TaskID:         session.TaskID,
+		Status:         session.Status,
+		StartedAt:      session.StartedAt,
+		PausedAt:       session.PausedAt,
+		EndedAt:        session.EndedAt,
+		TrackedMinutes: session.TrackedMinutes,
+		XpAwarded:      session.XpAwarded,
+	}
+}
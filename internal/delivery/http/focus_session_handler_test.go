@@ -0,0 +1,226 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+	deliveryHttp "github.com/igor/chronotask-api/internal/delivery/http"
+	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Mock FocusSessionRepository for E2E tests (every session belongs to the authenticated user)
+type mockFocusSessionRepository struct {
+	sessions map[string]*entity.FocusSession
+}
+
+func (m *mockFocusSessionRepository) Create(ctx context.Context, session *entity.FocusSession) error {
+	m.sessions[session.ID()] = session
+	return nil
+}
+
+func (m *mockFocusSessionRepository) FindByIDAndUserID(ctx context.Context, id string, userID string) (*entity.FocusSession, error) {
+	if session, ok := m.sessions[id]; ok && userID == "test-user-123" {
+		return session, nil
+	}
+	return nil, errors.New("focus session not found or does not belong to user")
+}
+
+func (m *mockFocusSessionRepository) FindOpenByUserID(ctx context.Context, userID string) (*entity.FocusSession, error) {
+	for _, session := range m.sessions {
+		if session.IsOpen() {
+			return session, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockFocusSessionRepository) FindByUserIDBetween(ctx context.Context, userID string, from time.Time, to time.Time) ([]*entity.FocusSession, error) {
+	var sessions []*entity.FocusSession
+	for _, session := range m.sessions {
+		if !session.StartedAt().Before(from) && session.StartedAt().Before(to) {
+			sessions = append(sessions, session)
+		}
+	}
+	return sessions, nil
+}
+
+func (m *mockFocusSessionRepository) Update(ctx context.Context, session *entity.FocusSession) error {
+	m.sessions[session.ID()] = session
+	return nil
+}
+
+// Helper function to setup test router with focus session routes
+func setupTestRouterForFocusSessions() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.Default()
+
+	habitRepo := newMockHabitRepository()
+	seedHabit(habitRepo)
+	sessionRepo := &mockFocusSessionRepository{sessions: map[string]*entity.FocusSession{}}
+
+	mockChar := entity.ReconstituteCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), 1, 0, 0, 0, "test-user-123", time.Now())
+	charRepo := &mockCharacterRepositoryForAttributeTests{
+		findByIDFunc: func(ctx context.Context, id string) (*entity.Character, error) {
+			return mockChar, nil
+		},
+		updateFunc: func(ctx context.Context, character *entity.Character) error {
+			return nil
+		},
+	}
+
+	// Create handler
+	focusSessionHandler := deliveryHttp.NewFocusSessionHandler(
+		usecase.NewStartFocusSessionUseCase(sessionRepo, habitRepo, &mockTaskRepository{tasks: map[string]*entity.Task{}}),
+		usecase.NewPauseFocusSessionUseCase(sessionRepo),
+		usecase.NewResumeFocusSessionUseCase(sessionRepo),
+		usecase.NewStopFocusSessionUseCase(sessionRepo, charRepo, &mockUnitOfWork{}),
+		usecase.NewListFocusSessionsUseCase(sessionRepo, newMockUserPreferencesRepository()),
+		usecase.NewGetActiveFocusSessionUseCase(sessionRepo),
+	)
+
+	// Create auth middleware with mock JWT service
+	authMiddleware := middleware.NewAuthMiddleware(&mockJWTService{})
+
+	// Setup routes
+	v1 := router.Group("/api/v1")
+	{
+		authenticated := v1.Group("")
+		authenticated.Use(authMiddleware.RequireAuth())
+		{
+			authenticated.POST("/focus-session", focusSessionHandler.Start)
+			authenticated.GET("/focus-session", focusSessionHandler.List)
+			authenticated.GET("/focus-session/active", focusSessionHandler.Active)
+			authenticated.POST("/focus-session/:id/pause", focusSessionHandler.Pause)
+			authenticated.POST("/focus-session/:id/resume", focusSessionHandler.Resume)
+			authenticated.POST("/focus-session/:id/stop", focusSessionHandler.Stop)
+		}
+	}
+
+	return router
+}
+
+func TestFocusSessionHandler_Lifecycle(t *testing.T) {
+	router := setupTestRouterForFocusSessions()
+
+	// Start
+	w := performJSONRequest(router, "POST", "/api/v1/focus-session", map[string]interface{}{
+		"habitId": "habit-123",
+	})
+	if w.Code != http.StatusCreated {
+		t.Fatalf("start status code = %v, want %v (body: %s)", w.Code, http.StatusCreated, w.Body.String())
+	}
+
+	var started dto.FocusSessionResponse
+	json.Unmarshal(w.Body.Bytes(), &started)
+	if started.Status != "running" {
+		t.Errorf("started status = %v, want %v", started.Status, "running")
+	}
+
+	// The open session can be restored by the client
+	w = performJSONRequest(router, "GET", "/api/v1/focus-session/active", nil)
+	if w.Code != http.StatusOK {
+		t.Errorf("active status code = %v, want %v", w.Code, http.StatusOK)
+	}
+
+	// Pause
+	w = performJSONRequest(router, "POST", "/api/v1/focus-session/"+started.ID+"/pause", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("pause status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	// Pausing twice is a conflict
+	w = performJSONRequest(router, "POST", "/api/v1/focus-session/"+started.ID+"/pause", nil)
+	if w.Code != http.StatusConflict {
+		t.Errorf("second pause status code = %v, want %v", w.Code, http.StatusConflict)
+	}
+
+	// Stop
+	w = performJSONRequest(router, "POST", "/api/v1/focus-session/"+started.ID+"/stop", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("stop status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var stopped dto.StopFocusSessionResponse
+	json.Unmarshal(w.Body.Bytes(), &stopped)
+	if stopped.Session.Status != "completed" {
+		t.Errorf("stopped status = %v, want %v", stopped.Session.Status, "completed")
+	}
+
+	// No open session left
+	w = performJSONRequest(router, "GET", "/api/v1/focus-session/active", nil)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("active status code after stop = %v, want %v", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestFocusSessionHandler_Start_Overlapping(t *testing.T) {
+	router := setupTestRouterForFocusSessions()
+
+	performJSONRequest(router, "POST", "/api/v1/focus-session", map[string]interface{}{"habitId": "habit-123"})
+	w := performJSONRequest(router, "POST", "/api/v1/focus-session", map[string]interface{}{"habitId": "habit-123"})
+
+	if w.Code != http.StatusConflict {
+		t.Errorf("Status code = %v, want %v (body: %s)", w.Code, http.StatusConflict, w.Body.String())
+	}
+}
+
+func TestFocusSessionHandler_Start_UnknownHabit(t *testing.T) {
+	router := setupTestRouterForFocusSessions()
+
+	w := performJSONRequest(router, "POST", "/api/v1/focus-session", map[string]interface{}{"habitId": "missing"})
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Status code = %v, want %v", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestFocusSessionHandler_Start_MissingTarget(t *testing.T) {
+	router := setupTestRouterForFocusSessions()
+
+	w := performJSONRequest(router, "POST", "/api/v1/focus-session", map[string]interface{}{})
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Status code = %v, want %v", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestFocusSessionHandler_Start_UnknownTask(t *testing.T) {
+	router := setupTestRouterForFocusSessions()
+
+	w := performJSONRequest(router, "POST", "/api/v1/focus-session", map[string]interface{}{"taskId": "missing"})
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Status code = %v, want %v", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestFocusSessionHandler_List_InvalidRange(t *testing.T) {
+	router := setupTestRouterForFocusSessions()
+
+	tests := []struct {
+		name  string
+		query string
+	}{
+		{"invalid date", "?from=10-01-2024"},
+		{"reversed range", "?from=2024-01-10&to=2024-01-09"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := performJSONRequest(router, "GET", "/api/v1/focus-session"+tt.query, nil)
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("Status code = %v, want %v", w.Code, http.StatusBadRequest)
+			}
+		})
+	}
+}
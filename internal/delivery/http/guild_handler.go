@@ -0,0 +1,301 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
+)
+
+// GuildHandler handles guild and raid-related HTTP requests
+type GuildHandler struct {
+	createGuildUseCase  *usecase.CreateGuildUseCase
+	joinGuildUseCase    *usecase.JoinGuildUseCase
+	startRaidUseCase    *usecase.StartRaidUseCase
+	getGuildRaidUseCase *usecase.GetGuildRaidUseCase
+}
+
+// NewGuildHandler creates a new GuildHandler
+func NewGuildHandler(
+	createGuildUseCase *usecase.CreateGuildUseCase,
+	joinGuildUseCase *usecase.JoinGuildUseCase,
+	startRaidUseCase *usecase.StartRaidUseCase,
+	getGuildRaidUseCase *usecase.GetGuildRaidUseCase,
+) *GuildHandler {
+	return &GuildHandler{
+		createGuildUseCase:  createGuildUseCase,
+		joinGuildUseCase:    joinGuildUseCase,
+		startRaidUseCase:    startRaidUseCase,
+		getGuildRaidUseCase: getGuildRaidUseCase,
+	}
+}
+
+// Create handles POST /guild - founds a guild led by one of the user's characters
+// This is a protected route that requires authentication
+func (h *GuildHandler) Create(c *gin.Context) {
+	var req dto.CreateGuildRequest
+
+	// Bind and validate request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates character ownership)
+	output, err := h.createGuildUseCase.Execute(c.Request.Context(), usecase.CreateGuildInput{
+		CharacterID: req.CharacterID,
+		UserID:      userID,
+		Name:        req.Name,
+	})
+	if err != nil {
+		respondGuildError(c, err, "failed_to_create_guild")
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusCreated, toGuildResponse(*output))
+}
+
+// Join handles POST /guild/:id/join - one of the user's characters joins a guild
+// This is a protected route that requires authentication
+func (h *GuildHandler) Join(c *gin.Context) {
+	var req dto.JoinGuildRequest
+
+	// Bind and validate request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates character ownership)
+	output, err := h.joinGuildUseCase.Execute(c.Request.Context(), usecase.JoinGuildInput{
+		GuildID:     c.Param("id"),
+		CharacterID: req.CharacterID,
+		UserID:      userID,
+	})
+	if err != nil {
+		respondGuildError(c, err, "failed_to_join_guild")
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, toGuildResponse(*output))
+}
+
+// StartRaid handles POST /guild/:id/raid - the guild leader starts a raid against a boss
+// Members' habit completions damage the boss until it dies or the raid runs out of time
+// This is a protected route that requires authentication
+func (h *GuildHandler) StartRaid(c *gin.Context) {
+	var req dto.StartRaidRequest
+
+	// Bind and validate request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates character ownership and leadership)
+	output, err := h.startRaidUseCase.Execute(c.Request.Context(), usecase.StartRaidInput{
+		GuildID:     c.Param("id"),
+		CharacterID: req.CharacterID,
+		UserID:      userID,
+		Boss:        req.Boss,
+	})
+	if err != nil {
+		respondGuildError(c, err, "failed_to_start_raid")
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusCreated, toRaidResponse(*output))
+}
+
+// GetRaid handles GET /guild/:id/raid?characterId= - the guild's current (or last) raid, as seen by a member
+// This is a protected route that requires authentication
+func (h *GuildHandler) GetRaid(c *gin.Context) {
+	var query dto.GuildRaidQuery
+
+	// Bind and validate the member
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates character ownership and membership)
+	output, err := h.getGuildRaidUseCase.Execute(c.Request.Context(), usecase.GetGuildRaidInput{
+		GuildID:     c.Param("id"),
+		CharacterID: query.CharacterID,
+		UserID:      userID,
+	})
+	if err != nil {
+		respondGuildError(c, err, "failed_to_get_raid")
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, toRaidResponse(*output))
+}
+
+// respondGuildError maps guild and raid use case errors to HTTP responses
+func respondGuildError(c *gin.Context, err error, fallbackCode string) {
+	switch {
+	case errors.Is(err, usecase.ErrCharacterNotFound):
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{
+			Error:   "forbidden",
+			Message: "character not found or you are not authorized to access it",
+		})
+	case errors.Is(err, usecase.ErrNotGuildMember):
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{
+			Error:   "not_guild_member",
+			Message: err.Error(),
+		})
+	case errors.Is(err, usecase.ErrNotGuildLeader):
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{
+			Error:   "not_guild_leader",
+			Message: err.Error(),
+		})
+	case errors.Is(err, usecase.ErrGuildNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "guild_not_found",
+			Message: err.Error(),
+		})
+	case errors.Is(err, usecase.ErrRaidNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "raid_not_found",
+			Message: err.Error(),
+		})
+	case errors.Is(err, usecase.ErrInvalidGuild):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_guild",
+			Message: err.Error(),
+		})
+	case errors.Is(err, usecase.ErrInvalidRaidBoss):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_raid_boss",
+			Message: err.Error(),
+		})
+	case errors.Is(err, usecase.ErrAlreadyInGuild):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "already_in_guild",
+			Message: err.Error(),
+		})
+	case errors.Is(err, usecase.ErrRaidInProgress):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "raid_in_progress",
+			Message: err.Error(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   fallbackCode,
+			Message: err.Error(),
+		})
+	}
+}
+
+// toGuildResponse converts a guild output to its DTO
+func toGuildResponse(guild usecase.GuildOutput) dto.GuildResponse {
+	return dto.GuildResponse{
+		ID:                guild.ID,
+		Name:              guild.Name,
+		LeaderCharacterID: guild.LeaderCharacterID,
+		Members:           guild.Members,
+		CreatedAt:         guild.CreatedAt,
+	}
+}
+
+// toRaidResponse converts a raid output to its DTO
+func toRaidResponse(raid usecase.RaidOutput) dto.RaidResponse {
+	contributions := make([]dto.RaidContributionResponse, len(raid.Contributions))
+	for i, contribution := range raid.Contributions {
+		contributions[i] = dto.RaidContributionResponse{
+			CharacterID: contribution.CharacterID,
+			Damage:      contribution.Damage,
+			Hits:        contribution.Hits,
+			RewardXp:    contribution.RewardXp,
+		}
+	}
+
+	return dto.RaidResponse{
+		ID:            raid.ID,
+		GuildID:       raid.GuildID,
+		Boss:          raid.Boss,
+		BossName:      raid.BossName,
+		MaxHP:         raid.MaxHP,
+		HP:            raid.HP,
+		RewardXp:      raid.RewardXp,
+		Status:        raid.Status,
+		StartedAt:     raid.StartedAt,
+		EndsAt:        raid.EndsAt,
+		EndedAt:       raid.EndedAt,
+		Contributions: contributions,
+	}
+}
+
+// toRaidStrikeResponse converts the raid damage of a habit completion to its DTO (nil when there was none)
+func toRaidStrikeResponse(strike *usecase.RaidStrikeOutput) *dto.RaidStrikeResponse {
+	if strike == nil {
+		return nil
+	}
+	return &dto.RaidStrikeResponse{
+		RaidID:    strike.RaidID,
+		Damage:    strike.Damage,
+		BossHP:    strike.BossHP,
+		Defeated:  strike.Defeated,
+		XpAwarded: strike.XpAwarded,
+	}
+}
@@ -0,0 +1,272 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+	deliveryHttp "github.com/igor/chronotask-api/internal/delivery/http"
+	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Mock GuildRepository
+type mockGuildRepository struct {
+	guilds  map[string]*entity.Guild
+	members map[string]string // Character ID -> guild ID
+}
+
+func newMockGuildRepository() *mockGuildRepository {
+	return &mockGuildRepository{guilds: map[string]*entity.Guild{}, members: map[string]string{}}
+}
+
+func (m *mockGuildRepository) Create(ctx context.Context, guild *entity.Guild) error {
+	m.guilds[guild.ID()] = guild
+	return m.AddMember(ctx, guild.ID(), guild.LeaderCharacterID(), guild.CreatedAt())
+}
+
+func (m *mockGuildRepository) FindByID(ctx context.Context, id string) (*entity.Guild, error) {
+	if guild, ok := m.guilds[id]; ok {
+		return guild, nil
+	}
+	return nil, errors.New("guild not found")
+}
+
+func (m *mockGuildRepository) FindByCharacterID(ctx context.Context, characterID string) (*entity.Guild, error) {
+	if guildID, ok := m.members[characterID]; ok {
+		return m.guilds[guildID], nil
+	}
+	return nil, nil
+}
+
+func (m *mockGuildRepository) AddMember(ctx context.Context, guildID string, characterID string, joinedAt time.Time) error {
+	m.members[characterID] = guildID
+	return nil
+}
+
+func (m *mockGuildRepository) CountMembers(ctx context.Context, guildID string) (int, error) {
+	count := 0
+	for _, id := range m.members {
+		if id == guildID {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// Mock RaidRepository (raids only; no habit completion strikes them in these tests)
+type mockRaidRepository struct {
+	raids map[string]*entity.Raid
+}
+
+func newMockRaidRepository() *mockRaidRepository {
+	return &mockRaidRepository{raids: map[string]*entity.Raid{}}
+}
+
+func (m *mockRaidRepository) Create(ctx context.Context, raid *entity.Raid) error {
+	m.raids[raid.ID()] = raid
+	return nil
+}
+
+func (m *mockRaidRepository) Update(ctx context.Context, raid *entity.Raid) error {
+	m.raids[raid.ID()] = raid
+	return nil
+}
+
+func (m *mockRaidRepository) FindByIDForUpdate(ctx context.Context, id string) (*entity.Raid, error) {
+	if raid, ok := m.raids[id]; ok {
+		return raid, nil
+	}
+	return nil, errors.New("raid not found")
+}
+
+func (m *mockRaidRepository) FindActiveByGuildIDForUpdate(ctx context.Context, guildID string) (*entity.Raid, error) {
+	for _, raid := range m.raids {
+		if raid.GuildID() == guildID && raid.IsActive() {
+			return raid, nil
+		}
+	}
+	return nil, nil
+}
+
+func (m *mockRaidRepository) FindLatestByGuildID(ctx context.Context, guildID string) (*entity.Raid, error) {
+	var latest *entity.Raid
+	for _, raid := range m.raids {
+		if raid.GuildID() == guildID && (latest == nil || raid.StartedAt().After(latest.StartedAt())) {
+			latest = raid
+		}
+	}
+	return latest, nil
+}
+
+func (m *mockRaidRepository) CreateHit(ctx context.Context, hit *entity.RaidHit) error {
+	return nil
+}
+
+func (m *mockRaidRepository) FindHitByCompletionID(ctx context.Context, completionID string) (*entity.RaidHit, error) {
+	return nil, nil
+}
+
+func (m *mockRaidRepository) ExistsHitByHabitOnDay(ctx context.Context, raidID, habitID string, day time.Time) (bool, error) {
+	return false, nil
+}
+
+func (m *mockRaidRepository) DeleteHit(ctx context.Context, completionID string) error {
+	return nil
+}
+
+func (m *mockRaidRepository) FindContributions(ctx context.Context, raidID string) ([]entity.RaidContribution, error) {
+	return []entity.RaidContribution{}, nil
+}
+
+// setupTestRouterForGuilds builds the guild routes for test-user-123 (char-123 and char-456)
+// char-789 belongs to another user
+func setupTestRouterForGuilds() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.Default()
+
+	characters := map[string]*entity.Character{
+		"char-123": entity.ReconstituteCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), 10, 0, 0, 0, "test-user-123", time.Now()),
+		"char-456": entity.ReconstituteCharacter("char-456", "Squire", valueobject.DefaultCharacterClass(), 3, 0, 0, 0, "test-user-123", time.Now()),
+		"char-789": entity.ReconstituteCharacter("char-789", "Stranger", valueobject.DefaultCharacterClass(), 5, 0, 0, 0, "other-user-789", time.Now()),
+	}
+
+	charRepo := &mockCharacterRepositoryForAttributeTests{
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Character, error) {
+			if character, ok := characters[id]; ok && character.UserID() == userID {
+				return character, nil
+			}
+			return nil, errors.New("character not found or does not belong to user")
+		},
+	}
+	guildRepo := newMockGuildRepository()
+	raidRepo := newMockRaidRepository()
+
+	// Create handler
+	guildHandler := deliveryHttp.NewGuildHandler(
+		usecase.NewCreateGuildUseCase(charRepo, guildRepo),
+		usecase.NewJoinGuildUseCase(charRepo, guildRepo),
+		usecase.NewStartRaidUseCase(charRepo, guildRepo, raidRepo, &mockUnitOfWork{}),
+		usecase.NewGetGuildRaidUseCase(charRepo, guildRepo, raidRepo),
+	)
+
+	// Create auth middleware with mock JWT service
+	authMiddleware := middleware.NewAuthMiddleware(&mockJWTService{})
+
+	// Setup routes
+	v1 := router.Group("/api/v1")
+	{
+		authenticated := v1.Group("")
+		authenticated.Use(authMiddleware.RequireAuth())
+		{
+			authenticated.POST("/guild", guildHandler.Create)
+			authenticated.POST("/guild/:id/join", guildHandler.Join)
+			authenticated.POST("/guild/:id/raid", guildHandler.StartRaid)
+			authenticated.GET("/guild/:id/raid", guildHandler.GetRaid)
+		}
+	}
+
+	return router
+}
+
+func TestGuildHandler_Raid(t *testing.T) {
+	router := setupTestRouterForGuilds()
+
+	w := performJSONRequest(router, "POST", "/api/v1/guild", dto.CreateGuildRequest{CharacterID: "char-123", Name: "Ordem da Aurora"})
+	if w.Code != http.StatusCreated {
+		t.Fatalf("Create status code = %v, want %v (body: %s)", w.Code, http.StatusCreated, w.Body.String())
+	}
+	var guild dto.GuildResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &guild); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if guild.LeaderCharacterID != "char-123" || guild.Members != 1 {
+		t.Errorf("guild = %+v, want led by char-123 with 1 member", guild)
+	}
+
+	w = performJSONRequest(router, "POST", "/api/v1/guild/"+guild.ID+"/join", dto.JoinGuildRequest{CharacterID: "char-456"})
+	if w.Code != http.StatusOK {
+		t.Fatalf("Join status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	w = performJSONRequest(router, "POST", "/api/v1/guild/"+guild.ID+"/raid", dto.StartRaidRequest{CharacterID: "char-123", Boss: "hidra-do-pantano"})
+	if w.Code != http.StatusCreated {
+		t.Fatalf("StartRaid status code = %v, want %v (body: %s)", w.Code, http.StatusCreated, w.Body.String())
+	}
+	var raid dto.RaidResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &raid); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+
+	// Hidra do Pântano: 300 HP and 150 XP per member
+	if raid.MaxHP != 600 || raid.HP != 600 || raid.RewardXp != 300 || raid.Status != "active" {
+		t.Errorf("raid = %+v, want an active 600 HP boss worth 300 XP", raid)
+	}
+
+	w = performJSONRequest(router, "GET", "/api/v1/guild/"+guild.ID+"/raid?characterId=char-456", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("GetRaid status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+	var current dto.RaidResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &current); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if current.ID != raid.ID || current.Contributions == nil {
+		t.Errorf("current raid = %+v, want %s with an empty contribution list", current, raid.ID)
+	}
+}
+
+func TestGuildHandler_Errors(t *testing.T) {
+	router := setupTestRouterForGuilds()
+
+	w := performJSONRequest(router, "POST", "/api/v1/guild", dto.CreateGuildRequest{CharacterID: "char-123", Name: "Ordem da Aurora"})
+	var guild dto.GuildResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &guild); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	raidPath := "/api/v1/guild/" + guild.ID + "/raid"
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       interface{}
+		wantStatus int
+		wantError  string
+	}{
+		{"name too short", "POST", "/api/v1/guild", dto.CreateGuildRequest{CharacterID: "char-456", Name: "Ab"}, http.StatusBadRequest, "invalid_guild"},
+		{"leader founds another guild", "POST", "/api/v1/guild", dto.CreateGuildRequest{CharacterID: "char-123", Name: "Outra Guilda"}, http.StatusConflict, "already_in_guild"},
+		{"character of another user", "POST", "/api/v1/guild/" + guild.ID + "/join", dto.JoinGuildRequest{CharacterID: "char-789"}, http.StatusForbidden, "forbidden"},
+		{"unknown guild", "POST", "/api/v1/guild/missing/join", dto.JoinGuildRequest{CharacterID: "char-456"}, http.StatusNotFound, "guild_not_found"},
+		{"unknown boss", "POST", raidPath, dto.StartRaidRequest{CharacterID: "char-123", Boss: "kraken"}, http.StatusBadRequest, "invalid_raid_boss"},
+		{"not the leader", "POST", raidPath, dto.StartRaidRequest{CharacterID: "char-456", Boss: "rei-lich"}, http.StatusForbidden, "not_guild_leader"},
+		{"no raid yet", "GET", raidPath + "?characterId=char-123", nil, http.StatusNotFound, "raid_not_found"},
+		{"not a member", "GET", raidPath + "?characterId=char-456", nil, http.StatusForbidden, "not_guild_member"},
+		{"missing character", "GET", raidPath, nil, http.StatusBadRequest, "invalid_request"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := performJSONRequest(router, tt.method, tt.path, tt.body)
+			if w.Code != tt.wantStatus {
+				t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, tt.wantStatus, w.Body.String())
+			}
+
+			var response dto.ErrorResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+				t.Fatalf("Failed to parse response: %v", err)
+			}
+			if response.Error != tt.wantError {
+				t.Errorf("Error = %q, want %q", response.Error, tt.wantError)
+			}
+		})
+	}
+}
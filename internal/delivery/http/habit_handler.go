@@ -0,0 +1,562 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
+)
+
+// HabitHandler handles habit-related HTTP requests
+type HabitHandler struct {
+	createHabitUseCase     *usecase.CreateHabitUseCase
+	listHabitsUseCase      *usecase.ListHabitsUseCase
+	getHabitUseCase        *usecase.GetHabitUseCase
+	updateHabitUseCase     *usecase.UpdateHabitUseCase
+	deleteHabitUseCase     *usecase.DeleteHabitUseCase
+	completeHabitUseCase   *usecase.CompleteHabitUseCase
+	undoCompletionUseCase  *usecase.UndoHabitCompletionUseCase
+	getDueHabitsUseCase    *usecase.GetDueHabitsUseCase
+	useStreakFreezeUseCase *usecase.UseStreakFreezeUseCase
+}
+
+// NewHabitHandler creates a new HabitHandler
+func NewHabitHandler(
+	createHabitUseCase *usecase.CreateHabitUseCase,
+	listHabitsUseCase *usecase.ListHabitsUseCase,
+	getHabitUseCase *usecase.GetHabitUseCase,
+	updateHabitUseCase *usecase.UpdateHabitUseCase,
+	deleteHabitUseCase *usecase.DeleteHabitUseCase,
+	completeHabitUseCase *usecase.CompleteHabitUseCase,
+	undoCompletionUseCase *usecase.UndoHabitCompletionUseCase,
+	getDueHabitsUseCase *usecase.GetDueHabitsUseCase,
+	useStreakFreezeUseCase *usecase.UseStreakFreezeUseCase,
+) *HabitHandler {
+	return &HabitHandler{
+		createHabitUseCase:     createHabitUseCase,
+		listHabitsUseCase:      listHabitsUseCase,
+		getHabitUseCase:        getHabitUseCase,
+		updateHabitUseCase:     updateHabitUseCase,
+		deleteHabitUseCase:     deleteHabitUseCase,
+		completeHabitUseCase:   completeHabitUseCase,
+		undoCompletionUseCase:  undoCompletionUseCase,
+		getDueHabitsUseCase:    getDueHabitsUseCase,
+		useStreakFreezeUseCase: useStreakFreezeUseCase,
+	}
+}
+
+// Create handles POST /habit - creates a new habit for one of the user's characters
+// This is a protected route that requires authentication
+func (h *HabitHandler) Create(c *gin.Context) {
+	var req dto.CreateHabitRequest
+
+	// Bind and validate request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates character ownership)
+	output, err := h.createHabitUseCase.Execute(c.Request.Context(), usecase.CreateHabitInput{
+		UserID:        userID,
+		CharacterID:   req.CharacterID,
+		Title:         req.Title,
+		Description:   req.Description,
+		AttributeName: req.AttributeName,
+		Difficulty:    req.Difficulty,
+		Recurrence:    mapRecurrenceRequestToInput(req.Recurrence),
+		Negative:      req.Negative,
+		DrainsXp:      req.DrainsXp,
+	})
+
+	if err != nil {
+		respondHabitError(c, err, "habit_creation_failed")
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusCreated, mapHabitOutputToResponse(*output))
+}
+
+// List handles GET /habit - gets all habits for the authenticated user
+// This is a protected route that requires authentication
+func (h *HabitHandler) List(c *gin.Context) {
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case
+	output, err := h.listHabitsUseCase.Execute(c.Request.Context(), usecase.ListHabitsInput{
+		UserID: userID,
+	})
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "failed_to_fetch_habits",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Convert use case output to DTOs
+	habitDTOs := make([]dto.HabitResponse, len(output.Habits))
+	for i, habit := range output.Habits {
+		habitDTOs[i] = mapHabitOutputToResponse(habit)
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, dto.GetHabitsResponse{
+		Habits: habitDTOs,
+	})
+}
+
+// Due handles GET /habit/due - gets the habits scheduled for a day (today by default)
+// Optional query parameter: date (YYYY-MM-DD)
+// This is a protected route that requires authentication
+func (h *HabitHandler) Due(c *gin.Context) {
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Without a date, the use case evaluates the user's today (in their time zone)
+	var date time.Time
+	if dateParam := c.Query("date"); dateParam != "" {
+		parsed, err := time.Parse("2006-01-02", dateParam)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "invalid_request",
+				Message: "date must be in the YYYY-MM-DD format",
+			})
+			return
+		}
+		date = parsed
+	}
+
+	// Execute use case
+	output, err := h.getDueHabitsUseCase.Execute(c.Request.Context(), usecase.GetDueHabitsInput{
+		UserID: userID,
+		Date:   date,
+	})
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "failed_to_fetch_habits",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Convert use case output to DTOs
+	habitDTOs := make([]dto.DueHabitResponse, len(output.Habits))
+	for i, dueHabit := range output.Habits {
+		habitDTOs[i] = dto.DueHabitResponse{
+			Habit:             mapHabitOutputToResponse(dueHabit.Habit),
+			CompletedToday:    dueHabit.CompletedToday,
+			PeriodCompletions: dueHabit.PeriodCompletions,
+		}
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, dto.GetDueHabitsResponse{
+		Date:   output.Date,
+		Habits: habitDTOs,
+	})
+}
+
+// GetByID handles GET /habit/:id - gets a single habit of the authenticated user
+// This is a protected route that requires authentication
+func (h *HabitHandler) GetByID(c *gin.Context) {
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates habit ownership)
+	output, err := h.getHabitUseCase.Execute(c.Request.Context(), usecase.GetHabitInput{
+		HabitID: c.Param("id"),
+		UserID:  userID,
+	})
+
+	if err != nil {
+		respondHabitError(c, err, "failed_to_fetch_habit")
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, mapHabitOutputToResponse(*output))
+}
+
+// Update handles PUT /habit/:id - replaces a habit of the authenticated user
+// This is a protected route that requires authentication
+func (h *HabitHandler) Update(c *gin.Context) {
+	var req dto.UpdateHabitRequest
+
+	// Bind and validate request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates habit ownership)
+	output, err := h.updateHabitUseCase.Execute(c.Request.Context(), usecase.UpdateHabitInput{
+		HabitID:       c.Param("id"),
+		UserID:        userID,
+		Title:         req.Title,
+		Description:   req.Description,
+		AttributeName: req.AttributeName,
+		Difficulty:    req.Difficulty,
+		Recurrence:    mapRecurrenceRequestToInput(req.Recurrence),
+		Negative:      req.Negative,
+		DrainsXp:      req.DrainsXp,
+		Active:        *req.Active,
+	})
+
+	if err != nil {
+		respondHabitError(c, err, "habit_update_failed")
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, mapHabitOutputToResponse(*output))
+}
+
+// Delete handles DELETE /habit/:id - deletes a habit of the authenticated user
+// This is a protected route that requires authentication
+func (h *HabitHandler) Delete(c *gin.Context) {
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates habit ownership)
+	err := h.deleteHabitUseCase.Execute(c.Request.Context(), usecase.DeleteHabitInput{
+		HabitID: c.Param("id"),
+		UserID:  userID,
+	})
+
+	if err != nil {
+		respondHabitError(c, err, "habit_deletion_failed")
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+// Complete handles POST /habit/:id/complete - logs a completion and rewards the character
+// This is a protected route that requires authentication
+func (h *HabitHandler) Complete(c *gin.Context) {
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates habit ownership)
+	output, err := h.completeHabitUseCase.Execute(c.Request.Context(), usecase.CompleteHabitInput{
+		HabitID: c.Param("id"),
+		UserID:  userID,
+	})
+
+	if err != nil {
+		respondHabitError(c, err, "habit_completion_failed")
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusCreated, dto.CompleteHabitResponse{
+		CompletionID:   output.CompletionID,
+		HabitID:        output.HabitID,
+		CharacterID:    output.CharacterID,
+		XpGained:       output.XpGained,
+		StreakBonusXp:  output.StreakBonusXp,
+		LevelsGained:   output.LevelsGained,
+		Level:          output.Level,
+		CurrentXp:      output.CurrentXp,
+		TotalXp:        output.TotalXp,
+		XpForNextLevel: output.XpForNextLevel,
+		AttributeName:  output.AttributeName,
+		AttributeValue: output.AttributeValue,
+		CurrentStreak:  output.CurrentStreak,
+		LongestStreak:  output.LongestStreak,
+		FreezesEarned:  output.FreezesEarned,
+		CompletedAt:    output.CompletedAt,
+		Raid:           toRaidStrikeResponse(output.Raid),
+		EnergyRestored: output.EnergyRestored,
+	})
+}
+
+// UndoCompletion handles DELETE /habit/:id/completion/:completionId - reverts a mistaken completion
+// This is a protected route that requires authentication
+func (h *HabitHandler) UndoCompletion(c *gin.Context) {
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates habit and completion ownership)
+	output, err := h.undoCompletionUseCase.Execute(c.Request.Context(), usecase.UndoHabitCompletionInput{
+		HabitID:      c.Param("id"),
+		CompletionID: c.Param("completionId"),
+		UserID:       userID,
+	})
+
+	if err != nil {
+		respondHabitError(c, err, "habit_completion_undo_failed")
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, dto.UndoHabitCompletionResponse{
+		CompletionID:     output.CompletionID,
+		HabitID:          output.HabitID,
+		CharacterID:      output.CharacterID,
+		XpReverted:       output.XpReverted,
+		LevelsReverted:   output.LevelsReverted,
+		Level:            output.Level,
+		CurrentXp:        output.CurrentXp,
+		TotalXp:          output.TotalXp,
+		XpForNextLevel:   output.XpForNextLevel,
+		AttributeName:    output.AttributeName,
+		AttributeValue:   output.AttributeValue,
+		FreezesRevoked:   output.FreezesRevoked,
+		RaidDamageHealed: output.RaidDamageHealed,
+		EnergyDrained:    output.EnergyDrained,
+	})
+}
+
+// Freeze handles POST /habit/:id/freeze - spends a streak freeze token to protect a missed day
+// This is a protected route that requires authentication
+func (h *HabitHandler) Freeze(c *gin.Context) {
+	var req dto.UseStreakFreezeRequest
+
+	// Bind and validate request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	date, err := time.Parse("2006-01-02", req.Date)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "date must be in the YYYY-MM-DD format",
+		})
+		return
+	}
+
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates habit ownership)
+	output, err := h.useStreakFreezeUseCase.Execute(c.Request.Context(), usecase.UseStreakFreezeInput{
+		HabitID: c.Param("id"),
+		UserID:  userID,
+		Date:    date,
+	})
+
+	if err != nil {
+		respondHabitError(c, err, "streak_freeze_failed")
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, dto.UseStreakFreezeResponse{
+		FreezeID:         output.FreezeID,
+		HabitID:          output.HabitID,
+		FrozenDate:       output.FrozenDate,
+		RemainingFreezes: output.RemainingFreezes,
+		CurrentStreak:    output.CurrentStreak,
+		LongestStreak:    output.LongestStreak,
+	})
+}
+
+// respondHabitError maps habit use case errors to HTTP responses
+// Unknown errors are reported as 422 with the given fallback error code
+func respondHabitError(c *gin.Context, err error, fallbackCode string) {
+	switch {
+	case errors.Is(err, usecase.ErrHabitNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "habit_not_found",
+			Message: "habit not found or you are not authorized to access it",
+		})
+	case errors.Is(err, usecase.ErrCharacterNotFound):
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{
+			Error:   "forbidden",
+			Message: "character not found or you are not authorized to access it",
+		})
+	case errors.Is(err, usecase.ErrHabitInactive):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "habit_inactive",
+			Message: "habit is not active",
+		})
+	case errors.Is(err, usecase.ErrHabitAlreadyCompleted):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "habit_already_completed",
+			Message: "habit was already completed for this day or period",
+		})
+	case errors.Is(err, usecase.ErrHabitCompletionNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "habit_completion_not_found",
+			Message: "habit completion not found for this habit",
+		})
+	case errors.Is(err, usecase.ErrStreakFreezeAlreadyUsed):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "streak_freeze_already_used",
+			Message: "the streak freeze earned by this completion was already used",
+		})
+	case errors.Is(err, usecase.ErrRaidAlreadyDefeated):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "raid_already_defeated",
+			Message: "the raid boss struck by this completion was already defeated",
+		})
+	case errors.Is(err, usecase.ErrNoStreakFreezes):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "no_streak_freezes",
+			Message: "no streak freezes available",
+		})
+	case errors.Is(err, usecase.ErrStreakFreezeNotNeeded):
+		c.JSON(http.StatusUnprocessableEntity, dto.ErrorResponse{
+			Error:   "streak_freeze_not_needed",
+			Message: "only missed scheduled days that were not frozen yet can be protected",
+		})
+	case errors.Is(err, usecase.ErrAttributeNotFound):
+		c.JSON(http.StatusUnprocessableEntity, dto.ErrorResponse{
+			Error:   "attribute_not_found",
+			Message: err.Error(),
+		})
+	default:
+		c.JSON(http.StatusUnprocessableEntity, dto.ErrorResponse{
+			Error:   fallbackCode,
+			Message: err.Error(),
+		})
+	}
+}
+
+// mapHabitOutputToResponse converts a habit use case output to its DTO
+func mapHabitOutputToResponse(habit usecase.HabitOutput) dto.HabitResponse {
+	return dto.HabitResponse{
+		ID:            habit.ID,
+		CharacterID:   habit.CharacterID,
+		Title:         habit.Title,
+		Description:   habit.Description,
+		AttributeName: habit.AttributeName,
+		Difficulty:    habit.Difficulty,
+		Recurrence:    mapRecurrenceOutputToResponse(habit.Recurrence),
+		Streak:        mapStreakOutputToResponse(habit.Streak),
+		Negative:      habit.Negative,
+		DrainsXp:      habit.DrainsXp,
+		Active:        habit.Active,
+		CreatedAt:     habit.CreatedAt,
+		UpdatedAt:     habit.UpdatedAt,
+	}
+}
+
+// mapRecurrenceOutputToResponse converts a recurrence use case output to its DTO
+func mapRecurrenceOutputToResponse(recurrence usecase.RecurrenceOutput) dto.RecurrenceResponse {
+	return dto.RecurrenceResponse{
+		Type:     recurrence.Type,
+		Weekdays: recurrence.Weekdays,
+		Interval: recurrence.Interval,
+		Times:    recurrence.Times,
+		Period:   recurrence.Period,
+		Rule:     recurrence.Rule,
+	}
+}
+
+// mapStreakOutputToResponse converts an optional streak use case output to its DTO
+func mapStreakOutputToResponse(streak *usecase.StreakOutput) *dto.StreakResponse {
+	if streak == nil {
+		return nil
+	}
+
+	return &dto.StreakResponse{
+		Current: streak.Current,
+		Longest: streak.Longest,
+	}
+}
+
+// mapRecurrenceRequestToInput converts an optional recurrence DTO to use case input
+func mapRecurrenceRequestToInput(req *dto.RecurrenceRequest) *usecase.RecurrenceInput {
+	if req == nil {
+		return nil
+	}
+
+	return &usecase.RecurrenceInput{
+		Type:     req.Type,
+		Weekdays: req.Weekdays,
+		Interval: req.Interval,
+		Times:    req.Times,
+		Period:   req.Period,
+	}
+}
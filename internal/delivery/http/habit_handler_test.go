@@ -0,0 +1,708 @@
+package http_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+	deliveryHttp "github.com/igor/chronotask-api/internal/delivery/http"
+	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Mock HabitRepository for E2E tests
+type mockHabitRepository struct {
+	habits map[string]*entity.Habit
+}
+
+func newMockHabitRepository() *mockHabitRepository {
+	return &mockHabitRepository{habits: map[string]*entity.Habit{}}
+}
+
+func (m *mockHabitRepository) Create(ctx context.Context, habit *entity.Habit) error {
+	m.habits[habit.ID()] = habit
+	return nil
+}
+
+func (m *mockHabitRepository) FindByID(ctx context.Context, id string) (*entity.Habit, error) {
+	if habit, ok := m.habits[id]; ok {
+		return habit, nil
+	}
+	return nil, errors.New("habit not found")
+}
+
+func (m *mockHabitRepository) FindByIDAndUserID(ctx context.Context, id string, userID string) (*entity.Habit, error) {
+	// Every habit in the mock belongs to char-123, owned by the JWT mock user
+	if habit, ok := m.habits[id]; ok && userID == "test-user-123" {
+		return habit, nil
+	}
+	return nil, errors.New("habit not found or does not belong to user")
+}
+
+func (m *mockHabitRepository) FindAllByUserID(ctx context.Context, userID string) ([]*entity.Habit, error) {
+	var habits []*entity.Habit
+	if userID != "test-user-123" {
+		return habits, nil
+	}
+	for _, habit := range m.habits {
+		habits = append(habits, habit)
+	}
+	return habits, nil
+}
+
+func (m *mockHabitRepository) FindUserIDsWithActiveHabits(ctx context.Context) ([]string, error) {
+	if len(m.habits) == 0 {
+		return []string{}, nil
+	}
+	return []string{"test-user-123"}, nil
+}
+
+func (m *mockHabitRepository) Update(ctx context.Context, habit *entity.Habit) error {
+	m.habits[habit.ID()] = habit
+	return nil
+}
+
+func (m *mockHabitRepository) Delete(ctx context.Context, id string) error {
+	delete(m.habits, id)
+	return nil
+}
+
+// Mock HabitCompletionRepository for E2E tests
+type mockHabitCompletionRepository struct {
+	completions map[string]*entity.HabitCompletion
+}
+
+func (m *mockHabitCompletionRepository) Create(ctx context.Context, completion *entity.HabitCompletion) error {
+	m.completions[completion.ID()] = completion
+	return nil
+}
+
+func (m *mockHabitCompletionRepository) FindByID(ctx context.Context, id string) (*entity.HabitCompletion, error) {
+	if completion, ok := m.completions[id]; ok {
+		return completion, nil
+	}
+	return nil, errors.New("habit completion not found")
+}
+
+func (m *mockHabitCompletionRepository) FindByHabitID(ctx context.Context, habitID string) ([]*entity.HabitCompletion, error) {
+	var completions []*entity.HabitCompletion
+	for _, completion := range m.completions {
+		if completion.HabitID() == habitID {
+			completions = append(completions, completion)
+		}
+	}
+	return completions, nil
+}
+
+func (m *mockHabitCompletionRepository) FindByUserIDBetween(ctx context.Context, userID string, from time.Time, to time.Time) ([]*entity.HabitCompletion, error) {
+	var completions []*entity.HabitCompletion
+	for _, completion := range m.completions {
+		if !completion.CompletedAt().Before(from) && completion.CompletedAt().Before(to) {
+			completions = append(completions, completion)
+		}
+	}
+	return completions, nil
+}
+
+func (m *mockHabitCompletionRepository) Delete(ctx context.Context, id string) error {
+	delete(m.completions, id)
+	return nil
+}
+
+// Mock StreakFreezeRepository for E2E tests
+type mockStreakFreezeRepository struct {
+	freezes []*entity.StreakFreeze
+}
+
+func (m *mockStreakFreezeRepository) Create(ctx context.Context, freeze *entity.StreakFreeze) error {
+	m.freezes = append(m.freezes, freeze)
+	return nil
+}
+
+func (m *mockStreakFreezeRepository) FindAvailableByCharacterID(ctx context.Context, characterID string) ([]*entity.StreakFreeze, error) {
+	var freezes []*entity.StreakFreeze
+	for _, freeze := range m.freezes {
+		if freeze.CharacterID() == characterID && !freeze.IsUsed() {
+			freezes = append(freezes, freeze)
+		}
+	}
+	return freezes, nil
+}
+
+func (m *mockStreakFreezeRepository) FindByHabitID(ctx context.Context, habitID string) ([]*entity.StreakFreeze, error) {
+	var freezes []*entity.StreakFreeze
+	for _, freeze := range m.freezes {
+		if freeze.HabitID() == habitID {
+			freezes = append(freezes, freeze)
+		}
+	}
+	return freezes, nil
+}
+
+func (m *mockStreakFreezeRepository) FindUsedByUserID(ctx context.Context, userID string) ([]*entity.StreakFreeze, error) {
+	var freezes []*entity.StreakFreeze
+	for _, freeze := range m.freezes {
+		if freeze.IsUsed() {
+			freezes = append(freezes, freeze)
+		}
+	}
+	return freezes, nil
+}
+
+func (m *mockStreakFreezeRepository) FindByCompletionID(ctx context.Context, completionID string) ([]*entity.StreakFreeze, error) {
+	var freezes []*entity.StreakFreeze
+	for _, freeze := range m.freezes {
+		if freeze.CompletionID() == completionID {
+			freezes = append(freezes, freeze)
+		}
+	}
+	return freezes, nil
+}
+
+func (m *mockStreakFreezeRepository) Update(ctx context.Context, freeze *entity.StreakFreeze) error {
+	return nil
+}
+
+func (m *mockStreakFreezeRepository) Delete(ctx context.Context, id string) error {
+	for i, freeze := range m.freezes {
+		if freeze.ID() == id {
+			m.freezes = append(m.freezes[:i], m.freezes[i+1:]...)
+			return nil
+		}
+	}
+	return errors.New("streak freeze not found")
+}
+
+// setupTestRouterForHabits creates a test router with habit endpoints
+func setupTestRouterForHabits(habitRepo *mockHabitRepository) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.Default()
+
+	mockChar := entity.ReconstituteCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), 1, 90, 90, 0, "test-user-123", time.Now())
+	charRepo := &mockCharacterRepositoryForAttributeTests{
+		findByIDFunc: func(ctx context.Context, id string) (*entity.Character, error) {
+			return mockChar, nil
+		},
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Character, error) {
+			if id == "char-123" && userID == "test-user-123" {
+				return mockChar, nil
+			}
+			return nil, errors.New("character not found or does not belong to user")
+		},
+		updateFunc: func(ctx context.Context, character *entity.Character) error {
+			return nil
+		},
+	}
+	mockAttr := entity.ReconstituteCharacterAttribute(1, "Força", 5, "char-123", time.Now())
+	attrRepo := &mockCharacterAttributeRepository{
+		existsByNameFunc: func(ctx context.Context, characterID string, attributeName string) (bool, error) {
+			return attributeName == "Força" || attributeName == "Destreza", nil
+		},
+		findByNameFunc: func(ctx context.Context, characterID string, attributeName string) (*entity.CharacterAttribute, error) {
+			return mockAttr, nil
+		},
+		updateFunc: func(ctx context.Context, attribute *entity.CharacterAttribute) error {
+			return nil
+		},
+	}
+	completionRepo := &mockHabitCompletionRepository{completions: map[string]*entity.HabitCompletion{}}
+	freezeRepo := &mockStreakFreezeRepository{}
+	preferencesRepo := newMockUserPreferencesRepository()
+
+	// Create handler
+	habitHandler := deliveryHttp.NewHabitHandler(
+		usecase.NewCreateHabitUseCase(habitRepo, charRepo, attrRepo),
+		usecase.NewListHabitsUseCase(habitRepo, completionRepo, freezeRepo, preferencesRepo),
+		usecase.NewGetHabitUseCase(habitRepo, completionRepo, freezeRepo, preferencesRepo),
+		usecase.NewUpdateHabitUseCase(habitRepo, attrRepo),
+		usecase.NewDeleteHabitUseCase(habitRepo),
+		usecase.NewCompleteHabitUseCase(habitRepo, completionRepo, freezeRepo, charRepo, attrRepo, preferencesRepo, newMockGuildRepository(), newMockRaidRepository(), newMockCharacterEnergyRepository(), &mockUnitOfWork{}),
+		usecase.NewUndoHabitCompletionUseCase(habitRepo, completionRepo, freezeRepo, charRepo, attrRepo, newMockGuildRepository(), newMockRaidRepository(), newMockCharacterEnergyRepository(), &mockUnitOfWork{}),
+		usecase.NewGetDueHabitsUseCase(habitRepo, completionRepo, preferencesRepo),
+		usecase.NewUseStreakFreezeUseCase(habitRepo, completionRepo, freezeRepo, preferencesRepo),
+	)
+
+	// Create auth middleware with mock JWT service
+	authMiddleware := middleware.NewAuthMiddleware(&mockJWTService{})
+
+	// Setup routes
+	v1 := router.Group("/api/v1")
+	{
+		authenticated := v1.Group("")
+		authenticated.Use(authMiddleware.RequireAuth())
+		{
+			authenticated.POST("/habit", habitHandler.Create)
+			authenticated.GET("/habit", habitHandler.List)
+			authenticated.GET("/habit/due", habitHandler.Due)
+			authenticated.GET("/habit/:id", habitHandler.GetByID)
+			authenticated.PUT("/habit/:id", habitHandler.Update)
+			authenticated.DELETE("/habit/:id", habitHandler.Delete)
+			authenticated.POST("/habit/:id/complete", habitHandler.Complete)
+			authenticated.DELETE("/habit/:id/completion/:completionId", habitHandler.UndoCompletion)
+			authenticated.POST("/habit/:id/freeze", habitHandler.Freeze)
+		}
+	}
+
+	return router
+}
+
+// seedHabit stores a habit owned by char-123 in the mock repository
+func seedHabit(habitRepo *mockHabitRepository) *entity.Habit {
+	difficulty, _ := valueobject.NewDifficulty("easy")
+	habit := entity.ReconstituteHabit("habit-123", "Push-ups", "", "char-123", "Força", difficulty, valueobject.NewDailyRecurrence(), false, false, true, time.Now(), time.Now())
+	habitRepo.habits[habit.ID()] = habit
+	return habit
+}
+
+func performJSONRequest(router *gin.Engine, method, path string, body interface{}) *httptest.ResponseRecorder {
+	var buf bytes.Buffer
+	if body != nil {
+		json.NewEncoder(&buf).Encode(body)
+	}
+
+	req, _ := http.NewRequest(method, path, &buf)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer valid_token")
+
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, req)
+	return w
+}
+
+func TestHabitHandler_Create_Success(t *testing.T) {
+	habitRepo := newMockHabitRepository()
+	router := setupTestRouterForHabits(habitRepo)
+
+	w := performJSONRequest(router, "POST", "/api/v1/habit", map[string]interface{}{
+		"characterId":   "char-123",
+		"title":         "Push-ups",
+		"attributeName": "Força",
+		"difficulty":    "medium",
+	})
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusCreated, w.Body.String())
+	}
+
+	var response map[string]interface{}
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	if response["title"] != "Push-ups" {
+		t.Errorf("response title = %v, want %v", response["title"], "Push-ups")
+	}
+
+	if response["difficulty"] != "medium" {
+		t.Errorf("response difficulty = %v, want %v", response["difficulty"], "medium")
+	}
+
+	if response["active"] != true {
+		t.Errorf("response active = %v, want %v", response["active"], true)
+	}
+
+	if len(habitRepo.habits) != 1 {
+		t.Errorf("stored habits = %v, want %v", len(habitRepo.habits), 1)
+	}
+}
+
+func TestHabitHandler_Create_CharacterNotOwned(t *testing.T) {
+	router := setupTestRouterForHabits(newMockHabitRepository())
+
+	w := performJSONRequest(router, "POST", "/api/v1/habit", map[string]interface{}{
+		"characterId":   "char-999",
+		"title":         "Push-ups",
+		"attributeName": "Força",
+		"difficulty":    "medium",
+	})
+
+	if w.Code != http.StatusForbidden {
+		t.Errorf("Status code = %v, want %v", w.Code, http.StatusForbidden)
+	}
+}
+
+func TestHabitHandler_Create_UnknownAttribute(t *testing.T) {
+	router := setupTestRouterForHabits(newMockHabitRepository())
+
+	w := performJSONRequest(router, "POST", "/api/v1/habit", map[string]interface{}{
+		"characterId":   "char-123",
+		"title":         "Push-ups",
+		"attributeName": "Sorte",
+		"difficulty":    "medium",
+	})
+
+	if w.Code != http.StatusUnprocessableEntity {
+		t.Errorf("Status code = %v, want %v", w.Code, http.StatusUnprocessableEntity)
+	}
+}
+
+func TestHabitHandler_Create_MissingFields(t *testing.T) {
+	router := setupTestRouterForHabits(newMockHabitRepository())
+
+	w := performJSONRequest(router, "POST", "/api/v1/habit", map[string]interface{}{
+		"title": "Push-ups",
+	})
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Status code = %v, want %v", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHabitHandler_List(t *testing.T) {
+	habitRepo := newMockHabitRepository()
+	seedHabit(habitRepo)
+	router := setupTestRouterForHabits(habitRepo)
+
+	w := performJSONRequest(router, "GET", "/api/v1/habit", nil)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Status code = %v, want %v", w.Code, http.StatusOK)
+	}
+
+	var response map[string]interface{}
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	habits, ok := response["habits"].([]interface{})
+	if !ok {
+		t.Fatal("response habits should be an array")
+	}
+
+	if len(habits) != 1 {
+		t.Errorf("len(habits) = %v, want %v", len(habits), 1)
+	}
+}
+
+func TestHabitHandler_GetByID_NotFound(t *testing.T) {
+	router := setupTestRouterForHabits(newMockHabitRepository())
+
+	w := performJSONRequest(router, "GET", "/api/v1/habit/unknown", nil)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Status code = %v, want %v", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestHabitHandler_Update_Success(t *testing.T) {
+	habitRepo := newMockHabitRepository()
+	seedHabit(habitRepo)
+	router := setupTestRouterForHabits(habitRepo)
+
+	w := performJSONRequest(router, "PUT", "/api/v1/habit/habit-123", map[string]interface{}{
+		"title":         "Burpees",
+		"description":   "Full body",
+		"attributeName": "Destreza",
+		"difficulty":    "hard",
+		"active":        false,
+	})
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	stored := habitRepo.habits["habit-123"]
+	if stored.Title() != "Burpees" || stored.AttributeName() != "Destreza" || stored.Active() {
+		t.Errorf("stored habit = (%v, %v, %v), want (Burpees, Destreza, false)", stored.Title(), stored.AttributeName(), stored.Active())
+	}
+}
+
+func TestHabitHandler_Update_MissingActive(t *testing.T) {
+	habitRepo := newMockHabitRepository()
+	seedHabit(habitRepo)
+	router := setupTestRouterForHabits(habitRepo)
+
+	w := performJSONRequest(router, "PUT", "/api/v1/habit/habit-123", map[string]interface{}{
+		"title":         "Burpees",
+		"attributeName": "Força",
+		"difficulty":    "hard",
+	})
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Status code = %v, want %v", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHabitHandler_Delete(t *testing.T) {
+	habitRepo := newMockHabitRepository()
+	seedHabit(habitRepo)
+	router := setupTestRouterForHabits(habitRepo)
+
+	w := performJSONRequest(router, "DELETE", "/api/v1/habit/habit-123", nil)
+
+	if w.Code != http.StatusNoContent {
+		t.Fatalf("Status code = %v, want %v", w.Code, http.StatusNoContent)
+	}
+
+	if len(habitRepo.habits) != 0 {
+		t.Errorf("stored habits = %v, want 0", len(habitRepo.habits))
+	}
+}
+
+func TestHabitHandler_Complete_LevelUp(t *testing.T) {
+	habitRepo := newMockHabitRepository()
+	seedHabit(habitRepo)
+	router := setupTestRouterForHabits(habitRepo)
+
+	// Character starts with 90/100 XP, an easy habit grants 10 XP
+	w := performJSONRequest(router, "POST", "/api/v1/habit/habit-123/complete", nil)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusCreated, w.Body.String())
+	}
+
+	var response map[string]interface{}
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	if response["xpGained"] != float64(10) {
+		t.Errorf("response xpGained = %v, want %v", response["xpGained"], 10)
+	}
+
+	if response["levelsGained"] != float64(1) {
+		t.Errorf("response levelsGained = %v, want %v", response["levelsGained"], 1)
+	}
+
+	if response["level"] != float64(2) {
+		t.Errorf("response level = %v, want %v", response["level"], 2)
+	}
+
+	if response["attributeValue"] != float64(6) {
+		t.Errorf("response attributeValue = %v, want %v", response["attributeValue"], 6)
+	}
+
+	if response["completionId"] == nil || response["completionId"] == "" {
+		t.Error("response completionId should not be empty")
+	}
+}
+
+func TestHabitHandler_UndoCompletion_RevertsLevelUp(t *testing.T) {
+	habitRepo := newMockHabitRepository()
+	seedHabit(habitRepo)
+	router := setupTestRouterForHabits(habitRepo)
+
+	// Character starts with 90/100 XP; the completion levels it up
+	w := performJSONRequest(router, "POST", "/api/v1/habit/habit-123/complete", nil)
+
+	var completed map[string]interface{}
+	json.Unmarshal(w.Body.Bytes(), &completed)
+
+	w = performJSONRequest(router, "DELETE", "/api/v1/habit/habit-123/completion/"+completed["completionId"].(string), nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response dto.UndoHabitCompletionResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	if response.XpReverted != 10 || response.LevelsReverted != 1 {
+		t.Errorf("reverted = (%v xp, %v levels), want (10, 1)", response.XpReverted, response.LevelsReverted)
+	}
+
+	if response.Level != 1 || response.CurrentXp != 90 || response.TotalXp != 90 || response.AttributeValue != 5 {
+		t.Errorf("response = %+v, want level 1 with 90/90 XP and attribute 5", response)
+	}
+
+	// The completion is gone
+	w = performJSONRequest(router, "DELETE", "/api/v1/habit/habit-123/completion/"+completed["completionId"].(string), nil)
+	if w.Code != http.StatusNotFound {
+		t.Errorf("second undo status code = %v, want %v", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestHabitHandler_UndoCompletion_UnknownCompletion(t *testing.T) {
+	habitRepo := newMockHabitRepository()
+	seedHabit(habitRepo)
+	router := setupTestRouterForHabits(habitRepo)
+
+	w := performJSONRequest(router, "DELETE", "/api/v1/habit/habit-123/completion/missing", nil)
+
+	if w.Code != http.StatusNotFound {
+		t.Errorf("Status code = %v, want %v", w.Code, http.StatusNotFound)
+	}
+}
+
+func TestHabitHandler_Complete_NegativeHabit(t *testing.T) {
+	habitRepo := newMockHabitRepository()
+	seedHabit(habitRepo).MakeNegative(true)
+	router := setupTestRouterForHabits(habitRepo)
+
+	// Character starts with 90/100 XP, an easy bad habit costs 10 XP
+	w := performJSONRequest(router, "POST", "/api/v1/habit/habit-123/complete", nil)
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusCreated, w.Body.String())
+	}
+
+	var response map[string]interface{}
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	if response["xpGained"] != float64(-10) {
+		t.Errorf("response xpGained = %v, want %v", response["xpGained"], -10)
+	}
+
+	if response["attributeValue"] != float64(4) {
+		t.Errorf("response attributeValue = %v, want %v", response["attributeValue"], 4)
+	}
+}
+
+func TestHabitHandler_Complete_InactiveHabit(t *testing.T) {
+	habitRepo := newMockHabitRepository()
+	seedHabit(habitRepo).Deactivate()
+	router := setupTestRouterForHabits(habitRepo)
+
+	w := performJSONRequest(router, "POST", "/api/v1/habit/habit-123/complete", nil)
+
+	if w.Code != http.StatusConflict {
+		t.Errorf("Status code = %v, want %v", w.Code, http.StatusConflict)
+	}
+}
+
+func TestHabitHandler_Complete_AlreadyCompletedToday(t *testing.T) {
+	habitRepo := newMockHabitRepository()
+	seedHabit(habitRepo)
+	router := setupTestRouterForHabits(habitRepo)
+
+	w := performJSONRequest(router, "POST", "/api/v1/habit/habit-123/complete", nil)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("first completion status code = %v, want %v (body: %s)", w.Code, http.StatusCreated, w.Body.String())
+	}
+
+	// A daily habit takes one completion a day
+	w = performJSONRequest(router, "POST", "/api/v1/habit/habit-123/complete", nil)
+
+	if w.Code != http.StatusConflict {
+		t.Fatalf("Status code = %v, want %v", w.Code, http.StatusConflict)
+	}
+
+	var response dto.ErrorResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	if response.Error != "habit_already_completed" {
+		t.Errorf("response error = %v, want %v", response.Error, "habit_already_completed")
+	}
+}
+
+func TestHabitHandler_Due(t *testing.T) {
+	habitRepo := newMockHabitRepository()
+	easy, _ := valueobject.NewDifficulty("easy")
+	weekends, _ := valueobject.NewRecurrence("weekly", []string{"sat", "sun"}, 0, 0, "")
+	createdAt := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	habitRepo.habits["daily"] = entity.ReconstituteHabit("daily", "Read", "", "char-123", "Força", easy, valueobject.NewDailyRecurrence(), false, false, true, createdAt, createdAt)
+	habitRepo.habits["weekends"] = entity.ReconstituteHabit("weekends", "Hike", "", "char-123", "Força", easy, weekends, false, false, true, createdAt, createdAt)
+	router := setupTestRouterForHabits(habitRepo)
+
+	// 2024-01-10 is a Wednesday
+	w := performJSONRequest(router, "GET", "/api/v1/habit/due?date=2024-01-10", nil)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response dto.GetDueHabitsResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	if response.Date != "2024-01-10" {
+		t.Errorf("response date = %v, want %v", response.Date, "2024-01-10")
+	}
+
+	if len(response.Habits) != 1 || response.Habits[0].Habit.ID != "daily" {
+		t.Errorf("response habits = %+v, want only the daily habit", response.Habits)
+	}
+}
+
+func TestHabitHandler_Due_InvalidDate(t *testing.T) {
+	router := setupTestRouterForHabits(newMockHabitRepository())
+
+	w := performJSONRequest(router, "GET", "/api/v1/habit/due?date=10/01/2024", nil)
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Status code = %v, want %v", w.Code, http.StatusBadRequest)
+	}
+}
+
+func TestHabitHandler_Create_WithRecurrence(t *testing.T) {
+	habitRepo := newMockHabitRepository()
+	router := setupTestRouterForHabits(habitRepo)
+
+	w := performJSONRequest(router, "POST", "/api/v1/habit", map[string]interface{}{
+		"characterId":   "char-123",
+		"title":         "Gym",
+		"attributeName": "Força",
+		"difficulty":    "hard",
+		"recurrence": map[string]interface{}{
+			"type":     "weekly",
+			"weekdays": []string{"mon", "wed", "fri"},
+		},
+	})
+
+	if w.Code != http.StatusCreated {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusCreated, w.Body.String())
+	}
+
+	var response dto.HabitResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	if response.Recurrence.Rule != "FREQ=WEEKLY;BYDAY=MO,WE,FR" {
+		t.Errorf("response recurrence rule = %v, want %v", response.Recurrence.Rule, "FREQ=WEEKLY;BYDAY=MO,WE,FR")
+	}
+}
+
+func TestHabitHandler_GetByID_IncludesStreak(t *testing.T) {
+	habitRepo := newMockHabitRepository()
+	seedHabit(habitRepo)
+	router := setupTestRouterForHabits(habitRepo)
+
+	performJSONRequest(router, "POST", "/api/v1/habit/habit-123/complete", nil)
+	w := performJSONRequest(router, "GET", "/api/v1/habit/habit-123", nil)
+
+	if w.Code != http.StatusOK {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response dto.HabitResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	if response.Streak == nil {
+		t.Fatal("response streak should be present")
+	}
+
+	if response.Streak.Current != 1 || response.Streak.Longest != 1 {
+		t.Errorf("response streak = %+v, want current 1 and longest 1", *response.Streak)
+	}
+}
+
+func TestHabitHandler_Freeze_NoTokens(t *testing.T) {
+	habitRepo := newMockHabitRepository()
+	habit := seedHabit(habitRepo)
+	created := time.Now().AddDate(0, 0, -5)
+	habitRepo.habits[habit.ID()] = entity.ReconstituteHabit(habit.ID(), habit.Title(), "", "char-123", "Força", habit.Difficulty(), habit.Recurrence(), false, false, true, created, created)
+	router := setupTestRouterForHabits(habitRepo)
+
+	w := performJSONRequest(router, "POST", "/api/v1/habit/habit-123/freeze", map[string]interface{}{
+		"date": time.Now().UTC().AddDate(0, 0, -1).Format("2006-01-02"),
+	})
+
+	if w.Code != http.StatusConflict {
+		t.Errorf("Status code = %v, want %v (body: %s)", w.Code, http.StatusConflict, w.Body.String())
+	}
+}
+
+func TestHabitHandler_Freeze_InvalidDate(t *testing.T) {
+	habitRepo := newMockHabitRepository()
+	seedHabit(habitRepo)
+	router := setupTestRouterForHabits(habitRepo)
+
+	w := performJSONRequest(router, "POST", "/api/v1/habit/habit-123/freeze", map[string]interface{}{
+		"date": "yesterday",
+	})
+
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Status code = %v, want %v", w.Code, http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,74 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+)
+
+// LevelCurveHandler handles level curve-related HTTP requests
+type LevelCurveHandler struct {
+	getXpTableUseCase *usecase.GetXpTableUseCase
+}
+
+// NewLevelCurveHandler creates a new LevelCurveHandler
+func NewLevelCurveHandler(getXpTableUseCase *usecase.GetXpTableUseCase) *LevelCurveHandler {
+	return &LevelCurveHandler{
+		getXpTableUseCase: getXpTableUseCase,
+	}
+}
+
+// GetXpTable handles GET /xp-table?levels=N - lists the XP needed by levels 1..N
+// This is a protected route that requires authentication
+func (h *LevelCurveHandler) GetXpTable(c *gin.Context) {
+	var query dto.XpTableQuery
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Execute use case
+	output, err := h.getXpTableUseCase.Execute(c.Request.Context(), usecase.GetXpTableInput{
+		Levels: query.Levels,
+	})
+
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidXpTableRange) {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "invalid_request",
+				Message: err.Error(),
+			})
+			return
+		}
+
+		// Generic error
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "failed_to_fetch_xp_table",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Convert use case output to DTOs
+	levels := make([]dto.XpTableLevelResponse, len(output.Levels))
+	for i, level := range output.Levels {
+		levels[i] = dto.XpTableLevelResponse{
+			Level:           level.Level,
+			XpForNextLevel:  level.XpForNextLevel,
+			TotalXpRequired: level.TotalXpRequired,
+		}
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, dto.XpTableResponse{
+		CurveType: output.CurveType,
+		MaxLevel:  output.MaxLevel,
+		Levels:    levels,
+	})
+}
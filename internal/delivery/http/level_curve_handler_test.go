@@ -0,0 +1,68 @@
+package http_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+	deliveryHttp "github.com/igor/chronotask-api/internal/delivery/http"
+	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+func setupTestRouterForLevelCurve() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.Default()
+
+	// Create handler
+	levelCurveHandler := deliveryHttp.NewLevelCurveHandler(usecase.NewGetXpTableUseCase(valueobject.DefaultLevelCurve()))
+
+	// Create auth middleware with mock JWT service
+	authMiddleware := middleware.NewAuthMiddleware(&mockJWTService{})
+
+	// Setup routes
+	v1 := router.Group("/api/v1")
+	{
+		authenticated := v1.Group("")
+		authenticated.Use(authMiddleware.RequireAuth())
+		{
+			authenticated.GET("/xp-table", levelCurveHandler.GetXpTable)
+		}
+	}
+
+	return router
+}
+
+func TestLevelCurveHandler_GetXpTable(t *testing.T) {
+	router := setupTestRouterForLevelCurve()
+
+	w := performJSONRequest(router, "GET", "/api/v1/xp-table?levels=3", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response dto.XpTableResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	if response.CurveType != "polynomial" || len(response.Levels) != 3 {
+		t.Fatalf("response = %+v, want 3 levels of the polynomial curve", response)
+	}
+	if response.Levels[1].XpForNextLevel != 283 || response.Levels[1].TotalXpRequired != 100 {
+		t.Errorf("Levels[1] = %+v, want 283 XP to level up from 100 total", response.Levels[1])
+	}
+}
+
+func TestLevelCurveHandler_GetXpTable_InvalidLevels(t *testing.T) {
+	router := setupTestRouterForLevelCurve()
+
+	for _, path := range []string{"/api/v1/xp-table?levels=-1", "/api/v1/xp-table?levels=501", "/api/v1/xp-table?levels=abc"} {
+		w := performJSONRequest(router, "GET", path, nil)
+		if w.Code != http.StatusBadRequest {
+			t.Errorf("%s: Status code = %v, want %v", path, w.Code, http.StatusBadRequest)
+		}
+	}
+}
@@ -0,0 +1,98 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+)
+
+// MonsterHandler handles monster catalog-related HTTP requests
+type MonsterHandler struct {
+	listMonstersUseCase *usecase.ListMonstersUseCase
+}
+
+// NewMonsterHandler creates a new MonsterHandler
+func NewMonsterHandler(listMonstersUseCase *usecase.ListMonstersUseCase) *MonsterHandler {
+	return &MonsterHandler{
+		listMonstersUseCase: listMonstersUseCase,
+	}
+}
+
+// List handles GET /monsters?level=5 - lists the monster catalog
+// With a level, only the monsters fighting at that level are listed, scaled to it
+// This is a protected route that requires authentication
+func (h *MonsterHandler) List(c *gin.Context) {
+	var query dto.ListMonstersQuery
+
+	// Bind and validate the level filter
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Execute use case
+	output, err := h.listMonstersUseCase.Execute(c.Request.Context(), usecase.ListMonstersInput{
+		Level: query.Level,
+	})
+
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidMonsterLevel) {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "invalid_request",
+				Message: err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "failed_to_fetch_monsters",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Convert use case output to DTOs
+	monsters := make([]dto.MonsterResponse, len(output.Monsters))
+	for i, monster := range output.Monsters {
+		monsters[i] = dto.MonsterResponse{
+			ID:         monster.ID,
+			Name:       monster.Name,
+			MinLevel:   monster.MinLevel,
+			MaxLevel:   monster.MaxLevel,
+			Level:      monster.Level,
+			Attributes: monster.Attributes,
+			HP:         monster.HP,
+			Attack:     monster.Attack,
+			Magic:      monster.Magic,
+			Defense:    monster.Defense,
+			Evasion:    monster.Evasion,
+			Initiative: monster.Initiative,
+			XpReward:   monster.XpReward,
+			Loot:       toLootDropResponses(monster.Loot),
+		}
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, dto.ListMonstersResponse{
+		Monsters: monsters,
+	})
+}
+
+// toLootDropResponses converts loot drop outputs to DTOs
+func toLootDropResponses(loot []usecase.LootDropOutput) []dto.LootDropResponse {
+	responses := make([]dto.LootDropResponse, len(loot))
+	for i, drop := range loot {
+		responses[i] = dto.LootDropResponse{
+			Item:     drop.Item,
+			Chance:   drop.Chance,
+			Quantity: drop.Quantity,
+		}
+	}
+	return responses
+}
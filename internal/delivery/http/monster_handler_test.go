@@ -0,0 +1,89 @@
+package http_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+	deliveryHttp "github.com/igor/chronotask-api/internal/delivery/http"
+	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+func setupTestRouterForMonsters() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.Default()
+
+	ogreAttributes := map[string]int{}
+	for _, profile := range valueobject.DefaultCharacterClass().Attributes() {
+		ogreAttributes[profile.Name] = 14
+	}
+
+	monsterRepo := &mockMonsterRepository{monsters: map[string]*entity.Monster{
+		"rato-gigante": newTestRat(),
+		"ogro":         entity.ReconstituteMonster("ogro", "Ogro", 10, 20, ogreAttributes, nil, 180),
+	}}
+
+	monsterHandler := deliveryHttp.NewMonsterHandler(usecase.NewListMonstersUseCase(monsterRepo))
+	authMiddleware := middleware.NewAuthMiddleware(&mockJWTService{})
+
+	authenticated := router.Group("/api/v1")
+	authenticated.Use(authMiddleware.RequireAuth())
+	authenticated.GET("/monsters", monsterHandler.List)
+
+	return router
+}
+
+func TestMonsterHandler_List(t *testing.T) {
+	router := setupTestRouterForMonsters()
+
+	w := performJSONRequest(router, "GET", "/api/v1/monsters", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response dto.ListMonstersResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	if len(response.Monsters) != 2 {
+		t.Fatalf("len(monsters) = %v, want 2", len(response.Monsters))
+	}
+
+	rat := response.Monsters[0]
+	if rat.ID != "rato-gigante" || rat.Level != 1 || rat.XpReward != 15 || rat.HP == 0 {
+		t.Errorf("monsters[0] = %+v, want the level 1 giant rat", rat)
+	}
+	if len(rat.Loot) != 1 || rat.Loot[0].Item != "Rabo de Rato" || rat.Loot[0].Chance != 100 {
+		t.Errorf("rat.Loot = %+v, want its tail at 100%%", rat.Loot)
+	}
+	if ogre := response.Monsters[1]; ogre.Level != 10 || ogre.MaxLevel != 20 || ogre.Loot == nil {
+		t.Errorf("monsters[1] = %+v, want the level 10 ogre with an empty loot table", ogre)
+	}
+}
+
+func TestMonsterHandler_List_ByLevel(t *testing.T) {
+	router := setupTestRouterForMonsters()
+
+	w := performJSONRequest(router, "GET", "/api/v1/monsters?level=15", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response dto.ListMonstersResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	// The ogre is scaled to level 15: 50% above its minimum level
+	if len(response.Monsters) != 1 || response.Monsters[0].ID != "ogro" || response.Monsters[0].Level != 15 || response.Monsters[0].XpReward != 270 {
+		t.Errorf("monsters = %+v, want only the ogre at level 15 worth 270 XP", response.Monsters)
+	}
+
+	w = performJSONRequest(router, "GET", "/api/v1/monsters?level=-1", nil)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Status code with level -1 = %v, want %v", w.Code, http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,158 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
+)
+
+// RatingHandler handles competitive rating-related HTTP requests
+type RatingHandler struct {
+	getCharacterRatingUseCase *usecase.GetCharacterRatingUseCase
+	getLeaderboardUseCase     *usecase.GetLeaderboardUseCase
+}
+
+// NewRatingHandler creates a new RatingHandler
+func NewRatingHandler(
+	getCharacterRatingUseCase *usecase.GetCharacterRatingUseCase,
+	getLeaderboardUseCase *usecase.GetLeaderboardUseCase,
+) *RatingHandler {
+	return &RatingHandler{
+		getCharacterRatingUseCase: getCharacterRatingUseCase,
+		getLeaderboardUseCase:     getLeaderboardUseCase,
+	}
+}
+
+// GetByCharacterID handles GET /character/:characterId/rating - gets a character's rating and its latest changes
+// This is a protected route that requires authentication
+func (h *RatingHandler) GetByCharacterID(c *gin.Context) {
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates character ownership)
+	output, err := h.getCharacterRatingUseCase.Execute(c.Request.Context(), usecase.GetCharacterRatingInput{
+		CharacterID: c.Param("characterId"),
+		UserID:      userID,
+	})
+
+	if err != nil {
+		if errors.Is(err, usecase.ErrCharacterNotFound) {
+			c.JSON(http.StatusForbidden, dto.ErrorResponse{
+				Error:   "forbidden",
+				Message: "character not found or you are not authorized to access it",
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "failed_to_fetch_rating",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, dto.CharacterRatingResponse{
+		CharacterID:  output.CharacterID,
+		Rating:       output.Rating,
+		Deviation:    output.Deviation,
+		Volatility:   output.Volatility,
+		Wins:         output.Wins,
+		Losses:       output.Losses,
+		Draws:        output.Draws,
+		Games:        output.Games,
+		LastPlayedAt: output.LastPlayedAt,
+		History:      toRatingChangeResponses(output.History),
+	})
+}
+
+// Leaderboard handles GET /leaderboard?page=1&pageSize=20 - lists the characters with ranked battles by rating
+// This is a protected route that requires authentication
+func (h *RatingHandler) Leaderboard(c *gin.Context) {
+	var query dto.LeaderboardQuery
+
+	// Bind and validate pagination
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Execute use case
+	output, err := h.getLeaderboardUseCase.Execute(c.Request.Context(), usecase.GetLeaderboardInput{
+		Page:     query.Page,
+		PageSize: query.PageSize,
+	})
+
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidPagination) {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "invalid_request",
+				Message: err.Error(),
+			})
+			return
+		}
+
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "failed_to_fetch_leaderboard",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Convert use case output to DTOs
+	entryDTOs := make([]dto.LeaderboardEntryResponse, len(output.Entries))
+	for i, entry := range output.Entries {
+		entryDTOs[i] = dto.LeaderboardEntryResponse{
+			Rank:        entry.Rank,
+			CharacterID: entry.CharacterID,
+			Name:        entry.Name,
+			Class:       entry.Class,
+			Level:       entry.Level,
+			Rating:      entry.Rating,
+			Deviation:   entry.Deviation,
+			Wins:        entry.Wins,
+			Losses:      entry.Losses,
+			Draws:       entry.Draws,
+		}
+	}
+
+	c.JSON(http.StatusOK, dto.LeaderboardResponse{
+		Entries:  entryDTOs,
+		Page:     output.Page,
+		PageSize: output.PageSize,
+		Total:    output.Total,
+	})
+}
+
+// toRatingChangeResponses converts rating change outputs to DTOs
+func toRatingChangeResponses(changes []usecase.RatingChangeOutput) []dto.RatingChangeResponse {
+	responses := make([]dto.RatingChangeResponse, len(changes))
+	for i, change := range changes {
+		responses[i] = dto.RatingChangeResponse{
+			CharacterID:         change.CharacterID,
+			BattleID:            change.BattleID,
+			OpponentCharacterID: change.OpponentCharacterID,
+			Result:              change.Result,
+			RatingBefore:        change.RatingBefore,
+			RatingAfter:         change.RatingAfter,
+			Delta:               change.Delta,
+			DeviationAfter:      change.DeviationAfter,
+			CreatedAt:           change.CreatedAt,
+		}
+	}
+	return responses
+}
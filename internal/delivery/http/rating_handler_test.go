@@ -0,0 +1,195 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+	deliveryHttp "github.com/igor/chronotask-api/internal/delivery/http"
+	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Mock CharacterRatingRepository (history entries are stored oldest first)
+type mockCharacterRatingRepository struct {
+	ratings map[string]*entity.CharacterRating
+	changes []*entity.RatingChange
+}
+
+func newMockCharacterRatingRepository() *mockCharacterRatingRepository {
+	return &mockCharacterRatingRepository{ratings: map[string]*entity.CharacterRating{}}
+}
+
+func (m *mockCharacterRatingRepository) FindByCharacterID(ctx context.Context, characterID string) (*entity.CharacterRating, error) {
+	return m.ratings[characterID], nil
+}
+
+func (m *mockCharacterRatingRepository) FindByCharacterIDForUpdate(ctx context.Context, characterID string) (*entity.CharacterRating, error) {
+	return m.ratings[characterID], nil
+}
+
+func (m *mockCharacterRatingRepository) Create(ctx context.Context, rating *entity.CharacterRating) error {
+	if _, ok := m.ratings[rating.CharacterID()]; !ok {
+		m.ratings[rating.CharacterID()] = rating
+	}
+	return nil
+}
+
+func (m *mockCharacterRatingRepository) Save(ctx context.Context, rating *entity.CharacterRating) error {
+	m.ratings[rating.CharacterID()] = rating
+	m.changes = append(m.changes, rating.PendingChanges()...)
+	rating.ClearPendingChanges()
+	return nil
+}
+
+func (m *mockCharacterRatingRepository) FindLeaderboard(ctx context.Context, limit int, offset int) ([]*entity.CharacterRating, error) {
+	var ratings []*entity.CharacterRating
+	for _, rating := range m.ratings {
+		ratings = append(ratings, rating)
+	}
+	slices.SortFunc(ratings, func(a, b *entity.CharacterRating) int {
+		if a.Rating().Rating() > b.Rating().Rating() {
+			return -1
+		}
+		return 1
+	})
+
+	if offset >= len(ratings) {
+		return nil, nil
+	}
+	return ratings[offset:min(offset+limit, len(ratings))], nil
+}
+
+func (m *mockCharacterRatingRepository) CountRated(ctx context.Context) (int, error) {
+	return len(m.ratings), nil
+}
+
+func (m *mockCharacterRatingRepository) FindRecentChangesByCharacterID(ctx context.Context, characterID string, limit int) ([]*entity.RatingChange, error) {
+	var changes []*entity.RatingChange
+	for i := len(m.changes) - 1; i >= 0 && len(changes) < limit; i-- {
+		if m.changes[i].CharacterID() == characterID {
+			changes = append(changes, m.changes[i])
+		}
+	}
+	return changes, nil
+}
+
+// setupTestRouterForRatings builds the rating routes for test-user-123 (char-123)
+// char-123 won a ranked battle against char-456, owned by another user
+func setupTestRouterForRatings(t *testing.T) *gin.Engine {
+	t.Helper()
+	gin.SetMode(gin.TestMode)
+
+	router := gin.Default()
+
+	characters := map[string]*entity.Character{
+		"char-123": entity.ReconstituteCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), 10, 0, 0, 0, "test-user-123", time.Now()),
+		"char-456": entity.ReconstituteCharacter("char-456", "Rival", valueobject.DefaultCharacterClass(), 8, 0, 0, 0, "other-user-456", time.Now()),
+	}
+
+	charRepo := &mockCharacterRepositoryForAttributeTests{
+		findByIDFunc: func(ctx context.Context, id string) (*entity.Character, error) {
+			if character, ok := characters[id]; ok {
+				return character, nil
+			}
+			return nil, errors.New("character not found")
+		},
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Character, error) {
+			if character, ok := characters[id]; ok && character.UserID() == userID {
+				return character, nil
+			}
+			return nil, errors.New("character not found or does not belong to user")
+		},
+	}
+
+	ratingRepo := newMockCharacterRatingRepository()
+	winner, _ := entity.NewCharacterRating("char-123")
+	loser, _ := entity.NewCharacterRating("char-456")
+	playedAt := time.Now().UTC().Add(-time.Hour)
+	winner.RecordResult("battle-1", "char-456", loser.Rating(), valueobject.RatingScoreWin, playedAt, 24*time.Hour)
+	loser.RecordResult("battle-1", "char-123", valueobject.InitialGlickoRating(), valueobject.RatingScoreLoss, playedAt, 24*time.Hour)
+	ratingRepo.Save(context.Background(), winner)
+	ratingRepo.Save(context.Background(), loser)
+
+	// Create handler
+	ratingHandler := deliveryHttp.NewRatingHandler(
+		usecase.NewGetCharacterRatingUseCase(charRepo, ratingRepo, 24*time.Hour),
+		usecase.NewGetLeaderboardUseCase(charRepo, ratingRepo, 24*time.Hour),
+	)
+
+	// Create auth middleware with mock JWT service
+	authMiddleware := middleware.NewAuthMiddleware(&mockJWTService{})
+
+	// Setup routes
+	v1 := router.Group("/api/v1")
+	{
+		authenticated := v1.Group("")
+		authenticated.Use(authMiddleware.RequireAuth())
+		{
+			authenticated.GET("/leaderboard", ratingHandler.Leaderboard)
+			authenticated.GET("/character/:characterId/rating", ratingHandler.GetByCharacterID)
+		}
+	}
+
+	return router
+}
+
+func TestRatingHandler_GetByCharacterID(t *testing.T) {
+	router := setupTestRouterForRatings(t)
+
+	w := performJSONRequest(router, "GET", "/api/v1/character/char-123/rating", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response dto.CharacterRatingResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	if response.CharacterID != "char-123" || response.Rating <= 1500 || response.Deviation >= 350 || response.Wins != 1 || response.Games != 1 {
+		t.Errorf("response = %+v, want a rating above 1500 after one win", response)
+	}
+	if len(response.History) != 1 || response.History[0].Result != "win" || response.History[0].BattleID != "battle-1" || response.History[0].Delta <= 0 {
+		t.Errorf("history = %+v, want the win in battle-1", response.History)
+	}
+
+	// Another user's character
+	w = performJSONRequest(router, "GET", "/api/v1/character/char-456/rating", nil)
+	if w.Code != http.StatusForbidden {
+		t.Errorf("Status code = %v, want %v", w.Code, http.StatusForbidden)
+	}
+}
+
+func TestRatingHandler_Leaderboard(t *testing.T) {
+	router := setupTestRouterForRatings(t)
+
+	w := performJSONRequest(router, "GET", "/api/v1/leaderboard?page=1&pageSize=10", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response dto.LeaderboardResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	if response.Total != 2 || len(response.Entries) != 2 {
+		t.Fatalf("response = %+v, want 2 ranked characters", response)
+	}
+	if first := response.Entries[0]; first.Rank != 1 || first.CharacterID != "char-123" || first.Name != "Warrior King" || first.Class == "" {
+		t.Errorf("first = %+v, want Warrior King (char-123) ranked 1st", first)
+	}
+	if second := response.Entries[1]; second.Rank != 2 || second.Losses != 1 || second.Rating >= 1500 {
+		t.Errorf("second = %+v, want the loser ranked 2nd below 1500", second)
+	}
+
+	w = performJSONRequest(router, "GET", "/api/v1/leaderboard?pageSize=51", nil)
+	if w.Code != http.StatusBadRequest {
+		t.Errorf("Status code with page size 51 = %v, want %v", w.Code, http.StatusBadRequest)
+	}
+}
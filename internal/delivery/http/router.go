@@ -11,6 +11,21 @@ type Router struct {
 	userHandler               *UserHandler
 	characterHandler          *CharacterHandler
 	characterAttributeHandler *CharacterAttributeHandler
+	habitHandler              *HabitHandler
+	focusSessionHandler       *FocusSessionHandler
+	taskHandler               *TaskHandler
+	userPreferencesHandler    *UserPreferencesHandler
+	xpHistoryHandler          *XpHistoryHandler
+	levelCurveHandler         *LevelCurveHandler
+	characterClassHandler     *CharacterClassHandler
+	characterStatsHandler     *CharacterStatsHandler
+	battleHandler             *BattleHandler
+	ratingHandler             *RatingHandler
+	monsterHandler            *MonsterHandler
+	dungeonHandler            *DungeonHandler
+	guildHandler              *GuildHandler
+	energyHandler             *EnergyHandler
+	tournamentHandler         *TournamentHandler
 	authMiddleware            *middleware.AuthMiddleware
 	corsMiddleware            *middleware.CORSMiddleware
 }
@@ -23,12 +38,42 @@ func NewRouter(
 	corsMiddleware *middleware.CORSMiddleware,
 	characterHandler *CharacterHandler,
 	characterAttributeHandler *CharacterAttributeHandler,
+	habitHandler *HabitHandler,
+	focusSessionHandler *FocusSessionHandler,
+	taskHandler *TaskHandler,
+	userPreferencesHandler *UserPreferencesHandler,
+	xpHistoryHandler *XpHistoryHandler,
+	levelCurveHandler *LevelCurveHandler,
+	characterClassHandler *CharacterClassHandler,
+	characterStatsHandler *CharacterStatsHandler,
+	battleHandler *BattleHandler,
+	ratingHandler *RatingHandler,
+	monsterHandler *MonsterHandler,
+	dungeonHandler *DungeonHandler,
+	guildHandler *GuildHandler,
+	energyHandler *EnergyHandler,
+	tournamentHandler *TournamentHandler,
 ) *Router {
 	return &Router{
 		healthHandler:             healthHandler,
 		userHandler:               userHandler,
 		characterHandler:          characterHandler,
 		characterAttributeHandler: characterAttributeHandler,
+		habitHandler:              habitHandler,
+		focusSessionHandler:       focusSessionHandler,
+		taskHandler:               taskHandler,
+		userPreferencesHandler:    userPreferencesHandler,
+		xpHistoryHandler:          xpHistoryHandler,
+		levelCurveHandler:         levelCurveHandler,
+		characterClassHandler:     characterClassHandler,
+		characterStatsHandler:     characterStatsHandler,
+		battleHandler:             battleHandler,
+		ratingHandler:             ratingHandler,
+		monsterHandler:            monsterHandler,
+		dungeonHandler:            dungeonHandler,
+		guildHandler:              guildHandler,
+		energyHandler:             energyHandler,
+		tournamentHandler:         tournamentHandler,
 		authMiddleware:            authMiddleware,
 		corsMiddleware:            corsMiddleware,
 	}
@@ -58,16 +103,91 @@ func (r *Router) SetupRoutes() *gin.Engine {
 			// User protected routes
 			authenticated.GET("/user/profile", r.userHandler.GetProfile)
 			authenticated.GET("/user/character", r.characterHandler.GetList)
+			authenticated.GET("/user/preferences", r.userPreferencesHandler.Get)
+			authenticated.PUT("/user/preferences", r.userPreferencesHandler.Update)
 
 			// Character protected routes
 			authenticated.POST("/character", r.characterHandler.Create)
 
+			// Character Class protected routes
+			authenticated.GET("/classes", r.characterClassHandler.List)
+
 			// Character Attribute protected routes
 			authenticated.GET("/character/:characterId/attribute", r.characterAttributeHandler.GetByCharacterID)
+			authenticated.POST("/character/:characterId/attribute/allocate", r.characterAttributeHandler.Allocate)
+
+			// Character Stats protected routes
+			authenticated.GET("/character/:characterId/stats", r.characterStatsHandler.GetByCharacterID)
+
+			// XP Ledger protected routes
+			authenticated.GET("/character/:characterId/xp-history", r.xpHistoryHandler.GetByCharacterID)
+
+			// Level Curve protected routes
+			authenticated.GET("/xp-table", r.levelCurveHandler.GetXpTable)
+
+			// Battle protected routes
+			authenticated.POST("/battle/pve", r.battleHandler.Pve)
+			authenticated.GET("/battle/:id/replay", r.battleHandler.Replay)
+			authenticated.POST("/battle/challenge", r.battleHandler.CreateChallenge)
+			authenticated.POST("/battle/challenge/:id/accept", r.battleHandler.AcceptChallenge)
+			authenticated.POST("/battle/challenge/:id/decline", r.battleHandler.DeclineChallenge)
+			authenticated.GET("/character/:characterId/battle-challenges", r.battleHandler.ListChallenges)
+
+			// Monster catalog protected routes
+			authenticated.GET("/monsters", r.monsterHandler.List)
+
+			// Dungeon protected routes
+			authenticated.POST("/dungeon/:id/start", r.dungeonHandler.Start)
+			authenticated.POST("/dungeon/:id/advance", r.dungeonHandler.Advance)
+			authenticated.POST("/dungeon/:id/abandon", r.dungeonHandler.Abandon)
+
+			// Guild protected routes
+			authenticated.POST("/guild", r.guildHandler.Create)
+			authenticated.POST("/guild/:id/join", r.guildHandler.Join)
+			authenticated.POST("/guild/:id/raid", r.guildHandler.StartRaid)
+			authenticated.GET("/guild/:id/raid", r.guildHandler.GetRaid)
+
+			// Tournament protected routes
+			authenticated.POST("/tournament", r.tournamentHandler.Create)
+			authenticated.POST("/tournament/:id/join", r.tournamentHandler.Join)
+			authenticated.GET("/tournament/:id", r.tournamentHandler.Get)
+
+			// Rating protected routes
+			authenticated.GET("/leaderboard", r.ratingHandler.Leaderboard)
+			authenticated.GET("/character/:characterId/rating", r.ratingHandler.GetByCharacterID)
+
+			// Energy protected routes
+			authenticated.GET("/character/:characterId/energy", r.energyHandler.GetByCharacterID)
+
+			// Habit protected routes
+			authenticated.POST("/habit", r.habitHandler.Create)
+			authenticated.GET("/habit", r.habitHandler.List)
+			authenticated.GET("/habit/due", r.habitHandler.Due)
+			authenticated.GET("/habit/:id", r.habitHandler.GetByID)
+			authenticated.PUT("/habit/:id", r.habitHandler.Update)
+			authenticated.DELETE("/habit/:id", r.habitHandler.Delete)
+			authenticated.POST("/habit/:id/complete", r.habitHandler.Complete)
+			authenticated.DELETE("/habit/:id/completion/:completionId", r.habitHandler.UndoCompletion)
+			authenticated.POST("/habit/:id/freeze", r.habitHandler.Freeze)
+
+			// Focus Session protected routes
+			authenticated.POST("/focus-session", r.focusSessionHandler.Start)
+			authenticated.GET("/focus-session", r.focusSessionHandler.List)
+			authenticated.GET("/focus-session/active", r.focusSessionHandler.Active)
+			authenticated.POST("/focus-session/:id/pause", r.focusSessionHandler.Pause)
+			authenticated.POST("/focus-session/:id/resume", r.focusSessionHandler.Resume)
+			authenticated.POST("/focus-session/:id/stop", r.focusSessionHandler.Stop)
+
+			// Task protected routes
+			authenticated.POST("/task", r.taskHandler.Create)
+			authenticated.GET("/task", r.taskHandler.List)
+			authenticated.GET("/task/:id", r.taskHandler.GetByID)
+			authenticated.POST("/task/:id/complete", r.taskHandler.Complete)
+			authenticated.POST("/task/:id/reopen", r.taskHandler.Reopen)
+			authenticated.PUT("/task/:id/checklist/:itemId", r.taskHandler.CheckItem)
 
 			// Future protected routes
 			// authenticated.PUT("/user/profile", r.userHandler.UpdateProfile)
-			// authenticated.POST("/habit", r.habitHandler.Create)
 		}
 	}
 
@@ -0,0 +1,345 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
+)
+
+// TaskHandler handles task (one-off to-do) HTTP requests
+type TaskHandler struct {
+	createTaskUseCase    *usecase.CreateTaskUseCase
+	listTasksUseCase     *usecase.ListTasksUseCase
+	getTaskUseCase       *usecase.GetTaskUseCase
+	completeTaskUseCase  *usecase.CompleteTaskUseCase
+	reopenTaskUseCase    *usecase.ReopenTaskUseCase
+	checkTaskItemUseCase *usecase.CheckTaskItemUseCase
+}
+
+// NewTaskHandler creates a new TaskHandler
+func NewTaskHandler(
+	createTaskUseCase *usecase.CreateTaskUseCase,
+	listTasksUseCase *usecase.ListTasksUseCase,
+	getTaskUseCase *usecase.GetTaskUseCase,
+	completeTaskUseCase *usecase.CompleteTaskUseCase,
+	reopenTaskUseCase *usecase.ReopenTaskUseCase,
+	checkTaskItemUseCase *usecase.CheckTaskItemUseCase,
+) *TaskHandler {
+	return &TaskHandler{
+		createTaskUseCase:    createTaskUseCase,
+		listTasksUseCase:     listTasksUseCase,
+		getTaskUseCase:       getTaskUseCase,
+		completeTaskUseCase:  completeTaskUseCase,
+		reopenTaskUseCase:    reopenTaskUseCase,
+		checkTaskItemUseCase: checkTaskItemUseCase,
+	}
+}
+
+// Create handles POST /task - creates a new task for one of the user's characters
+// This is a protected route that requires authentication
+func (h *TaskHandler) Create(c *gin.Context) {
+	var req dto.CreateTaskRequest
+
+	// Bind and validate request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	var dueDate *time.Time
+	if req.DueDate != "" {
+		parsed, err := time.Parse("2006-01-02", req.DueDate)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "invalid_request",
+				Message: "dueDate must be in the YYYY-MM-DD format",
+			})
+			return
+		}
+		dueDate = &parsed
+	}
+
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates character ownership)
+	output, err := h.createTaskUseCase.Execute(c.Request.Context(), usecase.CreateTaskInput{
+		UserID:      userID,
+		CharacterID: req.CharacterID,
+		Title:       req.Title,
+		Description: req.Description,
+		Difficulty:  req.Difficulty,
+		Priority:    req.Priority,
+		DueDate:     dueDate,
+		Checklist:   req.Checklist,
+	})
+
+	if err != nil {
+		respondTaskError(c, err, "task_creation_failed")
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusCreated, mapTaskOutputToResponse(*output))
+}
+
+// List handles GET /task?status=open|completed|overdue&dueFrom=YYYY-MM-DD&dueTo=YYYY-MM-DD
+// All filters are optional; due dates are inclusive
+// This is a protected route that requires authentication
+func (h *TaskHandler) List(c *gin.Context) {
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	dueFrom, fromErr := parseOptionalDateQuery(c, "dueFrom")
+	dueTo, toErr := parseOptionalDateQuery(c, "dueTo")
+	if fromErr != nil || toErr != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "dueFrom and dueTo must be in the YYYY-MM-DD format",
+		})
+		return
+	}
+
+	// Execute use case
+	output, err := h.listTasksUseCase.Execute(c.Request.Context(), usecase.ListTasksInput{
+		UserID:  userID,
+		Status:  c.Query("status"),
+		DueFrom: dueFrom,
+		DueTo:   dueTo,
+	})
+
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidTaskStatus) || errors.Is(err, usecase.ErrInvalidDateRange) {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "invalid_request",
+				Message: err.Error(),
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "failed_to_fetch_tasks",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Convert use case output to DTOs
+	taskDTOs := make([]dto.TaskResponse, len(output.Tasks))
+	for i, task := range output.Tasks {
+		taskDTOs[i] = mapTaskOutputToResponse(task)
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, dto.GetTasksResponse{
+		Tasks: taskDTOs,
+	})
+}
+
+// GetByID handles GET /task/:id - gets a single task
+// This is a protected route that requires authentication
+func (h *TaskHandler) GetByID(c *gin.Context) {
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates task ownership)
+	output, err := h.getTaskUseCase.Execute(c.Request.Context(), usecase.TaskInput{
+		TaskID: c.Param("id"),
+		UserID: userID,
+	})
+
+	if err != nil {
+		respondTaskError(c, err, "failed_to_fetch_task")
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, mapTaskOutputToResponse(*output))
+}
+
+// Complete handles POST /task/:id/complete - completes a task and awards its XP
+// This is a protected route that requires authentication
+func (h *TaskHandler) Complete(c *gin.Context) {
+	h.reward(c, h.completeTaskUseCase.Execute, "task_completion_failed")
+}
+
+// Reopen handles POST /task/:id/reopen - reopens a completed task and takes its XP back
+// This is a protected route that requires authentication
+func (h *TaskHandler) Reopen(c *gin.Context) {
+	h.reward(c, h.reopenTaskUseCase.Execute, "task_reopen_failed")
+}
+
+// CheckItem handles PUT /task/:id/checklist/:itemId - checks or unchecks a checklist item
+// This is a protected route that requires authentication
+func (h *TaskHandler) CheckItem(c *gin.Context) {
+	var req dto.CheckTaskItemRequest
+
+	// Bind and validate request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates task ownership)
+	output, err := h.checkTaskItemUseCase.Execute(c.Request.Context(), usecase.CheckTaskItemInput{
+		TaskID: c.Param("id"),
+		ItemID: c.Param("itemId"),
+		UserID: userID,
+		Done:   *req.Done,
+	})
+
+	if err != nil {
+		respondTaskError(c, err, "task_update_failed")
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, mapTaskOutputToResponse(*output))
+}
+
+// reward runs a complete/reopen use case for the task in the URL and writes the task with the character's progress
+func (h *TaskHandler) reward(
+	c *gin.Context,
+	execute func(ctx context.Context, input usecase.TaskInput) (*usecase.TaskRewardOutput, error),
+	fallbackCode string,
+) {
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates task ownership)
+	output, err := execute(c.Request.Context(), usecase.TaskInput{
+		TaskID: c.Param("id"),
+		UserID: userID,
+	})
+
+	if err != nil {
+		respondTaskError(c, err, fallbackCode)
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, dto.TaskRewardResponse{
+		Task:           mapTaskOutputToResponse(output.Task),
+		XpGained:       output.XpGained,
+		LevelsGained:   output.LevelsGained,
+		Level:          output.Level,
+		CurrentXp:      output.CurrentXp,
+		TotalXp:        output.TotalXp,
+		XpForNextLevel: output.XpForNextLevel,
+	})
+}
+
+// respondTaskError maps task use case errors to HTTP responses
+func respondTaskError(c *gin.Context, err error, fallbackCode string) {
+	switch {
+	case errors.Is(err, usecase.ErrTaskNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "task_not_found",
+			Message: "task not found or you are not authorized to access it",
+		})
+	case errors.Is(err, usecase.ErrChecklistItemNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "checklist_item_not_found",
+			Message: err.Error(),
+		})
+	case errors.Is(err, usecase.ErrTaskAlreadyCompleted),
+		errors.Is(err, usecase.ErrTaskNotCompleted):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "invalid_task_state",
+			Message: err.Error(),
+		})
+	default:
+		// Character errors share the habit mapping
+		respondHabitError(c, err, fallbackCode)
+	}
+}
+
+// parseOptionalDateQuery parses an optional YYYY-MM-DD query parameter, returning nil when absent
+func parseOptionalDateQuery(c *gin.Context, name string) (*time.Time, error) {
+	if c.Query(name) == "" {
+		return nil, nil
+	}
+	date, err := parseDateQuery(c, name, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	return &date, nil
+}
+
+// mapTaskOutputToResponse converts a task use case output to its DTO
+func mapTaskOutputToResponse(task usecase.TaskOutput) dto.TaskResponse {
+	checklist := make([]dto.TaskChecklistItemResponse, len(task.Checklist))
+	for i, item := range task.Checklist {
+		checklist[i] = dto.TaskChecklistItemResponse{
+			ID:    item.ID,
+			Title: item.Title,
+			Done:  item.Done,
+		}
+	}
+
+	return dto.TaskResponse{
+		ID:          task.ID,
+		CharacterID: task.CharacterID,
+		Title:       task.Title,
+		Description: task.Description,
+		Difficulty:  task.Difficulty,
+		Priority:    task.Priority,
+		DueDate:     task.DueDate,
+		Checklist:   checklist,
+		Status:      task.Status,
+		Overdue:     task.Overdue,
+		CompletedAt: task.CompletedAt,
+		XpAwarded:   task.XpAwarded,
+		CreatedAt:   task.CreatedAt,
+		UpdatedAt:   task.UpdatedAt,
+	}
+}
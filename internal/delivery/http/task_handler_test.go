@@ -0,0 +1,233 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+	deliveryHttp "github.com/igor/chronotask-api/internal/delivery/http"
+	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Mock TaskRepository for E2E tests (every task belongs to the authenticated user)
+type mockTaskRepository struct {
+	tasks map[string]*entity.Task
+}
+
+func (m *mockTaskRepository) Create(ctx context.Context, task *entity.Task) error {
+	m.tasks[task.ID()] = task
+	return nil
+}
+
+func (m *mockTaskRepository) FindByIDAndUserID(ctx context.Context, id string, userID string) (*entity.Task, error) {
+	if task, ok := m.tasks[id]; ok && userID == "test-user-123" {
+		return task, nil
+	}
+	return nil, errors.New("task not found or does not belong to user")
+}
+
+func (m *mockTaskRepository) FindByUserID(ctx context.Context, userID string, filter repository.TaskFilter) ([]*entity.Task, error) {
+	var tasks []*entity.Task
+	for _, task := range m.tasks {
+		if filter.Status != "" && task.Status() != filter.Status {
+			continue
+		}
+		if filter.DueTo != nil && (task.DueDate() == nil || task.DueDate().After(*filter.DueTo)) {
+			continue
+		}
+		tasks = append(tasks, task)
+	}
+	return tasks, nil
+}
+
+func (m *mockTaskRepository) Update(ctx context.Context, task *entity.Task) error {
+	m.tasks[task.ID()] = task
+	return nil
+}
+
+func (m *mockTaskRepository) UpdateCompletion(ctx context.Context, task *entity.Task) error {
+	m.tasks[task.ID()] = task
+	return nil
+}
+
+// Helper function to setup test router with task routes
+func setupTestRouterForTasks() (*gin.Engine, *entity.Character) {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.Default()
+
+	taskRepo := &mockTaskRepository{tasks: map[string]*entity.Task{}}
+	preferencesRepo := newMockUserPreferencesRepository()
+
+	mockChar := entity.ReconstituteCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), 1, 0, 0, 0, "test-user-123", time.Now())
+	charRepo := &mockCharacterRepositoryForAttributeTests{
+		findByIDFunc: func(ctx context.Context, id string) (*entity.Character, error) {
+			return mockChar, nil
+		},
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Character, error) {
+			if id == "char-123" && userID == "test-user-123" {
+				return mockChar, nil
+			}
+			return nil, errors.New("not found")
+		},
+		updateFunc: func(ctx context.Context, character *entity.Character) error {
+			return nil
+		},
+	}
+
+	// Create handler
+	taskHandler := deliveryHttp.NewTaskHandler(
+		usecase.NewCreateTaskUseCase(taskRepo, charRepo, preferencesRepo),
+		usecase.NewListTasksUseCase(taskRepo, preferencesRepo),
+		usecase.NewGetTaskUseCase(taskRepo, preferencesRepo),
+		usecase.NewCompleteTaskUseCase(taskRepo, charRepo, preferencesRepo, &mockUnitOfWork{}),
+		usecase.NewReopenTaskUseCase(taskRepo, charRepo, preferencesRepo, &mockUnitOfWork{}),
+		usecase.NewCheckTaskItemUseCase(taskRepo, preferencesRepo),
+	)
+
+	// Create auth middleware with mock JWT service
+	authMiddleware := middleware.NewAuthMiddleware(&mockJWTService{})
+
+	// Setup routes
+	v1 := router.Group("/api/v1")
+	{
+		authenticated := v1.Group("")
+		authenticated.Use(authMiddleware.RequireAuth())
+		{
+			authenticated.POST("/task", taskHandler.Create)
+			authenticated.GET("/task", taskHandler.List)
+			authenticated.GET("/task/:id", taskHandler.GetByID)
+			authenticated.POST("/task/:id/complete", taskHandler.Complete)
+			authenticated.POST("/task/:id/reopen", taskHandler.Reopen)
+			authenticated.PUT("/task/:id/checklist/:itemId", taskHandler.CheckItem)
+		}
+	}
+
+	return router, mockChar
+}
+
+func createTestTask(t *testing.T, router *gin.Engine, body map[string]interface{}) dto.TaskResponse {
+	t.Helper()
+
+	w := performJSONRequest(router, "POST", "/api/v1/task", body)
+	if w.Code != http.StatusCreated {
+		t.Fatalf("create status code = %v, want %v (body: %s)", w.Code, http.StatusCreated, w.Body.String())
+	}
+
+	var task dto.TaskResponse
+	json.Unmarshal(w.Body.Bytes(), &task)
+	return task
+}
+
+func TestTaskHandler_Lifecycle(t *testing.T) {
+	router, character := setupTestRouterForTasks()
+
+	task := createTestTask(t, router, map[string]interface{}{
+		"characterId": "char-123",
+		"title":       "File taxes",
+		"difficulty":  "hard",
+		"checklist":   []string{"Collect receipts", "Submit"},
+	})
+	if task.Priority != "medium" || task.Status != "open" || len(task.Checklist) != 2 {
+		t.Errorf("created task = %+v, want medium priority, open, 2 checklist items", task)
+	}
+
+	// Check a checklist item
+	w := performJSONRequest(router, "PUT", "/api/v1/task/"+task.ID+"/checklist/"+task.Checklist[0].ID, map[string]interface{}{
+		"done": true,
+	})
+	if w.Code != http.StatusOK {
+		t.Fatalf("check item status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	// Complete
+	w = performJSONRequest(router, "POST", "/api/v1/task/"+task.ID+"/complete", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("complete status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var completed dto.TaskRewardResponse
+	json.Unmarshal(w.Body.Bytes(), &completed)
+	if completed.Task.Status != "completed" || completed.XpGained != 40 {
+		t.Errorf("completed = %v (+%v XP), want completed (+40 XP)", completed.Task.Status, completed.XpGained)
+	}
+	if character.TotalXp() != 40 {
+		t.Errorf("character TotalXp() = %v, want 40", character.TotalXp())
+	}
+
+	// Completing twice is a conflict
+	w = performJSONRequest(router, "POST", "/api/v1/task/"+task.ID+"/complete", nil)
+	if w.Code != http.StatusConflict {
+		t.Errorf("second complete status code = %v, want %v", w.Code, http.StatusConflict)
+	}
+
+	// Reopen takes the XP back
+	w = performJSONRequest(router, "POST", "/api/v1/task/"+task.ID+"/reopen", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("reopen status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var reopened dto.TaskRewardResponse
+	json.Unmarshal(w.Body.Bytes(), &reopened)
+	if reopened.Task.Status != "open" || reopened.XpGained != -40 || character.TotalXp() != 0 {
+		t.Errorf("reopened = %v (%v XP, total %v), want open (-40 XP, total 0)", reopened.Task.Status, reopened.XpGained, character.TotalXp())
+	}
+}
+
+func TestTaskHandler_List_Overdue(t *testing.T) {
+	router, _ := setupTestRouterForTasks()
+
+	yesterday := time.Now().UTC().AddDate(0, 0, -1).Format("2006-01-02")
+	tomorrow := time.Now().UTC().AddDate(0, 0, 1).Format("2006-01-02")
+	createTestTask(t, router, map[string]interface{}{"characterId": "char-123", "title": "Late", "difficulty": "easy", "dueDate": yesterday})
+	createTestTask(t, router, map[string]interface{}{"characterId": "char-123", "title": "Upcoming", "difficulty": "easy", "dueDate": tomorrow})
+
+	w := performJSONRequest(router, "GET", "/api/v1/task?status=overdue", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response dto.GetTasksResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+	if len(response.Tasks) != 1 {
+		t.Fatalf("len(Tasks) = %v, want 1", len(response.Tasks))
+	}
+	if response.Tasks[0].Title != "Late" || !response.Tasks[0].Overdue {
+		t.Errorf("task = %v (overdue %v), want Late (overdue true)", response.Tasks[0].Title, response.Tasks[0].Overdue)
+	}
+}
+
+func TestTaskHandler_InvalidRequests(t *testing.T) {
+	router, _ := setupTestRouterForTasks()
+
+	tests := []struct {
+		name       string
+		method     string
+		path       string
+		body       map[string]interface{}
+		wantStatus int
+	}{
+		{"invalid due date", "POST", "/api/v1/task", map[string]interface{}{"characterId": "char-123", "title": "Late", "difficulty": "easy", "dueDate": "tomorrow"}, http.StatusBadRequest},
+		{"other user's character", "POST", "/api/v1/task", map[string]interface{}{"characterId": "char-999", "title": "Late", "difficulty": "easy"}, http.StatusForbidden},
+		{"invalid status filter", "GET", "/api/v1/task?status=done", nil, http.StatusBadRequest},
+		{"unknown task", "POST", "/api/v1/task/missing/complete", nil, http.StatusNotFound},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := performJSONRequest(router, tt.method, tt.path, tt.body)
+			if w.Code != tt.wantStatus {
+				t.Errorf("Status code = %v, want %v (body: %s)", w.Code, tt.wantStatus, w.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,280 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
+)
+
+// TournamentHandler handles tournament-related HTTP requests
+type TournamentHandler struct {
+	createTournamentUseCase *usecase.CreateTournamentUseCase
+	joinTournamentUseCase   *usecase.JoinTournamentUseCase
+	getTournamentUseCase    *usecase.GetTournamentUseCase
+}
+
+// NewTournamentHandler creates a new TournamentHandler
+func NewTournamentHandler(
+	createTournamentUseCase *usecase.CreateTournamentUseCase,
+	joinTournamentUseCase *usecase.JoinTournamentUseCase,
+	getTournamentUseCase *usecase.GetTournamentUseCase,
+) *TournamentHandler {
+	return &TournamentHandler{
+		createTournamentUseCase: createTournamentUseCase,
+		joinTournamentUseCase:   joinTournamentUseCase,
+		getTournamentUseCase:    getTournamentUseCase,
+	}
+}
+
+// Create handles POST /tournament - an admin or a guild leader creates a tournament
+// This is a protected route that requires authentication
+func (h *TournamentHandler) Create(c *gin.Context) {
+	var req dto.CreateTournamentRequest
+
+	// Bind and validate request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates the organizer)
+	output, err := h.createTournamentUseCase.Execute(c.Request.Context(), usecase.CreateTournamentInput{
+		UserID:               userID,
+		CharacterID:          req.CharacterID,
+		GuildID:              req.GuildID,
+		Name:                 req.Name,
+		Format:               req.Format,
+		Seeding:              req.Seeding,
+		JoinDeadline:         req.JoinDeadline,
+		RoundIntervalMinutes: req.RoundIntervalMinutes,
+		MaxEntrants:          req.MaxEntrants,
+		PrizeXp:              req.PrizeXp,
+	})
+	if err != nil {
+		respondTournamentError(c, err, "failed_to_create_tournament")
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusCreated, toTournamentResponse(*output))
+}
+
+// Join handles POST /tournament/:id/join - one of the user's characters joins a tournament before its deadline
+// This is a protected route that requires authentication
+func (h *TournamentHandler) Join(c *gin.Context) {
+	var req dto.JoinTournamentRequest
+
+	// Bind and validate request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates character ownership)
+	output, err := h.joinTournamentUseCase.Execute(c.Request.Context(), usecase.JoinTournamentInput{
+		TournamentID: c.Param("id"),
+		CharacterID:  req.CharacterID,
+		UserID:       userID,
+	})
+	if err != nil {
+		respondTournamentError(c, err, "failed_to_join_tournament")
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, toTournamentResponse(*output))
+}
+
+// Get handles GET /tournament/:id - the bracket of a tournament: entrants, rounds, standings and prizes
+// This is a protected route that requires authentication
+func (h *TournamentHandler) Get(c *gin.Context) {
+	// Execute use case
+	output, err := h.getTournamentUseCase.Execute(c.Request.Context(), usecase.GetTournamentInput{
+		TournamentID: c.Param("id"),
+	})
+	if err != nil {
+		respondTournamentError(c, err, "failed_to_get_tournament")
+		return
+	}
+
+	// Convert use case output to DTOs
+	entrants := make([]dto.TournamentEntrantResponse, len(output.Entrants))
+	for i, entrant := range output.Entrants {
+		entrants[i] = dto.TournamentEntrantResponse{
+			CharacterID: entrant.CharacterID,
+			Seed:        entrant.Seed,
+			SeedScore:   entrant.SeedScore,
+			JoinedAt:    entrant.JoinedAt,
+		}
+	}
+
+	rounds := make([]dto.TournamentRoundResponse, len(output.Rounds))
+	for i, round := range output.Rounds {
+		matches := make([]dto.TournamentMatchResponse, len(round.Matches))
+		for j, match := range round.Matches {
+			matches[j] = dto.TournamentMatchResponse{
+				Slot:                match.Slot,
+				CharacterID:         match.CharacterID,
+				OpponentCharacterID: match.OpponentCharacterID,
+				Bye:                 match.Bye,
+				BattleID:            match.BattleID,
+				WinnerCharacterID:   match.WinnerCharacterID,
+				PlayedAt:            match.PlayedAt,
+			}
+		}
+		rounds[i] = dto.TournamentRoundResponse{
+			Round:       round.Round,
+			ScheduledAt: round.ScheduledAt,
+			Played:      round.Played,
+			Matches:     matches,
+		}
+	}
+
+	standings := make([]dto.TournamentStandingResponse, len(output.Standings))
+	for i, standing := range output.Standings {
+		standings[i] = dto.TournamentStandingResponse{
+			Place:             standing.Place,
+			CharacterID:       standing.CharacterID,
+			Seed:              standing.Seed,
+			Wins:              standing.Wins,
+			Draws:             standing.Draws,
+			Losses:            standing.Losses,
+			Points:            standing.Points,
+			EliminatedInRound: standing.EliminatedInRound,
+		}
+	}
+
+	prizes := make([]dto.TournamentPrizeResponse, len(output.Prizes))
+	for i, prize := range output.Prizes {
+		prizes[i] = dto.TournamentPrizeResponse{
+			CharacterID: prize.CharacterID,
+			Place:       prize.Place,
+			Xp:          prize.Xp,
+		}
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, dto.TournamentBracketResponse{
+		Tournament: toTournamentResponse(output.Tournament),
+		Entrants:   entrants,
+		Rounds:     rounds,
+		Standings:  standings,
+		Prizes:     prizes,
+	})
+}
+
+// respondTournamentError maps tournament use case errors to HTTP responses
+func respondTournamentError(c *gin.Context, err error, fallbackCode string) {
+	switch {
+	case errors.Is(err, usecase.ErrCharacterNotFound):
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{
+			Error:   "forbidden",
+			Message: "character not found or you are not authorized to access it",
+		})
+	case errors.Is(err, usecase.ErrNotTournamentOrganizer):
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{
+			Error:   "not_tournament_organizer",
+			Message: err.Error(),
+		})
+	case errors.Is(err, usecase.ErrNotGuildLeader):
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{
+			Error:   "not_guild_leader",
+			Message: err.Error(),
+		})
+	case errors.Is(err, usecase.ErrNotGuildMember):
+		c.JSON(http.StatusForbidden, dto.ErrorResponse{
+			Error:   "not_guild_member",
+			Message: err.Error(),
+		})
+	case errors.Is(err, usecase.ErrGuildNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "guild_not_found",
+			Message: err.Error(),
+		})
+	case errors.Is(err, usecase.ErrTournamentNotFound):
+		c.JSON(http.StatusNotFound, dto.ErrorResponse{
+			Error:   "tournament_not_found",
+			Message: err.Error(),
+		})
+	case errors.Is(err, usecase.ErrInvalidTournament):
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_tournament",
+			Message: err.Error(),
+		})
+	case errors.Is(err, usecase.ErrTournamentClosed):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "tournament_closed",
+			Message: err.Error(),
+		})
+	case errors.Is(err, usecase.ErrTournamentFull):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "tournament_full",
+			Message: err.Error(),
+		})
+	case errors.Is(err, usecase.ErrAlreadyInTournament):
+		c.JSON(http.StatusConflict, dto.ErrorResponse{
+			Error:   "already_in_tournament",
+			Message: err.Error(),
+		})
+	default:
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   fallbackCode,
+			Message: err.Error(),
+		})
+	}
+}
+
+// toTournamentResponse converts a tournament output to its DTO
+func toTournamentResponse(tournament usecase.TournamentOutput) dto.TournamentResponse {
+	return dto.TournamentResponse{
+		ID:                   tournament.ID,
+		Name:                 tournament.Name,
+		Format:               tournament.Format,
+		Seeding:              tournament.Seeding,
+		GuildID:              tournament.GuildID,
+		OrganizerUserID:      tournament.OrganizerUserID,
+		MaxEntrants:          tournament.MaxEntrants,
+		Entrants:             tournament.Entrants,
+		PrizeXp:              tournament.PrizeXp,
+		JoinDeadline:         tournament.JoinDeadline,
+		RoundIntervalMinutes: tournament.RoundIntervalMinutes,
+		Status:               tournament.Status,
+		TotalRounds:          tournament.TotalRounds,
+		RoundsPlayed:         tournament.RoundsPlayed,
+		NextRoundAt:          tournament.NextRoundAt,
+		ChampionCharacterID:  tournament.ChampionCharacterID,
+		RunnerUpCharacterID:  tournament.RunnerUpCharacterID,
+		CreatedAt:            tournament.CreatedAt,
+		EndedAt:              tournament.EndedAt,
+	}
+}
@@ -0,0 +1,224 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+	deliveryHttp "github.com/igor/chronotask-api/internal/delivery/http"
+	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Mock TournamentRepository (open tournaments only; no round is fought in these tests)
+type mockTournamentRepository struct {
+	tournaments map[string]*entity.Tournament
+	entrants    map[string][]*entity.TournamentEntrant
+}
+
+func newMockTournamentRepository() *mockTournamentRepository {
+	return &mockTournamentRepository{tournaments: map[string]*entity.Tournament{}, entrants: map[string][]*entity.TournamentEntrant{}}
+}
+
+func (m *mockTournamentRepository) Create(ctx context.Context, tournament *entity.Tournament) error {
+	m.tournaments[tournament.ID()] = tournament
+	return nil
+}
+
+func (m *mockTournamentRepository) Update(ctx context.Context, tournament *entity.Tournament) error {
+	m.tournaments[tournament.ID()] = tournament
+	return nil
+}
+
+func (m *mockTournamentRepository) FindByID(ctx context.Context, id string) (*entity.Tournament, error) {
+	if tournament, ok := m.tournaments[id]; ok {
+		return tournament, nil
+	}
+	return nil, errors.New("tournament not found")
+}
+
+func (m *mockTournamentRepository) FindByIDForUpdate(ctx context.Context, id string) (*entity.Tournament, error) {
+	return m.FindByID(ctx, id)
+}
+
+func (m *mockTournamentRepository) FindDueIDs(ctx context.Context, at time.Time) ([]string, error) {
+	return nil, nil
+}
+
+func (m *mockTournamentRepository) AddEntrant(ctx context.Context, entrant *entity.TournamentEntrant) error {
+	m.entrants[entrant.TournamentID()] = append(m.entrants[entrant.TournamentID()], entrant)
+	return nil
+}
+
+func (m *mockTournamentRepository) UpdateEntrant(ctx context.Context, entrant *entity.TournamentEntrant) error {
+	return nil
+}
+
+func (m *mockTournamentRepository) FindEntrants(ctx context.Context, tournamentID string) ([]*entity.TournamentEntrant, error) {
+	return m.entrants[tournamentID], nil
+}
+
+func (m *mockTournamentRepository) CreateMatch(ctx context.Context, match *entity.TournamentMatch) error {
+	return nil
+}
+
+func (m *mockTournamentRepository) FindMatches(ctx context.Context, tournamentID string) ([]*entity.TournamentMatch, error) {
+	return nil, nil
+}
+
+// setupTestRouterForTournaments builds the tournament routes for test-user-123 (char-123), an admin when admin is true
+// char-789 belongs to another user
+func setupTestRouterForTournaments(admin bool) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.Default()
+
+	characters := map[string]*entity.Character{
+		"char-123": entity.ReconstituteCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), 10, 0, 0, 0, "test-user-123", time.Now()),
+		"char-789": entity.ReconstituteCharacter("char-789", "Stranger", valueobject.DefaultCharacterClass(), 5, 0, 0, 0, "other-user-789", time.Now()),
+	}
+
+	charRepo := &mockCharacterRepositoryForAttributeTests{
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Character, error) {
+			if character, ok := characters[id]; ok && character.UserID() == userID {
+				return character, nil
+			}
+			return nil, errors.New("character not found or does not belong to user")
+		},
+	}
+	guildRepo := newMockGuildRepository()
+	tournamentRepo := newMockTournamentRepository()
+
+	var adminUserIDs []string
+	if admin {
+		adminUserIDs = []string{"test-user-123"}
+	}
+
+	// Create handler
+	tournamentHandler := deliveryHttp.NewTournamentHandler(
+		usecase.NewCreateTournamentUseCase(charRepo, guildRepo, tournamentRepo, adminUserIDs),
+		usecase.NewJoinTournamentUseCase(charRepo, guildRepo, tournamentRepo, &mockUnitOfWork{}),
+		usecase.NewGetTournamentUseCase(tournamentRepo),
+	)
+
+	// Create auth middleware with mock JWT service
+	authMiddleware := middleware.NewAuthMiddleware(&mockJWTService{})
+
+	// Setup routes
+	v1 := router.Group("/api/v1")
+	{
+		authenticated := v1.Group("")
+		authenticated.Use(authMiddleware.RequireAuth())
+		{
+			authenticated.POST("/tournament", tournamentHandler.Create)
+			authenticated.POST("/tournament/:id/join", tournamentHandler.Join)
+			authenticated.GET("/tournament/:id", tournamentHandler.Get)
+		}
+	}
+
+	return router
+}
+
+// newCreateTournamentRequest creates the request of an open single-elimination tournament with a deadline in one day
+func newCreateTournamentRequest() dto.CreateTournamentRequest {
+	return dto.CreateTournamentRequest{
+		Name:                 "Copa do Hábito",
+		Format:               "single_elimination",
+		Seeding:              "level",
+		JoinDeadline:         time.Now().Add(24 * time.Hour).Format(time.RFC3339),
+		RoundIntervalMinutes: 60,
+		MaxEntrants:          8,
+		PrizeXp:              200,
+	}
+}
+
+func TestTournamentHandler_CreateJoinAndGet(t *testing.T) {
+	router := setupTestRouterForTournaments(true)
+
+	w := performJSONRequest(router, "POST", "/api/v1/tournament", newCreateTournamentRequest())
+	if w.Code != http.StatusCreated {
+		t.Fatalf("Create status code = %v, want %v (body: %s)", w.Code, http.StatusCreated, w.Body.String())
+	}
+	var tournament dto.TournamentResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &tournament); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if tournament.Status != "open" || tournament.OrganizerUserID != "test-user-123" || tournament.NextRoundAt != tournament.JoinDeadline {
+		t.Errorf("tournament = %+v, want open with the first round at the deadline", tournament)
+	}
+
+	w = performJSONRequest(router, "POST", "/api/v1/tournament/"+tournament.ID+"/join", dto.JoinTournamentRequest{CharacterID: "char-123"})
+	if w.Code != http.StatusOK {
+		t.Fatalf("Join status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	w = performJSONRequest(router, "GET", "/api/v1/tournament/"+tournament.ID, nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Get status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+	var bracket dto.TournamentBracketResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &bracket); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	if bracket.Tournament.Entrants != 1 || len(bracket.Entrants) != 1 || bracket.Entrants[0].CharacterID != "char-123" || bracket.Rounds == nil || bracket.Standings == nil {
+		t.Errorf("bracket = %+v, want char-123 entered with empty rounds and standings", bracket)
+	}
+}
+
+func TestTournamentHandler_Errors(t *testing.T) {
+	router := setupTestRouterForTournaments(true)
+
+	w := performJSONRequest(router, "POST", "/api/v1/tournament", newCreateTournamentRequest())
+	var tournament dto.TournamentResponse
+	if err := json.Unmarshal(w.Body.Bytes(), &tournament); err != nil {
+		t.Fatalf("Failed to parse response: %v", err)
+	}
+	joinPath := "/api/v1/tournament/" + tournament.ID + "/join"
+	performJSONRequest(router, "POST", joinPath, dto.JoinTournamentRequest{CharacterID: "char-123"})
+
+	invalidFormat := newCreateTournamentRequest()
+	invalidFormat.Format = "swiss"
+
+	tests := []struct {
+		name       string
+		router     *gin.Engine
+		method     string
+		path       string
+		body       interface{}
+		wantStatus int
+		wantError  string
+	}{
+		{"player creates an open tournament", setupTestRouterForTournaments(false), "POST", "/api/v1/tournament", newCreateTournamentRequest(), http.StatusForbidden, "not_tournament_organizer"},
+		{"unknown format", router, "POST", "/api/v1/tournament", invalidFormat, http.StatusBadRequest, "invalid_tournament"},
+		{"missing name", router, "POST", "/api/v1/tournament", dto.CreateTournamentRequest{Format: "round_robin"}, http.StatusBadRequest, "invalid_request"},
+		{"unknown guild", router, "POST", "/api/v1/tournament", dto.CreateTournamentRequest{GuildID: "missing", Name: "Copa", Format: "round_robin", Seeding: "level", JoinDeadline: "2030-01-01T00:00:00Z", RoundIntervalMinutes: 60, MaxEntrants: 4}, http.StatusNotFound, "guild_not_found"},
+		{"joined twice", router, "POST", joinPath, dto.JoinTournamentRequest{CharacterID: "char-123"}, http.StatusConflict, "already_in_tournament"},
+		{"character of another user", router, "POST", joinPath, dto.JoinTournamentRequest{CharacterID: "char-789"}, http.StatusForbidden, "forbidden"},
+		{"join unknown tournament", router, "POST", "/api/v1/tournament/missing/join", dto.JoinTournamentRequest{CharacterID: "char-123"}, http.StatusNotFound, "tournament_not_found"},
+		{"get unknown tournament", router, "GET", "/api/v1/tournament/missing", nil, http.StatusNotFound, "tournament_not_found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := performJSONRequest(tt.router, tt.method, tt.path, tt.body)
+			if w.Code != tt.wantStatus {
+				t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, tt.wantStatus, w.Body.String())
+			}
+
+			var response dto.ErrorResponse
+			if err := json.Unmarshal(w.Body.Bytes(), &response); err != nil {
+				t.Fatalf("Failed to parse response: %v", err)
+			}
+			if response.Error != tt.wantError {
+				t.Errorf("Error = %q, want %q", response.Error, tt.wantError)
+			}
+		})
+	}
+}
@@ -0,0 +1,120 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
+)
+
+// UserPreferencesHandler handles user preferences HTTP requests
+type UserPreferencesHandler struct {
+	getUserPreferencesUseCase    *usecase.GetUserPreferencesUseCase
+	updateUserPreferencesUseCase *usecase.UpdateUserPreferencesUseCase
+}
+
+// NewUserPreferencesHandler creates a new UserPreferencesHandler
+func NewUserPreferencesHandler(
+	getUserPreferencesUseCase *usecase.GetUserPreferencesUseCase,
+	updateUserPreferencesUseCase *usecase.UpdateUserPreferencesUseCase,
+) *UserPreferencesHandler {
+	return &UserPreferencesHandler{
+		getUserPreferencesUseCase:    getUserPreferencesUseCase,
+		updateUserPreferencesUseCase: updateUserPreferencesUseCase,
+	}
+}
+
+// Get handles GET /user/preferences - returns the user's time zone and day boundaries
+// This is a protected route that requires authentication
+func (h *UserPreferencesHandler) Get(c *gin.Context) {
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case
+	output, err := h.getUserPreferencesUseCase.Execute(c.Request.Context(), usecase.GetUserPreferencesInput{
+		UserID: userID,
+	})
+
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "failed_to_fetch_preferences",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, mapUserPreferencesOutputToResponse(*output))
+}
+
+// Update handles PUT /user/preferences - changes the user's time zone and day boundaries
+// This is a protected route that requires authentication
+func (h *UserPreferencesHandler) Update(c *gin.Context) {
+	var req dto.UpdateUserPreferencesRequest
+
+	// Bind and validate request
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates the time zone and week start)
+	output, err := h.updateUserPreferencesUseCase.Execute(c.Request.Context(), usecase.UpdateUserPreferencesInput{
+		UserID:          userID,
+		Timezone:        req.Timezone,
+		WeekStart:       req.WeekStart,
+		DayRolloverHour: *req.DayRolloverHour,
+	})
+
+	if err != nil {
+		if errors.Is(err, usecase.ErrInvalidPreferences) {
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "invalid_preferences",
+				Message: err.Error(),
+			})
+			return
+		}
+		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+			Error:   "failed_to_update_preferences",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Return response
+	c.JSON(http.StatusOK, mapUserPreferencesOutputToResponse(*output))
+}
+
+// mapUserPreferencesOutputToResponse converts a user preferences use case output to its DTO
+func mapUserPreferencesOutputToResponse(preferences usecase.UserPreferencesOutput) dto.UserPreferencesResponse {
+	return dto.UserPreferencesResponse{
+		Timezone:        preferences.Timezone,
+		WeekStart:       preferences.WeekStart,
+		DayRolloverHour: preferences.DayRolloverHour,
+		Today:           preferences.Today,
+		UpdatedAt:       preferences.UpdatedAt,
+	}
+}
@@ -0,0 +1,124 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+	deliveryHttp "github.com/igor/chronotask-api/internal/delivery/http"
+	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+// Mock UserPreferencesRepository for E2E tests (users without saved preferences get the defaults)
+type mockUserPreferencesRepository struct {
+	preferences map[string]*entity.UserPreferences
+}
+
+func newMockUserPreferencesRepository() *mockUserPreferencesRepository {
+	return &mockUserPreferencesRepository{preferences: map[string]*entity.UserPreferences{}}
+}
+
+func (m *mockUserPreferencesRepository) FindByUserID(ctx context.Context, userID string) (*entity.UserPreferences, error) {
+	return m.preferences[userID], nil
+}
+
+func (m *mockUserPreferencesRepository) Save(ctx context.Context, preferences *entity.UserPreferences) error {
+	m.preferences[preferences.UserID()] = preferences
+	return nil
+}
+
+// Helper function to setup test router with user preferences routes
+func setupTestRouterForUserPreferences(preferencesRepo *mockUserPreferencesRepository) *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.Default()
+
+	// Create handler
+	preferencesHandler := deliveryHttp.NewUserPreferencesHandler(
+		usecase.NewGetUserPreferencesUseCase(preferencesRepo),
+		usecase.NewUpdateUserPreferencesUseCase(preferencesRepo),
+	)
+
+	// Create auth middleware with mock JWT service
+	authMiddleware := middleware.NewAuthMiddleware(&mockJWTService{})
+
+	// Setup routes
+	v1 := router.Group("/api/v1")
+	{
+		authenticated := v1.Group("")
+		authenticated.Use(authMiddleware.RequireAuth())
+		{
+			authenticated.GET("/user/preferences", preferencesHandler.Get)
+			authenticated.PUT("/user/preferences", preferencesHandler.Update)
+		}
+	}
+
+	return router
+}
+
+func TestUserPreferencesHandler_GetAndUpdate(t *testing.T) {
+	preferencesRepo := newMockUserPreferencesRepository()
+	router := setupTestRouterForUserPreferences(preferencesRepo)
+
+	// Defaults before anything is saved
+	w := performJSONRequest(router, "GET", "/api/v1/user/preferences", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("get status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var defaults dto.UserPreferencesResponse
+	json.Unmarshal(w.Body.Bytes(), &defaults)
+	if defaults.Timezone != "UTC" || defaults.WeekStart != "monday" || defaults.DayRolloverHour != 0 || defaults.UpdatedAt != "" {
+		t.Errorf("defaults = %+v, want UTC, monday, 0 and no updatedAt", defaults)
+	}
+
+	// Update
+	w = performJSONRequest(router, "PUT", "/api/v1/user/preferences", map[string]interface{}{
+		"timezone":        "Europe/Lisbon",
+		"weekStart":       "sunday",
+		"dayRolloverHour": 4,
+	})
+	if w.Code != http.StatusOK {
+		t.Fatalf("update status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	// The saved preferences are returned afterwards
+	w = performJSONRequest(router, "GET", "/api/v1/user/preferences", nil)
+
+	var saved dto.UserPreferencesResponse
+	json.Unmarshal(w.Body.Bytes(), &saved)
+	if saved.Timezone != "Europe/Lisbon" || saved.WeekStart != "sunday" || saved.DayRolloverHour != 4 || saved.UpdatedAt == "" {
+		t.Errorf("saved = %+v, want Europe/Lisbon, sunday, 4 and an updatedAt", saved)
+	}
+	if preferencesRepo.preferences["test-user-123"] == nil {
+		t.Error("preferences were not saved for the authenticated user")
+	}
+}
+
+func TestUserPreferencesHandler_Update_InvalidRequests(t *testing.T) {
+	router := setupTestRouterForUserPreferences(newMockUserPreferencesRepository())
+
+	tests := []struct {
+		name string
+		body map[string]interface{}
+	}{
+		{"missing rollover hour", map[string]interface{}{"timezone": "Europe/Lisbon", "weekStart": "monday"}},
+		{"rollover hour out of range", map[string]interface{}{"timezone": "Europe/Lisbon", "weekStart": "monday", "dayRolloverHour": 20}},
+		{"unknown timezone", map[string]interface{}{"timezone": "Europe/Atlantis", "weekStart": "monday", "dayRolloverHour": 0}},
+		{"unknown weekday", map[string]interface{}{"timezone": "Europe/Lisbon", "weekStart": "caturday", "dayRolloverHour": 0}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := performJSONRequest(router, "PUT", "/api/v1/user/preferences", tt.body)
+			if w.Code != http.StatusBadRequest {
+				t.Errorf("Status code = %v, want %v (body: %s)", w.Code, http.StatusBadRequest, w.Body.String())
+			}
+		})
+	}
+}
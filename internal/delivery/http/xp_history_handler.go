@@ -0,0 +1,101 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
+)
+
+// XpHistoryHandler handles XP ledger-related HTTP requests
+type XpHistoryHandler struct {
+	getXpHistoryUseCase *usecase.GetXpHistoryUseCase
+}
+
+// NewXpHistoryHandler creates a new XpHistoryHandler
+func NewXpHistoryHandler(
+	getXpHistoryUseCase *usecase.GetXpHistoryUseCase,
+) *XpHistoryHandler {
+	return &XpHistoryHandler{
+		getXpHistoryUseCase: getXpHistoryUseCase,
+	}
+}
+
+// GetByCharacterID handles GET /character/:characterId/xp-history?page=1&pageSize=20 - lists a character's XP changes
+// This is a protected route that requires authentication
+func (h *XpHistoryHandler) GetByCharacterID(c *gin.Context) {
+	var query dto.XpHistoryQuery
+
+	// Bind and validate pagination
+	if err := c.ShouldBindQuery(&query); err != nil {
+		c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+		return
+	}
+
+	// Get authenticated user ID from middleware
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "user not authenticated",
+		})
+		return
+	}
+
+	// Execute use case (it validates character ownership)
+	output, err := h.getXpHistoryUseCase.Execute(c.Request.Context(), usecase.GetXpHistoryInput{
+		CharacterID: c.Param("characterId"),
+		UserID:      userID,
+		Page:        query.Page,
+		PageSize:    query.PageSize,
+	})
+
+	if err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrCharacterNotFound):
+			c.JSON(http.StatusForbidden, dto.ErrorResponse{
+				Error:   "forbidden",
+				Message: "character not found or you are not authorized to access it",
+			})
+		case errors.Is(err, usecase.ErrInvalidPagination):
+			c.JSON(http.StatusBadRequest, dto.ErrorResponse{
+				Error:   "invalid_request",
+				Message: err.Error(),
+			})
+		default:
+			c.JSON(http.StatusInternalServerError, dto.ErrorResponse{
+				Error:   "failed_to_fetch_xp_history",
+				Message: err.Error(),
+			})
+		}
+		return
+	}
+
+	// Convert use case output to DTOs
+	transactionDTOs := make([]dto.XpTransactionResponse, len(output.Transactions))
+	for i, transaction := range output.Transactions {
+		transactionDTOs[i] = dto.XpTransactionResponse{
+			ID:               transaction.ID,
+			Amount:           transaction.Amount,
+			SourceType:       transaction.SourceType,
+			SourceID:         transaction.SourceID,
+			ResultingLevel:   transaction.ResultingLevel,
+			ResultingTotalXp: transaction.ResultingTotalXp,
+			CreatedAt:        transaction.CreatedAt,
+		}
+	}
+
+	c.JSON(http.StatusOK, dto.XpHistoryResponse{
+		CharacterID:  output.CharacterID,
+		Transactions: transactionDTOs,
+		Page:         output.Page,
+		PageSize:     output.PageSize,
+		Total:        output.Total,
+	})
+}
@@ -0,0 +1,124 @@
+package http_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/igor/chronotask-api/internal/application/usecase"
+	"github.com/igor/chronotask-api/internal/delivery/dto"
+	deliveryHttp "github.com/igor/chronotask-api/internal/delivery/http"
+	"github.com/igor/chronotask-api/internal/delivery/http/middleware"
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Mock XpTransactionRepository for E2E tests (transactions are stored oldest first)
+type mockXpTransactionRepository struct {
+	transactions []*entity.XpTransaction
+}
+
+func (m *mockXpTransactionRepository) FindPageByCharacterID(ctx context.Context, characterID string, limit int, offset int) ([]*entity.XpTransaction, error) {
+	var page []*entity.XpTransaction
+	for i := len(m.transactions) - 1 - offset; i >= 0 && len(page) < limit; i-- {
+		page = append(page, m.transactions[i])
+	}
+	return page, nil
+}
+
+func (m *mockXpTransactionRepository) CountByCharacterID(ctx context.Context, characterID string) (int, error) {
+	return len(m.transactions), nil
+}
+
+func (m *mockXpTransactionRepository) FindAllByCharacterID(ctx context.Context, characterID string) ([]*entity.XpTransaction, error) {
+	return m.transactions, nil
+}
+
+// setupTestRouterForXpHistory creates a test router with the XP history endpoint (char-123 has 3 transactions)
+func setupTestRouterForXpHistory() *gin.Engine {
+	gin.SetMode(gin.TestMode)
+
+	router := gin.Default()
+
+	charRepo := &mockCharacterRepositoryForAttributeTests{
+		findByIDAndUserIDFunc: func(ctx context.Context, id string, userID string) (*entity.Character, error) {
+			if id == "char-123" && userID == "test-user-123" {
+				return entity.ReconstituteCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), 1, 35, 35, 0, "test-user-123", time.Now()), nil
+			}
+			return nil, errors.New("character not found or does not belong to user")
+		},
+	}
+
+	ledger := &mockXpTransactionRepository{}
+	for i, amount := range []int{10, 40, -15} {
+		source, _ := valueobject.NewXpSource(valueobject.XpSourceHabitCompletion, "comp-123")
+		ledger.transactions = append(ledger.transactions, entity.ReconstituteXpTransaction(int64(i+1), "char-123", amount, source, 1, 35, time.Now()))
+	}
+
+	// Create handler
+	xpHistoryHandler := deliveryHttp.NewXpHistoryHandler(usecase.NewGetXpHistoryUseCase(charRepo, ledger))
+
+	// Create auth middleware with mock JWT service
+	authMiddleware := middleware.NewAuthMiddleware(&mockJWTService{})
+
+	// Setup routes
+	v1 := router.Group("/api/v1")
+	{
+		authenticated := v1.Group("")
+		authenticated.Use(authMiddleware.RequireAuth())
+		{
+			authenticated.GET("/character/:characterId/xp-history", xpHistoryHandler.GetByCharacterID)
+		}
+	}
+
+	return router
+}
+
+func TestXpHistoryHandler_GetByCharacterID(t *testing.T) {
+	router := setupTestRouterForXpHistory()
+
+	w := performJSONRequest(router, "GET", "/api/v1/character/char-123/xp-history?page=1&pageSize=2", nil)
+	if w.Code != http.StatusOK {
+		t.Fatalf("Status code = %v, want %v (body: %s)", w.Code, http.StatusOK, w.Body.String())
+	}
+
+	var response dto.XpHistoryResponse
+	json.Unmarshal(w.Body.Bytes(), &response)
+
+	if response.Total != 3 || response.PageSize != 2 || len(response.Transactions) != 2 {
+		t.Fatalf("response = %+v, want 2 of 3 transactions", response)
+	}
+
+	// Most recent first
+	if response.Transactions[0].Amount != -15 || response.Transactions[1].Amount != 40 {
+		t.Errorf("amounts = (%v, %v), want (-15, 40)", response.Transactions[0].Amount, response.Transactions[1].Amount)
+	}
+}
+
+func TestXpHistoryHandler_GetByCharacterID_Errors(t *testing.T) {
+	router := setupTestRouterForXpHistory()
+
+	tests := []struct {
+		name string
+		path string
+		want int
+	}{
+		{"character of another user", "/api/v1/character/char-456/xp-history", http.StatusForbidden},
+		{"negative page", "/api/v1/character/char-123/xp-history?page=-1", http.StatusBadRequest},
+		{"page size too large", "/api/v1/character/char-123/xp-history?pageSize=1000", http.StatusBadRequest},
+		{"non-numeric page", "/api/v1/character/char-123/xp-history?page=abc", http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := performJSONRequest(router, "GET", tt.path, nil)
+			if w.Code != tt.want {
+				t.Errorf("Status code = %v, want %v (body: %s)", w.Code, tt.want, w.Body.String())
+			}
+		})
+	}
+}
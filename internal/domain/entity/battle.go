@@ -0,0 +1,167 @@
+package entity
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Kinds of battle
+const (
+	BattleKindPvP = "pvp" // Character against character (challenges)
+	BattleKindPvE = "pve" // Character against a monster
+)
+
+// Battle represents a fought battle with its combatant snapshots, seed and log (Domain Entity)
+// The snapshots and the seed are enough to simulate the battle again, action by action
+type Battle struct {
+	id         string
+	challenger valueobject.Combatant
+	opponent   valueobject.Combatant
+	seed       int64
+	outcome    BattleOutcome
+	foughtAt   time.Time
+}
+
+// NewBattle fights a battle between the two snapshots with the given seed
+func NewBattle(id string, challenger valueobject.Combatant, opponent valueobject.Combatant, seed int64, foughtAt time.Time) (*Battle, error) {
+	if id == "" {
+		return nil, fmt.Errorf("battle id cannot be empty")
+	}
+	if challenger.Kind() != valueobject.CombatantCharacter {
+		return nil, fmt.Errorf("battles are started by characters")
+	}
+	if opponent.ID() == "" {
+		return nil, fmt.Errorf("opponent cannot be empty")
+	}
+	if challenger.Kind() == opponent.Kind() && challenger.ID() == opponent.ID() {
+		return nil, fmt.Errorf("a character cannot battle itself")
+	}
+	if foughtAt.IsZero() {
+		return nil, fmt.Errorf("battle time cannot be empty")
+	}
+
+	return &Battle{
+		id:         id,
+		challenger: challenger,
+		opponent:   opponent,
+		seed:       seed,
+		outcome:    SimulateBattle(challenger, opponent, seed),
+		foughtAt:   foughtAt,
+	}, nil
+}
+
+// Getters (Read-only access to ensure encapsulation)
+
+func (b *Battle) ID() string {
+	return b.id
+}
+
+func (b *Battle) Challenger() valueobject.Combatant {
+	return b.challenger
+}
+
+func (b *Battle) Opponent() valueobject.Combatant {
+	return b.opponent
+}
+
+func (b *Battle) ChallengerID() string {
+	return b.challenger.ID()
+}
+
+func (b *Battle) OpponentID() string {
+	return b.opponent.ID()
+}
+
+func (b *Battle) Seed() int64 {
+	return b.seed
+}
+
+func (b *Battle) Winner() string {
+	return b.outcome.Winner
+}
+
+func (b *Battle) Rounds() int {
+	return b.outcome.Rounds
+}
+
+func (b *Battle) ChallengerHP() int {
+	return b.outcome.ChallengerHP
+}
+
+func (b *Battle) OpponentHP() int {
+	return b.outcome.OpponentHP
+}
+
+// Actions returns a copy of the battle log
+func (b *Battle) Actions() []BattleAction {
+	return slices.Clone(b.outcome.Actions)
+}
+
+func (b *Battle) FoughtAt() time.Time {
+	return b.foughtAt
+}
+
+// Business Methods
+
+// Kind returns whether the battle was fought against a character (pvp) or a monster (pve)
+func (b *Battle) Kind() string {
+	if b.opponent.Kind() == valueobject.CombatantMonster {
+		return BattleKindPvE
+	}
+	return BattleKindPvP
+}
+
+// IsDraw reports whether nobody won the battle
+func (b *Battle) IsDraw() bool {
+	return b.outcome.IsDraw()
+}
+
+// WinnerID returns the ID of the winning combatant (empty on a draw)
+func (b *Battle) WinnerID() string {
+	switch b.outcome.Winner {
+	case BattleSideChallenger:
+		return b.challenger.ID()
+	case BattleSideOpponent:
+		return b.opponent.ID()
+	default:
+		return ""
+	}
+}
+
+// Replay simulates the battle again from its snapshots and seed
+func (b *Battle) Replay() BattleOutcome {
+	return SimulateBattle(b.challenger, b.opponent, b.seed)
+}
+
+// IsReproducible reports whether replaying the battle yields exactly the recorded outcome and log
+func (b *Battle) IsReproducible() bool {
+	replay := b.Replay()
+
+	return replay.Winner == b.outcome.Winner &&
+		replay.Rounds == b.outcome.Rounds &&
+		replay.ChallengerHP == b.outcome.ChallengerHP &&
+		replay.OpponentHP == b.outcome.OpponentHP &&
+		slices.Equal(replay.Actions, b.outcome.Actions)
+}
+
+// ReconstituteBattle creates a Battle from existing data (for repository loading)
+func ReconstituteBattle(
+	id string,
+	challenger valueobject.Combatant,
+	opponent valueobject.Combatant,
+	seed int64,
+	outcome BattleOutcome,
+	foughtAt time.Time,
+) *Battle {
+	return &Battle{
+		id:         id,
+		challenger: challenger,
+		opponent:   opponent,
+		seed:       seed,
+		outcome:    outcome,
+		foughtAt:   foughtAt,
+	}
+}
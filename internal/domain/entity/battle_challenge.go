@@ -0,0 +1,191 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// Battle challenge statuses
+const (
+	BattleChallengePending  = "pending"
+	BattleChallengeAccepted = "accepted"
+	BattleChallengeDeclined = "declined"
+	BattleChallengeExpired  = "expired"
+)
+
+// BattleChallenge represents a PvP challenge from one character to another (Domain Entity)
+// A pending challenge expires on its own: the expiration is derived from expiresAt, never stored
+type BattleChallenge struct {
+	id                    string
+	challengerCharacterID string
+	opponentCharacterID   string
+	ranked                bool   // Ranked battles update both characters' ratings
+	status                string // pending, accepted or declined (expired is derived)
+	battleID              string // Set once the challenge is accepted and the battle is fought
+	createdAt             time.Time
+	expiresAt             time.Time
+	respondedAt           *time.Time // Set once the opponent accepts or declines
+}
+
+// NewBattleChallenge creates a new, pending BattleChallenge with validation
+func NewBattleChallenge(
+	id string,
+	challengerCharacterID string,
+	opponentCharacterID string,
+	ranked bool,
+	createdAt time.Time,
+	expiresIn time.Duration,
+) (*BattleChallenge, error) {
+	if id == "" {
+		return nil, fmt.Errorf("battle challenge id cannot be empty")
+	}
+	if challengerCharacterID == "" {
+		return nil, fmt.Errorf("challenger character id cannot be empty")
+	}
+	if opponentCharacterID == "" {
+		return nil, fmt.Errorf("opponent character id cannot be empty")
+	}
+	if challengerCharacterID == opponentCharacterID {
+		return nil, fmt.Errorf("a character cannot challenge itself")
+	}
+	if createdAt.IsZero() {
+		return nil, fmt.Errorf("creation time cannot be empty")
+	}
+	if expiresIn <= 0 {
+		return nil, fmt.Errorf("challenge expiration must be positive")
+	}
+
+	return &BattleChallenge{
+		id:                    id,
+		challengerCharacterID: challengerCharacterID,
+		opponentCharacterID:   opponentCharacterID,
+		ranked:                ranked,
+		status:                BattleChallengePending,
+		createdAt:             createdAt,
+		expiresAt:             createdAt.Add(expiresIn),
+	}, nil
+}
+
+// Getters (Read-only access to ensure encapsulation)
+
+func (bc *BattleChallenge) ID() string {
+	return bc.id
+}
+
+func (bc *BattleChallenge) ChallengerCharacterID() string {
+	return bc.challengerCharacterID
+}
+
+func (bc *BattleChallenge) OpponentCharacterID() string {
+	return bc.opponentCharacterID
+}
+
+// IsRanked reports whether the battle updates both characters' ratings
+func (bc *BattleChallenge) IsRanked() bool {
+	return bc.ranked
+}
+
+func (bc *BattleChallenge) BattleID() string {
+	return bc.battleID
+}
+
+func (bc *BattleChallenge) CreatedAt() time.Time {
+	return bc.createdAt
+}
+
+func (bc *BattleChallenge) ExpiresAt() time.Time {
+	return bc.expiresAt
+}
+
+func (bc *BattleChallenge) RespondedAt() *time.Time {
+	return bc.respondedAt
+}
+
+// StoredStatus returns the persisted status (pending, accepted or declined)
+func (bc *BattleChallenge) StoredStatus() string {
+	return bc.status
+}
+
+// Business Methods
+
+// Status returns the status of the challenge at the given time
+// A pending challenge past its expiration is reported as expired
+func (bc *BattleChallenge) Status(now time.Time) string {
+	if bc.status == BattleChallengePending && bc.IsExpired(now) {
+		return BattleChallengeExpired
+	}
+	return bc.status
+}
+
+// IsExpired reports whether the answer window is over at the given time
+func (bc *BattleChallenge) IsExpired(now time.Time) bool {
+	return !now.Before(bc.expiresAt)
+}
+
+// IsParticipant reports whether the character is the challenger or the opponent
+func (bc *BattleChallenge) IsParticipant(characterID string) bool {
+	return characterID == bc.challengerCharacterID || characterID == bc.opponentCharacterID
+}
+
+// Accept records the opponent's acceptance and the battle that was fought
+func (bc *BattleChallenge) Accept(at time.Time, battleID string) error {
+	if err := bc.respond(at); err != nil {
+		return err
+	}
+	if battleID == "" {
+		return fmt.Errorf("battle id cannot be empty")
+	}
+
+	bc.status = BattleChallengeAccepted
+	bc.battleID = battleID
+	bc.respondedAt = &at
+	return nil
+}
+
+// Decline records the opponent's refusal
+func (bc *BattleChallenge) Decline(at time.Time) error {
+	if err := bc.respond(at); err != nil {
+		return err
+	}
+
+	bc.status = BattleChallengeDeclined
+	bc.respondedAt = &at
+	return nil
+}
+
+// respond checks the challenge can still be answered at the given time
+func (bc *BattleChallenge) respond(at time.Time) error {
+	switch bc.Status(at) {
+	case BattleChallengePending:
+		return nil
+	case BattleChallengeExpired:
+		return fmt.Errorf("battle challenge has expired")
+	default:
+		return fmt.Errorf("battle challenge was already answered")
+	}
+}
+
+// ReconstituteBattleChallenge creates a BattleChallenge from existing data (for repository loading)
+func ReconstituteBattleChallenge(
+	id string,
+	challengerCharacterID string,
+	opponentCharacterID string,
+	ranked bool,
+	status string,
+	battleID string,
+	createdAt time.Time,
+	expiresAt time.Time,
+	respondedAt *time.Time,
+) *BattleChallenge {
+	return &BattleChallenge{
+		id:                    id,
+		challengerCharacterID: challengerCharacterID,
+		opponentCharacterID:   opponentCharacterID,
+		ranked:                ranked,
+		status:                status,
+		battleID:              battleID,
+		createdAt:             createdAt,
+		expiresAt:             expiresAt,
+		respondedAt:           respondedAt,
+	}
+}
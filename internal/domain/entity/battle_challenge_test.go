@@ -0,0 +1,146 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+var challengeCreatedAt = time.Date(2024, 3, 1, 18, 0, 0, 0, time.UTC)
+
+func newTestBattleChallenge(t *testing.T) *entity.BattleChallenge {
+	t.Helper()
+
+	challenge, err := entity.NewBattleChallenge("challenge-1", "char-123", "char-456", false, challengeCreatedAt, 24*time.Hour)
+	if err != nil {
+		t.Fatalf("NewBattleChallenge() error = %v, want nil", err)
+	}
+	return challenge
+}
+
+func TestNewBattleChallenge_Valid(t *testing.T) {
+	challenge := newTestBattleChallenge(t)
+
+	if got := challenge.Status(challengeCreatedAt); got != entity.BattleChallengePending {
+		t.Errorf("Status() = %v, want %v", got, entity.BattleChallengePending)
+	}
+
+	if want := challengeCreatedAt.Add(24 * time.Hour); !challenge.ExpiresAt().Equal(want) {
+		t.Errorf("ExpiresAt() = %v, want %v", challenge.ExpiresAt(), want)
+	}
+
+	if challenge.BattleID() != "" || challenge.RespondedAt() != nil {
+		t.Error("new challenge should have no battle nor answer")
+	}
+
+	if !challenge.IsParticipant("char-123") || !challenge.IsParticipant("char-456") || challenge.IsParticipant("char-789") {
+		t.Error("IsParticipant() should only hold for the challenger and the opponent")
+	}
+}
+
+func TestNewBattleChallenge_Invalid(t *testing.T) {
+	tests := []struct {
+		name         string
+		id           string
+		challengerID string
+		opponentID   string
+		createdAt    time.Time
+		expiresIn    time.Duration
+	}{
+		{"empty id", "", "char-123", "char-456", challengeCreatedAt, time.Hour},
+		{"empty challenger", "challenge-1", "", "char-456", challengeCreatedAt, time.Hour},
+		{"empty opponent", "challenge-1", "char-123", "", challengeCreatedAt, time.Hour},
+		{"self challenge", "challenge-1", "char-123", "char-123", challengeCreatedAt, time.Hour},
+		{"zero creation time", "challenge-1", "char-123", "char-456", time.Time{}, time.Hour},
+		{"no expiration", "challenge-1", "char-123", "char-456", challengeCreatedAt, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := entity.NewBattleChallenge(tt.id, tt.challengerID, tt.opponentID, false, tt.createdAt, tt.expiresIn); err == nil {
+				t.Error("NewBattleChallenge() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestBattleChallenge_ExpiresLazily(t *testing.T) {
+	challenge := newTestBattleChallenge(t)
+
+	if got := challenge.Status(challengeCreatedAt.Add(24*time.Hour - time.Second)); got != entity.BattleChallengePending {
+		t.Errorf("Status() just before expiration = %v, want %v", got, entity.BattleChallengePending)
+	}
+
+	expiredAt := challengeCreatedAt.Add(24 * time.Hour)
+	if got := challenge.Status(expiredAt); got != entity.BattleChallengeExpired {
+		t.Errorf("Status() at expiration = %v, want %v", got, entity.BattleChallengeExpired)
+	}
+
+	// The stored status doesn't change: expiration is derived
+	if got := challenge.StoredStatus(); got != entity.BattleChallengePending {
+		t.Errorf("StoredStatus() = %v, want %v", got, entity.BattleChallengePending)
+	}
+
+	if err := challenge.Accept(expiredAt, "battle-1"); err == nil {
+		t.Error("Accept() on expired challenge error = nil, want error")
+	}
+	if err := challenge.Decline(expiredAt); err == nil {
+		t.Error("Decline() on expired challenge error = nil, want error")
+	}
+}
+
+func TestBattleChallenge_Accept(t *testing.T) {
+	challenge := newTestBattleChallenge(t)
+	acceptedAt := challengeCreatedAt.Add(time.Hour)
+
+	if err := challenge.Accept(acceptedAt, ""); err == nil {
+		t.Error("Accept() without battle error = nil, want error")
+	}
+
+	if err := challenge.Accept(acceptedAt, "battle-1"); err != nil {
+		t.Fatalf("Accept() error = %v, want nil", err)
+	}
+
+	if got := challenge.Status(acceptedAt); got != entity.BattleChallengeAccepted {
+		t.Errorf("Status() = %v, want %v", got, entity.BattleChallengeAccepted)
+	}
+
+	// Answered challenges never expire
+	if got := challenge.Status(acceptedAt.Add(48 * time.Hour)); got != entity.BattleChallengeAccepted {
+		t.Errorf("Status() after expiration = %v, want %v", got, entity.BattleChallengeAccepted)
+	}
+
+	if challenge.BattleID() != "battle-1" {
+		t.Errorf("BattleID() = %v, want %v", challenge.BattleID(), "battle-1")
+	}
+
+	if challenge.RespondedAt() == nil || !challenge.RespondedAt().Equal(acceptedAt) {
+		t.Errorf("RespondedAt() = %v, want %v", challenge.RespondedAt(), acceptedAt)
+	}
+
+	if err := challenge.Decline(acceptedAt); err == nil {
+		t.Error("Decline() on accepted challenge error = nil, want error")
+	}
+}
+
+func TestBattleChallenge_Decline(t *testing.T) {
+	challenge := newTestBattleChallenge(t)
+	declinedAt := challengeCreatedAt.Add(time.Hour)
+
+	if err := challenge.Decline(declinedAt); err != nil {
+		t.Fatalf("Decline() error = %v, want nil", err)
+	}
+
+	if got := challenge.Status(declinedAt); got != entity.BattleChallengeDeclined {
+		t.Errorf("Status() = %v, want %v", got, entity.BattleChallengeDeclined)
+	}
+
+	if challenge.BattleID() != "" {
+		t.Errorf("BattleID() = %v, want empty", challenge.BattleID())
+	}
+
+	if err := challenge.Accept(declinedAt, "battle-1"); err == nil {
+		t.Error("Accept() on declined challenge error = nil, want error")
+	}
+}
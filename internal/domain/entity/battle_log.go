@@ -0,0 +1,200 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// BattleLogVersion is the version of the battle log format written by EncodeLog
+// Bump it on any change clients can't ignore; logs keep the version they were written with
+const BattleLogVersion = 1
+
+// Codes of the compact battle log
+const (
+	battleLogChallenger = 0 // Actor index of the challenger (the opponent is 1)
+	battleLogOpponent   = 1
+
+	battleLogPhysical = 0 // Attack codes
+	battleLogMagic    = 1
+
+	battleLogDodged   = 1 << 0 // Effect flags
+	battleLogCritical = 1 << 1
+)
+
+// battleLogRecord is the compact JSON event log of a battle (version 1):
+//
+//	{
+//	  "v": 1,
+//	  "seed": 42,
+//	  "combatants": [challenger, opponent],
+//	  "actions": [[round, actor, attack, effects, damage, targetHp], ...],
+//	  "result": {"winner": "challenger", "rounds": 3, "hp": [challengerHp, opponentHp]}
+//	}
+//
+// Each combatant is {"kind", "id", "name", "level", "stats": [hp, attack, magic, defense, evasion, initiative]}.
+// In an action, actor is 0 (challenger) or 1 (opponent), attack is 0 (physical) or 1 (magic) and effects
+// combines the flags 1 (dodged) and 2 (critical). The winner is empty on a draw.
+type battleLogRecord struct {
+	Version    int                   `json:"v"`
+	Seed       int64                 `json:"seed"`
+	Combatants [2]battleLogCombatant `json:"combatants"`
+	Actions    [][6]int              `json:"actions"`
+	Result     battleLogResult       `json:"result"`
+}
+
+// battleLogCombatant is a combatant snapshot in the battle log
+type battleLogCombatant struct {
+	Kind  string `json:"kind"`
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Level int    `json:"level"`
+	Stats [6]int `json:"stats"`
+}
+
+// battleLogResult is the outcome recorded in the battle log
+type battleLogResult struct {
+	Winner string `json:"winner"`
+	Rounds int    `json:"rounds"`
+	HP     [2]int `json:"hp"`
+}
+
+// EncodeLog encodes the battle as a versioned, compact JSON event log
+// The log holds everything needed to replay the battle: both snapshots, the seed and every action
+func (b *Battle) EncodeLog() ([]byte, error) {
+	record := battleLogRecord{
+		Version:    BattleLogVersion,
+		Seed:       b.seed,
+		Combatants: [2]battleLogCombatant{encodeLogCombatant(b.challenger), encodeLogCombatant(b.opponent)},
+		Actions:    make([][6]int, len(b.outcome.Actions)),
+		Result: battleLogResult{
+			Winner: b.outcome.Winner,
+			Rounds: b.outcome.Rounds,
+			HP:     [2]int{b.outcome.ChallengerHP, b.outcome.OpponentHP},
+		},
+	}
+
+	for i, action := range b.outcome.Actions {
+		actor := battleLogChallenger
+		if action.Actor == BattleSideOpponent {
+			actor = battleLogOpponent
+		}
+
+		attack := battleLogPhysical
+		if action.Attack == AttackMagic {
+			attack = battleLogMagic
+		}
+
+		effects := 0
+		if action.Dodged {
+			effects |= battleLogDodged
+		}
+		if action.Critical {
+			effects |= battleLogCritical
+		}
+
+		record.Actions[i] = [6]int{action.Round, actor, attack, effects, action.Damage, action.TargetHP}
+	}
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode battle log: %w", err)
+	}
+
+	return data, nil
+}
+
+// DecodeBattleLog rebuilds a Battle from its JSON event log (for repository loading)
+func DecodeBattleLog(id string, data []byte, foughtAt time.Time) (*Battle, error) {
+	var record battleLogRecord
+	if err := json.Unmarshal(data, &record); err != nil {
+		return nil, fmt.Errorf("invalid battle log: %w", err)
+	}
+
+	if record.Version != BattleLogVersion {
+		return nil, fmt.Errorf("unsupported battle log version %d", record.Version)
+	}
+
+	challenger, err := decodeLogCombatant(record.Combatants[battleLogChallenger])
+	if err != nil {
+		return nil, fmt.Errorf("invalid challenger in battle log: %w", err)
+	}
+
+	opponent, err := decodeLogCombatant(record.Combatants[battleLogOpponent])
+	if err != nil {
+		return nil, fmt.Errorf("invalid opponent in battle log: %w", err)
+	}
+
+	switch record.Result.Winner {
+	case BattleSideChallenger, BattleSideOpponent, "":
+	default:
+		return nil, fmt.Errorf("invalid winner in battle log: %s", record.Result.Winner)
+	}
+
+	outcome := BattleOutcome{
+		Winner:       record.Result.Winner,
+		Rounds:       record.Result.Rounds,
+		ChallengerHP: record.Result.HP[battleLogChallenger],
+		OpponentHP:   record.Result.HP[battleLogOpponent],
+		Actions:      make([]BattleAction, len(record.Actions)),
+	}
+
+	for i, entry := range record.Actions {
+		round, actor, attack, effects, damage, targetHP := entry[0], entry[1], entry[2], entry[3], entry[4], entry[5]
+
+		action := BattleAction{
+			Round:    round,
+			Actor:    BattleSideChallenger,
+			Attack:   AttackPhysical,
+			Dodged:   effects&battleLogDodged != 0,
+			Critical: effects&battleLogCritical != 0,
+			Damage:   damage,
+			TargetHP: targetHP,
+		}
+
+		switch actor {
+		case battleLogChallenger:
+		case battleLogOpponent:
+			action.Actor = BattleSideOpponent
+		default:
+			return nil, fmt.Errorf("invalid actor %d in battle log action %d", actor, i+1)
+		}
+
+		switch attack {
+		case battleLogPhysical:
+		case battleLogMagic:
+			action.Attack = AttackMagic
+		default:
+			return nil, fmt.Errorf("invalid attack %d in battle log action %d", attack, i+1)
+		}
+
+		outcome.Actions[i] = action
+	}
+
+	return ReconstituteBattle(id, challenger, opponent, record.Seed, outcome, foughtAt), nil
+}
+
+// encodeLogCombatant converts a combatant snapshot to its log record
+func encodeLogCombatant(combatant valueobject.Combatant) battleLogCombatant {
+	stats := combatant.Stats()
+
+	return battleLogCombatant{
+		Kind:  combatant.Kind(),
+		ID:    combatant.ID(),
+		Name:  combatant.Name(),
+		Level: combatant.Level(),
+		Stats: [6]int{stats.HP(), stats.Attack(), stats.Magic(), stats.Defense(), stats.Evasion(), stats.Initiative()},
+	}
+}
+
+// decodeLogCombatant rebuilds a combatant snapshot from its log record
+func decodeLogCombatant(record battleLogCombatant) (valueobject.Combatant, error) {
+	stats, err := valueobject.ReconstituteCombatStats(record.Stats[0], record.Stats[1], record.Stats[2], record.Stats[3], record.Stats[4], record.Stats[5])
+	if err != nil {
+		return valueobject.Combatant{}, err
+	}
+
+	return valueobject.NewCombatant(record.Kind, record.ID, record.Name, record.Level, stats)
+}
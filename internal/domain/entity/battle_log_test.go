@@ -0,0 +1,141 @@
+package entity_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+func TestBattleLog_ReplayReproducesTheLog(t *testing.T) {
+	matchups := []struct {
+		name            string
+		challengerValue int
+		challengerLevel int
+		opponentValue   int
+		opponentLevel   int
+	}{
+		{"even match", 6, 3, 6, 3},
+		{"stronger challenger", 15, 8, 4, 2},
+		{"stronger opponent", 3, 1, 20, 10},
+	}
+
+	for _, tt := range matchups {
+		for _, seed := range []int64{0, 1, 42, 9007199254740991} {
+			battle := newTestBattle(t,
+				newTestCombatant(t, "hero", tt.challengerValue, tt.challengerLevel),
+				newTestCombatant(t, "rival", tt.opponentValue, tt.opponentLevel),
+				seed,
+			)
+
+			log, err := battle.EncodeLog()
+			if err != nil {
+				t.Fatalf("%s/%d: EncodeLog() error = %v, want nil", tt.name, seed, err)
+			}
+
+			// Rebuild the battle from the stored log alone...
+			stored, err := entity.DecodeBattleLog(battle.ID(), log, battle.FoughtAt())
+			if err != nil {
+				t.Fatalf("%s/%d: DecodeBattleLog() error = %v, want nil", tt.name, seed, err)
+			}
+
+			// ...re-run the simulator from its snapshots and seed, and encode the replay again
+			replay := entity.ReconstituteBattle(stored.ID(), stored.Challenger(), stored.Opponent(), stored.Seed(), stored.Replay(), stored.FoughtAt())
+
+			replayLog, err := replay.EncodeLog()
+			if err != nil {
+				t.Fatalf("%s/%d: EncodeLog() of replay error = %v, want nil", tt.name, seed, err)
+			}
+
+			if !bytes.Equal(replayLog, log) {
+				t.Errorf("%s/%d: replayed log differs from the stored log\nreplay: %s\nstored: %s", tt.name, seed, replayLog, log)
+			}
+
+			if !stored.IsReproducible() {
+				t.Errorf("%s/%d: IsReproducible() = false, want true", tt.name, seed)
+			}
+		}
+	}
+}
+
+func TestBattleLog_Format(t *testing.T) {
+	battle := newTestBattle(t, newTestCombatant(t, "hero", 10, 5), newTestCombatant(t, "rival", 4, 2), 42)
+
+	log, err := battle.EncodeLog()
+	if err != nil {
+		t.Fatalf("EncodeLog() error = %v, want nil", err)
+	}
+
+	var decoded struct {
+		Version    int `json:"v"`
+		Seed       int64
+		Combatants []struct {
+			Kind  string
+			ID    string
+			Level int
+			Stats []int
+		}
+		Actions [][]int
+		Result  struct {
+			Winner string
+			Rounds int
+			HP     []int
+		}
+	}
+	if err := json.Unmarshal(log, &decoded); err != nil {
+		t.Fatalf("log is not valid JSON: %v", err)
+	}
+
+	if decoded.Version != entity.BattleLogVersion || decoded.Seed != 42 {
+		t.Errorf("log header = (v%d, seed %d), want (v%d, seed 42)", decoded.Version, decoded.Seed, entity.BattleLogVersion)
+	}
+
+	if len(decoded.Combatants) != 2 || decoded.Combatants[0].ID != "hero" || decoded.Combatants[1].ID != "rival" {
+		t.Fatalf("combatants = %+v, want hero then rival", decoded.Combatants)
+	}
+
+	stats := battle.Challenger().Stats()
+	if got := decoded.Combatants[0].Stats; len(got) != 6 || got[0] != stats.HP() || got[5] != stats.Initiative() {
+		t.Errorf("challenger stats = %v, want [hp ... initiative] of the snapshot", got)
+	}
+
+	actions := battle.Actions()
+	if len(decoded.Actions) != len(actions) {
+		t.Fatalf("len(actions) = %d, want %d", len(decoded.Actions), len(actions))
+	}
+
+	// Actions are compact tuples: [round, actor, attack, effects, damage, targetHp]
+	first := decoded.Actions[0]
+	if len(first) != 6 || first[0] != actions[0].Round || first[4] != actions[0].Damage || first[5] != actions[0].TargetHP {
+		t.Errorf("first action = %v, want a tuple of %+v", first, actions[0])
+	}
+
+	if decoded.Result.Winner != battle.Winner() || decoded.Result.Rounds != battle.Rounds() {
+		t.Errorf("result = %+v, want the battle outcome", decoded.Result)
+	}
+}
+
+func TestDecodeBattleLog_Invalid(t *testing.T) {
+	battle := newTestBattle(t, newTestCombatant(t, "hero", 10, 5), newTestCombatant(t, "rival", 4, 2), 42)
+	log, _ := battle.EncodeLog()
+
+	tests := []struct {
+		name string
+		log  string
+	}{
+		{"not json", "battle"},
+		{"unknown version", strings.Replace(string(log), `"v":1`, `"v":99`, 1)},
+		{"invalid combatant", strings.Replace(string(log), `"kind":"character"`, `"kind":"dragon"`, 1)},
+		{"invalid winner", strings.Replace(string(log), `"winner":"`+battle.Winner()+`"`, `"winner":"nobody"`, 1)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := entity.DecodeBattleLog("battle-1", []byte(tt.log), battleFoughtAt); err == nil {
+				t.Error("DecodeBattleLog() error = nil, want error")
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package entity
+
+import (
+	"math/rand/v2"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Sides of a battle
+const (
+	BattleSideChallenger = "challenger"
+	BattleSideOpponent   = "opponent"
+)
+
+// Kinds of attack (each combatant strikes with its strongest power)
+const (
+	AttackPhysical = "physical"
+	AttackMagic    = "magic"
+)
+
+// Battle rules
+const (
+	maxBattleRounds        = 30 // Battles still standing after this are decided by the HP left
+	criticalChance         = 10 // Chance (%) of a critical hit
+	damageVariance         = 10 // Damage varies up to this percentage around its base
+	physicalDefenseDivisor = 2  // Defense absorbs half of it against physical attacks
+	magicDefenseDivisor    = 4  // ...and only a quarter against magic
+)
+
+// battleRngStream separates the battle RNG from any other stream derived from the same seed
+const battleRngStream = 0x6261746c65
+
+// BattleAction is one strike of a battle log
+type BattleAction struct {
+	Round    int
+	Actor    string // Side that strikes (challenger or opponent)
+	Attack   string // physical or magic
+	Dodged   bool
+	Critical bool
+	Damage   int
+	TargetHP int // HP left to the target after the strike
+}
+
+// BattleOutcome is the result of a simulated battle with its turn-by-turn log
+type BattleOutcome struct {
+	Winner       string // Winning side, empty on a draw
+	Rounds       int
+	ChallengerHP int // HP left at the end
+	OpponentHP   int
+	Actions      []BattleAction
+}
+
+// IsDraw reports whether nobody won the battle
+func (o BattleOutcome) IsDraw() bool {
+	return o.Winner == ""
+}
+
+// SimulateBattle runs a turn-based battle between two combatants
+// The simulation is deterministic: the same combatants and seed always produce the same outcome and log.
+// Every round both combatants strike once, the one with the higher initiative first (the challenger on a tie).
+// A strike can be dodged (target's evasion), deals its power minus part of the target's defense with some
+// variance, and can be critical. The battle ends when a combatant reaches 0 HP or after maxBattleRounds, when
+// the combatant with the larger share of its HP left wins.
+func SimulateBattle(challenger valueobject.Combatant, opponent valueobject.Combatant, seed int64) BattleOutcome {
+	rng := rand.New(rand.NewPCG(uint64(seed), battleRngStream))
+
+	combatants := [2]valueobject.CombatStats{challenger.Stats(), opponent.Stats()}
+	sides := [2]string{BattleSideChallenger, BattleSideOpponent}
+	hp := [2]int{combatants[0].HP(), combatants[1].HP()}
+
+	order := [2]int{0, 1}
+	if combatants[1].Initiative() > combatants[0].Initiative() {
+		order = [2]int{1, 0}
+	}
+
+	outcome := BattleOutcome{}
+
+	for round := 1; round <= maxBattleRounds; round++ {
+		outcome.Rounds = round
+
+		for _, actor := range order {
+			target := 1 - actor
+
+			action := strike(rng, combatants[actor], combatants[target])
+			action.Round = round
+			action.Actor = sides[actor]
+
+			hp[target] = max(hp[target]-action.Damage, 0)
+			action.TargetHP = hp[target]
+			outcome.Actions = append(outcome.Actions, action)
+
+			if hp[target] == 0 {
+				outcome.Winner = sides[actor]
+				outcome.ChallengerHP, outcome.OpponentHP = hp[0], hp[1]
+				return outcome
+			}
+		}
+	}
+
+	// Nobody fell: the larger share of HP left wins (compared without rounding)
+	outcome.ChallengerHP, outcome.OpponentHP = hp[0], hp[1]
+	challengerShare := hp[0] * combatants[1].HP()
+	opponentShare := hp[1] * combatants[0].HP()
+	switch {
+	case challengerShare > opponentShare:
+		outcome.Winner = BattleSideChallenger
+	case opponentShare > challengerShare:
+		outcome.Winner = BattleSideOpponent
+	}
+
+	return outcome
+}
+
+// strike resolves one attack of attacker against target (Round, Actor and TargetHP are set by the caller)
+func strike(rng *rand.Rand, attacker valueobject.CombatStats, target valueobject.CombatStats) BattleAction {
+	action := BattleAction{Attack: AttackPhysical}
+	power, defense := attacker.Attack(), target.Defense()/physicalDefenseDivisor
+	if attacker.Magic() > attacker.Attack() {
+		action.Attack = AttackMagic
+		power, defense = attacker.Magic(), target.Defense()/magicDefenseDivisor
+	}
+
+	if rng.IntN(100) < target.Evasion() {
+		action.Dodged = true
+		return action
+	}
+
+	damage := max(power-defense, 1)
+	damage = max(damage*(100-damageVariance+rng.IntN(2*damageVariance+1))/100, 1)
+
+	if rng.IntN(100) < criticalChance {
+		action.Critical = true
+		damage = damage * 3 / 2
+	}
+
+	action.Damage = damage
+	return action
+}
@@ -0,0 +1,143 @@
+package entity_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// newTestCombatant builds a combatant with every attribute at the same value
+func newTestCombatant(t *testing.T, id string, attributeValue int, level int) valueobject.Combatant {
+	t.Helper()
+
+	attributes := map[string]int{}
+	for _, profile := range valueobject.DefaultCharacterClass().Attributes() {
+		attributes[profile.Name] = attributeValue
+	}
+
+	combatant, err := valueobject.NewCombatant(valueobject.CombatantCharacter, id, id, level, valueobject.NewCombatStats(attributes, level))
+	if err != nil {
+		t.Fatalf("NewCombatant() error = %v, want nil", err)
+	}
+	return combatant
+}
+
+func TestSimulateBattle_IsDeterministic(t *testing.T) {
+	challenger := newTestCombatant(t, "hero", 5, 3)
+	opponent := newTestCombatant(t, "rival", 5, 3)
+
+	for _, seed := range []int64{0, 1, 42, 9007199254740991} {
+		first := entity.SimulateBattle(challenger, opponent, seed)
+		second := entity.SimulateBattle(challenger, opponent, seed)
+
+		if !reflect.DeepEqual(first, second) {
+			t.Errorf("seed %d: outcomes differ between runs", seed)
+		}
+	}
+}
+
+func TestSimulateBattle_SeedChangesTheBattle(t *testing.T) {
+	challenger := newTestCombatant(t, "hero", 5, 3)
+	opponent := newTestCombatant(t, "rival", 5, 3)
+
+	reference := entity.SimulateBattle(challenger, opponent, 1)
+	for seed := int64(2); seed < 20; seed++ {
+		if !reflect.DeepEqual(reference, entity.SimulateBattle(challenger, opponent, seed)) {
+			return
+		}
+	}
+	t.Error("every seed produced the same battle")
+}
+
+func TestSimulateBattle_LogIsConsistent(t *testing.T) {
+	challenger := newTestCombatant(t, "hero", 6, 4)
+	opponent := newTestCombatant(t, "rival", 5, 3)
+
+	for seed := int64(0); seed < 50; seed++ {
+		outcome := entity.SimulateBattle(challenger, opponent, seed)
+
+		// Replaying the log must land on the reported HP
+		hp := map[string]int{
+			entity.BattleSideChallenger: challenger.Stats().HP(),
+			entity.BattleSideOpponent:   opponent.Stats().HP(),
+		}
+		for _, action := range outcome.Actions {
+			target := entity.BattleSideOpponent
+			if action.Actor == entity.BattleSideOpponent {
+				target = entity.BattleSideChallenger
+			}
+
+			if action.Dodged && action.Damage != 0 {
+				t.Fatalf("seed %d: dodged strike dealt %d damage", seed, action.Damage)
+			}
+			if !action.Dodged && action.Damage < 1 {
+				t.Fatalf("seed %d: strike dealt %d damage, want at least 1", seed, action.Damage)
+			}
+
+			hp[target] = max(hp[target]-action.Damage, 0)
+			if hp[target] != action.TargetHP {
+				t.Fatalf("seed %d: TargetHP = %d, want %d", seed, action.TargetHP, hp[target])
+			}
+		}
+
+		if hp[entity.BattleSideChallenger] != outcome.ChallengerHP || hp[entity.BattleSideOpponent] != outcome.OpponentHP {
+			t.Fatalf("seed %d: final HP (%d, %d), want (%d, %d)", seed, outcome.ChallengerHP, outcome.OpponentHP, hp[entity.BattleSideChallenger], hp[entity.BattleSideOpponent])
+		}
+
+		// A knockout ends the battle on the loser's 0 HP
+		if outcome.Rounds < 30 {
+			loserHP := outcome.OpponentHP
+			if outcome.Winner == entity.BattleSideOpponent {
+				loserHP = outcome.ChallengerHP
+			}
+			if outcome.IsDraw() || loserHP != 0 {
+				t.Fatalf("seed %d: battle ended early without a knockout", seed)
+			}
+		}
+	}
+}
+
+func TestSimulateBattle_HigherInitiativeStrikesFirst(t *testing.T) {
+	slow := newTestCombatant(t, "slow", 2, 1)
+	fast := newTestCombatant(t, "fast", 8, 1)
+
+	outcome := entity.SimulateBattle(slow, fast, 7)
+	if outcome.Actions[0].Actor != entity.BattleSideOpponent {
+		t.Errorf("first actor = %v, want opponent (higher initiative)", outcome.Actions[0].Actor)
+	}
+}
+
+func TestSimulateBattle_StrongerCombatantWins(t *testing.T) {
+	weak := newTestCombatant(t, "weak", 2, 1)
+	strong := newTestCombatant(t, "strong", 20, 20)
+
+	for seed := int64(0); seed < 20; seed++ {
+		outcome := entity.SimulateBattle(weak, strong, seed)
+		if outcome.Winner != entity.BattleSideOpponent {
+			t.Fatalf("seed %d: winner = %q, want opponent", seed, outcome.Winner)
+		}
+	}
+}
+
+func TestSimulateBattle_UndecidedBattleEndsOnHpShare(t *testing.T) {
+	// Huge HP and no damage power: nobody can fall within the round limit
+	tank := func(id string) valueobject.Combatant {
+		stats := valueobject.NewCombatStats(map[string]int{valueobject.AttributeConstitution: 1000}, 1)
+		combatant, _ := valueobject.NewCombatant(valueobject.CombatantCharacter, id, id, 1, stats)
+		return combatant
+	}
+
+	outcome := entity.SimulateBattle(tank("a"), tank("b"), 3)
+	if outcome.Rounds != 30 {
+		t.Fatalf("Rounds = %v, want 30", outcome.Rounds)
+	}
+
+	switch {
+	case outcome.ChallengerHP > outcome.OpponentHP && outcome.Winner != entity.BattleSideChallenger,
+		outcome.OpponentHP > outcome.ChallengerHP && outcome.Winner != entity.BattleSideOpponent,
+		outcome.ChallengerHP == outcome.OpponentHP && !outcome.IsDraw():
+		t.Errorf("Winner = %q with HP (%d, %d)", outcome.Winner, outcome.ChallengerHP, outcome.OpponentHP)
+	}
+}
@@ -0,0 +1,115 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+var battleFoughtAt = time.Date(2024, 3, 1, 19, 0, 0, 0, time.UTC)
+
+func newTestBattle(t *testing.T, challenger valueobject.Combatant, opponent valueobject.Combatant, seed int64) *entity.Battle {
+	t.Helper()
+
+	battle, err := entity.NewBattle("battle-1", challenger, opponent, seed, battleFoughtAt)
+	if err != nil {
+		t.Fatalf("NewBattle() error = %v, want nil", err)
+	}
+	return battle
+}
+
+func TestNewBattle_FightsTheSimulation(t *testing.T) {
+	challenger := newTestCombatant(t, "hero", 12, 4)
+	opponent := newTestCombatant(t, "rival", 3, 2)
+
+	battle := newTestBattle(t, challenger, opponent, 42)
+	outcome := entity.SimulateBattle(challenger, opponent, 42)
+
+	if battle.Winner() != outcome.Winner || battle.Rounds() != outcome.Rounds ||
+		battle.ChallengerHP() != outcome.ChallengerHP || battle.OpponentHP() != outcome.OpponentHP {
+		t.Errorf("battle = (%v, %d rounds, HP %d/%d), want the simulated outcome (%v, %d rounds, HP %d/%d)",
+			battle.Winner(), battle.Rounds(), battle.ChallengerHP(), battle.OpponentHP(),
+			outcome.Winner, outcome.Rounds, outcome.ChallengerHP, outcome.OpponentHP)
+	}
+
+	if len(battle.Actions()) != len(outcome.Actions) {
+		t.Errorf("len(Actions()) = %d, want %d", len(battle.Actions()), len(outcome.Actions))
+	}
+
+	if battle.Kind() != entity.BattleKindPvP {
+		t.Errorf("Kind() = %v, want %v", battle.Kind(), entity.BattleKindPvP)
+	}
+
+	if got := battle.WinnerID(); got != "hero" {
+		t.Errorf("WinnerID() = %v, want %v", got, "hero")
+	}
+
+	if !battle.IsReproducible() {
+		t.Error("IsReproducible() = false, want true")
+	}
+}
+
+func TestNewBattle_AgainstMonsterIsPvE(t *testing.T) {
+	opponent, err := entity.ReconstituteMonster("goblin", "Goblin", 2, 2, map[string]int{valueobject.AttributeStrength: 4}, nil, 25).Combatant(2)
+	if err != nil {
+		t.Fatalf("Combatant() error = %v, want nil", err)
+	}
+
+	battle := newTestBattle(t, newTestCombatant(t, "hero", 5, 2), opponent, 7)
+
+	if battle.Kind() != entity.BattleKindPvE {
+		t.Errorf("Kind() = %v, want %v", battle.Kind(), entity.BattleKindPvE)
+	}
+}
+
+func TestNewBattle_Invalid(t *testing.T) {
+	hero := newTestCombatant(t, "hero", 5, 2)
+	rival := newTestCombatant(t, "rival", 5, 2)
+	monster, _ := entity.ReconstituteMonster("goblin", "Goblin", 2, 2, map[string]int{}, nil, 25).Combatant(2)
+
+	tests := []struct {
+		name       string
+		id         string
+		challenger valueobject.Combatant
+		opponent   valueobject.Combatant
+		foughtAt   time.Time
+	}{
+		{"empty id", "", hero, rival, battleFoughtAt},
+		{"monster challenger", "battle-1", monster, hero, battleFoughtAt},
+		{"empty opponent", "battle-1", hero, valueobject.Combatant{}, battleFoughtAt},
+		{"battle against itself", "battle-1", hero, hero, battleFoughtAt},
+		{"zero battle time", "battle-1", hero, rival, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := entity.NewBattle(tt.id, tt.challenger, tt.opponent, 1, tt.foughtAt); err == nil {
+				t.Error("NewBattle() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestBattle_IsReproducible_DetectsTamperedLog(t *testing.T) {
+	challenger := newTestCombatant(t, "hero", 8, 3)
+	opponent := newTestCombatant(t, "rival", 8, 3)
+	battle := newTestBattle(t, challenger, opponent, 1234)
+
+	actions := battle.Actions()
+	actions[0].Damage += 5
+
+	outcome := entity.BattleOutcome{
+		Winner:       battle.Winner(),
+		Rounds:       battle.Rounds(),
+		ChallengerHP: battle.ChallengerHP(),
+		OpponentHP:   battle.OpponentHP(),
+		Actions:      actions,
+	}
+	tampered := entity.ReconstituteBattle(battle.ID(), challenger, opponent, battle.Seed(), outcome, battle.FoughtAt())
+
+	if tampered.IsReproducible() {
+		t.Error("IsReproducible() = true for a tampered log, want false")
+	}
+}
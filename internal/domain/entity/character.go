@@ -2,26 +2,65 @@ package entity
 
 import (
 	"fmt"
-	"math"
 	"strings"
+	"sync"
 	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
 )
 
+// AttributePointsPerLevel is how many attribute points a character receives on each level-up
+const AttributePointsPerLevel = 3
+
+// levelCurve is the XP curve every character levels on (configured once at startup)
+var (
+	levelCurveMu         sync.RWMutex
+	levelCurve           valueobject.LevelCurve = valueobject.DefaultLevelCurve()
+	levelCurveConfigured bool
+)
+
+// SetLevelCurve configures the XP curve and level cap of every character
+// It can only be called once, at startup before characters are used; nil keeps the default curve
+func SetLevelCurve(curve valueobject.LevelCurve) error {
+	levelCurveMu.Lock()
+	defer levelCurveMu.Unlock()
+
+	if levelCurveConfigured {
+		return fmt.Errorf("level curve was already configured")
+	}
+	levelCurveConfigured = true
+	if curve != nil {
+		levelCurve = curve
+	}
+	return nil
+}
+
+// CurrentLevelCurve returns the XP curve characters level on
+func CurrentLevelCurve() valueobject.LevelCurve {
+	levelCurveMu.RLock()
+	defer levelCurveMu.RUnlock()
+	return levelCurve
+}
+
 // Character represents a user's game character (Domain Entity)
 type Character struct {
-	id        string
-	name      string
-	level     int
-	currentXp int
-	totalXp   int
-	userID    string
-	createdAt time.Time
+	id                     string
+	name                   string
+	class                  valueobject.CharacterClass // Shapes the starting attributes and their growth
+	level                  int
+	currentXp              int
+	totalXp                int
+	unspentAttributePoints int // Negative when de-leveling took back points that were already spent
+	userID                 string
+	createdAt              time.Time
+	xpLedger               []*XpTransaction // XP changes not persisted yet
 }
 
 // NewCharacter creates a new Character entity with validation
 func NewCharacter(
 	id string,
 	name string,
+	class valueobject.CharacterClass,
 	userID string,
 ) (*Character, error) {
 	// Validate ID
@@ -41,6 +80,11 @@ func NewCharacter(
 		return nil, fmt.Errorf("character name cannot exceed 50 characters")
 	}
 
+	// Validate class
+	if class.Value() == "" {
+		return nil, fmt.Errorf("character class cannot be empty")
+	}
+
 	// Validate user ID
 	if userID == "" {
 		return nil, fmt.Errorf("user id cannot be empty")
@@ -49,6 +93,7 @@ func NewCharacter(
 	return &Character{
 		id:        id,
 		name:      name,
+		class:     class,
 		level:     1,      // Characters start at level 1
 		currentXp: 0,      // Start with 0 XP
 		totalXp:   0,      // Start with 0 total XP
@@ -67,6 +112,10 @@ func (c *Character) Name() string {
 	return c.name
 }
 
+func (c *Character) Class() valueobject.CharacterClass {
+	return c.class
+}
+
 func (c *Character) Level() int {
 	return c.level
 }
@@ -79,6 +128,10 @@ func (c *Character) TotalXp() int {
 	return c.totalXp
 }
 
+func (c *Character) UnspentAttributePoints() int {
+	return c.unspentAttributePoints
+}
+
 func (c *Character) UserID() string {
 	return c.userID
 }
@@ -107,6 +160,7 @@ func (c *Character) UpdateName(name string) error {
 }
 
 // AddXp adds experience points to the character and handles level-ups
+// Each level gained grants AttributePointsPerLevel unspent attribute points
 // Returns the number of levels gained (0 if no level up)
 func (c *Character) AddXp(xp int) (int, error) {
 	if xp < 0 {
@@ -117,28 +171,151 @@ func (c *Character) AddXp(xp int) (int, error) {
 		return 0, nil
 	}
 
-	c.currentXp += xp
+	// Check for level-ups (XP keeps accumulating once the level cap is reached)
+	level, currentXp, levelsGained := valueobject.GainLevelXp(CurrentLevelCurve(), c.level, c.currentXp, xp)
+	c.level = level
+	c.currentXp = currentXp
 	c.totalXp += xp
 
-	// Check for level-ups
-	levelsGained := 0
-	for c.currentXp >= c.XpForNextLevel() {
-		c.currentXp -= c.XpForNextLevel()
-		c.level++
-		levelsGained++
+	// Every level reached grants attribute points to distribute
+	c.unspentAttributePoints += levelsGained * AttributePointsPerLevel
+
+	return levelsGained, nil
+}
+
+// LoseXp removes experience points from the character and handles de-leveling
+// When current XP drops below zero the character goes back one level at a time, carrying the
+// remainder into that level's XpForNextLevel (the exact inverse of AddXp). Progress never drops
+// below level 1 with 0 XP.
+// Returns the XP actually removed and the number of levels lost
+func (c *Character) LoseXp(xp int) (int, int, error) {
+	if xp < 0 {
+		return 0, 0, fmt.Errorf("xp cannot be negative")
+	}
+
+	if xp == 0 {
+		return 0, 0, nil
+	}
+
+	// Check for de-levels (level 1 with 0 XP is the floor)
+	level, currentXp, levelsLost, xpLost := valueobject.LoseLevelXp(CurrentLevelCurve(), c.level, c.currentXp, xp)
+	c.level = level
+	c.currentXp = currentXp
+
+	// Levels lost take their attribute points back (spent points are owed by the next level-ups)
+	c.unspentAttributePoints -= levelsLost * AttributePointsPerLevel
+
+	c.totalXp -= xpLost
+	if c.totalXp < 0 {
+		c.totalXp = 0
+	}
+
+	return xpLost, levelsLost, nil
+}
+
+// AddXpFrom adds experience points earned from a source and records the change in the XP ledger
+// Returns the number of levels gained (0 if no level up)
+func (c *Character) AddXpFrom(xp int, source valueobject.XpSource) (int, error) {
+	levelsGained, err := c.AddXp(xp)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := c.recordXpChange(xp, source); err != nil {
+		return 0, err
 	}
 
 	return levelsGained, nil
 }
 
-// XpForNextLevel calculates the XP required to reach the next level
-// Uses a progressive formula: 100 * level^1.5
+// LoseXpFrom removes experience points because of a source and records the change in the XP ledger
+// Returns the XP actually removed and the number of levels lost
+func (c *Character) LoseXpFrom(xp int, source valueobject.XpSource) (int, int, error) {
+	xpLost, levelsLost, err := c.LoseXp(xp)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if err := c.recordXpChange(-xpLost, source); err != nil {
+		return 0, 0, err
+	}
+
+	return xpLost, levelsLost, nil
+}
+
+// recordXpChange appends an XP change to the pending ledger entries (changes of 0 XP are not recorded)
+func (c *Character) recordXpChange(amount int, source valueobject.XpSource) error {
+	if amount == 0 {
+		return nil
+	}
+
+	transaction, err := NewXpTransaction(c.id, amount, source, c.level, c.totalXp)
+	if err != nil {
+		return fmt.Errorf("failed to record xp change: %w", err)
+	}
+
+	c.xpLedger = append(c.xpLedger, transaction)
+	return nil
+}
+
+// PendingXpTransactions returns the XP ledger entries recorded since the character was loaded or last saved
+func (c *Character) PendingXpTransactions() []*XpTransaction {
+	return c.xpLedger
+}
+
+// ClearPendingXpTransactions marks the pending XP ledger entries as persisted
+func (c *Character) ClearPendingXpTransactions() {
+	c.xpLedger = nil
+}
+
+// CorrectXp overwrites the character's progress (used to repair drift from the XP ledger)
+// Unspent attribute points follow the level change, as if the levels had been gained or lost
+func (c *Character) CorrectXp(level int, currentXp int, totalXp int) error {
+	if level < 1 {
+		return fmt.Errorf("level must be at least 1")
+	}
+	if currentXp < 0 || totalXp < 0 {
+		return fmt.Errorf("xp cannot be negative")
+	}
+
+	c.unspentAttributePoints += (level - c.level) * AttributePointsPerLevel
+	c.level = level
+	c.currentXp = currentXp
+	c.totalXp = totalXp
+	return nil
+}
+
+// SpendAttributePoints takes points from the unspent attribute points (to be added to attributes)
+func (c *Character) SpendAttributePoints(points int) error {
+	if points <= 0 {
+		return fmt.Errorf("attribute points to spend must be positive")
+	}
+	if points > c.unspentAttributePoints {
+		return fmt.Errorf("not enough unspent attribute points: %d available", c.unspentAttributePoints)
+	}
+
+	c.unspentAttributePoints -= points
+	return nil
+}
+
+// XpForNextLevel calculates the XP required to reach the next level (0 at the level cap)
+// Uses the configured level curve (by default 100 * level^1.5)
 func (c *Character) XpForNextLevel() int {
-	return int(math.Round(100 * math.Pow(float64(c.level), 1.5)))
+	return CurrentLevelCurve().XpForNextLevel(c.level)
+}
+
+// IsMaxLevel reports whether the character reached the level cap
+func (c *Character) IsMaxLevel() bool {
+	curve := CurrentLevelCurve()
+	return curve.MaxLevel() > 0 && c.level >= curve.MaxLevel()
 }
 
 // XpProgress returns the percentage of XP progress towards the next level (0-100)
 func (c *Character) XpProgress() float64 {
+	if c.IsMaxLevel() {
+		return 100
+	}
+
 	xpNeeded := c.XpForNextLevel()
 	if xpNeeded == 0 {
 		return 0
@@ -146,23 +323,47 @@ func (c *Character) XpProgress() float64 {
 	return (float64(c.currentXp) / float64(xpNeeded)) * 100
 }
 
+// AttributeGrowthBonus returns the bonus the character's class adds to an attribute at its current level
+// The bonus follows the level, so de-leveling takes it back without touching the stored attributes
+func (c *Character) AttributeGrowthBonus(attributeName string) int {
+	return c.class.GrowthBonus(attributeName, c.level)
+}
+
+// CombatStats derives the character's battle stats from its attributes (with class bonuses) and level
+func (c *Character) CombatStats(attributes []*CharacterAttribute) valueobject.CombatStats {
+	values := make(map[string]int, len(attributes))
+	for _, attribute := range attributes {
+		values[attribute.AttributeName()] = attribute.Value() + c.AttributeGrowthBonus(attribute.AttributeName())
+	}
+	return valueobject.NewCombatStats(values, c.level)
+}
+
+// Combatant takes a battle snapshot of the character with its current attributes
+func (c *Character) Combatant(attributes []*CharacterAttribute) (valueobject.Combatant, error) {
+	return valueobject.NewCombatant(valueobject.CombatantCharacter, c.id, c.name, c.level, c.CombatStats(attributes))
+}
+
 // ReconstituteCharacter creates a Character from existing data (for repository loading)
 func ReconstituteCharacter(
 	id string,
 	name string,
+	class valueobject.CharacterClass,
 	level int,
 	currentXp int,
 	totalXp int,
+	unspentAttributePoints int,
 	userID string,
 	createdAt time.Time,
 ) *Character {
 	return &Character{
-		id:        id,
-		name:      name,
-		level:     level,
-		currentXp: currentXp,
-		totalXp:   totalXp,
-		userID:    userID,
-		createdAt: createdAt,
+		id:                     id,
+		name:                   name,
+		class:                  class,
+		level:                  level,
+		currentXp:              currentXp,
+		totalXp:                totalXp,
+		unspentAttributePoints: unspentAttributePoints,
+		userID:                 userID,
+		createdAt:              createdAt,
 	}
 }
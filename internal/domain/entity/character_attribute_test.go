@@ -9,7 +9,6 @@ import (
 
 func TestNewCharacterAttribute_ValidAttribute(t *testing.T) {
 	attribute, err := entity.NewCharacterAttribute(
-		"attr-123",
 		"Strength",
 		10,
 		"char-456",
@@ -19,8 +18,8 @@ func TestNewCharacterAttribute_ValidAttribute(t *testing.T) {
 		t.Fatalf("NewCharacterAttribute() error = %v, want nil", err)
 	}
 
-	if attribute.ID() != "attr-123" {
-		t.Errorf("ID() = %v, want %v", attribute.ID(), "attr-123")
+	if attribute.ID() != 0 {
+		t.Errorf("ID() = %v, want %v (assigned by the database)", attribute.ID(), 0)
 	}
 
 	if attribute.AttributeName() != "Strength" {
@@ -42,7 +41,6 @@ func TestNewCharacterAttribute_ValidAttribute(t *testing.T) {
 
 func TestNewCharacterAttribute_ZeroValue(t *testing.T) {
 	attribute, err := entity.NewCharacterAttribute(
-		"attr-123",
 		"Strength",
 		0,
 		"char-456",
@@ -71,7 +69,6 @@ func TestNewCharacterAttribute_InvalidAttributeName(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			_, err := entity.NewCharacterAttribute(
-				"attr-123",
 				tt.attributeName,
 				10,
 				"char-456",
@@ -83,22 +80,8 @@ func TestNewCharacterAttribute_InvalidAttributeName(t *testing.T) {
 	}
 }
 
-func TestNewCharacterAttribute_InvalidID(t *testing.T) {
-	_, err := entity.NewCharacterAttribute(
-		"",
-		"Strength",
-		10,
-		"char-456",
-	)
-
-	if err == nil {
-		t.Error("NewCharacterAttribute() error = nil, want error for empty ID")
-	}
-}
-
 func TestNewCharacterAttribute_InvalidCharacterID(t *testing.T) {
 	_, err := entity.NewCharacterAttribute(
-		"attr-123",
 		"Strength",
 		10,
 		"",
@@ -111,7 +94,6 @@ func TestNewCharacterAttribute_InvalidCharacterID(t *testing.T) {
 
 func TestNewCharacterAttribute_NegativeValue(t *testing.T) {
 	_, err := entity.NewCharacterAttribute(
-		"attr-123",
 		"Strength",
 		-5,
 		"char-456",
@@ -124,7 +106,6 @@ func TestNewCharacterAttribute_NegativeValue(t *testing.T) {
 
 func TestCharacterAttribute_UpdateValue(t *testing.T) {
 	attribute, _ := entity.NewCharacterAttribute(
-		"attr-123",
 		"Strength",
 		10,
 		"char-456",
@@ -142,7 +123,6 @@ func TestCharacterAttribute_UpdateValue(t *testing.T) {
 
 func TestCharacterAttribute_UpdateValue_ToZero(t *testing.T) {
 	attribute, _ := entity.NewCharacterAttribute(
-		"attr-123",
 		"Strength",
 		10,
 		"char-456",
@@ -160,7 +140,6 @@ func TestCharacterAttribute_UpdateValue_ToZero(t *testing.T) {
 
 func TestCharacterAttribute_UpdateValue_Negative(t *testing.T) {
 	attribute, _ := entity.NewCharacterAttribute(
-		"attr-123",
 		"Strength",
 		10,
 		"char-456",
@@ -179,7 +158,6 @@ func TestCharacterAttribute_UpdateValue_Negative(t *testing.T) {
 
 func TestCharacterAttribute_IncrementValue(t *testing.T) {
 	attribute, _ := entity.NewCharacterAttribute(
-		"attr-123",
 		"Strength",
 		10,
 		"char-456",
@@ -197,7 +175,6 @@ func TestCharacterAttribute_IncrementValue(t *testing.T) {
 
 func TestCharacterAttribute_IncrementValue_ByZero(t *testing.T) {
 	attribute, _ := entity.NewCharacterAttribute(
-		"attr-123",
 		"Strength",
 		10,
 		"char-456",
@@ -215,7 +192,6 @@ func TestCharacterAttribute_IncrementValue_ByZero(t *testing.T) {
 
 func TestCharacterAttribute_IncrementValue_Negative(t *testing.T) {
 	attribute, _ := entity.NewCharacterAttribute(
-		"attr-123",
 		"Strength",
 		10,
 		"char-456",
@@ -234,7 +210,6 @@ func TestCharacterAttribute_IncrementValue_Negative(t *testing.T) {
 
 func TestCharacterAttribute_DecrementValue(t *testing.T) {
 	attribute, _ := entity.NewCharacterAttribute(
-		"attr-123",
 		"Strength",
 		10,
 		"char-456",
@@ -252,7 +227,6 @@ func TestCharacterAttribute_DecrementValue(t *testing.T) {
 
 func TestCharacterAttribute_DecrementValue_ToZero(t *testing.T) {
 	attribute, _ := entity.NewCharacterAttribute(
-		"attr-123",
 		"Strength",
 		10,
 		"char-456",
@@ -270,7 +244,6 @@ func TestCharacterAttribute_DecrementValue_ToZero(t *testing.T) {
 
 func TestCharacterAttribute_DecrementValue_BelowZero(t *testing.T) {
 	attribute, _ := entity.NewCharacterAttribute(
-		"attr-123",
 		"Strength",
 		10,
 		"char-456",
@@ -289,7 +262,6 @@ func TestCharacterAttribute_DecrementValue_BelowZero(t *testing.T) {
 
 func TestCharacterAttribute_DecrementValue_ByZero(t *testing.T) {
 	attribute, _ := entity.NewCharacterAttribute(
-		"attr-123",
 		"Strength",
 		10,
 		"char-456",
@@ -307,7 +279,6 @@ func TestCharacterAttribute_DecrementValue_ByZero(t *testing.T) {
 
 func TestCharacterAttribute_DecrementValue_Negative(t *testing.T) {
 	attribute, _ := entity.NewCharacterAttribute(
-		"attr-123",
 		"Strength",
 		10,
 		"char-456",
@@ -328,7 +299,7 @@ func TestReconstituteCharacterAttribute(t *testing.T) {
 	createdAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
 
 	attribute := entity.ReconstituteCharacterAttribute(
-		"attr-123",
+		123,
 		"Strength",
 		50,
 		"char-456",
@@ -339,8 +310,8 @@ func TestReconstituteCharacterAttribute(t *testing.T) {
 		t.Fatal("ReconstituteCharacterAttribute() returned nil")
 	}
 
-	if attribute.ID() != "attr-123" {
-		t.Errorf("ID() = %v, want %v", attribute.ID(), "attr-123")
+	if attribute.ID() != 123 {
+		t.Errorf("ID() = %v, want %v", attribute.ID(), 123)
 	}
 
 	if attribute.AttributeName() != "Strength" {
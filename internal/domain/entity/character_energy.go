@@ -0,0 +1,159 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	// MaxEnergy is the size of a character's energy pool (a new character starts full)
+	MaxEnergy = 100
+
+	// EnergyRegenInterval is how long it takes to regenerate one point of energy
+	EnergyRegenInterval = 6 * time.Minute
+
+	// BattleEnergyCost is the energy each character spends to fight a battle
+	BattleEnergyCost = 10
+
+	// HabitCompletionEnergy is the energy restored by the first completion of a (non-negative) habit each day
+	HabitCompletionEnergy = 5
+)
+
+// CharacterEnergy represents the energy a character spends to fight battles (Domain Entity)
+// Energy is stored as of updatedAt; the regeneration since then is derived from the clock, never stored,
+// so no job has to tick it: one point every EnergyRegenInterval, up to MaxEnergy
+type CharacterEnergy struct {
+	characterID string
+	energy      int       // Energy as of updatedAt
+	updatedAt   time.Time // Start of the regeneration still to be counted
+}
+
+// NewCharacterEnergy creates the full energy pool of a character that never spent energy
+func NewCharacterEnergy(characterID string, at time.Time) (*CharacterEnergy, error) {
+	if characterID == "" {
+		return nil, fmt.Errorf("character id cannot be empty")
+	}
+
+	return &CharacterEnergy{
+		characterID: characterID,
+		energy:      MaxEnergy,
+		updatedAt:   at,
+	}, nil
+}
+
+// Getters (Read-only access to ensure encapsulation)
+
+func (ce *CharacterEnergy) CharacterID() string {
+	return ce.characterID
+}
+
+// Energy returns the energy as of UpdatedAt (see CurrentAt for the regeneration since)
+func (ce *CharacterEnergy) Energy() int {
+	return ce.energy
+}
+
+func (ce *CharacterEnergy) UpdatedAt() time.Time {
+	return ce.updatedAt
+}
+
+// Business Methods
+
+// CurrentAt returns the energy at the given time, with the regeneration since it was last updated
+func (ce *CharacterEnergy) CurrentAt(at time.Time) int {
+	return min(ce.energy+ce.regeneratedAt(at), MaxEnergy)
+}
+
+// IsFullAt reports whether the pool is full at the given time
+func (ce *CharacterEnergy) IsFullAt(at time.Time) bool {
+	return ce.CurrentAt(at) >= MaxEnergy
+}
+
+// NextPointAt returns when the next point of energy regenerates (the zero time when the pool is full)
+func (ce *CharacterEnergy) NextPointAt(at time.Time) time.Time {
+	if ce.IsFullAt(at) {
+		return time.Time{}
+	}
+	return ce.updatedAt.Add(time.Duration(ce.regeneratedAt(at)+1) * EnergyRegenInterval)
+}
+
+// FullAt returns when the pool will be full if no energy is spent (the given time when it already is)
+func (ce *CharacterEnergy) FullAt(at time.Time) time.Time {
+	if ce.IsFullAt(at) {
+		return at
+	}
+	return ce.updatedAt.Add(time.Duration(MaxEnergy-ce.energy) * EnergyRegenInterval)
+}
+
+// Spend takes energy from the pool
+// Returns error if the pool doesn't have that much energy at the given time
+func (ce *CharacterEnergy) Spend(amount int, at time.Time) error {
+	if amount < 0 {
+		return fmt.Errorf("energy spent cannot be negative")
+	}
+	if current := ce.CurrentAt(at); current < amount {
+		return fmt.Errorf("not enough energy: %d of %d", current, amount)
+	}
+
+	ce.settle(at)
+	ce.energy -= amount
+	return nil
+}
+
+// Restore gives energy back to the pool, up to MaxEnergy
+// Returns the energy actually restored
+func (ce *CharacterEnergy) Restore(amount int, at time.Time) (int, error) {
+	if amount < 0 {
+		return 0, fmt.Errorf("energy restored cannot be negative")
+	}
+
+	ce.settle(at)
+	restored := min(amount, MaxEnergy-ce.energy)
+	ce.energy += restored
+	return restored, nil
+}
+
+// Drain takes energy from the pool without going below 0
+// Returns the energy actually drained
+func (ce *CharacterEnergy) Drain(amount int, at time.Time) (int, error) {
+	if amount < 0 {
+		return 0, fmt.Errorf("energy drained cannot be negative")
+	}
+
+	ce.settle(at)
+	drained := min(amount, ce.energy)
+	ce.energy -= drained
+	return drained, nil
+}
+
+// regeneratedAt counts the points regenerated since updatedAt (uncapped)
+func (ce *CharacterEnergy) regeneratedAt(at time.Time) int {
+	if !at.After(ce.updatedAt) {
+		return 0
+	}
+	return int(at.Sub(ce.updatedAt) / EnergyRegenInterval)
+}
+
+// settle stores the energy regenerated up to the given time
+// The progress towards the next point is kept, unless the pool is full (a full pool doesn't regenerate)
+func (ce *CharacterEnergy) settle(at time.Time) {
+	regenerated := ce.regeneratedAt(at)
+	if ce.energy+regenerated >= MaxEnergy {
+		ce.energy = MaxEnergy
+		if at.After(ce.updatedAt) {
+			ce.updatedAt = at
+		}
+		return
+	}
+
+	ce.energy += regenerated
+	ce.updatedAt = ce.updatedAt.Add(time.Duration(regenerated) * EnergyRegenInterval)
+}
+
+// ReconstituteCharacterEnergy creates a CharacterEnergy from existing data (for repository loading)
+func ReconstituteCharacterEnergy(characterID string, energy int, updatedAt time.Time) *CharacterEnergy {
+	return &CharacterEnergy{
+		characterID: characterID,
+		energy:      energy,
+		updatedAt:   updatedAt,
+	}
+}
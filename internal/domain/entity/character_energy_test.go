@@ -0,0 +1,138 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+var energyClock = time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+func TestNewCharacterEnergy_StartsFull(t *testing.T) {
+	energy, err := entity.NewCharacterEnergy("char-123", energyClock)
+	if err != nil {
+		t.Fatalf("NewCharacterEnergy() error = %v, want nil", err)
+	}
+
+	if energy.CurrentAt(energyClock) != entity.MaxEnergy || !energy.IsFullAt(energyClock) {
+		t.Errorf("CurrentAt() = %d, want %d", energy.CurrentAt(energyClock), entity.MaxEnergy)
+	}
+	if !energy.NextPointAt(energyClock).IsZero() || !energy.FullAt(energyClock).Equal(energyClock) {
+		t.Errorf("NextPointAt() = %v, FullAt() = %v, want a full pool", energy.NextPointAt(energyClock), energy.FullAt(energyClock))
+	}
+
+	if _, err := entity.NewCharacterEnergy("", energyClock); err == nil {
+		t.Error("NewCharacterEnergy() without character error = nil, want error")
+	}
+}
+
+func TestCharacterEnergy_RegeneratesLazily(t *testing.T) {
+	energy := entity.ReconstituteCharacterEnergy("char-123", 20, energyClock)
+
+	tests := []struct {
+		name    string
+		elapsed time.Duration
+		want    int
+	}{
+		{"no time passed", 0, 20},
+		{"before the first point", entity.EnergyRegenInterval - time.Second, 20},
+		{"first point", entity.EnergyRegenInterval, 21},
+		{"an hour", time.Hour, 30},
+		{"capped at the max", 24 * time.Hour, entity.MaxEnergy},
+		{"clock before the update", -time.Hour, 20},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := energy.CurrentAt(energyClock.Add(tt.elapsed)); got != tt.want {
+				t.Errorf("CurrentAt() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+
+	at := energyClock.Add(time.Hour + time.Minute)
+	if want := energyClock.Add(time.Hour + entity.EnergyRegenInterval); !energy.NextPointAt(at).Equal(want) {
+		t.Errorf("NextPointAt() = %v, want %v", energy.NextPointAt(at), want)
+	}
+	if want := energyClock.Add(80 * entity.EnergyRegenInterval); !energy.FullAt(at).Equal(want) {
+		t.Errorf("FullAt() = %v, want %v", energy.FullAt(at), want)
+	}
+}
+
+func TestCharacterEnergy_Spend_KeepsProgressTowardsNextPoint(t *testing.T) {
+	energy := entity.ReconstituteCharacterEnergy("char-123", 20, energyClock)
+
+	// 2 points and half of the next one regenerated
+	at := energyClock.Add(2*entity.EnergyRegenInterval + entity.EnergyRegenInterval/2)
+	if err := energy.Spend(entity.BattleEnergyCost, at); err != nil {
+		t.Fatalf("Spend() error = %v, want nil", err)
+	}
+
+	if energy.CurrentAt(at) != 12 {
+		t.Errorf("CurrentAt() = %d, want 12", energy.CurrentAt(at))
+	}
+
+	// The half point isn't lost: the next one arrives half an interval later
+	if got := energy.CurrentAt(at.Add(entity.EnergyRegenInterval / 2)); got != 13 {
+		t.Errorf("CurrentAt() half an interval later = %d, want 13", got)
+	}
+}
+
+func TestCharacterEnergy_Spend_NotEnough(t *testing.T) {
+	energy := entity.ReconstituteCharacterEnergy("char-123", 9, energyClock)
+
+	if err := energy.Spend(entity.BattleEnergyCost, energyClock); err == nil {
+		t.Fatal("Spend() error = nil, want error")
+	}
+	if energy.CurrentAt(energyClock) != 9 || !energy.UpdatedAt().Equal(energyClock) {
+		t.Errorf("energy = %d updated at %v, want it untouched", energy.CurrentAt(energyClock), energy.UpdatedAt())
+	}
+
+	// One regenerated point later the battle is affordable
+	if err := energy.Spend(entity.BattleEnergyCost, energyClock.Add(entity.EnergyRegenInterval)); err != nil {
+		t.Errorf("Spend() after regenerating error = %v, want nil", err)
+	}
+}
+
+func TestCharacterEnergy_Spend_FromFullPoolRegeneratesFromThen(t *testing.T) {
+	energy := entity.ReconstituteCharacterEnergy("char-123", entity.MaxEnergy, energyClock)
+
+	// A full pool doesn't bank regeneration while idle
+	at := energyClock.Add(10 * time.Hour)
+	if err := energy.Spend(entity.BattleEnergyCost, at); err != nil {
+		t.Fatalf("Spend() error = %v, want nil", err)
+	}
+
+	if energy.CurrentAt(at.Add(entity.EnergyRegenInterval)) != entity.MaxEnergy-entity.BattleEnergyCost+1 {
+		t.Errorf("CurrentAt() = %d, want %d", energy.CurrentAt(at.Add(entity.EnergyRegenInterval)), entity.MaxEnergy-entity.BattleEnergyCost+1)
+	}
+}
+
+func TestCharacterEnergy_RestoreAndDrain(t *testing.T) {
+	energy := entity.ReconstituteCharacterEnergy("char-123", 97, energyClock)
+
+	restored, err := energy.Restore(entity.HabitCompletionEnergy, energyClock)
+	if err != nil {
+		t.Fatalf("Restore() error = %v, want nil", err)
+	}
+	if restored != 3 || energy.CurrentAt(energyClock) != entity.MaxEnergy {
+		t.Errorf("Restore() = %d, energy = %d, want 3 and %d", restored, energy.CurrentAt(energyClock), entity.MaxEnergy)
+	}
+
+	energy = entity.ReconstituteCharacterEnergy("char-123", 2, energyClock)
+	drained, err := energy.Drain(entity.HabitCompletionEnergy, energyClock)
+	if err != nil {
+		t.Fatalf("Drain() error = %v, want nil", err)
+	}
+	if drained != 2 || energy.CurrentAt(energyClock) != 0 {
+		t.Errorf("Drain() = %d, energy = %d, want 2 and 0", drained, energy.CurrentAt(energyClock))
+	}
+
+	if _, err := energy.Restore(-1, energyClock); err == nil {
+		t.Error("Restore() negative error = nil, want error")
+	}
+	if err := energy.Spend(-1, energyClock); err == nil {
+		t.Error("Spend() negative error = nil, want error")
+	}
+}
@@ -0,0 +1,171 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// CharacterRating represents the competitive (Glicko-2) rating of a character in ranked PvP battles (Domain Entity)
+// The stored rating is the one after the last ranked battle; the deviation growth of an inactive
+// character is derived from lastPlayedAt and the rating period, never stored
+type CharacterRating struct {
+	characterID    string
+	rating         valueobject.GlickoRating
+	wins           int
+	losses         int
+	draws          int
+	lastPlayedAt   *time.Time      // Nil until the first ranked battle
+	pendingChanges []*RatingChange // History entries recorded since the rating was loaded or last saved
+}
+
+// NewCharacterRating creates the initial rating of a character that never fought a ranked battle
+func NewCharacterRating(characterID string) (*CharacterRating, error) {
+	if characterID == "" {
+		return nil, fmt.Errorf("character id cannot be empty")
+	}
+
+	return &CharacterRating{
+		characterID: characterID,
+		rating:      valueobject.InitialGlickoRating(),
+	}, nil
+}
+
+// Getters (Read-only access to ensure encapsulation)
+
+func (cr *CharacterRating) CharacterID() string {
+	return cr.characterID
+}
+
+// Rating returns the rating as of the last ranked battle (see CurrentRating for the inactivity growth)
+func (cr *CharacterRating) Rating() valueobject.GlickoRating {
+	return cr.rating
+}
+
+func (cr *CharacterRating) Wins() int {
+	return cr.wins
+}
+
+func (cr *CharacterRating) Losses() int {
+	return cr.losses
+}
+
+func (cr *CharacterRating) Draws() int {
+	return cr.draws
+}
+
+func (cr *CharacterRating) LastPlayedAt() *time.Time {
+	return cr.lastPlayedAt
+}
+
+// Business Methods
+
+// Games returns the number of ranked battles fought
+func (cr *CharacterRating) Games() int {
+	return cr.wins + cr.losses + cr.draws
+}
+
+// CurrentRating returns the rating at the given time
+// Every rating period without a ranked battle since the last one grows the deviation
+func (cr *CharacterRating) CurrentRating(now time.Time, period time.Duration) valueobject.GlickoRating {
+	if cr.lastPlayedAt == nil || period <= 0 || !now.After(*cr.lastPlayedAt) {
+		return cr.rating
+	}
+
+	return cr.rating.Decay(float64(now.Sub(*cr.lastPlayedAt)) / float64(period))
+}
+
+// RecordResult updates the rating with the score of a ranked battle against an opponent
+// opponent is the opponent's current rating before the battle; the change is added to the pending history
+func (cr *CharacterRating) RecordResult(
+	battleID string,
+	opponentCharacterID string,
+	opponent valueobject.GlickoRating,
+	score float64,
+	at time.Time,
+	period time.Duration,
+) error {
+	if opponentCharacterID == cr.characterID {
+		return fmt.Errorf("a character cannot be rated against itself")
+	}
+
+	before := cr.CurrentRating(at, period)
+	after := before.Update([]valueobject.GlickoResult{{Opponent: opponent, Score: score}})
+
+	change, err := NewRatingChange(cr.characterID, battleID, opponentCharacterID, score, before, after, at)
+	if err != nil {
+		return err
+	}
+
+	switch score {
+	case valueobject.RatingScoreWin:
+		cr.wins++
+	case valueobject.RatingScoreLoss:
+		cr.losses++
+	default:
+		cr.draws++
+	}
+
+	cr.rating = after
+	cr.lastPlayedAt = &at
+	cr.pendingChanges = append(cr.pendingChanges, change)
+	return nil
+}
+
+// PendingChanges returns the history entries recorded since the rating was loaded or last saved
+func (cr *CharacterRating) PendingChanges() []*RatingChange {
+	return cr.pendingChanges
+}
+
+// ClearPendingChanges marks the pending history entries as persisted
+func (cr *CharacterRating) ClearPendingChanges() {
+	cr.pendingChanges = nil
+}
+
+// RateBattle updates the ratings of both characters of a PvP battle
+// Both updates use the ratings from before the battle, so the order doesn't matter
+func RateBattle(battle *Battle, challenger *CharacterRating, opponent *CharacterRating, period time.Duration) error {
+	if battle.Kind() != BattleKindPvP {
+		return fmt.Errorf("only battles between characters are rated")
+	}
+	if challenger.CharacterID() != battle.ChallengerID() || opponent.CharacterID() != battle.OpponentID() {
+		return fmt.Errorf("ratings don't match the battle's characters")
+	}
+
+	score := valueobject.RatingScoreDraw
+	switch battle.Winner() {
+	case BattleSideChallenger:
+		score = valueobject.RatingScoreWin
+	case BattleSideOpponent:
+		score = valueobject.RatingScoreLoss
+	}
+
+	at := battle.FoughtAt()
+	challengerBefore := challenger.CurrentRating(at, period)
+	opponentBefore := opponent.CurrentRating(at, period)
+
+	if err := challenger.RecordResult(battle.ID(), opponent.CharacterID(), opponentBefore, score, at, period); err != nil {
+		return err
+	}
+	return opponent.RecordResult(battle.ID(), challenger.CharacterID(), challengerBefore, valueobject.RatingScoreWin-score, at, period)
+}
+
+// ReconstituteCharacterRating creates a CharacterRating from existing data (for repository loading)
+func ReconstituteCharacterRating(
+	characterID string,
+	rating valueobject.GlickoRating,
+	wins int,
+	losses int,
+	draws int,
+	lastPlayedAt *time.Time,
+) *CharacterRating {
+	return &CharacterRating{
+		characterID:  characterID,
+		rating:       rating,
+		wins:         wins,
+		losses:       losses,
+		draws:        draws,
+		lastPlayedAt: lastPlayedAt,
+	}
+}
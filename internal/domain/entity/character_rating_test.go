@@ -0,0 +1,171 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+const ratingPeriod = 24 * time.Hour
+
+func newTestCharacterRating(t *testing.T, characterID string) *entity.CharacterRating {
+	t.Helper()
+
+	rating, err := entity.NewCharacterRating(characterID)
+	if err != nil {
+		t.Fatalf("NewCharacterRating() error = %v, want nil", err)
+	}
+	return rating
+}
+
+func TestNewCharacterRating_StartsAtInitialRating(t *testing.T) {
+	rating := newTestCharacterRating(t, "char-123")
+
+	if !rating.Rating().Equals(valueobject.InitialGlickoRating()) {
+		t.Errorf("Rating() = %+v, want the initial rating", rating.Rating())
+	}
+	if rating.Games() != 0 || rating.LastPlayedAt() != nil {
+		t.Errorf("Games() = %d, LastPlayedAt() = %v, want no ranked battle", rating.Games(), rating.LastPlayedAt())
+	}
+
+	if _, err := entity.NewCharacterRating(""); err == nil {
+		t.Error("NewCharacterRating(\"\") error = nil, want error")
+	}
+}
+
+func TestCharacterRating_RecordResult(t *testing.T) {
+	rating := newTestCharacterRating(t, "char-123")
+	opponent := valueobject.InitialGlickoRating()
+
+	if err := rating.RecordResult("battle-1", "char-456", opponent, valueobject.RatingScoreWin, battleFoughtAt, ratingPeriod); err != nil {
+		t.Fatalf("RecordResult() error = %v, want nil", err)
+	}
+
+	if rating.Rating().Rating() <= valueobject.InitialRating {
+		t.Errorf("Rating() = %v after a win, want more than %v", rating.Rating().Rating(), valueobject.InitialRating)
+	}
+	if rating.Wins() != 1 || rating.Games() != 1 {
+		t.Errorf("Wins() = %d, Games() = %d, want 1 and 1", rating.Wins(), rating.Games())
+	}
+	if rating.LastPlayedAt() == nil || !rating.LastPlayedAt().Equal(battleFoughtAt) {
+		t.Errorf("LastPlayedAt() = %v, want %v", rating.LastPlayedAt(), battleFoughtAt)
+	}
+
+	changes := rating.PendingChanges()
+	if len(changes) != 1 {
+		t.Fatalf("len(PendingChanges()) = %d, want 1", len(changes))
+	}
+	change := changes[0]
+	if change.BattleID() != "battle-1" || change.OpponentCharacterID() != "char-456" || change.Score() != valueobject.RatingScoreWin {
+		t.Errorf("change = (%s, %s, %v), want (battle-1, char-456, 1)", change.BattleID(), change.OpponentCharacterID(), change.Score())
+	}
+	if !change.Before().Equals(valueobject.InitialGlickoRating()) || !change.After().Equals(rating.Rating()) {
+		t.Errorf("change = %+v -> %+v, want initial -> %+v", change.Before(), change.After(), rating.Rating())
+	}
+	if change.Delta() <= 0 {
+		t.Errorf("Delta() = %v, want positive", change.Delta())
+	}
+
+	rating.ClearPendingChanges()
+	if len(rating.PendingChanges()) != 0 {
+		t.Errorf("len(PendingChanges()) = %d after clearing, want 0", len(rating.PendingChanges()))
+	}
+}
+
+func TestCharacterRating_RecordResult_Validation(t *testing.T) {
+	rating := newTestCharacterRating(t, "char-123")
+	opponent := valueobject.InitialGlickoRating()
+
+	if err := rating.RecordResult("battle-1", "char-123", opponent, valueobject.RatingScoreWin, battleFoughtAt, ratingPeriod); err == nil {
+		t.Error("RecordResult() against itself error = nil, want error")
+	}
+	if err := rating.RecordResult("battle-1", "char-456", opponent, 0.75, battleFoughtAt, ratingPeriod); err == nil {
+		t.Error("RecordResult() with an invalid score error = nil, want error")
+	}
+	if err := rating.RecordResult("", "char-456", opponent, valueobject.RatingScoreWin, battleFoughtAt, ratingPeriod); err == nil {
+		t.Error("RecordResult() without battle error = nil, want error")
+	}
+
+	if rating.Games() != 0 || len(rating.PendingChanges()) != 0 {
+		t.Errorf("Games() = %d, len(PendingChanges()) = %d after rejected results, want 0 and 0", rating.Games(), len(rating.PendingChanges()))
+	}
+}
+
+func TestCharacterRating_CurrentRating_GrowsDeviationWhenInactive(t *testing.T) {
+	stored, err := valueobject.NewGlickoRating(1700, 60, 0.06)
+	if err != nil {
+		t.Fatalf("NewGlickoRating() error = %v, want nil", err)
+	}
+	lastPlayedAt := battleFoughtAt
+	rating := entity.ReconstituteCharacterRating("char-123", stored, 10, 5, 1, &lastPlayedAt)
+
+	if got := rating.CurrentRating(lastPlayedAt, ratingPeriod); !got.Equals(stored) {
+		t.Errorf("CurrentRating() right after the battle = %+v, want %+v", got, stored)
+	}
+
+	month := rating.CurrentRating(lastPlayedAt.Add(30*ratingPeriod), ratingPeriod)
+	if want := stored.Decay(30); !month.Equals(want) {
+		t.Errorf("CurrentRating() after 30 periods = %+v, want %+v", month, want)
+	}
+	if month.Rating() != stored.Rating() {
+		t.Errorf("CurrentRating().Rating() = %v, want unchanged %v", month.Rating(), stored.Rating())
+	}
+
+	neverPlayed := newTestCharacterRating(t, "char-456")
+	if got := neverPlayed.CurrentRating(battleFoughtAt, ratingPeriod); !got.Equals(valueobject.InitialGlickoRating()) {
+		t.Errorf("CurrentRating() without battles = %+v, want the initial rating", got)
+	}
+}
+
+func TestRateBattle(t *testing.T) {
+	challenger := newTestCombatant(t, "hero", 12, 4)
+	opponent := newTestCombatant(t, "rival", 3, 2)
+	battle := newTestBattle(t, challenger, opponent, 42)
+
+	challengerRating := newTestCharacterRating(t, "hero")
+	opponentRating := newTestCharacterRating(t, "rival")
+
+	if err := entity.RateBattle(battle, challengerRating, opponentRating, ratingPeriod); err != nil {
+		t.Fatalf("RateBattle() error = %v, want nil", err)
+	}
+
+	winner, loser := challengerRating, opponentRating
+	if battle.Winner() == entity.BattleSideOpponent {
+		winner, loser = opponentRating, challengerRating
+	}
+
+	if winner.Wins() != 1 || loser.Losses() != 1 {
+		t.Errorf("winner has %d wins and loser %d losses, want 1 and 1", winner.Wins(), loser.Losses())
+	}
+
+	// Equal ratings before the battle: what one gains the other loses
+	gained := winner.Rating().Rating() - valueobject.InitialRating
+	lost := valueobject.InitialRating - loser.Rating().Rating()
+	if gained <= 0 || gained-lost > 0.000001 || lost-gained > 0.000001 {
+		t.Errorf("winner gained %v and loser lost %v, want the same positive amount", gained, lost)
+	}
+
+	if len(challengerRating.PendingChanges()) != 1 || len(opponentRating.PendingChanges()) != 1 {
+		t.Errorf("pending changes = %d and %d, want 1 and 1", len(challengerRating.PendingChanges()), len(opponentRating.PendingChanges()))
+	}
+}
+
+func TestRateBattle_Validation(t *testing.T) {
+	hero := newTestCombatant(t, "hero", 5, 3)
+	monster, err := valueobject.NewCombatant(valueobject.CombatantMonster, "goblin", "Goblin", 3, valueobject.NewCombatStats(map[string]int{}, 3))
+	if err != nil {
+		t.Fatalf("NewCombatant() error = %v, want nil", err)
+	}
+
+	pve := newTestBattle(t, hero, monster, 1)
+	if err := entity.RateBattle(pve, newTestCharacterRating(t, "hero"), newTestCharacterRating(t, "goblin"), ratingPeriod); err == nil {
+		t.Error("RateBattle() of a pve battle error = nil, want error")
+	}
+
+	pvp := newTestBattle(t, hero, newTestCombatant(t, "rival", 5, 3), 1)
+	if err := entity.RateBattle(pvp, newTestCharacterRating(t, "rival"), newTestCharacterRating(t, "hero"), ratingPeriod); err == nil {
+		t.Error("RateBattle() with swapped ratings error = nil, want error")
+	}
+}
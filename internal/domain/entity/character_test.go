@@ -5,12 +5,14 @@ import (
 	"time"
 
 	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
 )
 
 func TestNewCharacter_ValidCharacter(t *testing.T) {
 	character, err := entity.NewCharacter(
 		"char-123",
 		"Warrior King",
+		valueobject.DefaultCharacterClass(),
 		"user-456",
 	)
 
@@ -64,6 +66,7 @@ func TestNewCharacter_InvalidName(t *testing.T) {
 			_, err := entity.NewCharacter(
 				"char-123",
 				tt.characterName,
+				valueobject.DefaultCharacterClass(),
 				"user-456",
 			)
 			if err == nil {
@@ -77,6 +80,7 @@ func TestNewCharacter_InvalidID(t *testing.T) {
 	_, err := entity.NewCharacter(
 		"",
 		"Warrior King",
+		valueobject.DefaultCharacterClass(),
 		"user-456",
 	)
 
@@ -89,6 +93,7 @@ func TestNewCharacter_InvalidUserID(t *testing.T) {
 	_, err := entity.NewCharacter(
 		"char-123",
 		"Warrior King",
+		valueobject.DefaultCharacterClass(),
 		"",
 	)
 
@@ -101,6 +106,7 @@ func TestCharacter_UpdateName(t *testing.T) {
 	character, _ := entity.NewCharacter(
 		"char-123",
 		"Warrior King",
+		valueobject.DefaultCharacterClass(),
 		"user-456",
 	)
 
@@ -118,6 +124,7 @@ func TestCharacter_UpdateName_Invalid(t *testing.T) {
 	character, _ := entity.NewCharacter(
 		"char-123",
 		"Warrior King",
+		valueobject.DefaultCharacterClass(),
 		"user-456",
 	)
 
@@ -144,6 +151,7 @@ func TestCharacter_AddXp_NoLevelUp(t *testing.T) {
 	character, _ := entity.NewCharacter(
 		"char-123",
 		"Warrior King",
+		valueobject.DefaultCharacterClass(),
 		"user-456",
 	)
 
@@ -175,6 +183,7 @@ func TestCharacter_AddXp_SingleLevelUp(t *testing.T) {
 	character, _ := entity.NewCharacter(
 		"char-123",
 		"Warrior King",
+		valueobject.DefaultCharacterClass(),
 		"user-456",
 	)
 
@@ -208,6 +217,7 @@ func TestCharacter_AddXp_MultipleLevelUps(t *testing.T) {
 	character, _ := entity.NewCharacter(
 		"char-123",
 		"Warrior King",
+		valueobject.DefaultCharacterClass(),
 		"user-456",
 	)
 
@@ -238,6 +248,7 @@ func TestCharacter_AddXp_NegativeValue(t *testing.T) {
 	character, _ := entity.NewCharacter(
 		"char-123",
 		"Warrior King",
+		valueobject.DefaultCharacterClass(),
 		"user-456",
 	)
 
@@ -252,6 +263,7 @@ func TestCharacter_AddXp_Zero(t *testing.T) {
 	character, _ := entity.NewCharacter(
 		"char-123",
 		"Warrior King",
+		valueobject.DefaultCharacterClass(),
 		"user-456",
 	)
 
@@ -266,6 +278,87 @@ func TestCharacter_AddXp_Zero(t *testing.T) {
 	}
 }
 
+func TestCharacter_LoseXp_NoLevelDown(t *testing.T) {
+	character := entity.ReconstituteCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), 2, 100, 200, 0, "user-456", time.Now())
+
+	xpLost, levelsLost, err := character.LoseXp(40)
+
+	if err != nil {
+		t.Fatalf("LoseXp() error = %v, want nil", err)
+	}
+
+	if xpLost != 40 || levelsLost != 0 {
+		t.Errorf("LoseXp() = (%v, %v), want (40, 0)", xpLost, levelsLost)
+	}
+
+	if character.Level() != 2 || character.CurrentXp() != 60 || character.TotalXp() != 160 {
+		t.Errorf("character = (level %v, current %v, total %v), want (2, 60, 160)", character.Level(), character.CurrentXp(), character.TotalXp())
+	}
+}
+
+func TestCharacter_LoseXp_LevelDown(t *testing.T) {
+	// Level 3 with 10 XP: levels 1 and 2 cost 100 + 283 XP
+	character := entity.ReconstituteCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), 3, 10, 393, 0, "user-456", time.Now())
+
+	xpLost, levelsLost, err := character.LoseXp(50)
+
+	if err != nil {
+		t.Fatalf("LoseXp() error = %v, want nil", err)
+	}
+
+	if xpLost != 50 || levelsLost != 1 {
+		t.Errorf("LoseXp() = (%v, %v), want (50, 1)", xpLost, levelsLost)
+	}
+
+	// Back to level 2 with 283 - 40 XP
+	if character.Level() != 2 || character.CurrentXp() != 243 || character.TotalXp() != 343 {
+		t.Errorf("character = (level %v, current %v, total %v), want (2, 243, 343)", character.Level(), character.CurrentXp(), character.TotalXp())
+	}
+}
+
+func TestCharacter_LoseXp_IsInverseOfAddXp(t *testing.T) {
+	character, _ := entity.NewCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), "user-456")
+	character.AddXp(120)
+
+	levelsGained, _ := character.AddXp(900)
+	_, levelsLost, _ := character.LoseXp(900)
+
+	if levelsLost != levelsGained {
+		t.Errorf("levelsLost = %v, want %v", levelsLost, levelsGained)
+	}
+
+	if character.Level() != 2 || character.CurrentXp() != 20 || character.TotalXp() != 120 {
+		t.Errorf("character = (level %v, current %v, total %v), want (2, 20, 120)", character.Level(), character.CurrentXp(), character.TotalXp())
+	}
+}
+
+func TestCharacter_LoseXp_FloorsAtLevelOne(t *testing.T) {
+	character := entity.ReconstituteCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), 2, 30, 130, 0, "user-456", time.Now())
+
+	xpLost, levelsLost, err := character.LoseXp(1000)
+
+	if err != nil {
+		t.Fatalf("LoseXp() error = %v, want nil", err)
+	}
+
+	// Only the 130 XP the character had can be lost
+	if xpLost != 130 || levelsLost != 1 {
+		t.Errorf("LoseXp() = (%v, %v), want (130, 1)", xpLost, levelsLost)
+	}
+
+	if character.Level() != 1 || character.CurrentXp() != 0 || character.TotalXp() != 0 {
+		t.Errorf("character = (level %v, current %v, total %v), want (1, 0, 0)", character.Level(), character.CurrentXp(), character.TotalXp())
+	}
+}
+
+func TestCharacter_LoseXp_NegativeValue(t *testing.T) {
+	character, _ := entity.NewCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), "user-456")
+
+	if _, _, err := character.LoseXp(-10); err == nil {
+		t.Error("LoseXp() error = nil, want error for negative XP")
+	}
+}
+
 func TestCharacter_XpForNextLevel(t *testing.T) {
 	tests := []struct {
 		level          int
@@ -280,15 +373,17 @@ func TestCharacter_XpForNextLevel(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run("level_"+string(rune(tt.level+'0')), func(t *testing.T) {
-			character, _ := entity.NewCharacter("char-123", "Warrior", "user-456")
+			character, _ := entity.NewCharacter("char-123", "Warrior", valueobject.DefaultCharacterClass(), "user-456")
 
 			// Manually set level for testing (using Reconstitute)
 			character = entity.ReconstituteCharacter(
 				"char-123",
 				"Warrior",
+				valueobject.DefaultCharacterClass(),
 				tt.level,
 				0,
 				0,
+				0,
 				"user-456",
 				time.Now(),
 			)
@@ -307,6 +402,7 @@ func TestCharacter_XpProgress(t *testing.T) {
 	character, _ := entity.NewCharacter(
 		"char-123",
 		"Warrior King",
+		valueobject.DefaultCharacterClass(),
 		"user-456",
 	)
 
@@ -326,9 +422,11 @@ func TestReconstituteCharacter(t *testing.T) {
 	character := entity.ReconstituteCharacter(
 		"char-123",
 		"Warrior King",
+		valueobject.DefaultCharacterClass(),
 		10,
 		250,
 		5000,
+		4,
 		"user-456",
 		createdAt,
 	)
@@ -357,6 +455,10 @@ func TestReconstituteCharacter(t *testing.T) {
 		t.Errorf("TotalXp() = %v, want %v", character.TotalXp(), 5000)
 	}
 
+	if character.UnspentAttributePoints() != 4 {
+		t.Errorf("UnspentAttributePoints() = %v, want %v", character.UnspentAttributePoints(), 4)
+	}
+
 	if character.UserID() != "user-456" {
 		t.Errorf("UserID() = %v, want %v", character.UserID(), "user-456")
 	}
@@ -365,3 +467,106 @@ func TestReconstituteCharacter(t *testing.T) {
 		t.Errorf("CreatedAt() = %v, want %v", character.CreatedAt(), createdAt)
 	}
 }
+
+func TestCharacter_AddXp_GrantsAttributePoints(t *testing.T) {
+	character, _ := entity.NewCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), "user-456")
+
+	// 100 XP reaches level 2, 283 more reaches level 3
+	levelsGained, _ := character.AddXp(383)
+
+	if levelsGained != 2 {
+		t.Fatalf("levelsGained = %v, want 2", levelsGained)
+	}
+	if character.UnspentAttributePoints() != 2*entity.AttributePointsPerLevel {
+		t.Errorf("UnspentAttributePoints() = %v, want %v", character.UnspentAttributePoints(), 2*entity.AttributePointsPerLevel)
+	}
+}
+
+func TestCharacter_LoseXp_TakesBackAttributePoints(t *testing.T) {
+	character, _ := entity.NewCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), "user-456")
+	character.AddXp(383)
+
+	// Points already spent are owed: the balance goes negative until the next level-ups repay it
+	if err := character.SpendAttributePoints(2 * entity.AttributePointsPerLevel); err != nil {
+		t.Fatalf("SpendAttributePoints() error = %v, want nil", err)
+	}
+	character.LoseXp(383)
+
+	if character.UnspentAttributePoints() != -2*entity.AttributePointsPerLevel {
+		t.Errorf("UnspentAttributePoints() = %v, want %v", character.UnspentAttributePoints(), -2*entity.AttributePointsPerLevel)
+	}
+
+	character.AddXp(383)
+	if character.UnspentAttributePoints() != 0 {
+		t.Errorf("UnspentAttributePoints() = %v, want 0 after leveling up again", character.UnspentAttributePoints())
+	}
+}
+
+func TestCharacter_SpendAttributePoints(t *testing.T) {
+	character := entity.ReconstituteCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), 2, 0, 100, 3, "user-456", time.Now())
+
+	if err := character.SpendAttributePoints(0); err == nil {
+		t.Error("SpendAttributePoints(0) error = nil, want error")
+	}
+	if err := character.SpendAttributePoints(4); err == nil {
+		t.Error("SpendAttributePoints(4) error = nil, want error for 3 unspent points")
+	}
+	if character.UnspentAttributePoints() != 3 {
+		t.Fatalf("UnspentAttributePoints() = %v, want 3 after rejected spends", character.UnspentAttributePoints())
+	}
+
+	if err := character.SpendAttributePoints(3); err != nil {
+		t.Fatalf("SpendAttributePoints(3) error = %v, want nil", err)
+	}
+	if character.UnspentAttributePoints() != 0 {
+		t.Errorf("UnspentAttributePoints() = %v, want 0", character.UnspentAttributePoints())
+	}
+}
+
+func TestNewCharacter_InvalidClass(t *testing.T) {
+	_, err := entity.NewCharacter("char-123", "Warrior King", valueobject.CharacterClass{}, "user-456")
+	if err == nil {
+		t.Error("NewCharacter() error = nil, want error")
+	}
+}
+
+func TestCharacter_AttributeGrowthBonus_FollowsLevel(t *testing.T) {
+	warrior, _ := valueobject.NewCharacterClass(valueobject.ClassWarrior)
+	character, _ := entity.NewCharacter("char-123", "Conan", warrior, "user-456")
+
+	if got := character.AttributeGrowthBonus(valueobject.AttributeStrength); got != 0 {
+		t.Errorf("AttributeGrowthBonus() at level 1 = %v, want 0", got)
+	}
+
+	// 100 + 283 XP reach level 3
+	character.AddXp(383)
+	if got := character.AttributeGrowthBonus(valueobject.AttributeStrength); got != 2 {
+		t.Errorf("AttributeGrowthBonus() at level 3 = %v, want 2", got)
+	}
+
+	// De-leveling takes the bonus back
+	character.LoseXp(283)
+	if got := character.AttributeGrowthBonus(valueobject.AttributeStrength); got != 1 {
+		t.Errorf("AttributeGrowthBonus() at level 2 = %v, want 1", got)
+	}
+}
+
+func TestCharacter_CombatStats_IncludesClassBonus(t *testing.T) {
+	warrior, _ := valueobject.NewCharacterClass(valueobject.ClassWarrior)
+	character := entity.ReconstituteCharacter("char-123", "Conan", warrior, 3, 0, 0, 0, "user-456", time.Now())
+
+	attributes := []*entity.CharacterAttribute{
+		entity.ReconstituteCharacterAttribute(1, valueobject.AttributeStrength, 8, "char-123", time.Now()),
+		entity.ReconstituteCharacterAttribute(2, valueobject.AttributeConstitution, 7, "char-123", time.Now()),
+	}
+
+	// At level 3 the warrior grows Força and Constituição by 2
+	want := valueobject.NewCombatStats(map[string]int{
+		valueobject.AttributeStrength:     10,
+		valueobject.AttributeConstitution: 9,
+	}, 3)
+
+	if got := character.CombatStats(attributes); got != want {
+		t.Errorf("CombatStats() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,113 @@
+package entity
+
+import (
+	"math/rand/v2"
+	"slices"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// DungeonStage is one encounter of a dungeon
+type DungeonStage struct {
+	MonsterID  string
+	Checkpoint bool // Clearing the stage rests the character to full HP and saves the run once
+}
+
+// Dungeon represents a sequence of PvE encounters fought one after another (Domain Entity)
+// Dungeons are catalog data: they are only loaded, never created by users
+type Dungeon struct {
+	id         string
+	name       string
+	minLevel   int // Lowest character level allowed in
+	entryCost  int // XP paid to start a run
+	rewardXp   int // XP earned for clearing the last stage
+	rewardLoot []valueobject.LootDrop
+	stages     []DungeonStage
+}
+
+// ReconstituteDungeon creates a Dungeon from existing data (for repository loading)
+func ReconstituteDungeon(
+	id string,
+	name string,
+	minLevel int,
+	entryCost int,
+	rewardXp int,
+	rewardLoot []valueobject.LootDrop,
+	stages []DungeonStage,
+) *Dungeon {
+	return &Dungeon{
+		id:         id,
+		name:       name,
+		minLevel:   minLevel,
+		entryCost:  entryCost,
+		rewardXp:   rewardXp,
+		rewardLoot: slices.Clone(rewardLoot),
+		stages:     slices.Clone(stages),
+	}
+}
+
+// Getters (Read-only access to ensure encapsulation)
+
+func (d *Dungeon) ID() string {
+	return d.id
+}
+
+func (d *Dungeon) Name() string {
+	return d.name
+}
+
+func (d *Dungeon) MinLevel() int {
+	return d.minLevel
+}
+
+func (d *Dungeon) EntryCost() int {
+	return d.entryCost
+}
+
+func (d *Dungeon) RewardXp() int {
+	return d.rewardXp
+}
+
+// RewardLoot returns a copy of the loot table rolled when the dungeon is cleared
+func (d *Dungeon) RewardLoot() []valueobject.LootDrop {
+	return slices.Clone(d.rewardLoot)
+}
+
+// Stages returns a copy of the dungeon's stages, in the order they are fought
+func (d *Dungeon) Stages() []DungeonStage {
+	return slices.Clone(d.stages)
+}
+
+// Business Methods
+
+// StageCount returns how many stages must be cleared to finish the dungeon
+func (d *Dungeon) StageCount() int {
+	return len(d.stages)
+}
+
+// Stage returns the stage at a 0-based position
+func (d *Dungeon) Stage(position int) (DungeonStage, bool) {
+	if position < 0 || position >= len(d.stages) {
+		return DungeonStage{}, false
+	}
+	return d.stages[position], true
+}
+
+// AllowsLevel reports whether a character of the given level may enter the dungeon
+func (d *Dungeon) AllowsLevel(level int) bool {
+	return level >= d.minLevel
+}
+
+// RollRewardLoot rolls the reward loot table once per item
+// Rolls are derived from the seed of the battle that cleared the dungeon, like monster loot
+func (d *Dungeon) RollRewardLoot(seed int64) []valueobject.LootDrop {
+	rng := rand.New(rand.NewPCG(uint64(seed), lootRngStream))
+
+	var dropped []valueobject.LootDrop
+	for _, drop := range d.rewardLoot {
+		if drop.DropsOn(rng.IntN(100) + 1) {
+			dropped = append(dropped, drop)
+		}
+	}
+	return dropped
+}
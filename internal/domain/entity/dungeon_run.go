@@ -0,0 +1,218 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// Dungeon run statuses
+const (
+	DungeonRunInProgress = "in_progress"
+	DungeonRunCompleted  = "completed"
+	DungeonRunFailed     = "failed"
+	DungeonRunAbandoned  = "abandoned"
+)
+
+// DungeonRun represents a character's progress through a dungeon (Domain Entity)
+// The run is persisted between stages, so it can be resumed in a later session.
+// HP carries over from one stage to the next; clearing a checkpoint stage rests the character
+// to full HP and saves the run: the next defeat sends it back to the checkpoint instead of ending it.
+type DungeonRun struct {
+	id          string
+	dungeonID   string
+	characterID string
+	status      string // in_progress, completed, failed or abandoned
+	stage       int    // 0-based position of the next stage to fight (stages cleared)
+	hp          int    // HP the character carries into the next stage
+	checkpoint  int    // Stage to go back to after a defeat (0 when no checkpoint is saved)
+	startedAt   time.Time
+	updatedAt   time.Time
+	endedAt     *time.Time // Set once the run is completed, failed or abandoned
+}
+
+// NewDungeonRun creates a new run at the first stage of a dungeon, with the character at full HP
+func NewDungeonRun(id string, dungeonID string, characterID string, hp int, startedAt time.Time) (*DungeonRun, error) {
+	if id == "" {
+		return nil, fmt.Errorf("dungeon run id cannot be empty")
+	}
+	if dungeonID == "" {
+		return nil, fmt.Errorf("dungeon id cannot be empty")
+	}
+	if characterID == "" {
+		return nil, fmt.Errorf("character id cannot be empty")
+	}
+	if hp <= 0 {
+		return nil, fmt.Errorf("dungeon run hp must be positive")
+	}
+	if startedAt.IsZero() {
+		return nil, fmt.Errorf("start time cannot be empty")
+	}
+
+	return &DungeonRun{
+		id:          id,
+		dungeonID:   dungeonID,
+		characterID: characterID,
+		status:      DungeonRunInProgress,
+		hp:          hp,
+		startedAt:   startedAt,
+		updatedAt:   startedAt,
+	}, nil
+}
+
+// Getters (Read-only access to ensure encapsulation)
+
+func (r *DungeonRun) ID() string {
+	return r.id
+}
+
+func (r *DungeonRun) DungeonID() string {
+	return r.dungeonID
+}
+
+func (r *DungeonRun) CharacterID() string {
+	return r.characterID
+}
+
+func (r *DungeonRun) Status() string {
+	return r.status
+}
+
+// Stage returns the 0-based position of the next stage to fight, which is also the number of stages cleared
+func (r *DungeonRun) Stage() int {
+	return r.stage
+}
+
+func (r *DungeonRun) HP() int {
+	return r.hp
+}
+
+// Checkpoint returns the stage the run goes back to after a defeat (0 when no checkpoint is saved)
+func (r *DungeonRun) Checkpoint() int {
+	return r.checkpoint
+}
+
+func (r *DungeonRun) StartedAt() time.Time {
+	return r.startedAt
+}
+
+func (r *DungeonRun) UpdatedAt() time.Time {
+	return r.updatedAt
+}
+
+func (r *DungeonRun) EndedAt() *time.Time {
+	return r.endedAt
+}
+
+// Business Methods
+
+// IsInProgress reports whether the run can still advance
+func (r *DungeonRun) IsInProgress() bool {
+	return r.status == DungeonRunInProgress
+}
+
+// RecordVictory records the current stage as cleared with hpLeft
+// A checkpoint stage rests the character to maxHP and saves the run; clearing the last stage completes it
+func (r *DungeonRun) RecordVictory(dungeon *Dungeon, hpLeft int, maxHP int, at time.Time) error {
+	stage, err := r.currentStage(dungeon)
+	if err != nil {
+		return err
+	}
+	if hpLeft <= 0 {
+		return fmt.Errorf("a cleared stage must leave the character with hp")
+	}
+
+	r.stage++
+	r.hp = hpLeft
+	if stage.Checkpoint {
+		r.checkpoint = r.stage
+		r.hp = maxHP
+	}
+
+	if r.stage == dungeon.StageCount() {
+		r.end(DungeonRunCompleted, at)
+		return nil
+	}
+
+	r.updatedAt = at
+	return nil
+}
+
+// RecordDefeat records a lost (or drawn) battle at the current stage
+// With a saved checkpoint the run goes back to it at maxHP and the checkpoint is spent; otherwise the run fails
+// Returns whether the run went back to a checkpoint
+func (r *DungeonRun) RecordDefeat(dungeon *Dungeon, maxHP int, at time.Time) (bool, error) {
+	if _, err := r.currentStage(dungeon); err != nil {
+		return false, err
+	}
+
+	if r.checkpoint == 0 {
+		r.end(DungeonRunFailed, at)
+		return false, nil
+	}
+
+	r.stage = r.checkpoint
+	r.checkpoint = 0
+	r.hp = maxHP
+	r.updatedAt = at
+	return true, nil
+}
+
+// Abandon gives up the run (the entry cost is not refunded)
+func (r *DungeonRun) Abandon(at time.Time) error {
+	if !r.IsInProgress() {
+		return fmt.Errorf("dungeon run is already over")
+	}
+
+	r.end(DungeonRunAbandoned, at)
+	return nil
+}
+
+// currentStage returns the stage the run is about to fight, checking the run can advance
+func (r *DungeonRun) currentStage(dungeon *Dungeon) (DungeonStage, error) {
+	if !r.IsInProgress() {
+		return DungeonStage{}, fmt.Errorf("dungeon run is already over")
+	}
+	if dungeon.ID() != r.dungeonID {
+		return DungeonStage{}, fmt.Errorf("dungeon %s is not the dungeon of the run", dungeon.ID())
+	}
+
+	stage, ok := dungeon.Stage(r.stage)
+	if !ok {
+		return DungeonStage{}, fmt.Errorf("dungeon %s has no stage %d", dungeon.ID(), r.stage+1)
+	}
+	return stage, nil
+}
+
+// end closes the run with a final status
+func (r *DungeonRun) end(status string, at time.Time) {
+	r.status = status
+	r.updatedAt = at
+	r.endedAt = &at
+}
+
+// ReconstituteDungeonRun creates a DungeonRun from existing data (for repository loading)
+func ReconstituteDungeonRun(
+	id string,
+	dungeonID string,
+	characterID string,
+	status string,
+	stage int,
+	hp int,
+	checkpoint int,
+	startedAt time.Time,
+	updatedAt time.Time,
+	endedAt *time.Time,
+) *DungeonRun {
+	return &DungeonRun{
+		id:          id,
+		dungeonID:   dungeonID,
+		characterID: characterID,
+		status:      status,
+		stage:       stage,
+		hp:          hp,
+		checkpoint:  checkpoint,
+		startedAt:   startedAt,
+		updatedAt:   updatedAt,
+		endedAt:     endedAt,
+	}
+}
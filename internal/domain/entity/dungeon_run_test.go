@@ -0,0 +1,175 @@
+package entity_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+var runStartedAt = time.Date(2024, 3, 1, 18, 0, 0, 0, time.UTC)
+
+// newTestDungeon creates a three-stage dungeon with a checkpoint after the first stage
+func newTestDungeon(t *testing.T) *entity.Dungeon {
+	t.Helper()
+
+	coins, err := valueobject.NewLootDrop("Moeda de Prata", 100, 3)
+	if err != nil {
+		t.Fatalf("NewLootDrop() error = %v, want nil", err)
+	}
+
+	return entity.ReconstituteDungeon("toca-dos-ratos", "Toca dos Ratos", 1, 10, 80, []valueobject.LootDrop{coins}, []entity.DungeonStage{
+		{MonsterID: "rato-gigante", Checkpoint: true},
+		{MonsterID: "goblin"},
+		{MonsterID: "lobo-cinzento"},
+	})
+}
+
+func newTestDungeonRun(t *testing.T) *entity.DungeonRun {
+	t.Helper()
+
+	run, err := entity.NewDungeonRun("run-1", "toca-dos-ratos", "char-123", 100, runStartedAt)
+	if err != nil {
+		t.Fatalf("NewDungeonRun() error = %v, want nil", err)
+	}
+	return run
+}
+
+func TestNewDungeonRun_Valid(t *testing.T) {
+	run := newTestDungeonRun(t)
+
+	if run.Status() != entity.DungeonRunInProgress || run.Stage() != 0 || run.HP() != 100 || run.Checkpoint() != 0 {
+		t.Errorf("run = %+v, want a run at the first stage with 100 HP", run)
+	}
+	if run.EndedAt() != nil || !run.UpdatedAt().Equal(runStartedAt) {
+		t.Error("new run should not have ended")
+	}
+}
+
+func TestNewDungeonRun_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          string
+		dungeonID   string
+		characterID string
+		hp          int
+		startedAt   time.Time
+	}{
+		{"empty id", "", "toca-dos-ratos", "char-123", 100, runStartedAt},
+		{"empty dungeon", "run-1", "", "char-123", 100, runStartedAt},
+		{"empty character", "run-1", "toca-dos-ratos", "", 100, runStartedAt},
+		{"no hp", "run-1", "toca-dos-ratos", "char-123", 0, runStartedAt},
+		{"no start time", "run-1", "toca-dos-ratos", "char-123", 100, time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := entity.NewDungeonRun(tt.id, tt.dungeonID, tt.characterID, tt.hp, tt.startedAt); err == nil {
+				t.Error("NewDungeonRun() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestDungeonRun_HpCarriesOverAndCheckpointRests(t *testing.T) {
+	dungeon := newTestDungeon(t)
+	run := newTestDungeonRun(t)
+
+	// The first stage is a checkpoint: the character rests to full HP
+	if err := run.RecordVictory(dungeon, 30, 100, runStartedAt.Add(time.Minute)); err != nil {
+		t.Fatalf("RecordVictory() error = %v, want nil", err)
+	}
+	if run.Stage() != 1 || run.HP() != 100 || run.Checkpoint() != 1 {
+		t.Errorf("after a checkpoint: stage %d, hp %d, checkpoint %d, want 1, 100, 1", run.Stage(), run.HP(), run.Checkpoint())
+	}
+
+	// The HP left after a regular stage carries over
+	if err := run.RecordVictory(dungeon, 45, 100, runStartedAt.Add(2*time.Minute)); err != nil {
+		t.Fatalf("RecordVictory() error = %v, want nil", err)
+	}
+	if run.Stage() != 2 || run.HP() != 45 || !run.IsInProgress() {
+		t.Errorf("after stage 2: stage %d, hp %d, status %s, want 2, 45, in progress", run.Stage(), run.HP(), run.Status())
+	}
+
+	// Clearing the last stage completes the run
+	endedAt := runStartedAt.Add(3 * time.Minute)
+	if err := run.RecordVictory(dungeon, 10, 100, endedAt); err != nil {
+		t.Fatalf("RecordVictory() error = %v, want nil", err)
+	}
+	if run.Status() != entity.DungeonRunCompleted || run.Stage() != 3 || run.EndedAt() == nil || !run.EndedAt().Equal(endedAt) {
+		t.Errorf("run = %+v, want completed at %v", run, endedAt)
+	}
+
+	if err := run.RecordVictory(dungeon, 10, 100, endedAt); err == nil {
+		t.Error("RecordVictory() error = nil, want error for a completed run")
+	}
+}
+
+func TestDungeonRun_DefeatGoesBackToCheckpointOnce(t *testing.T) {
+	dungeon := newTestDungeon(t)
+	run := newTestDungeonRun(t)
+
+	run.RecordVictory(dungeon, 30, 100, runStartedAt.Add(time.Minute))
+	run.RecordVictory(dungeon, 45, 100, runStartedAt.Add(2*time.Minute))
+
+	// The first defeat spends the checkpoint
+	retreated, err := run.RecordDefeat(dungeon, 100, runStartedAt.Add(3*time.Minute))
+	if err != nil {
+		t.Fatalf("RecordDefeat() error = %v, want nil", err)
+	}
+	if !retreated || run.Stage() != 1 || run.HP() != 100 || run.Checkpoint() != 0 || !run.IsInProgress() {
+		t.Errorf("after the first defeat: retreated %v, stage %d, hp %d, checkpoint %d, want back at stage 1 with 100 HP",
+			retreated, run.Stage(), run.HP(), run.Checkpoint())
+	}
+
+	// Without a checkpoint the run fails
+	retreated, err = run.RecordDefeat(dungeon, 100, runStartedAt.Add(4*time.Minute))
+	if err != nil {
+		t.Fatalf("RecordDefeat() error = %v, want nil", err)
+	}
+	if retreated || run.Status() != entity.DungeonRunFailed || run.EndedAt() == nil {
+		t.Errorf("after the second defeat: retreated %v, status %s, want failed", retreated, run.Status())
+	}
+}
+
+func TestDungeonRun_Abandon(t *testing.T) {
+	run := newTestDungeonRun(t)
+
+	if err := run.Abandon(runStartedAt.Add(time.Minute)); err != nil {
+		t.Fatalf("Abandon() error = %v, want nil", err)
+	}
+	if run.Status() != entity.DungeonRunAbandoned || run.EndedAt() == nil {
+		t.Errorf("run = %+v, want abandoned", run)
+	}
+
+	if err := run.Abandon(runStartedAt.Add(2 * time.Minute)); err == nil {
+		t.Error("Abandon() error = nil, want error for a run already over")
+	}
+	if _, err := run.RecordDefeat(newTestDungeon(t), 100, runStartedAt.Add(2*time.Minute)); err == nil {
+		t.Error("RecordDefeat() error = nil, want error for a run already over")
+	}
+}
+
+func TestDungeonRun_OtherDungeon(t *testing.T) {
+	run := newTestDungeonRun(t)
+	other := entity.ReconstituteDungeon("cripta", "Cripta", 5, 40, 300, nil, []entity.DungeonStage{{MonsterID: "esqueleto"}})
+
+	if err := run.RecordVictory(other, 50, 100, runStartedAt); err == nil {
+		t.Error("RecordVictory() error = nil, want error for another dungeon")
+	}
+}
+
+func TestDungeon_RollRewardLoot(t *testing.T) {
+	dungeon := newTestDungeon(t)
+
+	loot := dungeon.RollRewardLoot(42)
+	if len(loot) != 1 || loot[0].Item() != "Moeda de Prata" || !slices.Equal(loot, dungeon.RollRewardLoot(42)) {
+		t.Errorf("RollRewardLoot() = %+v, want the guaranteed silver coins", loot)
+	}
+
+	if !dungeon.AllowsLevel(1) || entity.ReconstituteDungeon("cripta", "Cripta", 5, 40, 300, nil, nil).AllowsLevel(4) {
+		t.Error("AllowsLevel() should only hold from the dungeon's minimum level")
+	}
+}
@@ -0,0 +1,235 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// Focus session statuses
+const (
+	FocusSessionRunning   = "running"
+	FocusSessionPaused    = "paused"
+	FocusSessionCompleted = "completed"
+)
+
+const (
+	// focusXpPerMinute is the XP awarded for each tracked minute of a completed session
+	focusXpPerMinute = 1
+
+	// maxRewardedFocusMinutes caps the rewarded time of a single session
+	// (a timer forgotten overnight shouldn't be worth a whole level)
+	maxRewardedFocusMinutes = 240
+)
+
+// FocusSession represents a timed focus session tracked against a habit or a task (Domain Entity)
+// Sessions are kept server-side: the tracked time is derived from the start, pause and end timestamps
+type FocusSession struct {
+	id            string
+	characterID   string
+	habitID       string // Empty for task sessions
+	taskID        string // Empty for habit sessions
+	startedAt     time.Time
+	pausedAt      *time.Time // Set while the session is paused
+	pausedSeconds int        // Total time spent paused (finished pauses only)
+	endedAt       *time.Time // Set once the session is stopped
+	xpAwarded     int        // Filled when the session is stopped
+}
+
+// NewFocusSession creates a new, running FocusSession tracked against a habit, with validation
+func NewFocusSession(id string, characterID string, habitID string, startedAt time.Time) (*FocusSession, error) {
+	if habitID == "" {
+		return nil, fmt.Errorf("habit id cannot be empty")
+	}
+
+	return newFocusSession(id, characterID, habitID, "", startedAt)
+}
+
+// NewTaskFocusSession creates a new, running FocusSession tracked against a task, with validation
+func NewTaskFocusSession(id string, characterID string, taskID string, startedAt time.Time) (*FocusSession, error) {
+	if taskID == "" {
+		return nil, fmt.Errorf("task id cannot be empty")
+	}
+
+	return newFocusSession(id, characterID, "", taskID, startedAt)
+}
+
+// newFocusSession validates the fields shared by habit and task sessions
+func newFocusSession(id string, characterID string, habitID string, taskID string, startedAt time.Time) (*FocusSession, error) {
+	if id == "" {
+		return nil, fmt.Errorf("focus session id cannot be empty")
+	}
+	if characterID == "" {
+		return nil, fmt.Errorf("character id cannot be empty")
+	}
+	if startedAt.IsZero() {
+		return nil, fmt.Errorf("start time cannot be empty")
+	}
+
+	return &FocusSession{
+		id:          id,
+		characterID: characterID,
+		habitID:     habitID,
+		taskID:      taskID,
+		startedAt:   startedAt,
+	}, nil
+}
+
+// Getters (Read-only access to ensure encapsulation)
+
+func (fs *FocusSession) ID() string {
+	return fs.id
+}
+
+func (fs *FocusSession) CharacterID() string {
+	return fs.characterID
+}
+
+func (fs *FocusSession) HabitID() string {
+	return fs.habitID
+}
+
+func (fs *FocusSession) TaskID() string {
+	return fs.taskID
+}
+
+func (fs *FocusSession) StartedAt() time.Time {
+	return fs.startedAt
+}
+
+func (fs *FocusSession) PausedAt() *time.Time {
+	return fs.pausedAt
+}
+
+func (fs *FocusSession) PausedSeconds() int {
+	return fs.pausedSeconds
+}
+
+func (fs *FocusSession) EndedAt() *time.Time {
+	return fs.endedAt
+}
+
+func (fs *FocusSession) XpAwarded() int {
+	return fs.xpAwarded
+}
+
+// Business Methods
+
+// Status returns the current status of the session (running, paused or completed)
+func (fs *FocusSession) Status() string {
+	switch {
+	case fs.endedAt != nil:
+		return FocusSessionCompleted
+	case fs.pausedAt != nil:
+		return FocusSessionPaused
+	default:
+		return FocusSessionRunning
+	}
+}
+
+// IsOpen reports whether the session wasn't stopped yet (running or paused)
+func (fs *FocusSession) IsOpen() bool {
+	return fs.endedAt == nil
+}
+
+// Pause pauses a running session at the given time
+func (fs *FocusSession) Pause(at time.Time) error {
+	if fs.Status() != FocusSessionRunning {
+		return fmt.Errorf("only running focus sessions can be paused")
+	}
+	if at.Before(fs.startedAt) {
+		return fmt.Errorf("pause time cannot be before the session start")
+	}
+
+	fs.pausedAt = &at
+	return nil
+}
+
+// Resume resumes a paused session at the given time
+func (fs *FocusSession) Resume(at time.Time) error {
+	if fs.Status() != FocusSessionPaused {
+		return fmt.Errorf("only paused focus sessions can be resumed")
+	}
+	if at.Before(*fs.pausedAt) {
+		return fmt.Errorf("resume time cannot be before the pause")
+	}
+
+	fs.pausedSeconds += int(at.Sub(*fs.pausedAt).Seconds())
+	fs.pausedAt = nil
+	return nil
+}
+
+// Stop ends an open session at the given time and computes its XP reward
+// Stopping a paused session ends it at the time it was paused
+func (fs *FocusSession) Stop(at time.Time) error {
+	if !fs.IsOpen() {
+		return fmt.Errorf("focus session was already stopped")
+	}
+
+	if fs.pausedAt != nil {
+		at = *fs.pausedAt
+		fs.pausedAt = nil
+	}
+	if at.Before(fs.startedAt) {
+		return fmt.Errorf("stop time cannot be before the session start")
+	}
+
+	fs.endedAt = &at
+	fs.xpAwarded = focusXpForMinutes(fs.TrackedMinutes(at))
+	return nil
+}
+
+// TrackedDuration returns the focused time (excluding pauses) up to the given time
+// Paused and completed sessions don't accumulate time
+func (fs *FocusSession) TrackedDuration(now time.Time) time.Duration {
+	end := now
+	switch {
+	case fs.endedAt != nil:
+		end = *fs.endedAt
+	case fs.pausedAt != nil:
+		end = *fs.pausedAt
+	}
+
+	tracked := end.Sub(fs.startedAt) - time.Duration(fs.pausedSeconds)*time.Second
+	if tracked < 0 {
+		return 0
+	}
+	return tracked
+}
+
+// TrackedMinutes returns the whole focused minutes up to the given time
+func (fs *FocusSession) TrackedMinutes(now time.Time) int {
+	return int(fs.TrackedDuration(now) / time.Minute)
+}
+
+// focusXpForMinutes returns the XP reward for the given tracked minutes
+func focusXpForMinutes(minutes int) int {
+	if minutes > maxRewardedFocusMinutes {
+		minutes = maxRewardedFocusMinutes
+	}
+	return minutes * focusXpPerMinute
+}
+
+// ReconstituteFocusSession creates a FocusSession from existing data (for repository loading)
+func ReconstituteFocusSession(
+	id string,
+	characterID string,
+	habitID string,
+	taskID string,
+	startedAt time.Time,
+	pausedAt *time.Time,
+	pausedSeconds int,
+	endedAt *time.Time,
+	xpAwarded int,
+) *FocusSession {
+	return &FocusSession{
+		id:            id,
+		characterID:   characterID,
+		habitID:       habitID,
+		taskID:        taskID,
+		startedAt:     startedAt,
+		pausedAt:      pausedAt,
+		pausedSeconds: pausedSeconds,
+		endedAt:       endedAt,
+		xpAwarded:     xpAwarded,
+	}
+}
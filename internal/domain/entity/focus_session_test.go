@@ -0,0 +1,139 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+var focusStart = time.Date(2024, 1, 10, 9, 0, 0, 0, time.UTC)
+
+func newTestFocusSession(t *testing.T) *entity.FocusSession {
+	t.Helper()
+
+	session, err := entity.NewFocusSession("focus-1", "char-456", "habit-123", focusStart)
+	if err != nil {
+		t.Fatalf("NewFocusSession() error = %v, want nil", err)
+	}
+	return session
+}
+
+func TestNewFocusSession_Valid(t *testing.T) {
+	session := newTestFocusSession(t)
+
+	if session.Status() != entity.FocusSessionRunning {
+		t.Errorf("Status() = %v, want %v", session.Status(), entity.FocusSessionRunning)
+	}
+
+	if !session.IsOpen() {
+		t.Error("IsOpen() = false, want true")
+	}
+
+	if got := session.TrackedMinutes(focusStart.Add(25 * time.Minute)); got != 25 {
+		t.Errorf("TrackedMinutes() = %v, want %v", got, 25)
+	}
+}
+
+func TestNewFocusSession_Invalid(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          string
+		characterID string
+		habitID     string
+		startedAt   time.Time
+	}{
+		{"empty id", "", "char-456", "habit-123", focusStart},
+		{"empty character id", "focus-1", "", "habit-123", focusStart},
+		{"empty habit id", "focus-1", "char-456", "", focusStart},
+		{"zero start", "focus-1", "char-456", "habit-123", time.Time{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := entity.NewFocusSession(tt.id, tt.characterID, tt.habitID, tt.startedAt); err == nil {
+				t.Error("NewFocusSession() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestNewTaskFocusSession(t *testing.T) {
+	session, err := entity.NewTaskFocusSession("focus-1", "char-456", "task-123", focusStart)
+	if err != nil {
+		t.Fatalf("NewTaskFocusSession() error = %v, want nil", err)
+	}
+
+	if session.TaskID() != "task-123" || session.HabitID() != "" {
+		t.Errorf("targets = (habit %q, task %q), want (\"\", task-123)", session.HabitID(), session.TaskID())
+	}
+
+	if _, err := entity.NewTaskFocusSession("focus-1", "char-456", "", focusStart); err == nil {
+		t.Error("NewTaskFocusSession() with empty task id error = nil, want error")
+	}
+}
+
+func TestFocusSession_PauseResumeExcludesPausedTime(t *testing.T) {
+	session := newTestFocusSession(t)
+
+	if err := session.Pause(focusStart.Add(10 * time.Minute)); err != nil {
+		t.Fatalf("Pause() error = %v, want nil", err)
+	}
+
+	// Paused sessions don't accumulate time
+	if got := session.TrackedMinutes(focusStart.Add(time.Hour)); got != 10 {
+		t.Errorf("TrackedMinutes() while paused = %v, want %v", got, 10)
+	}
+
+	if err := session.Pause(focusStart.Add(15 * time.Minute)); err == nil {
+		t.Error("Pause() on paused session error = nil, want error")
+	}
+
+	if err := session.Resume(focusStart.Add(20 * time.Minute)); err != nil {
+		t.Fatalf("Resume() error = %v, want nil", err)
+	}
+
+	if err := session.Stop(focusStart.Add(45 * time.Minute)); err != nil {
+		t.Fatalf("Stop() error = %v, want nil", err)
+	}
+
+	if session.Status() != entity.FocusSessionCompleted {
+		t.Errorf("Status() = %v, want %v", session.Status(), entity.FocusSessionCompleted)
+	}
+
+	// 45 minutes minus a 10 minute pause
+	if got := session.TrackedMinutes(focusStart.Add(2 * time.Hour)); got != 35 {
+		t.Errorf("TrackedMinutes() = %v, want %v", got, 35)
+	}
+
+	if session.XpAwarded() != 35 {
+		t.Errorf("XpAwarded() = %v, want %v", session.XpAwarded(), 35)
+	}
+}
+
+func TestFocusSession_StopWhilePaused(t *testing.T) {
+	session := newTestFocusSession(t)
+	session.Pause(focusStart.Add(30 * time.Minute))
+
+	if err := session.Stop(focusStart.Add(3 * time.Hour)); err != nil {
+		t.Fatalf("Stop() error = %v, want nil", err)
+	}
+
+	// The session ends when it was paused
+	if !session.EndedAt().Equal(focusStart.Add(30 * time.Minute)) {
+		t.Errorf("EndedAt() = %v, want %v", session.EndedAt(), focusStart.Add(30*time.Minute))
+	}
+
+	if err := session.Stop(focusStart.Add(4 * time.Hour)); err == nil {
+		t.Error("Stop() on completed session error = nil, want error")
+	}
+}
+
+func TestFocusSession_XpIsCapped(t *testing.T) {
+	session := newTestFocusSession(t)
+	session.Stop(focusStart.Add(10 * time.Hour))
+
+	if session.XpAwarded() != 240 {
+		t.Errorf("XpAwarded() = %v, want %v", session.XpAwarded(), 240)
+	}
+}
@@ -0,0 +1,82 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Guild name length limits
+const (
+	minGuildNameLength = 3
+	maxGuildNameLength = 50
+)
+
+// Guild represents a group of characters that raid bosses together (Domain Entity)
+// A character belongs to at most one guild; the character that founds it leads it
+type Guild struct {
+	id                string
+	name              string
+	leaderCharacterID string
+	createdAt         time.Time
+}
+
+// NewGuild creates a new Guild led by the character that founds it
+func NewGuild(id string, name string, leaderCharacterID string, createdAt time.Time) (*Guild, error) {
+	name = strings.TrimSpace(name)
+
+	if id == "" {
+		return nil, fmt.Errorf("guild id cannot be empty")
+	}
+	if len([]rune(name)) < minGuildNameLength || len([]rune(name)) > maxGuildNameLength {
+		return nil, fmt.Errorf("guild name must be between %d and %d characters", minGuildNameLength, maxGuildNameLength)
+	}
+	if leaderCharacterID == "" {
+		return nil, fmt.Errorf("guild leader cannot be empty")
+	}
+	if createdAt.IsZero() {
+		return nil, fmt.Errorf("creation time cannot be empty")
+	}
+
+	return &Guild{
+		id:                id,
+		name:              name,
+		leaderCharacterID: leaderCharacterID,
+		createdAt:         createdAt,
+	}, nil
+}
+
+// Getters (Read-only access to ensure encapsulation)
+
+func (g *Guild) ID() string {
+	return g.id
+}
+
+func (g *Guild) Name() string {
+	return g.name
+}
+
+func (g *Guild) LeaderCharacterID() string {
+	return g.leaderCharacterID
+}
+
+func (g *Guild) CreatedAt() time.Time {
+	return g.createdAt
+}
+
+// Business Methods
+
+// IsLeader checks whether a character leads the guild
+func (g *Guild) IsLeader(characterID string) bool {
+	return g.leaderCharacterID == characterID
+}
+
+// ReconstituteGuild creates a Guild from existing data (for repository loading)
+func ReconstituteGuild(id string, name string, leaderCharacterID string, createdAt time.Time) *Guild {
+	return &Guild{
+		id:                id,
+		name:              name,
+		leaderCharacterID: leaderCharacterID,
+		createdAt:         createdAt,
+	}
+}
@@ -0,0 +1,45 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+func TestNewGuild_Valid(t *testing.T) {
+	guild, err := entity.NewGuild("guild-1", "  Cavaleiros do Hábito ", "char-123", time.Now())
+	if err != nil {
+		t.Fatalf("NewGuild() error = %v, want nil", err)
+	}
+
+	if guild.Name() != "Cavaleiros do Hábito" {
+		t.Errorf("Name() = %q, want the trimmed name", guild.Name())
+	}
+	if !guild.IsLeader("char-123") || guild.IsLeader("char-456") {
+		t.Error("the founder should lead the guild")
+	}
+}
+
+func TestNewGuild_Invalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		id        string
+		guildName string
+		leader    string
+	}{
+		{"empty id", "", "Cavaleiros", "char-123"},
+		{"name too short", "guild-1", " ab ", "char-123"},
+		{"name too long", "guild-1", strings.Repeat("a", 51), "char-123"},
+		{"empty leader", "guild-1", "Cavaleiros", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := entity.NewGuild(tt.id, tt.guildName, tt.leader, time.Now()); err == nil {
+				t.Error("NewGuild() error = nil, want error")
+			}
+		})
+	}
+}
@@ -0,0 +1,296 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Habit represents a recurring activity tracked by a character (Domain Entity)
+type Habit struct {
+	id            string
+	title         string
+	description   string
+	characterID   string
+	attributeName string // Attribute that grows when the habit is completed
+	difficulty    valueobject.Difficulty
+	recurrence    valueobject.Recurrence // When the habit is expected to be done
+	negative      bool                   // Bad habit: logging it drains the attribute instead of growing it
+	drainsXp      bool                   // Negative habits only: logging it also costs XP
+	active        bool
+	createdAt     time.Time
+	updatedAt     time.Time
+}
+
+// NewHabit creates a new Habit entity with validation
+func NewHabit(
+	id string,
+	title string,
+	description string,
+	characterID string,
+	attributeName string,
+	difficulty valueobject.Difficulty,
+	recurrence valueobject.Recurrence,
+) (*Habit, error) {
+	// Validate ID
+	if id == "" {
+		return nil, fmt.Errorf("habit id cannot be empty")
+	}
+
+	// Validate title
+	title, err := validateHabitTitle(title)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate description
+	description, err = validateHabitDescription(description)
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate character ID
+	if characterID == "" {
+		return nil, fmt.Errorf("character id cannot be empty")
+	}
+
+	// Validate linked attribute
+	attributeName = strings.TrimSpace(attributeName)
+	if attributeName == "" {
+		return nil, fmt.Errorf("attribute name cannot be empty")
+	}
+
+	// Validate difficulty (zero value means it was never validated)
+	if difficulty.Value() == "" {
+		return nil, fmt.Errorf("difficulty cannot be empty")
+	}
+
+	// Validate recurrence (zero value means it was never validated)
+	if recurrence.Type() == "" {
+		return nil, fmt.Errorf("recurrence cannot be empty")
+	}
+
+	now := time.Now()
+
+	return &Habit{
+		id:            id,
+		title:         title,
+		description:   description,
+		characterID:   characterID,
+		attributeName: attributeName,
+		difficulty:    difficulty,
+		recurrence:    recurrence,
+		active:        true, // Habits start active
+		createdAt:     now,
+		updatedAt:     now,
+	}, nil
+}
+
+// Getters (Read-only access to ensure encapsulation)
+
+func (h *Habit) ID() string {
+	return h.id
+}
+
+func (h *Habit) Title() string {
+	return h.title
+}
+
+func (h *Habit) Description() string {
+	return h.description
+}
+
+func (h *Habit) CharacterID() string {
+	return h.characterID
+}
+
+func (h *Habit) AttributeName() string {
+	return h.attributeName
+}
+
+func (h *Habit) Difficulty() valueobject.Difficulty {
+	return h.difficulty
+}
+
+func (h *Habit) Recurrence() valueobject.Recurrence {
+	return h.recurrence
+}
+
+func (h *Habit) IsNegative() bool {
+	return h.negative
+}
+
+func (h *Habit) DrainsXp() bool {
+	return h.drainsXp
+}
+
+func (h *Habit) Active() bool {
+	return h.active
+}
+
+func (h *Habit) CreatedAt() time.Time {
+	return h.createdAt
+}
+
+func (h *Habit) UpdatedAt() time.Time {
+	return h.updatedAt
+}
+
+// Business Methods
+
+// UpdateTitle updates the habit's title
+func (h *Habit) UpdateTitle(title string) error {
+	title, err := validateHabitTitle(title)
+	if err != nil {
+		return err
+	}
+
+	h.title = title
+	h.updatedAt = time.Now()
+	return nil
+}
+
+// UpdateDescription updates the habit's description
+func (h *Habit) UpdateDescription(description string) error {
+	description, err := validateHabitDescription(description)
+	if err != nil {
+		return err
+	}
+
+	h.description = description
+	h.updatedAt = time.Now()
+	return nil
+}
+
+// LinkAttribute changes the attribute that grows when the habit is completed
+func (h *Habit) LinkAttribute(attributeName string) error {
+	attributeName = strings.TrimSpace(attributeName)
+	if attributeName == "" {
+		return fmt.Errorf("attribute name cannot be empty")
+	}
+
+	h.attributeName = attributeName
+	h.updatedAt = time.Now()
+	return nil
+}
+
+// ChangeDifficulty updates the habit's difficulty
+func (h *Habit) ChangeDifficulty(difficulty valueobject.Difficulty) error {
+	if difficulty.Value() == "" {
+		return fmt.Errorf("difficulty cannot be empty")
+	}
+
+	h.difficulty = difficulty
+	h.updatedAt = time.Now()
+	return nil
+}
+
+// ChangeRecurrence updates the habit's schedule
+func (h *Habit) ChangeRecurrence(recurrence valueobject.Recurrence) error {
+	if recurrence.Type() == "" {
+		return fmt.Errorf("recurrence cannot be empty")
+	}
+
+	h.recurrence = recurrence
+	h.updatedAt = time.Now()
+	return nil
+}
+
+// MakeNegative turns the habit into a bad habit (logging it drains the linked attribute)
+// When drainsXp is true, logging it also costs XP
+func (h *Habit) MakeNegative(drainsXp bool) {
+	h.negative = true
+	h.drainsXp = drainsXp
+	h.updatedAt = time.Now()
+}
+
+// MakePositive turns the habit into a regular (rewarding) habit
+func (h *Habit) MakePositive() {
+	h.negative = false
+	h.drainsXp = false
+	h.updatedAt = time.Now()
+}
+
+// IsDueOn reports whether the habit is scheduled for the given day (a calendar day of the user's clock)
+// completionsInPeriod is the number of completions in the current period before that day
+// Negative habits are never due (they are only logged when they happen)
+func (h *Habit) IsDueOn(day time.Time, completionsInPeriod int, clock valueobject.DayClock) bool {
+	if !h.active || h.negative {
+		return false
+	}
+	return h.recurrence.IsDueOn(clock.Date(day), h.CreatedDay(clock), completionsInPeriod)
+}
+
+// CreatedDay returns the calendar day the habit was created on, according to the user's clock
+func (h *Habit) CreatedDay(clock valueobject.DayClock) time.Time {
+	return clock.Day(h.createdAt)
+}
+
+// Activate marks the habit as active
+func (h *Habit) Activate() {
+	h.active = true
+	h.updatedAt = time.Now()
+}
+
+// Deactivate marks the habit as inactive (kept for history, but no longer tracked)
+func (h *Habit) Deactivate() {
+	h.active = false
+	h.updatedAt = time.Now()
+}
+
+// validateHabitTitle trims and validates a habit title
+func validateHabitTitle(title string) (string, error) {
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return "", fmt.Errorf("habit title cannot be empty")
+	}
+	if len(title) < 2 {
+		return "", fmt.Errorf("habit title must be at least 2 characters")
+	}
+	if len(title) > 100 {
+		return "", fmt.Errorf("habit title cannot exceed 100 characters")
+	}
+	return title, nil
+}
+
+// validateHabitDescription trims and validates a habit description (optional)
+func validateHabitDescription(description string) (string, error) {
+	description = strings.TrimSpace(description)
+	if len(description) > 500 {
+		return "", fmt.Errorf("habit description cannot exceed 500 characters")
+	}
+	return description, nil
+}
+
+// ReconstituteHabit creates a Habit from existing data (for repository loading)
+func ReconstituteHabit(
+	id string,
+	title string,
+	description string,
+	characterID string,
+	attributeName string,
+	difficulty valueobject.Difficulty,
+	recurrence valueobject.Recurrence,
+	negative bool,
+	drainsXp bool,
+	active bool,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Habit {
+	return &Habit{
+		id:            id,
+		title:         title,
+		description:   description,
+		characterID:   characterID,
+		attributeName: attributeName,
+		difficulty:    difficulty,
+		recurrence:    recurrence,
+		negative:      negative,
+		drainsXp:      drainsXp && negative,
+		active:        active,
+		createdAt:     createdAt,
+		updatedAt:     updatedAt,
+	}
+}
@@ -0,0 +1,163 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// HabitCompletion represents a single logged completion of a habit (Domain Entity)
+// It records exactly what the completion awarded so it can be audited (or reverted)
+// Completions of negative habits (slips) record their losses as negative values
+type HabitCompletion struct {
+	id             string
+	habitID        string
+	characterID    string
+	xpGained       int
+	levelsGained   int
+	attributeName  string
+	attributeGain  int
+	energyRestored int // Battle energy restored by the completion (drained again if it is undone)
+	completedAt    time.Time
+}
+
+// NewHabitCompletion creates a new HabitCompletion entity with validation
+func NewHabitCompletion(
+	id string,
+	habitID string,
+	characterID string,
+	xpGained int,
+	levelsGained int,
+	attributeName string,
+	attributeGain int,
+) (*HabitCompletion, error) {
+	// Validate IDs
+	if id == "" {
+		return nil, fmt.Errorf("habit completion id cannot be empty")
+	}
+	if habitID == "" {
+		return nil, fmt.Errorf("habit id cannot be empty")
+	}
+	if characterID == "" {
+		return nil, fmt.Errorf("character id cannot be empty")
+	}
+
+	// Validate rewards
+	if xpGained < 0 {
+		return nil, fmt.Errorf("xp gained cannot be negative")
+	}
+	if levelsGained < 0 {
+		return nil, fmt.Errorf("levels gained cannot be negative")
+	}
+	if attributeName == "" {
+		return nil, fmt.Errorf("attribute name cannot be empty")
+	}
+	if attributeGain < 0 {
+		return nil, fmt.Errorf("attribute gain cannot be negative")
+	}
+
+	return &HabitCompletion{
+		id:            id,
+		habitID:       habitID,
+		characterID:   characterID,
+		xpGained:      xpGained,
+		levelsGained:  levelsGained,
+		attributeName: attributeName,
+		attributeGain: attributeGain,
+		completedAt:   time.Now(),
+	}, nil
+}
+
+// NewHabitSlip creates a HabitCompletion for a negative habit with validation
+// Losses are given as non-negative amounts and recorded as negative values
+func NewHabitSlip(
+	id string,
+	habitID string,
+	characterID string,
+	xpLost int,
+	levelsLost int,
+	attributeName string,
+	attributeLoss int,
+) (*HabitCompletion, error) {
+	slip, err := NewHabitCompletion(id, habitID, characterID, xpLost, levelsLost, attributeName, attributeLoss)
+	if err != nil {
+		return nil, err
+	}
+
+	slip.xpGained = -xpLost
+	slip.levelsGained = -levelsLost
+	slip.attributeGain = -attributeLoss
+	return slip, nil
+}
+
+// Getters (Read-only access to ensure encapsulation)
+
+func (hc *HabitCompletion) ID() string {
+	return hc.id
+}
+
+func (hc *HabitCompletion) HabitID() string {
+	return hc.habitID
+}
+
+func (hc *HabitCompletion) CharacterID() string {
+	return hc.characterID
+}
+
+func (hc *HabitCompletion) XpGained() int {
+	return hc.xpGained
+}
+
+func (hc *HabitCompletion) LevelsGained() int {
+	return hc.levelsGained
+}
+
+func (hc *HabitCompletion) AttributeName() string {
+	return hc.attributeName
+}
+
+func (hc *HabitCompletion) AttributeGain() int {
+	return hc.attributeGain
+}
+
+func (hc *HabitCompletion) EnergyRestored() int {
+	return hc.energyRestored
+}
+
+func (hc *HabitCompletion) CompletedAt() time.Time {
+	return hc.completedAt
+}
+
+// RecordEnergyRestored records the battle energy the completion restored
+func (hc *HabitCompletion) RecordEnergyRestored(energy int) error {
+	if energy < 0 {
+		return fmt.Errorf("energy restored cannot be negative")
+	}
+
+	hc.energyRestored = energy
+	return nil
+}
+
+// ReconstituteHabitCompletion creates a HabitCompletion from existing data (for repository loading)
+func ReconstituteHabitCompletion(
+	id string,
+	habitID string,
+	characterID string,
+	xpGained int,
+	levelsGained int,
+	attributeName string,
+	attributeGain int,
+	energyRestored int,
+	completedAt time.Time,
+) *HabitCompletion {
+	return &HabitCompletion{
+		id:             id,
+		habitID:        habitID,
+		characterID:    characterID,
+		xpGained:       xpGained,
+		levelsGained:   levelsGained,
+		attributeName:  attributeName,
+		attributeGain:  attributeGain,
+		energyRestored: energyRestored,
+		completedAt:    completedAt,
+	}
+}
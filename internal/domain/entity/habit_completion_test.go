@@ -0,0 +1,121 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+func TestNewHabitCompletion_Valid(t *testing.T) {
+	completion, err := entity.NewHabitCompletion("comp-1", "habit-123", "char-456", 20, 1, "Força", 1)
+
+	if err != nil {
+		t.Fatalf("NewHabitCompletion() error = %v, want nil", err)
+	}
+
+	if completion.ID() != "comp-1" {
+		t.Errorf("ID() = %v, want %v", completion.ID(), "comp-1")
+	}
+
+	if completion.XpGained() != 20 {
+		t.Errorf("XpGained() = %v, want %v", completion.XpGained(), 20)
+	}
+
+	if completion.LevelsGained() != 1 {
+		t.Errorf("LevelsGained() = %v, want %v", completion.LevelsGained(), 1)
+	}
+
+	if completion.AttributeGain() != 1 {
+		t.Errorf("AttributeGain() = %v, want %v", completion.AttributeGain(), 1)
+	}
+
+	if completion.CompletedAt().IsZero() {
+		t.Error("CompletedAt() should not be zero")
+	}
+}
+
+func TestNewHabitSlip(t *testing.T) {
+	slip, err := entity.NewHabitSlip("slip-1", "habit-123", "char-456", 40, 1, "Força", 1)
+
+	if err != nil {
+		t.Fatalf("NewHabitSlip() error = %v, want nil", err)
+	}
+
+	// Losses are recorded as negative values
+	if slip.XpGained() != -40 || slip.LevelsGained() != -1 || slip.AttributeGain() != -1 {
+		t.Errorf("slip = (xp %v, levels %v, attribute %v), want (-40, -1, -1)", slip.XpGained(), slip.LevelsGained(), slip.AttributeGain())
+	}
+
+	if _, err := entity.NewHabitSlip("slip-2", "habit-123", "char-456", -10, 0, "Força", 1); err == nil {
+		t.Error("NewHabitSlip() with negative loss error = nil, want error")
+	}
+}
+
+func TestNewHabitCompletion_Invalid(t *testing.T) {
+	tests := []struct {
+		name          string
+		id            string
+		habitID       string
+		characterID   string
+		xpGained      int
+		levelsGained  int
+		attributeName string
+		attributeGain int
+	}{
+		{"empty id", "", "habit-123", "char-456", 20, 0, "Força", 1},
+		{"empty habit id", "comp-1", "", "char-456", 20, 0, "Força", 1},
+		{"empty character id", "comp-1", "habit-123", "", 20, 0, "Força", 1},
+		{"negative xp", "comp-1", "habit-123", "char-456", -1, 0, "Força", 1},
+		{"negative levels", "comp-1", "habit-123", "char-456", 20, -1, "Força", 1},
+		{"empty attribute", "comp-1", "habit-123", "char-456", 20, 0, "", 1},
+		{"negative attribute gain", "comp-1", "habit-123", "char-456", 20, 0, "Força", -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := entity.NewHabitCompletion(tt.id, tt.habitID, tt.characterID, tt.xpGained, tt.levelsGained, tt.attributeName, tt.attributeGain)
+			if err == nil {
+				t.Error("NewHabitCompletion() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestReconstituteHabitCompletion(t *testing.T) {
+	completedAt := time.Date(2023, 1, 1, 8, 0, 0, 0, time.UTC)
+
+	completion := entity.ReconstituteHabitCompletion("comp-1", "habit-123", "char-456", 40, 0, "Vontade", 1, 5, completedAt)
+
+	if completion.HabitID() != "habit-123" {
+		t.Errorf("HabitID() = %v, want %v", completion.HabitID(), "habit-123")
+	}
+
+	if completion.AttributeName() != "Vontade" {
+		t.Errorf("AttributeName() = %v, want %v", completion.AttributeName(), "Vontade")
+	}
+
+	if completion.EnergyRestored() != 5 {
+		t.Errorf("EnergyRestored() = %v, want %v", completion.EnergyRestored(), 5)
+	}
+
+	if completion.CompletedAt() != completedAt {
+		t.Errorf("CompletedAt() = %v, want %v", completion.CompletedAt(), completedAt)
+	}
+}
+
+func TestHabitCompletion_RecordEnergyRestored(t *testing.T) {
+	completion, _ := entity.NewHabitCompletion("comp-1", "habit-123", "char-456", 40, 0, "Vontade", 1)
+
+	if err := completion.RecordEnergyRestored(-1); err == nil {
+		t.Error("RecordEnergyRestored(-1) error = nil, want error")
+	}
+
+	if err := completion.RecordEnergyRestored(3); err != nil {
+		t.Fatalf("RecordEnergyRestored() error = %v, want nil", err)
+	}
+
+	if completion.EnergyRestored() != 3 {
+		t.Errorf("EnergyRestored() = %v, want %v", completion.EnergyRestored(), 3)
+	}
+}
@@ -0,0 +1,126 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// HabitPenalty represents the penalty applied to a character for missing a scheduled habit on a day (Domain Entity)
+// A habit is penalized at most once per day, which keeps the day-end job idempotent
+type HabitPenalty struct {
+	id            string
+	habitID       string
+	characterID   string
+	day           time.Time // Missed day (date only)
+	xpLost        int
+	levelsLost    int
+	attributeName string
+	attributeLoss int
+	appliedAt     time.Time
+}
+
+// NewHabitPenalty creates a new HabitPenalty with validation
+func NewHabitPenalty(
+	id string,
+	habitID string,
+	characterID string,
+	day time.Time,
+	xpLost int,
+	levelsLost int,
+	attributeName string,
+	attributeLoss int,
+) (*HabitPenalty, error) {
+	if id == "" {
+		return nil, fmt.Errorf("habit penalty id cannot be empty")
+	}
+	if habitID == "" {
+		return nil, fmt.Errorf("habit id cannot be empty")
+	}
+	if characterID == "" {
+		return nil, fmt.Errorf("character id cannot be empty")
+	}
+	if day.IsZero() {
+		return nil, fmt.Errorf("penalty day cannot be empty")
+	}
+	if attributeName == "" {
+		return nil, fmt.Errorf("attribute name cannot be empty")
+	}
+	if xpLost < 0 || levelsLost < 0 || attributeLoss < 0 {
+		return nil, fmt.Errorf("penalty losses cannot be negative")
+	}
+
+	return &HabitPenalty{
+		id:            id,
+		habitID:       habitID,
+		characterID:   characterID,
+		day:           time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC),
+		xpLost:        xpLost,
+		levelsLost:    levelsLost,
+		attributeName: attributeName,
+		attributeLoss: attributeLoss,
+		appliedAt:     time.Now(),
+	}, nil
+}
+
+// Getters (Read-only access to ensure encapsulation)
+
+func (hp *HabitPenalty) ID() string {
+	return hp.id
+}
+
+func (hp *HabitPenalty) HabitID() string {
+	return hp.habitID
+}
+
+func (hp *HabitPenalty) CharacterID() string {
+	return hp.characterID
+}
+
+func (hp *HabitPenalty) Day() time.Time {
+	return hp.day
+}
+
+func (hp *HabitPenalty) XpLost() int {
+	return hp.xpLost
+}
+
+func (hp *HabitPenalty) LevelsLost() int {
+	return hp.levelsLost
+}
+
+func (hp *HabitPenalty) AttributeName() string {
+	return hp.attributeName
+}
+
+func (hp *HabitPenalty) AttributeLoss() int {
+	return hp.attributeLoss
+}
+
+func (hp *HabitPenalty) AppliedAt() time.Time {
+	return hp.appliedAt
+}
+
+// ReconstituteHabitPenalty creates a HabitPenalty from existing data (for repository loading)
+func ReconstituteHabitPenalty(
+	id string,
+	habitID string,
+	characterID string,
+	day time.Time,
+	xpLost int,
+	levelsLost int,
+	attributeName string,
+	attributeLoss int,
+	appliedAt time.Time,
+) *HabitPenalty {
+	return &HabitPenalty{
+		id:            id,
+		habitID:       habitID,
+		characterID:   characterID,
+		day:           day,
+		xpLost:        xpLost,
+		levelsLost:    levelsLost,
+		attributeName: attributeName,
+		attributeLoss: attributeLoss,
+		appliedAt:     appliedAt,
+	}
+}
@@ -0,0 +1,54 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+func TestNewHabitPenalty_Valid(t *testing.T) {
+	day := time.Date(2024, 1, 10, 23, 0, 0, 0, time.FixedZone("BRT", -3*60*60))
+
+	penalty, err := entity.NewHabitPenalty("penalty-1", "habit-123", "char-456", day, 20, 1, "Força", 1)
+
+	if err != nil {
+		t.Fatalf("NewHabitPenalty() error = %v, want nil", err)
+	}
+
+	// The day is kept as a calendar date
+	if want := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC); !penalty.Day().Equal(want) {
+		t.Errorf("Day() = %v, want %v", penalty.Day(), want)
+	}
+
+	if penalty.XpLost() != 20 || penalty.LevelsLost() != 1 || penalty.AttributeLoss() != 1 {
+		t.Errorf("losses = (%v, %v, %v), want (20, 1, 1)", penalty.XpLost(), penalty.LevelsLost(), penalty.AttributeLoss())
+	}
+}
+
+func TestNewHabitPenalty_Invalid(t *testing.T) {
+	day := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		id            string
+		habitID       string
+		day           time.Time
+		xpLost        int
+		attributeName string
+	}{
+		{"empty id", "", "habit-123", day, 20, "Força"},
+		{"empty habit id", "penalty-1", "", day, 20, "Força"},
+		{"zero day", "penalty-1", "habit-123", time.Time{}, 20, "Força"},
+		{"negative xp", "penalty-1", "habit-123", day, -20, "Força"},
+		{"empty attribute", "penalty-1", "habit-123", day, 20, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := entity.NewHabitPenalty(tt.id, tt.habitID, "char-456", tt.day, tt.xpLost, 0, tt.attributeName, 1); err == nil {
+				t.Error("NewHabitPenalty() error = nil, want error")
+			}
+		})
+	}
+}
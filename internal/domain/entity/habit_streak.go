@@ -0,0 +1,199 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// CalculateStreak computes the habit's current and longest streaks relative to its schedule
+// A streak counts consecutive scheduled occurrences (days, or whole periods for N-times-per-period
+// habits) that were completed or protected by a streak freeze. The occurrence containing today
+// never breaks the streak while it is still pending. Completions and freezes of other habits are ignored.
+// For negative habits the streak counts consecutive clean days (without slips) instead.
+// The user's clock defines the day and week boundaries
+func (h *Habit) CalculateStreak(completions []*HabitCompletion, freezes []*StreakFreeze, now time.Time, clock valueobject.DayClock) valueobject.Streak {
+	// Index completions and freezes by calendar day
+	completedDays := map[string]int{}
+	for _, completion := range completions {
+		if completion.HabitID() == h.id {
+			completedDays[clock.Day(completion.CompletedAt()).Format("2006-01-02")]++
+		}
+	}
+
+	frozenDays := map[string]bool{}
+	for _, freeze := range freezes {
+		if freeze.HabitID() == h.id && freeze.IsUsed() {
+			// Frozen dates are calendar dates, no time zone conversion
+			frozenDays[freeze.FrozenDate().Format("2006-01-02")] = true
+		}
+	}
+
+	start := h.CreatedDay(clock)
+	endOfToday := clock.Today(now).AddDate(0, 0, 1)
+
+	if h.negative {
+		return calculateCleanStreak(completedDays, start, endOfToday)
+	}
+
+	recurrence := h.recurrence
+	required := 1
+	if recurrence.Type() == valueobject.RecurrenceTimesPerPeriod {
+		required = recurrence.Times()
+	}
+
+	run, longest := 0, 0
+	weekStart := clock.WeekStart()
+	for unitStart := recurrence.PeriodStart(start, weekStart); unitStart.Before(endOfToday); unitStart = recurrence.NextPeriodStart(unitStart, weekStart) {
+		// Day-based schedules only count the days the habit was due
+		if recurrence.Type() != valueobject.RecurrenceTimesPerPeriod && !recurrence.IsDueOn(unitStart, start, 0) {
+			continue
+		}
+
+		unitEnd := recurrence.NextPeriodStart(unitStart, weekStart)
+
+		count, frozen := 0, false
+		for day := unitStart; day.Before(unitEnd); day = day.AddDate(0, 0, 1) {
+			key := day.Format("2006-01-02")
+			count += completedDays[key]
+			frozen = frozen || frozenDays[key]
+		}
+
+		switch {
+		case count >= required || frozen:
+			run++
+			if run > longest {
+				longest = run
+			}
+		case unitEnd.Before(endOfToday):
+			// Missed occurrence fully in the past
+			run = 0
+		}
+	}
+
+	return valueobject.NewStreak(run, longest)
+}
+
+// calculateCleanStreak counts consecutive days without slips of a negative habit
+// Today only counts once it is over, but a slip today breaks the streak immediately
+func calculateCleanStreak(slipDays map[string]int, start time.Time, endOfToday time.Time) valueobject.Streak {
+	run, longest := 0, 0
+	for day := start; day.Before(endOfToday); day = day.AddDate(0, 0, 1) {
+		switch {
+		case slipDays[day.Format("2006-01-02")] > 0:
+			run = 0
+		case day.AddDate(0, 0, 1).Before(endOfToday):
+			run++
+			if run > longest {
+				longest = run
+			}
+		}
+	}
+
+	return valueobject.NewStreak(run, longest)
+}
+
+// StreakWithCompletionAt computes the streak as if the habit were also completed at completedAt
+// Used to find out whether a new completion extends the streak (and reaches a milestone)
+func (h *Habit) StreakWithCompletionAt(completions []*HabitCompletion, freezes []*StreakFreeze, completedAt time.Time, clock valueobject.DayClock) valueobject.Streak {
+	pending := ReconstituteHabitCompletion("", h.id, h.characterID, 0, 0, h.attributeName, 0, 0, completedAt)
+
+	withPending := make([]*HabitCompletion, 0, len(completions)+1)
+	withPending = append(withPending, completions...)
+	withPending = append(withPending, pending)
+
+	return h.CalculateStreak(withPending, freezes, completedAt, clock)
+}
+
+// IsCompletedOn reports whether the habit was completed (or, for negative habits, slipped) on day
+// The user's clock defines the day boundaries
+func (h *Habit) IsCompletedOn(day time.Time, completions []*HabitCompletion, clock valueobject.DayClock) bool {
+	day = clock.Date(day)
+	for _, completion := range completions {
+		if completion.HabitID() == h.id && clock.Day(completion.CompletedAt()).Equal(day) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsQuotaMetOn reports whether the habit can't be completed again on day
+// Day-based schedules take one completion a day; N-times-per-period habits take N completions
+// per period. Slips of negative habits are never limited. The user's clock defines the day and week boundaries
+func (h *Habit) IsQuotaMetOn(day time.Time, completions []*HabitCompletion, clock valueobject.DayClock) bool {
+	if h.negative {
+		return false
+	}
+
+	day = clock.Date(day)
+	unitStart := h.recurrence.PeriodStart(day, clock.WeekStart())
+	unitEnd := h.recurrence.NextPeriodStart(day, clock.WeekStart())
+
+	required := 1
+	if h.recurrence.Type() == valueobject.RecurrenceTimesPerPeriod {
+		required = h.recurrence.Times()
+	}
+
+	count := 0
+	for _, completion := range completions {
+		completedDay := clock.Day(completion.CompletedAt())
+		if completion.HabitID() == h.id && !completedDay.Before(unitStart) && completedDay.Before(unitEnd) {
+			count++
+		}
+	}
+
+	return count >= required
+}
+
+// WasMissedOn reports whether the habit was scheduled on day but neither completed nor protected by a streak freeze
+// For N-times-per-period habits a miss is only known on the last day of the period, when the quota wasn't met.
+// The day the habit was created (or a period it was created in) is never missed, and neither are
+// inactive or negative habits. The user's clock defines the day and week boundaries
+func (h *Habit) WasMissedOn(day time.Time, completions []*HabitCompletion, freezes []*StreakFreeze, clock valueobject.DayClock) bool {
+	if !h.active || h.negative {
+		return false
+	}
+
+	day = clock.Date(day)
+	createdDay := h.CreatedDay(clock)
+
+	recurrence := h.recurrence
+	unitStart := recurrence.PeriodStart(day, clock.WeekStart())
+	unitEnd := recurrence.NextPeriodStart(day, clock.WeekStart())
+
+	if !createdDay.Before(unitStart) {
+		return false
+	}
+
+	required := 1
+	if recurrence.Type() == valueobject.RecurrenceTimesPerPeriod {
+		// The quota can still be met until the period is over
+		if !unitEnd.Equal(day.AddDate(0, 0, 1)) {
+			return false
+		}
+		required = recurrence.Times()
+	} else if !recurrence.IsDueOn(day, createdDay, 0) {
+		return false
+	}
+
+	count := 0
+	for _, completion := range completions {
+		completedDay := clock.Day(completion.CompletedAt())
+		if completion.HabitID() == h.id && !completedDay.Before(unitStart) && completedDay.Before(unitEnd) {
+			count++
+		}
+	}
+
+	for _, freeze := range freezes {
+		if freeze.HabitID() != h.id || !freeze.IsUsed() {
+			continue
+		}
+		// Frozen dates are calendar dates, no time zone conversion
+		frozenDay := clock.Date(freeze.FrozenDate())
+		if !frozenDay.Before(unitStart) && frozenDay.Before(unitEnd) {
+			return false
+		}
+	}
+
+	return count < required
+}
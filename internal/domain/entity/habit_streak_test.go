@@ -0,0 +1,295 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// streakHabit creates a habit with the given schedule, created on Monday 2024-01-01
+func streakHabit(recurrence valueobject.Recurrence) *entity.Habit {
+	createdAt := time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+	difficulty, _ := valueobject.NewDifficulty("easy")
+	return entity.ReconstituteHabit("habit-123", "Read", "", "char-456", "Inteligência", difficulty, recurrence, false, false, true, createdAt, createdAt)
+}
+
+// completionsOn creates one completion of habit-123 at noon of each given January 2024 day
+func completionsOn(days ...int) []*entity.HabitCompletion {
+	var completions []*entity.HabitCompletion
+	for _, day := range days {
+		completions = append(completions, entity.ReconstituteHabitCompletion(
+			"comp", "habit-123", "char-456", 10, 0, "Inteligência", 1, 0,
+			time.Date(2024, 1, day, 12, 0, 0, 0, time.UTC),
+		))
+	}
+	return completions
+}
+
+func january(day int) time.Time {
+	return time.Date(2024, 1, day, 20, 0, 0, 0, time.UTC)
+}
+
+// utc is the default clock: UTC days starting at midnight, weeks starting on Monday
+var utc = valueobject.DefaultDayClock()
+
+func mustDayClock(t *testing.T, timezone string, rolloverHour int) valueobject.DayClock {
+	t.Helper()
+
+	clock, err := valueobject.NewDayClock(timezone, rolloverHour, time.Monday)
+	if err != nil {
+		t.Fatalf("NewDayClock() error = %v, want nil", err)
+	}
+	return clock
+}
+
+func TestHabit_CalculateStreak_Daily(t *testing.T) {
+	habit := streakHabit(valueobject.NewDailyRecurrence())
+
+	tests := []struct {
+		name        string
+		completions []*entity.HabitCompletion
+		today       time.Time
+		wantCurrent int
+		wantLongest int
+	}{
+		{"no completions", nil, january(5), 0, 0},
+		{"unbroken run", completionsOn(1, 2, 3, 4, 5), january(5), 5, 5},
+		{"today pending keeps streak", completionsOn(1, 2, 3, 4), january(5), 4, 4},
+		{"missed yesterday breaks streak", completionsOn(1, 2, 3), january(5), 0, 3},
+		{"new run after a break", completionsOn(1, 2, 3, 5, 6), january(6), 2, 3},
+		{"multiple completions in a day count once", completionsOn(1, 1, 2), january(2), 2, 2},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			streak := habit.CalculateStreak(tt.completions, nil, tt.today, utc)
+			if streak.Current() != tt.wantCurrent || streak.Longest() != tt.wantLongest {
+				t.Errorf("CalculateStreak() = (%v, %v), want (%v, %v)", streak.Current(), streak.Longest(), tt.wantCurrent, tt.wantLongest)
+			}
+		})
+	}
+}
+
+func TestHabit_CalculateStreak_WeeklyIgnoresUnscheduledDays(t *testing.T) {
+	monWedFri, _ := valueobject.NewRecurrence("weekly", []string{"mon", "wed", "fri"}, 0, 0, "")
+	habit := streakHabit(monWedFri)
+
+	// Mon 1, Wed 3, Fri 5, Mon 8: four scheduled days kept, Tuesday and the weekend don't count
+	streak := habit.CalculateStreak(completionsOn(1, 3, 5, 8), nil, january(9), utc)
+
+	if streak.Current() != 4 {
+		t.Errorf("Current() = %v, want %v", streak.Current(), 4)
+	}
+}
+
+func TestHabit_CalculateStreak_TimesPerPeriod(t *testing.T) {
+	threePerWeek, _ := valueobject.NewTimesPerPeriodRecurrence(3, "week")
+	habit := streakHabit(threePerWeek)
+
+	// Week 1 (Jan 1-7) met, week 2 (Jan 8-14) met, week 3 in progress with one completion
+	streak := habit.CalculateStreak(completionsOn(1, 3, 6, 8, 9, 10, 15), nil, january(16), utc)
+
+	if streak.Current() != 2 || streak.Longest() != 2 {
+		t.Errorf("CalculateStreak() = (%v, %v), want (2, 2)", streak.Current(), streak.Longest())
+	}
+
+	// Once week 3 is over without reaching the quota, the streak is broken
+	streak = habit.CalculateStreak(completionsOn(1, 3, 6, 8, 9, 10, 15), nil, january(22), utc)
+
+	if streak.Current() != 0 || streak.Longest() != 2 {
+		t.Errorf("CalculateStreak() = (%v, %v), want (0, 2)", streak.Current(), streak.Longest())
+	}
+}
+
+func TestHabit_CalculateStreak_FreezeProtectsMissedDay(t *testing.T) {
+	habit := streakHabit(valueobject.NewDailyRecurrence())
+
+	freeze, _ := entity.NewStreakFreeze("freeze-1", "char-456", "comp-1")
+	freeze.Use("habit-123", january(3))
+
+	otherFreeze, _ := entity.NewStreakFreeze("freeze-2", "char-456", "comp-2")
+	otherFreeze.Use("other-habit", january(4))
+
+	// Jan 3 is frozen, Jan 4 is missed (the freeze belongs to another habit)
+	streak := habit.CalculateStreak(completionsOn(1, 2, 5), []*entity.StreakFreeze{freeze, otherFreeze}, january(5), utc)
+
+	if streak.Current() != 1 || streak.Longest() != 3 {
+		t.Errorf("CalculateStreak() = (%v, %v), want (1, 3)", streak.Current(), streak.Longest())
+	}
+}
+
+func TestHabit_CalculateStreak_NegativeCountsCleanDays(t *testing.T) {
+	habit := streakHabit(valueobject.NewDailyRecurrence())
+	habit.MakeNegative(false)
+
+	tests := []struct {
+		name        string
+		slips       []*entity.HabitCompletion
+		today       time.Time
+		wantCurrent int
+		wantLongest int
+	}{
+		{"no slips, today still open", nil, january(5), 4, 4},
+		{"slip resets the run", completionsOn(3), january(6), 2, 2},
+		{"slip today breaks immediately", completionsOn(5), january(5), 0, 4},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			streak := habit.CalculateStreak(tt.slips, nil, tt.today, utc)
+			if streak.Current() != tt.wantCurrent || streak.Longest() != tt.wantLongest {
+				t.Errorf("CalculateStreak() = (%v, %v), want (%v, %v)", streak.Current(), streak.Longest(), tt.wantCurrent, tt.wantLongest)
+			}
+		})
+	}
+}
+
+func TestHabit_CalculateStreak_UsesUserClock(t *testing.T) {
+	habit := streakHabit(valueobject.NewDailyRecurrence())
+
+	// Completed at noon UTC from the 1st to the 8th, then at 01:30 UTC on the 10th
+	completions := append(completionsOn(1, 2, 3, 4, 5, 6, 7, 8), entity.ReconstituteHabitCompletion(
+		"comp", "habit-123", "char-456", 10, 0, "Inteligência", 1, 0,
+		time.Date(2024, 1, 10, 1, 30, 0, 0, time.UTC),
+	))
+	now := time.Date(2024, 1, 10, 2, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name        string
+		clock       valueobject.DayClock
+		wantCurrent int
+		wantLongest int
+	}{
+		// 01:30 in Lisbon is already the 10th: the 9th was missed
+		{"lisbon", mustDayClock(t, "Europe/Lisbon", 0), 1, 8},
+		// 22:30 in São Paulo is still the 9th: the streak is unbroken
+		{"sao paulo", mustDayClock(t, "America/Sao_Paulo", 0), 9, 9},
+		// A 04:00 rollover keeps the late-night completion on the 9th
+		{"lisbon with rollover", mustDayClock(t, "Europe/Lisbon", 4), 9, 9},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			streak := habit.CalculateStreak(completions, nil, now, tt.clock)
+			if streak.Current() != tt.wantCurrent || streak.Longest() != tt.wantLongest {
+				t.Errorf("CalculateStreak() = (%v, %v), want (%v, %v)", streak.Current(), streak.Longest(), tt.wantCurrent, tt.wantLongest)
+			}
+		})
+	}
+}
+
+func TestHabit_StreakWithCompletionAt(t *testing.T) {
+	habit := streakHabit(valueobject.NewDailyRecurrence())
+	history := completionsOn(1, 2, 3, 4, 5, 6)
+
+	before := habit.CalculateStreak(history, nil, january(7), utc)
+	after := habit.StreakWithCompletionAt(history, nil, january(7), utc)
+
+	if before.Current() != 6 {
+		t.Errorf("before Current() = %v, want %v", before.Current(), 6)
+	}
+
+	if after.Current() != 7 || !after.IsMilestone() {
+		t.Errorf("after Current() = %v (milestone %v), want 7 (milestone true)", after.Current(), after.IsMilestone())
+	}
+
+	// The history itself is left untouched
+	if len(history) != 6 {
+		t.Errorf("len(history) = %v, want %v", len(history), 6)
+	}
+}
+
+func TestHabit_WasMissedOn(t *testing.T) {
+	daily := streakHabit(valueobject.NewDailyRecurrence())
+	monWedFri, _ := valueobject.NewRecurrence("weekly", []string{"mon", "wed", "fri"}, 0, 0, "")
+	weekly := streakHabit(monWedFri)
+	twicePerWeek, _ := valueobject.NewTimesPerPeriodRecurrence(2, "week")
+	quota := streakHabit(twicePerWeek)
+	negative := streakHabit(valueobject.NewDailyRecurrence())
+	negative.MakeNegative(true)
+
+	frozen := entity.ReconstituteStreakFreeze("freeze-1", "char-456", "comp-1", "", time.Time{}, time.Now(), nil)
+	frozen.Use("habit-123", time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC))
+
+	tests := []struct {
+		name        string
+		habit       *entity.Habit
+		day         time.Time
+		completions []*entity.HabitCompletion
+		freezes     []*entity.StreakFreeze
+		want        bool
+	}{
+		{"daily not completed", daily, january(3), completionsOn(2), nil, true},
+		{"daily completed", daily, january(3), completionsOn(3), nil, false},
+		{"creation day is never missed", daily, january(1), nil, nil, false},
+		{"frozen day", daily, january(4), nil, []*entity.StreakFreeze{frozen}, false},
+		{"weekly unscheduled day", weekly, january(9), nil, nil, false},
+		{"weekly scheduled day", weekly, january(10), nil, nil, true},
+		{"quota pending mid-week", quota, january(10), nil, nil, false},
+		{"quota not met at week end", quota, january(14), completionsOn(9), nil, true},
+		{"quota met", quota, january(14), completionsOn(9, 12), nil, false},
+		{"negative habit", negative, january(3), nil, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.habit.WasMissedOn(tt.day, tt.completions, tt.freezes, utc); got != tt.want {
+				t.Errorf("WasMissedOn() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHabit_IsQuotaMetOn(t *testing.T) {
+	daily := streakHabit(valueobject.NewDailyRecurrence())
+	twicePerWeek, _ := valueobject.NewTimesPerPeriodRecurrence(2, "week")
+	quota := streakHabit(twicePerWeek)
+	negative := streakHabit(valueobject.NewDailyRecurrence())
+	negative.MakeNegative(true)
+
+	tests := []struct {
+		name        string
+		habit       *entity.Habit
+		day         time.Time
+		completions []*entity.HabitCompletion
+		want        bool
+	}{
+		{"daily not completed today", daily, january(3), completionsOn(2), false},
+		{"daily completed today", daily, january(3), completionsOn(3), true},
+		{"quota pending", quota, january(12), completionsOn(9), false},
+		{"quota met earlier in the week", quota, january(12), completionsOn(9, 10), true},
+		{"quota of last week", quota, january(15), completionsOn(9, 10), false},
+		{"negative habit", negative, january(3), completionsOn(3, 3), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.habit.IsQuotaMetOn(tt.day, tt.completions, utc); got != tt.want {
+				t.Errorf("IsQuotaMetOn() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHabit_IsCompletedOn(t *testing.T) {
+	habit := streakHabit(valueobject.NewDailyRecurrence())
+	lisbon := mustDayClock(t, "Europe/Lisbon", 4)
+
+	// 2024-01-03 02:00 UTC is still January 2nd in Lisbon with a 04:00 rollover
+	lateNight := []*entity.HabitCompletion{entity.ReconstituteHabitCompletion(
+		"comp", "habit-123", "char-456", 10, 0, "Inteligência", 1, 0,
+		time.Date(2024, 1, 3, 2, 0, 0, 0, time.UTC),
+	)}
+
+	if !habit.IsCompletedOn(january(3), completionsOn(3), utc) {
+		t.Error("IsCompletedOn(completed day) = false, want true")
+	}
+	if habit.IsCompletedOn(january(3), completionsOn(2, 4), utc) {
+		t.Error("IsCompletedOn(other days) = true, want false")
+	}
+	if !habit.IsCompletedOn(time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC), lateNight, lisbon) {
+		t.Error("IsCompletedOn() ignored the user's clock")
+	}
+}
@@ -0,0 +1,308 @@
+package entity_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+func newTestHabit(t *testing.T) *entity.Habit {
+	t.Helper()
+
+	difficulty, _ := valueobject.NewDifficulty("medium")
+	habit, err := entity.NewHabit(
+		"habit-123",
+		"Morning run",
+		"Run 5km before work",
+		"char-456",
+		"Constituição",
+		difficulty,
+		valueobject.NewDailyRecurrence(),
+	)
+	if err != nil {
+		t.Fatalf("NewHabit() error = %v, want nil", err)
+	}
+	return habit
+}
+
+func TestNewHabit_ValidHabit(t *testing.T) {
+	habit := newTestHabit(t)
+
+	if habit.ID() != "habit-123" {
+		t.Errorf("ID() = %v, want %v", habit.ID(), "habit-123")
+	}
+
+	if habit.Title() != "Morning run" {
+		t.Errorf("Title() = %v, want %v", habit.Title(), "Morning run")
+	}
+
+	if habit.Description() != "Run 5km before work" {
+		t.Errorf("Description() = %v, want %v", habit.Description(), "Run 5km before work")
+	}
+
+	if habit.CharacterID() != "char-456" {
+		t.Errorf("CharacterID() = %v, want %v", habit.CharacterID(), "char-456")
+	}
+
+	if habit.AttributeName() != "Constituição" {
+		t.Errorf("AttributeName() = %v, want %v", habit.AttributeName(), "Constituição")
+	}
+
+	if habit.Difficulty().Value() != "medium" {
+		t.Errorf("Difficulty() = %v, want %v", habit.Difficulty(), "medium")
+	}
+
+	if habit.Recurrence().Type() != valueobject.RecurrenceDaily {
+		t.Errorf("Recurrence() = %v, want %v", habit.Recurrence().Type(), valueobject.RecurrenceDaily)
+	}
+
+	// New habits should start active
+	if !habit.Active() {
+		t.Error("Active() = false, want true")
+	}
+
+	if habit.CreatedAt().IsZero() {
+		t.Error("CreatedAt() should not be zero")
+	}
+}
+
+func TestNewHabit_EmptyDescription(t *testing.T) {
+	difficulty, _ := valueobject.NewDifficulty("easy")
+	habit, err := entity.NewHabit("habit-123", "Read", "", "char-456", "Inteligência", difficulty, valueobject.NewDailyRecurrence())
+
+	if err != nil {
+		t.Fatalf("NewHabit() error = %v, want nil", err)
+	}
+
+	if habit.Description() != "" {
+		t.Errorf("Description() = %v, want empty", habit.Description())
+	}
+}
+
+func TestNewHabit_InvalidTitle(t *testing.T) {
+	difficulty, _ := valueobject.NewDifficulty("easy")
+
+	tests := []struct {
+		name  string
+		title string
+	}{
+		{"empty", ""},
+		{"too short", "A"},
+		{"only spaces", "   "},
+		{"too long", strings.Repeat("a", 101)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := entity.NewHabit("habit-123", tt.title, "", "char-456", "Força", difficulty, valueobject.NewDailyRecurrence())
+			if err == nil {
+				t.Error("NewHabit() error = nil, want error for invalid title")
+			}
+		})
+	}
+}
+
+func TestNewHabit_InvalidFields(t *testing.T) {
+	difficulty, _ := valueobject.NewDifficulty("easy")
+	daily := valueobject.NewDailyRecurrence()
+
+	tests := []struct {
+		name          string
+		id            string
+		description   string
+		characterID   string
+		attributeName string
+		difficulty    valueobject.Difficulty
+		recurrence    valueobject.Recurrence
+	}{
+		{"empty id", "", "", "char-456", "Força", difficulty, daily},
+		{"description too long", "habit-123", strings.Repeat("a", 501), "char-456", "Força", difficulty, daily},
+		{"empty character id", "habit-123", "", "", "Força", difficulty, daily},
+		{"empty attribute", "habit-123", "", "char-456", "  ", difficulty, daily},
+		{"zero difficulty", "habit-123", "", "char-456", "Força", valueobject.Difficulty{}, daily},
+		{"zero recurrence", "habit-123", "", "char-456", "Força", difficulty, valueobject.Recurrence{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := entity.NewHabit(tt.id, "Push-ups", tt.description, tt.characterID, tt.attributeName, tt.difficulty, tt.recurrence)
+			if err == nil {
+				t.Error("NewHabit() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestHabit_UpdateTitle(t *testing.T) {
+	habit := newTestHabit(t)
+
+	if err := habit.UpdateTitle("Evening run"); err != nil {
+		t.Fatalf("UpdateTitle() error = %v, want nil", err)
+	}
+
+	if habit.Title() != "Evening run" {
+		t.Errorf("Title() = %v, want %v", habit.Title(), "Evening run")
+	}
+}
+
+func TestHabit_UpdateTitle_Invalid(t *testing.T) {
+	habit := newTestHabit(t)
+
+	if err := habit.UpdateTitle(""); err == nil {
+		t.Error("UpdateTitle() error = nil, want error for empty title")
+	}
+
+	// Title should remain unchanged
+	if habit.Title() != "Morning run" {
+		t.Errorf("Title() = %v, want %v (should remain unchanged)", habit.Title(), "Morning run")
+	}
+}
+
+func TestHabit_LinkAttribute(t *testing.T) {
+	habit := newTestHabit(t)
+
+	if err := habit.LinkAttribute("Força"); err != nil {
+		t.Fatalf("LinkAttribute() error = %v, want nil", err)
+	}
+
+	if habit.AttributeName() != "Força" {
+		t.Errorf("AttributeName() = %v, want %v", habit.AttributeName(), "Força")
+	}
+
+	if err := habit.LinkAttribute(""); err == nil {
+		t.Error("LinkAttribute() error = nil, want error for empty attribute")
+	}
+}
+
+func TestHabit_ChangeDifficulty(t *testing.T) {
+	habit := newTestHabit(t)
+	hard, _ := valueobject.NewDifficulty("hard")
+
+	if err := habit.ChangeDifficulty(hard); err != nil {
+		t.Fatalf("ChangeDifficulty() error = %v, want nil", err)
+	}
+
+	if !habit.Difficulty().Equals(hard) {
+		t.Errorf("Difficulty() = %v, want %v", habit.Difficulty(), hard)
+	}
+
+	if err := habit.ChangeDifficulty(valueobject.Difficulty{}); err == nil {
+		t.Error("ChangeDifficulty() error = nil, want error for zero difficulty")
+	}
+}
+
+func TestHabit_ChangeRecurrence(t *testing.T) {
+	habit := newTestHabit(t)
+	everyOtherDay, _ := valueobject.NewIntervalRecurrence(2)
+
+	if err := habit.ChangeRecurrence(everyOtherDay); err != nil {
+		t.Fatalf("ChangeRecurrence() error = %v, want nil", err)
+	}
+
+	if !habit.Recurrence().Equals(everyOtherDay) {
+		t.Errorf("Recurrence() = %v, want %v", habit.Recurrence(), everyOtherDay)
+	}
+
+	if err := habit.ChangeRecurrence(valueobject.Recurrence{}); err == nil {
+		t.Error("ChangeRecurrence() error = nil, want error for zero recurrence")
+	}
+}
+
+func TestHabit_IsDueOn(t *testing.T) {
+	createdAt := time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+	difficulty, _ := valueobject.NewDifficulty("easy")
+	everyThreeDays, _ := valueobject.NewIntervalRecurrence(3)
+
+	habit := entity.ReconstituteHabit("habit-123", "Stretch", "", "char-456", "Destreza", difficulty, everyThreeDays, false, false, true, createdAt, createdAt)
+
+	if !habit.IsDueOn(time.Date(2024, 1, 4, 20, 0, 0, 0, time.UTC), 0, valueobject.DefaultDayClock()) {
+		t.Error("IsDueOn() = false three days after creation, want true")
+	}
+
+	if habit.IsDueOn(time.Date(2024, 1, 5, 20, 0, 0, 0, time.UTC), 0, valueobject.DefaultDayClock()) {
+		t.Error("IsDueOn() = true four days after creation, want false")
+	}
+
+	// Inactive habits are never due
+	habit.Deactivate()
+	if habit.IsDueOn(time.Date(2024, 1, 4, 20, 0, 0, 0, time.UTC), 0, valueobject.DefaultDayClock()) {
+		t.Error("IsDueOn() = true for inactive habit, want false")
+	}
+}
+
+func TestHabit_MakeNegative(t *testing.T) {
+	habit := newTestHabit(t)
+
+	habit.MakeNegative(true)
+	if !habit.IsNegative() || !habit.DrainsXp() {
+		t.Errorf("after MakeNegative(true) = (negative %v, drainsXp %v), want (true, true)", habit.IsNegative(), habit.DrainsXp())
+	}
+
+	// Negative habits are only logged when they happen, never due
+	if habit.IsDueOn(time.Now(), 0, valueobject.DefaultDayClock()) {
+		t.Error("IsDueOn() = true for negative habit, want false")
+	}
+
+	habit.MakePositive()
+	if habit.IsNegative() || habit.DrainsXp() {
+		t.Errorf("after MakePositive() = (negative %v, drainsXp %v), want (false, false)", habit.IsNegative(), habit.DrainsXp())
+	}
+}
+
+func TestHabit_ActivateDeactivate(t *testing.T) {
+	habit := newTestHabit(t)
+
+	habit.Deactivate()
+	if habit.Active() {
+		t.Error("Active() = true after Deactivate(), want false")
+	}
+
+	habit.Activate()
+	if !habit.Active() {
+		t.Error("Active() = false after Activate(), want true")
+	}
+}
+
+func TestReconstituteHabit(t *testing.T) {
+	createdAt := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	updatedAt := time.Date(2023, 2, 1, 0, 0, 0, 0, time.UTC)
+	difficulty, _ := valueobject.NewDifficulty("hard")
+
+	habit := entity.ReconstituteHabit(
+		"habit-123",
+		"Meditate",
+		"10 minutes",
+		"char-456",
+		"Vontade",
+		difficulty,
+		valueobject.NewDailyRecurrence(),
+		false,
+		false,
+		false,
+		createdAt,
+		updatedAt,
+	)
+
+	if habit == nil {
+		t.Fatal("ReconstituteHabit() returned nil")
+	}
+
+	if habit.ID() != "habit-123" {
+		t.Errorf("ID() = %v, want %v", habit.ID(), "habit-123")
+	}
+
+	if habit.Active() {
+		t.Error("Active() = true, want false")
+	}
+
+	if habit.CreatedAt() != createdAt {
+		t.Errorf("CreatedAt() = %v, want %v", habit.CreatedAt(), createdAt)
+	}
+
+	if habit.UpdatedAt() != updatedAt {
+		t.Errorf("UpdatedAt() = %v, want %v", habit.UpdatedAt(), updatedAt)
+	}
+}
@@ -0,0 +1,136 @@
+package entity
+
+import (
+	"maps"
+	"math/rand/v2"
+	"slices"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// monsterGrowthPercent is how much (%) a monster's attributes and XP reward grow per level above its minimum
+const monsterGrowthPercent = 10
+
+// lootRngStream separates the loot RNG from the battle RNG derived from the same seed
+const lootRngStream = 0x6c6f6f74
+
+// Monster represents an opponent of the PvE battles (Domain Entity)
+// Monsters are catalog data: they are only loaded, never created by users
+// A monster fights at the challenger's level, clamped to its level range, and grows stronger with it
+type Monster struct {
+	id         string
+	name       string
+	minLevel   int
+	maxLevel   int
+	attributes map[string]int         // Same seven attributes characters have, by attribute name, at the minimum level
+	loot       []valueobject.LootDrop // Items the monster may drop when defeated
+	xpReward   int                    // XP a character earns for defeating the monster at its minimum level
+}
+
+// ReconstituteMonster creates a Monster from existing data (for repository loading)
+func ReconstituteMonster(
+	id string,
+	name string,
+	minLevel int,
+	maxLevel int,
+	attributes map[string]int,
+	loot []valueobject.LootDrop,
+	xpReward int,
+) *Monster {
+	return &Monster{
+		id:         id,
+		name:       name,
+		minLevel:   minLevel,
+		maxLevel:   maxLevel,
+		attributes: maps.Clone(attributes),
+		loot:       slices.Clone(loot),
+		xpReward:   xpReward,
+	}
+}
+
+// Getters (Read-only access to ensure encapsulation)
+
+func (m *Monster) ID() string {
+	return m.id
+}
+
+func (m *Monster) Name() string {
+	return m.name
+}
+
+func (m *Monster) MinLevel() int {
+	return m.minLevel
+}
+
+func (m *Monster) MaxLevel() int {
+	return m.maxLevel
+}
+
+// Attributes returns a copy of the monster's attribute values at its minimum level, by attribute name
+func (m *Monster) Attributes() map[string]int {
+	return maps.Clone(m.attributes)
+}
+
+// Loot returns a copy of the monster's loot table
+func (m *Monster) Loot() []valueobject.LootDrop {
+	return slices.Clone(m.loot)
+}
+
+// XpReward returns the XP reward at the monster's minimum level
+func (m *Monster) XpReward() int {
+	return m.xpReward
+}
+
+// Business Methods
+
+// LevelFor returns the level the monster fights a challenger of the given level at (clamped to its range)
+func (m *Monster) LevelFor(challengerLevel int) int {
+	return min(max(challengerLevel, m.minLevel), m.maxLevel)
+}
+
+// AttributesAt returns the monster's attribute values at a level (clamped to its range)
+func (m *Monster) AttributesAt(level int) map[string]int {
+	level = m.LevelFor(level)
+
+	attributes := make(map[string]int, len(m.attributes))
+	for name, value := range m.attributes {
+		attributes[name] = m.scale(value, level)
+	}
+	return attributes
+}
+
+// XpRewardAt returns the XP reward for defeating the monster at a level (clamped to its range)
+func (m *Monster) XpRewardAt(level int) int {
+	return m.scale(m.xpReward, m.LevelFor(level))
+}
+
+// CombatStatsAt derives the monster's battle stats at a level with the same formulas characters use
+func (m *Monster) CombatStatsAt(level int) valueobject.CombatStats {
+	level = m.LevelFor(level)
+	return valueobject.NewCombatStats(m.AttributesAt(level), level)
+}
+
+// Combatant takes a battle snapshot of the monster scaled to the challenger's level
+func (m *Monster) Combatant(challengerLevel int) (valueobject.Combatant, error) {
+	level := m.LevelFor(challengerLevel)
+	return valueobject.NewCombatant(valueobject.CombatantMonster, m.id, m.name, level, m.CombatStatsAt(level))
+}
+
+// RollLoot rolls the monster's loot table once per item
+// Rolls are derived from the battle seed, so a battle always drops the same loot
+func (m *Monster) RollLoot(seed int64) []valueobject.LootDrop {
+	rng := rand.New(rand.NewPCG(uint64(seed), lootRngStream))
+
+	var dropped []valueobject.LootDrop
+	for _, drop := range m.loot {
+		if drop.DropsOn(rng.IntN(100) + 1) {
+			dropped = append(dropped, drop)
+		}
+	}
+	return dropped
+}
+
+// scale grows a value by monsterGrowthPercent per level above the minimum (rounded)
+func (m *Monster) scale(value int, level int) int {
+	return (value*(100+monsterGrowthPercent*(level-m.minLevel)) + 50) / 100
+}
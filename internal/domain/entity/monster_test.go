@@ -0,0 +1,127 @@
+package entity_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// newTestGoblin creates a level 2-6 goblin with a guaranteed and a rare drop
+func newTestGoblin(t *testing.T, attributes map[string]int) *entity.Monster {
+	t.Helper()
+
+	coins, err := valueobject.NewLootDrop("Moeda de Cobre", 100, 3)
+	if err != nil {
+		t.Fatalf("NewLootDrop() error = %v, want nil", err)
+	}
+	ear, err := valueobject.NewLootDrop("Orelha de Goblin", 30, 1)
+	if err != nil {
+		t.Fatalf("NewLootDrop() error = %v, want nil", err)
+	}
+
+	return entity.ReconstituteMonster("goblin", "Goblin", 2, 6, attributes, []valueobject.LootDrop{coins, ear}, 25)
+}
+
+func TestMonster_Combatant(t *testing.T) {
+	attributes := map[string]int{
+		valueobject.AttributeStrength:     5,
+		valueobject.AttributeConstitution: 4,
+	}
+	monster := newTestGoblin(t, attributes)
+
+	// The monster keeps its own copy of the attributes
+	attributes[valueobject.AttributeStrength] = 99
+	monster.Attributes()[valueobject.AttributeConstitution] = 99
+
+	want := valueobject.NewCombatStats(map[string]int{
+		valueobject.AttributeStrength:     5,
+		valueobject.AttributeConstitution: 4,
+	}, 2)
+
+	// A level 1 challenger meets the goblin at its minimum level
+	combatant, err := monster.Combatant(1)
+	if err != nil {
+		t.Fatalf("Combatant() error = %v, want nil", err)
+	}
+
+	if combatant.Kind() != valueobject.CombatantMonster || combatant.ID() != "goblin" || combatant.Level() != 2 {
+		t.Errorf("Combatant() = %+v, want the level 2 goblin", combatant)
+	}
+	if combatant.Stats() != want {
+		t.Errorf("Stats() = %+v, want %+v", combatant.Stats(), want)
+	}
+}
+
+func TestMonster_ScalesWithChallengerLevel(t *testing.T) {
+	monster := newTestGoblin(t, map[string]int{
+		valueobject.AttributeStrength:     5,
+		valueobject.AttributeConstitution: 4,
+	})
+
+	tests := []struct {
+		name             string
+		challengerLevel  int
+		wantLevel        int
+		wantStrength     int
+		wantConstitution int
+		wantXpReward     int
+	}{
+		{name: "below the range", challengerLevel: 1, wantLevel: 2, wantStrength: 5, wantConstitution: 4, wantXpReward: 25},
+		{name: "minimum level", challengerLevel: 2, wantLevel: 2, wantStrength: 5, wantConstitution: 4, wantXpReward: 25},
+		{name: "inside the range", challengerLevel: 4, wantLevel: 4, wantStrength: 6, wantConstitution: 5, wantXpReward: 30},
+		{name: "maximum level", challengerLevel: 6, wantLevel: 6, wantStrength: 7, wantConstitution: 6, wantXpReward: 35},
+		{name: "above the range", challengerLevel: 20, wantLevel: 6, wantStrength: 7, wantConstitution: 6, wantXpReward: 35},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := monster.LevelFor(tt.challengerLevel); got != tt.wantLevel {
+				t.Errorf("LevelFor() = %d, want %d", got, tt.wantLevel)
+			}
+
+			attributes := monster.AttributesAt(tt.challengerLevel)
+			if attributes[valueobject.AttributeStrength] != tt.wantStrength || attributes[valueobject.AttributeConstitution] != tt.wantConstitution {
+				t.Errorf("AttributesAt() = %v, want strength %d and constitution %d", attributes, tt.wantStrength, tt.wantConstitution)
+			}
+
+			if got := monster.XpRewardAt(tt.challengerLevel); got != tt.wantXpReward {
+				t.Errorf("XpRewardAt() = %d, want %d", got, tt.wantXpReward)
+			}
+
+			combatant, err := monster.Combatant(tt.challengerLevel)
+			if err != nil {
+				t.Fatalf("Combatant() error = %v, want nil", err)
+			}
+			if combatant.Level() != tt.wantLevel || combatant.Stats() != valueobject.NewCombatStats(attributes, tt.wantLevel) {
+				t.Errorf("Combatant() = %+v, want the scaled level %d goblin", combatant, tt.wantLevel)
+			}
+		})
+	}
+}
+
+func TestMonster_RollLoot(t *testing.T) {
+	monster := newTestGoblin(t, map[string]int{valueobject.AttributeStrength: 5})
+
+	rareDrops := 0
+	for seed := int64(1); seed <= 200; seed++ {
+		loot := monster.RollLoot(seed)
+
+		// The same seed always drops the same loot
+		if !slices.Equal(loot, monster.RollLoot(seed)) {
+			t.Fatalf("RollLoot(%d) is not deterministic", seed)
+		}
+
+		// Guaranteed drops always drop
+		if len(loot) == 0 || loot[0].Item() != "Moeda de Cobre" || loot[0].Quantity() != 3 {
+			t.Fatalf("RollLoot(%d) = %+v, want the copper coins first", seed, loot)
+		}
+		rareDrops += len(loot) - 1
+	}
+
+	// The 30% drop drops sometimes, but not always
+	if rareDrops < 30 || rareDrops > 90 {
+		t.Errorf("rare drops = %d in 200 rolls, want about 60", rareDrops)
+	}
+}
@@ -0,0 +1,264 @@
+package entity
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Raid statuses
+const (
+	RaidActive   = "active"
+	RaidDefeated = "defeated"
+	RaidExpired  = "expired"
+)
+
+// RaidContribution is the damage a character dealt to a raid boss
+type RaidContribution struct {
+	CharacterID string
+	Damage      int
+	Hits        int
+}
+
+// RaidReward is a contributor's share of a defeated boss's reward
+type RaidReward struct {
+	CharacterID string
+	Damage      int
+	Xp          int
+}
+
+// Raid represents a guild fighting a boss with its members' habit completions (Domain Entity)
+// Every completion during the raid strikes the boss; the guild has until endsAt to bring its HP to 0.
+// When the boss dies its reward is split among the contributors in proportion to the damage they dealt.
+type Raid struct {
+	id        string
+	guildID   string
+	boss      valueobject.RaidBoss
+	maxHP     int
+	hp        int
+	rewardXp  int    // XP split among the contributors when the boss dies
+	status    string // active, defeated or expired
+	startedAt time.Time
+	endsAt    time.Time
+	endedAt   *time.Time // Set once the boss is defeated or the raid expires
+}
+
+// NewRaid creates a new raid of a guild against a boss, scaled to the number of members
+func NewRaid(id string, guildID string, boss valueobject.RaidBoss, members int, startedAt time.Time) (*Raid, error) {
+	if id == "" {
+		return nil, fmt.Errorf("raid id cannot be empty")
+	}
+	if guildID == "" {
+		return nil, fmt.Errorf("guild id cannot be empty")
+	}
+	if boss.Value() == "" {
+		return nil, fmt.Errorf("raid boss cannot be empty")
+	}
+	if members < 1 {
+		return nil, fmt.Errorf("a raid needs at least one guild member")
+	}
+	if startedAt.IsZero() {
+		return nil, fmt.Errorf("start time cannot be empty")
+	}
+
+	return &Raid{
+		id:        id,
+		guildID:   guildID,
+		boss:      boss,
+		maxHP:     boss.MaxHP(members),
+		hp:        boss.MaxHP(members),
+		rewardXp:  boss.RewardXp(members),
+		status:    RaidActive,
+		startedAt: startedAt,
+		endsAt:    startedAt.Add(boss.Duration()),
+	}, nil
+}
+
+// RaidStrikeDamage returns the damage a habit completion deals to a raid boss
+// Characters strike with their best offensive stat, so every class contributes
+func RaidStrikeDamage(stats valueobject.CombatStats) int {
+	return max(stats.Attack(), stats.Magic(), 1)
+}
+
+// Getters (Read-only access to ensure encapsulation)
+
+func (r *Raid) ID() string {
+	return r.id
+}
+
+func (r *Raid) GuildID() string {
+	return r.guildID
+}
+
+func (r *Raid) Boss() valueobject.RaidBoss {
+	return r.boss
+}
+
+func (r *Raid) MaxHP() int {
+	return r.maxHP
+}
+
+func (r *Raid) HP() int {
+	return r.hp
+}
+
+func (r *Raid) RewardXp() int {
+	return r.rewardXp
+}
+
+func (r *Raid) Status() string {
+	return r.status
+}
+
+func (r *Raid) StartedAt() time.Time {
+	return r.startedAt
+}
+
+func (r *Raid) EndsAt() time.Time {
+	return r.endsAt
+}
+
+func (r *Raid) EndedAt() *time.Time {
+	return r.endedAt
+}
+
+// Business Methods
+
+// IsActive reports whether the boss is still being fought
+func (r *Raid) IsActive() bool {
+	return r.status == RaidActive
+}
+
+// IsOverdueAt reports whether an active raid ran out of time at the given time (it should be expired)
+func (r *Raid) IsOverdueAt(at time.Time) bool {
+	return r.IsActive() && !at.Before(r.endsAt)
+}
+
+// Strike deals damage to the boss; the boss dies when its HP reaches 0
+// Returns the damage actually dealt (never more than the HP left)
+func (r *Raid) Strike(damage int, at time.Time) (int, error) {
+	if !r.IsActive() {
+		return 0, fmt.Errorf("raid is already over")
+	}
+	if r.IsOverdueAt(at) {
+		return 0, fmt.Errorf("raid ran out of time")
+	}
+	if damage <= 0 {
+		return 0, fmt.Errorf("raid damage must be positive")
+	}
+
+	dealt := min(damage, r.hp)
+	r.hp -= dealt
+	if r.hp == 0 {
+		r.status = RaidDefeated
+		r.endedAt = &at
+	}
+	return dealt, nil
+}
+
+// Heal gives back damage to a boss still being fought (when the completion that dealt it is undone)
+func (r *Raid) Heal(damage int) error {
+	if !r.IsActive() {
+		return fmt.Errorf("raid is already over")
+	}
+	if damage < 0 {
+		return fmt.Errorf("healed damage cannot be negative")
+	}
+
+	r.hp = min(r.hp+damage, r.maxHP)
+	return nil
+}
+
+// Expire ends a raid that ran out of time without defeating the boss (no reward is given)
+func (r *Raid) Expire() error {
+	if !r.IsActive() {
+		return fmt.Errorf("raid is already over")
+	}
+
+	endsAt := r.endsAt
+	r.status = RaidExpired
+	r.endedAt = &endsAt
+	return nil
+}
+
+// Rewards splits the reward among the contributors in proportion to the damage they dealt
+// The XP lost to rounding goes to the largest remainders, so the whole reward is handed out
+// Rewards are sorted by damage (most first)
+func (r *Raid) Rewards(contributions []RaidContribution) []RaidReward {
+	totalDamage := 0
+	for _, contribution := range contributions {
+		totalDamage += max(contribution.Damage, 0)
+	}
+	if totalDamage == 0 {
+		return nil
+	}
+
+	type share struct {
+		reward    RaidReward
+		remainder int
+	}
+	shares := make([]share, 0, len(contributions))
+	handedOut := 0
+	for _, contribution := range contributions {
+		if contribution.Damage <= 0 {
+			continue
+		}
+		xp := r.rewardXp * contribution.Damage
+		shares = append(shares, share{
+			reward:    RaidReward{CharacterID: contribution.CharacterID, Damage: contribution.Damage, Xp: xp / totalDamage},
+			remainder: xp % totalDamage,
+		})
+		handedOut += xp / totalDamage
+	}
+
+	// Ties are broken by damage, then by character, so the split is deterministic
+	slices.SortFunc(shares, func(a, b share) int {
+		return cmp.Or(
+			cmp.Compare(b.remainder, a.remainder),
+			cmp.Compare(b.reward.Damage, a.reward.Damage),
+			cmp.Compare(a.reward.CharacterID, b.reward.CharacterID),
+		)
+	})
+	for i := 0; i < r.rewardXp-handedOut; i++ {
+		shares[i].reward.Xp++
+	}
+
+	rewards := make([]RaidReward, len(shares))
+	for i, share := range shares {
+		rewards[i] = share.reward
+	}
+	slices.SortFunc(rewards, func(a, b RaidReward) int {
+		return cmp.Or(cmp.Compare(b.Damage, a.Damage), cmp.Compare(a.CharacterID, b.CharacterID))
+	})
+	return rewards
+}
+
+// ReconstituteRaid creates a Raid from existing data (for repository loading)
+func ReconstituteRaid(
+	id string,
+	guildID string,
+	boss valueobject.RaidBoss,
+	maxHP int,
+	hp int,
+	rewardXp int,
+	status string,
+	startedAt time.Time,
+	endsAt time.Time,
+	endedAt *time.Time,
+) *Raid {
+	return &Raid{
+		id:        id,
+		guildID:   guildID,
+		boss:      boss,
+		maxHP:     maxHP,
+		hp:        hp,
+		rewardXp:  rewardXp,
+		status:    status,
+		startedAt: startedAt,
+		endsAt:    endsAt,
+		endedAt:   endedAt,
+	}
+}
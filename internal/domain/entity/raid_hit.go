@@ -0,0 +1,99 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// RaidHit represents the damage one habit completion dealt to a raid boss (Domain Entity)
+// Hits are keyed by completion, so undoing the completion can take its damage back
+// A habit strikes a raid at most once per day of its user, whatever the number of completions
+type RaidHit struct {
+	completionID string
+	raidID       string
+	characterID  string
+	habitID      string    // Empty for hits recorded before hits were tracked per habit
+	day          time.Time // Calendar date in the user's clock (zero with habitID)
+	damage       int
+	hitAt        time.Time
+}
+
+// NewRaidHit creates a new RaidHit with validation
+// day is the calendar day of the completion in its user's clock
+func NewRaidHit(completionID string, raidID string, characterID string, habitID string, day time.Time, damage int, hitAt time.Time) (*RaidHit, error) {
+	if completionID == "" {
+		return nil, fmt.Errorf("completion id cannot be empty")
+	}
+	if raidID == "" {
+		return nil, fmt.Errorf("raid id cannot be empty")
+	}
+	if characterID == "" {
+		return nil, fmt.Errorf("character id cannot be empty")
+	}
+	if habitID == "" {
+		return nil, fmt.Errorf("habit id cannot be empty")
+	}
+	if day.IsZero() {
+		return nil, fmt.Errorf("hit day cannot be empty")
+	}
+	if damage <= 0 {
+		return nil, fmt.Errorf("raid hit damage must be positive")
+	}
+	if hitAt.IsZero() {
+		return nil, fmt.Errorf("hit time cannot be empty")
+	}
+
+	return &RaidHit{
+		completionID: completionID,
+		raidID:       raidID,
+		characterID:  characterID,
+		habitID:      habitID,
+		day:          time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC),
+		damage:       damage,
+		hitAt:        hitAt,
+	}, nil
+}
+
+// Getters (Read-only access to ensure encapsulation)
+
+func (h *RaidHit) CompletionID() string {
+	return h.completionID
+}
+
+func (h *RaidHit) RaidID() string {
+	return h.raidID
+}
+
+func (h *RaidHit) CharacterID() string {
+	return h.characterID
+}
+
+func (h *RaidHit) HabitID() string {
+	return h.habitID
+}
+
+// Day returns the calendar date the hit counts for (a date, no time zone conversion)
+func (h *RaidHit) Day() time.Time {
+	return h.day
+}
+
+func (h *RaidHit) Damage() int {
+	return h.damage
+}
+
+func (h *RaidHit) HitAt() time.Time {
+	return h.hitAt
+}
+
+// ReconstituteRaidHit creates a RaidHit from existing data (for repository loading)
+func ReconstituteRaidHit(completionID string, raidID string, characterID string, habitID string, day time.Time, damage int, hitAt time.Time) *RaidHit {
+	return &RaidHit{
+		completionID: completionID,
+		raidID:       raidID,
+		characterID:  characterID,
+		habitID:      habitID,
+		day:          day,
+		damage:       damage,
+		hitAt:        hitAt,
+	}
+}
@@ -0,0 +1,201 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+var raidStartedAt = time.Date(2024, 3, 1, 18, 0, 0, 0, time.UTC)
+
+// newTestRaid creates a raid of a two-member guild against the swamp hydra (600 HP, 300 XP)
+func newTestRaid(t *testing.T) *entity.Raid {
+	t.Helper()
+
+	boss, err := valueobject.NewRaidBoss(valueobject.RaidBossSwampHydra)
+	if err != nil {
+		t.Fatalf("NewRaidBoss() error = %v, want nil", err)
+	}
+	raid, err := entity.NewRaid("raid-1", "guild-1", boss, 2, raidStartedAt)
+	if err != nil {
+		t.Fatalf("NewRaid() error = %v, want nil", err)
+	}
+	return raid
+}
+
+func TestNewRaid_ScalesWithGuild(t *testing.T) {
+	raid := newTestRaid(t)
+
+	if raid.MaxHP() != 600 || raid.HP() != 600 || raid.RewardXp() != 300 {
+		t.Errorf("raid = %d/%d HP for %d XP, want 600/600 HP for 300 XP", raid.HP(), raid.MaxHP(), raid.RewardXp())
+	}
+	if !raid.IsActive() || !raid.EndsAt().Equal(raidStartedAt.Add(3*24*time.Hour)) || raid.EndedAt() != nil {
+		t.Errorf("raid should be active for the boss's 3 days")
+	}
+
+	boss, _ := valueobject.NewRaidBoss(valueobject.RaidBossSwampHydra)
+	if _, err := entity.NewRaid("raid-2", "guild-1", boss, 0, raidStartedAt); err == nil {
+		t.Error("NewRaid() without members error = nil, want error")
+	}
+}
+
+func TestRaid_Strike(t *testing.T) {
+	raid := newTestRaid(t)
+	at := raidStartedAt.Add(time.Hour)
+
+	dealt, err := raid.Strike(250, at)
+	if err != nil || dealt != 250 || raid.HP() != 350 {
+		t.Fatalf("Strike(250) = %d, %v; HP = %d, want 250 dealt and 350 HP left", dealt, err, raid.HP())
+	}
+
+	// The killing blow only deals the HP left
+	dealt, err = raid.Strike(400, at)
+	if err != nil || dealt != 350 {
+		t.Fatalf("Strike(400) = %d, %v, want 350 dealt", dealt, err)
+	}
+	if raid.Status() != entity.RaidDefeated || raid.HP() != 0 || raid.EndedAt() == nil || !raid.EndedAt().Equal(at) {
+		t.Errorf("raid = %s with %d HP, want the boss defeated", raid.Status(), raid.HP())
+	}
+
+	if _, err := raid.Strike(10, at); err == nil {
+		t.Error("Strike() on a defeated boss error = nil, want error")
+	}
+	if err := raid.Heal(10); err == nil {
+		t.Error("Heal() on a defeated boss error = nil, want error")
+	}
+}
+
+func TestRaid_Strike_Invalid(t *testing.T) {
+	raid := newTestRaid(t)
+
+	if _, err := raid.Strike(0, raidStartedAt); err == nil {
+		t.Error("Strike(0) error = nil, want error")
+	}
+	if _, err := raid.Strike(10, raid.EndsAt()); err == nil {
+		t.Error("Strike() after the deadline error = nil, want error")
+	}
+	if raid.HP() != raid.MaxHP() {
+		t.Errorf("HP() = %d, want the boss untouched", raid.HP())
+	}
+}
+
+func TestRaid_Heal(t *testing.T) {
+	raid := newTestRaid(t)
+	raid.Strike(100, raidStartedAt)
+
+	if err := raid.Heal(60); err != nil || raid.HP() != 560 {
+		t.Errorf("Heal(60) = %v; HP = %d, want 560", err, raid.HP())
+	}
+	if err := raid.Heal(500); err != nil || raid.HP() != 600 {
+		t.Errorf("Heal(500) = %v; HP = %d, want capped at 600", err, raid.HP())
+	}
+}
+
+func TestRaid_Expire(t *testing.T) {
+	raid := newTestRaid(t)
+
+	if raid.IsOverdueAt(raid.EndsAt().Add(-time.Second)) || !raid.IsOverdueAt(raid.EndsAt()) {
+		t.Error("the raid should be overdue from its deadline on")
+	}
+
+	if err := raid.Expire(); err != nil {
+		t.Fatalf("Expire() error = %v, want nil", err)
+	}
+	if raid.Status() != entity.RaidExpired || raid.EndedAt() == nil || !raid.EndedAt().Equal(raid.EndsAt()) || raid.IsOverdueAt(raid.EndsAt()) {
+		t.Errorf("raid = %s, want expired at its deadline", raid.Status())
+	}
+	if err := raid.Expire(); err == nil {
+		t.Error("Expire() twice error = nil, want error")
+	}
+}
+
+func TestRaid_Rewards(t *testing.T) {
+	raid := newTestRaid(t) // 300 XP
+
+	rewards := raid.Rewards([]entity.RaidContribution{
+		{CharacterID: "char-c", Damage: 100, Hits: 2},
+		{CharacterID: "char-a", Damage: 300, Hits: 5},
+		{CharacterID: "char-b", Damage: 100, Hits: 1},
+		{CharacterID: "char-d", Damage: 0},
+	})
+
+	// 300 XP split 3:1:1 is 180, 60 and 60
+	want := []entity.RaidReward{
+		{CharacterID: "char-a", Damage: 300, Xp: 180},
+		{CharacterID: "char-b", Damage: 100, Xp: 60},
+		{CharacterID: "char-c", Damage: 100, Xp: 60},
+	}
+	if len(rewards) != len(want) {
+		t.Fatalf("Rewards() = %+v, want %+v", rewards, want)
+	}
+	for i := range want {
+		if rewards[i] != want[i] {
+			t.Errorf("Rewards()[%d] = %+v, want %+v", i, rewards[i], want[i])
+		}
+	}
+}
+
+func TestRaid_Rewards_HandsOutRoundedXp(t *testing.T) {
+	raid := newTestRaid(t) // 300 XP
+
+	// Three equal contributors of 100 XP each, plus one that dealt a sliver
+	rewards := raid.Rewards([]entity.RaidContribution{
+		{CharacterID: "char-a", Damage: 199},
+		{CharacterID: "char-b", Damage: 199},
+		{CharacterID: "char-c", Damage: 199},
+		{CharacterID: "char-d", Damage: 3},
+	})
+
+	total := 0
+	for _, reward := range rewards {
+		total += reward.Xp
+	}
+	if total != 300 {
+		t.Errorf("total XP = %d, want the whole 300 handed out (rewards: %+v)", total, rewards)
+	}
+	if rewards[0].Xp != 100 || rewards[3].CharacterID != "char-d" || rewards[3].Xp != 1 {
+		t.Errorf("Rewards() = %+v, want 99.5 rounded up for the top contributors and 1 for char-d", rewards)
+	}
+
+	if rewards := raid.Rewards(nil); rewards != nil {
+		t.Errorf("Rewards(nil) = %+v, want nil", rewards)
+	}
+}
+
+func TestRaidStrikeDamage(t *testing.T) {
+	warrior := valueobject.NewCombatStats(map[string]int{valueobject.AttributeStrength: 10}, 1)
+	mage := valueobject.NewCombatStats(map[string]int{valueobject.AttributeIntelligence: 10}, 1)
+
+	if got := entity.RaidStrikeDamage(warrior); got != warrior.Attack() {
+		t.Errorf("RaidStrikeDamage(warrior) = %d, want its attack %d", got, warrior.Attack())
+	}
+	if got := entity.RaidStrikeDamage(mage); got != mage.Magic() {
+		t.Errorf("RaidStrikeDamage(mage) = %d, want its magic %d", got, mage.Magic())
+	}
+}
+
+func TestNewRaidHit(t *testing.T) {
+	hit, err := entity.NewRaidHit("completion-1", "raid-1", "char-123", "habit-1", raidStartedAt, 25, raidStartedAt)
+	if err != nil {
+		t.Fatalf("NewRaidHit() error = %v, want nil", err)
+	}
+	if hit.CompletionID() != "completion-1" || hit.HabitID() != "habit-1" || hit.Damage() != 25 {
+		t.Errorf("hit = %+v, want 25 damage by completion-1 of habit-1", hit)
+	}
+	day := time.Date(raidStartedAt.Year(), raidStartedAt.Month(), raidStartedAt.Day(), 0, 0, 0, 0, time.UTC)
+	if !hit.Day().Equal(day) {
+		t.Errorf("Day() = %v, want %v", hit.Day(), day)
+	}
+
+	if _, err := entity.NewRaidHit("completion-1", "raid-1", "char-123", "habit-1", raidStartedAt, 0, raidStartedAt); err == nil {
+		t.Error("NewRaidHit() without damage error = nil, want error")
+	}
+	if _, err := entity.NewRaidHit("completion-1", "raid-1", "char-123", "", raidStartedAt, 25, raidStartedAt); err == nil {
+		t.Error("NewRaidHit() without habit error = nil, want error")
+	}
+	if _, err := entity.NewRaidHit("completion-1", "raid-1", "char-123", "habit-1", time.Time{}, 25, raidStartedAt); err == nil {
+		t.Error("NewRaidHit() without day error = nil, want error")
+	}
+}
@@ -0,0 +1,127 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// RatingChange represents one entry of a character's rating history (Domain Entity)
+// Every ranked battle records the rating before and after it, so the rating's evolution can be shown
+type RatingChange struct {
+	id                  int64 // Assigned by the database, 0 until persisted
+	characterID         string
+	battleID            string
+	opponentCharacterID string
+	score               float64 // 1 for a win, 0.5 for a draw, 0 for a loss
+	before              valueobject.GlickoRating
+	after               valueobject.GlickoRating
+	createdAt           time.Time
+}
+
+// NewRatingChange creates a new RatingChange with validation
+func NewRatingChange(
+	characterID string,
+	battleID string,
+	opponentCharacterID string,
+	score float64,
+	before valueobject.GlickoRating,
+	after valueobject.GlickoRating,
+	createdAt time.Time,
+) (*RatingChange, error) {
+	if characterID == "" {
+		return nil, fmt.Errorf("character id cannot be empty")
+	}
+	if battleID == "" {
+		return nil, fmt.Errorf("battle id cannot be empty")
+	}
+	if opponentCharacterID == "" {
+		return nil, fmt.Errorf("opponent character id cannot be empty")
+	}
+	if !isRatingScore(score) {
+		return nil, fmt.Errorf("invalid rating score: %g", score)
+	}
+	if createdAt.IsZero() {
+		return nil, fmt.Errorf("rating change time cannot be empty")
+	}
+
+	return &RatingChange{
+		characterID:         characterID,
+		battleID:            battleID,
+		opponentCharacterID: opponentCharacterID,
+		score:               score,
+		before:              before,
+		after:               after,
+		createdAt:           createdAt,
+	}, nil
+}
+
+// Getters (Read-only access to ensure encapsulation)
+
+func (rc *RatingChange) ID() int64 {
+	return rc.id
+}
+
+func (rc *RatingChange) CharacterID() string {
+	return rc.characterID
+}
+
+func (rc *RatingChange) BattleID() string {
+	return rc.battleID
+}
+
+func (rc *RatingChange) OpponentCharacterID() string {
+	return rc.opponentCharacterID
+}
+
+func (rc *RatingChange) Score() float64 {
+	return rc.score
+}
+
+func (rc *RatingChange) Before() valueobject.GlickoRating {
+	return rc.before
+}
+
+func (rc *RatingChange) After() valueobject.GlickoRating {
+	return rc.after
+}
+
+func (rc *RatingChange) CreatedAt() time.Time {
+	return rc.createdAt
+}
+
+// Business Methods
+
+// Delta returns the rating gained (negative when rating was lost)
+func (rc *RatingChange) Delta() float64 {
+	return rc.after.Rating() - rc.before.Rating()
+}
+
+// isRatingScore reports whether the score is a win, a draw or a loss
+func isRatingScore(score float64) bool {
+	return score == valueobject.RatingScoreWin || score == valueobject.RatingScoreDraw || score == valueobject.RatingScoreLoss
+}
+
+// ReconstituteRatingChange creates a RatingChange from existing data (for repository loading)
+func ReconstituteRatingChange(
+	id int64,
+	characterID string,
+	battleID string,
+	opponentCharacterID string,
+	score float64,
+	before valueobject.GlickoRating,
+	after valueobject.GlickoRating,
+	createdAt time.Time,
+) *RatingChange {
+	return &RatingChange{
+		id:                  id,
+		characterID:         characterID,
+		battleID:            battleID,
+		opponentCharacterID: opponentCharacterID,
+		score:               score,
+		before:              before,
+		after:               after,
+		createdAt:           createdAt,
+	}
+}
@@ -0,0 +1,115 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// StreakFreeze represents a token that protects a habit streak for one missed day (Domain Entity)
+// Tokens are earned by the character and, once used, are bound to a habit and a day
+type StreakFreeze struct {
+	id           string
+	characterID  string
+	completionID string    // Habit completion that earned the token (empty for legacy tokens)
+	habitID      string    // Empty while the token is available
+	frozenDate   time.Time // Protected day (date only), zero while the token is available
+	earnedAt     time.Time
+	usedAt       *time.Time
+}
+
+// NewStreakFreeze creates a new, available StreakFreeze token earned by a habit completion with validation
+func NewStreakFreeze(id string, characterID string, completionID string) (*StreakFreeze, error) {
+	if id == "" {
+		return nil, fmt.Errorf("streak freeze id cannot be empty")
+	}
+	if characterID == "" {
+		return nil, fmt.Errorf("character id cannot be empty")
+	}
+	if completionID == "" {
+		return nil, fmt.Errorf("habit completion id cannot be empty")
+	}
+
+	return &StreakFreeze{
+		id:           id,
+		characterID:  characterID,
+		completionID: completionID,
+		earnedAt:     time.Now(),
+	}, nil
+}
+
+// Getters (Read-only access to ensure encapsulation)
+
+func (sf *StreakFreeze) ID() string {
+	return sf.id
+}
+
+func (sf *StreakFreeze) CharacterID() string {
+	return sf.characterID
+}
+
+func (sf *StreakFreeze) CompletionID() string {
+	return sf.completionID
+}
+
+func (sf *StreakFreeze) HabitID() string {
+	return sf.habitID
+}
+
+func (sf *StreakFreeze) FrozenDate() time.Time {
+	return sf.frozenDate
+}
+
+func (sf *StreakFreeze) EarnedAt() time.Time {
+	return sf.earnedAt
+}
+
+func (sf *StreakFreeze) UsedAt() *time.Time {
+	return sf.usedAt
+}
+
+// Business Methods
+
+// IsUsed reports whether the token was already spent
+func (sf *StreakFreeze) IsUsed() bool {
+	return sf.usedAt != nil
+}
+
+// Use spends the token to protect the habit's streak on the given day
+func (sf *StreakFreeze) Use(habitID string, day time.Time) error {
+	if sf.IsUsed() {
+		return fmt.Errorf("streak freeze was already used")
+	}
+	if habitID == "" {
+		return fmt.Errorf("habit id cannot be empty")
+	}
+	if day.IsZero() {
+		return fmt.Errorf("frozen date cannot be empty")
+	}
+
+	now := time.Now()
+	sf.habitID = habitID
+	sf.frozenDate = time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.UTC)
+	sf.usedAt = &now
+	return nil
+}
+
+// ReconstituteStreakFreeze creates a StreakFreeze from existing data (for repository loading)
+func ReconstituteStreakFreeze(
+	id string,
+	characterID string,
+	completionID string,
+	habitID string,
+	frozenDate time.Time,
+	earnedAt time.Time,
+	usedAt *time.Time,
+) *StreakFreeze {
+	return &StreakFreeze{
+		id:           id,
+		characterID:  characterID,
+		completionID: completionID,
+		habitID:      habitID,
+		frozenDate:   frozenDate,
+		earnedAt:     earnedAt,
+		usedAt:       usedAt,
+	}
+}
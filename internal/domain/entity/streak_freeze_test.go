@@ -0,0 +1,88 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+func TestNewStreakFreeze_Valid(t *testing.T) {
+	freeze, err := entity.NewStreakFreeze("freeze-1", "char-456", "comp-1")
+
+	if err != nil {
+		t.Fatalf("NewStreakFreeze() error = %v, want nil", err)
+	}
+
+	if freeze.IsUsed() {
+		t.Error("IsUsed() = true, want false for a new token")
+	}
+
+	if freeze.HabitID() != "" {
+		t.Errorf("HabitID() = %v, want empty", freeze.HabitID())
+	}
+
+	if freeze.CompletionID() != "comp-1" {
+		t.Errorf("CompletionID() = %v, want %v", freeze.CompletionID(), "comp-1")
+	}
+
+	if freeze.EarnedAt().IsZero() {
+		t.Error("EarnedAt() should not be zero")
+	}
+}
+
+func TestNewStreakFreeze_Invalid(t *testing.T) {
+	if _, err := entity.NewStreakFreeze("", "char-456", "comp-1"); err == nil {
+		t.Error("NewStreakFreeze() error = nil, want error for empty id")
+	}
+
+	if _, err := entity.NewStreakFreeze("freeze-1", "", "comp-1"); err == nil {
+		t.Error("NewStreakFreeze() error = nil, want error for empty character id")
+	}
+
+	if _, err := entity.NewStreakFreeze("freeze-1", "char-456", ""); err == nil {
+		t.Error("NewStreakFreeze() error = nil, want error for empty completion id")
+	}
+}
+
+func TestStreakFreeze_Use(t *testing.T) {
+	freeze, _ := entity.NewStreakFreeze("freeze-1", "char-456", "comp-1")
+	day := time.Date(2024, 1, 10, 15, 0, 0, 0, time.UTC)
+
+	if err := freeze.Use("habit-123", day); err != nil {
+		t.Fatalf("Use() error = %v, want nil", err)
+	}
+
+	if !freeze.IsUsed() {
+		t.Error("IsUsed() = false after Use(), want true")
+	}
+
+	if freeze.HabitID() != "habit-123" {
+		t.Errorf("HabitID() = %v, want %v", freeze.HabitID(), "habit-123")
+	}
+
+	if want := time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC); !freeze.FrozenDate().Equal(want) {
+		t.Errorf("FrozenDate() = %v, want %v", freeze.FrozenDate(), want)
+	}
+
+	// A token can only be spent once
+	if err := freeze.Use("habit-456", day); err == nil {
+		t.Error("Use() error = nil, want error for used token")
+	}
+}
+
+func TestStreakFreeze_Use_Invalid(t *testing.T) {
+	freeze, _ := entity.NewStreakFreeze("freeze-1", "char-456", "comp-1")
+
+	if err := freeze.Use("", time.Now()); err == nil {
+		t.Error("Use() error = nil, want error for empty habit id")
+	}
+
+	if err := freeze.Use("habit-123", time.Time{}); err == nil {
+		t.Error("Use() error = nil, want error for zero day")
+	}
+
+	if freeze.IsUsed() {
+		t.Error("IsUsed() = true after failed Use(), want false")
+	}
+}
@@ -0,0 +1,287 @@
+package entity
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// Task statuses
+const (
+	TaskOpen      = "open"
+	TaskCompleted = "completed"
+)
+
+// maxTaskChecklistItems caps the checklist of a single task
+const maxTaskChecklistItems = 50
+
+// Task represents a one-off to-do of a character (Domain Entity)
+// Unlike habits, a task is done once: completing it awards XP and reopening it takes the XP back
+type Task struct {
+	id          string
+	characterID string
+	title       string
+	description string
+	difficulty  valueobject.Difficulty // Defines the XP reward
+	priority    valueobject.Priority
+	dueDate     *time.Time // Optional calendar date (stored as UTC midnight)
+	checklist   []*TaskChecklistItem
+	completedAt *time.Time
+	xpAwarded   int // Filled while the task is completed
+	createdAt   time.Time
+	updatedAt   time.Time
+}
+
+// TaskChecklistItem represents a step of a task's checklist
+type TaskChecklistItem struct {
+	id    string
+	title string
+	done  bool
+}
+
+// NewTask creates a new, open Task entity with validation
+func NewTask(
+	id string,
+	characterID string,
+	title string,
+	description string,
+	difficulty valueobject.Difficulty,
+	priority valueobject.Priority,
+	dueDate *time.Time,
+	checklist []*TaskChecklistItem,
+) (*Task, error) {
+	// Validate IDs
+	if id == "" {
+		return nil, fmt.Errorf("task id cannot be empty")
+	}
+	if characterID == "" {
+		return nil, fmt.Errorf("character id cannot be empty")
+	}
+
+	// Validate title and description
+	title = strings.TrimSpace(title)
+	if len(title) < 2 {
+		return nil, fmt.Errorf("task title must be at least 2 characters")
+	}
+	if len(title) > 100 {
+		return nil, fmt.Errorf("task title cannot exceed 100 characters")
+	}
+
+	description = strings.TrimSpace(description)
+	if len(description) > 500 {
+		return nil, fmt.Errorf("task description cannot exceed 500 characters")
+	}
+
+	// Validate value objects (zero value means they were never validated)
+	if difficulty.Value() == "" {
+		return nil, fmt.Errorf("difficulty cannot be empty")
+	}
+	if priority.Value() == "" {
+		return nil, fmt.Errorf("priority cannot be empty")
+	}
+
+	// Validate checklist
+	if len(checklist) > maxTaskChecklistItems {
+		return nil, fmt.Errorf("task checklist cannot exceed %d items", maxTaskChecklistItems)
+	}
+
+	// Due dates are calendar dates
+	if dueDate != nil {
+		day := time.Date(dueDate.Year(), dueDate.Month(), dueDate.Day(), 0, 0, 0, 0, time.UTC)
+		dueDate = &day
+	}
+
+	now := time.Now()
+
+	return &Task{
+		id:          id,
+		characterID: characterID,
+		title:       title,
+		description: description,
+		difficulty:  difficulty,
+		priority:    priority,
+		dueDate:     dueDate,
+		checklist:   checklist,
+		createdAt:   now,
+		updatedAt:   now,
+	}, nil
+}
+
+// NewTaskChecklistItem creates a new, unchecked TaskChecklistItem with validation
+func NewTaskChecklistItem(id string, title string) (*TaskChecklistItem, error) {
+	if id == "" {
+		return nil, fmt.Errorf("checklist item id cannot be empty")
+	}
+
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return nil, fmt.Errorf("checklist item title cannot be empty")
+	}
+	if len(title) > 100 {
+		return nil, fmt.Errorf("checklist item title cannot exceed 100 characters")
+	}
+
+	return &TaskChecklistItem{id: id, title: title}, nil
+}
+
+// Getters (Read-only access to ensure encapsulation)
+
+func (t *Task) ID() string {
+	return t.id
+}
+
+func (t *Task) CharacterID() string {
+	return t.characterID
+}
+
+func (t *Task) Title() string {
+	return t.title
+}
+
+func (t *Task) Description() string {
+	return t.description
+}
+
+func (t *Task) Difficulty() valueobject.Difficulty {
+	return t.difficulty
+}
+
+func (t *Task) Priority() valueobject.Priority {
+	return t.priority
+}
+
+func (t *Task) DueDate() *time.Time {
+	return t.dueDate
+}
+
+func (t *Task) Checklist() []*TaskChecklistItem {
+	return t.checklist
+}
+
+func (t *Task) CompletedAt() *time.Time {
+	return t.completedAt
+}
+
+func (t *Task) XpAwarded() int {
+	return t.xpAwarded
+}
+
+func (t *Task) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+func (t *Task) UpdatedAt() time.Time {
+	return t.updatedAt
+}
+
+func (i *TaskChecklistItem) ID() string {
+	return i.id
+}
+
+func (i *TaskChecklistItem) Title() string {
+	return i.title
+}
+
+func (i *TaskChecklistItem) Done() bool {
+	return i.done
+}
+
+// Business Methods
+
+// Status returns the current status of the task (open or completed)
+func (t *Task) Status() string {
+	if t.completedAt != nil {
+		return TaskCompleted
+	}
+	return TaskOpen
+}
+
+// IsCompleted reports whether the task was completed
+func (t *Task) IsCompleted() bool {
+	return t.completedAt != nil
+}
+
+// IsOverdue reports whether the task is still open after its due date
+// today is the user's current calendar day (only its date is used)
+func (t *Task) IsOverdue(today time.Time) bool {
+	if t.IsCompleted() || t.dueDate == nil {
+		return false
+	}
+	return t.dueDate.Before(time.Date(today.Year(), today.Month(), today.Day(), 0, 0, 0, 0, time.UTC))
+}
+
+// Complete marks the task as done at the given time and records its XP reward
+func (t *Task) Complete(at time.Time) error {
+	if t.IsCompleted() {
+		return fmt.Errorf("task was already completed")
+	}
+
+	t.completedAt = &at
+	t.xpAwarded = t.difficulty.XpReward()
+	t.updatedAt = time.Now()
+	return nil
+}
+
+// Reopen marks a completed task as open again
+// Returns the XP the completion had awarded, so it can be taken back
+func (t *Task) Reopen() (int, error) {
+	if !t.IsCompleted() {
+		return 0, fmt.Errorf("task is not completed")
+	}
+
+	xp := t.xpAwarded
+	t.completedAt = nil
+	t.xpAwarded = 0
+	t.updatedAt = time.Now()
+	return xp, nil
+}
+
+// CheckItem marks a checklist item as done (or not done)
+func (t *Task) CheckItem(itemID string, done bool) error {
+	for _, item := range t.checklist {
+		if item.id == itemID {
+			item.done = done
+			t.updatedAt = time.Now()
+			return nil
+		}
+	}
+	return fmt.Errorf("checklist item not found")
+}
+
+// ReconstituteTask creates a Task from existing data (for repository loading)
+func ReconstituteTask(
+	id string,
+	characterID string,
+	title string,
+	description string,
+	difficulty valueobject.Difficulty,
+	priority valueobject.Priority,
+	dueDate *time.Time,
+	checklist []*TaskChecklistItem,
+	completedAt *time.Time,
+	xpAwarded int,
+	createdAt time.Time,
+	updatedAt time.Time,
+) *Task {
+	return &Task{
+		id:          id,
+		characterID: characterID,
+		title:       title,
+		description: description,
+		difficulty:  difficulty,
+		priority:    priority,
+		dueDate:     dueDate,
+		checklist:   checklist,
+		completedAt: completedAt,
+		xpAwarded:   xpAwarded,
+		createdAt:   createdAt,
+		updatedAt:   updatedAt,
+	}
+}
+
+// ReconstituteTaskChecklistItem creates a TaskChecklistItem from existing data (for repository loading)
+func ReconstituteTaskChecklistItem(id string, title string, done bool) *TaskChecklistItem {
+	return &TaskChecklistItem{id: id, title: title, done: done}
+}
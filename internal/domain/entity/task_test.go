@@ -0,0 +1,134 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+func newTestTask(t *testing.T, dueDate *time.Time) *entity.Task {
+	t.Helper()
+
+	difficulty, _ := valueobject.NewDifficulty("medium")
+	priority, _ := valueobject.NewPriority("high")
+	item, err := entity.NewTaskChecklistItem("item-1", "Draft")
+	if err != nil {
+		t.Fatalf("NewTaskChecklistItem() error = %v, want nil", err)
+	}
+
+	task, err := entity.NewTask("task-1", "char-456", "  Write report  ", "", difficulty, priority, dueDate, []*entity.TaskChecklistItem{item})
+	if err != nil {
+		t.Fatalf("NewTask() error = %v, want nil", err)
+	}
+	return task
+}
+
+func TestNewTask_Valid(t *testing.T) {
+	due := time.Date(2024, 3, 10, 18, 30, 0, 0, time.UTC)
+	task := newTestTask(t, &due)
+
+	if task.Title() != "Write report" {
+		t.Errorf("Title() = %q, want %q", task.Title(), "Write report")
+	}
+	if task.Status() != entity.TaskOpen {
+		t.Errorf("Status() = %v, want %v", task.Status(), entity.TaskOpen)
+	}
+
+	// Due dates are kept as calendar dates
+	if want := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC); !task.DueDate().Equal(want) {
+		t.Errorf("DueDate() = %v, want %v", task.DueDate(), want)
+	}
+}
+
+func TestNewTask_Invalid(t *testing.T) {
+	difficulty, _ := valueobject.NewDifficulty("easy")
+	priority, _ := valueobject.NewPriority("low")
+
+	tests := []struct {
+		name        string
+		id          string
+		characterID string
+		title       string
+		difficulty  valueobject.Difficulty
+		priority    valueobject.Priority
+	}{
+		{"empty id", "", "char-456", "Write report", difficulty, priority},
+		{"empty character id", "task-1", "", "Write report", difficulty, priority},
+		{"short title", "task-1", "char-456", " a ", difficulty, priority},
+		{"missing difficulty", "task-1", "char-456", "Write report", valueobject.Difficulty{}, priority},
+		{"missing priority", "task-1", "char-456", "Write report", difficulty, valueobject.Priority{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := entity.NewTask(tt.id, tt.characterID, tt.title, "", tt.difficulty, tt.priority, nil, nil); err == nil {
+				t.Error("NewTask() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestTask_IsOverdue(t *testing.T) {
+	due := time.Date(2024, 3, 10, 0, 0, 0, 0, time.UTC)
+	task := newTestTask(t, &due)
+
+	if task.IsOverdue(time.Date(2024, 3, 10, 23, 0, 0, 0, time.UTC)) {
+		t.Error("IsOverdue() on the due date = true, want false")
+	}
+	if !task.IsOverdue(time.Date(2024, 3, 11, 0, 0, 0, 0, time.UTC)) {
+		t.Error("IsOverdue() after the due date = false, want true")
+	}
+
+	task.Complete(time.Date(2024, 3, 12, 9, 0, 0, 0, time.UTC))
+	if task.IsOverdue(time.Date(2024, 3, 12, 10, 0, 0, 0, time.UTC)) {
+		t.Error("IsOverdue() on completed task = true, want false")
+	}
+
+	if newTestTask(t, nil).IsOverdue(time.Now()) {
+		t.Error("IsOverdue() without due date = true, want false")
+	}
+}
+
+func TestTask_CompleteAndReopen(t *testing.T) {
+	task := newTestTask(t, nil)
+
+	if err := task.Complete(time.Now()); err != nil {
+		t.Fatalf("Complete() error = %v, want nil", err)
+	}
+	if task.Status() != entity.TaskCompleted {
+		t.Errorf("Status() = %v, want %v", task.Status(), entity.TaskCompleted)
+	}
+	if task.XpAwarded() != 20 {
+		t.Errorf("XpAwarded() = %v, want %v", task.XpAwarded(), 20)
+	}
+	if err := task.Complete(time.Now()); err == nil {
+		t.Error("Complete() on completed task error = nil, want error")
+	}
+
+	xp, err := task.Reopen()
+	if err != nil {
+		t.Fatalf("Reopen() error = %v, want nil", err)
+	}
+	if xp != 20 || task.XpAwarded() != 0 || task.CompletedAt() != nil {
+		t.Errorf("Reopen() = %v (xpAwarded %v), want 20 (xpAwarded 0)", xp, task.XpAwarded())
+	}
+	if _, err := task.Reopen(); err == nil {
+		t.Error("Reopen() on open task error = nil, want error")
+	}
+}
+
+func TestTask_CheckItem(t *testing.T) {
+	task := newTestTask(t, nil)
+
+	if err := task.CheckItem("item-1", true); err != nil {
+		t.Fatalf("CheckItem() error = %v, want nil", err)
+	}
+	if !task.Checklist()[0].Done() {
+		t.Error("Done() = false, want true")
+	}
+	if err := task.CheckItem("unknown", true); err == nil {
+		t.Error("CheckItem() with unknown item error = nil, want error")
+	}
+}
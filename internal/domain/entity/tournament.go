@@ -0,0 +1,619 @@
+package entity
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"math/bits"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Tournament formats
+const (
+	TournamentSingleElimination = "single_elimination" // Losers are out; byes fill the bracket up to a power of two
+	TournamentRoundRobin        = "round_robin"        // Every entrant fights every other entrant once
+)
+
+// Tournament seeding criteria
+const (
+	TournamentSeedByLevel  = "level"
+	TournamentSeedByRating = "rating"
+)
+
+// Tournament statuses
+const (
+	TournamentOpen      = "open"      // Characters can join until the deadline
+	TournamentRunning   = "running"   // Seeded; rounds are fought on schedule
+	TournamentCompleted = "completed" // Every round was fought and the prizes awarded
+	TournamentCancelled = "cancelled" // Not enough characters joined before the deadline
+)
+
+// Tournament limits
+const (
+	MinTournamentEntrants      = 2
+	MaxTournamentEntrants      = 64
+	MaxTournamentPrizeXp       = 1000
+	MinTournamentRoundInterval = time.Minute
+
+	minTournamentNameLength = 3
+	maxTournamentNameLength = 60
+)
+
+// TournamentStanding is an entrant's record in a tournament
+type TournamentStanding struct {
+	CharacterID       string
+	Seed              int
+	Wins              int
+	Draws             int
+	Losses            int
+	Points            int // 2 per win and 1 per draw (round-robin ranking)
+	EliminatedInRound int // Single-elimination: 0 while the character is still in the bracket
+}
+
+// TournamentPrize is the XP a finisher of a tournament is awarded
+type TournamentPrize struct {
+	CharacterID string
+	Place       int
+	Xp          int
+}
+
+// Tournament represents characters fighting a bracket of scheduled rounds for a prize (Domain Entity)
+// Characters join until the deadline, when the bracket is seeded and the first round is fought;
+// every following round is fought one round interval after the previous one.
+// The champion wins the prize XP and the runner-up half of it.
+type Tournament struct {
+	id              string
+	name            string
+	format          string // single_elimination or round_robin
+	seeding         string // level or rating
+	guildID         string // Only members of the guild can join; empty when open to every character
+	organizerUserID string
+	maxEntrants     int
+	prizeXp         int
+	joinDeadline    time.Time // Also when the first round is fought
+	roundInterval   time.Duration
+	status          string // open, running, completed or cancelled
+	totalRounds     int    // Set when the bracket is seeded
+	roundsPlayed    int
+	championID      string
+	runnerUpID      string
+	createdAt       time.Time
+	endedAt         *time.Time // Set once the tournament is completed or cancelled
+}
+
+// NewTournament creates a new Tournament open for characters to join until the deadline
+func NewTournament(
+	id string,
+	name string,
+	format string,
+	seeding string,
+	guildID string,
+	organizerUserID string,
+	maxEntrants int,
+	prizeXp int,
+	joinDeadline time.Time,
+	roundInterval time.Duration,
+	createdAt time.Time,
+) (*Tournament, error) {
+	name = strings.TrimSpace(name)
+
+	if id == "" {
+		return nil, fmt.Errorf("tournament id cannot be empty")
+	}
+	if len([]rune(name)) < minTournamentNameLength || len([]rune(name)) > maxTournamentNameLength {
+		return nil, fmt.Errorf("tournament name must be between %d and %d characters", minTournamentNameLength, maxTournamentNameLength)
+	}
+	if format != TournamentSingleElimination && format != TournamentRoundRobin {
+		return nil, fmt.Errorf("invalid tournament format: %s", format)
+	}
+	if seeding != TournamentSeedByLevel && seeding != TournamentSeedByRating {
+		return nil, fmt.Errorf("invalid tournament seeding: %s", seeding)
+	}
+	if organizerUserID == "" {
+		return nil, fmt.Errorf("tournament organizer cannot be empty")
+	}
+	if maxEntrants < MinTournamentEntrants || maxEntrants > MaxTournamentEntrants {
+		return nil, fmt.Errorf("tournament must allow between %d and %d entrants", MinTournamentEntrants, MaxTournamentEntrants)
+	}
+	if prizeXp < 0 || prizeXp > MaxTournamentPrizeXp {
+		return nil, fmt.Errorf("tournament prize must be between 0 and %d XP", MaxTournamentPrizeXp)
+	}
+	if createdAt.IsZero() {
+		return nil, fmt.Errorf("creation time cannot be empty")
+	}
+	if !joinDeadline.After(createdAt) {
+		return nil, fmt.Errorf("join deadline must be in the future")
+	}
+	if roundInterval < MinTournamentRoundInterval {
+		return nil, fmt.Errorf("round interval must be at least %s", MinTournamentRoundInterval)
+	}
+
+	return &Tournament{
+		id:              id,
+		name:            name,
+		format:          format,
+		seeding:         seeding,
+		guildID:         guildID,
+		organizerUserID: organizerUserID,
+		maxEntrants:     maxEntrants,
+		prizeXp:         prizeXp,
+		joinDeadline:    joinDeadline,
+		roundInterval:   roundInterval,
+		status:          TournamentOpen,
+		createdAt:       createdAt,
+	}, nil
+}
+
+// TournamentRounds returns the number of rounds a tournament of the format takes with the given entrants
+// Single-elimination halves the bracket each round; round-robin gives every entrant one bye when they're odd
+func TournamentRounds(format string, entrants int) int {
+	if entrants < MinTournamentEntrants {
+		return 0
+	}
+	if format == TournamentRoundRobin {
+		return entrants - 1 + entrants%2
+	}
+	return bits.Len(uint(entrants - 1))
+}
+
+// Getters (Read-only access to ensure encapsulation)
+
+func (t *Tournament) ID() string {
+	return t.id
+}
+
+func (t *Tournament) Name() string {
+	return t.name
+}
+
+func (t *Tournament) Format() string {
+	return t.format
+}
+
+func (t *Tournament) Seeding() string {
+	return t.seeding
+}
+
+func (t *Tournament) GuildID() string {
+	return t.guildID
+}
+
+func (t *Tournament) OrganizerUserID() string {
+	return t.organizerUserID
+}
+
+func (t *Tournament) MaxEntrants() int {
+	return t.maxEntrants
+}
+
+func (t *Tournament) PrizeXp() int {
+	return t.prizeXp
+}
+
+func (t *Tournament) JoinDeadline() time.Time {
+	return t.joinDeadline
+}
+
+func (t *Tournament) RoundInterval() time.Duration {
+	return t.roundInterval
+}
+
+func (t *Tournament) Status() string {
+	return t.status
+}
+
+func (t *Tournament) TotalRounds() int {
+	return t.totalRounds
+}
+
+func (t *Tournament) RoundsPlayed() int {
+	return t.roundsPlayed
+}
+
+func (t *Tournament) ChampionID() string {
+	return t.championID
+}
+
+func (t *Tournament) RunnerUpID() string {
+	return t.runnerUpID
+}
+
+func (t *Tournament) CreatedAt() time.Time {
+	return t.createdAt
+}
+
+func (t *Tournament) EndedAt() *time.Time {
+	return t.endedAt
+}
+
+// Business Methods
+
+// IsOpenAt reports whether characters can still join at the given time
+func (t *Tournament) IsOpenAt(at time.Time) bool {
+	return t.status == TournamentOpen && at.Before(t.joinDeadline)
+}
+
+// IsGuildTournament reports whether only the members of a guild can join
+func (t *Tournament) IsGuildTournament() bool {
+	return t.guildID != ""
+}
+
+// RoundAt returns when a round (1-based) is scheduled
+func (t *Tournament) RoundAt(round int) time.Time {
+	return t.joinDeadline.Add(time.Duration(round-1) * t.roundInterval)
+}
+
+// NextRoundAt returns when the next round is scheduled (zero once the tournament is over)
+// The first round is fought as soon as the bracket is seeded, at the join deadline
+func (t *Tournament) NextRoundAt() time.Time {
+	switch t.status {
+	case TournamentOpen:
+		return t.joinDeadline
+	case TournamentRunning:
+		return t.RoundAt(t.roundsPlayed + 1)
+	default:
+		return time.Time{}
+	}
+}
+
+// IsDueAt reports whether the tournament has work scheduled at or before the given time
+// (seeding the bracket, or fighting the next round)
+func (t *Tournament) IsDueAt(at time.Time) bool {
+	next := t.NextRoundAt()
+	return !next.IsZero() && !at.Before(next)
+}
+
+// IsLastRoundPlayed reports whether every round of a running tournament was fought
+func (t *Tournament) IsLastRoundPlayed() bool {
+	return t.status == TournamentRunning && t.roundsPlayed == t.totalRounds
+}
+
+// Start seeds the entrants by their scores (level or rating, highest first) and closes the registrations
+// Ties go to the character that joined first
+func (t *Tournament) Start(entrants []*TournamentEntrant, scores map[string]int) error {
+	if t.status != TournamentOpen {
+		return fmt.Errorf("tournament has already started")
+	}
+	if len(entrants) < MinTournamentEntrants {
+		return fmt.Errorf("a tournament needs at least %d entrants", MinTournamentEntrants)
+	}
+
+	slices.SortFunc(entrants, func(a, b *TournamentEntrant) int {
+		return cmp.Or(
+			cmp.Compare(scores[b.characterID], scores[a.characterID]),
+			a.joinedAt.Compare(b.joinedAt),
+			cmp.Compare(a.characterID, b.characterID),
+		)
+	})
+	for i, entrant := range entrants {
+		entrant.seed = i + 1
+		entrant.seedScore = scores[entrant.characterID]
+	}
+
+	t.totalRounds = TournamentRounds(t.format, len(entrants))
+	t.status = TournamentRunning
+	return nil
+}
+
+// Cancel ends a tournament that didn't get enough entrants before its deadline
+func (t *Tournament) Cancel(at time.Time) error {
+	if t.status != TournamentOpen {
+		return fmt.Errorf("tournament has already started")
+	}
+
+	t.status = TournamentCancelled
+	t.endedAt = &at
+	return nil
+}
+
+// Pairings returns the pairings of the next round from the seeded entrants and the matches already fought
+func (t *Tournament) Pairings(entrants []*TournamentEntrant, matches []*TournamentMatch) ([]TournamentPairing, error) {
+	if t.status != TournamentRunning || t.roundsPlayed >= t.totalRounds {
+		return nil, fmt.Errorf("tournament has no round left to fight")
+	}
+
+	seeds := make(map[string]int, len(entrants))
+	for _, entrant := range entrants {
+		if !entrant.IsSeeded() {
+			return nil, fmt.Errorf("entrant %s is not seeded", entrant.characterID)
+		}
+		seeds[entrant.characterID] = entrant.seed
+	}
+
+	round := t.roundsPlayed + 1
+	var pairs [][2]string
+	if t.format == TournamentRoundRobin {
+		pairs = roundRobinPairs(entrants, round)
+	} else {
+		var err error
+		pairs, err = singleEliminationPairs(entrants, matches, round)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// The better seed goes first: it enters the battle as the challenger and advances on a draw
+	pairings := make([]TournamentPairing, 0, len(pairs))
+	for _, pair := range pairs {
+		first, second := pair[0], pair[1]
+		if second != "" && (first == "" || seeds[second] < seeds[first]) {
+			first, second = second, first
+		}
+		if first == "" {
+			continue
+		}
+		pairings = append(pairings, TournamentPairing{Slot: len(pairings) + 1, CharacterID: first, OpponentID: second})
+	}
+
+	return pairings, nil
+}
+
+// RecordMatch records the battle of a pairing of the next round, or the bye when battle is nil
+// On a draw the better seed advances in single-elimination; round-robin records the draw
+func (t *Tournament) RecordMatch(pairing TournamentPairing, battle *Battle, at time.Time) (*TournamentMatch, error) {
+	if t.status != TournamentRunning || t.roundsPlayed >= t.totalRounds {
+		return nil, fmt.Errorf("tournament has no round left to fight")
+	}
+
+	match := &TournamentMatch{
+		tournamentID: t.id,
+		round:        t.roundsPlayed + 1,
+		slot:         pairing.Slot,
+		characterID:  pairing.CharacterID,
+		opponentID:   pairing.OpponentID,
+		playedAt:     at,
+	}
+
+	if pairing.IsBye() {
+		if battle != nil {
+			return nil, fmt.Errorf("a bye has no battle")
+		}
+		match.winnerID = pairing.CharacterID
+		return match, nil
+	}
+
+	if battle == nil {
+		return nil, fmt.Errorf("match between %s and %s has no battle", pairing.CharacterID, pairing.OpponentID)
+	}
+	if battle.ChallengerID() != pairing.CharacterID || battle.OpponentID() != pairing.OpponentID {
+		return nil, fmt.Errorf("battle %s was not fought by the pairing", battle.ID())
+	}
+
+	match.battleID = battle.ID()
+	match.winnerID = battle.WinnerID()
+	if match.winnerID == "" && t.format == TournamentSingleElimination {
+		match.winnerID = pairing.CharacterID
+	}
+	return match, nil
+}
+
+// CompleteRound marks the next round as fought
+func (t *Tournament) CompleteRound() error {
+	if t.status != TournamentRunning || t.roundsPlayed >= t.totalRounds {
+		return fmt.Errorf("tournament has no round left to fight")
+	}
+
+	t.roundsPlayed++
+	return nil
+}
+
+// Finish completes a tournament whose rounds were all fought, crowning the top two of the standings
+func (t *Tournament) Finish(entrants []*TournamentEntrant, matches []*TournamentMatch, at time.Time) error {
+	if !t.IsLastRoundPlayed() {
+		return fmt.Errorf("tournament still has rounds to fight")
+	}
+
+	standings := t.Standings(entrants, matches)
+	if len(standings) < MinTournamentEntrants {
+		return fmt.Errorf("a tournament needs at least %d entrants", MinTournamentEntrants)
+	}
+
+	t.championID = standings[0].CharacterID
+	t.runnerUpID = standings[1].CharacterID
+	t.status = TournamentCompleted
+	t.endedAt = &at
+	return nil
+}
+
+// Prizes returns the XP awarded to the champion (the whole prize) and the runner-up (half of it)
+// Empty until the tournament is completed; finishers whose share is 0 are left out
+func (t *Tournament) Prizes() []TournamentPrize {
+	if t.status != TournamentCompleted {
+		return nil
+	}
+
+	var prizes []TournamentPrize
+	for _, prize := range []TournamentPrize{
+		{CharacterID: t.championID, Place: 1, Xp: t.prizeXp},
+		{CharacterID: t.runnerUpID, Place: 2, Xp: t.prizeXp / 2},
+	} {
+		if prize.Xp > 0 {
+			prizes = append(prizes, prize)
+		}
+	}
+	return prizes
+}
+
+// Standings ranks the entrants by their matches (best first)
+// Round-robin ranks by points, then wins; single-elimination by how long the character stayed in the bracket.
+// Byes count as neither wins nor losses. The better seed breaks every tie.
+func (t *Tournament) Standings(entrants []*TournamentEntrant, matches []*TournamentMatch) []TournamentStanding {
+	standings := make([]TournamentStanding, len(entrants))
+	index := make(map[string]int, len(entrants))
+	for i, entrant := range entrants {
+		standings[i] = TournamentStanding{CharacterID: entrant.characterID, Seed: entrant.seed}
+		index[entrant.characterID] = i
+	}
+
+	for _, match := range matches {
+		if match.IsBye() {
+			continue
+		}
+		for _, characterID := range []string{match.characterID, match.opponentID} {
+			i, ok := index[characterID]
+			if !ok {
+				continue
+			}
+			switch match.winnerID {
+			case "":
+				standings[i].Draws++
+				standings[i].Points++
+			case characterID:
+				standings[i].Wins++
+				standings[i].Points += 2
+			default:
+				standings[i].Losses++
+				standings[i].EliminatedInRound = match.round
+			}
+		}
+	}
+
+	if t.format == TournamentRoundRobin {
+		for i := range standings {
+			standings[i].EliminatedInRound = 0
+		}
+		slices.SortFunc(standings, func(a, b TournamentStanding) int {
+			return cmp.Or(cmp.Compare(b.Points, a.Points), cmp.Compare(b.Wins, a.Wins), cmp.Compare(a.Seed, b.Seed))
+		})
+		return standings
+	}
+
+	// Characters still in the bracket come first, then the ones knocked out latest
+	lasted := func(standing TournamentStanding) int {
+		if standing.EliminatedInRound == 0 {
+			return math.MaxInt
+		}
+		return standing.EliminatedInRound
+	}
+	slices.SortFunc(standings, func(a, b TournamentStanding) int {
+		return cmp.Or(cmp.Compare(lasted(b), lasted(a)), cmp.Compare(b.Wins, a.Wins), cmp.Compare(a.Seed, b.Seed))
+	})
+	return standings
+}
+
+// singleEliminationPairs pairs the first round by the standard bracket (1 against the lowest seed,
+// the top seeds getting the byes) and every later round by the winners of adjacent slots
+func singleEliminationPairs(entrants []*TournamentEntrant, matches []*TournamentMatch, round int) ([][2]string, error) {
+	if round == 1 {
+		bySeed := make(map[int]string, len(entrants))
+		for _, entrant := range entrants {
+			bySeed[entrant.seed] = entrant.characterID
+		}
+
+		order := bracketOrder(1 << bits.Len(uint(len(entrants)-1)))
+		pairs := make([][2]string, 0, len(order)/2)
+		for i := 0; i < len(order); i += 2 {
+			pairs = append(pairs, [2]string{bySeed[order[i]], bySeed[order[i+1]]})
+		}
+		return pairs, nil
+	}
+
+	var previous []*TournamentMatch
+	for _, match := range matches {
+		if match.round == round-1 {
+			previous = append(previous, match)
+		}
+	}
+	if len(previous) == 0 || len(previous)%2 != 0 {
+		return nil, fmt.Errorf("round %d has %d matches, can't pair round %d", round-1, len(previous), round)
+	}
+	slices.SortFunc(previous, func(a, b *TournamentMatch) int {
+		return cmp.Compare(a.slot, b.slot)
+	})
+
+	pairs := make([][2]string, 0, len(previous)/2)
+	for i := 0; i < len(previous); i += 2 {
+		pairs = append(pairs, [2]string{previous[i].winnerID, previous[i+1].winnerID})
+	}
+	return pairs, nil
+}
+
+// roundRobinPairs pairs a round with the circle method: the first seed stays put while the others rotate
+// An odd number of entrants gets an empty spot, and whoever faces it sits the round out
+func roundRobinPairs(entrants []*TournamentEntrant, round int) [][2]string {
+	seeded := slices.Clone(entrants)
+	slices.SortFunc(seeded, func(a, b *TournamentEntrant) int {
+		return cmp.Compare(a.seed, b.seed)
+	})
+
+	spots := make([]string, 0, len(seeded)+1)
+	for _, entrant := range seeded {
+		spots = append(spots, entrant.characterID)
+	}
+	if len(spots)%2 != 0 {
+		spots = append(spots, "")
+	}
+
+	n := len(spots)
+	rotated := make([]string, n)
+	rotated[0] = spots[0]
+	for i := 1; i < n; i++ {
+		rotated[i] = spots[1+(i-1+round-1)%(n-1)]
+	}
+
+	pairs := make([][2]string, 0, n/2)
+	for i := 0; i < n/2; i++ {
+		if rotated[i] == "" || rotated[n-1-i] == "" {
+			continue
+		}
+		pairs = append(pairs, [2]string{rotated[i], rotated[n-1-i]})
+	}
+	return pairs
+}
+
+// bracketOrder returns the seeds in bracket order for a bracket of the given size (a power of two),
+// so that the two best seeds can only meet in the final: 1, 8, 4, 5, 2, 7, 3, 6 for 8
+func bracketOrder(size int) []int {
+	order := []int{1}
+	for len(order) < size {
+		next := make([]int, 0, len(order)*2)
+		for _, seed := range order {
+			next = append(next, seed, 2*len(order)+1-seed)
+		}
+		order = next
+	}
+	return order
+}
+
+// ReconstituteTournament creates a Tournament from existing data (for repository loading)
+func ReconstituteTournament(
+	id string,
+	name string,
+	format string,
+	seeding string,
+	guildID string,
+	organizerUserID string,
+	maxEntrants int,
+	prizeXp int,
+	joinDeadline time.Time,
+	roundInterval time.Duration,
+	status string,
+	totalRounds int,
+	roundsPlayed int,
+	championID string,
+	runnerUpID string,
+	createdAt time.Time,
+	endedAt *time.Time,
+) *Tournament {
+	return &Tournament{
+		id:              id,
+		name:            name,
+		format:          format,
+		seeding:         seeding,
+		guildID:         guildID,
+		organizerUserID: organizerUserID,
+		maxEntrants:     maxEntrants,
+		prizeXp:         prizeXp,
+		joinDeadline:    joinDeadline,
+		roundInterval:   roundInterval,
+		status:          status,
+		totalRounds:     totalRounds,
+		roundsPlayed:    roundsPlayed,
+		championID:      championID,
+		runnerUpID:      runnerUpID,
+		createdAt:       createdAt,
+		endedAt:         endedAt,
+	}
+}
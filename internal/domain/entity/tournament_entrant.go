@@ -0,0 +1,75 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+)
+
+// TournamentEntrant represents a character that joined a tournament (Domain Entity)
+// Entrants are seeded when the join deadline passes: seed 1 is the strongest character
+type TournamentEntrant struct {
+	tournamentID string
+	characterID  string
+	seed         int // 0 until the bracket is seeded
+	seedScore    int // Level or rating the character was seeded by
+	joinedAt     time.Time
+}
+
+// NewTournamentEntrant creates a new, unseeded TournamentEntrant
+func NewTournamentEntrant(tournamentID string, characterID string, joinedAt time.Time) (*TournamentEntrant, error) {
+	if tournamentID == "" {
+		return nil, fmt.Errorf("tournament id cannot be empty")
+	}
+	if characterID == "" {
+		return nil, fmt.Errorf("character id cannot be empty")
+	}
+	if joinedAt.IsZero() {
+		return nil, fmt.Errorf("join time cannot be empty")
+	}
+
+	return &TournamentEntrant{
+		tournamentID: tournamentID,
+		characterID:  characterID,
+		joinedAt:     joinedAt,
+	}, nil
+}
+
+// Getters (Read-only access to ensure encapsulation)
+
+func (e *TournamentEntrant) TournamentID() string {
+	return e.tournamentID
+}
+
+func (e *TournamentEntrant) CharacterID() string {
+	return e.characterID
+}
+
+func (e *TournamentEntrant) Seed() int {
+	return e.seed
+}
+
+func (e *TournamentEntrant) SeedScore() int {
+	return e.seedScore
+}
+
+func (e *TournamentEntrant) JoinedAt() time.Time {
+	return e.joinedAt
+}
+
+// Business Methods
+
+// IsSeeded reports whether the entrant has its place in the bracket
+func (e *TournamentEntrant) IsSeeded() bool {
+	return e.seed > 0
+}
+
+// ReconstituteTournamentEntrant creates a TournamentEntrant from existing data (for repository loading)
+func ReconstituteTournamentEntrant(tournamentID string, characterID string, seed int, seedScore int, joinedAt time.Time) *TournamentEntrant {
+	return &TournamentEntrant{
+		tournamentID: tournamentID,
+		characterID:  characterID,
+		seed:         seed,
+		seedScore:    seedScore,
+		joinedAt:     joinedAt,
+	}
+}
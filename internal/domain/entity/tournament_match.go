@@ -0,0 +1,117 @@
+package entity
+
+import (
+	"time"
+)
+
+// TournamentPairing is a match to be played in a round: the better seed against its opponent
+// An empty opponent is a bye (single-elimination only): the character advances without fighting
+type TournamentPairing struct {
+	Slot        int
+	CharacterID string
+	OpponentID  string
+}
+
+// IsBye reports whether the pairing has no opponent
+func (p TournamentPairing) IsBye() bool {
+	return p.OpponentID == ""
+}
+
+// TournamentMatch represents the result of a pairing of a tournament round (Domain Entity)
+// Matches are keyed by tournament, round and slot, so a round can only be recorded once
+type TournamentMatch struct {
+	tournamentID string
+	round        int
+	slot         int
+	characterID  string // Better seed
+	opponentID   string // Empty on a bye
+	battleID     string // Empty on a bye
+	winnerID     string // Empty on a round-robin draw
+	playedAt     time.Time
+}
+
+// Getters (Read-only access to ensure encapsulation)
+
+func (m *TournamentMatch) TournamentID() string {
+	return m.tournamentID
+}
+
+func (m *TournamentMatch) Round() int {
+	return m.round
+}
+
+func (m *TournamentMatch) Slot() int {
+	return m.slot
+}
+
+func (m *TournamentMatch) CharacterID() string {
+	return m.characterID
+}
+
+func (m *TournamentMatch) OpponentID() string {
+	return m.opponentID
+}
+
+func (m *TournamentMatch) BattleID() string {
+	return m.battleID
+}
+
+func (m *TournamentMatch) WinnerID() string {
+	return m.winnerID
+}
+
+func (m *TournamentMatch) PlayedAt() time.Time {
+	return m.playedAt
+}
+
+// Business Methods
+
+// IsBye reports whether the character advanced without fighting
+func (m *TournamentMatch) IsBye() bool {
+	return m.opponentID == ""
+}
+
+// IsDraw reports whether the match ended without a winner
+func (m *TournamentMatch) IsDraw() bool {
+	return m.winnerID == ""
+}
+
+// LoserID returns the character that lost the match (empty on a bye or a draw)
+func (m *TournamentMatch) LoserID() string {
+	switch m.winnerID {
+	case "":
+		return ""
+	case m.characterID:
+		return m.opponentID
+	default:
+		return m.characterID
+	}
+}
+
+// Involves reports whether the character played the match
+func (m *TournamentMatch) Involves(characterID string) bool {
+	return m.characterID == characterID || m.opponentID == characterID
+}
+
+// ReconstituteTournamentMatch creates a TournamentMatch from existing data (for repository loading)
+func ReconstituteTournamentMatch(
+	tournamentID string,
+	round int,
+	slot int,
+	characterID string,
+	opponentID string,
+	battleID string,
+	winnerID string,
+	playedAt time.Time,
+) *TournamentMatch {
+	return &TournamentMatch{
+		tournamentID: tournamentID,
+		round:        round,
+		slot:         slot,
+		characterID:  characterID,
+		opponentID:   opponentID,
+		battleID:     battleID,
+		winnerID:     winnerID,
+		playedAt:     playedAt,
+	}
+}
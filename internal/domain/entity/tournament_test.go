@@ -0,0 +1,320 @@
+package entity_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+var tournamentCreatedAt = time.Date(2024, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func newTestTournament(t *testing.T, format string) *entity.Tournament {
+	t.Helper()
+
+	tournament, err := entity.NewTournament("tournament-1", "Copa do Hábito", format, entity.TournamentSeedByLevel, "", "user-1", 16, 100,
+		tournamentCreatedAt.Add(24*time.Hour), time.Hour, tournamentCreatedAt)
+	if err != nil {
+		t.Fatalf("NewTournament() error = %v, want nil", err)
+	}
+	return tournament
+}
+
+// startTestTournament seeds entrants char-1..char-n, char-1 being the strongest (seed 1)
+func startTestTournament(t *testing.T, tournament *entity.Tournament, n int) []*entity.TournamentEntrant {
+	t.Helper()
+
+	entrants := make([]*entity.TournamentEntrant, n)
+	scores := map[string]int{}
+	for i := range entrants {
+		characterID := fmt.Sprintf("char-%d", n-i)
+		entrants[i], _ = entity.NewTournamentEntrant(tournament.ID(), characterID, tournamentCreatedAt.Add(time.Duration(i)*time.Minute))
+		scores[characterID] = 100 - (n - i)
+	}
+
+	if err := tournament.Start(entrants, scores); err != nil {
+		t.Fatalf("Start() error = %v, want nil", err)
+	}
+	return entrants
+}
+
+// playTestRound fights the next round; the better seed is much stronger, so it always wins
+func playTestRound(t *testing.T, tournament *entity.Tournament, entrants []*entity.TournamentEntrant, matches []*entity.TournamentMatch) []*entity.TournamentMatch {
+	t.Helper()
+
+	pairings, err := tournament.Pairings(entrants, matches)
+	if err != nil {
+		t.Fatalf("Pairings() error = %v, want nil", err)
+	}
+
+	at := tournament.NextRoundAt()
+	for _, pairing := range pairings {
+		var battle *entity.Battle
+		if !pairing.IsBye() {
+			battle, err = entity.NewBattle(fmt.Sprintf("battle-%d-%d", tournament.RoundsPlayed()+1, pairing.Slot),
+				newTestCombatant(t, pairing.CharacterID, 40, 10), newTestCombatant(t, pairing.OpponentID, 1, 10), 42, at)
+			if err != nil {
+				t.Fatalf("NewBattle() error = %v, want nil", err)
+			}
+		}
+
+		match, err := tournament.RecordMatch(pairing, battle, at)
+		if err != nil {
+			t.Fatalf("RecordMatch() error = %v, want nil", err)
+		}
+		matches = append(matches, match)
+	}
+
+	if err := tournament.CompleteRound(); err != nil {
+		t.Fatalf("CompleteRound() error = %v, want nil", err)
+	}
+	return matches
+}
+
+func TestNewTournament_Invalid(t *testing.T) {
+	deadline := tournamentCreatedAt.Add(time.Hour)
+
+	tests := []struct {
+		name          string
+		tournamentID  string
+		tName         string
+		format        string
+		seeding       string
+		maxEntrants   int
+		prizeXp       int
+		deadline      time.Time
+		roundInterval time.Duration
+	}{
+		{"empty id", "", "Copa", entity.TournamentRoundRobin, entity.TournamentSeedByLevel, 8, 0, deadline, time.Hour},
+		{"short name", "t-1", "Co", entity.TournamentRoundRobin, entity.TournamentSeedByLevel, 8, 0, deadline, time.Hour},
+		{"unknown format", "t-1", "Copa", "swiss", entity.TournamentSeedByLevel, 8, 0, deadline, time.Hour},
+		{"unknown seeding", "t-1", "Copa", entity.TournamentRoundRobin, "xp", 8, 0, deadline, time.Hour},
+		{"one entrant", "t-1", "Copa", entity.TournamentRoundRobin, entity.TournamentSeedByLevel, 1, 0, deadline, time.Hour},
+		{"too many entrants", "t-1", "Copa", entity.TournamentRoundRobin, entity.TournamentSeedByLevel, entity.MaxTournamentEntrants + 1, 0, deadline, time.Hour},
+		{"negative prize", "t-1", "Copa", entity.TournamentRoundRobin, entity.TournamentSeedByLevel, 8, -1, deadline, time.Hour},
+		{"prize too high", "t-1", "Copa", entity.TournamentRoundRobin, entity.TournamentSeedByLevel, 8, entity.MaxTournamentPrizeXp + 1, deadline, time.Hour},
+		{"deadline in the past", "t-1", "Copa", entity.TournamentRoundRobin, entity.TournamentSeedByLevel, 8, 0, tournamentCreatedAt, time.Hour},
+		{"round interval too short", "t-1", "Copa", entity.TournamentRoundRobin, entity.TournamentSeedByLevel, 8, 0, deadline, time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := entity.NewTournament(tt.tournamentID, tt.tName, tt.format, tt.seeding, "", "user-1", tt.maxEntrants, tt.prizeXp, tt.deadline, tt.roundInterval, tournamentCreatedAt)
+			if err == nil {
+				t.Error("NewTournament() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestTournamentRounds(t *testing.T) {
+	tests := []struct {
+		format   string
+		entrants int
+		want     int
+	}{
+		{entity.TournamentSingleElimination, 2, 1},
+		{entity.TournamentSingleElimination, 5, 3},
+		{entity.TournamentSingleElimination, 8, 3},
+		{entity.TournamentSingleElimination, 9, 4},
+		{entity.TournamentRoundRobin, 4, 3},
+		{entity.TournamentRoundRobin, 5, 5},
+		{entity.TournamentRoundRobin, 1, 0},
+	}
+
+	for _, tt := range tests {
+		if got := entity.TournamentRounds(tt.format, tt.entrants); got != tt.want {
+			t.Errorf("TournamentRounds(%s, %d) = %d, want %d", tt.format, tt.entrants, got, tt.want)
+		}
+	}
+}
+
+func TestTournament_Schedule(t *testing.T) {
+	tournament := newTestTournament(t, entity.TournamentSingleElimination)
+	deadline := tournament.JoinDeadline()
+
+	if !tournament.IsOpenAt(deadline.Add(-time.Second)) || tournament.IsOpenAt(deadline) {
+		t.Error("characters should be able to join until the deadline")
+	}
+	if tournament.IsDueAt(deadline.Add(-time.Second)) || !tournament.IsDueAt(deadline) {
+		t.Error("the bracket should be seeded at the deadline")
+	}
+
+	startTestTournament(t, tournament, 4)
+	if !tournament.NextRoundAt().Equal(deadline) {
+		t.Errorf("NextRoundAt() = %v, want the first round at the deadline", tournament.NextRoundAt())
+	}
+
+	if err := tournament.CompleteRound(); err != nil {
+		t.Fatalf("CompleteRound() error = %v, want nil", err)
+	}
+	if want := deadline.Add(time.Hour); !tournament.NextRoundAt().Equal(want) || tournament.IsDueAt(want.Add(-time.Second)) {
+		t.Errorf("NextRoundAt() = %v, want %v", tournament.NextRoundAt(), want)
+	}
+}
+
+func TestTournament_Start_SeedsByScore(t *testing.T) {
+	tournament := newTestTournament(t, entity.TournamentSingleElimination)
+
+	early, _ := entity.NewTournamentEntrant(tournament.ID(), "char-early", tournamentCreatedAt)
+	late, _ := entity.NewTournamentEntrant(tournament.ID(), "char-late", tournamentCreatedAt.Add(time.Minute))
+	strong, _ := entity.NewTournamentEntrant(tournament.ID(), "char-strong", tournamentCreatedAt.Add(2*time.Minute))
+	entrants := []*entity.TournamentEntrant{late, strong, early}
+
+	// Ties go to the character that joined first
+	err := tournament.Start(entrants, map[string]int{"char-early": 5, "char-late": 5, "char-strong": 12})
+	if err != nil {
+		t.Fatalf("Start() error = %v, want nil", err)
+	}
+
+	if strong.Seed() != 1 || early.Seed() != 2 || late.Seed() != 3 || strong.SeedScore() != 12 {
+		t.Errorf("seeds = strong %d, early %d, late %d, want 1, 2, 3", strong.Seed(), early.Seed(), late.Seed())
+	}
+	if tournament.Status() != entity.TournamentRunning || tournament.TotalRounds() != 2 {
+		t.Errorf("status = %s with %d rounds, want running with 2", tournament.Status(), tournament.TotalRounds())
+	}
+
+	if err := tournament.Start(entrants, nil); err == nil {
+		t.Error("Start() error = nil, want error for a tournament already running")
+	}
+}
+
+func TestTournament_Start_NotEnoughEntrants(t *testing.T) {
+	tournament := newTestTournament(t, entity.TournamentRoundRobin)
+	lonely, _ := entity.NewTournamentEntrant(tournament.ID(), "char-1", tournamentCreatedAt)
+
+	if err := tournament.Start([]*entity.TournamentEntrant{lonely}, nil); err == nil {
+		t.Fatal("Start() error = nil, want error for a single entrant")
+	}
+
+	if err := tournament.Cancel(tournament.JoinDeadline()); err != nil {
+		t.Fatalf("Cancel() error = %v, want nil", err)
+	}
+	if tournament.Status() != entity.TournamentCancelled || tournament.EndedAt() == nil || tournament.IsDueAt(tournament.JoinDeadline()) {
+		t.Errorf("status = %s, want a cancelled tournament with nothing left to do", tournament.Status())
+	}
+	if tournament.Prizes() != nil {
+		t.Error("a cancelled tournament awards no prize")
+	}
+}
+
+func TestTournament_SingleElimination_PlaysBracket(t *testing.T) {
+	tournament := newTestTournament(t, entity.TournamentSingleElimination)
+	entrants := startTestTournament(t, tournament, 5)
+
+	// 5 entrants fill a bracket of 8: the top three seeds get byes
+	pairings, err := tournament.Pairings(entrants, nil)
+	if err != nil {
+		t.Fatalf("Pairings() error = %v, want nil", err)
+	}
+	want := []entity.TournamentPairing{
+		{Slot: 1, CharacterID: "char-1"},
+		{Slot: 2, CharacterID: "char-4", OpponentID: "char-5"},
+		{Slot: 3, CharacterID: "char-2"},
+		{Slot: 4, CharacterID: "char-3"},
+	}
+	if fmt.Sprint(pairings) != fmt.Sprint(want) {
+		t.Fatalf("round 1 pairings = %v, want %v", pairings, want)
+	}
+
+	var matches []*entity.TournamentMatch
+	matches = playTestRound(t, tournament, entrants, matches)
+
+	// Round 2 pairs the winners of adjacent slots
+	pairings, _ = tournament.Pairings(entrants, matches)
+	want = []entity.TournamentPairing{
+		{Slot: 1, CharacterID: "char-1", OpponentID: "char-4"},
+		{Slot: 2, CharacterID: "char-2", OpponentID: "char-3"},
+	}
+	if fmt.Sprint(pairings) != fmt.Sprint(want) {
+		t.Fatalf("round 2 pairings = %v, want %v", pairings, want)
+	}
+
+	matches = playTestRound(t, tournament, entrants, matches)
+	matches = playTestRound(t, tournament, entrants, matches)
+
+	if _, err := tournament.Pairings(entrants, matches); err == nil {
+		t.Error("Pairings() error = nil, want error once every round was fought")
+	}
+
+	if err := tournament.Finish(entrants, matches, tournament.RoundAt(3)); err != nil {
+		t.Fatalf("Finish() error = %v, want nil", err)
+	}
+	if tournament.ChampionID() != "char-1" || tournament.RunnerUpID() != "char-2" || tournament.Status() != entity.TournamentCompleted {
+		t.Errorf("champion = %s, runner-up = %s, want char-1 and char-2", tournament.ChampionID(), tournament.RunnerUpID())
+	}
+
+	// Standings follow how long each character stayed in the bracket, then wins; byes aren't wins
+	standings := tournament.Standings(entrants, matches)
+	if standings[0].Wins != 2 || standings[2].CharacterID != "char-4" || standings[3].EliminatedInRound != 2 || standings[4].CharacterID != "char-5" {
+		t.Errorf("standings = %+v, want char-1 (2 wins), char-2, char-4 (1 win), char-3 (out in round 2), char-5", standings)
+	}
+
+	prizes := tournament.Prizes()
+	if len(prizes) != 2 || prizes[0] != (entity.TournamentPrize{CharacterID: "char-1", Place: 1, Xp: 100}) || prizes[1].Xp != 50 {
+		t.Errorf("Prizes() = %+v, want 100 XP for char-1 and 50 for char-2", prizes)
+	}
+}
+
+func TestTournament_RoundRobin_EveryoneMeetsOnce(t *testing.T) {
+	tournament := newTestTournament(t, entity.TournamentRoundRobin)
+	entrants := startTestTournament(t, tournament, 5)
+
+	met := map[string]int{}
+	var matches []*entity.TournamentMatch
+	for round := 1; round <= tournament.TotalRounds(); round++ {
+		before := len(matches)
+		matches = playTestRound(t, tournament, entrants, matches)
+
+		// With 5 entrants someone sits out every round
+		playing := map[string]bool{}
+		for _, match := range matches[before:] {
+			if match.IsBye() || playing[match.CharacterID()] || playing[match.OpponentID()] {
+				t.Fatalf("round %d: %+v, want each character to fight at most once", round, match)
+			}
+			playing[match.CharacterID()], playing[match.OpponentID()] = true, true
+			met[match.CharacterID()+" "+match.OpponentID()]++
+		}
+		if len(playing) != 4 {
+			t.Errorf("round %d: %d characters fought, want 4", round, len(playing))
+		}
+	}
+
+	if len(met) != 10 {
+		t.Errorf("pairs met = %d, want every one of the 10 pairs", len(met))
+	}
+	for pair, times := range met {
+		if times != 1 {
+			t.Errorf("%s met %d times, want 1", pair, times)
+		}
+	}
+
+	if err := tournament.Finish(entrants, matches, tournament.RoundAt(5)); err != nil {
+		t.Fatalf("Finish() error = %v, want nil", err)
+	}
+
+	// The better seed wins every match: char-1 wins all 4
+	standings := tournament.Standings(entrants, matches)
+	if standings[0].CharacterID != "char-1" || standings[0].Points != 8 || standings[4].Losses != 4 {
+		t.Errorf("standings = %+v, want char-1 with 8 points first and char-5 without a win last", standings)
+	}
+	if tournament.ChampionID() != "char-1" || tournament.RunnerUpID() != "char-2" {
+		t.Errorf("champion = %s, runner-up = %s, want char-1 and char-2", tournament.ChampionID(), tournament.RunnerUpID())
+	}
+}
+
+func TestTournament_RecordMatch_RejectsOtherBattle(t *testing.T) {
+	tournament := newTestTournament(t, entity.TournamentSingleElimination)
+	startTestTournament(t, tournament, 2)
+
+	pairing := entity.TournamentPairing{Slot: 1, CharacterID: "char-1", OpponentID: "char-2"}
+	battle, _ := entity.NewBattle("battle-1", newTestCombatant(t, "char-1", 5, 1), newTestCombatant(t, "char-9", 5, 1), 1, tournamentCreatedAt)
+
+	if _, err := tournament.RecordMatch(pairing, battle, tournamentCreatedAt); err == nil {
+		t.Error("RecordMatch() error = nil, want error for a battle of another pairing")
+	}
+	if _, err := tournament.RecordMatch(pairing, nil, tournamentCreatedAt); err == nil {
+		t.Error("RecordMatch() error = nil, want error for a match without battle")
+	}
+}
@@ -0,0 +1,70 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// UserPreferences represents a user's calendar settings (Domain Entity)
+// They define when the user's days and weeks start, which drives every "today",
+// streak and day-end calculation
+type UserPreferences struct {
+	userID    string
+	clock     valueobject.DayClock
+	updatedAt time.Time
+}
+
+// NewUserPreferences creates new UserPreferences with validation
+func NewUserPreferences(userID string, clock valueobject.DayClock) (*UserPreferences, error) {
+	if userID == "" {
+		return nil, fmt.Errorf("user id cannot be empty")
+	}
+
+	return &UserPreferences{
+		userID:    userID,
+		clock:     clock,
+		updatedAt: time.Now(),
+	}, nil
+}
+
+// DefaultUserPreferences returns the preferences of a user who never changed them
+// (UTC days starting at midnight, weeks starting on Monday)
+func DefaultUserPreferences(userID string) *UserPreferences {
+	return &UserPreferences{
+		userID: userID,
+		clock:  valueobject.DefaultDayClock(),
+	}
+}
+
+// Getters (Read-only access to ensure encapsulation)
+
+func (up *UserPreferences) UserID() string {
+	return up.userID
+}
+
+func (up *UserPreferences) Clock() valueobject.DayClock {
+	return up.clock
+}
+
+func (up *UserPreferences) UpdatedAt() time.Time {
+	return up.updatedAt
+}
+
+// Business Methods
+
+// ChangeClock replaces the user's time zone, day rollover hour and week start
+func (up *UserPreferences) ChangeClock(clock valueobject.DayClock) {
+	up.clock = clock
+	up.updatedAt = time.Now()
+}
+
+// ReconstituteUserPreferences creates UserPreferences from existing data (for repository loading)
+func ReconstituteUserPreferences(userID string, clock valueobject.DayClock, updatedAt time.Time) *UserPreferences {
+	return &UserPreferences{
+		userID:    userID,
+		clock:     clock,
+		updatedAt: updatedAt,
+	}
+}
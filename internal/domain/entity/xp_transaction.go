@@ -0,0 +1,127 @@
+package entity
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+// XpTransaction represents one entry of a character's append-only XP ledger (Domain Entity)
+// Every XP change is recorded with its signed amount, what caused it and the level it left the character at,
+// so the character's level and XP can always be rebuilt from the ledger
+type XpTransaction struct {
+	id               int64 // Assigned by the database, 0 until persisted
+	characterID      string
+	amount           int // Negative when XP was lost
+	source           valueobject.XpSource
+	resultingLevel   int
+	resultingTotalXp int
+	createdAt        time.Time
+}
+
+// NewXpTransaction creates a new XpTransaction with validation
+func NewXpTransaction(
+	characterID string,
+	amount int,
+	source valueobject.XpSource,
+	resultingLevel int,
+	resultingTotalXp int,
+) (*XpTransaction, error) {
+	if characterID == "" {
+		return nil, fmt.Errorf("character id cannot be empty")
+	}
+	if amount == 0 {
+		return nil, fmt.Errorf("xp transaction amount cannot be zero")
+	}
+	if source.IsZero() {
+		return nil, fmt.Errorf("xp transaction source cannot be empty")
+	}
+	if resultingLevel < 1 {
+		return nil, fmt.Errorf("resulting level must be at least 1")
+	}
+	if resultingTotalXp < 0 {
+		return nil, fmt.Errorf("resulting total xp cannot be negative")
+	}
+
+	return &XpTransaction{
+		characterID:      characterID,
+		amount:           amount,
+		source:           source,
+		resultingLevel:   resultingLevel,
+		resultingTotalXp: resultingTotalXp,
+		createdAt:        time.Now(),
+	}, nil
+}
+
+// Getters (Read-only access to ensure encapsulation)
+
+func (xt *XpTransaction) ID() int64 {
+	return xt.id
+}
+
+func (xt *XpTransaction) CharacterID() string {
+	return xt.characterID
+}
+
+func (xt *XpTransaction) Amount() int {
+	return xt.amount
+}
+
+func (xt *XpTransaction) Source() valueobject.XpSource {
+	return xt.source
+}
+
+func (xt *XpTransaction) ResultingLevel() int {
+	return xt.resultingLevel
+}
+
+func (xt *XpTransaction) ResultingTotalXp() int {
+	return xt.resultingTotalXp
+}
+
+func (xt *XpTransaction) CreatedAt() time.Time {
+	return xt.createdAt
+}
+
+// ReplayXpTransactions rebuilds the progress of a level 1 character from its ledger (oldest first)
+// Returns the level, current XP and total XP the ledger adds up to
+func ReplayXpTransactions(transactions []*XpTransaction) (int, int, int, error) {
+	replay := &Character{level: 1}
+
+	for _, transaction := range transactions {
+		if transaction.Amount() > 0 {
+			if _, err := replay.AddXp(transaction.Amount()); err != nil {
+				return 0, 0, 0, fmt.Errorf("failed to replay xp transaction %d: %w", transaction.ID(), err)
+			}
+			continue
+		}
+
+		if _, _, err := replay.LoseXp(-transaction.Amount()); err != nil {
+			return 0, 0, 0, fmt.Errorf("failed to replay xp transaction %d: %w", transaction.ID(), err)
+		}
+	}
+
+	return replay.level, replay.currentXp, replay.totalXp, nil
+}
+
+// ReconstituteXpTransaction creates an XpTransaction from existing data (for repository loading)
+func ReconstituteXpTransaction(
+	id int64,
+	characterID string,
+	amount int,
+	source valueobject.XpSource,
+	resultingLevel int,
+	resultingTotalXp int,
+	createdAt time.Time,
+) *XpTransaction {
+	return &XpTransaction{
+		id:               id,
+		characterID:      characterID,
+		amount:           amount,
+		source:           source,
+		resultingLevel:   resultingLevel,
+		resultingTotalXp: resultingTotalXp,
+		createdAt:        createdAt,
+	}
+}
@@ -0,0 +1,120 @@
+package entity_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+func mustXpSource(t *testing.T, sourceType string, sourceID string) valueobject.XpSource {
+	t.Helper()
+
+	source, err := valueobject.NewXpSource(sourceType, sourceID)
+	if err != nil {
+		t.Fatalf("NewXpSource() error = %v, want nil", err)
+	}
+	return source
+}
+
+func TestNewXpTransaction_Invalid(t *testing.T) {
+	source := mustXpSource(t, valueobject.XpSourceTaskCompletion, "task-123")
+
+	tests := []struct {
+		name        string
+		characterID string
+		amount      int
+		source      valueobject.XpSource
+		level       int
+		totalXp     int
+	}{
+		{"empty character id", "", 10, source, 1, 10},
+		{"zero amount", "char-123", 0, source, 1, 10},
+		{"missing source", "char-123", 10, valueobject.XpSource{}, 1, 10},
+		{"level zero", "char-123", 10, source, 0, 10},
+		{"negative total xp", "char-123", -10, source, 1, -10},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := entity.NewXpTransaction(tt.characterID, tt.amount, tt.source, tt.level, tt.totalXp); err == nil {
+				t.Error("NewXpTransaction() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestCharacter_AddXpFrom_RecordsLedger(t *testing.T) {
+	character := entity.ReconstituteCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), 1, 90, 90, 0, "user-456", time.Now())
+
+	character.AddXpFrom(20, mustXpSource(t, valueobject.XpSourceHabitCompletion, "comp-1"))
+	character.LoseXpFrom(500, mustXpSource(t, valueobject.XpSourceHabitPenalty, "penalty-1"))
+
+	ledger := character.PendingXpTransactions()
+	if len(ledger) != 2 {
+		t.Fatalf("len(PendingXpTransactions()) = %v, want %v", len(ledger), 2)
+	}
+
+	// Level 2 with 10 XP after the reward, floored at level 1 with 0 XP after the penalty
+	if ledger[0].Amount() != 20 || ledger[0].ResultingLevel() != 2 || ledger[0].ResultingTotalXp() != 110 {
+		t.Errorf("reward = (%v, level %v, total %v), want (20, level 2, total 110)", ledger[0].Amount(), ledger[0].ResultingLevel(), ledger[0].ResultingTotalXp())
+	}
+	if ledger[1].Amount() != -110 || ledger[1].ResultingLevel() != 1 || ledger[1].Source().ID() != "penalty-1" {
+		t.Errorf("penalty = (%v, level %v, source %v), want (-110, level 1, penalty-1)", ledger[1].Amount(), ledger[1].ResultingLevel(), ledger[1].Source().ID())
+	}
+
+	character.ClearPendingXpTransactions()
+	if len(character.PendingXpTransactions()) != 0 {
+		t.Error("PendingXpTransactions() should be empty after clearing")
+	}
+}
+
+func TestCharacter_LoseXpFrom_NothingToLose(t *testing.T) {
+	character, _ := entity.NewCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), "user-456")
+
+	character.LoseXpFrom(40, mustXpSource(t, valueobject.XpSourceHabitPenalty, "penalty-1"))
+
+	if len(character.PendingXpTransactions()) != 0 {
+		t.Errorf("len(PendingXpTransactions()) = %v, want 0 when no XP was lost", len(character.PendingXpTransactions()))
+	}
+}
+
+func TestReplayXpTransactions_MatchesCharacter(t *testing.T) {
+	character, _ := entity.NewCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), "user-456")
+
+	character.AddXpFrom(120, mustXpSource(t, valueobject.XpSourceHabitCompletion, "comp-1"))
+	character.AddXpFrom(900, mustXpSource(t, valueobject.XpSourceTaskCompletion, "task-1"))
+	character.LoseXpFrom(300, mustXpSource(t, valueobject.XpSourceTaskReopen, "task-1"))
+	character.AddXpFrom(45, mustXpSource(t, valueobject.XpSourceFocusSession, "session-1"))
+
+	level, currentXp, totalXp, err := entity.ReplayXpTransactions(character.PendingXpTransactions())
+	if err != nil {
+		t.Fatalf("ReplayXpTransactions() error = %v, want nil", err)
+	}
+
+	if level != character.Level() || currentXp != character.CurrentXp() || totalXp != character.TotalXp() {
+		t.Errorf("replay = (level %v, xp %v, total %v), want (level %v, xp %v, total %v)",
+			level, currentXp, totalXp, character.Level(), character.CurrentXp(), character.TotalXp())
+	}
+}
+
+func TestCharacter_CorrectXp(t *testing.T) {
+	character, _ := entity.NewCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), "user-456")
+
+	if err := character.CorrectXp(3, 10, 393); err != nil {
+		t.Fatalf("CorrectXp() error = %v, want nil", err)
+	}
+	if character.Level() != 3 || character.CurrentXp() != 10 || character.TotalXp() != 393 {
+		t.Errorf("character = (level %v, xp %v, total %v), want (3, 10, 393)", character.Level(), character.CurrentXp(), character.TotalXp())
+	}
+
+	// The two corrected levels grant their attribute points
+	if character.UnspentAttributePoints() != 2*entity.AttributePointsPerLevel {
+		t.Errorf("UnspentAttributePoints() = %v, want %v", character.UnspentAttributePoints(), 2*entity.AttributePointsPerLevel)
+	}
+
+	if err := character.CorrectXp(0, 0, 0); err == nil {
+		t.Error("CorrectXp() error = nil, want error for level 0")
+	}
+}
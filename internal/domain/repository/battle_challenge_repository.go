@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+// BattleChallengeRepository defines the interface for PvP challenge persistence (Port)
+// This is defined in the domain layer, but implemented in the infrastructure layer
+type BattleChallengeRepository interface {
+	// Create persists a new challenge
+	Create(ctx context.Context, challenge *entity.BattleChallenge) error
+
+	// FindByID retrieves a challenge by its ID
+	FindByID(ctx context.Context, id string) (*entity.BattleChallenge, error)
+
+	// FindPendingByCharacterID retrieves the challenges sent or received by a character
+	// still waiting for an answer at the given time (most recent first)
+	FindPendingByCharacterID(ctx context.Context, characterID string, now time.Time) ([]*entity.BattleChallenge, error)
+
+	// Respond saves the answer to a challenge
+	// Returns error if the challenge was already answered (so it can only be answered once)
+	Respond(ctx context.Context, challenge *entity.BattleChallenge) error
+}
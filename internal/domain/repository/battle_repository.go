@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+// BattleRepository defines the interface for battle persistence (Port)
+// This is defined in the domain layer, but implemented in the infrastructure layer
+type BattleRepository interface {
+	// Create persists the result of a fought battle
+	Create(ctx context.Context, battle *entity.Battle) error
+
+	// FindByID retrieves a battle by its ID
+	FindByID(ctx context.Context, id string) (*entity.Battle, error)
+}
@@ -18,9 +18,17 @@ type CharacterAttributeRepository interface {
 	// FindByCharacterID retrieves all attributes for a character
 	FindByCharacterID(ctx context.Context, characterID string) ([]*entity.CharacterAttribute, error)
 
+	// FindByCharacterIDForUpdate retrieves all attributes for a character and locks them until the unit of work ends
+	// Lock the attributes' character first (see CharacterRepository.FindByIDForUpdate)
+	FindByCharacterIDForUpdate(ctx context.Context, characterID string) ([]*entity.CharacterAttribute, error)
+
 	// FindByCharacterIDAndName retrieves a specific attribute by character ID and attribute name
 	FindByCharacterIDAndName(ctx context.Context, characterID string, attributeName string) (*entity.CharacterAttribute, error)
 
+	// FindByCharacterIDAndNameForUpdate retrieves a specific attribute and locks it until the unit of work ends
+	// Lock the attribute's character first (see CharacterRepository.FindByIDForUpdate)
+	FindByCharacterIDAndNameForUpdate(ctx context.Context, characterID string, attributeName string) (*entity.CharacterAttribute, error)
+
 	// Update updates an existing character attribute
 	Update(ctx context.Context, attribute *entity.CharacterAttribute) error
 
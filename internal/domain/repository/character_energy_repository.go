@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+// CharacterEnergyRepository defines the interface for battle energy persistence (Port)
+// This is defined in the domain layer, but implemented in the infrastructure layer
+type CharacterEnergyRepository interface {
+	// FindByCharacterID retrieves the energy of a character
+	// Returns nil (without error) when the character never spent energy (its pool is full)
+	FindByCharacterID(ctx context.Context, characterID string) (*entity.CharacterEnergy, error)
+
+	// FindByCharacterIDForUpdate retrieves the energy of a character and locks it until the unit of work ends
+	// Returns nil (without error) when the character never spent energy (its pool is full)
+	FindByCharacterIDForUpdate(ctx context.Context, characterID string) (*entity.CharacterEnergy, error)
+
+	// Create persists the energy of a character that never spent energy
+	// Does nothing when the character already has one (e.g. created by a concurrent request)
+	Create(ctx context.Context, energy *entity.CharacterEnergy) error
+
+	// Update saves the energy of a character
+	Update(ctx context.Context, energy *entity.CharacterEnergy) error
+}
@@ -0,0 +1,35 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+// CharacterRatingRepository defines the interface for competitive rating persistence (Port)
+// This is defined in the domain layer, but implemented in the infrastructure layer
+type CharacterRatingRepository interface {
+	// FindByCharacterID retrieves the rating of a character
+	// Returns nil (without error) when the character never fought a ranked battle
+	FindByCharacterID(ctx context.Context, characterID string) (*entity.CharacterRating, error)
+
+	// FindByCharacterIDForUpdate retrieves the rating of a character and locks it until the unit of work ends
+	// Returns nil (without error) when the character never fought a ranked battle
+	FindByCharacterIDForUpdate(ctx context.Context, characterID string) (*entity.CharacterRating, error)
+
+	// Create persists the initial rating of a character that never fought a ranked battle
+	// Does nothing when the character already has one (e.g. created by a concurrent request)
+	Create(ctx context.Context, rating *entity.CharacterRating) error
+
+	// Save creates or replaces the rating of a character, together with its pending history entries
+	Save(ctx context.Context, rating *entity.CharacterRating) error
+
+	// FindLeaderboard retrieves a page of the ratings of characters with ranked battles (highest rating first)
+	FindLeaderboard(ctx context.Context, limit int, offset int) ([]*entity.CharacterRating, error)
+
+	// CountRated counts the characters with ranked battles
+	CountRated(ctx context.Context) (int, error)
+
+	// FindRecentChangesByCharacterID retrieves the latest rating history entries of a character (most recent first)
+	FindRecentChangesByCharacterID(ctx context.Context, characterID string, limit int) ([]*entity.RatingChange, error)
+}
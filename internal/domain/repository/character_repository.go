@@ -15,6 +15,11 @@ type CharacterRepository interface {
 	// FindByID retrieves a character by their ID
 	FindByID(ctx context.Context, id string) (*entity.Character, error)
 
+	// FindByIDForUpdate retrieves a character by their ID and locks it until the unit of work ends
+	// Load characters this way before changing their XP, level or points: Update saves absolute values
+	// Lock order: raids, tournaments and dungeon runs first, then characters, then their attributes and energy
+	FindByIDForUpdate(ctx context.Context, id string) (*entity.Character, error)
+
 	// FindByIDAndUserID retrieves a character by ID and validates ownership
 	// Returns error if character doesn't exist OR doesn't belong to the user
 	FindByIDAndUserID(ctx context.Context, id string, userID string) (*entity.Character, error)
@@ -26,6 +31,7 @@ type CharacterRepository interface {
 	FindAllByUserID(ctx context.Context, userID string) ([]*entity.Character, error)
 
 	// Update updates an existing character
+	// The character's pending XP ledger entries are written in the same transaction
 	Update(ctx context.Context, character *entity.Character) error
 
 	// Delete removes a character
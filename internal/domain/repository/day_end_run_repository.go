@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// DayEndRunRepository records which user days were already processed by the day-end job (Port)
+// This is defined in the domain layer, but implemented in the infrastructure layer
+type DayEndRunRepository interface {
+	// Exists checks if the user's day was already processed
+	Exists(ctx context.Context, userID string, day time.Time) (bool, error)
+
+	// FindLastDay retrieves the user's most recent processed day
+	// Returns nil if none of the user's days was processed yet
+	FindLastDay(ctx context.Context, userID string) (*time.Time, error)
+
+	// Create marks the user's day as processed (no-op if it already was)
+	Create(ctx context.Context, userID string, day time.Time) error
+}
@@ -0,0 +1,14 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+// DungeonRepository defines the interface for reading the dungeon catalog (Port)
+// This is defined in the domain layer, but implemented in the infrastructure layer
+type DungeonRepository interface {
+	// FindByID retrieves a dungeon with its stages by its ID
+	FindByID(ctx context.Context, id string) (*entity.Dungeon, error)
+}
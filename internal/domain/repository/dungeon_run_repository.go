@@ -0,0 +1,26 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+// DungeonRunRepository defines the interface for dungeon run persistence (Port)
+// This is defined in the domain layer, but implemented in the infrastructure layer
+type DungeonRunRepository interface {
+	// Create persists a new run
+	// Returns error if the character already has a run in progress
+	Create(ctx context.Context, run *entity.DungeonRun) error
+
+	// Update saves the progress of a run
+	Update(ctx context.Context, run *entity.DungeonRun) error
+
+	// FindInProgressByCharacterID retrieves the run in progress of a character
+	// Returns nil (without error) when the character isn't running a dungeon
+	FindInProgressByCharacterID(ctx context.Context, characterID string) (*entity.DungeonRun, error)
+
+	// FindInProgressByCharacterIDForUpdate retrieves the run in progress of a character and locks it until the unit of work ends
+	// Returns nil (without error) when the character isn't running a dungeon
+	FindInProgressByCharacterIDForUpdate(ctx context.Context, characterID string) (*entity.DungeonRun, error)
+}
@@ -0,0 +1,36 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+// ErrFocusSessionOpen is returned by Create when the character already has an open (running or paused) session
+var ErrFocusSessionOpen = errors.New("character already has an open focus session")
+
+// FocusSessionRepository defines the interface for focus session persistence (Port)
+// This is defined in the domain layer, but implemented in the infrastructure layer
+type FocusSessionRepository interface {
+	// Create persists a new focus session
+	// Returns ErrFocusSessionOpen when the character already has an open session (e.g. started concurrently)
+	Create(ctx context.Context, session *entity.FocusSession) error
+
+	// FindByIDAndUserID retrieves a focus session by ID and validates ownership (through its character)
+	// Returns error if the session doesn't exist OR doesn't belong to the user
+	FindByIDAndUserID(ctx context.Context, id string, userID string) (*entity.FocusSession, error)
+
+	// FindOpenByUserID retrieves the running or paused session of any character owned by a user
+	// Returns nil (without error) when the user has no open session
+	FindOpenByUserID(ctx context.Context, userID string) (*entity.FocusSession, error)
+
+	// FindByUserIDBetween retrieves the sessions of all characters owned by a user
+	// started in the [from, to) interval (oldest first)
+	FindByUserIDBetween(ctx context.Context, userID string, from time.Time, to time.Time) ([]*entity.FocusSession, error)
+
+	// Update saves the pause, resume or stop of an open focus session
+	// Returns error if the session was already stopped (so it can only be stopped once)
+	Update(ctx context.Context, session *entity.FocusSession) error
+}
@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+// GuildRepository defines the interface for guild persistence (Port)
+// This is defined in the domain layer, but implemented in the infrastructure layer
+type GuildRepository interface {
+	// Create persists a new guild with its leader as the first member
+	// Returns error if the leader already belongs to a guild
+	Create(ctx context.Context, guild *entity.Guild) error
+
+	// FindByID retrieves a guild by its ID
+	FindByID(ctx context.Context, id string) (*entity.Guild, error)
+
+	// FindByCharacterID retrieves the guild a character belongs to
+	// Returns nil (without error) when the character isn't in a guild
+	FindByCharacterID(ctx context.Context, characterID string) (*entity.Guild, error)
+
+	// AddMember adds a character to a guild
+	// Returns error if the character already belongs to a guild
+	AddMember(ctx context.Context, guildID string, characterID string, joinedAt time.Time) error
+
+	// CountMembers counts the members of a guild (its leader included)
+	CountMembers(ctx context.Context, guildID string) (int, error)
+}
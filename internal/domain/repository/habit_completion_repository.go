@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+// HabitCompletionRepository defines the interface for habit completion persistence (Port)
+// This is defined in the domain layer, but implemented in the infrastructure layer
+type HabitCompletionRepository interface {
+	// Create persists a new habit completion
+	Create(ctx context.Context, completion *entity.HabitCompletion) error
+
+	// FindByID retrieves a habit completion by its ID
+	FindByID(ctx context.Context, id string) (*entity.HabitCompletion, error)
+
+	// FindByHabitID retrieves all completions of a habit (most recent first)
+	FindByHabitID(ctx context.Context, habitID string) ([]*entity.HabitCompletion, error)
+
+	// FindByUserIDBetween retrieves the completions of all habits owned by a user
+	// completed in the [from, to) interval (oldest first)
+	FindByUserIDBetween(ctx context.Context, userID string, from time.Time, to time.Time) ([]*entity.HabitCompletion, error)
+
+	// Delete removes a habit completion
+	Delete(ctx context.Context, id string) error
+}
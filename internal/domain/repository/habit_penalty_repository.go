@@ -0,0 +1,19 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+// HabitPenaltyRepository defines the interface for missed habit penalty persistence (Port)
+// This is defined in the domain layer, but implemented in the infrastructure layer
+type HabitPenaltyRepository interface {
+	// Create persists a new habit penalty
+	// Returns error if the habit was already penalized on the same day
+	Create(ctx context.Context, penalty *entity.HabitPenalty) error
+
+	// ExistsByHabitIDAndDay checks if a habit was already penalized on a day
+	ExistsByHabitIDAndDay(ctx context.Context, habitID string, day time.Time) (bool, error)
+}
@@ -0,0 +1,33 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+// HabitRepository defines the interface for habit persistence (Port)
+// This is defined in the domain layer, but implemented in the infrastructure layer
+type HabitRepository interface {
+	// Create persists a new habit
+	Create(ctx context.Context, habit *entity.Habit) error
+
+	// FindByID retrieves a habit by its ID
+	FindByID(ctx context.Context, id string) (*entity.Habit, error)
+
+	// FindByIDAndUserID retrieves a habit by ID and validates ownership (through its character)
+	// Returns error if habit doesn't exist OR doesn't belong to the user
+	FindByIDAndUserID(ctx context.Context, id string, userID string) (*entity.Habit, error)
+
+	// FindAllByUserID retrieves all habits of all characters owned by a user
+	FindAllByUserID(ctx context.Context, userID string) ([]*entity.Habit, error)
+
+	// FindUserIDsWithActiveHabits retrieves the IDs of the users owning at least one active habit
+	FindUserIDsWithActiveHabits(ctx context.Context) ([]string, error)
+
+	// Update updates an existing habit
+	Update(ctx context.Context, habit *entity.Habit) error
+
+	// Delete removes a habit
+	Delete(ctx context.Context, id string) error
+}
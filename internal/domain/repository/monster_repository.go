@@ -0,0 +1,20 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+// MonsterRepository defines the interface for reading the monster catalog (Port)
+// This is defined in the domain layer, but implemented in the infrastructure layer
+type MonsterRepository interface {
+	// FindByID retrieves a monster by its ID
+	FindByID(ctx context.Context, id string) (*entity.Monster, error)
+
+	// FindAll retrieves the whole catalog, ordered by minimum level and ID
+	FindAll(ctx context.Context) ([]*entity.Monster, error)
+
+	// FindByLevel retrieves the monsters whose level range includes the level, ordered by minimum level and ID
+	FindByLevel(ctx context.Context, level int) ([]*entity.Monster, error)
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+// RaidRepository defines the interface for raid persistence (Port)
+// This is defined in the domain layer, but implemented in the infrastructure layer
+type RaidRepository interface {
+	// Create persists a new raid
+	// Returns error if the guild already has an active raid
+	Create(ctx context.Context, raid *entity.Raid) error
+
+	// Update saves the boss's HP and the status of a raid
+	Update(ctx context.Context, raid *entity.Raid) error
+
+	// FindByIDForUpdate retrieves a raid by its ID and locks it until the unit of work ends
+	FindByIDForUpdate(ctx context.Context, id string) (*entity.Raid, error)
+
+	// FindActiveByGuildIDForUpdate retrieves the active raid of a guild and locks it until the unit of work ends
+	// Every strike goes through this lock, so simultaneous completions decrement the boss's HP one after another
+	// Returns nil (without error) when the guild isn't raiding
+	FindActiveByGuildIDForUpdate(ctx context.Context, guildID string) (*entity.Raid, error)
+
+	// FindLatestByGuildID retrieves the most recently started raid of a guild, whatever its status
+	// Returns nil (without error) when the guild never raided
+	FindLatestByGuildID(ctx context.Context, guildID string) (*entity.Raid, error)
+
+	// CreateHit persists the damage a habit completion dealt to a raid
+	CreateHit(ctx context.Context, hit *entity.RaidHit) error
+
+	// FindHitByCompletionID retrieves the hit of a habit completion
+	// Returns nil (without error) when the completion didn't strike a raid
+	FindHitByCompletionID(ctx context.Context, completionID string) (*entity.RaidHit, error)
+
+	// ExistsHitByHabitOnDay reports whether a completion of the habit already struck the raid on day
+	// (a calendar date in the user's clock)
+	ExistsHitByHabitOnDay(ctx context.Context, raidID string, habitID string, day time.Time) (bool, error)
+
+	// DeleteHit removes the hit of a habit completion
+	DeleteHit(ctx context.Context, completionID string) error
+
+	// FindContributions sums the damage each character dealt to a raid (most damage first)
+	FindContributions(ctx context.Context, raidID string) ([]entity.RaidContribution, error)
+}
@@ -0,0 +1,38 @@
+package repository
+
+import (
+	"context"
+	"errors"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+// ErrStreakFreezeUsed is returned when a token was spent before it could be deleted
+var ErrStreakFreezeUsed = errors.New("streak freeze was already used")
+
+// StreakFreezeRepository defines the interface for streak freeze token persistence (Port)
+// This is defined in the domain layer, but implemented in the infrastructure layer
+type StreakFreezeRepository interface {
+	// Create persists a new streak freeze token
+	Create(ctx context.Context, freeze *entity.StreakFreeze) error
+
+	// FindAvailableByCharacterID retrieves the unused tokens of a character (oldest first)
+	FindAvailableByCharacterID(ctx context.Context, characterID string) ([]*entity.StreakFreeze, error)
+
+	// FindByHabitID retrieves the tokens spent on a habit
+	FindByHabitID(ctx context.Context, habitID string) ([]*entity.StreakFreeze, error)
+
+	// FindUsedByUserID retrieves the tokens spent on any habit owned by a user
+	FindUsedByUserID(ctx context.Context, userID string) ([]*entity.StreakFreeze, error)
+
+	// FindByCompletionID retrieves the tokens earned by a habit completion
+	FindByCompletionID(ctx context.Context, completionID string) ([]*entity.StreakFreeze, error)
+
+	// Update saves a streak freeze token spent on a habit
+	// Returns error if the token was already spent (so it can only be spent once)
+	Update(ctx context.Context, freeze *entity.StreakFreeze) error
+
+	// Delete removes a streak freeze token that was not spent yet
+	// Returns ErrStreakFreezeUsed if it was spent (so a spent token is never taken back)
+	Delete(ctx context.Context, id string) error
+}
@@ -0,0 +1,43 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+// ErrTaskCompletionChanged is returned when a concurrent request already completed (or reopened) the task
+var ErrTaskCompletionChanged = errors.New("task was already completed or reopened")
+
+// TaskFilter narrows down the tasks returned by FindByUserID
+// Zero values mean "no filter"
+type TaskFilter struct {
+	Status  string     // entity.TaskOpen or entity.TaskCompleted
+	DueFrom *time.Time // Inclusive, only tasks with a due date
+	DueTo   *time.Time // Inclusive, only tasks with a due date
+}
+
+// TaskRepository defines the interface for task persistence (Port)
+// This is defined in the domain layer, but implemented in the infrastructure layer
+type TaskRepository interface {
+	// Create persists a new task
+	Create(ctx context.Context, task *entity.Task) error
+
+	// FindByIDAndUserID retrieves a task by ID and validates ownership (through its character)
+	// Returns error if the task doesn't exist OR doesn't belong to the user
+	FindByIDAndUserID(ctx context.Context, id string, userID string) (*entity.Task, error)
+
+	// FindByUserID retrieves the tasks of all characters owned by a user matching the filter
+	// Tasks are ordered by due date (tasks without one last), then by priority
+	FindByUserID(ctx context.Context, userID string, filter TaskFilter) ([]*entity.Task, error)
+
+	// Update updates an existing task (including its checklist)
+	// The completion is saved by UpdateCompletion
+	Update(ctx context.Context, task *entity.Task) error
+
+	// UpdateCompletion saves the completion or reopening of a task
+	// Only a task still in the opposite state is updated: returns ErrTaskCompletionChanged otherwise
+	UpdateCompletion(ctx context.Context, task *entity.Task) error
+}
@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+// TournamentRepository defines the interface for tournament persistence (Port)
+// This is defined in the domain layer, but implemented in the infrastructure layer
+type TournamentRepository interface {
+	// Create persists a new tournament
+	Create(ctx context.Context, tournament *entity.Tournament) error
+
+	// Update saves the status, the rounds played and the podium of a tournament
+	Update(ctx context.Context, tournament *entity.Tournament) error
+
+	// FindByID retrieves a tournament by its ID
+	// Returns error if the tournament is not found
+	FindByID(ctx context.Context, id string) (*entity.Tournament, error)
+
+	// FindByIDForUpdate retrieves a tournament by its ID and locks it until the unit of work ends
+	// Joins and round jobs go through this lock, so a round is never fought twice
+	// Returns error if the tournament is not found
+	FindByIDForUpdate(ctx context.Context, id string) (*entity.Tournament, error)
+
+	// FindDueIDs retrieves the IDs of the tournaments with a bracket to seed or a round to fight at the given time
+	FindDueIDs(ctx context.Context, at time.Time) ([]string, error)
+
+	// AddEntrant persists a character joining a tournament
+	// Returns error if the character already joined
+	AddEntrant(ctx context.Context, entrant *entity.TournamentEntrant) error
+
+	// UpdateEntrant saves the seed of an entrant
+	UpdateEntrant(ctx context.Context, entrant *entity.TournamentEntrant) error
+
+	// FindEntrants retrieves the entrants of a tournament (by seed, then by join time)
+	FindEntrants(ctx context.Context, tournamentID string) ([]*entity.TournamentEntrant, error)
+
+	// CreateMatch persists the result of a match
+	// Returns error if the match of that round and slot was already recorded
+	CreateMatch(ctx context.Context, match *entity.TournamentMatch) error
+
+	// FindMatches retrieves the matches of a tournament (by round, then by slot)
+	FindMatches(ctx context.Context, tournamentID string) ([]*entity.TournamentMatch, error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+// UserPreferencesRepository defines the interface for user preferences persistence (Port)
+// This is defined in the domain layer, but implemented in the infrastructure layer
+type UserPreferencesRepository interface {
+	// FindByUserID retrieves the preferences of a user
+	// Returns nil (without error) when the user never saved any
+	FindByUserID(ctx context.Context, userID string) (*entity.UserPreferences, error)
+
+	// Save creates or replaces the preferences of a user
+	Save(ctx context.Context, preferences *entity.UserPreferences) error
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+// XpTransactionRepository defines the interface for reading the XP ledger (Port)
+// This is defined in the domain layer, but implemented in the infrastructure layer
+// Ledger entries are written by CharacterRepository, together with the character they describe
+type XpTransactionRepository interface {
+	// FindPageByCharacterID retrieves a page of a character's XP transactions (most recent first)
+	FindPageByCharacterID(ctx context.Context, characterID string, limit int, offset int) ([]*entity.XpTransaction, error)
+
+	// CountByCharacterID counts the XP transactions of a character
+	CountByCharacterID(ctx context.Context, characterID string) (int, error)
+
+	// FindAllByCharacterID retrieves the whole XP ledger of a character (oldest first)
+	FindAllByCharacterID(ctx context.Context, characterID string) ([]*entity.XpTransaction, error)
+}
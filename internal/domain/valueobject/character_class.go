@@ -0,0 +1,175 @@
+package valueobject
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Attributes every character has
+const (
+	AttributeStrength     = "Força"
+	AttributeConstitution = "Constituição"
+	AttributeWillpower    = "Vontade"
+	AttributeWisdom       = "Sabedoria"
+	AttributeIntelligence = "Inteligência"
+	AttributeCharisma     = "Carisma"
+	AttributeDexterity    = "Destreza"
+)
+
+// Supported character classes
+const (
+	ClassAdventurer = "aventureiro" // Balanced profile of the characters created before classes existed
+	ClassWarrior    = "guerreiro"
+	ClassMage       = "mago"
+	ClassRogue      = "ladino"
+)
+
+// AttributeProfile is how a class starts and grows one attribute
+type AttributeProfile struct {
+	Name           string
+	Base           int // Value of the attribute when the character is created
+	GrowthPerLevel int // Bonus added to the attribute on every level above 1
+}
+
+// classProfile describes a class of the catalog
+type classProfile struct {
+	displayName string
+	description string
+	attributes  []AttributeProfile
+}
+
+// classOrder lists the classes in the order the catalog is presented
+var classOrder = []string{ClassWarrior, ClassMage, ClassRogue, ClassAdventurer}
+
+// classCatalog holds the profile of every class (every class starts with 35 attribute points)
+var classCatalog = map[string]classProfile{
+	ClassAdventurer: {
+		displayName: "Aventureiro",
+		description: "Equilibrado, sem especialização: cresce apenas com os pontos distribuídos",
+		attributes: []AttributeProfile{
+			{AttributeStrength, 5, 0},
+			{AttributeConstitution, 5, 0},
+			{AttributeWillpower, 5, 0},
+			{AttributeWisdom, 5, 0},
+			{AttributeIntelligence, 5, 0},
+			{AttributeCharisma, 5, 0},
+			{AttributeDexterity, 5, 0},
+		},
+	},
+	ClassWarrior: {
+		displayName: "Guerreiro",
+		description: "Combatente resistente, especialista em força bruta",
+		attributes: []AttributeProfile{
+			{AttributeStrength, 8, 1},
+			{AttributeConstitution, 7, 1},
+			{AttributeWillpower, 5, 0},
+			{AttributeWisdom, 3, 0},
+			{AttributeIntelligence, 3, 0},
+			{AttributeCharisma, 4, 0},
+			{AttributeDexterity, 5, 0},
+		},
+	},
+	ClassMage: {
+		displayName: "Mago",
+		description: "Estudioso das artes arcanas, frágil mas poderoso",
+		attributes: []AttributeProfile{
+			{AttributeStrength, 3, 0},
+			{AttributeConstitution, 4, 0},
+			{AttributeWillpower, 6, 0},
+			{AttributeWisdom, 7, 1},
+			{AttributeIntelligence, 8, 1},
+			{AttributeCharisma, 4, 0},
+			{AttributeDexterity, 3, 0},
+		},
+	},
+	ClassRogue: {
+		displayName: "Ladino",
+		description: "Ágil e carismático, vence pela astúcia",
+		attributes: []AttributeProfile{
+			{AttributeStrength, 4, 0},
+			{AttributeConstitution, 4, 0},
+			{AttributeWillpower, 4, 0},
+			{AttributeWisdom, 4, 0},
+			{AttributeIntelligence, 5, 0},
+			{AttributeCharisma, 6, 1},
+			{AttributeDexterity, 8, 1},
+		},
+	},
+}
+
+// CharacterClass represents the class of a character, which shapes its attributes (Value Object)
+type CharacterClass struct {
+	value string
+}
+
+// NewCharacterClass creates a new CharacterClass value object with validation
+func NewCharacterClass(class string) (CharacterClass, error) {
+	class = strings.TrimSpace(strings.ToLower(class))
+
+	if class == "" {
+		return CharacterClass{}, fmt.Errorf("character class cannot be empty")
+	}
+
+	if _, ok := classCatalog[class]; !ok {
+		return CharacterClass{}, fmt.Errorf("invalid character class: must be one of %s", strings.Join(classOrder, ", "))
+	}
+
+	return CharacterClass{value: class}, nil
+}
+
+// DefaultCharacterClass returns the class of characters created without choosing one
+func DefaultCharacterClass() CharacterClass {
+	return CharacterClass{value: ClassAdventurer}
+}
+
+// CharacterClasses returns every class of the catalog
+func CharacterClasses() []CharacterClass {
+	classes := make([]CharacterClass, len(classOrder))
+	for i, class := range classOrder {
+		classes[i] = CharacterClass{value: class}
+	}
+	return classes
+}
+
+// Value returns the class string value
+func (c CharacterClass) Value() string {
+	return c.value
+}
+
+// String implements the Stringer interface
+func (c CharacterClass) String() string {
+	return c.value
+}
+
+// DisplayName returns the name of the class shown to players
+func (c CharacterClass) DisplayName() string {
+	return classCatalog[c.value].displayName
+}
+
+// Description returns a short description of the class
+func (c CharacterClass) Description() string {
+	return classCatalog[c.value].description
+}
+
+// Attributes returns the starting value and growth of every attribute of the class
+func (c CharacterClass) Attributes() []AttributeProfile {
+	profiles := classCatalog[c.value].attributes
+	attributes := make([]AttributeProfile, len(profiles))
+	copy(attributes, profiles)
+	return attributes
+}
+
+// GrowthBonus returns the bonus the class adds to an attribute at a level (nothing at level 1)
+func (c CharacterClass) GrowthBonus(attributeName string, level int) int {
+	for _, profile := range classCatalog[c.value].attributes {
+		if profile.Name == attributeName {
+			return profile.GrowthPerLevel * max(level-1, 0)
+		}
+	}
+	return 0
+}
+
+// Equals checks if two classes are equal
+func (c CharacterClass) Equals(other CharacterClass) bool {
+	return c.value == other.value
+}
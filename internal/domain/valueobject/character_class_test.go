@@ -0,0 +1,96 @@
+package valueobject_test
+
+import (
+	"testing"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+func TestNewCharacterClass_Valid(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"guerreiro", valueobject.ClassWarrior},
+		{"Mago", valueobject.ClassMage},
+		{"  LADINO  ", valueobject.ClassRogue},
+		{"aventureiro", valueobject.ClassAdventurer},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			class, err := valueobject.NewCharacterClass(tt.input)
+			if err != nil {
+				t.Fatalf("NewCharacterClass() error = %v, want nil", err)
+			}
+			if class.Value() != tt.want {
+				t.Errorf("Value() = %v, want %v", class.Value(), tt.want)
+			}
+		})
+	}
+}
+
+func TestNewCharacterClass_Invalid(t *testing.T) {
+	for _, input := range []string{"", "   ", "necromante"} {
+		if _, err := valueobject.NewCharacterClass(input); err == nil {
+			t.Errorf("NewCharacterClass(%q) error = nil, want error", input)
+		}
+	}
+}
+
+func TestCharacterClasses_Profiles(t *testing.T) {
+	classes := valueobject.CharacterClasses()
+	if len(classes) != 4 {
+		t.Fatalf("len(CharacterClasses()) = %v, want 4", len(classes))
+	}
+
+	for _, class := range classes {
+		t.Run(class.Value(), func(t *testing.T) {
+			if class.DisplayName() == "" || class.Description() == "" {
+				t.Error("DisplayName() and Description() should not be empty")
+			}
+
+			// Every class covers the seven attributes with the same budget
+			attributes := class.Attributes()
+			if len(attributes) != 7 {
+				t.Fatalf("len(Attributes()) = %v, want 7", len(attributes))
+			}
+
+			total := 0
+			for _, attribute := range attributes {
+				total += attribute.Base
+			}
+			if total != 35 {
+				t.Errorf("sum of base attributes = %v, want 35", total)
+			}
+		})
+	}
+}
+
+func TestCharacterClass_GrowthBonus(t *testing.T) {
+	warrior, _ := valueobject.NewCharacterClass(valueobject.ClassWarrior)
+
+	tests := []struct {
+		name      string
+		attribute string
+		level     int
+		want      int
+	}{
+		{"no bonus at level 1", valueobject.AttributeStrength, 1, 0},
+		{"grows every level", valueobject.AttributeStrength, 5, 4},
+		{"attribute without growth", valueobject.AttributeIntelligence, 5, 0},
+		{"unknown attribute", "Sorte", 5, 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := warrior.GrowthBonus(tt.attribute, tt.level); got != tt.want {
+				t.Errorf("GrowthBonus(%s, %d) = %v, want %v", tt.attribute, tt.level, got, tt.want)
+			}
+		})
+	}
+
+	if got := valueobject.DefaultCharacterClass().GrowthBonus(valueobject.AttributeStrength, 10); got != 0 {
+		t.Errorf("adventurer GrowthBonus() = %v, want 0", got)
+	}
+}
@@ -0,0 +1,103 @@
+package valueobject
+
+import "fmt"
+
+// Combat stat formulas: every stat grows with the attributes that feed it and a little with the level
+const (
+	baseHp            = 50 // HP of a character with no Constituição
+	hpPerConstitution = 10
+	hpPerLevel        = 5
+	baseEvasion       = 2  // Evasion (%) of a character with no Destreza
+	maxEvasion        = 40 // Evasion is a chance to dodge: never close to certain
+)
+
+// CombatStats represents the battle stats derived from a character's attributes and level (Value Object)
+type CombatStats struct {
+	hp         int
+	attack     int
+	magic      int
+	defense    int
+	evasion    int
+	initiative int
+}
+
+// NewCombatStats derives the combat stats from attribute values (by attribute name) and level
+// Missing attributes count as 0; the values should already include any class bonus
+func NewCombatStats(attributes map[string]int, level int) CombatStats {
+	strength := attributes[AttributeStrength]
+	constitution := attributes[AttributeConstitution]
+	willpower := attributes[AttributeWillpower]
+	wisdom := attributes[AttributeWisdom]
+	intelligence := attributes[AttributeIntelligence]
+	charisma := attributes[AttributeCharisma]
+	dexterity := attributes[AttributeDexterity]
+	level = max(level, 1)
+
+	return CombatStats{
+		hp:         baseHp + constitution*hpPerConstitution + level*hpPerLevel,
+		attack:     strength*2 + dexterity/2 + level,
+		magic:      intelligence*2 + wisdom/2 + level,
+		defense:    constitution + willpower/2 + level/2,
+		evasion:    min(baseEvasion+dexterity/2+charisma/4, maxEvasion),
+		initiative: dexterity*2 + wisdom,
+	}
+}
+
+// ReconstituteCombatStats creates CombatStats from stored values (e.g. a battle snapshot) with validation
+func ReconstituteCombatStats(hp int, attack int, magic int, defense int, evasion int, initiative int) (CombatStats, error) {
+	if hp <= 0 {
+		return CombatStats{}, fmt.Errorf("combat hp must be positive")
+	}
+	if attack < 0 || magic < 0 || defense < 0 || initiative < 0 {
+		return CombatStats{}, fmt.Errorf("combat stats cannot be negative")
+	}
+	if evasion < 0 || evasion > maxEvasion {
+		return CombatStats{}, fmt.Errorf("combat evasion must be between 0 and %d", maxEvasion)
+	}
+
+	return CombatStats{
+		hp:         hp,
+		attack:     attack,
+		magic:      magic,
+		defense:    defense,
+		evasion:    evasion,
+		initiative: initiative,
+	}, nil
+}
+
+// HP returns the health points (the character is defeated at 0)
+func (s CombatStats) HP() int {
+	return s.hp
+}
+
+// Attack returns the physical damage power (Força, Destreza)
+func (s CombatStats) Attack() int {
+	return s.attack
+}
+
+// Magic returns the magical damage power (Inteligência, Sabedoria)
+func (s CombatStats) Magic() int {
+	return s.magic
+}
+
+// Defense returns how much incoming damage is absorbed (Constituição, Vontade)
+func (s CombatStats) Defense() int {
+	return s.defense
+}
+
+// Evasion returns the chance (%) of dodging an attack (Destreza, Carisma)
+func (s CombatStats) Evasion() int {
+	return s.evasion
+}
+
+// Initiative returns how early the character acts in a round (Destreza, Sabedoria)
+func (s CombatStats) Initiative() int {
+	return s.initiative
+}
+
+// Wounded returns the stats of a combatant entering a battle with only hp left (between 1 and its full HP)
+// The lowered HP is the combatant's whole pool for that battle, so the battle snapshot replays it
+func (s CombatStats) Wounded(hp int) CombatStats {
+	s.hp = min(max(hp, 1), s.hp)
+	return s
+}
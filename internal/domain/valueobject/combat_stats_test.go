@@ -0,0 +1,117 @@
+package valueobject_test
+
+import (
+	"testing"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+func TestNewCombatStats(t *testing.T) {
+	balanced := map[string]int{
+		valueobject.AttributeStrength:     5,
+		valueobject.AttributeConstitution: 5,
+		valueobject.AttributeWillpower:    5,
+		valueobject.AttributeWisdom:       5,
+		valueobject.AttributeIntelligence: 5,
+		valueobject.AttributeCharisma:     5,
+		valueobject.AttributeDexterity:    5,
+	}
+
+	tests := []struct {
+		name       string
+		attributes map[string]int
+		level      int
+		want       [6]int // hp, attack, magic, defense, evasion, initiative
+	}{
+		{"balanced level 1", balanced, 1, [6]int{105, 13, 13, 7, 5, 15}},
+		{"balanced level 10", balanced, 10, [6]int{150, 22, 22, 12, 5, 15}},
+		{"no attributes", map[string]int{}, 1, [6]int{55, 1, 1, 0, 2, 0}},
+		{"level below 1 counts as 1", map[string]int{}, 0, [6]int{55, 1, 1, 0, 2, 0}},
+		{"evasion is capped", map[string]int{valueobject.AttributeDexterity: 200}, 1, [6]int{55, 101, 1, 0, 40, 400}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stats := valueobject.NewCombatStats(tt.attributes, tt.level)
+			got := [6]int{stats.HP(), stats.Attack(), stats.Magic(), stats.Defense(), stats.Evasion(), stats.Initiative()}
+			if got != tt.want {
+				t.Errorf("NewCombatStats() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewCombatStats_AttributesFeedTheirStats(t *testing.T) {
+	base := valueobject.NewCombatStats(map[string]int{}, 1)
+
+	tests := []struct {
+		attribute string
+		stat      func(valueobject.CombatStats) int
+	}{
+		{valueobject.AttributeStrength, valueobject.CombatStats.Attack},
+		{valueobject.AttributeConstitution, valueobject.CombatStats.HP},
+		{valueobject.AttributeConstitution, valueobject.CombatStats.Defense},
+		{valueobject.AttributeIntelligence, valueobject.CombatStats.Magic},
+		{valueobject.AttributeDexterity, valueobject.CombatStats.Evasion},
+		{valueobject.AttributeDexterity, valueobject.CombatStats.Initiative},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.attribute, func(t *testing.T) {
+			stats := valueobject.NewCombatStats(map[string]int{tt.attribute: 10}, 1)
+			if tt.stat(stats) <= tt.stat(base) {
+				t.Errorf("raising %s did not raise its stat (%d <= %d)", tt.attribute, tt.stat(stats), tt.stat(base))
+			}
+		})
+	}
+}
+
+func TestReconstituteCombatStats(t *testing.T) {
+	original := valueobject.NewCombatStats(map[string]int{valueobject.AttributeStrength: 7, valueobject.AttributeDexterity: 9}, 4)
+
+	stats, err := valueobject.ReconstituteCombatStats(original.HP(), original.Attack(), original.Magic(), original.Defense(), original.Evasion(), original.Initiative())
+	if err != nil {
+		t.Fatalf("ReconstituteCombatStats() error = %v, want nil", err)
+	}
+	if stats != original {
+		t.Errorf("ReconstituteCombatStats() = %+v, want %+v", stats, original)
+	}
+
+	invalid := [][6]int{
+		{0, 1, 1, 1, 1, 1},   // no hp
+		{10, -1, 1, 1, 1, 1}, // negative attack
+		{10, 1, 1, 1, 41, 1}, // evasion above the cap
+	}
+	for _, values := range invalid {
+		if _, err := valueobject.ReconstituteCombatStats(values[0], values[1], values[2], values[3], values[4], values[5]); err == nil {
+			t.Errorf("ReconstituteCombatStats(%v) error = nil, want error", values)
+		}
+	}
+}
+
+func TestCombatStats_Wounded(t *testing.T) {
+	stats := valueobject.NewCombatStats(map[string]int{valueobject.AttributeConstitution: 5}, 1) // 105 HP
+
+	tests := []struct {
+		name string
+		hp   int
+		want int
+	}{
+		{"some hp lost", 40, 40},
+		{"no hp lost", 105, 105},
+		{"never above full hp", 500, 105},
+		{"never below 1", 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wounded := stats.Wounded(tt.hp)
+			if wounded.HP() != tt.want {
+				t.Errorf("Wounded(%d).HP() = %d, want %d", tt.hp, wounded.HP(), tt.want)
+			}
+			if wounded.Defense() != stats.Defense() || wounded.Attack() != stats.Attack() {
+				t.Errorf("Wounded() = %+v, want only the HP changed from %+v", wounded, stats)
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package valueobject
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Kinds of combatants
+const (
+	CombatantCharacter = "character"
+	CombatantMonster   = "monster"
+)
+
+// Combatant is a snapshot of a fighter taken when a battle starts (Value Object)
+// Battles only see the snapshot, so later attribute or level changes never alter a simulated battle
+type Combatant struct {
+	kind  string
+	id    string
+	name  string
+	level int
+	stats CombatStats
+}
+
+// NewCombatant creates a new Combatant value object with validation
+func NewCombatant(kind string, id string, name string, level int, stats CombatStats) (Combatant, error) {
+	switch kind {
+	case CombatantCharacter, CombatantMonster:
+	default:
+		return Combatant{}, fmt.Errorf("invalid combatant kind: must be one of character, monster")
+	}
+
+	if strings.TrimSpace(id) == "" {
+		return Combatant{}, fmt.Errorf("combatant id cannot be empty")
+	}
+	if strings.TrimSpace(name) == "" {
+		return Combatant{}, fmt.Errorf("combatant name cannot be empty")
+	}
+	if level < 1 {
+		return Combatant{}, fmt.Errorf("combatant level must be at least 1")
+	}
+	if stats.HP() <= 0 {
+		return Combatant{}, fmt.Errorf("combatant hp must be positive")
+	}
+
+	return Combatant{kind: kind, id: id, name: name, level: level, stats: stats}, nil
+}
+
+// Kind returns whether the combatant is a character or a monster
+func (c Combatant) Kind() string {
+	return c.kind
+}
+
+// ID returns the ID of the character or monster behind the combatant
+func (c Combatant) ID() string {
+	return c.id
+}
+
+// Name returns the combatant's name
+func (c Combatant) Name() string {
+	return c.name
+}
+
+// Level returns the combatant's level when the battle started
+func (c Combatant) Level() int {
+	return c.level
+}
+
+// Stats returns the combatant's combat stats when the battle started
+func (c Combatant) Stats() CombatStats {
+	return c.stats
+}
+
+// Wounded returns the combatant entering the battle with only hp left (see CombatStats.Wounded)
+func (c Combatant) Wounded(hp int) Combatant {
+	c.stats = c.stats.Wounded(hp)
+	return c
+}
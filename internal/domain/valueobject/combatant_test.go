@@ -0,0 +1,34 @@
+package valueobject_test
+
+import (
+	"testing"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+func TestNewCombatant_Invalid(t *testing.T) {
+	stats := valueobject.NewCombatStats(map[string]int{}, 1)
+
+	tests := []struct {
+		name  string
+		kind  string
+		id    string
+		cname string
+		level int
+		stats valueobject.CombatStats
+	}{
+		{"invalid kind", "dragon", "x", "X", 1, stats},
+		{"empty id", valueobject.CombatantMonster, "", "X", 1, stats},
+		{"empty name", valueobject.CombatantMonster, "x", " ", 1, stats},
+		{"level zero", valueobject.CombatantMonster, "x", "X", 0, stats},
+		{"no hp", valueobject.CombatantMonster, "x", "X", 1, valueobject.CombatStats{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := valueobject.NewCombatant(tt.kind, tt.id, tt.cname, tt.level, tt.stats); err == nil {
+				t.Error("NewCombatant() error = nil, want error")
+			}
+		})
+	}
+}
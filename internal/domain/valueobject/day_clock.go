@@ -0,0 +1,122 @@
+package valueobject
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// maxDayRolloverHour is the latest hour a user's day may roll over at
+// (a day starting in the afternoon would no longer match the calendar date it is named after)
+const maxDayRolloverHour = 12
+
+// DayClock maps instants to a user's calendar days (Value Object)
+// Days start at the rollover hour in the user's time zone (a 04:00 rollover keeps a
+// completion logged at 01:00 on the previous day), and weeks start on the configured weekday.
+// Calendar days are represented as midnight in the clock's time zone.
+type DayClock struct {
+	location     *time.Location
+	rolloverHour int
+	weekStart    time.Weekday
+}
+
+// NewDayClock creates a DayClock for an IANA time zone name (e.g. "Europe/Lisbon")
+func NewDayClock(timezone string, rolloverHour int, weekStart time.Weekday) (DayClock, error) {
+	timezone = strings.TrimSpace(timezone)
+	if timezone == "" {
+		return DayClock{}, fmt.Errorf("timezone cannot be empty")
+	}
+
+	// LoadLocation treats "Local" as the server zone, which is never what a user means
+	if timezone == "Local" {
+		return DayClock{}, fmt.Errorf("invalid timezone: %s", timezone)
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return DayClock{}, fmt.Errorf("invalid timezone: %s", timezone)
+	}
+
+	if rolloverHour < 0 || rolloverHour > maxDayRolloverHour {
+		return DayClock{}, fmt.Errorf("day rollover hour must be between 0 and %d", maxDayRolloverHour)
+	}
+
+	if weekStart < time.Sunday || weekStart > time.Saturday {
+		return DayClock{}, fmt.Errorf("invalid week start: %d", weekStart)
+	}
+
+	return DayClock{location: location, rolloverHour: rolloverHour, weekStart: weekStart}, nil
+}
+
+// DefaultDayClock returns the clock used for users without preferences:
+// UTC days starting at midnight, weeks starting on Monday
+func DefaultDayClock() DayClock {
+	return DayClock{location: time.UTC, weekStart: time.Monday}
+}
+
+// ParseWeekday parses a weekday name (sunday, mon, tu, ...)
+func ParseWeekday(name string) (time.Weekday, error) {
+	day, ok := weekdayNames[strings.TrimSpace(strings.ToLower(name))]
+	if !ok {
+		return time.Sunday, fmt.Errorf("invalid weekday: %s", name)
+	}
+	return day, nil
+}
+
+// WeekdayName returns the lowercase full name of a weekday (monday, tuesday, ...)
+func WeekdayName(day time.Weekday) string {
+	return strings.ToLower(day.String())
+}
+
+// Location returns the clock's time zone
+func (c DayClock) Location() *time.Location {
+	if c.location == nil {
+		return time.UTC
+	}
+	return c.location
+}
+
+// Timezone returns the IANA name of the clock's time zone
+func (c DayClock) Timezone() string {
+	return c.Location().String()
+}
+
+// RolloverHour returns the local hour at which a new day starts
+func (c DayClock) RolloverHour() int {
+	return c.rolloverHour
+}
+
+// WeekStart returns the first day of the week
+func (c DayClock) WeekStart() time.Weekday {
+	return c.weekStart
+}
+
+// Day returns the calendar day the instant t belongs to (midnight in the clock's time zone)
+func (c DayClock) Day(t time.Time) time.Time {
+	local := t.In(c.Location()).Add(-time.Duration(c.rolloverHour) * time.Hour)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, c.Location())
+}
+
+// Today returns the calendar day containing now
+func (c DayClock) Today(now time.Time) time.Time {
+	return c.Day(now)
+}
+
+// Date returns the calendar day with the same date as day, whatever its location
+// Used for dates received as plain YYYY-MM-DD values
+func (c DayClock) Date(day time.Time) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, c.Location())
+}
+
+// DayStart returns the instant the calendar day starts (its rollover hour)
+// The end of a day is the start of the next one
+func (c DayClock) DayStart(day time.Time) time.Time {
+	return time.Date(day.Year(), day.Month(), day.Day(), c.rolloverHour, 0, 0, 0, c.Location())
+}
+
+// Equals checks if two clocks are equal
+func (c DayClock) Equals(other DayClock) bool {
+	return c.Timezone() == other.Timezone() &&
+		c.rolloverHour == other.rolloverHour &&
+		c.weekStart == other.weekStart
+}
@@ -0,0 +1,109 @@
+package valueobject_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+func TestNewDayClock_Invalid(t *testing.T) {
+	tests := []struct {
+		name         string
+		timezone     string
+		rolloverHour int
+		weekStart    time.Weekday
+	}{
+		{"empty timezone", "", 0, time.Monday},
+		{"unknown timezone", "Mars/Olympus_Mons", 0, time.Monday},
+		{"server local timezone", "Local", 0, time.Monday},
+		{"negative rollover", "Europe/Lisbon", -1, time.Monday},
+		{"rollover too late", "Europe/Lisbon", 13, time.Monday},
+		{"invalid week start", "Europe/Lisbon", 0, time.Weekday(7)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := valueobject.NewDayClock(tt.timezone, tt.rolloverHour, tt.weekStart); err == nil {
+				t.Error("NewDayClock() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestDayClock_Day(t *testing.T) {
+	// 2024-01-10 01:30 UTC: 01:30 in Lisbon, 22:30 of the 9th in São Paulo
+	instant := time.Date(2024, 1, 10, 1, 30, 0, 0, time.UTC)
+
+	tests := []struct {
+		name         string
+		timezone     string
+		rolloverHour int
+		want         string
+	}{
+		{"utc", "UTC", 0, "2024-01-10"},
+		{"lisbon", "Europe/Lisbon", 0, "2024-01-10"},
+		{"sao paulo", "America/Sao_Paulo", 0, "2024-01-09"},
+		{"lisbon before rollover", "Europe/Lisbon", 4, "2024-01-09"},
+		{"tokyo", "Asia/Tokyo", 0, "2024-01-10"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock, err := valueobject.NewDayClock(tt.timezone, tt.rolloverHour, time.Monday)
+			if err != nil {
+				t.Fatalf("NewDayClock() error = %v, want nil", err)
+			}
+
+			day := clock.Day(instant)
+			if got := day.Format("2006-01-02"); got != tt.want {
+				t.Errorf("Day() = %v, want %v", got, tt.want)
+			}
+			if day.Location() != clock.Location() {
+				t.Errorf("Day() location = %v, want %v", day.Location(), clock.Location())
+			}
+		})
+	}
+}
+
+func TestDayClock_DayStart(t *testing.T) {
+	clock, _ := valueobject.NewDayClock("America/Sao_Paulo", 4, time.Sunday)
+
+	// Days start at 04:00 in São Paulo (07:00 UTC)
+	start := clock.DayStart(time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC))
+	if want := time.Date(2024, 1, 10, 7, 0, 0, 0, time.UTC); !start.Equal(want) {
+		t.Errorf("DayStart() = %v, want %v", start, want)
+	}
+
+	// The instant a day starts belongs to it, the one before to the previous day
+	if got := clock.Day(start).Format("2006-01-02"); got != "2024-01-10" {
+		t.Errorf("Day(DayStart()) = %v, want %v", got, "2024-01-10")
+	}
+	if got := clock.Day(start.Add(-time.Second)).Format("2006-01-02"); got != "2024-01-09" {
+		t.Errorf("Day(DayStart() - 1s) = %v, want %v", got, "2024-01-09")
+	}
+}
+
+func TestDefaultDayClock(t *testing.T) {
+	clock := valueobject.DefaultDayClock()
+
+	if clock.Timezone() != "UTC" || clock.RolloverHour() != 0 || clock.WeekStart() != time.Monday {
+		t.Errorf("DefaultDayClock() = (%v, %v, %v), want (UTC, 0, Monday)", clock.Timezone(), clock.RolloverHour(), clock.WeekStart())
+	}
+}
+
+func TestParseWeekday(t *testing.T) {
+	for _, name := range []string{"sunday", "Sun", " SU "} {
+		if got, err := valueobject.ParseWeekday(name); err != nil || got != time.Sunday {
+			t.Errorf("ParseWeekday(%q) = (%v, %v), want (Sunday, nil)", name, got, err)
+		}
+	}
+
+	if _, err := valueobject.ParseWeekday("funday"); err == nil {
+		t.Error("ParseWeekday() error = nil, want error")
+	}
+
+	if got := valueobject.WeekdayName(time.Saturday); got != "saturday" {
+		t.Errorf("WeekdayName() = %v, want %v", got, "saturday")
+	}
+}
@@ -0,0 +1,66 @@
+package valueobject
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Supported habit difficulty levels
+const (
+	DifficultyTrivial = "trivial"
+	DifficultyEasy    = "easy"
+	DifficultyMedium  = "medium"
+	DifficultyHard    = "hard"
+)
+
+// Difficulty represents how demanding a habit is (Value Object)
+type Difficulty struct {
+	value string
+}
+
+// NewDifficulty creates a new Difficulty value object with validation
+func NewDifficulty(difficulty string) (Difficulty, error) {
+	difficulty = strings.TrimSpace(strings.ToLower(difficulty))
+
+	if difficulty == "" {
+		return Difficulty{}, fmt.Errorf("difficulty cannot be empty")
+	}
+
+	switch difficulty {
+	case DifficultyTrivial, DifficultyEasy, DifficultyMedium, DifficultyHard:
+		return Difficulty{value: difficulty}, nil
+	default:
+		return Difficulty{}, fmt.Errorf("invalid difficulty: must be one of trivial, easy, medium, hard")
+	}
+}
+
+// Value returns the difficulty string value
+func (d Difficulty) Value() string {
+	return d.value
+}
+
+// String implements the Stringer interface
+func (d Difficulty) String() string {
+	return d.value
+}
+
+// XpReward returns the experience points awarded for completing a habit of this difficulty
+func (d Difficulty) XpReward() int {
+	switch d.value {
+	case DifficultyTrivial:
+		return 5
+	case DifficultyEasy:
+		return 10
+	case DifficultyMedium:
+		return 20
+	case DifficultyHard:
+		return 40
+	default:
+		return 0
+	}
+}
+
+// Equals checks if two difficulties are equal
+func (d Difficulty) Equals(other Difficulty) bool {
+	return d.value == other.value
+}
@@ -0,0 +1,94 @@
+package valueobject_test
+
+import (
+	"testing"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+func TestNewDifficulty_ValidDifficulty(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+	}{
+		{"trivial", "trivial", "trivial"},
+		{"easy", "easy", "easy"},
+		{"medium", "medium", "medium"},
+		{"hard", "hard", "hard"},
+		{"uppercase converted", "HARD", "hard"},
+		{"surrounding spaces", "  easy  ", "easy"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			difficulty, err := valueobject.NewDifficulty(tt.input)
+			if err != nil {
+				t.Errorf("NewDifficulty() error = %v, want nil", err)
+				return
+			}
+			if difficulty.Value() != tt.want {
+				t.Errorf("NewDifficulty() = %v, want %v", difficulty.Value(), tt.want)
+			}
+		})
+	}
+}
+
+func TestNewDifficulty_InvalidDifficulty(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+	}{
+		{"empty", ""},
+		{"only spaces", "   "},
+		{"unknown", "legendary"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := valueobject.NewDifficulty(tt.input)
+			if err == nil {
+				t.Errorf("NewDifficulty() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestDifficulty_Equals(t *testing.T) {
+	easy1, _ := valueobject.NewDifficulty("easy")
+	easy2, _ := valueobject.NewDifficulty("EASY")
+	hard, _ := valueobject.NewDifficulty("hard")
+
+	if !easy1.Equals(easy2) {
+		t.Error("Equal difficulties should be equal")
+	}
+
+	if easy1.Equals(hard) {
+		t.Error("Different difficulties should not be equal")
+	}
+}
+
+func TestDifficulty_XpReward(t *testing.T) {
+	tests := []struct {
+		input string
+		want  int
+	}{
+		{"trivial", 5},
+		{"easy", 10},
+		{"medium", 20},
+		{"hard", 40},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			difficulty, _ := valueobject.NewDifficulty(tt.input)
+			if got := difficulty.XpReward(); got != tt.want {
+				t.Errorf("XpReward() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	if got := (valueobject.Difficulty{}).XpReward(); got != 0 {
+		t.Errorf("zero Difficulty XpReward() = %v, want 0", got)
+	}
+}
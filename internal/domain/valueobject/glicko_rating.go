@@ -0,0 +1,184 @@
+package valueobject
+
+import (
+	"fmt"
+	"math"
+)
+
+// Glicko-2 values of a character that never fought a ranked battle
+const (
+	InitialRating           = 1500.0
+	InitialRatingDeviation  = 350.0 // Also the highest deviation: a rating is never less certain than a new one
+	InitialRatingVolatility = 0.06
+)
+
+// Glicko-2 system constants
+const (
+	glickoScale   = 173.7178 // Converts ratings and deviations to the Glicko-2 scale
+	glickoTau     = 0.5      // Constrains volatility changes over time (0.3 to 1.2, lower is steadier)
+	glickoEpsilon = 0.000001 // Convergence tolerance of the volatility iteration
+)
+
+// Scores of a ranked battle
+const (
+	RatingScoreLoss = 0.0
+	RatingScoreDraw = 0.5
+	RatingScoreWin  = 1.0
+)
+
+// GlickoRating represents a competitive rating with its deviation and volatility (Value Object)
+// The deviation measures how uncertain the rating is; the volatility how erratic the player's results are
+type GlickoRating struct {
+	rating     float64
+	deviation  float64
+	volatility float64
+}
+
+// GlickoResult represents the score of one rated game against an opponent
+type GlickoResult struct {
+	Opponent GlickoRating // Opponent's rating before the game
+	Score    float64      // RatingScoreWin, RatingScoreDraw or RatingScoreLoss
+}
+
+// InitialGlickoRating returns the rating of a character that never fought a ranked battle
+func InitialGlickoRating() GlickoRating {
+	return GlickoRating{
+		rating:     InitialRating,
+		deviation:  InitialRatingDeviation,
+		volatility: InitialRatingVolatility,
+	}
+}
+
+// NewGlickoRating creates a GlickoRating from stored values with validation
+func NewGlickoRating(rating float64, deviation float64, volatility float64) (GlickoRating, error) {
+	if math.IsNaN(rating) || math.IsInf(rating, 0) {
+		return GlickoRating{}, fmt.Errorf("rating must be a finite number")
+	}
+	if deviation <= 0 || deviation > InitialRatingDeviation {
+		return GlickoRating{}, fmt.Errorf("rating deviation must be between 0 and %g", InitialRatingDeviation)
+	}
+	if volatility <= 0 || math.IsInf(volatility, 0) {
+		return GlickoRating{}, fmt.Errorf("rating volatility must be positive")
+	}
+
+	return GlickoRating{
+		rating:     rating,
+		deviation:  deviation,
+		volatility: volatility,
+	}, nil
+}
+
+// Rating returns the rating (1500 for a new character)
+func (r GlickoRating) Rating() float64 {
+	return r.rating
+}
+
+// Deviation returns the rating deviation (RD): the rating is within about two deviations of the true strength
+func (r GlickoRating) Deviation() float64 {
+	return r.deviation
+}
+
+// Volatility returns the expected fluctuation of the rating
+func (r GlickoRating) Volatility() float64 {
+	return r.volatility
+}
+
+// Decay returns the rating after the given number of rating periods without games
+// Only the deviation changes: the longer a character is inactive, the less certain its rating is
+func (r GlickoRating) Decay(periods float64) GlickoRating {
+	if periods <= 0 {
+		return r
+	}
+
+	phi := r.deviation / glickoScale
+	phi = math.Sqrt(phi*phi + periods*r.volatility*r.volatility)
+
+	return GlickoRating{
+		rating:     r.rating,
+		deviation:  min(phi*glickoScale, InitialRatingDeviation),
+		volatility: r.volatility,
+	}
+}
+
+// Update returns the rating after a rating period with the given results (Glicko-2, steps 2 to 8)
+// A period without results only grows the deviation
+func (r GlickoRating) Update(results []GlickoResult) GlickoRating {
+	if len(results) == 0 {
+		return r.Decay(1)
+	}
+
+	mu := (r.rating - InitialRating) / glickoScale
+	phi := r.deviation / glickoScale
+
+	// Estimated variance (v) and improvement (delta) from the game outcomes
+	var varianceInverse, improvement float64
+	for _, result := range results {
+		opponentMu := (result.Opponent.rating - InitialRating) / glickoScale
+		g := glickoG(result.Opponent.deviation / glickoScale)
+		expected := 1 / (1 + math.Exp(-g*(mu-opponentMu)))
+
+		varianceInverse += g * g * expected * (1 - expected)
+		improvement += g * (result.Score - expected)
+	}
+	variance := 1 / varianceInverse
+	delta := variance * improvement
+
+	volatility := glickoVolatility(r.volatility, phi, variance, delta)
+
+	phiStar := math.Sqrt(phi*phi + volatility*volatility)
+	newPhi := 1 / math.Sqrt(1/(phiStar*phiStar)+1/variance)
+	newMu := mu + newPhi*newPhi*improvement
+
+	return GlickoRating{
+		rating:     newMu*glickoScale + InitialRating,
+		deviation:  min(newPhi*glickoScale, InitialRatingDeviation),
+		volatility: volatility,
+	}
+}
+
+// Equals checks if two ratings are equal
+func (r GlickoRating) Equals(other GlickoRating) bool {
+	return r.rating == other.rating && r.deviation == other.deviation && r.volatility == other.volatility
+}
+
+// glickoG weighs a game by the opponent's deviation (uncertain opponents count less)
+func glickoG(phi float64) float64 {
+	return 1 / math.Sqrt(1+3*phi*phi/(math.Pi*math.Pi))
+}
+
+// glickoVolatility computes the new volatility (Glicko-2 step 5, Illinois algorithm)
+func glickoVolatility(sigma float64, phi float64, variance float64, delta float64) float64 {
+	alpha := math.Log(sigma * sigma)
+	f := func(x float64) float64 {
+		ex := math.Exp(x)
+		denominator := phi*phi + variance + ex
+		return ex*(delta*delta-phi*phi-variance-ex)/(2*denominator*denominator) - (x-alpha)/(glickoTau*glickoTau)
+	}
+
+	// Bracket the root
+	a := alpha
+	var b float64
+	if delta*delta > phi*phi+variance {
+		b = math.Log(delta*delta - phi*phi - variance)
+	} else {
+		k := 1.0
+		for f(alpha-k*glickoTau) < 0 {
+			k++
+		}
+		b = alpha - k*glickoTau
+	}
+
+	fa, fb := f(a), f(b)
+	for math.Abs(b-a) > glickoEpsilon {
+		c := a + (a-b)*fa/(fb-fa)
+		fc := f(c)
+		if fc*fb <= 0 {
+			a, fa = b, fb
+		} else {
+			fa /= 2
+		}
+		b, fb = c, fc
+	}
+
+	return math.Exp(a / 2)
+}
@@ -0,0 +1,131 @@
+package valueobject_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+func mustGlickoRating(t *testing.T, rating float64, deviation float64, volatility float64) valueobject.GlickoRating {
+	t.Helper()
+
+	r, err := valueobject.NewGlickoRating(rating, deviation, volatility)
+	if err != nil {
+		t.Fatalf("NewGlickoRating() error = %v, want nil", err)
+	}
+	return r
+}
+
+func assertClose(t *testing.T, name string, got float64, want float64, tolerance float64) {
+	t.Helper()
+
+	if math.Abs(got-want) > tolerance {
+		t.Errorf("%s = %v, want %v (±%v)", name, got, want, tolerance)
+	}
+}
+
+// The example from Glickman's "Example of the Glicko-2 system"
+func TestGlickoRating_Update_PaperExample(t *testing.T) {
+	player := mustGlickoRating(t, 1500, 200, 0.06)
+
+	updated := player.Update([]valueobject.GlickoResult{
+		{Opponent: mustGlickoRating(t, 1400, 30, 0.06), Score: valueobject.RatingScoreWin},
+		{Opponent: mustGlickoRating(t, 1550, 100, 0.06), Score: valueobject.RatingScoreLoss},
+		{Opponent: mustGlickoRating(t, 1700, 300, 0.06), Score: valueobject.RatingScoreLoss},
+	})
+
+	assertClose(t, "Rating()", updated.Rating(), 1464.06, 0.01)
+	assertClose(t, "Deviation()", updated.Deviation(), 151.52, 0.01)
+	assertClose(t, "Volatility()", updated.Volatility(), 0.05999, 0.00001)
+}
+
+func TestGlickoRating_Update_SingleGame(t *testing.T) {
+	initial := valueobject.InitialGlickoRating()
+
+	won := initial.Update([]valueobject.GlickoResult{{Opponent: initial, Score: valueobject.RatingScoreWin}})
+	lost := initial.Update([]valueobject.GlickoResult{{Opponent: initial, Score: valueobject.RatingScoreLoss}})
+	drew := initial.Update([]valueobject.GlickoResult{{Opponent: initial, Score: valueobject.RatingScoreDraw}})
+
+	if won.Rating() <= initial.Rating() {
+		t.Errorf("Rating() after a win = %v, want more than %v", won.Rating(), initial.Rating())
+	}
+	if lost.Rating() >= initial.Rating() {
+		t.Errorf("Rating() after a loss = %v, want less than %v", lost.Rating(), initial.Rating())
+	}
+	assertClose(t, "Rating() after a draw between equals", drew.Rating(), initial.Rating(), 0.000001)
+	assertClose(t, "rating gained + rating lost", won.Rating()+lost.Rating(), 2*initial.Rating(), 0.000001)
+
+	if won.Deviation() >= initial.Deviation() {
+		t.Errorf("Deviation() after a game = %v, want less than %v", won.Deviation(), initial.Deviation())
+	}
+}
+
+func TestGlickoRating_Update_UpsetMovesMore(t *testing.T) {
+	weak := mustGlickoRating(t, 1400, 80, 0.06)
+	strong := mustGlickoRating(t, 1700, 80, 0.06)
+
+	upset := weak.Update([]valueobject.GlickoResult{{Opponent: strong, Score: valueobject.RatingScoreWin}})
+	expected := weak.Update([]valueobject.GlickoResult{{Opponent: mustGlickoRating(t, 1200, 80, 0.06), Score: valueobject.RatingScoreWin}})
+
+	if upset.Rating()-weak.Rating() <= expected.Rating()-weak.Rating() {
+		t.Errorf("beating a stronger opponent gained %v, want more than the %v of beating a weaker one",
+			upset.Rating()-weak.Rating(), expected.Rating()-weak.Rating())
+	}
+}
+
+func TestGlickoRating_Decay(t *testing.T) {
+	rating := mustGlickoRating(t, 1800, 50, 0.06)
+
+	if got := rating.Decay(0); !got.Equals(rating) {
+		t.Errorf("Decay(0) = %+v, want unchanged", got)
+	}
+
+	month := rating.Decay(30)
+	year := rating.Decay(365)
+
+	if month.Rating() != rating.Rating() || month.Volatility() != rating.Volatility() {
+		t.Errorf("Decay() changed rating or volatility: %+v", month)
+	}
+	if month.Deviation() <= rating.Deviation() || year.Deviation() <= month.Deviation() {
+		t.Errorf("Deviation() = %v (month) and %v (year), want growing from %v", month.Deviation(), year.Deviation(), rating.Deviation())
+	}
+
+	// sqrt(phi² + periods·σ²) back on the Glicko scale
+	phi := 50 / 173.7178
+	assertClose(t, "Deviation() after 30 periods", month.Deviation(), math.Sqrt(phi*phi+30*0.06*0.06)*173.7178, 0.000001)
+
+	if got := rating.Decay(1000000).Deviation(); got != valueobject.InitialRatingDeviation {
+		t.Errorf("Deviation() after a long inactivity = %v, want capped at %v", got, valueobject.InitialRatingDeviation)
+	}
+}
+
+func TestGlickoRating_Update_NoGamesDecaysOnePeriod(t *testing.T) {
+	rating := mustGlickoRating(t, 1600, 100, 0.06)
+
+	if got, want := rating.Update(nil), rating.Decay(1); !got.Equals(want) {
+		t.Errorf("Update(nil) = %+v, want %+v", got, want)
+	}
+}
+
+func TestNewGlickoRating_Validation(t *testing.T) {
+	tests := []struct {
+		name       string
+		rating     float64
+		deviation  float64
+		volatility float64
+	}{
+		{"zero deviation", 1500, 0, 0.06},
+		{"deviation above initial", 1500, 351, 0.06},
+		{"zero volatility", 1500, 200, 0},
+		{"not a number", math.NaN(), 200, 0.06},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := valueobject.NewGlickoRating(tt.rating, tt.deviation, tt.volatility); err == nil {
+				t.Error("NewGlickoRating() error = nil, want error")
+			}
+		})
+	}
+}
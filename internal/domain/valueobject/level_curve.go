@@ -0,0 +1,231 @@
+package valueobject
+
+import (
+	"fmt"
+	"math"
+)
+
+// Supported level curve types (selected in configuration)
+const (
+	LevelCurvePolynomial  = "polynomial"
+	LevelCurveExponential = "exponential"
+	LevelCurveTable       = "table"
+)
+
+// maxXpPerLevel keeps steep curves within the range of the XP columns
+const maxXpPerLevel = math.MaxInt32
+
+// LevelCurve defines how much XP each level requires and the level cap (Port)
+// Implementations are selected in configuration so the progression can be rebalanced without code changes
+type LevelCurve interface {
+	// Type returns the kind of curve (polynomial, exponential or table)
+	Type() string
+
+	// XpForNextLevel returns the XP needed to go from level to level+1 (0 at the level cap)
+	XpForNextLevel(level int) int
+
+	// MaxLevel returns the highest level a character can reach (0 when uncapped)
+	MaxLevel() int
+}
+
+// PolynomialLevelCurve requires baseXp * level^exponent XP per level (Value Object)
+type PolynomialLevelCurve struct {
+	baseXp   int
+	exponent float64
+	maxLevel int
+}
+
+// NewPolynomialLevelCurve creates a new PolynomialLevelCurve value object with validation
+func NewPolynomialLevelCurve(baseXp int, exponent float64, maxLevel int) (PolynomialLevelCurve, error) {
+	if baseXp <= 0 {
+		return PolynomialLevelCurve{}, fmt.Errorf("level curve base xp must be positive")
+	}
+	if exponent < 0 {
+		return PolynomialLevelCurve{}, fmt.Errorf("level curve exponent cannot be negative")
+	}
+	if err := validateMaxLevel(maxLevel); err != nil {
+		return PolynomialLevelCurve{}, err
+	}
+
+	return PolynomialLevelCurve{baseXp: baseXp, exponent: exponent, maxLevel: maxLevel}, nil
+}
+
+// DefaultLevelCurve returns the original progression: 100 * level^1.5 XP per level, uncapped
+func DefaultLevelCurve() PolynomialLevelCurve {
+	return PolynomialLevelCurve{baseXp: 100, exponent: 1.5}
+}
+
+// Type returns the kind of curve
+func (c PolynomialLevelCurve) Type() string {
+	return LevelCurvePolynomial
+}
+
+// XpForNextLevel returns the XP needed to go from level to level+1 (0 at the level cap)
+func (c PolynomialLevelCurve) XpForNextLevel(level int) int {
+	if isAtMaxLevel(level, c.maxLevel) {
+		return 0
+	}
+	return clampXp(float64(c.baseXp) * math.Pow(float64(max(level, 1)), c.exponent))
+}
+
+// MaxLevel returns the highest level a character can reach (0 when uncapped)
+func (c PolynomialLevelCurve) MaxLevel() int {
+	return c.maxLevel
+}
+
+// ExponentialLevelCurve requires baseXp * growth^(level-1) XP per level (Value Object)
+type ExponentialLevelCurve struct {
+	baseXp   int
+	growth   float64
+	maxLevel int
+}
+
+// NewExponentialLevelCurve creates a new ExponentialLevelCurve value object with validation
+func NewExponentialLevelCurve(baseXp int, growth float64, maxLevel int) (ExponentialLevelCurve, error) {
+	if baseXp <= 0 {
+		return ExponentialLevelCurve{}, fmt.Errorf("level curve base xp must be positive")
+	}
+	if growth < 1 {
+		return ExponentialLevelCurve{}, fmt.Errorf("level curve growth must be at least 1")
+	}
+	if err := validateMaxLevel(maxLevel); err != nil {
+		return ExponentialLevelCurve{}, err
+	}
+
+	return ExponentialLevelCurve{baseXp: baseXp, growth: growth, maxLevel: maxLevel}, nil
+}
+
+// Type returns the kind of curve
+func (c ExponentialLevelCurve) Type() string {
+	return LevelCurveExponential
+}
+
+// XpForNextLevel returns the XP needed to go from level to level+1 (0 at the level cap)
+func (c ExponentialLevelCurve) XpForNextLevel(level int) int {
+	if isAtMaxLevel(level, c.maxLevel) {
+		return 0
+	}
+	return clampXp(float64(c.baseXp) * math.Pow(c.growth, float64(max(level, 1)-1)))
+}
+
+// MaxLevel returns the highest level a character can reach (0 when uncapped)
+func (c ExponentialLevelCurve) MaxLevel() int {
+	return c.maxLevel
+}
+
+// TableLevelCurve looks up the XP of each level in a designer-provided table (Value Object)
+// The table holds the XP needed to leave levels 1, 2, 3...; the level after its last entry is the cap
+type TableLevelCurve struct {
+	xpPerLevel []int
+	maxLevel   int
+}
+
+// NewTableLevelCurve creates a new TableLevelCurve value object with validation
+// maxLevel lowers the cap below the end of the table (0 keeps the table's own cap)
+func NewTableLevelCurve(xpPerLevel []int, maxLevel int) (TableLevelCurve, error) {
+	if len(xpPerLevel) == 0 {
+		return TableLevelCurve{}, fmt.Errorf("level curve table cannot be empty")
+	}
+	for i, xp := range xpPerLevel {
+		if xp <= 0 {
+			return TableLevelCurve{}, fmt.Errorf("level curve table entry for level %d must be positive", i+1)
+		}
+	}
+	if err := validateMaxLevel(maxLevel); err != nil {
+		return TableLevelCurve{}, err
+	}
+	if maxLevel > len(xpPerLevel)+1 {
+		return TableLevelCurve{}, fmt.Errorf("max level %d is beyond the level curve table (levels 1 to %d)", maxLevel, len(xpPerLevel)+1)
+	}
+
+	if maxLevel == 0 {
+		maxLevel = len(xpPerLevel) + 1
+	}
+
+	table := make([]int, len(xpPerLevel))
+	copy(table, xpPerLevel)
+
+	return TableLevelCurve{xpPerLevel: table, maxLevel: maxLevel}, nil
+}
+
+// Type returns the kind of curve
+func (c TableLevelCurve) Type() string {
+	return LevelCurveTable
+}
+
+// XpForNextLevel returns the XP needed to go from level to level+1 (0 at the level cap)
+func (c TableLevelCurve) XpForNextLevel(level int) int {
+	if isAtMaxLevel(level, c.maxLevel) {
+		return 0
+	}
+	return c.xpPerLevel[max(level, 1)-1]
+}
+
+// MaxLevel returns the highest level a character can reach
+func (c TableLevelCurve) MaxLevel() int {
+	return c.maxLevel
+}
+
+// GainLevelXp adds xp to a progress of currentXp within level, leveling up on curve
+// XP keeps accumulating once the level cap is reached
+// Returns the new level, the new current XP and the number of levels gained
+func GainLevelXp(curve LevelCurve, level int, currentXp int, xp int) (int, int, int) {
+	currentXp += xp
+
+	levelsGained := 0
+	for !isAtMaxLevel(level, curve.MaxLevel()) && currentXp >= curve.XpForNextLevel(level) {
+		currentXp -= curve.XpForNextLevel(level)
+		level++
+		levelsGained++
+	}
+
+	return level, currentXp, levelsGained
+}
+
+// LoseLevelXp removes xp from a progress of currentXp within level, de-leveling on curve
+// Each level lost carries the remainder into that level's XpForNextLevel (the exact inverse of GainLevelXp);
+// progress never drops below level 1 with 0 XP
+// Returns the new level, the new current XP, the number of levels lost and the XP actually removed
+func LoseLevelXp(curve LevelCurve, level int, currentXp int, xp int) (int, int, int, int) {
+	xpLost := xp
+	currentXp -= xp
+
+	levelsLost := 0
+	for currentXp < 0 && level > 1 {
+		level--
+		currentXp += curve.XpForNextLevel(level)
+		levelsLost++
+	}
+
+	// Level 1 with 0 XP is the floor
+	if currentXp < 0 {
+		xpLost += currentXp
+		currentXp = 0
+	}
+
+	return level, currentXp, levelsLost, xpLost
+}
+
+// validateMaxLevel checks a level cap (0 means uncapped)
+func validateMaxLevel(maxLevel int) error {
+	if maxLevel < 0 {
+		return fmt.Errorf("max level cannot be negative")
+	}
+	if maxLevel == 1 {
+		return fmt.Errorf("max level must be at least 2")
+	}
+	return nil
+}
+
+// isAtMaxLevel reports whether level reached a cap (0 means uncapped)
+func isAtMaxLevel(level int, maxLevel int) bool {
+	return maxLevel > 0 && level >= maxLevel
+}
+
+// clampXp rounds a level's XP, keeping it between 1 and maxXpPerLevel
+func clampXp(xp float64) int {
+	if xp >= maxXpPerLevel {
+		return maxXpPerLevel
+	}
+	return max(int(math.Round(xp)), 1)
+}
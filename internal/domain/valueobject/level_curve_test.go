@@ -0,0 +1,167 @@
+package valueobject_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+func TestDefaultLevelCurve(t *testing.T) {
+	curve := valueobject.DefaultLevelCurve()
+
+	if curve.Type() != valueobject.LevelCurvePolynomial {
+		t.Errorf("Type() = %v, want %v", curve.Type(), valueobject.LevelCurvePolynomial)
+	}
+	if curve.MaxLevel() != 0 {
+		t.Errorf("MaxLevel() = %v, want 0 (uncapped)", curve.MaxLevel())
+	}
+
+	// The default must keep the original 100 * level^1.5 progression
+	for level := 1; level <= 100; level++ {
+		want := int(math.Round(100 * math.Pow(float64(level), 1.5)))
+		if got := curve.XpForNextLevel(level); got != want {
+			t.Errorf("XpForNextLevel(%d) = %v, want %v", level, got, want)
+		}
+	}
+}
+
+func TestPolynomialLevelCurve_MaxLevel(t *testing.T) {
+	curve, err := valueobject.NewPolynomialLevelCurve(50, 2, 10)
+	if err != nil {
+		t.Fatalf("NewPolynomialLevelCurve() error = %v, want nil", err)
+	}
+
+	if got := curve.XpForNextLevel(3); got != 450 {
+		t.Errorf("XpForNextLevel(3) = %v, want 450", got)
+	}
+	if got := curve.XpForNextLevel(9); got != 4050 {
+		t.Errorf("XpForNextLevel(9) = %v, want 4050", got)
+	}
+	if got := curve.XpForNextLevel(10); got != 0 {
+		t.Errorf("XpForNextLevel(10) = %v, want 0 at the level cap", got)
+	}
+}
+
+func TestExponentialLevelCurve_XpForNextLevel(t *testing.T) {
+	curve, err := valueobject.NewExponentialLevelCurve(100, 2, 0)
+	if err != nil {
+		t.Fatalf("NewExponentialLevelCurve() error = %v, want nil", err)
+	}
+
+	tests := []struct {
+		level int
+		want  int
+	}{
+		{1, 100},
+		{2, 200},
+		{5, 1600},
+		{100, math.MaxInt32}, // Clamped instead of overflowing
+	}
+
+	for _, tt := range tests {
+		if got := curve.XpForNextLevel(tt.level); got != tt.want {
+			t.Errorf("XpForNextLevel(%d) = %v, want %v", tt.level, got, tt.want)
+		}
+	}
+}
+
+func TestTableLevelCurve_XpForNextLevel(t *testing.T) {
+	table := []int{100, 250, 500}
+
+	curve, err := valueobject.NewTableLevelCurve(table, 0)
+	if err != nil {
+		t.Fatalf("NewTableLevelCurve() error = %v, want nil", err)
+	}
+
+	// The table must be copied on construction
+	table[0] = 1
+
+	if curve.MaxLevel() != 4 {
+		t.Errorf("MaxLevel() = %v, want 4", curve.MaxLevel())
+	}
+
+	tests := []struct {
+		level int
+		want  int
+	}{
+		{1, 100},
+		{2, 250},
+		{3, 500},
+		{4, 0},
+		{5, 0},
+	}
+
+	for _, tt := range tests {
+		if got := curve.XpForNextLevel(tt.level); got != tt.want {
+			t.Errorf("XpForNextLevel(%d) = %v, want %v", tt.level, got, tt.want)
+		}
+	}
+
+	capped, err := valueobject.NewTableLevelCurve([]int{100, 250, 500}, 3)
+	if err != nil {
+		t.Fatalf("NewTableLevelCurve() error = %v, want nil", err)
+	}
+	if got := capped.XpForNextLevel(3); got != 0 {
+		t.Errorf("XpForNextLevel(3) = %v, want 0 at the level cap", got)
+	}
+}
+
+func TestGainLevelXp_StopsAtMaxLevel(t *testing.T) {
+	curve, err := valueobject.NewTableLevelCurve([]int{100, 200}, 0)
+	if err != nil {
+		t.Fatalf("NewTableLevelCurve() error = %v, want nil", err)
+	}
+
+	// 300 XP reaches the cap (level 3); the rest keeps accumulating
+	level, currentXp, levelsGained := valueobject.GainLevelXp(curve, 1, 0, 350)
+	if level != 3 || currentXp != 50 || levelsGained != 2 {
+		t.Errorf("GainLevelXp() = (%v, %v, %v), want (3, 50, 2)", level, currentXp, levelsGained)
+	}
+
+	level, currentXp, levelsGained = valueobject.GainLevelXp(curve, 3, 50, 1000)
+	if level != 3 || currentXp != 1050 || levelsGained != 0 {
+		t.Errorf("GainLevelXp() at the cap = (%v, %v, %v), want (3, 1050, 0)", level, currentXp, levelsGained)
+	}
+
+	// Losing the same XP must restore the starting point
+	level, currentXp, levelsLost, xpLost := valueobject.LoseLevelXp(curve, 3, 50, 350)
+	if level != 1 || currentXp != 0 || levelsLost != 2 || xpLost != 350 {
+		t.Errorf("LoseLevelXp() = (%v, %v, %v, %v), want (1, 0, 2, 350)", level, currentXp, levelsLost, xpLost)
+	}
+}
+
+func TestLoseLevelXp_FloorsAtLevelOne(t *testing.T) {
+	curve := valueobject.DefaultLevelCurve()
+
+	// Level 2 with 30 XP holds 130 XP in total; only that much can be lost
+	level, currentXp, levelsLost, xpLost := valueobject.LoseLevelXp(curve, 2, 30, 500)
+	if level != 1 || currentXp != 0 || levelsLost != 1 || xpLost != 130 {
+		t.Errorf("LoseLevelXp() = (%v, %v, %v, %v), want (1, 0, 1, 130)", level, currentXp, levelsLost, xpLost)
+	}
+}
+
+func TestNewLevelCurve_Invalid(t *testing.T) {
+	tests := []struct {
+		name string
+		new  func() error
+	}{
+		{"polynomial zero base", func() error { _, err := valueobject.NewPolynomialLevelCurve(0, 1.5, 0); return err }},
+		{"polynomial negative exponent", func() error { _, err := valueobject.NewPolynomialLevelCurve(100, -1, 0); return err }},
+		{"polynomial max level one", func() error { _, err := valueobject.NewPolynomialLevelCurve(100, 1.5, 1); return err }},
+		{"polynomial negative max level", func() error { _, err := valueobject.NewPolynomialLevelCurve(100, 1.5, -5); return err }},
+		{"exponential zero base", func() error { _, err := valueobject.NewExponentialLevelCurve(0, 1.2, 0); return err }},
+		{"exponential shrinking growth", func() error { _, err := valueobject.NewExponentialLevelCurve(100, 0.9, 0); return err }},
+		{"empty table", func() error { _, err := valueobject.NewTableLevelCurve(nil, 0); return err }},
+		{"non-positive table entry", func() error { _, err := valueobject.NewTableLevelCurve([]int{100, 0}, 0); return err }},
+		{"max level beyond table", func() error { _, err := valueobject.NewTableLevelCurve([]int{100, 200}, 4); return err }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.new(); err == nil {
+				t.Error("error = nil, want error")
+			}
+		})
+	}
+}
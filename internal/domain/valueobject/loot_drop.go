@@ -0,0 +1,56 @@
+package valueobject
+
+import (
+	"fmt"
+	"strings"
+)
+
+// LootDrop is an item a monster may drop when defeated: the item, its drop chance (%) and quantity (Value Object)
+type LootDrop struct {
+	item     string
+	chance   int
+	quantity int
+}
+
+// NewLootDrop creates a new LootDrop value object with validation
+func NewLootDrop(item string, chance int, quantity int) (LootDrop, error) {
+	item = strings.TrimSpace(item)
+	if item == "" {
+		return LootDrop{}, fmt.Errorf("loot item cannot be empty")
+	}
+
+	if chance < 1 || chance > 100 {
+		return LootDrop{}, fmt.Errorf("loot chance must be between 1 and 100, got %d", chance)
+	}
+
+	if quantity < 1 {
+		return LootDrop{}, fmt.Errorf("loot quantity must be at least 1, got %d", quantity)
+	}
+
+	return LootDrop{item: item, chance: chance, quantity: quantity}, nil
+}
+
+// Item returns the name of the dropped item
+func (l LootDrop) Item() string {
+	return l.item
+}
+
+// Chance returns the chance (%) of the item dropping
+func (l LootDrop) Chance() int {
+	return l.chance
+}
+
+// Quantity returns how many of the item drop at once
+func (l LootDrop) Quantity() int {
+	return l.quantity
+}
+
+// DropsOn reports whether the item drops for a roll between 1 and 100
+func (l LootDrop) DropsOn(roll int) bool {
+	return roll <= l.chance
+}
+
+// Equals checks if two loot drops are equal
+func (l LootDrop) Equals(other LootDrop) bool {
+	return l == other
+}
@@ -0,0 +1,50 @@
+package valueobject_test
+
+import (
+	"testing"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+func TestNewLootDrop(t *testing.T) {
+	tests := []struct {
+		name     string
+		item     string
+		chance   int
+		quantity int
+		wantErr  bool
+	}{
+		{name: "valid drop", item: "Orelha de Goblin", chance: 50, quantity: 1},
+		{name: "guaranteed drop", item: "Moeda de Cobre", chance: 100, quantity: 3},
+		{name: "empty item", item: "  ", chance: 50, quantity: 1, wantErr: true},
+		{name: "zero chance", item: "Osso", chance: 0, quantity: 1, wantErr: true},
+		{name: "chance above 100", item: "Osso", chance: 101, quantity: 1, wantErr: true},
+		{name: "zero quantity", item: "Osso", chance: 50, quantity: 0, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			drop, err := valueobject.NewLootDrop(tt.item, tt.chance, tt.quantity)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewLootDrop() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if drop.Item() != tt.item || drop.Chance() != tt.chance || drop.Quantity() != tt.quantity {
+				t.Errorf("NewLootDrop() = %+v, want %s x%d at %d%%", drop, tt.item, tt.quantity, tt.chance)
+			}
+		})
+	}
+}
+
+func TestLootDrop_DropsOn(t *testing.T) {
+	drop, _ := valueobject.NewLootDrop("Orelha de Goblin", 30, 1)
+
+	if !drop.DropsOn(1) || !drop.DropsOn(30) {
+		t.Error("DropsOn() = false for rolls up to the chance, want true")
+	}
+	if drop.DropsOn(31) || drop.DropsOn(100) {
+		t.Error("DropsOn() = true for rolls above the chance, want false")
+	}
+}
@@ -0,0 +1,41 @@
+package valueobject
+
+import "fmt"
+
+// MissedHabitPenalty represents what a character loses for each scheduled habit it missed (Value Object)
+type MissedHabitPenalty struct {
+	xpPercent     int // Percentage of the habit's XP reward lost
+	attributeLoss int // Points lost on the habit's linked attribute
+}
+
+// NewMissedHabitPenalty creates a new MissedHabitPenalty value object with validation
+func NewMissedHabitPenalty(xpPercent int, attributeLoss int) (MissedHabitPenalty, error) {
+	if xpPercent < 0 || xpPercent > 100 {
+		return MissedHabitPenalty{}, fmt.Errorf("missed habit xp penalty must be between 0 and 100 percent")
+	}
+	if attributeLoss < 0 {
+		return MissedHabitPenalty{}, fmt.Errorf("missed habit attribute penalty cannot be negative")
+	}
+
+	return MissedHabitPenalty{xpPercent: xpPercent, attributeLoss: attributeLoss}, nil
+}
+
+// XpPercent returns the percentage of the habit's XP reward lost
+func (p MissedHabitPenalty) XpPercent() int {
+	return p.xpPercent
+}
+
+// AttributeLoss returns the points lost on the habit's linked attribute
+func (p MissedHabitPenalty) AttributeLoss() int {
+	return p.attributeLoss
+}
+
+// XpLoss returns the XP lost for missing a habit of the given difficulty
+func (p MissedHabitPenalty) XpLoss(difficulty Difficulty) int {
+	return difficulty.XpReward() * p.xpPercent / 100
+}
+
+// IsZero reports whether the penalty doesn't take anything away
+func (p MissedHabitPenalty) IsZero() bool {
+	return p.xpPercent == 0 && p.attributeLoss == 0
+}
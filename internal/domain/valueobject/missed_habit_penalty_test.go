@@ -0,0 +1,47 @@
+package valueobject_test
+
+import (
+	"testing"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+func TestNewMissedHabitPenalty(t *testing.T) {
+	tests := []struct {
+		name          string
+		xpPercent     int
+		attributeLoss int
+		wantErr       bool
+	}{
+		{"half reward and one point", 50, 1, false},
+		{"no penalty", 0, 0, false},
+		{"whole reward", 100, 0, false},
+		{"negative percent", -1, 1, true},
+		{"more than the reward", 101, 1, true},
+		{"negative attribute loss", 50, -1, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := valueobject.NewMissedHabitPenalty(tt.xpPercent, tt.attributeLoss)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewMissedHabitPenalty() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMissedHabitPenalty_XpLoss(t *testing.T) {
+	penalty, _ := valueobject.NewMissedHabitPenalty(50, 1)
+	hard, _ := valueobject.NewDifficulty("hard")
+	trivial, _ := valueobject.NewDifficulty("trivial")
+
+	if got := penalty.XpLoss(hard); got != 20 {
+		t.Errorf("XpLoss(hard) = %v, want %v", got, 20)
+	}
+
+	// Rounded down
+	if got := penalty.XpLoss(trivial); got != 2 {
+		t.Errorf("XpLoss(trivial) = %v, want %v", got, 2)
+	}
+}
@@ -0,0 +1,63 @@
+package valueobject
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Supported task priorities
+const (
+	PriorityLow    = "low"
+	PriorityMedium = "medium"
+	PriorityHigh   = "high"
+)
+
+// Priority represents how urgent a task is (Value Object)
+type Priority struct {
+	value string
+}
+
+// NewPriority creates a new Priority value object with validation
+func NewPriority(priority string) (Priority, error) {
+	priority = strings.TrimSpace(strings.ToLower(priority))
+
+	if priority == "" {
+		return Priority{}, fmt.Errorf("priority cannot be empty")
+	}
+
+	switch priority {
+	case PriorityLow, PriorityMedium, PriorityHigh:
+		return Priority{value: priority}, nil
+	default:
+		return Priority{}, fmt.Errorf("invalid priority: must be one of low, medium, high")
+	}
+}
+
+// Value returns the priority string value
+func (p Priority) Value() string {
+	return p.value
+}
+
+// String implements the Stringer interface
+func (p Priority) String() string {
+	return p.value
+}
+
+// Rank returns the priority as a sortable number (higher is more urgent)
+func (p Priority) Rank() int {
+	switch p.value {
+	case PriorityLow:
+		return 1
+	case PriorityMedium:
+		return 2
+	case PriorityHigh:
+		return 3
+	default:
+		return 0
+	}
+}
+
+// Equals checks if two priorities are equal
+func (p Priority) Equals(other Priority) bool {
+	return p.value == other.value
+}
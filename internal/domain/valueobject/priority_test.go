@@ -0,0 +1,46 @@
+package valueobject_test
+
+import (
+	"testing"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+func TestNewPriority_ValidPriority(t *testing.T) {
+	tests := []struct {
+		name  string
+		input string
+		want  string
+		rank  int
+	}{
+		{"low", "low", "low", 1},
+		{"medium", "medium", "medium", 2},
+		{"high", "high", "high", 3},
+		{"uppercase converted", "HIGH", "high", 3},
+		{"surrounding spaces", "  low  ", "low", 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			priority, err := valueobject.NewPriority(tt.input)
+			if err != nil {
+				t.Errorf("NewPriority() error = %v, want nil", err)
+				return
+			}
+			if priority.Value() != tt.want {
+				t.Errorf("NewPriority() = %v, want %v", priority.Value(), tt.want)
+			}
+			if priority.Rank() != tt.rank {
+				t.Errorf("Rank() = %v, want %v", priority.Rank(), tt.rank)
+			}
+		})
+	}
+}
+
+func TestNewPriority_InvalidPriority(t *testing.T) {
+	for _, input := range []string{"", "   ", "urgent"} {
+		if _, err := valueobject.NewPriority(input); err == nil {
+			t.Errorf("NewPriority(%q) error = nil, want error", input)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+package valueobject
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Supported raid bosses
+const (
+	RaidBossSwampHydra    = "hidra-do-pantano"
+	RaidBossAncientDragon = "dragao-anciao"
+	RaidBossLichKing      = "rei-lich"
+)
+
+// raidBossProfile describes a boss of the catalog
+type raidBossProfile struct {
+	name              string
+	hpPerMember       int // The HP pool grows with the guild, so every member's completions matter
+	rewardXpPerMember int // XP split among the contributors when the boss dies
+	duration          time.Duration
+}
+
+// raidBossOrder lists the bosses in the order the catalog is presented
+var raidBossOrder = []string{RaidBossSwampHydra, RaidBossAncientDragon, RaidBossLichKing}
+
+// raidBossCatalog holds the profile of every raid boss
+var raidBossCatalog = map[string]raidBossProfile{
+	RaidBossSwampHydra:    {"Hidra do Pântano", 300, 150, 3 * 24 * time.Hour},
+	RaidBossAncientDragon: {"Dragão Ancião", 800, 400, 7 * 24 * time.Hour},
+	RaidBossLichKing:      {"Rei Lich", 1600, 900, 14 * 24 * time.Hour},
+}
+
+// RaidBoss represents a boss a guild can raid (Value Object)
+type RaidBoss struct {
+	value string
+}
+
+// NewRaidBoss creates a new RaidBoss value object with validation
+func NewRaidBoss(boss string) (RaidBoss, error) {
+	boss = strings.TrimSpace(strings.ToLower(boss))
+
+	if boss == "" {
+		return RaidBoss{}, fmt.Errorf("raid boss cannot be empty")
+	}
+
+	if _, ok := raidBossCatalog[boss]; !ok {
+		return RaidBoss{}, fmt.Errorf("invalid raid boss: must be one of %s", strings.Join(raidBossOrder, ", "))
+	}
+
+	return RaidBoss{value: boss}, nil
+}
+
+// RaidBosses returns every boss of the catalog
+func RaidBosses() []RaidBoss {
+	bosses := make([]RaidBoss, len(raidBossOrder))
+	for i, boss := range raidBossOrder {
+		bosses[i] = RaidBoss{value: boss}
+	}
+	return bosses
+}
+
+// Value returns the boss string value
+func (b RaidBoss) Value() string {
+	return b.value
+}
+
+// String implements the Stringer interface
+func (b RaidBoss) String() string {
+	return b.value
+}
+
+// Name returns the name of the boss shown to players
+func (b RaidBoss) Name() string {
+	return raidBossCatalog[b.value].name
+}
+
+// MaxHP returns the HP pool of the boss against a guild of the given size
+func (b RaidBoss) MaxHP(members int) int {
+	return raidBossCatalog[b.value].hpPerMember * max(members, 1)
+}
+
+// RewardXp returns the XP split among the contributors against a guild of the given size
+func (b RaidBoss) RewardXp(members int) int {
+	return raidBossCatalog[b.value].rewardXpPerMember * max(members, 1)
+}
+
+// Duration returns how long the guild has to defeat the boss
+func (b RaidBoss) Duration() time.Duration {
+	return raidBossCatalog[b.value].duration
+}
+
+// Equals checks if two bosses are equal
+func (b RaidBoss) Equals(other RaidBoss) bool {
+	return b.value == other.value
+}
@@ -0,0 +1,39 @@
+package valueobject_test
+
+import (
+	"testing"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+func TestNewRaidBoss(t *testing.T) {
+	boss, err := valueobject.NewRaidBoss("  Hidra-do-Pantano ")
+	if err != nil {
+		t.Fatalf("NewRaidBoss() error = %v, want nil", err)
+	}
+	if boss.Value() != valueobject.RaidBossSwampHydra || boss.Name() != "Hidra do Pântano" {
+		t.Errorf("boss = %v (%s), want the swamp hydra", boss.Value(), boss.Name())
+	}
+
+	for _, input := range []string{"", "   ", "kraken"} {
+		if _, err := valueobject.NewRaidBoss(input); err == nil {
+			t.Errorf("NewRaidBoss(%q) error = nil, want error", input)
+		}
+	}
+}
+
+func TestRaidBoss_ScalesWithGuild(t *testing.T) {
+	for _, boss := range valueobject.RaidBosses() {
+		t.Run(boss.Value(), func(t *testing.T) {
+			if boss.Name() == "" || boss.Duration() <= 0 {
+				t.Error("Name() and Duration() should be set")
+			}
+			if boss.MaxHP(4) != 4*boss.MaxHP(1) || boss.RewardXp(4) != 4*boss.RewardXp(1) {
+				t.Errorf("MaxHP(4) = %d, RewardXp(4) = %d, want 4 times a single member's", boss.MaxHP(4), boss.RewardXp(4))
+			}
+			if boss.MaxHP(0) != boss.MaxHP(1) {
+				t.Errorf("MaxHP(0) = %d, want at least a single member's", boss.MaxHP(0))
+			}
+		})
+	}
+}
@@ -0,0 +1,334 @@
+package valueobject
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Supported recurrence types
+const (
+	RecurrenceDaily          = "daily"            // Every day
+	RecurrenceWeekly         = "weekly"           // On specific weekdays (e.g. Mon/Wed/Fri)
+	RecurrenceInterval       = "interval"         // Every N days
+	RecurrenceTimesPerPeriod = "times_per_period" // N times per week or month, any day
+)
+
+// Supported periods for RecurrenceTimesPerPeriod
+const (
+	PeriodWeek  = "week"
+	PeriodMonth = "month"
+)
+
+const maxRecurrenceInterval = 365
+
+// weekdayCodes maps weekdays to their RRULE two-letter codes
+var weekdayCodes = map[time.Weekday]string{
+	time.Sunday:    "SU",
+	time.Monday:    "MO",
+	time.Tuesday:   "TU",
+	time.Wednesday: "WE",
+	time.Thursday:  "TH",
+	time.Friday:    "FR",
+	time.Saturday:  "SA",
+}
+
+// weekdayNames maps accepted weekday inputs to weekdays
+var weekdayNames = map[string]time.Weekday{
+	"sun": time.Sunday, "sunday": time.Sunday, "su": time.Sunday,
+	"mon": time.Monday, "monday": time.Monday, "mo": time.Monday,
+	"tue": time.Tuesday, "tuesday": time.Tuesday, "tu": time.Tuesday,
+	"wed": time.Wednesday, "wednesday": time.Wednesday, "we": time.Wednesday,
+	"thu": time.Thursday, "thursday": time.Thursday, "th": time.Thursday,
+	"fri": time.Friday, "friday": time.Friday, "fr": time.Friday,
+	"sat": time.Saturday, "saturday": time.Saturday, "sa": time.Saturday,
+}
+
+// Recurrence represents when a habit is expected to be done (Value Object)
+// It is persisted as an RRULE-style string (see Rule and ParseRecurrence)
+type Recurrence struct {
+	kind     string
+	weekdays []time.Weekday // RecurrenceWeekly only, sorted Sunday..Saturday
+	interval int            // RecurrenceInterval only
+	times    int            // RecurrenceTimesPerPeriod only
+	period   string         // RecurrenceTimesPerPeriod only
+}
+
+// NewDailyRecurrence creates a recurrence due every day
+func NewDailyRecurrence() Recurrence {
+	return Recurrence{kind: RecurrenceDaily}
+}
+
+// NewWeeklyRecurrence creates a recurrence due on the given weekdays
+func NewWeeklyRecurrence(weekdays []time.Weekday) (Recurrence, error) {
+	if len(weekdays) == 0 {
+		return Recurrence{}, fmt.Errorf("weekly recurrence requires at least one weekday")
+	}
+
+	seen := map[time.Weekday]bool{}
+	var days []time.Weekday
+	for _, day := range weekdays {
+		if day < time.Sunday || day > time.Saturday {
+			return Recurrence{}, fmt.Errorf("invalid weekday: %d", day)
+		}
+		if !seen[day] {
+			seen[day] = true
+			days = append(days, day)
+		}
+	}
+	sort.Slice(days, func(i, j int) bool { return days[i] < days[j] })
+
+	return Recurrence{kind: RecurrenceWeekly, weekdays: days}, nil
+}
+
+// NewIntervalRecurrence creates a recurrence due every N days
+func NewIntervalRecurrence(interval int) (Recurrence, error) {
+	if interval < 1 || interval > maxRecurrenceInterval {
+		return Recurrence{}, fmt.Errorf("recurrence interval must be between 1 and %d days", maxRecurrenceInterval)
+	}
+
+	// Every 1 day is just daily
+	if interval == 1 {
+		return NewDailyRecurrence(), nil
+	}
+
+	return Recurrence{kind: RecurrenceInterval, interval: interval}, nil
+}
+
+// NewTimesPerPeriodRecurrence creates a recurrence due N times per week or month
+func NewTimesPerPeriodRecurrence(times int, period string) (Recurrence, error) {
+	period = strings.TrimSpace(strings.ToLower(period))
+
+	var maxTimes int
+	switch period {
+	case PeriodWeek:
+		maxTimes = 7
+	case PeriodMonth:
+		maxTimes = 31
+	default:
+		return Recurrence{}, fmt.Errorf("invalid recurrence period: must be one of week, month")
+	}
+
+	if times < 1 || times > maxTimes {
+		return Recurrence{}, fmt.Errorf("recurrence times must be between 1 and %d per %s", maxTimes, period)
+	}
+
+	return Recurrence{kind: RecurrenceTimesPerPeriod, times: times, period: period}, nil
+}
+
+// NewRecurrence creates a recurrence from raw input (as received by the API)
+// Only the fields relevant to the given type are used
+func NewRecurrence(kind string, weekdays []string, interval int, times int, period string) (Recurrence, error) {
+	kind = strings.TrimSpace(strings.ToLower(kind))
+
+	switch kind {
+	case "", RecurrenceDaily:
+		return NewDailyRecurrence(), nil
+	case RecurrenceWeekly:
+		days := make([]time.Weekday, 0, len(weekdays))
+		for _, name := range weekdays {
+			day, ok := weekdayNames[strings.TrimSpace(strings.ToLower(name))]
+			if !ok {
+				return Recurrence{}, fmt.Errorf("invalid weekday: %s", name)
+			}
+			days = append(days, day)
+		}
+		return NewWeeklyRecurrence(days)
+	case RecurrenceInterval:
+		return NewIntervalRecurrence(interval)
+	case RecurrenceTimesPerPeriod:
+		return NewTimesPerPeriodRecurrence(times, period)
+	default:
+		return Recurrence{}, fmt.Errorf("invalid recurrence type: must be one of daily, weekly, interval, times_per_period")
+	}
+}
+
+// ParseRecurrence parses an RRULE-style string produced by Rule
+// Examples: "FREQ=DAILY", "FREQ=DAILY;INTERVAL=2", "FREQ=WEEKLY;BYDAY=MO,WE,FR", "FREQ=WEEKLY;TIMES=3"
+func ParseRecurrence(rule string) (Recurrence, error) {
+	parts := map[string]string{}
+	for _, part := range strings.Split(strings.TrimSpace(rule), ";") {
+		key, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return Recurrence{}, fmt.Errorf("invalid recurrence rule: %q", rule)
+		}
+		parts[strings.ToUpper(strings.TrimSpace(key))] = strings.ToUpper(strings.TrimSpace(value))
+	}
+
+	freq := parts["FREQ"]
+
+	if timesStr, ok := parts["TIMES"]; ok {
+		times, err := strconv.Atoi(timesStr)
+		if err != nil {
+			return Recurrence{}, fmt.Errorf("invalid recurrence times: %q", timesStr)
+		}
+		switch freq {
+		case "WEEKLY":
+			return NewTimesPerPeriodRecurrence(times, PeriodWeek)
+		case "MONTHLY":
+			return NewTimesPerPeriodRecurrence(times, PeriodMonth)
+		default:
+			return Recurrence{}, fmt.Errorf("invalid recurrence rule: %q", rule)
+		}
+	}
+
+	switch freq {
+	case "DAILY":
+		if intervalStr, ok := parts["INTERVAL"]; ok {
+			interval, err := strconv.Atoi(intervalStr)
+			if err != nil {
+				return Recurrence{}, fmt.Errorf("invalid recurrence interval: %q", intervalStr)
+			}
+			return NewIntervalRecurrence(interval)
+		}
+		return NewDailyRecurrence(), nil
+	case "WEEKLY":
+		byDay, ok := parts["BYDAY"]
+		if !ok {
+			return Recurrence{}, fmt.Errorf("invalid recurrence rule: %q", rule)
+		}
+		return NewRecurrence(RecurrenceWeekly, strings.Split(byDay, ","), 0, 0, "")
+	default:
+		return Recurrence{}, fmt.Errorf("invalid recurrence rule: %q", rule)
+	}
+}
+
+// Type returns the recurrence type
+func (r Recurrence) Type() string {
+	return r.kind
+}
+
+// Weekdays returns the weekdays of a weekly recurrence
+func (r Recurrence) Weekdays() []time.Weekday {
+	return append([]time.Weekday(nil), r.weekdays...)
+}
+
+// WeekdayNames returns the weekdays of a weekly recurrence as lowercase short names (mon, wed, ...)
+func (r Recurrence) WeekdayNames() []string {
+	names := make([]string, 0, len(r.weekdays))
+	for _, day := range r.weekdays {
+		names = append(names, strings.ToLower(day.String()[:3]))
+	}
+	return names
+}
+
+// Interval returns the number of days between occurrences of an interval recurrence
+func (r Recurrence) Interval() int {
+	return r.interval
+}
+
+// Times returns how many times per period a times-per-period recurrence is expected
+func (r Recurrence) Times() int {
+	return r.times
+}
+
+// Period returns the period of a times-per-period recurrence (week or month)
+func (r Recurrence) Period() string {
+	return r.period
+}
+
+// Rule returns the RRULE-style representation used for persistence
+func (r Recurrence) Rule() string {
+	switch r.kind {
+	case RecurrenceWeekly:
+		codes := make([]string, 0, len(r.weekdays))
+		for _, day := range r.weekdays {
+			codes = append(codes, weekdayCodes[day])
+		}
+		return "FREQ=WEEKLY;BYDAY=" + strings.Join(codes, ",")
+	case RecurrenceInterval:
+		return fmt.Sprintf("FREQ=DAILY;INTERVAL=%d", r.interval)
+	case RecurrenceTimesPerPeriod:
+		if r.period == PeriodMonth {
+			return fmt.Sprintf("FREQ=MONTHLY;TIMES=%d", r.times)
+		}
+		return fmt.Sprintf("FREQ=WEEKLY;TIMES=%d", r.times)
+	default:
+		return "FREQ=DAILY"
+	}
+}
+
+// String implements the Stringer interface
+func (r Recurrence) String() string {
+	return r.Rule()
+}
+
+// Equals checks if two recurrences are equal
+func (r Recurrence) Equals(other Recurrence) bool {
+	return r.Rule() == other.Rule()
+}
+
+// PeriodStart returns the first day of the period containing day
+// Weeks start on weekStart; recurrences without a period use the day itself
+func (r Recurrence) PeriodStart(day time.Time, weekStart time.Weekday) time.Time {
+	day = truncateToDay(day)
+
+	if r.kind != RecurrenceTimesPerPeriod {
+		return day
+	}
+
+	if r.period == PeriodMonth {
+		return time.Date(day.Year(), day.Month(), 1, 0, 0, 0, 0, day.Location())
+	}
+
+	offset := (int(day.Weekday()) - int(weekStart) + 7) % 7
+	return day.AddDate(0, 0, -offset)
+}
+
+// NextPeriodStart returns the first day after the period containing day
+// Recurrences without a period use the next day
+func (r Recurrence) NextPeriodStart(day time.Time, weekStart time.Weekday) time.Time {
+	start := r.PeriodStart(day, weekStart)
+
+	if r.kind != RecurrenceTimesPerPeriod {
+		return start.AddDate(0, 0, 1)
+	}
+
+	if r.period == PeriodMonth {
+		return start.AddDate(0, 1, 0)
+	}
+
+	return start.AddDate(0, 0, 7)
+}
+
+// IsDueOn reports whether the habit is due on day
+// anchor is the day the schedule started (used by interval recurrences);
+// completionsInPeriod is the number of completions in the current period before day
+// (used by times-per-period recurrences)
+func (r Recurrence) IsDueOn(day time.Time, anchor time.Time, completionsInPeriod int) bool {
+	day = truncateToDay(day)
+
+	switch r.kind {
+	case RecurrenceWeekly:
+		for _, weekday := range r.weekdays {
+			if day.Weekday() == weekday {
+				return true
+			}
+		}
+		return false
+	case RecurrenceInterval:
+		anchor = truncateToDay(anchor.In(day.Location()))
+		if day.Before(anchor) {
+			return false
+		}
+		return daysBetween(anchor, day)%r.interval == 0
+	case RecurrenceTimesPerPeriod:
+		return completionsInPeriod < r.times
+	default:
+		return true
+	}
+}
+
+// truncateToDay returns midnight of the given time in its own location
+func truncateToDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// daysBetween returns the number of calendar days from a to b (ignores DST shifts)
+func daysBetween(a, b time.Time) int {
+	ua := time.Date(a.Year(), a.Month(), a.Day(), 0, 0, 0, 0, time.UTC)
+	ub := time.Date(b.Year(), b.Month(), b.Day(), 0, 0, 0, 0, time.UTC)
+	return int(ub.Sub(ua).Hours() / 24)
+}
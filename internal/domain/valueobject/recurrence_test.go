@@ -0,0 +1,168 @@
+package valueobject_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+func TestNewRecurrence_Valid(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     string
+		weekdays []string
+		interval int
+		times    int
+		period   string
+		wantRule string
+	}{
+		{"empty defaults to daily", "", nil, 0, 0, "", "FREQ=DAILY"},
+		{"daily", "daily", nil, 0, 0, "", "FREQ=DAILY"},
+		{"weekly sorted and deduplicated", "weekly", []string{"fri", "Monday", "WE", "mon"}, 0, 0, "", "FREQ=WEEKLY;BYDAY=MO,WE,FR"},
+		{"every 2 days", "interval", nil, 2, 0, "", "FREQ=DAILY;INTERVAL=2"},
+		{"every 1 day is daily", "interval", nil, 1, 0, "", "FREQ=DAILY"},
+		{"3 times per week", "times_per_period", nil, 0, 3, "week", "FREQ=WEEKLY;TIMES=3"},
+		{"10 times per month", "times_per_period", nil, 0, 10, "MONTH", "FREQ=MONTHLY;TIMES=10"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			recurrence, err := valueobject.NewRecurrence(tt.kind, tt.weekdays, tt.interval, tt.times, tt.period)
+			if err != nil {
+				t.Fatalf("NewRecurrence() error = %v, want nil", err)
+			}
+			if recurrence.Rule() != tt.wantRule {
+				t.Errorf("Rule() = %v, want %v", recurrence.Rule(), tt.wantRule)
+			}
+		})
+	}
+}
+
+func TestNewRecurrence_Invalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		kind     string
+		weekdays []string
+		interval int
+		times    int
+		period   string
+	}{
+		{"unknown type", "hourly", nil, 0, 0, ""},
+		{"weekly without days", "weekly", nil, 0, 0, ""},
+		{"weekly with unknown day", "weekly", []string{"funday"}, 0, 0, ""},
+		{"zero interval", "interval", nil, 0, 0, ""},
+		{"interval too large", "interval", nil, 366, 0, ""},
+		{"zero times", "times_per_period", nil, 0, 0, "week"},
+		{"too many times per week", "times_per_period", nil, 0, 8, "week"},
+		{"unknown period", "times_per_period", nil, 0, 3, "year"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := valueobject.NewRecurrence(tt.kind, tt.weekdays, tt.interval, tt.times, tt.period)
+			if err == nil {
+				t.Error("NewRecurrence() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestParseRecurrence_RoundTrip(t *testing.T) {
+	rules := []string{
+		"FREQ=DAILY",
+		"FREQ=DAILY;INTERVAL=3",
+		"FREQ=WEEKLY;BYDAY=MO,WE,FR",
+		"FREQ=WEEKLY;TIMES=3",
+		"FREQ=MONTHLY;TIMES=12",
+	}
+
+	for _, rule := range rules {
+		t.Run(rule, func(t *testing.T) {
+			recurrence, err := valueobject.ParseRecurrence(rule)
+			if err != nil {
+				t.Fatalf("ParseRecurrence() error = %v, want nil", err)
+			}
+			if recurrence.Rule() != rule {
+				t.Errorf("Rule() = %v, want %v", recurrence.Rule(), rule)
+			}
+		})
+	}
+}
+
+func TestParseRecurrence_Invalid(t *testing.T) {
+	rules := []string{"", "DAILY", "FREQ=YEARLY", "FREQ=WEEKLY", "FREQ=DAILY;INTERVAL=x", "FREQ=DAILY;TIMES=2"}
+
+	for _, rule := range rules {
+		t.Run(rule, func(t *testing.T) {
+			if _, err := valueobject.ParseRecurrence(rule); err == nil {
+				t.Error("ParseRecurrence() error = nil, want error")
+			}
+		})
+	}
+}
+
+func TestRecurrence_IsDueOn(t *testing.T) {
+	// 2024-01-01 is a Monday
+	monday := time.Date(2024, 1, 1, 9, 0, 0, 0, time.UTC)
+	tuesday := monday.AddDate(0, 0, 1)
+	anchor := time.Date(2023, 12, 30, 22, 0, 0, 0, time.UTC) // Saturday
+
+	weekly, _ := valueobject.NewRecurrence("weekly", []string{"mon", "wed", "fri"}, 0, 0, "")
+	everyOtherDay, _ := valueobject.NewIntervalRecurrence(2)
+	threePerWeek, _ := valueobject.NewTimesPerPeriodRecurrence(3, "week")
+
+	tests := []struct {
+		name        string
+		recurrence  valueobject.Recurrence
+		day         time.Time
+		completions int
+		want        bool
+	}{
+		{"daily", valueobject.NewDailyRecurrence(), tuesday, 0, true},
+		{"weekly on listed day", weekly, monday, 0, true},
+		{"weekly on other day", weekly, tuesday, 0, false},
+		{"interval on anchor offset", everyOtherDay, monday, 0, true},
+		{"interval off anchor offset", everyOtherDay, tuesday, 0, false},
+		{"interval before anchor", everyOtherDay, anchor.AddDate(0, 0, -2), 0, false},
+		{"times per period with quota left", threePerWeek, monday, 2, true},
+		{"times per period with quota met", threePerWeek, monday, 3, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.recurrence.IsDueOn(tt.day, anchor, tt.completions); got != tt.want {
+				t.Errorf("IsDueOn() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRecurrence_PeriodStart(t *testing.T) {
+	// 2024-01-10 is a Wednesday
+	wednesday := time.Date(2024, 1, 10, 15, 30, 0, 0, time.UTC)
+
+	perWeek, _ := valueobject.NewTimesPerPeriodRecurrence(3, "week")
+	perMonth, _ := valueobject.NewTimesPerPeriodRecurrence(3, "month")
+
+	if got, want := perWeek.PeriodStart(wednesday, time.Monday), time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("weekly PeriodStart() = %v, want %v", got, want)
+	}
+
+	if got, want := perMonth.PeriodStart(wednesday, time.Monday), time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("monthly PeriodStart() = %v, want %v", got, want)
+	}
+
+	if got, want := valueobject.NewDailyRecurrence().PeriodStart(wednesday, time.Monday), time.Date(2024, 1, 10, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("daily PeriodStart() = %v, want %v", got, want)
+	}
+
+	// Weeks starting on Sunday
+	if got, want := perWeek.PeriodStart(wednesday, time.Sunday), time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("weekly PeriodStart() from Sunday = %v, want %v", got, want)
+	}
+
+	if got, want := perWeek.NextPeriodStart(wednesday, time.Sunday), time.Date(2024, 1, 14, 0, 0, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("weekly NextPeriodStart() from Sunday = %v, want %v", got, want)
+	}
+}
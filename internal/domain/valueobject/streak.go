@@ -0,0 +1,54 @@
+package valueobject
+
+// streakMilestones maps streak lengths to the bonus XP granted when they are reached
+// Lengths are counted in scheduled occurrences (days, or periods for N-times-per-period habits)
+var streakMilestones = map[int]int{
+	7:   50,
+	30:  200,
+	100: 750,
+	365: 3000,
+}
+
+// Streak represents the current and longest run of kept occurrences of a habit (Value Object)
+type Streak struct {
+	current int
+	longest int
+}
+
+// NewStreak creates a new Streak value object
+// Negative values are treated as zero and longest is never shorter than current
+func NewStreak(current int, longest int) Streak {
+	if current < 0 {
+		current = 0
+	}
+	if longest < current {
+		longest = current
+	}
+	return Streak{current: current, longest: longest}
+}
+
+// Current returns the length of the ongoing streak
+func (s Streak) Current() int {
+	return s.current
+}
+
+// Longest returns the length of the longest streak ever reached
+func (s Streak) Longest() int {
+	return s.longest
+}
+
+// IsMilestone reports whether the current streak length is a milestone
+func (s Streak) IsMilestone() bool {
+	_, ok := streakMilestones[s.current]
+	return ok
+}
+
+// MilestoneBonusXp returns the bonus XP for the current streak length (0 when it is not a milestone)
+func (s Streak) MilestoneBonusXp() int {
+	return streakMilestones[s.current]
+}
+
+// Equals checks if two streaks are equal
+func (s Streak) Equals(other Streak) bool {
+	return s.current == other.current && s.longest == other.longest
+}
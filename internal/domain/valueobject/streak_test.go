@@ -0,0 +1,50 @@
+package valueobject_test
+
+import (
+	"testing"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+func TestNewStreak(t *testing.T) {
+	streak := valueobject.NewStreak(5, 3)
+
+	if streak.Current() != 5 {
+		t.Errorf("Current() = %v, want %v", streak.Current(), 5)
+	}
+
+	// Longest can never be shorter than current
+	if streak.Longest() != 5 {
+		t.Errorf("Longest() = %v, want %v", streak.Longest(), 5)
+	}
+
+	if negative := valueobject.NewStreak(-1, 0); negative.Current() != 0 {
+		t.Errorf("Current() = %v, want 0 for negative input", negative.Current())
+	}
+}
+
+func TestStreak_MilestoneBonusXp(t *testing.T) {
+	tests := []struct {
+		current       int
+		wantMilestone bool
+		wantBonus     int
+	}{
+		{1, false, 0},
+		{6, false, 0},
+		{7, true, 50},
+		{30, true, 200},
+		{100, true, 750},
+		{365, true, 3000},
+		{366, false, 0},
+	}
+
+	for _, tt := range tests {
+		streak := valueobject.NewStreak(tt.current, tt.current)
+		if streak.IsMilestone() != tt.wantMilestone {
+			t.Errorf("NewStreak(%d).IsMilestone() = %v, want %v", tt.current, streak.IsMilestone(), tt.wantMilestone)
+		}
+		if streak.MilestoneBonusXp() != tt.wantBonus {
+			t.Errorf("NewStreak(%d).MilestoneBonusXp() = %v, want %v", tt.current, streak.MilestoneBonusXp(), tt.wantBonus)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package valueobject
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Supported XP source types (what caused an XP change)
+const (
+	XpSourceHabitCompletion     = "habit_completion"
+	XpSourceHabitCompletionUndo = "habit_completion_undo"
+	XpSourceHabitPenalty        = "habit_penalty"
+	XpSourceFocusSession        = "focus_session"
+	XpSourceTaskCompletion      = "task_completion"
+	XpSourceTaskReopen          = "task_reopen"
+	XpSourceBattleVictory       = "battle_victory"
+	XpSourceDungeonEntry        = "dungeon_entry"      // Entry cost of a dungeon run
+	XpSourceDungeonCompletion   = "dungeon_completion" // Final reward of a dungeon run
+	XpSourceRaidReward          = "raid_reward"        // Share of a defeated raid boss's reward
+	XpSourceTournamentPrize     = "tournament_prize"   // Prize of a tournament's champion or runner-up
+	XpSourceOpeningBalance      = "opening_balance"    // XP characters had before the ledger existed
+)
+
+// XpSource identifies what caused an XP change: a source type and the ID of the record behind it (Value Object)
+type XpSource struct {
+	sourceType string
+	sourceID   string
+}
+
+// NewXpSource creates a new XpSource value object with validation
+func NewXpSource(sourceType string, sourceID string) (XpSource, error) {
+	sourceType = strings.TrimSpace(strings.ToLower(sourceType))
+
+	switch sourceType {
+	case XpSourceHabitCompletion, XpSourceHabitCompletionUndo, XpSourceHabitPenalty,
+		XpSourceFocusSession, XpSourceTaskCompletion, XpSourceTaskReopen, XpSourceOpeningBalance,
+		XpSourceBattleVictory, XpSourceDungeonEntry, XpSourceDungeonCompletion, XpSourceRaidReward, XpSourceTournamentPrize:
+	case "":
+		return XpSource{}, fmt.Errorf("xp source type cannot be empty")
+	default:
+		return XpSource{}, fmt.Errorf("invalid xp source type: %s", sourceType)
+	}
+
+	if strings.TrimSpace(sourceID) == "" {
+		return XpSource{}, fmt.Errorf("xp source id cannot be empty")
+	}
+
+	return XpSource{sourceType: sourceType, sourceID: sourceID}, nil
+}
+
+// Type returns the source type
+func (s XpSource) Type() string {
+	return s.sourceType
+}
+
+// ID returns the ID of the record that caused the XP change
+func (s XpSource) ID() string {
+	return s.sourceID
+}
+
+// IsZero reports whether the source is unset
+func (s XpSource) IsZero() bool {
+	return s.sourceType == ""
+}
+
+// Equals checks if two sources are equal
+func (s XpSource) Equals(other XpSource) bool {
+	return s.sourceType == other.sourceType && s.sourceID == other.sourceID
+}
@@ -0,0 +1,47 @@
+package valueobject_test
+
+import (
+	"testing"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+)
+
+func TestNewXpSource_Valid(t *testing.T) {
+	source, err := valueobject.NewXpSource(" Habit_Completion ", "comp-123")
+	if err != nil {
+		t.Fatalf("NewXpSource() error = %v, want nil", err)
+	}
+
+	if source.Type() != valueobject.XpSourceHabitCompletion || source.ID() != "comp-123" {
+		t.Errorf("source = (%v, %v), want (%v, comp-123)", source.Type(), source.ID(), valueobject.XpSourceHabitCompletion)
+	}
+
+	other, _ := valueobject.NewXpSource(valueobject.XpSourceHabitCompletion, "comp-123")
+	if !source.Equals(other) {
+		t.Error("Equals() = false, want true for the same type and id")
+	}
+}
+
+func TestNewXpSource_Invalid(t *testing.T) {
+	tests := []struct {
+		name       string
+		sourceType string
+		sourceID   string
+	}{
+		{"empty type", "", "comp-123"},
+		{"unknown type", "lottery", "comp-123"},
+		{"empty id", valueobject.XpSourceTaskCompletion, "  "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := valueobject.NewXpSource(tt.sourceType, tt.sourceID); err == nil {
+				t.Error("NewXpSource() error = nil, want error")
+			}
+		})
+	}
+
+	if !(valueobject.XpSource{}).IsZero() {
+		t.Error("IsZero() = false, want true for an unset source")
+	}
+}
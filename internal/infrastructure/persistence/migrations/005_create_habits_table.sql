@@ -0,0 +1,37 @@
+-- Create habits table
+CREATE TABLE IF NOT EXISTS habits (
+    id VARCHAR(255) PRIMARY KEY,
+    title VARCHAR(100) NOT NULL,
+    description VARCHAR(500) NOT NULL DEFAULT '',
+    character_id VARCHAR(255) NOT NULL,
+    attribute_name VARCHAR(50) NOT NULL,
+    difficulty VARCHAR(20) NOT NULL,
+    active BOOLEAN NOT NULL DEFAULT true,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    -- Foreign key constraint
+    CONSTRAINT fk_habit_character
+        FOREIGN KEY (character_id)
+        REFERENCES characters(id)
+        ON DELETE CASCADE,
+
+    -- The linked attribute must exist for the same character
+    CONSTRAINT fk_habit_character_attribute
+        FOREIGN KEY (character_id, attribute_name)
+        REFERENCES character_attributes(character_id, attribute_name)
+        ON DELETE CASCADE,
+
+    -- Only known difficulty levels are allowed
+    CONSTRAINT chk_habit_difficulty
+        CHECK (difficulty IN ('trivial', 'easy', 'medium', 'hard'))
+);
+
+-- Create index on character_id for faster lookups
+CREATE INDEX IF NOT EXISTS idx_habits_character_id ON habits(character_id);
+
+-- Create index on active for filtering tracked habits
+CREATE INDEX IF NOT EXISTS idx_habits_active ON habits(active);
+
+-- Create index on created_at for sorting
+CREATE INDEX IF NOT EXISTS idx_habits_created_at ON habits(created_at);
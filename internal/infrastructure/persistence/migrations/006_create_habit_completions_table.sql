@@ -0,0 +1,29 @@
+-- Create habit_completions table
+-- Each row records what a completion awarded (XP, levels, attribute growth)
+CREATE TABLE IF NOT EXISTS habit_completions (
+    id VARCHAR(255) PRIMARY KEY,
+    habit_id VARCHAR(255) NOT NULL,
+    character_id VARCHAR(255) NOT NULL,
+    xp_gained INTEGER NOT NULL DEFAULT 0,
+    levels_gained INTEGER NOT NULL DEFAULT 0,
+    attribute_name VARCHAR(50) NOT NULL,
+    attribute_gain INTEGER NOT NULL DEFAULT 0,
+    completed_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    -- Foreign key constraints
+    CONSTRAINT fk_completion_habit
+        FOREIGN KEY (habit_id)
+        REFERENCES habits(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_completion_character
+        FOREIGN KEY (character_id)
+        REFERENCES characters(id)
+        ON DELETE CASCADE
+);
+
+-- Create index on habit_id + completed_at for history lookups
+CREATE INDEX IF NOT EXISTS idx_habit_completions_habit_id ON habit_completions(habit_id, completed_at DESC);
+
+-- Create index on character_id for per-character queries
+CREATE INDEX IF NOT EXISTS idx_habit_completions_character_id ON habit_completions(character_id);
@@ -0,0 +1,8 @@
+-- Add recurrence schedule to habits
+-- Stored as an RRULE-style string, e.g.:
+--   FREQ=DAILY                    every day
+--   FREQ=DAILY;INTERVAL=2         every 2nd day (counted from the habit creation day)
+--   FREQ=WEEKLY;BYDAY=MO,WE,FR    on specific weekdays
+--   FREQ=WEEKLY;TIMES=3           3 times per week (FREQ=MONTHLY for per month)
+ALTER TABLE habits
+    ADD COLUMN IF NOT EXISTS recurrence VARCHAR(100) NOT NULL DEFAULT 'FREQ=DAILY';
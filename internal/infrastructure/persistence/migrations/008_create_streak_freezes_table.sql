@@ -0,0 +1,36 @@
+-- Create streak_freezes table
+-- Each row is a token earned by a character; once used it protects one day of a habit streak
+CREATE TABLE IF NOT EXISTS streak_freezes (
+    id VARCHAR(255) PRIMARY KEY,
+    character_id VARCHAR(255) NOT NULL,
+    habit_id VARCHAR(255),
+    frozen_date DATE,
+    earned_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    used_at TIMESTAMP,
+
+    -- Foreign key constraints
+    CONSTRAINT fk_streak_freeze_character
+        FOREIGN KEY (character_id)
+        REFERENCES characters(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_streak_freeze_habit
+        FOREIGN KEY (habit_id)
+        REFERENCES habits(id)
+        ON DELETE CASCADE,
+
+    -- A used token must be bound to a habit and a day
+    CONSTRAINT chk_streak_freeze_used
+        CHECK ((used_at IS NULL AND habit_id IS NULL AND frozen_date IS NULL)
+            OR (used_at IS NOT NULL AND habit_id IS NOT NULL AND frozen_date IS NOT NULL)),
+
+    -- A day can only be frozen once per habit
+    CONSTRAINT uq_streak_freeze_habit_date
+        UNIQUE (habit_id, frozen_date)
+);
+
+-- Create index on character_id for fetching available tokens
+CREATE INDEX IF NOT EXISTS idx_streak_freezes_character_id ON streak_freezes(character_id, used_at);
+
+-- Create index on habit_id for streak calculations
+CREATE INDEX IF NOT EXISTS idx_streak_freezes_habit_id ON streak_freezes(habit_id);
@@ -0,0 +1,5 @@
+-- Add support for negative (bad) habits
+-- Logging a negative habit drains the linked attribute and, when drains_xp is set, costs XP
+ALTER TABLE habits
+    ADD COLUMN IF NOT EXISTS negative BOOLEAN NOT NULL DEFAULT false,
+    ADD COLUMN IF NOT EXISTS drains_xp BOOLEAN NOT NULL DEFAULT false;
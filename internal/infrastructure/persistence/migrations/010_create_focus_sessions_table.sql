@@ -0,0 +1,40 @@
+-- Create focus_sessions table
+-- Each row is a timed focus session against a habit; the tracked time is derived from its timestamps
+CREATE TABLE IF NOT EXISTS focus_sessions (
+    id VARCHAR(255) PRIMARY KEY,
+    character_id VARCHAR(255) NOT NULL,
+    habit_id VARCHAR(255) NOT NULL,
+    started_at TIMESTAMP NOT NULL,
+    paused_at TIMESTAMP,
+    paused_seconds INTEGER NOT NULL DEFAULT 0,
+    ended_at TIMESTAMP,
+    xp_awarded INTEGER NOT NULL DEFAULT 0,
+
+    -- Foreign key constraints
+    CONSTRAINT fk_focus_session_character
+        FOREIGN KEY (character_id)
+        REFERENCES characters(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_focus_session_habit
+        FOREIGN KEY (habit_id)
+        REFERENCES habits(id)
+        ON DELETE CASCADE,
+
+    -- Check constraints
+    CONSTRAINT chk_focus_session_paused_seconds
+        CHECK (paused_seconds >= 0),
+
+    CONSTRAINT chk_focus_session_ended_at
+        CHECK (ended_at IS NULL OR ended_at >= started_at),
+
+    -- A stopped session can't be paused
+    CONSTRAINT chk_focus_session_paused_at
+        CHECK (paused_at IS NULL OR ended_at IS NULL)
+);
+
+-- Create index on character_id + started_at for date range queries
+CREATE INDEX IF NOT EXISTS idx_focus_sessions_character_id ON focus_sessions(character_id, started_at);
+
+-- Create partial index on open sessions for overlap checks
+CREATE INDEX IF NOT EXISTS idx_focus_sessions_open ON focus_sessions(character_id) WHERE ended_at IS NULL;
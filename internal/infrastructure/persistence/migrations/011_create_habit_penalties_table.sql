@@ -0,0 +1,47 @@
+-- Create habit_penalties table
+-- Each row records the penalty applied to a character for missing a scheduled habit on a day
+CREATE TABLE IF NOT EXISTS habit_penalties (
+    id VARCHAR(255) PRIMARY KEY,
+    habit_id VARCHAR(255) NOT NULL,
+    character_id VARCHAR(255) NOT NULL,
+    day DATE NOT NULL,
+    xp_lost INTEGER NOT NULL DEFAULT 0,
+    levels_lost INTEGER NOT NULL DEFAULT 0,
+    attribute_name VARCHAR(50) NOT NULL,
+    attribute_loss INTEGER NOT NULL DEFAULT 0,
+    applied_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    -- Foreign key constraints
+    CONSTRAINT fk_penalty_habit
+        FOREIGN KEY (habit_id)
+        REFERENCES habits(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_penalty_character
+        FOREIGN KEY (character_id)
+        REFERENCES characters(id)
+        ON DELETE CASCADE,
+
+    -- A habit is penalized at most once per day
+    CONSTRAINT uq_penalty_habit_day
+        UNIQUE (habit_id, day)
+);
+
+-- Create index on character_id for per-character queries
+CREATE INDEX IF NOT EXISTS idx_habit_penalties_character_id ON habit_penalties(character_id, day DESC);
+
+-- Create day_end_runs table
+-- Each row marks a user's day as processed by the day-end job (keeps it idempotent)
+CREATE TABLE IF NOT EXISTS day_end_runs (
+    user_id VARCHAR(255) NOT NULL,
+    day DATE NOT NULL,
+    processed_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT pk_day_end_runs
+        PRIMARY KEY (user_id, day),
+
+    CONSTRAINT fk_day_end_run_user
+        FOREIGN KEY (user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE
+);
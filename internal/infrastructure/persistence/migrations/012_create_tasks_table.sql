@@ -0,0 +1,42 @@
+-- Create tasks table
+-- Each row is a one-off to-do of a character; the checklist is stored inline as a JSON array
+CREATE TABLE IF NOT EXISTS tasks (
+    id VARCHAR(255) PRIMARY KEY,
+    character_id VARCHAR(255) NOT NULL,
+    title VARCHAR(100) NOT NULL,
+    description VARCHAR(500) NOT NULL DEFAULT '',
+    difficulty VARCHAR(20) NOT NULL,
+    priority VARCHAR(20) NOT NULL DEFAULT 'medium',
+    due_date DATE,
+    checklist JSONB NOT NULL DEFAULT '[]',
+    completed_at TIMESTAMP,
+    xp_awarded INTEGER NOT NULL DEFAULT 0,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+
+    -- Foreign key constraints
+    CONSTRAINT fk_task_character
+        FOREIGN KEY (character_id)
+        REFERENCES characters(id)
+        ON DELETE CASCADE,
+
+    -- Check constraints
+    CONSTRAINT chk_task_difficulty
+        CHECK (difficulty IN ('trivial', 'easy', 'medium', 'hard')),
+
+    CONSTRAINT chk_task_priority
+        CHECK (priority IN ('low', 'medium', 'high')),
+
+    CONSTRAINT chk_task_xp_awarded
+        CHECK (xp_awarded >= 0),
+
+    -- Only completed tasks keep an XP reward
+    CONSTRAINT chk_task_completed_xp
+        CHECK (completed_at IS NOT NULL OR xp_awarded = 0)
+);
+
+-- Create index on character_id + due_date for the task list filters
+CREATE INDEX IF NOT EXISTS idx_tasks_character_id ON tasks(character_id, due_date);
+
+-- Create partial index on open tasks
+CREATE INDEX IF NOT EXISTS idx_tasks_open ON tasks(character_id) WHERE completed_at IS NULL;
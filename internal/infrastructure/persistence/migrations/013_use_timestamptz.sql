@@ -0,0 +1,42 @@
+-- Store every instant as TIMESTAMPTZ
+-- TIMESTAMP columns carry no zone: values were written as the wall clock of whatever zone
+-- the API or the database session was running in (TZ=America/Sao_Paulo in docker-compose),
+-- so the same instant could be stored with different values. Day boundaries are now computed
+-- in each user's own time zone, which requires unambiguous instants.
+-- Existing values are interpreted as UTC (the zone the use cases record their timestamps in).
+ALTER TABLE users
+    ALTER COLUMN created_at TYPE TIMESTAMPTZ USING created_at AT TIME ZONE 'UTC',
+    ALTER COLUMN updated_at TYPE TIMESTAMPTZ USING updated_at AT TIME ZONE 'UTC';
+
+ALTER TABLE characters
+    ALTER COLUMN created_at TYPE TIMESTAMPTZ USING created_at AT TIME ZONE 'UTC';
+
+ALTER TABLE character_attributes
+    ALTER COLUMN created_at TYPE TIMESTAMPTZ USING created_at AT TIME ZONE 'UTC';
+
+ALTER TABLE habits
+    ALTER COLUMN created_at TYPE TIMESTAMPTZ USING created_at AT TIME ZONE 'UTC',
+    ALTER COLUMN updated_at TYPE TIMESTAMPTZ USING updated_at AT TIME ZONE 'UTC';
+
+ALTER TABLE habit_completions
+    ALTER COLUMN completed_at TYPE TIMESTAMPTZ USING completed_at AT TIME ZONE 'UTC';
+
+ALTER TABLE streak_freezes
+    ALTER COLUMN earned_at TYPE TIMESTAMPTZ USING earned_at AT TIME ZONE 'UTC',
+    ALTER COLUMN used_at TYPE TIMESTAMPTZ USING used_at AT TIME ZONE 'UTC';
+
+ALTER TABLE focus_sessions
+    ALTER COLUMN started_at TYPE TIMESTAMPTZ USING started_at AT TIME ZONE 'UTC',
+    ALTER COLUMN paused_at TYPE TIMESTAMPTZ USING paused_at AT TIME ZONE 'UTC',
+    ALTER COLUMN ended_at TYPE TIMESTAMPTZ USING ended_at AT TIME ZONE 'UTC';
+
+ALTER TABLE habit_penalties
+    ALTER COLUMN applied_at TYPE TIMESTAMPTZ USING applied_at AT TIME ZONE 'UTC';
+
+ALTER TABLE day_end_runs
+    ALTER COLUMN processed_at TYPE TIMESTAMPTZ USING processed_at AT TIME ZONE 'UTC';
+
+ALTER TABLE tasks
+    ALTER COLUMN completed_at TYPE TIMESTAMPTZ USING completed_at AT TIME ZONE 'UTC',
+    ALTER COLUMN created_at TYPE TIMESTAMPTZ USING created_at AT TIME ZONE 'UTC',
+    ALTER COLUMN updated_at TYPE TIMESTAMPTZ USING updated_at AT TIME ZONE 'UTC';
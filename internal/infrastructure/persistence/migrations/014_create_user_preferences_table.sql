@@ -0,0 +1,23 @@
+-- Create user_preferences table
+-- Calendar settings of a user: days start at day_rollover_hour in the user's time zone,
+-- weeks start on week_start (0 = Sunday ... 6 = Saturday)
+-- Users without a row use UTC days starting at midnight and weeks starting on Monday
+CREATE TABLE IF NOT EXISTS user_preferences (
+    user_id VARCHAR(255) PRIMARY KEY,
+    timezone VARCHAR(64) NOT NULL DEFAULT 'UTC',
+    week_start SMALLINT NOT NULL DEFAULT 1,
+    day_rollover_hour SMALLINT NOT NULL DEFAULT 0,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- Foreign key constraint
+    CONSTRAINT fk_user_preferences_user
+        FOREIGN KEY (user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT chk_user_preferences_week_start
+        CHECK (week_start BETWEEN 0 AND 6),
+
+    CONSTRAINT chk_user_preferences_day_rollover_hour
+        CHECK (day_rollover_hour BETWEEN 0 AND 12)
+);
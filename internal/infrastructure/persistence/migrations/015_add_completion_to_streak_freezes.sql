@@ -0,0 +1,13 @@
+-- Track the habit completion that earned each streak freeze token
+-- Undoing a completion revokes the token it earned; tokens earned before this column existed have no completion
+ALTER TABLE streak_freezes
+    ADD COLUMN IF NOT EXISTS completion_id VARCHAR(255);
+
+ALTER TABLE streak_freezes
+    ADD CONSTRAINT fk_streak_freeze_completion
+        FOREIGN KEY (completion_id)
+        REFERENCES habit_completions(id)
+        ON DELETE SET NULL;
+
+-- Create index on completion_id for revoking tokens when a completion is undone
+CREATE INDEX IF NOT EXISTS idx_streak_freezes_completion_id ON streak_freezes(completion_id);
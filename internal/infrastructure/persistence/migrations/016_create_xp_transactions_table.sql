@@ -0,0 +1,34 @@
+-- Create xp_transactions table
+-- Append-only ledger of every XP change of a character; amount is negative when XP was lost
+-- Rows are written in the same transaction as the character update they describe
+CREATE TABLE IF NOT EXISTS xp_transactions (
+    id BIGSERIAL PRIMARY KEY,
+    character_id VARCHAR(255) NOT NULL,
+    amount INTEGER NOT NULL,
+    source_type VARCHAR(50) NOT NULL,
+    source_id VARCHAR(255) NOT NULL,
+    resulting_level INTEGER NOT NULL,
+    resulting_total_xp INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- Foreign key constraint
+    CONSTRAINT fk_xp_transaction_character
+        FOREIGN KEY (character_id)
+        REFERENCES characters(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT chk_xp_transaction_amount
+        CHECK (amount <> 0),
+
+    CONSTRAINT chk_xp_transaction_resulting_level
+        CHECK (resulting_level >= 1)
+);
+
+-- Create index on character_id for the paginated history (newest first) and ledger replays
+CREATE INDEX IF NOT EXISTS idx_xp_transactions_character_id ON xp_transactions(character_id, id);
+
+-- XP earned before the ledger existed is recorded as an opening balance
+INSERT INTO xp_transactions (character_id, amount, source_type, source_id, resulting_level, resulting_total_xp, created_at)
+SELECT id, total_xp, 'opening_balance', id, level, total_xp, NOW()
+FROM characters
+WHERE total_xp > 0;
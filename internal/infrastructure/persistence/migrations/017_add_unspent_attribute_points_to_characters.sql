@@ -0,0 +1,9 @@
+-- Attribute points received on level-up and not yet distributed by the user
+-- Negative when de-leveling took back points that were already spent (repaid by the next level-ups)
+ALTER TABLE characters
+    ADD COLUMN IF NOT EXISTS unspent_attribute_points INTEGER NOT NULL DEFAULT 0;
+
+-- Characters that leveled up before points existed receive the points of every level they reached (3 per level)
+UPDATE characters
+SET unspent_attribute_points = (level - 1) * 3
+WHERE level > 1;
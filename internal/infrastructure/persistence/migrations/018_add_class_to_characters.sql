@@ -0,0 +1,4 @@
+-- Class chosen when the character is created: shapes its starting attributes and their growth per level
+-- Characters created before classes existed keep their balanced attributes as adventurers
+ALTER TABLE characters
+    ADD COLUMN IF NOT EXISTS class VARCHAR(20) NOT NULL DEFAULT 'aventureiro';
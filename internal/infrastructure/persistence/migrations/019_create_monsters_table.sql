@@ -0,0 +1,33 @@
+-- Create monsters table
+-- Catalog of the PvE opponents, with the same seven attributes characters have
+CREATE TABLE IF NOT EXISTS monsters (
+    id VARCHAR(50) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    level INTEGER NOT NULL,
+    strength INTEGER NOT NULL,
+    constitution INTEGER NOT NULL,
+    willpower INTEGER NOT NULL,
+    wisdom INTEGER NOT NULL,
+    intelligence INTEGER NOT NULL,
+    charisma INTEGER NOT NULL,
+    dexterity INTEGER NOT NULL,
+    xp_reward INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_monster_level
+        CHECK (level >= 1),
+
+    CONSTRAINT chk_monster_xp_reward
+        CHECK (xp_reward >= 0)
+);
+
+-- Starting catalog
+INSERT INTO monsters (id, name, level, strength, constitution, willpower, wisdom, intelligence, charisma, dexterity, xp_reward)
+VALUES
+    ('rato-gigante', 'Rato Gigante', 1, 3, 3, 2, 2, 1, 1, 6, 15),
+    ('goblin', 'Goblin', 2, 5, 4, 3, 2, 3, 2, 6, 25),
+    ('lobo-cinzento', 'Lobo Cinzento', 3, 6, 5, 3, 4, 2, 2, 8, 40),
+    ('esqueleto', 'Esqueleto', 5, 8, 6, 5, 3, 3, 1, 5, 70),
+    ('bruxa-do-pantano', 'Bruxa do Pântano', 7, 3, 6, 8, 9, 11, 5, 5, 110),
+    ('ogro', 'Ogro', 10, 14, 14, 6, 3, 2, 2, 4, 180)
+ON CONFLICT (id) DO NOTHING;
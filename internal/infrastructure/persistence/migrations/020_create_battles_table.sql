@@ -0,0 +1,27 @@
+-- Create battles table
+-- Each row is the result of a fought battle; the seed allows simulating it again
+CREATE TABLE IF NOT EXISTS battles (
+    id VARCHAR(255) PRIMARY KEY,
+    challenger_id VARCHAR(255) NOT NULL,
+    opponent_id VARCHAR(255) NOT NULL,
+    seed BIGINT NOT NULL,
+    winner VARCHAR(20) NOT NULL DEFAULT '',
+    rounds INTEGER NOT NULL,
+    challenger_hp INTEGER NOT NULL,
+    opponent_hp INTEGER NOT NULL,
+    fought_at TIMESTAMPTZ NOT NULL,
+
+    -- Check constraints
+    CONSTRAINT chk_battle_winner
+        CHECK (winner IN ('', 'challenger', 'opponent')),
+
+    CONSTRAINT chk_battle_rounds
+        CHECK (rounds >= 1),
+
+    CONSTRAINT chk_battle_hp
+        CHECK (challenger_hp >= 0 AND opponent_hp >= 0)
+);
+
+-- Create indexes on the combatants for battle history queries
+CREATE INDEX IF NOT EXISTS idx_battles_challenger_id ON battles(challenger_id, fought_at);
+CREATE INDEX IF NOT EXISTS idx_battles_opponent_id ON battles(opponent_id, fought_at);
@@ -0,0 +1,46 @@
+-- Create battle_challenges table
+-- Each row is a PvP challenge between two characters; pending challenges past expires_at are expired
+CREATE TABLE IF NOT EXISTS battle_challenges (
+    id VARCHAR(255) PRIMARY KEY,
+    challenger_character_id VARCHAR(255) NOT NULL,
+    opponent_character_id VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'pending',
+    battle_id VARCHAR(255),
+    created_at TIMESTAMPTZ NOT NULL,
+    expires_at TIMESTAMPTZ NOT NULL,
+    responded_at TIMESTAMPTZ,
+
+    -- Foreign key constraints
+    CONSTRAINT fk_battle_challenge_challenger
+        FOREIGN KEY (challenger_character_id)
+        REFERENCES characters(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_battle_challenge_opponent
+        FOREIGN KEY (opponent_character_id)
+        REFERENCES characters(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_battle_challenge_battle
+        FOREIGN KEY (battle_id)
+        REFERENCES battles(id)
+        ON DELETE SET NULL,
+
+    -- Check constraints
+    CONSTRAINT chk_battle_challenge_status
+        CHECK (status IN ('pending', 'accepted', 'declined')),
+
+    CONSTRAINT chk_battle_challenge_self
+        CHECK (challenger_character_id <> opponent_character_id),
+
+    CONSTRAINT chk_battle_challenge_expires_at
+        CHECK (expires_at > created_at),
+
+    -- Answered challenges record when they were answered
+    CONSTRAINT chk_battle_challenge_responded_at
+        CHECK ((status = 'pending') = (responded_at IS NULL))
+);
+
+-- Create partial indexes on pending challenges for each side
+CREATE INDEX IF NOT EXISTS idx_battle_challenges_challenger_pending ON battle_challenges(challenger_character_id, expires_at) WHERE status = 'pending';
+CREATE INDEX IF NOT EXISTS idx_battle_challenges_opponent_pending ON battle_challenges(opponent_character_id, expires_at) WHERE status = 'pending';
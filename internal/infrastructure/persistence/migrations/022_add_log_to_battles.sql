@@ -0,0 +1,8 @@
+-- Add the kind and the replay log to battles
+-- The log is a versioned JSON event log (combatant snapshots, seed and actions) served to replay the battle.
+-- Battles recorded before this migration have no log and can't be replayed.
+ALTER TABLE battles ADD COLUMN IF NOT EXISTS kind VARCHAR(10) NOT NULL DEFAULT 'pvp';
+ALTER TABLE battles ADD COLUMN IF NOT EXISTS log JSONB;
+
+ALTER TABLE battles DROP CONSTRAINT IF EXISTS chk_battle_kind;
+ALTER TABLE battles ADD CONSTRAINT chk_battle_kind CHECK (kind IN ('pvp', 'pve'));
@@ -0,0 +1,3 @@
+-- Add the ranked flag to battle challenges
+-- Ranked battles update both characters' ratings; challenges sent before this migration are unranked
+ALTER TABLE battle_challenges ADD COLUMN IF NOT EXISTS ranked BOOLEAN NOT NULL DEFAULT FALSE;
@@ -0,0 +1,33 @@
+-- Create character_ratings table
+-- Current Glicko-2 rating of each character with ranked battles (companion table of characters).
+-- The deviation is the one after the last ranked battle: its growth while inactive is derived from last_played_at.
+CREATE TABLE IF NOT EXISTS character_ratings (
+    character_id VARCHAR(255) PRIMARY KEY,
+    rating DOUBLE PRECISION NOT NULL DEFAULT 1500,
+    deviation DOUBLE PRECISION NOT NULL DEFAULT 350,
+    volatility DOUBLE PRECISION NOT NULL DEFAULT 0.06,
+    wins INTEGER NOT NULL DEFAULT 0,
+    losses INTEGER NOT NULL DEFAULT 0,
+    draws INTEGER NOT NULL DEFAULT 0,
+    last_played_at TIMESTAMPTZ,
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- Foreign key constraint
+    CONSTRAINT fk_character_rating_character
+        FOREIGN KEY (character_id)
+        REFERENCES characters(id)
+        ON DELETE CASCADE,
+
+    -- Check constraints
+    CONSTRAINT chk_character_rating_deviation
+        CHECK (deviation > 0 AND deviation <= 350),
+
+    CONSTRAINT chk_character_rating_volatility
+        CHECK (volatility > 0),
+
+    CONSTRAINT chk_character_rating_games
+        CHECK (wins >= 0 AND losses >= 0 AND draws >= 0)
+);
+
+-- Create index on rating for the leaderboard (highest first)
+CREATE INDEX IF NOT EXISTS idx_character_ratings_rating ON character_ratings(rating DESC, character_id);
@@ -0,0 +1,38 @@
+-- Create rating_changes table
+-- Rating history: one row per character and ranked battle, with the rating before and after it.
+-- Rows are written in the same transaction as the character_ratings row they lead to
+CREATE TABLE IF NOT EXISTS rating_changes (
+    id BIGSERIAL PRIMARY KEY,
+    character_id VARCHAR(255) NOT NULL,
+    battle_id VARCHAR(255) NOT NULL,
+    opponent_character_id VARCHAR(255) NOT NULL,
+    score DOUBLE PRECISION NOT NULL,
+    rating_before DOUBLE PRECISION NOT NULL,
+    deviation_before DOUBLE PRECISION NOT NULL,
+    volatility_before DOUBLE PRECISION NOT NULL,
+    rating_after DOUBLE PRECISION NOT NULL,
+    deviation_after DOUBLE PRECISION NOT NULL,
+    volatility_after DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- Foreign key constraints
+    CONSTRAINT fk_rating_change_character
+        FOREIGN KEY (character_id)
+        REFERENCES characters(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_rating_change_battle
+        FOREIGN KEY (battle_id)
+        REFERENCES battles(id)
+        ON DELETE CASCADE,
+
+    -- A battle rates each of its characters once
+    CONSTRAINT uq_rating_change_character_battle
+        UNIQUE (character_id, battle_id),
+
+    CONSTRAINT chk_rating_change_score
+        CHECK (score IN (0, 0.5, 1))
+);
+
+-- Create index on character_id for the history (newest first)
+CREATE INDEX IF NOT EXISTS idx_rating_changes_character_id ON rating_changes(character_id, id);
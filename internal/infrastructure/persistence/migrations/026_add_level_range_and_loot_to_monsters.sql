@@ -0,0 +1,52 @@
+-- Monsters fight at the challenger's level, clamped to their level range
+-- Attributes and xp_reward are the values at min_level; they grow 10% per level above it
+ALTER TABLE monsters RENAME COLUMN level TO min_level;
+
+ALTER TABLE monsters
+    ADD COLUMN IF NOT EXISTS max_level INTEGER;
+
+UPDATE monsters SET max_level = min_level WHERE max_level IS NULL;
+
+ALTER TABLE monsters
+    ALTER COLUMN max_level SET NOT NULL;
+
+ALTER TABLE monsters
+    DROP CONSTRAINT IF EXISTS chk_monster_level;
+
+ALTER TABLE monsters
+    ADD CONSTRAINT chk_monster_level_range
+        CHECK (min_level >= 1 AND max_level >= min_level);
+
+-- Loot table: items the monster may drop when defeated
+-- [{"item": "Moeda de Cobre", "chance": 100, "quantity": 2}], chance in % (1-100)
+ALTER TABLE monsters
+    ADD COLUMN IF NOT EXISTS loot JSONB NOT NULL DEFAULT '[]';
+
+-- Create index for the catalog filtered by level
+CREATE INDEX IF NOT EXISTS idx_monsters_level_range ON monsters(min_level, max_level);
+
+-- Catalog with level ranges and loot
+INSERT INTO monsters (id, name, min_level, max_level, strength, constitution, willpower, wisdom, intelligence, charisma, dexterity, xp_reward, loot)
+VALUES
+    ('rato-gigante', 'Rato Gigante', 1, 4, 3, 3, 2, 2, 1, 1, 6, 15,
+        '[{"item": "Moeda de Cobre", "chance": 100, "quantity": 2}, {"item": "Rabo de Rato", "chance": 60, "quantity": 1}]'),
+    ('goblin', 'Goblin', 2, 6, 5, 4, 3, 2, 3, 2, 6, 25,
+        '[{"item": "Moeda de Cobre", "chance": 100, "quantity": 5}, {"item": "Orelha de Goblin", "chance": 40, "quantity": 1}, {"item": "Adaga Enferrujada", "chance": 10, "quantity": 1}]'),
+    ('lobo-cinzento', 'Lobo Cinzento', 3, 8, 6, 5, 3, 4, 2, 2, 8, 40,
+        '[{"item": "Pele de Lobo", "chance": 50, "quantity": 1}, {"item": "Presa de Lobo", "chance": 25, "quantity": 2}]'),
+    ('esqueleto', 'Esqueleto', 5, 12, 8, 6, 5, 3, 3, 1, 5, 70,
+        '[{"item": "Osso", "chance": 80, "quantity": 3}, {"item": "Escudo Rachado", "chance": 10, "quantity": 1}]'),
+    ('bruxa-do-pantano', 'Bruxa do Pântano', 7, 15, 3, 6, 8, 9, 11, 5, 5, 110,
+        '[{"item": "Erva do Pântano", "chance": 60, "quantity": 2}, {"item": "Poção Misteriosa", "chance": 20, "quantity": 1}]'),
+    ('ogro', 'Ogro', 10, 20, 14, 14, 6, 3, 2, 2, 4, 180,
+        '[{"item": "Moeda de Prata", "chance": 100, "quantity": 3}, {"item": "Clava de Ogro", "chance": 15, "quantity": 1}]'),
+    ('troll-das-cavernas', 'Troll das Cavernas', 15, 25, 20, 22, 8, 4, 3, 2, 7, 280,
+        '[{"item": "Moeda de Prata", "chance": 100, "quantity": 6}, {"item": "Sangue de Troll", "chance": 30, "quantity": 1}]'),
+    ('cavaleiro-espectral', 'Cavaleiro Espectral', 20, 30, 22, 18, 16, 10, 8, 6, 12, 400,
+        '[{"item": "Moeda de Ouro", "chance": 100, "quantity": 2}, {"item": "Essência Espectral", "chance": 35, "quantity": 1}, {"item": "Lâmina Fantasma", "chance": 5, "quantity": 1}]'),
+    ('serpe', 'Serpe', 28, 40, 26, 24, 14, 12, 10, 8, 20, 600,
+        '[{"item": "Moeda de Ouro", "chance": 100, "quantity": 5}, {"item": "Escama de Serpe", "chance": 50, "quantity": 2}, {"item": "Ferrão de Serpe", "chance": 10, "quantity": 1}]')
+ON CONFLICT (id) DO UPDATE SET
+    min_level = EXCLUDED.min_level,
+    max_level = EXCLUDED.max_level,
+    loot = EXCLUDED.loot;
@@ -0,0 +1,76 @@
+-- Create dungeons table
+-- Catalog of the dungeons: a sequence of PvE stages fought with the HP left from the previous one
+-- entry_cost is XP paid to start a run; reward_xp and reward_loot are earned for clearing the last stage
+CREATE TABLE IF NOT EXISTS dungeons (
+    id VARCHAR(50) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    min_level INTEGER NOT NULL,
+    entry_cost INTEGER NOT NULL,
+    reward_xp INTEGER NOT NULL,
+    reward_loot JSONB NOT NULL DEFAULT '[]',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    CONSTRAINT chk_dungeon_min_level
+        CHECK (min_level >= 1),
+
+    CONSTRAINT chk_dungeon_entry_cost
+        CHECK (entry_cost >= 0),
+
+    CONSTRAINT chk_dungeon_reward_xp
+        CHECK (reward_xp >= 0)
+);
+
+-- Create dungeon_stages table
+-- Stages are fought in position order (starting at 1); clearing a checkpoint stage rests the character
+-- and saves the run once
+CREATE TABLE IF NOT EXISTS dungeon_stages (
+    dungeon_id VARCHAR(50) NOT NULL,
+    position INTEGER NOT NULL,
+    monster_id VARCHAR(50) NOT NULL,
+    checkpoint BOOLEAN NOT NULL DEFAULT FALSE,
+
+    PRIMARY KEY (dungeon_id, position),
+
+    -- Foreign key constraints
+    CONSTRAINT fk_dungeon_stage_dungeon
+        FOREIGN KEY (dungeon_id)
+        REFERENCES dungeons(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_dungeon_stage_monster
+        FOREIGN KEY (monster_id)
+        REFERENCES monsters(id),
+
+    CONSTRAINT chk_dungeon_stage_position
+        CHECK (position >= 1)
+);
+
+-- Starting catalog
+INSERT INTO dungeons (id, name, min_level, entry_cost, reward_xp, reward_loot)
+VALUES
+    ('toca-dos-ratos', 'Toca dos Ratos', 1, 10, 80,
+        '[{"item": "Moeda de Prata", "chance": 100, "quantity": 2}, {"item": "Queijo Dourado", "chance": 25, "quantity": 1}]'),
+    ('cripta-esquecida', 'Cripta Esquecida', 5, 40, 300,
+        '[{"item": "Moeda de Ouro", "chance": 100, "quantity": 1}, {"item": "Amuleto Ósseo", "chance": 20, "quantity": 1}]'),
+    ('covil-do-troll', 'Covil do Troll', 15, 120, 900,
+        '[{"item": "Moeda de Ouro", "chance": 100, "quantity": 5}, {"item": "Coração de Troll", "chance": 15, "quantity": 1}]')
+ON CONFLICT (id) DO NOTHING;
+
+INSERT INTO dungeon_stages (dungeon_id, position, monster_id, checkpoint)
+VALUES
+    ('toca-dos-ratos', 1, 'rato-gigante', FALSE),
+    ('toca-dos-ratos', 2, 'rato-gigante', FALSE),
+    ('toca-dos-ratos', 3, 'goblin', TRUE),
+    ('toca-dos-ratos', 4, 'goblin', FALSE),
+    ('toca-dos-ratos', 5, 'lobo-cinzento', FALSE),
+    ('cripta-esquecida', 1, 'esqueleto', FALSE),
+    ('cripta-esquecida', 2, 'esqueleto', TRUE),
+    ('cripta-esquecida', 3, 'bruxa-do-pantano', FALSE),
+    ('cripta-esquecida', 4, 'esqueleto', TRUE),
+    ('cripta-esquecida', 5, 'ogro', FALSE),
+    ('covil-do-troll', 1, 'ogro', FALSE),
+    ('covil-do-troll', 2, 'ogro', TRUE),
+    ('covil-do-troll', 3, 'troll-das-cavernas', FALSE),
+    ('covil-do-troll', 4, 'cavaleiro-espectral', TRUE),
+    ('covil-do-troll', 5, 'troll-das-cavernas', FALSE)
+ON CONFLICT (dungeon_id, position) DO NOTHING;
@@ -0,0 +1,40 @@
+-- Create dungeon_runs table
+-- Each row is a character's progress through a dungeon, saved after every stage so it can be resumed
+-- stage is the number of stages cleared; checkpoint is the stage a defeat sends the run back to (0 = none)
+CREATE TABLE IF NOT EXISTS dungeon_runs (
+    id VARCHAR(255) PRIMARY KEY,
+    dungeon_id VARCHAR(50) NOT NULL,
+    character_id VARCHAR(255) NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'in_progress',
+    stage INTEGER NOT NULL DEFAULT 0,
+    hp INTEGER NOT NULL,
+    checkpoint INTEGER NOT NULL DEFAULT 0,
+    started_at TIMESTAMPTZ NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+    ended_at TIMESTAMPTZ,
+
+    -- Foreign key constraints
+    CONSTRAINT fk_dungeon_run_dungeon
+        FOREIGN KEY (dungeon_id)
+        REFERENCES dungeons(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_dungeon_run_character
+        FOREIGN KEY (character_id)
+        REFERENCES characters(id)
+        ON DELETE CASCADE,
+
+    -- Check constraints
+    CONSTRAINT chk_dungeon_run_status
+        CHECK (status IN ('in_progress', 'completed', 'failed', 'abandoned')),
+
+    CONSTRAINT chk_dungeon_run_progress
+        CHECK (stage >= 0 AND checkpoint >= 0 AND checkpoint <= stage AND hp > 0),
+
+    -- Finished runs record when they ended
+    CONSTRAINT chk_dungeon_run_ended_at
+        CHECK ((status = 'in_progress') = (ended_at IS NULL))
+);
+
+-- A character runs one dungeon at a time
+CREATE UNIQUE INDEX IF NOT EXISTS idx_dungeon_runs_in_progress ON dungeon_runs(character_id) WHERE status = 'in_progress';
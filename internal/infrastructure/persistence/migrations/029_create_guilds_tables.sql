@@ -0,0 +1,40 @@
+-- Create guilds table
+-- Groups of characters that raid bosses together; the founding character leads the guild
+CREATE TABLE IF NOT EXISTS guilds (
+    id VARCHAR(255) PRIMARY KEY,
+    name VARCHAR(50) NOT NULL,
+    leader_character_id VARCHAR(255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- Foreign key constraint
+    CONSTRAINT fk_guild_leader
+        FOREIGN KEY (leader_character_id)
+        REFERENCES characters(id)
+        ON DELETE CASCADE,
+
+    -- Check constraint
+    CONSTRAINT chk_guild_name_length
+        CHECK (char_length(name) BETWEEN 3 AND 50)
+);
+
+-- Create guild_members table
+-- A character belongs to at most one guild (character_id is the primary key); the leader is a member too
+CREATE TABLE IF NOT EXISTS guild_members (
+    character_id VARCHAR(255) PRIMARY KEY,
+    guild_id VARCHAR(255) NOT NULL,
+    joined_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+
+    -- Foreign key constraints
+    CONSTRAINT fk_guild_member_guild
+        FOREIGN KEY (guild_id)
+        REFERENCES guilds(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_guild_member_character
+        FOREIGN KEY (character_id)
+        REFERENCES characters(id)
+        ON DELETE CASCADE
+);
+
+-- Create index on guild_id for listing and counting the members of a guild
+CREATE INDEX IF NOT EXISTS idx_guild_members_guild_id ON guild_members(guild_id);
@@ -0,0 +1,71 @@
+-- Create raids table
+-- A guild's fight against a boss; its HP pool and reward are scaled to the members when the raid starts
+CREATE TABLE IF NOT EXISTS raids (
+    id VARCHAR(255) PRIMARY KEY,
+    guild_id VARCHAR(255) NOT NULL,
+    boss VARCHAR(50) NOT NULL,
+    max_hp INTEGER NOT NULL,
+    hp INTEGER NOT NULL,
+    reward_xp INTEGER NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    started_at TIMESTAMPTZ NOT NULL,
+    ends_at TIMESTAMPTZ NOT NULL,
+    ended_at TIMESTAMPTZ,
+
+    -- Foreign key constraint
+    CONSTRAINT fk_raid_guild
+        FOREIGN KEY (guild_id)
+        REFERENCES guilds(id)
+        ON DELETE CASCADE,
+
+    -- Check constraints
+    CONSTRAINT chk_raid_status
+        CHECK (status IN ('active', 'defeated', 'expired')),
+
+    CONSTRAINT chk_raid_hp
+        CHECK (max_hp > 0 AND hp >= 0 AND hp <= max_hp AND (hp = 0) = (status = 'defeated')),
+
+    CONSTRAINT chk_raid_reward_xp
+        CHECK (reward_xp >= 0),
+
+    CONSTRAINT chk_raid_window
+        CHECK (ends_at > started_at),
+
+    -- Finished raids record when they ended
+    CONSTRAINT chk_raid_ended_at
+        CHECK ((status = 'active') = (ended_at IS NULL))
+);
+
+-- A guild fights one boss at a time
+CREATE UNIQUE INDEX IF NOT EXISTS idx_raids_active ON raids(guild_id) WHERE status = 'active';
+
+-- Create index for the latest raid of a guild
+CREATE INDEX IF NOT EXISTS idx_raids_guild_started_at ON raids(guild_id, started_at DESC);
+
+-- Create raid_hits table
+-- The damage each habit completion dealt to a boss (a completion strikes at most once, so it can be taken back on undo)
+CREATE TABLE IF NOT EXISTS raid_hits (
+    completion_id VARCHAR(255) PRIMARY KEY,
+    raid_id VARCHAR(255) NOT NULL,
+    character_id VARCHAR(255) NOT NULL,
+    damage INTEGER NOT NULL,
+    hit_at TIMESTAMPTZ NOT NULL,
+
+    -- Foreign key constraints
+    CONSTRAINT fk_raid_hit_raid
+        FOREIGN KEY (raid_id)
+        REFERENCES raids(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_raid_hit_character
+        FOREIGN KEY (character_id)
+        REFERENCES characters(id)
+        ON DELETE CASCADE,
+
+    -- Check constraint
+    CONSTRAINT chk_raid_hit_damage
+        CHECK (damage > 0)
+);
+
+-- Create index for summing each character's contribution to a raid
+CREATE INDEX IF NOT EXISTS idx_raid_hits_raid_character ON raid_hits(raid_id, character_id);
@@ -0,0 +1,18 @@
+-- Create character_energy table
+-- Battle energy of each character that ever spent some (companion table of characters; no row means a full pool).
+-- energy is the value as of updated_at: the regeneration since then is derived from the clock, never stored.
+CREATE TABLE IF NOT EXISTS character_energy (
+    character_id VARCHAR(255) PRIMARY KEY,
+    energy INTEGER NOT NULL,
+    updated_at TIMESTAMPTZ NOT NULL,
+
+    -- Foreign key constraint
+    CONSTRAINT fk_character_energy_character
+        FOREIGN KEY (character_id)
+        REFERENCES characters(id)
+        ON DELETE CASCADE,
+
+    -- Check constraints
+    CONSTRAINT chk_character_energy_range
+        CHECK (energy >= 0 AND energy <= 100)
+);
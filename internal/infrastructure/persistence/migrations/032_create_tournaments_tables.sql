@@ -0,0 +1,127 @@
+-- Create tournaments table
+-- Characters join until join_deadline, when the bracket is seeded and the first round is fought;
+-- next_round_at is when the round job has work to do (NULL once the tournament is over)
+CREATE TABLE IF NOT EXISTS tournaments (
+    id VARCHAR(255) PRIMARY KEY,
+    name VARCHAR(60) NOT NULL,
+    format VARCHAR(30) NOT NULL,
+    seeding VARCHAR(20) NOT NULL,
+    guild_id VARCHAR(255),
+    organizer_user_id VARCHAR(255) NOT NULL,
+    max_entrants INTEGER NOT NULL,
+    prize_xp INTEGER NOT NULL,
+    join_deadline TIMESTAMPTZ NOT NULL,
+    round_interval_seconds INTEGER NOT NULL,
+    status VARCHAR(20) NOT NULL DEFAULT 'open',
+    total_rounds INTEGER NOT NULL DEFAULT 0,
+    rounds_played INTEGER NOT NULL DEFAULT 0,
+    champion_character_id VARCHAR(255),
+    runner_up_character_id VARCHAR(255),
+    next_round_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL,
+    ended_at TIMESTAMPTZ,
+
+    -- Foreign key constraints
+    CONSTRAINT fk_tournament_guild
+        FOREIGN KEY (guild_id)
+        REFERENCES guilds(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_tournament_organizer
+        FOREIGN KEY (organizer_user_id)
+        REFERENCES users(id)
+        ON DELETE CASCADE,
+
+    -- Check constraints
+    CONSTRAINT chk_tournament_format
+        CHECK (format IN ('single_elimination', 'round_robin')),
+
+    CONSTRAINT chk_tournament_seeding
+        CHECK (seeding IN ('level', 'rating')),
+
+    CONSTRAINT chk_tournament_status
+        CHECK (status IN ('open', 'running', 'completed', 'cancelled')),
+
+    CONSTRAINT chk_tournament_max_entrants
+        CHECK (max_entrants BETWEEN 2 AND 64),
+
+    CONSTRAINT chk_tournament_prize_xp
+        CHECK (prize_xp BETWEEN 0 AND 1000),
+
+    CONSTRAINT chk_tournament_round_interval
+        CHECK (round_interval_seconds >= 60),
+
+    CONSTRAINT chk_tournament_rounds
+        CHECK (rounds_played >= 0 AND rounds_played <= total_rounds),
+
+    CONSTRAINT chk_tournament_join_deadline
+        CHECK (join_deadline > created_at),
+
+    -- Finished tournaments record when they ended
+    CONSTRAINT chk_tournament_ended_at
+        CHECK ((status IN ('open', 'running')) = (ended_at IS NULL))
+);
+
+-- Create partial index for the round job's lookup of due tournaments
+CREATE INDEX IF NOT EXISTS idx_tournaments_next_round_at ON tournaments(next_round_at) WHERE next_round_at IS NOT NULL;
+
+-- Create tournament_entrants table
+-- Characters that joined a tournament; seed stays 0 until the bracket is seeded at the deadline
+CREATE TABLE IF NOT EXISTS tournament_entrants (
+    tournament_id VARCHAR(255) NOT NULL,
+    character_id VARCHAR(255) NOT NULL,
+    seed INTEGER NOT NULL DEFAULT 0,
+    seed_score INTEGER NOT NULL DEFAULT 0,
+    joined_at TIMESTAMPTZ NOT NULL,
+
+    PRIMARY KEY (tournament_id, character_id),
+
+    -- Foreign key constraints
+    CONSTRAINT fk_tournament_entrant_tournament
+        FOREIGN KEY (tournament_id)
+        REFERENCES tournaments(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_tournament_entrant_character
+        FOREIGN KEY (character_id)
+        REFERENCES characters(id)
+        ON DELETE CASCADE,
+
+    -- Check constraint
+    CONSTRAINT chk_tournament_entrant_seed
+        CHECK (seed >= 0)
+);
+
+-- Create tournament_matches table
+-- One row per pairing of a fought round; the primary key keeps a rerun round job from recording a round twice.
+-- opponent_character_id is NULL for a bye, winner_character_id for a round-robin draw
+CREATE TABLE IF NOT EXISTS tournament_matches (
+    tournament_id VARCHAR(255) NOT NULL,
+    round INTEGER NOT NULL,
+    slot INTEGER NOT NULL,
+    character_id VARCHAR(255) NOT NULL,
+    opponent_character_id VARCHAR(255),
+    battle_id VARCHAR(255),
+    winner_character_id VARCHAR(255),
+    played_at TIMESTAMPTZ NOT NULL,
+
+    PRIMARY KEY (tournament_id, round, slot),
+
+    -- Foreign key constraints
+    CONSTRAINT fk_tournament_match_tournament
+        FOREIGN KEY (tournament_id)
+        REFERENCES tournaments(id)
+        ON DELETE CASCADE,
+
+    CONSTRAINT fk_tournament_match_battle
+        FOREIGN KEY (battle_id)
+        REFERENCES battles(id)
+        ON DELETE SET NULL,
+
+    -- Check constraints
+    CONSTRAINT chk_tournament_match_round
+        CHECK (round > 0 AND slot > 0),
+
+    CONSTRAINT chk_tournament_match_self
+        CHECK (character_id <> opponent_character_id)
+);
@@ -0,0 +1,19 @@
+-- A character has at most one open (running or paused) focus session
+-- The start use case checks for an open session before inserting, but two concurrent starts
+-- could both pass that check; the unique index makes the second insert fail instead.
+
+-- Close the older duplicates left by such races (the newest open session of each character is kept)
+UPDATE focus_sessions fs
+SET ended_at = COALESCE(fs.paused_at, fs.started_at), paused_at = NULL
+WHERE fs.ended_at IS NULL
+  AND EXISTS (
+      SELECT 1
+      FROM focus_sessions newer
+      WHERE newer.character_id = fs.character_id
+        AND newer.ended_at IS NULL
+        AND (newer.started_at, newer.id) > (fs.started_at, fs.id)
+  );
+
+DROP INDEX IF EXISTS idx_focus_sessions_open;
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_focus_sessions_open ON focus_sessions(character_id) WHERE ended_at IS NULL;
@@ -0,0 +1,18 @@
+-- Track focus sessions against a task as well as against a habit
+-- Each session belongs to exactly one of them
+ALTER TABLE focus_sessions
+    ALTER COLUMN habit_id DROP NOT NULL,
+    ADD COLUMN IF NOT EXISTS task_id VARCHAR(255);
+
+ALTER TABLE focus_sessions
+    ADD CONSTRAINT fk_focus_session_task
+        FOREIGN KEY (task_id)
+        REFERENCES tasks(id)
+        ON DELETE CASCADE;
+
+ALTER TABLE focus_sessions
+    ADD CONSTRAINT chk_focus_session_target
+        CHECK (num_nonnulls(habit_id, task_id) = 1);
+
+-- Create index on task_id for cascading deletes
+CREATE INDEX IF NOT EXISTS idx_focus_sessions_task_id ON focus_sessions(task_id);
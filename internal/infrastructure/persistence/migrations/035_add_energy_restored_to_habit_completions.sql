@@ -0,0 +1,8 @@
+-- Record the battle energy each habit completion restored
+-- Undoing a completion drains exactly that amount; completions logged before this column existed drain nothing
+ALTER TABLE habit_completions
+    ADD COLUMN IF NOT EXISTS energy_restored INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE habit_completions
+    ADD CONSTRAINT chk_habit_completion_energy_restored
+        CHECK (energy_restored >= 0);
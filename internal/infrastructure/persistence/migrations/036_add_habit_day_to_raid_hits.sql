@@ -0,0 +1,13 @@
+-- Track the habit and the user's day of each raid hit
+-- A habit strikes a raid boss at most once a day, however many times it is completed (or undone and redone);
+-- hits recorded before these columns existed have no habit and never block a strike
+ALTER TABLE raid_hits
+    ADD COLUMN IF NOT EXISTS habit_id VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS hit_day DATE;
+
+ALTER TABLE raid_hits
+    ADD CONSTRAINT chk_raid_hit_habit_day
+        CHECK ((habit_id IS NULL) = (hit_day IS NULL));
+
+-- One hit per habit and day in each raid
+CREATE UNIQUE INDEX IF NOT EXISTS idx_raid_hits_habit_day ON raid_hits(raid_id, habit_id, hit_day);
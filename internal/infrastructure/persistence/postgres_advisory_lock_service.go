@@ -0,0 +1,57 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+)
+
+// PostgresAdvisoryLockService implements the LockService interface with Postgres session advisory locks
+// Locks are held on a dedicated pool connection, so every API replica sharing the database sees them
+type PostgresAdvisoryLockService struct {
+	db *PostgresDB
+}
+
+// NewPostgresAdvisoryLockService creates a new PostgresAdvisoryLockService
+func NewPostgresAdvisoryLockService(db *PostgresDB) *PostgresAdvisoryLockService {
+	return &PostgresAdvisoryLockService{
+		db: db,
+	}
+}
+
+// TryLock tries to acquire the advisory lock identified by key without waiting
+// The key is hashed into the 64-bit advisory lock space
+func (s *PostgresAdvisoryLockService) TryLock(ctx context.Context, key string) (func(), bool, error) {
+	// Session locks belong to the connection that acquired them: keep it until release
+	conn, err := s.db.Pool.Acquire(ctx)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to acquire connection for lock: %w", err)
+	}
+
+	var acquired bool
+	err = conn.QueryRow(ctx, `SELECT pg_try_advisory_lock(hashtextextended($1, 0))`, key).Scan(&acquired)
+	if err != nil {
+		conn.Release()
+		return nil, false, fmt.Errorf("failed to acquire advisory lock: %w", err)
+	}
+
+	if !acquired {
+		conn.Release()
+		return nil, false, nil
+	}
+
+	release := func() {
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if _, err := conn.Exec(ctx, `SELECT pg_advisory_unlock(hashtextextended($1, 0))`, key); err != nil {
+			// Closing the connection releases every lock it holds
+			log.Printf("failed to release advisory lock %q, closing connection: %v", key, err)
+			conn.Conn().Close(ctx)
+		}
+		conn.Release()
+	}
+
+	return release, true, nil
+}
@@ -0,0 +1,179 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/jackc/pgx/v5"
+)
+
+// battleChallengeColumns lists the columns selected for every battle challenge query
+const battleChallengeColumns = `id, challenger_character_id, opponent_character_id, ranked, status, battle_id, created_at, expires_at, responded_at`
+
+// PostgresBattleChallengeRepository implements the BattleChallengeRepository interface
+type PostgresBattleChallengeRepository struct {
+	db *PostgresDB
+}
+
+// NewPostgresBattleChallengeRepository creates a new PostgresBattleChallengeRepository
+func NewPostgresBattleChallengeRepository(db *PostgresDB) *PostgresBattleChallengeRepository {
+	return &PostgresBattleChallengeRepository{
+		db: db,
+	}
+}
+
+// Create persists a new challenge
+func (r *PostgresBattleChallengeRepository) Create(ctx context.Context, challenge *entity.BattleChallenge) error {
+	query := `
+		INSERT INTO battle_challenges (id, challenger_character_id, opponent_character_id, ranked, status, battle_id, created_at, expires_at, responded_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err := r.db.conn(ctx).Exec(ctx, query,
+		challenge.ID(),
+		challenge.ChallengerCharacterID(),
+		challenge.OpponentCharacterID(),
+		challenge.IsRanked(),
+		challenge.StoredStatus(),
+		challengeBattleID(challenge),
+		challenge.CreatedAt(),
+		challenge.ExpiresAt(),
+		challenge.RespondedAt(),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create battle challenge: %w", err)
+	}
+
+	return nil
+}
+
+// FindByID retrieves a challenge by its ID
+func (r *PostgresBattleChallengeRepository) FindByID(ctx context.Context, id string) (*entity.BattleChallenge, error) {
+	query := `
+		SELECT ` + battleChallengeColumns + `
+		FROM battle_challenges
+		WHERE id = $1
+	`
+
+	challenge, err := scanBattleChallenge(r.db.conn(ctx).QueryRow(ctx, query, id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("battle challenge not found")
+		}
+		return nil, fmt.Errorf("failed to find battle challenge: %w", err)
+	}
+
+	return challenge, nil
+}
+
+// FindPendingByCharacterID retrieves the challenges sent or received by a character
+// still waiting for an answer at the given time (most recent first)
+func (r *PostgresBattleChallengeRepository) FindPendingByCharacterID(ctx context.Context, characterID string, now time.Time) ([]*entity.BattleChallenge, error) {
+	query := `
+		SELECT ` + battleChallengeColumns + `
+		FROM battle_challenges
+		WHERE (challenger_character_id = $1 OR opponent_character_id = $1)
+			AND status = 'pending' AND expires_at > $2
+		ORDER BY created_at DESC
+	`
+
+	rows, err := r.db.conn(ctx).Query(ctx, query, characterID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find battle challenges: %w", err)
+	}
+	defer rows.Close()
+
+	var challenges []*entity.BattleChallenge
+
+	for rows.Next() {
+		challenge, err := scanBattleChallenge(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan battle challenge: %w", err)
+		}
+		challenges = append(challenges, challenge)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating battle challenges: %w", err)
+	}
+
+	return challenges, nil
+}
+
+// Respond saves the answer to a challenge
+// Only pending rows are updated, so two concurrent answers can't both succeed
+func (r *PostgresBattleChallengeRepository) Respond(ctx context.Context, challenge *entity.BattleChallenge) error {
+	query := `
+		UPDATE battle_challenges
+		SET status = $2, battle_id = $3, responded_at = $4
+		WHERE id = $1 AND status = 'pending'
+	`
+
+	result, err := r.db.conn(ctx).Exec(ctx, query,
+		challenge.ID(),
+		challenge.StoredStatus(),
+		challengeBattleID(challenge),
+		challenge.RespondedAt(),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update battle challenge: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("battle challenge not found or already answered")
+	}
+
+	return nil
+}
+
+// challengeBattleID returns the nullable battle column of a challenge (nil until it is accepted)
+func challengeBattleID(challenge *entity.BattleChallenge) *string {
+	if challenge.BattleID() == "" {
+		return nil
+	}
+
+	battleID := challenge.BattleID()
+	return &battleID
+}
+
+// scanBattleChallenge scans a single row into a BattleChallenge entity
+func scanBattleChallenge(row pgx.Row) (*entity.BattleChallenge, error) {
+	var (
+		id                    string
+		challengerCharacterID string
+		opponentCharacterID   string
+		ranked                bool
+		status                string
+		battleID              *string
+		createdAt             time.Time
+		expiresAt             time.Time
+		respondedAt           *time.Time
+	)
+
+	err := row.Scan(
+		&id,
+		&challengerCharacterID,
+		&opponentCharacterID,
+		&ranked,
+		&status,
+		&battleID,
+		&createdAt,
+		&expiresAt,
+		&respondedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// The battle column is NULL until the challenge is accepted
+	var battle string
+	if battleID != nil {
+		battle = *battleID
+	}
+
+	return entity.ReconstituteBattleChallenge(id, challengerCharacterID, opponentCharacterID, ranked, status, battle, createdAt, expiresAt, respondedAt), nil
+}
@@ -0,0 +1,88 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+	"github.com/igor/chronotask-api/internal/infrastructure/persistence"
+)
+
+func TestPostgresBattleChallengeRepository_AcceptOnlyOnce(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer db.Pool.Exec(context.Background(), "DELETE FROM battles")
+
+	ctx := context.Background()
+	userRepo := persistence.NewPostgresUserRepository(db)
+	charRepo := persistence.NewPostgresCharacterRepository(db)
+	battleRepo := persistence.NewPostgresBattleRepository(db)
+	challengeRepo := persistence.NewPostgresBattleChallengeRepository(db)
+
+	challenger := createTestCharacter(t, userRepo, charRepo)
+	opponent, err := entity.NewCharacter("test-rival-id", "Test Rival", valueobject.DefaultCharacterClass(), challenger.UserID())
+	if err != nil {
+		t.Fatalf("Failed to create rival entity: %v", err)
+	}
+	if err := charRepo.Create(ctx, opponent); err != nil {
+		t.Fatalf("Failed to save rival: %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	challenge, err := entity.NewBattleChallenge("test-challenge-id", challenger.ID(), opponent.ID(), false, now, time.Hour)
+	if err != nil {
+		t.Fatalf("Failed to create challenge entity: %v", err)
+	}
+	if err := challengeRepo.Create(ctx, challenge); err != nil {
+		t.Fatalf("Create() error = %v, want nil", err)
+	}
+
+	// Pending challenges are listed for both sides until they expire
+	for _, characterID := range []string{challenger.ID(), opponent.ID()} {
+		pending, err := challengeRepo.FindPendingByCharacterID(ctx, characterID, now)
+		if err != nil || len(pending) != 1 {
+			t.Errorf("FindPendingByCharacterID(%s) = %d challenges, %v, want 1", characterID, len(pending), err)
+		}
+	}
+	if pending, _ := challengeRepo.FindPendingByCharacterID(ctx, opponent.ID(), now.Add(time.Hour)); len(pending) != 0 {
+		t.Errorf("FindPendingByCharacterID() after expiration = %d challenges, want 0", len(pending))
+	}
+
+	// Accept with a recorded battle
+	battle, err := entity.NewBattle("test-battle-id", newTestCharacterCombatant(t, challenger, 9), newTestCharacterCombatant(t, opponent, 3), 99, now)
+	if err != nil {
+		t.Fatalf("Failed to create battle entity: %v", err)
+	}
+	if err := battleRepo.Create(ctx, battle); err != nil {
+		t.Fatalf("battle Create() error = %v, want nil", err)
+	}
+	if err := challenge.Accept(now, battle.ID()); err != nil {
+		t.Fatalf("Accept() error = %v, want nil", err)
+	}
+	if err := challengeRepo.Respond(ctx, challenge); err != nil {
+		t.Fatalf("Respond() error = %v, want nil", err)
+	}
+
+	found, err := challengeRepo.FindByID(ctx, challenge.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v, want nil", err)
+	}
+	if found.StoredStatus() != entity.BattleChallengeAccepted || found.BattleID() != battle.ID() || found.RespondedAt() == nil {
+		t.Errorf("found = (%v, %v, %v), want accepted with the battle", found.StoredStatus(), found.BattleID(), found.RespondedAt())
+	}
+
+	// A second answer (e.g. a concurrent decline) is rejected
+	if err := challengeRepo.Respond(ctx, challenge); err == nil {
+		t.Error("Respond() on answered challenge error = nil, want error")
+	}
+
+	savedBattle, err := battleRepo.FindByID(ctx, battle.ID())
+	if err != nil {
+		t.Fatalf("battle FindByID() error = %v, want nil", err)
+	}
+	if savedBattle.Seed() != 99 || savedBattle.WinnerID() != battle.WinnerID() {
+		t.Errorf("battle = (seed %v, winner %v), want (99, %v)", savedBattle.Seed(), savedBattle.WinnerID(), battle.WinnerID())
+	}
+}
@@ -0,0 +1,90 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/jackc/pgx/v5"
+)
+
+// PostgresBattleRepository implements the BattleRepository interface
+type PostgresBattleRepository struct {
+	db *PostgresDB
+}
+
+// NewPostgresBattleRepository creates a new PostgresBattleRepository
+func NewPostgresBattleRepository(db *PostgresDB) *PostgresBattleRepository {
+	return &PostgresBattleRepository{
+		db: db,
+	}
+}
+
+// Create persists a fought battle with its replay log
+func (r *PostgresBattleRepository) Create(ctx context.Context, battle *entity.Battle) error {
+	log, err := battle.EncodeLog()
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO battles (id, kind, challenger_id, opponent_id, seed, winner, rounds, challenger_hp, opponent_hp, log, fought_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	_, err = r.db.conn(ctx).Exec(ctx, query,
+		battle.ID(),
+		battle.Kind(),
+		battle.ChallengerID(),
+		battle.OpponentID(),
+		battle.Seed(),
+		battle.Winner(),
+		battle.Rounds(),
+		battle.ChallengerHP(),
+		battle.OpponentHP(),
+		log,
+		battle.FoughtAt(),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create battle: %w", err)
+	}
+
+	return nil
+}
+
+// FindByID retrieves a battle by its ID, rebuilt from its replay log
+func (r *PostgresBattleRepository) FindByID(ctx context.Context, id string) (*entity.Battle, error) {
+	query := `
+		SELECT id, log, fought_at
+		FROM battles
+		WHERE id = $1
+	`
+
+	var (
+		battleID string
+		log      []byte
+		foughtAt time.Time
+	)
+
+	err := r.db.conn(ctx).QueryRow(ctx, query, id).Scan(&battleID, &log, &foughtAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("battle not found")
+		}
+		return nil, fmt.Errorf("failed to find battle: %w", err)
+	}
+
+	// Battles recorded before replay logs existed can't be rebuilt
+	if log == nil {
+		return nil, fmt.Errorf("battle has no replay log")
+	}
+
+	battle, err := entity.DecodeBattleLog(battleID, log, foughtAt)
+	if err != nil {
+		return nil, fmt.Errorf("invalid battle in database: %w", err)
+	}
+
+	return battle, nil
+}
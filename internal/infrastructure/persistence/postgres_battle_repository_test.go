@@ -0,0 +1,82 @@
+package persistence_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+	"github.com/igor/chronotask-api/internal/infrastructure/persistence"
+)
+
+// newTestCharacterCombatant snapshots a character with all attributes at attributeValue
+func newTestCharacterCombatant(t *testing.T, character *entity.Character, attributeValue int) valueobject.Combatant {
+	t.Helper()
+
+	attributes := map[string]int{}
+	for _, profile := range valueobject.DefaultCharacterClass().Attributes() {
+		attributes[profile.Name] = attributeValue
+	}
+
+	combatant, err := valueobject.NewCombatant(valueobject.CombatantCharacter, character.ID(), character.Name(), character.Level(), valueobject.NewCombatStats(attributes, character.Level()))
+	if err != nil {
+		t.Fatalf("Failed to create combatant: %v", err)
+	}
+	return combatant
+}
+
+func TestPostgresBattleRepository_StoresReplayLog(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer db.Pool.Exec(context.Background(), "DELETE FROM battles")
+
+	ctx := context.Background()
+	userRepo := persistence.NewPostgresUserRepository(db)
+	charRepo := persistence.NewPostgresCharacterRepository(db)
+	monsterRepo := persistence.NewPostgresMonsterRepository(db)
+	battleRepo := persistence.NewPostgresBattleRepository(db)
+
+	character := createTestCharacter(t, userRepo, charRepo)
+
+	monster, err := monsterRepo.FindByID(ctx, "goblin")
+	if err != nil {
+		t.Fatalf("Failed to find monster: %v", err)
+	}
+	opponent, err := monster.Combatant(character.Level())
+	if err != nil {
+		t.Fatalf("Failed to create monster combatant: %v", err)
+	}
+
+	battle, err := entity.NewBattle("test-battle-id", newTestCharacterCombatant(t, character, 6), opponent, 2024, time.Now().UTC().Truncate(time.Microsecond))
+	if err != nil {
+		t.Fatalf("Failed to create battle entity: %v", err)
+	}
+	if err := battleRepo.Create(ctx, battle); err != nil {
+		t.Fatalf("Create() error = %v, want nil", err)
+	}
+
+	found, err := battleRepo.FindByID(ctx, battle.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v, want nil", err)
+	}
+
+	if found.Kind() != entity.BattleKindPvE || !found.FoughtAt().Equal(battle.FoughtAt()) {
+		t.Errorf("found = (%v, %v), want (pve, %v)", found.Kind(), found.FoughtAt(), battle.FoughtAt())
+	}
+
+	// The stored log is the one written and still replays the same battle
+	want, _ := battle.EncodeLog()
+	got, _ := found.EncodeLog()
+	if !bytes.Equal(got, want) {
+		t.Errorf("stored log = %s, want %s", got, want)
+	}
+	if !found.IsReproducible() {
+		t.Error("stored battle should be reproducible from its snapshots and seed")
+	}
+
+	if _, err := battleRepo.FindByID(ctx, "unknown-battle"); err == nil {
+		t.Error("FindByID() error = nil, want error for an unknown battle")
+	}
+}
@@ -30,7 +30,7 @@ func (r *PostgresCharacterAttributeRepository) Create(ctx context.Context, attri
 	`
 
 	var id int
-	err := r.db.Pool.QueryRow(ctx, query,
+	err := r.db.conn(ctx).QueryRow(ctx, query,
 		attribute.AttributeName(),
 		attribute.Value(),
 		attribute.CharacterID(),
@@ -60,7 +60,7 @@ func (r *PostgresCharacterAttributeRepository) FindByID(ctx context.Context, id
 		createdAt     time.Time
 	)
 
-	err := r.db.Pool.QueryRow(ctx, query, id).Scan(
+	err := r.db.conn(ctx).QueryRow(ctx, query, id).Scan(
 		&attributeID,
 		&attributeName,
 		&value,
@@ -95,7 +95,25 @@ func (r *PostgresCharacterAttributeRepository) FindByCharacterID(ctx context.Con
 		ORDER BY id ASC
 	`
 
-	rows, err := r.db.Pool.Query(ctx, query, characterID)
+	return r.findByCharacterID(ctx, query, characterID)
+}
+
+// FindByCharacterIDForUpdate retrieves all attributes for a character and locks their rows until the transaction ends
+func (r *PostgresCharacterAttributeRepository) FindByCharacterIDForUpdate(ctx context.Context, characterID string) ([]*entity.CharacterAttribute, error) {
+	query := `
+		SELECT id, attribute_name, value, character_id, created_at
+		FROM character_attributes
+		WHERE character_id = $1
+		ORDER BY id ASC
+		FOR UPDATE
+	`
+
+	return r.findByCharacterID(ctx, query, characterID)
+}
+
+// findByCharacterID runs a query for all attributes of a character
+func (r *PostgresCharacterAttributeRepository) findByCharacterID(ctx context.Context, query string, characterID string) ([]*entity.CharacterAttribute, error) {
+	rows, err := r.db.conn(ctx).Query(ctx, query, characterID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find character attributes: %w", err)
 	}
@@ -150,6 +168,23 @@ func (r *PostgresCharacterAttributeRepository) FindByCharacterIDAndName(ctx cont
 		WHERE character_id = $1 AND attribute_name = $2
 	`
 
+	return r.findByCharacterIDAndName(ctx, query, characterID, attributeName)
+}
+
+// FindByCharacterIDAndNameForUpdate retrieves a specific attribute and locks its row until the transaction ends
+func (r *PostgresCharacterAttributeRepository) FindByCharacterIDAndNameForUpdate(ctx context.Context, characterID string, attributeName string) (*entity.CharacterAttribute, error) {
+	query := `
+		SELECT id, attribute_name, value, character_id, created_at
+		FROM character_attributes
+		WHERE character_id = $1 AND attribute_name = $2
+		FOR UPDATE
+	`
+
+	return r.findByCharacterIDAndName(ctx, query, characterID, attributeName)
+}
+
+// findByCharacterIDAndName runs a query for a single attribute by character ID and attribute name
+func (r *PostgresCharacterAttributeRepository) findByCharacterIDAndName(ctx context.Context, query string, characterID string, attributeName string) (*entity.CharacterAttribute, error) {
 	var (
 		attributeID int
 		attrName    string
@@ -158,7 +193,7 @@ func (r *PostgresCharacterAttributeRepository) FindByCharacterIDAndName(ctx cont
 		createdAt   time.Time
 	)
 
-	err := r.db.Pool.QueryRow(ctx, query, characterID, attributeName).Scan(
+	err := r.db.conn(ctx).QueryRow(ctx, query, characterID, attributeName).Scan(
 		&attributeID,
 		&attrName,
 		&value,
@@ -192,7 +227,7 @@ func (r *PostgresCharacterAttributeRepository) Update(ctx context.Context, attri
 		WHERE id = $1
 	`
 
-	result, err := r.db.Pool.Exec(ctx, query,
+	result, err := r.db.conn(ctx).Exec(ctx, query,
 		attribute.ID(),
 		attribute.AttributeName(),
 		attribute.Value(),
@@ -213,7 +248,7 @@ func (r *PostgresCharacterAttributeRepository) Update(ctx context.Context, attri
 func (r *PostgresCharacterAttributeRepository) Delete(ctx context.Context, id int) error {
 	query := `DELETE FROM character_attributes WHERE id = $1`
 
-	result, err := r.db.Pool.Exec(ctx, query, id)
+	result, err := r.db.conn(ctx).Exec(ctx, query, id)
 	if err != nil {
 		return fmt.Errorf("failed to delete character attribute: %w", err)
 	}
@@ -230,7 +265,7 @@ func (r *PostgresCharacterAttributeRepository) ExistsByCharacterIDAndName(ctx co
 	query := `SELECT EXISTS(SELECT 1 FROM character_attributes WHERE character_id = $1 AND attribute_name = $2)`
 
 	var exists bool
-	err := r.db.Pool.QueryRow(ctx, query, characterID, attributeName).Scan(&exists)
+	err := r.db.conn(ctx).QueryRow(ctx, query, characterID, attributeName).Scan(&exists)
 	if err != nil {
 		return false, fmt.Errorf("failed to check if character attribute exists: %w", err)
 	}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/igor/chronotask-api/internal/domain/entity"
 	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
 	"github.com/igor/chronotask-api/internal/infrastructure/persistence"
 )
 
@@ -20,6 +21,7 @@ func createTestCharacter(t *testing.T, userRepo repository.UserRepository, charR
 	character, err := entity.NewCharacter(
 		"test-char-id",
 		"Test Warrior",
+		valueobject.DefaultCharacterClass(),
 		user.ID(),
 	)
 	if err != nil {
@@ -47,7 +49,6 @@ func TestPostgresCharacterAttributeRepository_Create(t *testing.T) {
 
 	// Create attribute
 	attribute, err := entity.NewCharacterAttribute(
-		"attr-123",
 		"Strength",
 		10,
 		character.ID(),
@@ -86,7 +87,7 @@ func TestPostgresCharacterAttributeRepository_FindByID_NotFound(t *testing.T) {
 
 	attrRepo := persistence.NewPostgresCharacterAttributeRepository(db)
 
-	_, err := attrRepo.FindByID(context.Background(), "non-existent-id")
+	_, err := attrRepo.FindByID(context.Background(), 999999)
 	if err == nil {
 		t.Error("FindByID() error = nil, want error for non-existent attribute")
 	}
@@ -103,9 +104,9 @@ func TestPostgresCharacterAttributeRepository_FindByCharacterID(t *testing.T) {
 	character := createTestCharacter(t, userRepo, charRepo)
 
 	// Create multiple attributes
-	attr1, _ := entity.NewCharacterAttribute("attr-1", "Strength", 10, character.ID())
-	attr2, _ := entity.NewCharacterAttribute("attr-2", "Agility", 15, character.ID())
-	attr3, _ := entity.NewCharacterAttribute("attr-3", "Intelligence", 20, character.ID())
+	attr1, _ := entity.NewCharacterAttribute("Strength", 10, character.ID())
+	attr2, _ := entity.NewCharacterAttribute("Agility", 15, character.ID())
+	attr3, _ := entity.NewCharacterAttribute("Intelligence", 20, character.ID())
 
 	attrRepo.Create(context.Background(), attr1)
 	attrRepo.Create(context.Background(), attr2)
@@ -161,7 +162,7 @@ func TestPostgresCharacterAttributeRepository_FindByCharacterIDAndName(t *testin
 
 	character := createTestCharacter(t, userRepo, charRepo)
 
-	attribute, _ := entity.NewCharacterAttribute("attr-123", "Strength", 10, character.ID())
+	attribute, _ := entity.NewCharacterAttribute("Strength", 10, character.ID())
 	attrRepo.Create(context.Background(), attribute)
 
 	// Find specific attribute
@@ -205,7 +206,7 @@ func TestPostgresCharacterAttributeRepository_Update(t *testing.T) {
 
 	character := createTestCharacter(t, userRepo, charRepo)
 
-	attribute, _ := entity.NewCharacterAttribute("attr-123", "Strength", 10, character.ID())
+	attribute, _ := entity.NewCharacterAttribute("Strength", 10, character.ID())
 	attrRepo.Create(context.Background(), attribute)
 
 	// Update attribute value
@@ -235,7 +236,7 @@ func TestPostgresCharacterAttributeRepository_Update_NotFound(t *testing.T) {
 	character := createTestCharacter(t, userRepo, charRepo)
 
 	// Try to update non-existent attribute
-	attribute, _ := entity.NewCharacterAttribute("non-existent", "Strength", 10, character.ID())
+	attribute, _ := entity.NewCharacterAttribute("Strength", 10, character.ID())
 
 	err := attrRepo.Update(context.Background(), attribute)
 	if err == nil {
@@ -253,7 +254,7 @@ func TestPostgresCharacterAttributeRepository_Delete(t *testing.T) {
 
 	character := createTestCharacter(t, userRepo, charRepo)
 
-	attribute, _ := entity.NewCharacterAttribute("attr-123", "Strength", 10, character.ID())
+	attribute, _ := entity.NewCharacterAttribute("Strength", 10, character.ID())
 	attrRepo.Create(context.Background(), attribute)
 
 	err := attrRepo.Delete(context.Background(), attribute.ID())
@@ -274,7 +275,7 @@ func TestPostgresCharacterAttributeRepository_Delete_NotFound(t *testing.T) {
 
 	attrRepo := persistence.NewPostgresCharacterAttributeRepository(db)
 
-	err := attrRepo.Delete(context.Background(), "non-existent-id")
+	err := attrRepo.Delete(context.Background(), 999999)
 	if err == nil {
 		t.Error("Delete() error = nil, want error for non-existent attribute")
 	}
@@ -300,7 +301,7 @@ func TestPostgresCharacterAttributeRepository_ExistsByCharacterIDAndName(t *test
 	}
 
 	// Create attribute
-	attribute, _ := entity.NewCharacterAttribute("attr-123", "Strength", 10, character.ID())
+	attribute, _ := entity.NewCharacterAttribute("Strength", 10, character.ID())
 	attrRepo.Create(context.Background(), attribute)
 
 	// Should exist now
@@ -320,7 +321,7 @@ func TestPostgresCharacterAttributeRepository_ForeignKeyConstraint(t *testing.T)
 	attrRepo := persistence.NewPostgresCharacterAttributeRepository(db)
 
 	// Try to create attribute with non-existent character ID
-	attribute, _ := entity.NewCharacterAttribute("attr-123", "Strength", 10, "non-existent-character")
+	attribute, _ := entity.NewCharacterAttribute("Strength", 10, "non-existent-character")
 
 	err := attrRepo.Create(context.Background(), attribute)
 	if err == nil {
@@ -339,14 +340,14 @@ func TestPostgresCharacterAttributeRepository_UniqueConstraint(t *testing.T) {
 	character := createTestCharacter(t, userRepo, charRepo)
 
 	// Create first attribute
-	attribute1, _ := entity.NewCharacterAttribute("attr-123", "Strength", 10, character.ID())
+	attribute1, _ := entity.NewCharacterAttribute("Strength", 10, character.ID())
 	err := attrRepo.Create(context.Background(), attribute1)
 	if err != nil {
 		t.Fatalf("First Create() error = %v, want nil", err)
 	}
 
 	// Try to create second attribute with same name for same character
-	attribute2, _ := entity.NewCharacterAttribute("attr-456", "Strength", 15, character.ID())
+	attribute2, _ := entity.NewCharacterAttribute("Strength", 15, character.ID())
 	err = attrRepo.Create(context.Background(), attribute2)
 	if err == nil {
 		t.Error("Second Create() should fail due to unique constraint on (character_id, attribute_name)")
@@ -364,7 +365,7 @@ func TestPostgresCharacterAttributeRepository_CascadeDelete(t *testing.T) {
 	character := createTestCharacter(t, userRepo, charRepo)
 
 	// Create attribute
-	attribute, _ := entity.NewCharacterAttribute("attr-123", "Strength", 10, character.ID())
+	attribute, _ := entity.NewCharacterAttribute("Strength", 10, character.ID())
 	attrRepo.Create(context.Background(), attribute)
 
 	// Delete character
@@ -0,0 +1,130 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/jackc/pgx/v5"
+)
+
+// characterEnergyColumns lists the columns selected for every character energy query
+const characterEnergyColumns = `character_id, energy, updated_at`
+
+// PostgresCharacterEnergyRepository implements the CharacterEnergyRepository interface
+type PostgresCharacterEnergyRepository struct {
+	db *PostgresDB
+}
+
+// NewPostgresCharacterEnergyRepository creates a new PostgresCharacterEnergyRepository
+func NewPostgresCharacterEnergyRepository(db *PostgresDB) *PostgresCharacterEnergyRepository {
+	return &PostgresCharacterEnergyRepository{
+		db: db,
+	}
+}
+
+// FindByCharacterID retrieves the energy of a character
+// Returns nil (without error) when the character never spent energy
+func (r *PostgresCharacterEnergyRepository) FindByCharacterID(ctx context.Context, characterID string) (*entity.CharacterEnergy, error) {
+	query := `
+		SELECT ` + characterEnergyColumns + `
+		FROM character_energy
+		WHERE character_id = $1
+	`
+
+	return r.findOne(ctx, query, characterID)
+}
+
+// FindByCharacterIDForUpdate retrieves the energy of a character and locks its row until the transaction ends
+// Returns nil (without error) when the character never spent energy
+func (r *PostgresCharacterEnergyRepository) FindByCharacterIDForUpdate(ctx context.Context, characterID string) (*entity.CharacterEnergy, error) {
+	query := `
+		SELECT ` + characterEnergyColumns + `
+		FROM character_energy
+		WHERE character_id = $1
+		FOR UPDATE
+	`
+
+	return r.findOne(ctx, query, characterID)
+}
+
+// findOne runs a query for a single energy pool, returning nil when there is none
+func (r *PostgresCharacterEnergyRepository) findOne(ctx context.Context, query string, characterID string) (*entity.CharacterEnergy, error) {
+	energy, err := scanCharacterEnergy(r.db.conn(ctx).QueryRow(ctx, query, characterID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find character energy: %w", err)
+	}
+
+	return energy, nil
+}
+
+// Create persists the energy of a character that never spent energy
+// Does nothing when the character already has one
+func (r *PostgresCharacterEnergyRepository) Create(ctx context.Context, energy *entity.CharacterEnergy) error {
+	query := `
+		INSERT INTO character_energy (character_id, energy, updated_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (character_id) DO NOTHING
+	`
+
+	_, err := r.db.conn(ctx).Exec(ctx, query,
+		energy.CharacterID(),
+		energy.Energy(),
+		energy.UpdatedAt(),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create character energy: %w", err)
+	}
+
+	return nil
+}
+
+// Update saves the energy of a character
+func (r *PostgresCharacterEnergyRepository) Update(ctx context.Context, energy *entity.CharacterEnergy) error {
+	query := `
+		UPDATE character_energy
+		SET energy = $2, updated_at = $3
+		WHERE character_id = $1
+	`
+
+	result, err := r.db.conn(ctx).Exec(ctx, query,
+		energy.CharacterID(),
+		energy.Energy(),
+		energy.UpdatedAt(),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update character energy: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("character energy not found")
+	}
+
+	return nil
+}
+
+// scanCharacterEnergy reads a character energy row (selected with characterEnergyColumns)
+func scanCharacterEnergy(row pgx.Row) (*entity.CharacterEnergy, error) {
+	var (
+		characterID string
+		energy      int
+		updatedAt   time.Time
+	)
+
+	err := row.Scan(
+		&characterID,
+		&energy,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.ReconstituteCharacterEnergy(characterID, energy, updatedAt), nil
+}
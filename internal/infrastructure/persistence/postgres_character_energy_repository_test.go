@@ -0,0 +1,57 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/infrastructure/persistence"
+)
+
+func TestPostgresCharacterEnergyRepository_CreateAndUpdate(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	userRepo := persistence.NewPostgresUserRepository(db)
+	charRepo := persistence.NewPostgresCharacterRepository(db)
+	energyRepo := persistence.NewPostgresCharacterEnergyRepository(db)
+
+	character := createTestCharacter(t, userRepo, charRepo)
+
+	// Characters that never spent energy have no row yet
+	if energy, err := energyRepo.FindByCharacterIDForUpdate(ctx, character.ID()); err != nil || energy != nil {
+		t.Fatalf("FindByCharacterIDForUpdate() = %v, %v, want nil, nil", energy, err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	energy, err := entity.NewCharacterEnergy(character.ID(), now)
+	if err != nil {
+		t.Fatalf("Failed to create energy entity: %v", err)
+	}
+	if err := energyRepo.Create(ctx, energy); err != nil {
+		t.Fatalf("Create() error = %v, want nil", err)
+	}
+
+	if err := energy.Spend(entity.BattleEnergyCost, now); err != nil {
+		t.Fatalf("Spend() error = %v, want nil", err)
+	}
+	if err := energyRepo.Update(ctx, energy); err != nil {
+		t.Fatalf("Update() error = %v, want nil", err)
+	}
+
+	// Creating it again (a concurrent first spend) keeps the stored energy
+	again, _ := entity.NewCharacterEnergy(character.ID(), now)
+	if err := energyRepo.Create(ctx, again); err != nil {
+		t.Fatalf("Create() again error = %v, want nil", err)
+	}
+
+	found, err := energyRepo.FindByCharacterID(ctx, character.ID())
+	if err != nil {
+		t.Fatalf("FindByCharacterID() error = %v, want nil", err)
+	}
+	if found.Energy() != entity.MaxEnergy-entity.BattleEnergyCost || !found.UpdatedAt().Equal(now) {
+		t.Errorf("found = (%d, %v), want (%d, %v)", found.Energy(), found.UpdatedAt(), entity.MaxEnergy-entity.BattleEnergyCost, now)
+	}
+}
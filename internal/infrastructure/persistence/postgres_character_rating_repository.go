@@ -0,0 +1,314 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+	"github.com/jackc/pgx/v5"
+)
+
+// characterRatingColumns lists the columns selected for every character rating query
+const characterRatingColumns = `character_id, rating, deviation, volatility, wins, losses, draws, last_played_at`
+
+// PostgresCharacterRatingRepository implements the CharacterRatingRepository interface
+type PostgresCharacterRatingRepository struct {
+	db *PostgresDB
+}
+
+// NewPostgresCharacterRatingRepository creates a new PostgresCharacterRatingRepository
+func NewPostgresCharacterRatingRepository(db *PostgresDB) *PostgresCharacterRatingRepository {
+	return &PostgresCharacterRatingRepository{
+		db: db,
+	}
+}
+
+// FindByCharacterID retrieves the rating of a character
+// Returns nil (without error) when the character never fought a ranked battle
+func (r *PostgresCharacterRatingRepository) FindByCharacterID(ctx context.Context, characterID string) (*entity.CharacterRating, error) {
+	query := `
+		SELECT ` + characterRatingColumns + `
+		FROM character_ratings
+		WHERE character_id = $1
+	`
+
+	return r.findOne(ctx, query, characterID)
+}
+
+// FindByCharacterIDForUpdate retrieves the rating of a character and locks its row until the transaction ends
+// Returns nil (without error) when the character never fought a ranked battle
+func (r *PostgresCharacterRatingRepository) FindByCharacterIDForUpdate(ctx context.Context, characterID string) (*entity.CharacterRating, error) {
+	query := `
+		SELECT ` + characterRatingColumns + `
+		FROM character_ratings
+		WHERE character_id = $1
+		FOR UPDATE
+	`
+
+	return r.findOne(ctx, query, characterID)
+}
+
+// findOne runs a query for a single rating, returning nil when there is none
+func (r *PostgresCharacterRatingRepository) findOne(ctx context.Context, query string, characterID string) (*entity.CharacterRating, error) {
+	rating, err := scanCharacterRating(r.db.conn(ctx).QueryRow(ctx, query, characterID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find character rating: %w", err)
+	}
+
+	return rating, nil
+}
+
+// Create persists the initial rating of a character that never fought a ranked battle
+// Does nothing when the character already has one (e.g. created by a concurrent request)
+func (r *PostgresCharacterRatingRepository) Create(ctx context.Context, rating *entity.CharacterRating) error {
+	query := `
+		INSERT INTO character_ratings (character_id, rating, deviation, volatility, wins, losses, draws, last_played_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())
+		ON CONFLICT (character_id) DO NOTHING
+	`
+
+	current := rating.Rating()
+	_, err := r.db.conn(ctx).Exec(ctx, query,
+		rating.CharacterID(),
+		current.Rating(),
+		current.Deviation(),
+		current.Volatility(),
+		rating.Wins(),
+		rating.Losses(),
+		rating.Draws(),
+		rating.LastPlayedAt(),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create character rating: %w", err)
+	}
+
+	return nil
+}
+
+// Save creates or replaces the rating of a character, together with its pending history entries
+func (r *PostgresCharacterRatingRepository) Save(ctx context.Context, rating *entity.CharacterRating) error {
+	query := `
+		INSERT INTO character_ratings (character_id, rating, deviation, volatility, wins, losses, draws, last_played_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NOW())
+		ON CONFLICT (character_id) DO UPDATE
+		SET rating = EXCLUDED.rating,
+			deviation = EXCLUDED.deviation,
+			volatility = EXCLUDED.volatility,
+			wins = EXCLUDED.wins,
+			losses = EXCLUDED.losses,
+			draws = EXCLUDED.draws,
+			last_played_at = EXCLUDED.last_played_at,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	tx, err := r.db.begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	current := rating.Rating()
+	_, err = tx.Exec(ctx, query,
+		rating.CharacterID(),
+		current.Rating(),
+		current.Deviation(),
+		current.Volatility(),
+		rating.Wins(),
+		rating.Losses(),
+		rating.Draws(),
+		rating.LastPlayedAt(),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to save character rating: %w", err)
+	}
+
+	if err := insertRatingChanges(ctx, tx, rating.PendingChanges()); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit character rating: %w", err)
+	}
+
+	rating.ClearPendingChanges()
+	return nil
+}
+
+// FindLeaderboard retrieves a page of the ratings of characters with ranked battles (highest rating first)
+func (r *PostgresCharacterRatingRepository) FindLeaderboard(ctx context.Context, limit int, offset int) ([]*entity.CharacterRating, error) {
+	query := `
+		SELECT ` + characterRatingColumns + `
+		FROM character_ratings
+		ORDER BY rating DESC, character_id
+		LIMIT $1 OFFSET $2
+	`
+
+	rows, err := r.db.conn(ctx).Query(ctx, query, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find leaderboard: %w", err)
+	}
+	defer rows.Close()
+
+	var ratings []*entity.CharacterRating
+
+	for rows.Next() {
+		rating, err := scanCharacterRating(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan character rating: %w", err)
+		}
+		ratings = append(ratings, rating)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating character ratings: %w", err)
+	}
+
+	return ratings, nil
+}
+
+// CountRated counts the characters with ranked battles
+func (r *PostgresCharacterRatingRepository) CountRated(ctx context.Context) (int, error) {
+	query := `SELECT COUNT(*) FROM character_ratings`
+
+	var count int
+	if err := r.db.conn(ctx).QueryRow(ctx, query).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count character ratings: %w", err)
+	}
+
+	return count, nil
+}
+
+// FindRecentChangesByCharacterID retrieves the latest rating history entries of a character (most recent first)
+func (r *PostgresCharacterRatingRepository) FindRecentChangesByCharacterID(ctx context.Context, characterID string, limit int) ([]*entity.RatingChange, error) {
+	query := `
+		SELECT id, character_id, battle_id, opponent_character_id, score,
+			rating_before, deviation_before, volatility_before,
+			rating_after, deviation_after, volatility_after, created_at
+		FROM rating_changes
+		WHERE character_id = $1
+		ORDER BY id DESC
+		LIMIT $2
+	`
+
+	rows, err := r.db.conn(ctx).Query(ctx, query, characterID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find rating changes: %w", err)
+	}
+	defer rows.Close()
+
+	var changes []*entity.RatingChange
+
+	for rows.Next() {
+		var (
+			id                  int64
+			changeCharacterID   string
+			battleID            string
+			opponentCharacterID string
+			score               float64
+			before              [3]float64
+			after               [3]float64
+			createdAt           time.Time
+		)
+
+		err := rows.Scan(
+			&id,
+			&changeCharacterID,
+			&battleID,
+			&opponentCharacterID,
+			&score,
+			&before[0], &before[1], &before[2],
+			&after[0], &after[1], &after[2],
+			&createdAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan rating change: %w", err)
+		}
+
+		beforeRating, err := valueobject.NewGlickoRating(before[0], before[1], before[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid stored rating change: %w", err)
+		}
+		afterRating, err := valueobject.NewGlickoRating(after[0], after[1], after[2])
+		if err != nil {
+			return nil, fmt.Errorf("invalid stored rating change: %w", err)
+		}
+
+		changes = append(changes, entity.ReconstituteRatingChange(id, changeCharacterID, battleID, opponentCharacterID, score, beforeRating, afterRating, createdAt))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating rating changes: %w", err)
+	}
+
+	return changes, nil
+}
+
+// insertRatingChanges writes rating history entries in the given transaction
+func insertRatingChanges(ctx context.Context, tx pgx.Tx, changes []*entity.RatingChange) error {
+	query := `
+		INSERT INTO rating_changes (character_id, battle_id, opponent_character_id, score,
+			rating_before, deviation_before, volatility_before,
+			rating_after, deviation_after, volatility_after, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+	`
+
+	for _, change := range changes {
+		before, after := change.Before(), change.After()
+		_, err := tx.Exec(ctx, query,
+			change.CharacterID(),
+			change.BattleID(),
+			change.OpponentCharacterID(),
+			change.Score(),
+			before.Rating(), before.Deviation(), before.Volatility(),
+			after.Rating(), after.Deviation(), after.Volatility(),
+			change.CreatedAt(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create rating change: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// scanCharacterRating scans a single row into a CharacterRating entity
+func scanCharacterRating(row pgx.Row) (*entity.CharacterRating, error) {
+	var (
+		characterID  string
+		rating       float64
+		deviation    float64
+		volatility   float64
+		wins         int
+		losses       int
+		draws        int
+		lastPlayedAt *time.Time
+	)
+
+	err := row.Scan(
+		&characterID,
+		&rating,
+		&deviation,
+		&volatility,
+		&wins,
+		&losses,
+		&draws,
+		&lastPlayedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	glicko, err := valueobject.NewGlickoRating(rating, deviation, volatility)
+	if err != nil {
+		return nil, fmt.Errorf("invalid stored character rating: %w", err)
+	}
+
+	return entity.ReconstituteCharacterRating(characterID, glicko, wins, losses, draws, lastPlayedAt), nil
+}
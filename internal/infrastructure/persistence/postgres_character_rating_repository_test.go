@@ -0,0 +1,88 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+	"github.com/igor/chronotask-api/internal/infrastructure/persistence"
+)
+
+func TestPostgresCharacterRatingRepository_SaveWithHistory(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer db.Pool.Exec(context.Background(), "DELETE FROM battles")
+
+	ctx := context.Background()
+	userRepo := persistence.NewPostgresUserRepository(db)
+	charRepo := persistence.NewPostgresCharacterRepository(db)
+	battleRepo := persistence.NewPostgresBattleRepository(db)
+	ratingRepo := persistence.NewPostgresCharacterRatingRepository(db)
+
+	hero := createTestCharacter(t, userRepo, charRepo)
+	rival, err := entity.NewCharacter("test-rival-id", "Test Rival", valueobject.DefaultCharacterClass(), hero.UserID())
+	if err != nil {
+		t.Fatalf("Failed to create rival entity: %v", err)
+	}
+	if err := charRepo.Create(ctx, rival); err != nil {
+		t.Fatalf("Failed to save rival: %v", err)
+	}
+
+	// Characters without ranked battles have no rating yet
+	if rating, err := ratingRepo.FindByCharacterID(ctx, hero.ID()); err != nil || rating != nil {
+		t.Fatalf("FindByCharacterID() = %v, %v, want nil, nil", rating, err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	battle, err := entity.NewBattle("test-battle-id", newTestCharacterCombatant(t, hero, 9), newTestCharacterCombatant(t, rival, 3), 7, now)
+	if err != nil {
+		t.Fatalf("Failed to create battle entity: %v", err)
+	}
+	if err := battleRepo.Create(ctx, battle); err != nil {
+		t.Fatalf("battle Create() error = %v, want nil", err)
+	}
+
+	heroRating, _ := entity.NewCharacterRating(hero.ID())
+	rivalRating, _ := entity.NewCharacterRating(rival.ID())
+	if err := entity.RateBattle(battle, heroRating, rivalRating, 24*time.Hour); err != nil {
+		t.Fatalf("RateBattle() error = %v, want nil", err)
+	}
+	for _, rating := range []*entity.CharacterRating{heroRating, rivalRating} {
+		if err := ratingRepo.Save(ctx, rating); err != nil {
+			t.Fatalf("Save() error = %v, want nil", err)
+		}
+		if len(rating.PendingChanges()) != 0 {
+			t.Error("Save() should clear the pending history entries")
+		}
+	}
+
+	found, err := ratingRepo.FindByCharacterIDForUpdate(ctx, hero.ID())
+	if err != nil || found == nil {
+		t.Fatalf("FindByCharacterIDForUpdate() = %v, %v, want the rating", found, err)
+	}
+	if !found.Rating().Equals(heroRating.Rating()) || found.Games() != 1 || found.LastPlayedAt() == nil || !found.LastPlayedAt().Equal(now) {
+		t.Errorf("found = %+v, want the saved rating", found)
+	}
+
+	// Leaderboard: highest rating first
+	leaderboard, err := ratingRepo.FindLeaderboard(ctx, 10, 0)
+	if err != nil || len(leaderboard) != 2 {
+		t.Fatalf("FindLeaderboard() = %d ratings, %v, want 2", len(leaderboard), err)
+	}
+	if leaderboard[0].Rating().Rating() < leaderboard[1].Rating().Rating() {
+		t.Error("FindLeaderboard() should order by rating, highest first")
+	}
+	if count, err := ratingRepo.CountRated(ctx); err != nil || count != 2 {
+		t.Errorf("CountRated() = %d, %v, want 2", count, err)
+	}
+
+	changes, err := ratingRepo.FindRecentChangesByCharacterID(ctx, rival.ID(), 10)
+	if err != nil || len(changes) != 1 {
+		t.Fatalf("FindRecentChangesByCharacterID() = %d changes, %v, want 1", len(changes), err)
+	}
+	if changes[0].BattleID() != battle.ID() || changes[0].OpponentCharacterID() != hero.ID() || !changes[0].After().Equals(rivalRating.Rating()) {
+		t.Errorf("change = %+v, want the rival's change in the battle", changes[0])
+	}
+}
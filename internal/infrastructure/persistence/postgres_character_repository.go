@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
 	"github.com/jackc/pgx/v5"
 )
 
@@ -24,16 +25,18 @@ func NewPostgresCharacterRepository(db *PostgresDB) *PostgresCharacterRepository
 // Create persists a new character
 func (r *PostgresCharacterRepository) Create(ctx context.Context, character *entity.Character) error {
 	query := `
-		INSERT INTO characters (id, name, level, current_xp, total_xp, user_id, created_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		INSERT INTO characters (id, name, class, level, current_xp, total_xp, unspent_attribute_points, user_id, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 	`
 
-	_, err := r.db.Pool.Exec(ctx, query,
+	_, err := r.db.conn(ctx).Exec(ctx, query,
 		character.ID(),
 		character.Name(),
+		character.Class().Value(),
 		character.Level(),
 		character.CurrentXp(),
 		character.TotalXp(),
+		character.UnspentAttributePoints(),
 		character.UserID(),
 		character.CreatedAt(),
 	)
@@ -48,27 +51,48 @@ func (r *PostgresCharacterRepository) Create(ctx context.Context, character *ent
 // FindByID retrieves a character by their ID
 func (r *PostgresCharacterRepository) FindByID(ctx context.Context, id string) (*entity.Character, error) {
 	query := `
-		SELECT id, name, level, current_xp, total_xp, user_id, created_at
+		SELECT id, name, class, level, current_xp, total_xp, unspent_attribute_points, user_id, created_at
 		FROM characters
 		WHERE id = $1
 	`
 
+	return r.findByID(ctx, query, id)
+}
+
+// FindByIDForUpdate retrieves a character by their ID and locks its row until the transaction ends
+func (r *PostgresCharacterRepository) FindByIDForUpdate(ctx context.Context, id string) (*entity.Character, error) {
+	query := `
+		SELECT id, name, class, level, current_xp, total_xp, unspent_attribute_points, user_id, created_at
+		FROM characters
+		WHERE id = $1
+		FOR UPDATE
+	`
+
+	return r.findByID(ctx, query, id)
+}
+
+// findByID runs a query for a single character by ID
+func (r *PostgresCharacterRepository) findByID(ctx context.Context, query string, id string) (*entity.Character, error) {
 	var (
-		characterID string
-		name        string
-		level       int
-		currentXp   int
-		totalXp     int
-		userID      string
-		createdAt   time.Time
+		characterID            string
+		name                   string
+		className              string
+		level                  int
+		currentXp              int
+		totalXp                int
+		unspentAttributePoints int
+		userID                 string
+		createdAt              time.Time
 	)
 
-	err := r.db.Pool.QueryRow(ctx, query, id).Scan(
+	err := r.db.conn(ctx).QueryRow(ctx, query, id).Scan(
 		&characterID,
 		&name,
+		&className,
 		&level,
 		&currentXp,
 		&totalXp,
+		&unspentAttributePoints,
 		&userID,
 		&createdAt,
 	)
@@ -80,12 +104,19 @@ func (r *PostgresCharacterRepository) FindByID(ctx context.Context, id string) (
 		return nil, fmt.Errorf("failed to find character: %w", err)
 	}
 
+	class, err := valueobject.NewCharacterClass(className)
+	if err != nil {
+		return nil, fmt.Errorf("invalid character class in database: %w", err)
+	}
+
 	character := entity.ReconstituteCharacter(
 		characterID,
 		name,
+		class,
 		level,
 		currentXp,
 		totalXp,
+		unspentAttributePoints,
 		userID,
 		createdAt,
 	)
@@ -97,27 +128,31 @@ func (r *PostgresCharacterRepository) FindByID(ctx context.Context, id string) (
 // Returns error if character doesn't exist OR doesn't belong to the user
 func (r *PostgresCharacterRepository) FindByIDAndUserID(ctx context.Context, id string, userID string) (*entity.Character, error) {
 	query := `
-		SELECT id, name, level, current_xp, total_xp, user_id, created_at
+		SELECT id, name, class, level, current_xp, total_xp, unspent_attribute_points, user_id, created_at
 		FROM characters
 		WHERE id = $1 AND user_id = $2
 	`
 
 	var (
-		characterID string
-		name        string
-		level       int
-		currentXp   int
-		totalXp     int
-		userIDVal   string
-		createdAt   time.Time
+		characterID            string
+		name                   string
+		className              string
+		level                  int
+		currentXp              int
+		totalXp                int
+		unspentAttributePoints int
+		userIDVal              string
+		createdAt              time.Time
 	)
 
-	err := r.db.Pool.QueryRow(ctx, query, id, userID).Scan(
+	err := r.db.conn(ctx).QueryRow(ctx, query, id, userID).Scan(
 		&characterID,
 		&name,
+		&className,
 		&level,
 		&currentXp,
 		&totalXp,
+		&unspentAttributePoints,
 		&userIDVal,
 		&createdAt,
 	)
@@ -129,12 +164,19 @@ func (r *PostgresCharacterRepository) FindByIDAndUserID(ctx context.Context, id
 		return nil, fmt.Errorf("failed to find character: %w", err)
 	}
 
+	class, err := valueobject.NewCharacterClass(className)
+	if err != nil {
+		return nil, fmt.Errorf("invalid character class in database: %w", err)
+	}
+
 	character := entity.ReconstituteCharacter(
 		characterID,
 		name,
+		class,
 		level,
 		currentXp,
 		totalXp,
+		unspentAttributePoints,
 		userIDVal,
 		createdAt,
 	)
@@ -145,27 +187,31 @@ func (r *PostgresCharacterRepository) FindByIDAndUserID(ctx context.Context, id
 // FindByUserID retrieves a character by their user ID
 func (r *PostgresCharacterRepository) FindByUserID(ctx context.Context, userID string) (*entity.Character, error) {
 	query := `
-		SELECT id, name, level, current_xp, total_xp, user_id, created_at
+		SELECT id, name, class, level, current_xp, total_xp, unspent_attribute_points, user_id, created_at
 		FROM characters
 		WHERE user_id = $1
 	`
 
 	var (
-		characterID string
-		name        string
-		level       int
-		currentXp   int
-		totalXp     int
-		userIDVal   string
-		createdAt   time.Time
+		characterID            string
+		name                   string
+		className              string
+		level                  int
+		currentXp              int
+		totalXp                int
+		unspentAttributePoints int
+		userIDVal              string
+		createdAt              time.Time
 	)
 
-	err := r.db.Pool.QueryRow(ctx, query, userID).Scan(
+	err := r.db.conn(ctx).QueryRow(ctx, query, userID).Scan(
 		&characterID,
 		&name,
+		&className,
 		&level,
 		&currentXp,
 		&totalXp,
+		&unspentAttributePoints,
 		&userIDVal,
 		&createdAt,
 	)
@@ -177,12 +223,19 @@ func (r *PostgresCharacterRepository) FindByUserID(ctx context.Context, userID s
 		return nil, fmt.Errorf("failed to find character by user: %w", err)
 	}
 
+	class, err := valueobject.NewCharacterClass(className)
+	if err != nil {
+		return nil, fmt.Errorf("invalid character class in database: %w", err)
+	}
+
 	character := entity.ReconstituteCharacter(
 		characterID,
 		name,
+		class,
 		level,
 		currentXp,
 		totalXp,
+		unspentAttributePoints,
 		userIDVal,
 		createdAt,
 	)
@@ -193,13 +246,13 @@ func (r *PostgresCharacterRepository) FindByUserID(ctx context.Context, userID s
 // FindAllByUserID retrieves all characters for a user
 func (r *PostgresCharacterRepository) FindAllByUserID(ctx context.Context, userID string) ([]*entity.Character, error) {
 	query := `
-		SELECT id, name, level, current_xp, total_xp, user_id, created_at
+		SELECT id, name, class, level, current_xp, total_xp, unspent_attribute_points, user_id, created_at
 		FROM characters
 		WHERE user_id = $1
 		ORDER BY created_at DESC
 	`
 
-	rows, err := r.db.Pool.Query(ctx, query, userID)
+	rows, err := r.db.conn(ctx).Query(ctx, query, userID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to find characters: %w", err)
 	}
@@ -209,21 +262,25 @@ func (r *PostgresCharacterRepository) FindAllByUserID(ctx context.Context, userI
 
 	for rows.Next() {
 		var (
-			characterID string
-			name        string
-			level       int
-			currentXp   int
-			totalXp     int
-			userIDVal   string
-			createdAt   time.Time
+			characterID            string
+			name                   string
+			className              string
+			level                  int
+			currentXp              int
+			totalXp                int
+			unspentAttributePoints int
+			userIDVal              string
+			createdAt              time.Time
 		)
 
 		err := rows.Scan(
 			&characterID,
 			&name,
+			&className,
 			&level,
 			&currentXp,
 			&totalXp,
+			&unspentAttributePoints,
 			&userIDVal,
 			&createdAt,
 		)
@@ -232,12 +289,19 @@ func (r *PostgresCharacterRepository) FindAllByUserID(ctx context.Context, userI
 			return nil, fmt.Errorf("failed to scan character: %w", err)
 		}
 
+		class, err := valueobject.NewCharacterClass(className)
+		if err != nil {
+			return nil, fmt.Errorf("invalid character class in database: %w", err)
+		}
+
 		character := entity.ReconstituteCharacter(
 			characterID,
 			name,
+			class,
 			level,
 			currentXp,
 			totalXp,
+			unspentAttributePoints,
 			userIDVal,
 			createdAt,
 		)
@@ -253,19 +317,27 @@ func (r *PostgresCharacterRepository) FindAllByUserID(ctx context.Context, userI
 }
 
 // Update updates an existing character
+// The character's pending XP ledger entries are written in the same transaction
 func (r *PostgresCharacterRepository) Update(ctx context.Context, character *entity.Character) error {
 	query := `
 		UPDATE characters
-		SET name = $2, level = $3, current_xp = $4, total_xp = $5
+		SET name = $2, level = $3, current_xp = $4, total_xp = $5, unspent_attribute_points = $6
 		WHERE id = $1
 	`
 
-	result, err := r.db.Pool.Exec(ctx, query,
+	tx, err := r.db.begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	result, err := tx.Exec(ctx, query,
 		character.ID(),
 		character.Name(),
 		character.Level(),
 		character.CurrentXp(),
 		character.TotalXp(),
+		character.UnspentAttributePoints(),
 	)
 
 	if err != nil {
@@ -276,6 +348,15 @@ func (r *PostgresCharacterRepository) Update(ctx context.Context, character *ent
 		return fmt.Errorf("character not found")
 	}
 
+	if err := insertXpTransactions(ctx, tx, character.PendingXpTransactions()); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit character update: %w", err)
+	}
+
+	character.ClearPendingXpTransactions()
 	return nil
 }
 
@@ -283,7 +364,7 @@ func (r *PostgresCharacterRepository) Update(ctx context.Context, character *ent
 func (r *PostgresCharacterRepository) Delete(ctx context.Context, id string) error {
 	query := `DELETE FROM characters WHERE id = $1`
 
-	result, err := r.db.Pool.Exec(ctx, query, id)
+	result, err := r.db.conn(ctx).Exec(ctx, query, id)
 	if err != nil {
 		return fmt.Errorf("failed to delete character: %w", err)
 	}
@@ -300,7 +381,7 @@ func (r *PostgresCharacterRepository) ExistsByUserID(ctx context.Context, userID
 	query := `SELECT EXISTS(SELECT 1 FROM characters WHERE user_id = $1)`
 
 	var exists bool
-	err := r.db.Pool.QueryRow(ctx, query, userID).Scan(&exists)
+	err := r.db.conn(ctx).QueryRow(ctx, query, userID).Scan(&exists)
 	if err != nil {
 		return false, fmt.Errorf("failed to check if character exists for user: %w", err)
 	}
@@ -109,6 +109,7 @@ func TestPostgresCharacterRepository_Create(t *testing.T) {
 	character, err := entity.NewCharacter(
 		"char-123",
 		"Warrior King",
+		valueobject.DefaultCharacterClass(),
 		user.ID(),
 	)
 	if err != nil {
@@ -156,7 +157,7 @@ func TestPostgresCharacterRepository_FindByUserID(t *testing.T) {
 
 	user := createTestUser(t, userRepo)
 
-	character, _ := entity.NewCharacter("char-123", "Warrior King", user.ID())
+	character, _ := entity.NewCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), user.ID())
 	charRepo.Create(context.Background(), character)
 
 	found, err := charRepo.FindByUserID(context.Background(), user.ID())
@@ -178,7 +179,7 @@ func TestPostgresCharacterRepository_Update(t *testing.T) {
 
 	user := createTestUser(t, userRepo)
 
-	character, _ := entity.NewCharacter("char-123", "Warrior King", user.ID())
+	character, _ := entity.NewCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), user.ID())
 	charRepo.Create(context.Background(), character)
 
 	// Add XP and level up
@@ -215,7 +216,7 @@ func TestPostgresCharacterRepository_Delete(t *testing.T) {
 
 	user := createTestUser(t, userRepo)
 
-	character, _ := entity.NewCharacter("char-123", "Warrior King", user.ID())
+	character, _ := entity.NewCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), user.ID())
 	charRepo.Create(context.Background(), character)
 
 	err := charRepo.Delete(context.Background(), character.ID())
@@ -249,7 +250,7 @@ func TestPostgresCharacterRepository_ExistsByUserID(t *testing.T) {
 	}
 
 	// Create character
-	character, _ := entity.NewCharacter("char-123", "Warrior King", user.ID())
+	character, _ := entity.NewCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), user.ID())
 	charRepo.Create(context.Background(), character)
 
 	// Should exist now
@@ -269,7 +270,7 @@ func TestPostgresCharacterRepository_ForeignKeyConstraint(t *testing.T) {
 	charRepo := persistence.NewPostgresCharacterRepository(db)
 
 	// Try to create character with non-existent user ID
-	character, _ := entity.NewCharacter("char-123", "Warrior King", "non-existent-user")
+	character, _ := entity.NewCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), "non-existent-user")
 
 	err := charRepo.Create(context.Background(), character)
 	if err == nil {
@@ -287,14 +288,14 @@ func TestPostgresCharacterRepository_UniqueUserConstraint(t *testing.T) {
 	user := createTestUser(t, userRepo)
 
 	// Create first character
-	character1, _ := entity.NewCharacter("char-123", "Warrior King", user.ID())
+	character1, _ := entity.NewCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), user.ID())
 	err := charRepo.Create(context.Background(), character1)
 	if err != nil {
 		t.Fatalf("First Create() error = %v, want nil", err)
 	}
 
 	// Try to create second character for same user
-	character2, _ := entity.NewCharacter("char-456", "Another Warrior", user.ID())
+	character2, _ := entity.NewCharacter("char-456", "Another Warrior", valueobject.DefaultCharacterClass(), user.ID())
 	err = charRepo.Create(context.Background(), character2)
 	if err == nil {
 		t.Error("Second Create() should fail due to unique user constraint")
@@ -310,7 +311,7 @@ func TestPostgresCharacterRepository_FindByIDAndUserID_Success(t *testing.T) {
 
 	user := createTestUser(t, userRepo)
 
-	character, _ := entity.NewCharacter("char-123", "Warrior King", user.ID())
+	character, _ := entity.NewCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), user.ID())
 	charRepo.Create(context.Background(), character)
 
 	// Find character with correct user ID
@@ -337,7 +338,7 @@ func TestPostgresCharacterRepository_FindByIDAndUserID_WrongUser(t *testing.T) {
 
 	user := createTestUser(t, userRepo)
 
-	character, _ := entity.NewCharacter("char-123", "Warrior King", user.ID())
+	character, _ := entity.NewCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), user.ID())
 	charRepo.Create(context.Background(), character)
 
 	// Try to find character with wrong user ID
@@ -359,3 +360,29 @@ func TestPostgresCharacterRepository_FindByIDAndUserID_NotFound(t *testing.T) {
 		t.Error("FindByIDAndUserID() for non-existent character should return error")
 	}
 }
+
+func TestPostgresCharacterRepository_Create_PersistsClass(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	userRepo := persistence.NewPostgresUserRepository(db)
+	charRepo := persistence.NewPostgresCharacterRepository(db)
+
+	user := createTestUser(t, userRepo)
+
+	mage, _ := valueobject.NewCharacterClass(valueobject.ClassMage)
+	character, _ := entity.NewCharacter("char-123", "Merlin", mage, user.ID())
+
+	if err := charRepo.Create(context.Background(), character); err != nil {
+		t.Fatalf("Create() error = %v, want nil", err)
+	}
+
+	found, err := charRepo.FindByID(context.Background(), character.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v, want nil", err)
+	}
+
+	if !found.Class().Equals(mage) {
+		t.Errorf("found.Class() = %v, want %v", found.Class(), mage)
+	}
+}
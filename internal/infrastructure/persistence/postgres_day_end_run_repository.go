@@ -0,0 +1,62 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// PostgresDayEndRunRepository implements the DayEndRunRepository interface
+type PostgresDayEndRunRepository struct {
+	db *PostgresDB
+}
+
+// NewPostgresDayEndRunRepository creates a new PostgresDayEndRunRepository
+func NewPostgresDayEndRunRepository(db *PostgresDB) *PostgresDayEndRunRepository {
+	return &PostgresDayEndRunRepository{
+		db: db,
+	}
+}
+
+// Exists checks if the user's day was already processed
+func (r *PostgresDayEndRunRepository) Exists(ctx context.Context, userID string, day time.Time) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM day_end_runs WHERE user_id = $1 AND day = $2)`
+
+	var exists bool
+	err := r.db.conn(ctx).QueryRow(ctx, query, userID, calendarDate(day)).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check if day end run exists: %w", err)
+	}
+
+	return exists, nil
+}
+
+// FindLastDay retrieves the user's most recent processed day
+// Returns nil if none of the user's days was processed yet
+func (r *PostgresDayEndRunRepository) FindLastDay(ctx context.Context, userID string) (*time.Time, error) {
+	query := `SELECT MAX(day) FROM day_end_runs WHERE user_id = $1`
+
+	var day *time.Time
+	err := r.db.conn(ctx).QueryRow(ctx, query, userID).Scan(&day)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find last day end run: %w", err)
+	}
+
+	return day, nil
+}
+
+// Create marks the user's day as processed (no-op if it already was)
+func (r *PostgresDayEndRunRepository) Create(ctx context.Context, userID string, day time.Time) error {
+	query := `
+		INSERT INTO day_end_runs (user_id, day, processed_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (user_id, day) DO NOTHING
+	`
+
+	_, err := r.db.conn(ctx).Exec(ctx, query, userID, calendarDate(day), time.Now())
+	if err != nil {
+		return fmt.Errorf("failed to create day end run: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+	"github.com/jackc/pgx/v5"
+)
+
+// PostgresDungeonRepository implements the DungeonRepository interface
+type PostgresDungeonRepository struct {
+	db *PostgresDB
+}
+
+// NewPostgresDungeonRepository creates a new PostgresDungeonRepository
+func NewPostgresDungeonRepository(db *PostgresDB) *PostgresDungeonRepository {
+	return &PostgresDungeonRepository{
+		db: db,
+	}
+}
+
+// FindByID retrieves a dungeon with its stages by its ID
+func (r *PostgresDungeonRepository) FindByID(ctx context.Context, id string) (*entity.Dungeon, error) {
+	query := `
+		SELECT id, name, min_level, entry_cost, reward_xp, reward_loot
+		FROM dungeons
+		WHERE id = $1
+	`
+
+	var (
+		dungeonID string
+		name      string
+		minLevel  int
+		entryCost int
+		rewardXp  int
+		lootJSON  []byte
+	)
+
+	err := r.db.conn(ctx).QueryRow(ctx, query, id).Scan(
+		&dungeonID,
+		&name,
+		&minLevel,
+		&entryCost,
+		&rewardXp,
+		&lootJSON,
+	)
+
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("dungeon not found")
+		}
+		return nil, fmt.Errorf("failed to find dungeon: %w", err)
+	}
+
+	rewardLoot, err := unmarshalLoot(lootJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	stages, err := r.findStages(ctx, dungeonID)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.ReconstituteDungeon(dungeonID, name, minLevel, entryCost, rewardXp, rewardLoot, stages), nil
+}
+
+// findStages retrieves the stages of a dungeon in the order they are fought
+func (r *PostgresDungeonRepository) findStages(ctx context.Context, dungeonID string) ([]entity.DungeonStage, error) {
+	query := `
+		SELECT monster_id, checkpoint
+		FROM dungeon_stages
+		WHERE dungeon_id = $1
+		ORDER BY position
+	`
+
+	rows, err := r.db.conn(ctx).Query(ctx, query, dungeonID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find dungeon stages: %w", err)
+	}
+	defer rows.Close()
+
+	var stages []entity.DungeonStage
+
+	for rows.Next() {
+		var stage entity.DungeonStage
+		if err := rows.Scan(&stage.MonsterID, &stage.Checkpoint); err != nil {
+			return nil, fmt.Errorf("failed to scan dungeon stage: %w", err)
+		}
+		stages = append(stages, stage)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating dungeon stages: %w", err)
+	}
+
+	return stages, nil
+}
+
+// unmarshalLoot decodes a JSONB loot table (monster loot or dungeon reward)
+func unmarshalLoot(data []byte) ([]valueobject.LootDrop, error) {
+	var records []lootDropRecord
+	if err := json.Unmarshal(data, &records); err != nil {
+		return nil, fmt.Errorf("invalid loot in database: %w", err)
+	}
+
+	loot := make([]valueobject.LootDrop, len(records))
+	for i, record := range records {
+		drop, err := valueobject.NewLootDrop(record.Item, record.Chance, record.Quantity)
+		if err != nil {
+			return nil, fmt.Errorf("invalid loot in database: %w", err)
+		}
+		loot[i] = drop
+	}
+	return loot, nil
+}
@@ -0,0 +1,38 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/igor/chronotask-api/internal/infrastructure/persistence"
+)
+
+func TestPostgresDungeonRepository_FindByID(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	dungeonRepo := persistence.NewPostgresDungeonRepository(db)
+
+	// The migration seeds the starting catalog
+	dungeon, err := dungeonRepo.FindByID(context.Background(), "toca-dos-ratos")
+	if err != nil {
+		t.Fatalf("FindByID() error = %v, want nil", err)
+	}
+
+	if dungeon.Name() != "Toca dos Ratos" || dungeon.MinLevel() != 1 || dungeon.EntryCost() != 10 || dungeon.RewardXp() != 80 {
+		t.Errorf("dungeon = (%v, %v, %v, %v), want (Toca dos Ratos, 1, 10, 80)", dungeon.Name(), dungeon.MinLevel(), dungeon.EntryCost(), dungeon.RewardXp())
+	}
+	if loot := dungeon.RewardLoot(); len(loot) != 2 || loot[0].Item() != "Moeda de Prata" {
+		t.Errorf("RewardLoot() = %+v, want 2 drops starting with silver coins", loot)
+	}
+
+	// Stages come in position order
+	stages := dungeon.Stages()
+	if len(stages) != 5 || stages[0].MonsterID != "rato-gigante" || !stages[2].Checkpoint || stages[4].MonsterID != "lobo-cinzento" {
+		t.Errorf("Stages() = %+v, want 5 stages from the rat to the wolf with a checkpoint at the 3rd", stages)
+	}
+
+	if _, err := dungeonRepo.FindByID(context.Background(), "masmorra"); err == nil {
+		t.Error("FindByID() error = nil, want error for an unknown dungeon")
+	}
+}
@@ -0,0 +1,165 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/jackc/pgx/v5"
+)
+
+// dungeonRunColumns lists the columns selected for every dungeon run query
+const dungeonRunColumns = `id, dungeon_id, character_id, status, stage, hp, checkpoint, started_at, updated_at, ended_at`
+
+// PostgresDungeonRunRepository implements the DungeonRunRepository interface
+type PostgresDungeonRunRepository struct {
+	db *PostgresDB
+}
+
+// NewPostgresDungeonRunRepository creates a new PostgresDungeonRunRepository
+func NewPostgresDungeonRunRepository(db *PostgresDB) *PostgresDungeonRunRepository {
+	return &PostgresDungeonRunRepository{
+		db: db,
+	}
+}
+
+// Create persists a new run
+// The unique index on runs in progress rejects a second run of the same character
+func (r *PostgresDungeonRunRepository) Create(ctx context.Context, run *entity.DungeonRun) error {
+	query := `
+		INSERT INTO dungeon_runs (id, dungeon_id, character_id, status, stage, hp, checkpoint, started_at, updated_at, ended_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	_, err := r.db.conn(ctx).Exec(ctx, query,
+		run.ID(),
+		run.DungeonID(),
+		run.CharacterID(),
+		run.Status(),
+		run.Stage(),
+		run.HP(),
+		run.Checkpoint(),
+		run.StartedAt(),
+		run.UpdatedAt(),
+		run.EndedAt(),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create dungeon run: %w", err)
+	}
+
+	return nil
+}
+
+// Update saves the progress of a run
+func (r *PostgresDungeonRunRepository) Update(ctx context.Context, run *entity.DungeonRun) error {
+	query := `
+		UPDATE dungeon_runs
+		SET status = $2, stage = $3, hp = $4, checkpoint = $5, updated_at = $6, ended_at = $7
+		WHERE id = $1
+	`
+
+	result, err := r.db.conn(ctx).Exec(ctx, query,
+		run.ID(),
+		run.Status(),
+		run.Stage(),
+		run.HP(),
+		run.Checkpoint(),
+		run.UpdatedAt(),
+		run.EndedAt(),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update dungeon run: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("dungeon run not found")
+	}
+
+	return nil
+}
+
+// FindInProgressByCharacterID retrieves the run in progress of a character
+// Returns nil (without error) when the character isn't running a dungeon
+func (r *PostgresDungeonRunRepository) FindInProgressByCharacterID(ctx context.Context, characterID string) (*entity.DungeonRun, error) {
+	query := `
+		SELECT ` + dungeonRunColumns + `
+		FROM dungeon_runs
+		WHERE character_id = $1 AND status = 'in_progress'
+	`
+
+	return r.findOne(ctx, query, characterID)
+}
+
+// FindInProgressByCharacterIDForUpdate retrieves the run in progress of a character and locks its row until the transaction ends
+// Returns nil (without error) when the character isn't running a dungeon
+func (r *PostgresDungeonRunRepository) FindInProgressByCharacterIDForUpdate(ctx context.Context, characterID string) (*entity.DungeonRun, error) {
+	query := `
+		SELECT ` + dungeonRunColumns + `
+		FROM dungeon_runs
+		WHERE character_id = $1 AND status = 'in_progress'
+		FOR UPDATE
+	`
+
+	return r.findOne(ctx, query, characterID)
+}
+
+// findOne runs a query for a single run, returning nil when there is none
+func (r *PostgresDungeonRunRepository) findOne(ctx context.Context, query string, characterID string) (*entity.DungeonRun, error) {
+	run, err := scanDungeonRun(r.db.conn(ctx).QueryRow(ctx, query, characterID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find dungeon run: %w", err)
+	}
+
+	return run, nil
+}
+
+// scanDungeonRun scans a single row into a DungeonRun entity
+func scanDungeonRun(row pgx.Row) (*entity.DungeonRun, error) {
+	var (
+		id          string
+		dungeonID   string
+		characterID string
+		status      string
+		stage       int
+		hp          int
+		checkpoint  int
+		startedAt   time.Time
+		updatedAt   time.Time
+		endedAt     *time.Time
+	)
+
+	err := row.Scan(
+		&id,
+		&dungeonID,
+		&characterID,
+		&status,
+		&stage,
+		&hp,
+		&checkpoint,
+		&startedAt,
+		&updatedAt,
+		&endedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.ReconstituteDungeonRun(
+		id,
+		dungeonID,
+		characterID,
+		status,
+		stage,
+		hp,
+		checkpoint,
+		startedAt,
+		updatedAt,
+		endedAt,
+	), nil
+}
@@ -0,0 +1,75 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/infrastructure/persistence"
+)
+
+func TestPostgresDungeonRunRepository_CreateAndUpdate(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	userRepo := persistence.NewPostgresUserRepository(db)
+	charRepo := persistence.NewPostgresCharacterRepository(db)
+	dungeonRepo := persistence.NewPostgresDungeonRepository(db)
+	runRepo := persistence.NewPostgresDungeonRunRepository(db)
+
+	character := createTestCharacter(t, userRepo, charRepo)
+
+	// Characters without a run have nothing in progress
+	if run, err := runRepo.FindInProgressByCharacterID(ctx, character.ID()); err != nil || run != nil {
+		t.Fatalf("FindInProgressByCharacterID() = %v, %v, want nil, nil", run, err)
+	}
+
+	dungeon, err := dungeonRepo.FindByID(ctx, "toca-dos-ratos")
+	if err != nil {
+		t.Fatalf("Failed to find dungeon: %v", err)
+	}
+
+	startedAt := time.Now().UTC().Truncate(time.Microsecond)
+	run, err := entity.NewDungeonRun("test-run-id", dungeon.ID(), character.ID(), 120, startedAt)
+	if err != nil {
+		t.Fatalf("Failed to create run entity: %v", err)
+	}
+	if err := runRepo.Create(ctx, run); err != nil {
+		t.Fatalf("Create() error = %v, want nil", err)
+	}
+
+	// A character runs one dungeon at a time
+	second, _ := entity.NewDungeonRun("test-run-id-2", dungeon.ID(), character.ID(), 120, startedAt)
+	if err := runRepo.Create(ctx, second); err == nil {
+		t.Error("Create() error = nil, want error for a second run in progress")
+	}
+
+	// Progress is saved between stages
+	if err := run.RecordVictory(dungeon, 80, 120, startedAt.Add(time.Minute)); err != nil {
+		t.Fatalf("RecordVictory() error = %v, want nil", err)
+	}
+	if err := runRepo.Update(ctx, run); err != nil {
+		t.Fatalf("Update() error = %v, want nil", err)
+	}
+
+	found, err := runRepo.FindInProgressByCharacterIDForUpdate(ctx, character.ID())
+	if err != nil || found == nil {
+		t.Fatalf("FindInProgressByCharacterIDForUpdate() = %v, %v, want the run", found, err)
+	}
+	if found.ID() != run.ID() || found.Stage() != 1 || found.HP() != 80 || !found.UpdatedAt().Equal(startedAt.Add(time.Minute)) {
+		t.Errorf("found = %+v, want the run after its first stage", found)
+	}
+
+	// Finished runs are no longer in progress
+	if err := run.Abandon(startedAt.Add(2 * time.Minute)); err != nil {
+		t.Fatalf("Abandon() error = %v, want nil", err)
+	}
+	if err := runRepo.Update(ctx, run); err != nil {
+		t.Fatalf("Update() error = %v, want nil", err)
+	}
+	if found, err := runRepo.FindInProgressByCharacterID(ctx, character.ID()); err != nil || found != nil {
+		t.Errorf("FindInProgressByCharacterID() = %v, %v, want nil, nil after abandoning", found, err)
+	}
+}
@@ -0,0 +1,217 @@
+package persistence
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// uniqueViolation is the SQLSTATE Postgres reports when an insert breaks a unique index
+const uniqueViolation = "23505"
+
+// focusSessionColumns lists the columns selected for every focus session query (prefixed for joins)
+const focusSessionColumns = `fs.id, fs.character_id, fs.habit_id, fs.task_id, fs.started_at, fs.paused_at, fs.paused_seconds, fs.ended_at, fs.xp_awarded`
+
+// PostgresFocusSessionRepository implements the FocusSessionRepository interface
+type PostgresFocusSessionRepository struct {
+	db *PostgresDB
+}
+
+// NewPostgresFocusSessionRepository creates a new PostgresFocusSessionRepository
+func NewPostgresFocusSessionRepository(db *PostgresDB) *PostgresFocusSessionRepository {
+	return &PostgresFocusSessionRepository{
+		db: db,
+	}
+}
+
+// Create persists a new focus session
+// The unique index on open sessions rejects a second open session of the same character
+func (r *PostgresFocusSessionRepository) Create(ctx context.Context, session *entity.FocusSession) error {
+	query := `
+		INSERT INTO focus_sessions (id, character_id, habit_id, task_id, started_at, paused_at, paused_seconds, ended_at, xp_awarded)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err := r.db.conn(ctx).Exec(ctx, query,
+		session.ID(),
+		session.CharacterID(),
+		nullableFocusTarget(session.HabitID()),
+		nullableFocusTarget(session.TaskID()),
+		session.StartedAt(),
+		session.PausedAt(),
+		session.PausedSeconds(),
+		session.EndedAt(),
+		session.XpAwarded(),
+	)
+
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == uniqueViolation && pgErr.ConstraintName == "idx_focus_sessions_open" {
+			return repository.ErrFocusSessionOpen
+		}
+		return fmt.Errorf("failed to create focus session: %w", err)
+	}
+
+	return nil
+}
+
+// FindByIDAndUserID retrieves a focus session by ID and validates ownership (through its character)
+// Returns error if the session doesn't exist OR doesn't belong to the user
+func (r *PostgresFocusSessionRepository) FindByIDAndUserID(ctx context.Context, id string, userID string) (*entity.FocusSession, error) {
+	query := `
+		SELECT ` + focusSessionColumns + `
+		FROM focus_sessions fs
+		INNER JOIN characters c ON c.id = fs.character_id
+		WHERE fs.id = $1 AND c.user_id = $2
+	`
+
+	session, err := scanFocusSession(r.db.conn(ctx).QueryRow(ctx, query, id, userID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("focus session not found or does not belong to user")
+		}
+		return nil, fmt.Errorf("failed to find focus session: %w", err)
+	}
+
+	return session, nil
+}
+
+// FindOpenByUserID retrieves the running or paused session of any character owned by a user
+// Returns nil (without error) when the user has no open session
+func (r *PostgresFocusSessionRepository) FindOpenByUserID(ctx context.Context, userID string) (*entity.FocusSession, error) {
+	query := `
+		SELECT ` + focusSessionColumns + `
+		FROM focus_sessions fs
+		INNER JOIN characters c ON c.id = fs.character_id
+		WHERE c.user_id = $1 AND fs.ended_at IS NULL
+		ORDER BY fs.started_at DESC
+		LIMIT 1
+	`
+
+	session, err := scanFocusSession(r.db.conn(ctx).QueryRow(ctx, query, userID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find open focus session: %w", err)
+	}
+
+	return session, nil
+}
+
+// FindByUserIDBetween retrieves the sessions of all characters owned by a user
+// started in the [from, to) interval (oldest first)
+func (r *PostgresFocusSessionRepository) FindByUserIDBetween(ctx context.Context, userID string, from time.Time, to time.Time) ([]*entity.FocusSession, error) {
+	query := `
+		SELECT ` + focusSessionColumns + `
+		FROM focus_sessions fs
+		INNER JOIN characters c ON c.id = fs.character_id
+		WHERE c.user_id = $1 AND fs.started_at >= $2 AND fs.started_at < $3
+		ORDER BY fs.started_at ASC
+	`
+
+	rows, err := r.db.conn(ctx).Query(ctx, query, userID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find focus sessions: %w", err)
+	}
+	defer rows.Close()
+
+	var sessions []*entity.FocusSession
+
+	for rows.Next() {
+		session, err := scanFocusSession(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan focus session: %w", err)
+		}
+		sessions = append(sessions, session)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating focus sessions: %w", err)
+	}
+
+	return sessions, nil
+}
+
+// Update saves the pause, resume or stop of an open focus session
+// Only open rows are updated, so two concurrent stops can't both succeed (and award XP twice)
+func (r *PostgresFocusSessionRepository) Update(ctx context.Context, session *entity.FocusSession) error {
+	query := `
+		UPDATE focus_sessions
+		SET paused_at = $2, paused_seconds = $3, ended_at = $4, xp_awarded = $5
+		WHERE id = $1 AND ended_at IS NULL
+	`
+
+	result, err := r.db.conn(ctx).Exec(ctx, query,
+		session.ID(),
+		session.PausedAt(),
+		session.PausedSeconds(),
+		session.EndedAt(),
+		session.XpAwarded(),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update focus session: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("focus session not found or already ended")
+	}
+
+	return nil
+}
+
+// nullableFocusTarget returns the nullable habit or task column of a session (nil for the one it isn't tracked against)
+func nullableFocusTarget(id string) *string {
+	if id == "" {
+		return nil
+	}
+	return &id
+}
+
+// scanFocusSession scans a single row into a FocusSession entity
+func scanFocusSession(row pgx.Row) (*entity.FocusSession, error) {
+	var (
+		id            string
+		characterID   string
+		habitID       *string
+		taskID        *string
+		startedAt     time.Time
+		pausedAt      *time.Time
+		pausedSeconds int
+		endedAt       *time.Time
+		xpAwarded     int
+	)
+
+	err := row.Scan(
+		&id,
+		&characterID,
+		&habitID,
+		&taskID,
+		&startedAt,
+		&pausedAt,
+		&pausedSeconds,
+		&endedAt,
+		&xpAwarded,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Only one of the target columns is set
+	var habit, task string
+	if habitID != nil {
+		habit = *habitID
+	}
+	if taskID != nil {
+		task = *taskID
+	}
+
+	return entity.ReconstituteFocusSession(id, characterID, habit, task, startedAt, pausedAt, pausedSeconds, endedAt, xpAwarded), nil
+}
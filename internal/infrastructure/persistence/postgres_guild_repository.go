@@ -0,0 +1,140 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/jackc/pgx/v5"
+)
+
+// guildColumns lists the columns selected for every guild query
+const guildColumns = `g.id, g.name, g.leader_character_id, g.created_at`
+
+// PostgresGuildRepository implements the GuildRepository interface
+type PostgresGuildRepository struct {
+	db *PostgresDB
+}
+
+// NewPostgresGuildRepository creates a new PostgresGuildRepository
+func NewPostgresGuildRepository(db *PostgresDB) *PostgresGuildRepository {
+	return &PostgresGuildRepository{
+		db: db,
+	}
+}
+
+// Create persists a new guild with its leader as the first member
+// The primary key of guild_members rejects a leader that already belongs to a guild
+func (r *PostgresGuildRepository) Create(ctx context.Context, guild *entity.Guild) error {
+	tx, err := r.db.begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO guilds (id, name, leader_character_id, created_at)
+		VALUES ($1, $2, $3, $4)
+	`, guild.ID(), guild.Name(), guild.LeaderCharacterID(), guild.CreatedAt())
+	if err != nil {
+		return fmt.Errorf("failed to create guild: %w", err)
+	}
+
+	_, err = tx.Exec(ctx, `
+		INSERT INTO guild_members (character_id, guild_id, joined_at)
+		VALUES ($1, $2, $3)
+	`, guild.LeaderCharacterID(), guild.ID(), guild.CreatedAt())
+	if err != nil {
+		return fmt.Errorf("failed to add guild leader: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit guild creation: %w", err)
+	}
+
+	return nil
+}
+
+// FindByID retrieves a guild by its ID
+func (r *PostgresGuildRepository) FindByID(ctx context.Context, id string) (*entity.Guild, error) {
+	query := `
+		SELECT ` + guildColumns + `
+		FROM guilds g
+		WHERE g.id = $1
+	`
+
+	guild, err := scanGuild(r.db.conn(ctx).QueryRow(ctx, query, id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("guild not found")
+		}
+		return nil, fmt.Errorf("failed to find guild: %w", err)
+	}
+
+	return guild, nil
+}
+
+// FindByCharacterID retrieves the guild a character belongs to
+// Returns nil (without error) when the character isn't in a guild
+func (r *PostgresGuildRepository) FindByCharacterID(ctx context.Context, characterID string) (*entity.Guild, error) {
+	query := `
+		SELECT ` + guildColumns + `
+		FROM guilds g
+		INNER JOIN guild_members m ON m.guild_id = g.id
+		WHERE m.character_id = $1
+	`
+
+	guild, err := scanGuild(r.db.conn(ctx).QueryRow(ctx, query, characterID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find guild of character: %w", err)
+	}
+
+	return guild, nil
+}
+
+// AddMember adds a character to a guild
+// The primary key of guild_members rejects a character that already belongs to a guild
+func (r *PostgresGuildRepository) AddMember(ctx context.Context, guildID string, characterID string, joinedAt time.Time) error {
+	query := `
+		INSERT INTO guild_members (character_id, guild_id, joined_at)
+		VALUES ($1, $2, $3)
+	`
+
+	if _, err := r.db.conn(ctx).Exec(ctx, query, characterID, guildID, joinedAt); err != nil {
+		return fmt.Errorf("failed to add guild member: %w", err)
+	}
+
+	return nil
+}
+
+// CountMembers counts the members of a guild (its leader included)
+func (r *PostgresGuildRepository) CountMembers(ctx context.Context, guildID string) (int, error) {
+	query := `SELECT COUNT(*) FROM guild_members WHERE guild_id = $1`
+
+	var count int
+	if err := r.db.conn(ctx).QueryRow(ctx, query, guildID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count guild members: %w", err)
+	}
+
+	return count, nil
+}
+
+// scanGuild scans a single row into a Guild entity
+func scanGuild(row pgx.Row) (*entity.Guild, error) {
+	var (
+		id                string
+		name              string
+		leaderCharacterID string
+		createdAt         time.Time
+	)
+
+	if err := row.Scan(&id, &name, &leaderCharacterID, &createdAt); err != nil {
+		return nil, err
+	}
+
+	return entity.ReconstituteGuild(id, name, leaderCharacterID, createdAt), nil
+}
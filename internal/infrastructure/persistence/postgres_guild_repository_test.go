@@ -0,0 +1,54 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/infrastructure/persistence"
+)
+
+func TestPostgresGuildRepository_CreateAndFind(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	userRepo := persistence.NewPostgresUserRepository(db)
+	charRepo := persistence.NewPostgresCharacterRepository(db)
+	guildRepo := persistence.NewPostgresGuildRepository(db)
+
+	character := createTestCharacter(t, userRepo, charRepo)
+
+	// Characters start without a guild
+	if guild, err := guildRepo.FindByCharacterID(ctx, character.ID()); err != nil || guild != nil {
+		t.Fatalf("FindByCharacterID() = %v, %v, want nil, nil", guild, err)
+	}
+
+	createdAt := time.Now().UTC().Truncate(time.Microsecond)
+	guild, err := entity.NewGuild("test-guild-id", "Cavaleiros do Hábito", character.ID(), createdAt)
+	if err != nil {
+		t.Fatalf("Failed to create guild entity: %v", err)
+	}
+	if err := guildRepo.Create(ctx, guild); err != nil {
+		t.Fatalf("Create() error = %v, want nil", err)
+	}
+
+	// The leader is the first member
+	found, err := guildRepo.FindByCharacterID(ctx, character.ID())
+	if err != nil || found == nil || found.ID() != guild.ID() || found.Name() != guild.Name() || !found.CreatedAt().Equal(createdAt) {
+		t.Fatalf("FindByCharacterID() = %+v, %v, want the new guild", found, err)
+	}
+	if count, err := guildRepo.CountMembers(ctx, guild.ID()); err != nil || count != 1 {
+		t.Errorf("CountMembers() = %d, %v, want 1", count, err)
+	}
+
+	// A character belongs to one guild at a time
+	if err := guildRepo.AddMember(ctx, guild.ID(), character.ID(), createdAt); err == nil {
+		t.Error("AddMember() error = nil, want error for a character already in a guild")
+	}
+
+	if _, err := guildRepo.FindByID(ctx, "missing-guild"); err == nil {
+		t.Error("FindByID() error = nil, want error for a missing guild")
+	}
+}
@@ -0,0 +1,188 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/jackc/pgx/v5"
+)
+
+// habitCompletionColumns lists the columns selected for every habit completion query (prefixed for joins)
+const habitCompletionColumns = `hc.id, hc.habit_id, hc.character_id, hc.xp_gained, hc.levels_gained, hc.attribute_name, hc.attribute_gain, hc.energy_restored, hc.completed_at`
+
+// PostgresHabitCompletionRepository implements the HabitCompletionRepository interface
+type PostgresHabitCompletionRepository struct {
+	db *PostgresDB
+}
+
+// NewPostgresHabitCompletionRepository creates a new PostgresHabitCompletionRepository
+func NewPostgresHabitCompletionRepository(db *PostgresDB) *PostgresHabitCompletionRepository {
+	return &PostgresHabitCompletionRepository{
+		db: db,
+	}
+}
+
+// Create persists a new habit completion
+func (r *PostgresHabitCompletionRepository) Create(ctx context.Context, completion *entity.HabitCompletion) error {
+	query := `
+		INSERT INTO habit_completions (id, habit_id, character_id, xp_gained, levels_gained, attribute_name, attribute_gain, energy_restored, completed_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err := r.db.conn(ctx).Exec(ctx, query,
+		completion.ID(),
+		completion.HabitID(),
+		completion.CharacterID(),
+		completion.XpGained(),
+		completion.LevelsGained(),
+		completion.AttributeName(),
+		completion.AttributeGain(),
+		completion.EnergyRestored(),
+		completion.CompletedAt(),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create habit completion: %w", err)
+	}
+
+	return nil
+}
+
+// FindByID retrieves a habit completion by its ID
+func (r *PostgresHabitCompletionRepository) FindByID(ctx context.Context, id string) (*entity.HabitCompletion, error) {
+	query := `
+		SELECT ` + habitCompletionColumns + `
+		FROM habit_completions hc
+		WHERE hc.id = $1
+	`
+
+	completion, err := scanHabitCompletion(r.db.conn(ctx).QueryRow(ctx, query, id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("habit completion not found")
+		}
+		return nil, fmt.Errorf("failed to find habit completion: %w", err)
+	}
+
+	return completion, nil
+}
+
+// FindByHabitID retrieves all completions of a habit (most recent first)
+func (r *PostgresHabitCompletionRepository) FindByHabitID(ctx context.Context, habitID string) ([]*entity.HabitCompletion, error) {
+	query := `
+		SELECT ` + habitCompletionColumns + `
+		FROM habit_completions hc
+		WHERE hc.habit_id = $1
+		ORDER BY hc.completed_at DESC
+	`
+
+	rows, err := r.db.conn(ctx).Query(ctx, query, habitID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find habit completions: %w", err)
+	}
+
+	return collectHabitCompletions(rows)
+}
+
+// FindByUserIDBetween retrieves the completions of all habits owned by a user
+// completed in the [from, to) interval (oldest first)
+func (r *PostgresHabitCompletionRepository) FindByUserIDBetween(ctx context.Context, userID string, from time.Time, to time.Time) ([]*entity.HabitCompletion, error) {
+	query := `
+		SELECT ` + habitCompletionColumns + `
+		FROM habit_completions hc
+		INNER JOIN characters c ON c.id = hc.character_id
+		WHERE c.user_id = $1 AND hc.completed_at >= $2 AND hc.completed_at < $3
+		ORDER BY hc.completed_at ASC
+	`
+
+	rows, err := r.db.conn(ctx).Query(ctx, query, userID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find habit completions: %w", err)
+	}
+
+	return collectHabitCompletions(rows)
+}
+
+// Delete removes a habit completion
+func (r *PostgresHabitCompletionRepository) Delete(ctx context.Context, id string) error {
+	query := `DELETE FROM habit_completions WHERE id = $1`
+
+	result, err := r.db.conn(ctx).Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete habit completion: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("habit completion not found")
+	}
+
+	return nil
+}
+
+// collectHabitCompletions scans all rows into entities and closes them
+func collectHabitCompletions(rows pgx.Rows) ([]*entity.HabitCompletion, error) {
+	defer rows.Close()
+
+	var completions []*entity.HabitCompletion
+
+	for rows.Next() {
+		completion, err := scanHabitCompletion(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan habit completion: %w", err)
+		}
+
+		completions = append(completions, completion)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating habit completions: %w", err)
+	}
+
+	return completions, nil
+}
+
+// scanHabitCompletion scans a single habit completion row into an entity
+func scanHabitCompletion(row pgx.Row) (*entity.HabitCompletion, error) {
+	var (
+		id             string
+		habitID        string
+		characterID    string
+		xpGained       int
+		levelsGained   int
+		attributeName  string
+		attributeGain  int
+		energyRestored int
+		completedAt    time.Time
+	)
+
+	err := row.Scan(
+		&id,
+		&habitID,
+		&characterID,
+		&xpGained,
+		&levelsGained,
+		&attributeName,
+		&attributeGain,
+		&energyRestored,
+		&completedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	completion := entity.ReconstituteHabitCompletion(
+		id,
+		habitID,
+		characterID,
+		xpGained,
+		levelsGained,
+		attributeName,
+		attributeGain,
+		energyRestored,
+		completedAt,
+	)
+
+	return completion, nil
+}
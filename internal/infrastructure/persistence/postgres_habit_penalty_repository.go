@@ -0,0 +1,66 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+)
+
+// PostgresHabitPenaltyRepository implements the HabitPenaltyRepository interface
+type PostgresHabitPenaltyRepository struct {
+	db *PostgresDB
+}
+
+// NewPostgresHabitPenaltyRepository creates a new PostgresHabitPenaltyRepository
+func NewPostgresHabitPenaltyRepository(db *PostgresDB) *PostgresHabitPenaltyRepository {
+	return &PostgresHabitPenaltyRepository{
+		db: db,
+	}
+}
+
+// Create persists a new habit penalty
+// Returns error if the habit was already penalized on the same day (uq_penalty_habit_day)
+func (r *PostgresHabitPenaltyRepository) Create(ctx context.Context, penalty *entity.HabitPenalty) error {
+	query := `
+		INSERT INTO habit_penalties (id, habit_id, character_id, day, xp_lost, levels_lost, attribute_name, attribute_loss, applied_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
+	`
+
+	_, err := r.db.conn(ctx).Exec(ctx, query,
+		penalty.ID(),
+		penalty.HabitID(),
+		penalty.CharacterID(),
+		penalty.Day(),
+		penalty.XpLost(),
+		penalty.LevelsLost(),
+		penalty.AttributeName(),
+		penalty.AttributeLoss(),
+		penalty.AppliedAt(),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create habit penalty: %w", err)
+	}
+
+	return nil
+}
+
+// ExistsByHabitIDAndDay checks if a habit was already penalized on a day
+func (r *PostgresHabitPenaltyRepository) ExistsByHabitIDAndDay(ctx context.Context, habitID string, day time.Time) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM habit_penalties WHERE habit_id = $1 AND day = $2)`
+
+	var exists bool
+	err := r.db.conn(ctx).QueryRow(ctx, query, habitID, calendarDate(day)).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check if habit penalty exists: %w", err)
+	}
+
+	return exists, nil
+}
+
+// calendarDate returns the calendar date of t at UTC midnight (for DATE columns)
+func calendarDate(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
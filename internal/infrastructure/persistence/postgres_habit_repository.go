@@ -0,0 +1,275 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+	"github.com/jackc/pgx/v5"
+)
+
+// habitColumns lists the columns selected for every habit query (prefixed for joins)
+const habitColumns = `h.id, h.title, h.description, h.character_id, h.attribute_name, h.difficulty, h.recurrence, h.negative, h.drains_xp, h.active, h.created_at, h.updated_at`
+
+// PostgresHabitRepository implements the HabitRepository interface
+type PostgresHabitRepository struct {
+	db *PostgresDB
+}
+
+// NewPostgresHabitRepository creates a new PostgresHabitRepository
+func NewPostgresHabitRepository(db *PostgresDB) *PostgresHabitRepository {
+	return &PostgresHabitRepository{
+		db: db,
+	}
+}
+
+// Create persists a new habit
+func (r *PostgresHabitRepository) Create(ctx context.Context, habit *entity.Habit) error {
+	query := `
+		INSERT INTO habits (id, title, description, character_id, attribute_name, difficulty, recurrence, negative, drains_xp, active, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+
+	_, err := r.db.conn(ctx).Exec(ctx, query,
+		habit.ID(),
+		habit.Title(),
+		habit.Description(),
+		habit.CharacterID(),
+		habit.AttributeName(),
+		habit.Difficulty().Value(),
+		habit.Recurrence().Rule(),
+		habit.IsNegative(),
+		habit.DrainsXp(),
+		habit.Active(),
+		habit.CreatedAt(),
+		habit.UpdatedAt(),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create habit: %w", err)
+	}
+
+	return nil
+}
+
+// FindByID retrieves a habit by its ID
+func (r *PostgresHabitRepository) FindByID(ctx context.Context, id string) (*entity.Habit, error) {
+	query := `
+		SELECT ` + habitColumns + `
+		FROM habits h
+		WHERE h.id = $1
+	`
+
+	habit, err := scanHabit(r.db.conn(ctx).QueryRow(ctx, query, id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("habit not found")
+		}
+		return nil, fmt.Errorf("failed to find habit: %w", err)
+	}
+
+	return habit, nil
+}
+
+// FindByIDAndUserID retrieves a habit by ID and validates ownership (through its character)
+// Returns error if habit doesn't exist OR doesn't belong to the user
+func (r *PostgresHabitRepository) FindByIDAndUserID(ctx context.Context, id string, userID string) (*entity.Habit, error) {
+	query := `
+		SELECT ` + habitColumns + `
+		FROM habits h
+		INNER JOIN characters c ON c.id = h.character_id
+		WHERE h.id = $1 AND c.user_id = $2
+	`
+
+	habit, err := scanHabit(r.db.conn(ctx).QueryRow(ctx, query, id, userID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("habit not found or does not belong to user")
+		}
+		return nil, fmt.Errorf("failed to find habit: %w", err)
+	}
+
+	return habit, nil
+}
+
+// FindAllByUserID retrieves all habits of all characters owned by a user
+func (r *PostgresHabitRepository) FindAllByUserID(ctx context.Context, userID string) ([]*entity.Habit, error) {
+	query := `
+		SELECT ` + habitColumns + `
+		FROM habits h
+		INNER JOIN characters c ON c.id = h.character_id
+		WHERE c.user_id = $1
+		ORDER BY h.created_at ASC
+	`
+
+	rows, err := r.db.conn(ctx).Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find habits: %w", err)
+	}
+	defer rows.Close()
+
+	var habits []*entity.Habit
+
+	for rows.Next() {
+		habit, err := scanHabit(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan habit: %w", err)
+		}
+
+		habits = append(habits, habit)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating habits: %w", err)
+	}
+
+	return habits, nil
+}
+
+// FindUserIDsWithActiveHabits retrieves the IDs of the users owning at least one active habit
+func (r *PostgresHabitRepository) FindUserIDsWithActiveHabits(ctx context.Context) ([]string, error) {
+	query := `
+		SELECT DISTINCT c.user_id
+		FROM habits h
+		INNER JOIN characters c ON c.id = h.character_id
+		WHERE h.active = true
+		ORDER BY c.user_id
+	`
+
+	rows, err := r.db.conn(ctx).Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find users with active habits: %w", err)
+	}
+	defer rows.Close()
+
+	var userIDs []string
+
+	for rows.Next() {
+		var userID string
+		if err := rows.Scan(&userID); err != nil {
+			return nil, fmt.Errorf("failed to scan user id: %w", err)
+		}
+
+		userIDs = append(userIDs, userID)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating user ids: %w", err)
+	}
+
+	return userIDs, nil
+}
+
+// Update updates an existing habit
+func (r *PostgresHabitRepository) Update(ctx context.Context, habit *entity.Habit) error {
+	query := `
+		UPDATE habits
+		SET title = $2, description = $3, attribute_name = $4, difficulty = $5, recurrence = $6,
+			negative = $7, drains_xp = $8, active = $9, updated_at = $10
+		WHERE id = $1
+	`
+
+	result, err := r.db.conn(ctx).Exec(ctx, query,
+		habit.ID(),
+		habit.Title(),
+		habit.Description(),
+		habit.AttributeName(),
+		habit.Difficulty().Value(),
+		habit.Recurrence().Rule(),
+		habit.IsNegative(),
+		habit.DrainsXp(),
+		habit.Active(),
+		habit.UpdatedAt(),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update habit: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("habit not found")
+	}
+
+	return nil
+}
+
+// Delete removes a habit
+func (r *PostgresHabitRepository) Delete(ctx context.Context, id string) error {
+	query := `DELETE FROM habits WHERE id = $1`
+
+	result, err := r.db.conn(ctx).Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete habit: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("habit not found")
+	}
+
+	return nil
+}
+
+// scanHabit scans a single habit row (selected with habitColumns) into an entity
+func scanHabit(row pgx.Row) (*entity.Habit, error) {
+	var (
+		id            string
+		title         string
+		description   string
+		characterID   string
+		attributeName string
+		difficultyStr string
+		rule          string
+		negative      bool
+		drainsXp      bool
+		active        bool
+		createdAt     time.Time
+		updatedAt     time.Time
+	)
+
+	err := row.Scan(
+		&id,
+		&title,
+		&description,
+		&characterID,
+		&attributeName,
+		&difficultyStr,
+		&rule,
+		&negative,
+		&drainsXp,
+		&active,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	difficulty, err := valueobject.NewDifficulty(difficultyStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid difficulty in database: %w", err)
+	}
+
+	recurrence, err := valueobject.ParseRecurrence(rule)
+	if err != nil {
+		return nil, fmt.Errorf("invalid recurrence in database: %w", err)
+	}
+
+	habit := entity.ReconstituteHabit(
+		id,
+		title,
+		description,
+		characterID,
+		attributeName,
+		difficulty,
+		recurrence,
+		negative,
+		drainsXp,
+		active,
+		createdAt,
+		updatedAt,
+	)
+
+	return habit, nil
+}
@@ -0,0 +1,181 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+	"github.com/igor/chronotask-api/internal/infrastructure/persistence"
+)
+
+// createTestHabit creates a character with a "Força" attribute and a habit linked to it
+func createTestHabit(
+	t *testing.T,
+	userRepo repository.UserRepository,
+	charRepo repository.CharacterRepository,
+	attrRepo repository.CharacterAttributeRepository,
+	habitRepo repository.HabitRepository,
+) (*entity.Character, *entity.Habit) {
+	t.Helper()
+
+	character := createTestCharacter(t, userRepo, charRepo)
+
+	attribute, _ := entity.NewCharacterAttribute("Força", 5, character.ID())
+	if err := attrRepo.Create(context.Background(), attribute); err != nil {
+		t.Fatalf("Failed to save test attribute: %v", err)
+	}
+
+	difficulty, _ := valueobject.NewDifficulty("medium")
+	recurrence, _ := valueobject.NewRecurrence("weekly", []string{"mon", "wed", "fri"}, 0, 0, "")
+	habit, err := entity.NewHabit("test-habit-id", "Push-ups", "3 sets of 20", character.ID(), "Força", difficulty, recurrence)
+	if err != nil {
+		t.Fatalf("Failed to create test habit entity: %v", err)
+	}
+
+	if err := habitRepo.Create(context.Background(), habit); err != nil {
+		t.Fatalf("Failed to save test habit: %v", err)
+	}
+
+	return character, habit
+}
+
+func TestPostgresHabitRepository_CreateAndFindByID(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	userRepo := persistence.NewPostgresUserRepository(db)
+	charRepo := persistence.NewPostgresCharacterRepository(db)
+	attrRepo := persistence.NewPostgresCharacterAttributeRepository(db)
+	habitRepo := persistence.NewPostgresHabitRepository(db)
+
+	_, habit := createTestHabit(t, userRepo, charRepo, attrRepo, habitRepo)
+
+	found, err := habitRepo.FindByID(context.Background(), habit.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v, want nil", err)
+	}
+
+	if found.Title() != habit.Title() {
+		t.Errorf("found.Title() = %v, want %v", found.Title(), habit.Title())
+	}
+
+	if !found.Recurrence().Equals(habit.Recurrence()) {
+		t.Errorf("found.Recurrence() = %v, want %v", found.Recurrence(), habit.Recurrence())
+	}
+
+	if !found.Difficulty().Equals(habit.Difficulty()) {
+		t.Errorf("found.Difficulty() = %v, want %v", found.Difficulty(), habit.Difficulty())
+	}
+
+	if !found.Active() {
+		t.Error("found.Active() = false, want true")
+	}
+}
+
+func TestPostgresHabitRepository_FindByIDAndUserID_WrongUser(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	userRepo := persistence.NewPostgresUserRepository(db)
+	charRepo := persistence.NewPostgresCharacterRepository(db)
+	attrRepo := persistence.NewPostgresCharacterAttributeRepository(db)
+	habitRepo := persistence.NewPostgresHabitRepository(db)
+
+	_, habit := createTestHabit(t, userRepo, charRepo, attrRepo, habitRepo)
+
+	_, err := habitRepo.FindByIDAndUserID(context.Background(), habit.ID(), "another-user")
+	if err == nil {
+		t.Error("FindByIDAndUserID() error = nil, want error for habit of another user")
+	}
+}
+
+func TestPostgresHabitRepository_FindAllByUserID(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	userRepo := persistence.NewPostgresUserRepository(db)
+	charRepo := persistence.NewPostgresCharacterRepository(db)
+	attrRepo := persistence.NewPostgresCharacterAttributeRepository(db)
+	habitRepo := persistence.NewPostgresHabitRepository(db)
+
+	character, _ := createTestHabit(t, userRepo, charRepo, attrRepo, habitRepo)
+
+	habits, err := habitRepo.FindAllByUserID(context.Background(), character.UserID())
+	if err != nil {
+		t.Fatalf("FindAllByUserID() error = %v, want nil", err)
+	}
+
+	if len(habits) != 1 {
+		t.Errorf("len(habits) = %v, want %v", len(habits), 1)
+	}
+}
+
+func TestPostgresHabitRepository_Update(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	userRepo := persistence.NewPostgresUserRepository(db)
+	charRepo := persistence.NewPostgresCharacterRepository(db)
+	attrRepo := persistence.NewPostgresCharacterAttributeRepository(db)
+	habitRepo := persistence.NewPostgresHabitRepository(db)
+
+	_, habit := createTestHabit(t, userRepo, charRepo, attrRepo, habitRepo)
+
+	habit.UpdateTitle("Pull-ups")
+	habit.Deactivate()
+
+	if err := habitRepo.Update(context.Background(), habit); err != nil {
+		t.Fatalf("Update() error = %v, want nil", err)
+	}
+
+	found, _ := habitRepo.FindByID(context.Background(), habit.ID())
+
+	if found.Title() != "Pull-ups" {
+		t.Errorf("found.Title() = %v, want %v", found.Title(), "Pull-ups")
+	}
+
+	if found.Active() {
+		t.Error("found.Active() = true, want false")
+	}
+}
+
+func TestPostgresHabitRepository_Delete(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	userRepo := persistence.NewPostgresUserRepository(db)
+	charRepo := persistence.NewPostgresCharacterRepository(db)
+	attrRepo := persistence.NewPostgresCharacterAttributeRepository(db)
+	habitRepo := persistence.NewPostgresHabitRepository(db)
+
+	_, habit := createTestHabit(t, userRepo, charRepo, attrRepo, habitRepo)
+
+	if err := habitRepo.Delete(context.Background(), habit.ID()); err != nil {
+		t.Fatalf("Delete() error = %v, want nil", err)
+	}
+
+	if _, err := habitRepo.FindByID(context.Background(), habit.ID()); err == nil {
+		t.Error("FindByID() after Delete() should return error")
+	}
+}
+
+func TestPostgresHabitRepository_AttributeForeignKeyConstraint(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	userRepo := persistence.NewPostgresUserRepository(db)
+	charRepo := persistence.NewPostgresCharacterRepository(db)
+	habitRepo := persistence.NewPostgresHabitRepository(db)
+
+	character := createTestCharacter(t, userRepo, charRepo)
+
+	// Character has no "Força" attribute, so the composite foreign key must reject it
+	difficulty, _ := valueobject.NewDifficulty("easy")
+	habit, _ := entity.NewHabit("habit-fk", "Push-ups", "", character.ID(), "Força", difficulty, valueobject.NewDailyRecurrence())
+
+	if err := habitRepo.Create(context.Background(), habit); err == nil {
+		t.Error("Create() with unknown attribute should fail due to foreign key constraint")
+	}
+}
@@ -0,0 +1,154 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+	"github.com/jackc/pgx/v5"
+)
+
+// monsterColumns lists the columns selected for every monster query
+const monsterColumns = `id, name, min_level, max_level, strength, constitution, willpower, wisdom, intelligence, charisma, dexterity, loot, xp_reward`
+
+// lootDropRecord is the JSON representation of a loot drop in the loot column
+type lootDropRecord struct {
+	Item     string `json:"item"`
+	Chance   int    `json:"chance"`
+	Quantity int    `json:"quantity"`
+}
+
+// PostgresMonsterRepository implements the MonsterRepository interface
+type PostgresMonsterRepository struct {
+	db *PostgresDB
+}
+
+// NewPostgresMonsterRepository creates a new PostgresMonsterRepository
+func NewPostgresMonsterRepository(db *PostgresDB) *PostgresMonsterRepository {
+	return &PostgresMonsterRepository{
+		db: db,
+	}
+}
+
+// FindByID retrieves a monster by its ID
+func (r *PostgresMonsterRepository) FindByID(ctx context.Context, id string) (*entity.Monster, error) {
+	query := `
+		SELECT ` + monsterColumns + `
+		FROM monsters
+		WHERE id = $1
+	`
+
+	monster, err := scanMonster(r.db.conn(ctx).QueryRow(ctx, query, id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("monster not found")
+		}
+		return nil, fmt.Errorf("failed to find monster: %w", err)
+	}
+
+	return monster, nil
+}
+
+// FindAll retrieves the whole catalog, ordered by minimum level and ID
+func (r *PostgresMonsterRepository) FindAll(ctx context.Context) ([]*entity.Monster, error) {
+	query := `
+		SELECT ` + monsterColumns + `
+		FROM monsters
+		ORDER BY min_level, id
+	`
+
+	return r.findMany(ctx, query)
+}
+
+// FindByLevel retrieves the monsters whose level range includes the level, ordered by minimum level and ID
+func (r *PostgresMonsterRepository) FindByLevel(ctx context.Context, level int) ([]*entity.Monster, error) {
+	query := `
+		SELECT ` + monsterColumns + `
+		FROM monsters
+		WHERE min_level <= $1 AND max_level >= $1
+		ORDER BY min_level, id
+	`
+
+	return r.findMany(ctx, query, level)
+}
+
+// findMany runs a monster query and scans every row
+func (r *PostgresMonsterRepository) findMany(ctx context.Context, query string, args ...any) ([]*entity.Monster, error) {
+	rows, err := r.db.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find monsters: %w", err)
+	}
+	defer rows.Close()
+
+	var monsters []*entity.Monster
+
+	for rows.Next() {
+		monster, err := scanMonster(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan monster: %w", err)
+		}
+		monsters = append(monsters, monster)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating monsters: %w", err)
+	}
+
+	return monsters, nil
+}
+
+// scanMonster scans a single row into a Monster entity
+func scanMonster(row pgx.Row) (*entity.Monster, error) {
+	var (
+		id           string
+		name         string
+		minLevel     int
+		maxLevel     int
+		strength     int
+		constitution int
+		willpower    int
+		wisdom       int
+		intelligence int
+		charisma     int
+		dexterity    int
+		lootJSON     []byte
+		xpReward     int
+	)
+
+	err := row.Scan(
+		&id,
+		&name,
+		&minLevel,
+		&maxLevel,
+		&strength,
+		&constitution,
+		&willpower,
+		&wisdom,
+		&intelligence,
+		&charisma,
+		&dexterity,
+		&lootJSON,
+		&xpReward,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	attributes := map[string]int{
+		valueobject.AttributeStrength:     strength,
+		valueobject.AttributeConstitution: constitution,
+		valueobject.AttributeWillpower:    willpower,
+		valueobject.AttributeWisdom:       wisdom,
+		valueobject.AttributeIntelligence: intelligence,
+		valueobject.AttributeCharisma:     charisma,
+		valueobject.AttributeDexterity:    dexterity,
+	}
+
+	loot, err := unmarshalLoot(lootJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.ReconstituteMonster(id, name, minLevel, maxLevel, attributes, loot, xpReward), nil
+}
@@ -0,0 +1,69 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+	"github.com/igor/chronotask-api/internal/infrastructure/persistence"
+)
+
+func TestPostgresMonsterRepository_FindByID(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	monsterRepo := persistence.NewPostgresMonsterRepository(db)
+
+	// The migrations seed the catalog
+	monster, err := monsterRepo.FindByID(context.Background(), "goblin")
+	if err != nil {
+		t.Fatalf("FindByID() error = %v, want nil", err)
+	}
+
+	if monster.Name() != "Goblin" || monster.MinLevel() != 2 || monster.MaxLevel() != 6 || monster.XpReward() != 25 {
+		t.Errorf("monster = (%v, %v-%v, %v), want (Goblin, 2-6, 25)", monster.Name(), monster.MinLevel(), monster.MaxLevel(), monster.XpReward())
+	}
+	if got := monster.Attributes()[valueobject.AttributeDexterity]; got != 6 {
+		t.Errorf("Destreza = %v, want 6", got)
+	}
+
+	loot := monster.Loot()
+	if len(loot) != 3 || loot[0].Item() != "Moeda de Cobre" || loot[0].Chance() != 100 || loot[0].Quantity() != 5 {
+		t.Errorf("Loot() = %+v, want 3 drops starting with 5 copper coins", loot)
+	}
+
+	if _, err := monsterRepo.FindByID(context.Background(), "dragao"); err == nil {
+		t.Error("FindByID() error = nil, want error for an unknown monster")
+	}
+}
+
+func TestPostgresMonsterRepository_FindByLevel(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	monsterRepo := persistence.NewPostgresMonsterRepository(db)
+
+	all, err := monsterRepo.FindAll(context.Background())
+	if err != nil {
+		t.Fatalf("FindAll() error = %v, want nil", err)
+	}
+	if len(all) < 6 || all[0].ID() != "rato-gigante" {
+		t.Fatalf("FindAll() = %d monsters, want the catalog starting with the giant rat", len(all))
+	}
+
+	monsters, err := monsterRepo.FindByLevel(context.Background(), 4)
+	if err != nil {
+		t.Fatalf("FindByLevel() error = %v, want nil", err)
+	}
+
+	var ids []string
+	for _, monster := range monsters {
+		if monster.MinLevel() > 4 || monster.MaxLevel() < 4 {
+			t.Errorf("monster %s (%d-%d) does not fight at level 4", monster.ID(), monster.MinLevel(), monster.MaxLevel())
+		}
+		ids = append(ids, monster.ID())
+	}
+	if len(ids) != 3 || ids[0] != "rato-gigante" || ids[1] != "goblin" || ids[2] != "lobo-cinzento" {
+		t.Errorf("FindByLevel(4) = %v, want [rato-gigante goblin lobo-cinzento]", ids)
+	}
+}
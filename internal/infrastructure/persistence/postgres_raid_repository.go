@@ -0,0 +1,294 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+	"github.com/jackc/pgx/v5"
+)
+
+// raidColumns lists the columns selected for every raid query
+const raidColumns = `id, guild_id, boss, max_hp, hp, reward_xp, status, started_at, ends_at, ended_at`
+
+// PostgresRaidRepository implements the RaidRepository interface
+type PostgresRaidRepository struct {
+	db *PostgresDB
+}
+
+// NewPostgresRaidRepository creates a new PostgresRaidRepository
+func NewPostgresRaidRepository(db *PostgresDB) *PostgresRaidRepository {
+	return &PostgresRaidRepository{
+		db: db,
+	}
+}
+
+// Create persists a new raid
+// The unique index on active raids rejects a second raid of the same guild
+func (r *PostgresRaidRepository) Create(ctx context.Context, raid *entity.Raid) error {
+	query := `
+		INSERT INTO raids (id, guild_id, boss, max_hp, hp, reward_xp, status, started_at, ends_at, ended_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+	`
+
+	_, err := r.db.conn(ctx).Exec(ctx, query,
+		raid.ID(),
+		raid.GuildID(),
+		raid.Boss().Value(),
+		raid.MaxHP(),
+		raid.HP(),
+		raid.RewardXp(),
+		raid.Status(),
+		raid.StartedAt(),
+		raid.EndsAt(),
+		raid.EndedAt(),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create raid: %w", err)
+	}
+
+	return nil
+}
+
+// Update saves the boss's HP and the status of a raid
+func (r *PostgresRaidRepository) Update(ctx context.Context, raid *entity.Raid) error {
+	query := `
+		UPDATE raids
+		SET hp = $2, status = $3, ended_at = $4
+		WHERE id = $1
+	`
+
+	result, err := r.db.conn(ctx).Exec(ctx, query, raid.ID(), raid.HP(), raid.Status(), raid.EndedAt())
+	if err != nil {
+		return fmt.Errorf("failed to update raid: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("raid not found")
+	}
+
+	return nil
+}
+
+// FindByIDForUpdate retrieves a raid by its ID and locks its row until the transaction ends
+func (r *PostgresRaidRepository) FindByIDForUpdate(ctx context.Context, id string) (*entity.Raid, error) {
+	query := `
+		SELECT ` + raidColumns + `
+		FROM raids
+		WHERE id = $1
+		FOR UPDATE
+	`
+
+	raid, err := r.findOne(ctx, query, id)
+	if err != nil {
+		return nil, err
+	}
+	if raid == nil {
+		return nil, fmt.Errorf("raid not found")
+	}
+
+	return raid, nil
+}
+
+// FindActiveByGuildIDForUpdate retrieves the active raid of a guild and locks its row until the transaction ends
+// Returns nil (without error) when the guild isn't raiding
+func (r *PostgresRaidRepository) FindActiveByGuildIDForUpdate(ctx context.Context, guildID string) (*entity.Raid, error) {
+	query := `
+		SELECT ` + raidColumns + `
+		FROM raids
+		WHERE guild_id = $1 AND status = 'active'
+		FOR UPDATE
+	`
+
+	return r.findOne(ctx, query, guildID)
+}
+
+// FindLatestByGuildID retrieves the most recently started raid of a guild, whatever its status
+// Returns nil (without error) when the guild never raided
+func (r *PostgresRaidRepository) FindLatestByGuildID(ctx context.Context, guildID string) (*entity.Raid, error) {
+	query := `
+		SELECT ` + raidColumns + `
+		FROM raids
+		WHERE guild_id = $1
+		ORDER BY started_at DESC
+		LIMIT 1
+	`
+
+	return r.findOne(ctx, query, guildID)
+}
+
+// findOne runs a query for a single raid, returning nil when there is none
+func (r *PostgresRaidRepository) findOne(ctx context.Context, query string, arg string) (*entity.Raid, error) {
+	raid, err := scanRaid(r.db.conn(ctx).QueryRow(ctx, query, arg))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find raid: %w", err)
+	}
+
+	return raid, nil
+}
+
+// CreateHit persists the damage a habit completion dealt to a raid
+func (r *PostgresRaidRepository) CreateHit(ctx context.Context, hit *entity.RaidHit) error {
+	query := `
+		INSERT INTO raid_hits (completion_id, raid_id, character_id, habit_id, hit_day, damage, hit_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	_, err := r.db.conn(ctx).Exec(ctx, query, hit.CompletionID(), hit.RaidID(), hit.CharacterID(), hit.HabitID(), calendarDate(hit.Day()), hit.Damage(), hit.HitAt())
+	if err != nil {
+		return fmt.Errorf("failed to create raid hit: %w", err)
+	}
+
+	return nil
+}
+
+// FindHitByCompletionID retrieves the hit of a habit completion
+// Returns nil (without error) when the completion didn't strike a raid
+func (r *PostgresRaidRepository) FindHitByCompletionID(ctx context.Context, completionID string) (*entity.RaidHit, error) {
+	query := `
+		SELECT completion_id, raid_id, character_id, COALESCE(habit_id, ''), hit_day, damage, hit_at
+		FROM raid_hits
+		WHERE completion_id = $1
+	`
+
+	var (
+		raidID      string
+		characterID string
+		habitID     string
+		day         *time.Time
+		damage      int
+		hitAt       time.Time
+	)
+
+	err := r.db.conn(ctx).QueryRow(ctx, query, completionID).Scan(&completionID, &raidID, &characterID, &habitID, &day, &damage, &hitAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find raid hit: %w", err)
+	}
+
+	var hitDay time.Time
+	if day != nil {
+		hitDay = *day
+	}
+
+	return entity.ReconstituteRaidHit(completionID, raidID, characterID, habitID, hitDay, damage, hitAt), nil
+}
+
+// ExistsHitByHabitOnDay reports whether a completion of the habit already struck the raid on day
+func (r *PostgresRaidRepository) ExistsHitByHabitOnDay(ctx context.Context, raidID string, habitID string, day time.Time) (bool, error) {
+	query := `SELECT EXISTS(SELECT 1 FROM raid_hits WHERE raid_id = $1 AND habit_id = $2 AND hit_day = $3)`
+
+	var exists bool
+	err := r.db.conn(ctx).QueryRow(ctx, query, raidID, habitID, calendarDate(day)).Scan(&exists)
+	if err != nil {
+		return false, fmt.Errorf("failed to check raid hits: %w", err)
+	}
+
+	return exists, nil
+}
+
+// DeleteHit removes the hit of a habit completion
+func (r *PostgresRaidRepository) DeleteHit(ctx context.Context, completionID string) error {
+	query := `DELETE FROM raid_hits WHERE completion_id = $1`
+
+	result, err := r.db.conn(ctx).Exec(ctx, query, completionID)
+	if err != nil {
+		return fmt.Errorf("failed to delete raid hit: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("raid hit not found")
+	}
+
+	return nil
+}
+
+// FindContributions sums the damage each character dealt to a raid (most damage first)
+func (r *PostgresRaidRepository) FindContributions(ctx context.Context, raidID string) ([]entity.RaidContribution, error) {
+	query := `
+		SELECT character_id, SUM(damage), COUNT(*)
+		FROM raid_hits
+		WHERE raid_id = $1
+		GROUP BY character_id
+		ORDER BY SUM(damage) DESC, character_id
+	`
+
+	rows, err := r.db.conn(ctx).Query(ctx, query, raidID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query raid contributions: %w", err)
+	}
+	defer rows.Close()
+
+	var contributions []entity.RaidContribution
+	for rows.Next() {
+		var contribution entity.RaidContribution
+		if err := rows.Scan(&contribution.CharacterID, &contribution.Damage, &contribution.Hits); err != nil {
+			return nil, fmt.Errorf("failed to scan raid contribution: %w", err)
+		}
+		contributions = append(contributions, contribution)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating raid contributions: %w", err)
+	}
+
+	return contributions, nil
+}
+
+// scanRaid scans a single row into a Raid entity
+func scanRaid(row pgx.Row) (*entity.Raid, error) {
+	var (
+		id        string
+		guildID   string
+		bossValue string
+		maxHP     int
+		hp        int
+		rewardXp  int
+		status    string
+		startedAt time.Time
+		endsAt    time.Time
+		endedAt   *time.Time
+	)
+
+	err := row.Scan(
+		&id,
+		&guildID,
+		&bossValue,
+		&maxHP,
+		&hp,
+		&rewardXp,
+		&status,
+		&startedAt,
+		&endsAt,
+		&endedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	boss, err := valueobject.NewRaidBoss(bossValue)
+	if err != nil {
+		return nil, fmt.Errorf("invalid raid boss in database: %w", err)
+	}
+
+	return entity.ReconstituteRaid(
+		id,
+		guildID,
+		boss,
+		maxHP,
+		hp,
+		rewardXp,
+		status,
+		startedAt,
+		endsAt,
+		endedAt,
+	), nil
+}
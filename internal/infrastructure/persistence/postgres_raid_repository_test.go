@@ -0,0 +1,121 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+	"github.com/igor/chronotask-api/internal/infrastructure/persistence"
+)
+
+func TestPostgresRaidRepository_StrikeAndContributions(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	ctx := context.Background()
+	userRepo := persistence.NewPostgresUserRepository(db)
+	charRepo := persistence.NewPostgresCharacterRepository(db)
+	guildRepo := persistence.NewPostgresGuildRepository(db)
+	raidRepo := persistence.NewPostgresRaidRepository(db)
+	unitOfWork := persistence.NewPostgresUnitOfWork(db)
+
+	character := createTestCharacter(t, userRepo, charRepo)
+	startedAt := time.Now().UTC().Truncate(time.Microsecond)
+	guild, _ := entity.NewGuild("test-guild-id", "Cavaleiros do Hábito", character.ID(), startedAt)
+	if err := guildRepo.Create(ctx, guild); err != nil {
+		t.Fatalf("Failed to save guild: %v", err)
+	}
+
+	// Guilds that never raided have no raid
+	if raid, err := raidRepo.FindLatestByGuildID(ctx, guild.ID()); err != nil || raid != nil {
+		t.Fatalf("FindLatestByGuildID() = %v, %v, want nil, nil", raid, err)
+	}
+
+	boss, _ := valueobject.NewRaidBoss(valueobject.RaidBossSwampHydra)
+	raid, err := entity.NewRaid("test-raid-id", guild.ID(), boss, 1, startedAt)
+	if err != nil {
+		t.Fatalf("Failed to create raid entity: %v", err)
+	}
+	if err := raidRepo.Create(ctx, raid); err != nil {
+		t.Fatalf("Create() error = %v, want nil", err)
+	}
+
+	// A guild fights one boss at a time
+	second, _ := entity.NewRaid("test-raid-id-2", guild.ID(), boss, 1, startedAt)
+	if err := raidRepo.Create(ctx, second); err == nil {
+		t.Error("Create() error = nil, want error for a second active raid")
+	}
+
+	// Two strikes by different habits, each saved with the raid locked
+	for i, habitID := range []string{"habit-1", "habit-2"} {
+		completionID := "completion-" + habitID
+		err := unitOfWork.Do(ctx, func(ctx context.Context) error {
+			locked, err := raidRepo.FindActiveByGuildIDForUpdate(ctx, guild.ID())
+			if err != nil || locked == nil {
+				t.Fatalf("FindActiveByGuildIDForUpdate() = %v, %v, want the raid", locked, err)
+			}
+			dealt, err := locked.Strike(40, startedAt.Add(time.Duration(i+1)*time.Minute))
+			if err != nil {
+				return err
+			}
+			hit, err := entity.NewRaidHit(completionID, locked.ID(), character.ID(), habitID, startedAt, dealt, startedAt.Add(time.Duration(i+1)*time.Minute))
+			if err != nil {
+				return err
+			}
+			if err := raidRepo.CreateHit(ctx, hit); err != nil {
+				return err
+			}
+			return raidRepo.Update(ctx, locked)
+		})
+		if err != nil {
+			t.Fatalf("strike %d error = %v, want nil", i+1, err)
+		}
+	}
+
+	found, err := raidRepo.FindLatestByGuildID(ctx, guild.ID())
+	if err != nil || found == nil || found.HP() != raid.MaxHP()-80 || found.Boss().Value() != boss.Value() || !found.EndsAt().Equal(raid.EndsAt()) {
+		t.Fatalf("FindLatestByGuildID() = %+v, %v, want the raid with 80 damage taken", found, err)
+	}
+
+	contributions, err := raidRepo.FindContributions(ctx, raid.ID())
+	if err != nil || len(contributions) != 1 || contributions[0].CharacterID != character.ID() || contributions[0].Damage != 80 || contributions[0].Hits != 2 {
+		t.Errorf("FindContributions() = %+v, %v, want 80 damage in 2 hits", contributions, err)
+	}
+
+	// Hits are found (and taken back) by completion
+	hit, err := raidRepo.FindHitByCompletionID(ctx, "completion-habit-2")
+	if err != nil || hit == nil || hit.Damage() != 40 || hit.RaidID() != raid.ID() {
+		t.Fatalf("FindHitByCompletionID() = %+v, %v, want the second hit", hit, err)
+	}
+
+	// A habit strikes once a day
+	if struck, err := raidRepo.ExistsHitByHabitOnDay(ctx, raid.ID(), "habit-2", startedAt); err != nil || !struck {
+		t.Errorf("ExistsHitByHabitOnDay(habit-2) = %v, %v, want true, nil", struck, err)
+	}
+	if struck, err := raidRepo.ExistsHitByHabitOnDay(ctx, raid.ID(), "habit-3", startedAt); err != nil || struck {
+		t.Errorf("ExistsHitByHabitOnDay(habit-3) = %v, %v, want false, nil", struck, err)
+	}
+
+	if err := raidRepo.DeleteHit(ctx, hit.CompletionID()); err != nil {
+		t.Fatalf("DeleteHit() error = %v, want nil", err)
+	}
+	if struck, err := raidRepo.ExistsHitByHabitOnDay(ctx, raid.ID(), "habit-2", startedAt); err != nil || struck {
+		t.Errorf("ExistsHitByHabitOnDay(habit-2) = %v, %v, want false, nil after deleting", struck, err)
+	}
+	if hit, err := raidRepo.FindHitByCompletionID(ctx, "completion-habit-2"); err != nil || hit != nil {
+		t.Errorf("FindHitByCompletionID() = %v, %v, want nil, nil after deleting", hit, err)
+	}
+
+	// Finished raids are no longer active
+	if err := found.Expire(); err != nil {
+		t.Fatalf("Expire() error = %v, want nil", err)
+	}
+	if err := raidRepo.Update(ctx, found); err != nil {
+		t.Fatalf("Update() error = %v, want nil", err)
+	}
+	if locked, err := raidRepo.FindActiveByGuildIDForUpdate(ctx, guild.ID()); err != nil || locked != nil {
+		t.Errorf("FindActiveByGuildIDForUpdate() = %v, %v, want nil, nil after expiring", locked, err)
+	}
+}
@@ -0,0 +1,237 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/jackc/pgx/v5"
+)
+
+// streakFreezeColumns lists the columns selected for every streak freeze query (prefixed for joins)
+const streakFreezeColumns = `sf.id, sf.character_id, sf.completion_id, sf.habit_id, sf.frozen_date, sf.earned_at, sf.used_at`
+
+// PostgresStreakFreezeRepository implements the StreakFreezeRepository interface
+type PostgresStreakFreezeRepository struct {
+	db *PostgresDB
+}
+
+// NewPostgresStreakFreezeRepository creates a new PostgresStreakFreezeRepository
+func NewPostgresStreakFreezeRepository(db *PostgresDB) *PostgresStreakFreezeRepository {
+	return &PostgresStreakFreezeRepository{
+		db: db,
+	}
+}
+
+// Create persists a new streak freeze token
+func (r *PostgresStreakFreezeRepository) Create(ctx context.Context, freeze *entity.StreakFreeze) error {
+	query := `
+		INSERT INTO streak_freezes (id, character_id, completion_id, habit_id, frozen_date, earned_at, used_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	habitID, frozenDate := streakFreezeUsage(freeze)
+
+	// Legacy tokens have no completion
+	var completionID *string
+	if freeze.CompletionID() != "" {
+		id := freeze.CompletionID()
+		completionID = &id
+	}
+
+	_, err := r.db.conn(ctx).Exec(ctx, query,
+		freeze.ID(),
+		freeze.CharacterID(),
+		completionID,
+		habitID,
+		frozenDate,
+		freeze.EarnedAt(),
+		freeze.UsedAt(),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create streak freeze: %w", err)
+	}
+
+	return nil
+}
+
+// FindAvailableByCharacterID retrieves the unused tokens of a character (oldest first)
+func (r *PostgresStreakFreezeRepository) FindAvailableByCharacterID(ctx context.Context, characterID string) ([]*entity.StreakFreeze, error) {
+	query := `
+		SELECT ` + streakFreezeColumns + `
+		FROM streak_freezes sf
+		WHERE sf.character_id = $1 AND sf.used_at IS NULL
+		ORDER BY sf.earned_at ASC
+	`
+
+	rows, err := r.db.conn(ctx).Query(ctx, query, characterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find streak freezes: %w", err)
+	}
+
+	return collectStreakFreezes(rows)
+}
+
+// FindByHabitID retrieves the tokens spent on a habit
+func (r *PostgresStreakFreezeRepository) FindByHabitID(ctx context.Context, habitID string) ([]*entity.StreakFreeze, error) {
+	query := `
+		SELECT ` + streakFreezeColumns + `
+		FROM streak_freezes sf
+		WHERE sf.habit_id = $1
+		ORDER BY sf.frozen_date ASC
+	`
+
+	rows, err := r.db.conn(ctx).Query(ctx, query, habitID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find streak freezes: %w", err)
+	}
+
+	return collectStreakFreezes(rows)
+}
+
+// FindUsedByUserID retrieves the tokens spent on any habit owned by a user
+func (r *PostgresStreakFreezeRepository) FindUsedByUserID(ctx context.Context, userID string) ([]*entity.StreakFreeze, error) {
+	query := `
+		SELECT ` + streakFreezeColumns + `
+		FROM streak_freezes sf
+		INNER JOIN characters c ON c.id = sf.character_id
+		WHERE c.user_id = $1 AND sf.used_at IS NOT NULL
+		ORDER BY sf.frozen_date ASC
+	`
+
+	rows, err := r.db.conn(ctx).Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find streak freezes: %w", err)
+	}
+
+	return collectStreakFreezes(rows)
+}
+
+// FindByCompletionID retrieves the tokens earned by a habit completion
+func (r *PostgresStreakFreezeRepository) FindByCompletionID(ctx context.Context, completionID string) ([]*entity.StreakFreeze, error) {
+	query := `
+		SELECT ` + streakFreezeColumns + `
+		FROM streak_freezes sf
+		WHERE sf.completion_id = $1
+		ORDER BY sf.earned_at ASC
+	`
+
+	rows, err := r.db.conn(ctx).Query(ctx, query, completionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find streak freezes: %w", err)
+	}
+
+	return collectStreakFreezes(rows)
+}
+
+// Update saves a streak freeze token spent on a habit
+// Only unused rows are updated, so two concurrent requests can't both spend the same token
+func (r *PostgresStreakFreezeRepository) Update(ctx context.Context, freeze *entity.StreakFreeze) error {
+	query := `
+		UPDATE streak_freezes
+		SET habit_id = $2, frozen_date = $3, used_at = $4
+		WHERE id = $1 AND used_at IS NULL
+	`
+
+	habitID, frozenDate := streakFreezeUsage(freeze)
+
+	result, err := r.db.conn(ctx).Exec(ctx, query,
+		freeze.ID(),
+		habitID,
+		frozenDate,
+		freeze.UsedAt(),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update streak freeze: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("streak freeze not found or already used")
+	}
+
+	return nil
+}
+
+// Delete removes a streak freeze token that was not spent yet
+// Only unused rows are deleted, so a token spent by a concurrent request stays
+func (r *PostgresStreakFreezeRepository) Delete(ctx context.Context, id string) error {
+	query := `DELETE FROM streak_freezes WHERE id = $1 AND used_at IS NULL`
+
+	result, err := r.db.conn(ctx).Exec(ctx, query, id)
+	if err != nil {
+		return fmt.Errorf("failed to delete streak freeze: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return repository.ErrStreakFreezeUsed
+	}
+
+	return nil
+}
+
+// streakFreezeUsage returns the nullable usage columns of a token (nil while it is available)
+func streakFreezeUsage(freeze *entity.StreakFreeze) (*string, *time.Time) {
+	if !freeze.IsUsed() {
+		return nil, nil
+	}
+
+	habitID := freeze.HabitID()
+	frozenDate := freeze.FrozenDate()
+	return &habitID, &frozenDate
+}
+
+// collectStreakFreezes scans all rows into entities and closes them
+func collectStreakFreezes(rows pgx.Rows) ([]*entity.StreakFreeze, error) {
+	defer rows.Close()
+
+	var freezes []*entity.StreakFreeze
+
+	for rows.Next() {
+		var (
+			id           string
+			characterID  string
+			completionID *string
+			habitID      *string
+			frozenDate   *time.Time
+			earnedAt     time.Time
+			usedAt       *time.Time
+		)
+
+		err := rows.Scan(
+			&id,
+			&characterID,
+			&completionID,
+			&habitID,
+			&frozenDate,
+			&earnedAt,
+			&usedAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan streak freeze: %w", err)
+		}
+
+		// Usage columns are NULL while the token is available
+		var usedOnHabit string
+		var usedOnDate time.Time
+		if habitID != nil && frozenDate != nil {
+			usedOnHabit, usedOnDate = *habitID, *frozenDate
+		}
+
+		var earnedBy string
+		if completionID != nil {
+			earnedBy = *completionID
+		}
+
+		freezes = append(freezes, entity.ReconstituteStreakFreeze(id, characterID, earnedBy, usedOnHabit, usedOnDate, earnedAt, usedAt))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating streak freezes: %w", err)
+	}
+
+	return freezes, nil
+}
@@ -0,0 +1,298 @@
+package persistence
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/repository"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+	"github.com/jackc/pgx/v5"
+)
+
+// taskColumns lists the columns selected for every task query (prefixed for joins)
+const taskColumns = `t.id, t.character_id, t.title, t.description, t.difficulty, t.priority, t.due_date, t.checklist, t.completed_at, t.xp_awarded, t.created_at, t.updated_at`
+
+// checklistItemRecord is the JSON representation of a checklist item in the checklist column
+type checklistItemRecord struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	Done  bool   `json:"done"`
+}
+
+// PostgresTaskRepository implements the TaskRepository interface
+type PostgresTaskRepository struct {
+	db *PostgresDB
+}
+
+// NewPostgresTaskRepository creates a new PostgresTaskRepository
+func NewPostgresTaskRepository(db *PostgresDB) *PostgresTaskRepository {
+	return &PostgresTaskRepository{
+		db: db,
+	}
+}
+
+// Create persists a new task
+func (r *PostgresTaskRepository) Create(ctx context.Context, task *entity.Task) error {
+	checklist, err := marshalChecklist(task.Checklist())
+	if err != nil {
+		return err
+	}
+
+	query := `
+		INSERT INTO tasks (id, character_id, title, description, difficulty, priority, due_date, checklist, completed_at, xp_awarded, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+	`
+
+	_, err = r.db.conn(ctx).Exec(ctx, query,
+		task.ID(),
+		task.CharacterID(),
+		task.Title(),
+		task.Description(),
+		task.Difficulty().Value(),
+		task.Priority().Value(),
+		task.DueDate(),
+		checklist,
+		task.CompletedAt(),
+		task.XpAwarded(),
+		task.CreatedAt(),
+		task.UpdatedAt(),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create task: %w", err)
+	}
+
+	return nil
+}
+
+// FindByIDAndUserID retrieves a task by ID and validates ownership (through its character)
+// Returns error if the task doesn't exist OR doesn't belong to the user
+func (r *PostgresTaskRepository) FindByIDAndUserID(ctx context.Context, id string, userID string) (*entity.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `
+		FROM tasks t
+		INNER JOIN characters c ON c.id = t.character_id
+		WHERE t.id = $1 AND c.user_id = $2
+	`
+
+	task, err := scanTask(r.db.conn(ctx).QueryRow(ctx, query, id, userID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("task not found or does not belong to user")
+		}
+		return nil, fmt.Errorf("failed to find task: %w", err)
+	}
+
+	return task, nil
+}
+
+// FindByUserID retrieves the tasks of all characters owned by a user matching the filter
+// Tasks are ordered by due date (tasks without one last), then by priority
+func (r *PostgresTaskRepository) FindByUserID(ctx context.Context, userID string, filter repository.TaskFilter) ([]*entity.Task, error) {
+	query := `
+		SELECT ` + taskColumns + `
+		FROM tasks t
+		INNER JOIN characters c ON c.id = t.character_id
+		WHERE c.user_id = $1
+	`
+	args := []any{userID}
+
+	switch filter.Status {
+	case entity.TaskOpen:
+		query += ` AND t.completed_at IS NULL`
+	case entity.TaskCompleted:
+		query += ` AND t.completed_at IS NOT NULL`
+	}
+	if filter.DueFrom != nil {
+		args = append(args, calendarDate(*filter.DueFrom))
+		query += ` AND t.due_date >= $` + strconv.Itoa(len(args))
+	}
+	if filter.DueTo != nil {
+		args = append(args, calendarDate(*filter.DueTo))
+		query += ` AND t.due_date <= $` + strconv.Itoa(len(args))
+	}
+
+	query += `
+		ORDER BY t.due_date ASC NULLS LAST,
+			CASE t.priority WHEN 'high' THEN 3 WHEN 'medium' THEN 2 ELSE 1 END DESC,
+			t.created_at ASC
+	`
+
+	rows, err := r.db.conn(ctx).Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find tasks: %w", err)
+	}
+	defer rows.Close()
+
+	var tasks []*entity.Task
+
+	for rows.Next() {
+		task, err := scanTask(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan task: %w", err)
+		}
+		tasks = append(tasks, task)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating tasks: %w", err)
+	}
+
+	return tasks, nil
+}
+
+// Update updates an existing task (including its checklist)
+// The completion is saved by UpdateCompletion
+func (r *PostgresTaskRepository) Update(ctx context.Context, task *entity.Task) error {
+	checklist, err := marshalChecklist(task.Checklist())
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE tasks
+		SET title = $2, description = $3, difficulty = $4, priority = $5, due_date = $6,
+			checklist = $7, updated_at = $8
+		WHERE id = $1
+	`
+
+	result, err := r.db.conn(ctx).Exec(ctx, query,
+		task.ID(),
+		task.Title(),
+		task.Description(),
+		task.Difficulty().Value(),
+		task.Priority().Value(),
+		task.DueDate(),
+		checklist,
+		task.UpdatedAt(),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update task: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("task not found")
+	}
+
+	return nil
+}
+
+// UpdateCompletion saves the completion or reopening of a task
+// Only a task still in the opposite state is updated, so concurrent requests can't both reward (or take back) it
+func (r *PostgresTaskRepository) UpdateCompletion(ctx context.Context, task *entity.Task) error {
+	guard := `completed_at IS NULL`
+	if !task.IsCompleted() {
+		guard = `completed_at IS NOT NULL`
+	}
+
+	query := `
+		UPDATE tasks
+		SET completed_at = $2, xp_awarded = $3, updated_at = $4
+		WHERE id = $1 AND ` + guard
+
+	result, err := r.db.conn(ctx).Exec(ctx, query,
+		task.ID(),
+		task.CompletedAt(),
+		task.XpAwarded(),
+		task.UpdatedAt(),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to update task completion: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return repository.ErrTaskCompletionChanged
+	}
+
+	return nil
+}
+
+// marshalChecklist encodes a task checklist for the JSONB checklist column
+func marshalChecklist(items []*entity.TaskChecklistItem) ([]byte, error) {
+	records := make([]checklistItemRecord, len(items))
+	for i, item := range items {
+		records[i] = checklistItemRecord{ID: item.ID(), Title: item.Title(), Done: item.Done()}
+	}
+
+	data, err := json.Marshal(records)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode task checklist: %w", err)
+	}
+	return data, nil
+}
+
+// scanTask scans a single row into a Task entity
+func scanTask(row pgx.Row) (*entity.Task, error) {
+	var (
+		id            string
+		characterID   string
+		title         string
+		description   string
+		difficultyStr string
+		priorityStr   string
+		dueDate       *time.Time
+		checklistJSON []byte
+		completedAt   *time.Time
+		xpAwarded     int
+		createdAt     time.Time
+		updatedAt     time.Time
+	)
+
+	err := row.Scan(
+		&id,
+		&characterID,
+		&title,
+		&description,
+		&difficultyStr,
+		&priorityStr,
+		&dueDate,
+		&checklistJSON,
+		&completedAt,
+		&xpAwarded,
+		&createdAt,
+		&updatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	difficulty, err := valueobject.NewDifficulty(difficultyStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid difficulty in database: %w", err)
+	}
+
+	priority, err := valueobject.NewPriority(priorityStr)
+	if err != nil {
+		return nil, fmt.Errorf("invalid priority in database: %w", err)
+	}
+
+	var records []checklistItemRecord
+	if err := json.Unmarshal(checklistJSON, &records); err != nil {
+		return nil, fmt.Errorf("invalid checklist in database: %w", err)
+	}
+	checklist := make([]*entity.TaskChecklistItem, len(records))
+	for i, record := range records {
+		checklist[i] = entity.ReconstituteTaskChecklistItem(record.ID, record.Title, record.Done)
+	}
+
+	return entity.ReconstituteTask(
+		id,
+		characterID,
+		title,
+		description,
+		difficulty,
+		priority,
+		dueDate,
+		checklist,
+		completedAt,
+		xpAwarded,
+		createdAt,
+		updatedAt,
+	), nil
+}
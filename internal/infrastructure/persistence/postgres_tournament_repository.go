@@ -0,0 +1,389 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/jackc/pgx/v5"
+)
+
+// tournamentColumns lists the columns selected for every tournament query
+const tournamentColumns = `id, name, format, seeding, guild_id, organizer_user_id, max_entrants, prize_xp, join_deadline,
+	round_interval_seconds, status, total_rounds, rounds_played, champion_character_id, runner_up_character_id, created_at, ended_at`
+
+// PostgresTournamentRepository implements the TournamentRepository interface
+type PostgresTournamentRepository struct {
+	db *PostgresDB
+}
+
+// NewPostgresTournamentRepository creates a new PostgresTournamentRepository
+func NewPostgresTournamentRepository(db *PostgresDB) *PostgresTournamentRepository {
+	return &PostgresTournamentRepository{
+		db: db,
+	}
+}
+
+// Create persists a new tournament
+func (r *PostgresTournamentRepository) Create(ctx context.Context, tournament *entity.Tournament) error {
+	query := `
+		INSERT INTO tournaments (id, name, format, seeding, guild_id, organizer_user_id, max_entrants, prize_xp, join_deadline,
+			round_interval_seconds, status, total_rounds, rounds_played, champion_character_id, runner_up_character_id,
+			next_round_at, created_at, ended_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18)
+	`
+
+	_, err := r.db.conn(ctx).Exec(ctx, query,
+		tournament.ID(),
+		tournament.Name(),
+		tournament.Format(),
+		tournament.Seeding(),
+		nullableTournamentID(tournament.GuildID()),
+		tournament.OrganizerUserID(),
+		tournament.MaxEntrants(),
+		tournament.PrizeXp(),
+		tournament.JoinDeadline(),
+		int(tournament.RoundInterval()/time.Second),
+		tournament.Status(),
+		tournament.TotalRounds(),
+		tournament.RoundsPlayed(),
+		nullableTournamentID(tournament.ChampionID()),
+		nullableTournamentID(tournament.RunnerUpID()),
+		tournamentNextRoundAt(tournament),
+		tournament.CreatedAt(),
+		tournament.EndedAt(),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to create tournament: %w", err)
+	}
+
+	return nil
+}
+
+// Update saves the status, the rounds played and the podium of a tournament
+// next_round_at is derived from them, so the round job finds the tournament when its next round is due
+func (r *PostgresTournamentRepository) Update(ctx context.Context, tournament *entity.Tournament) error {
+	query := `
+		UPDATE tournaments
+		SET status = $2, total_rounds = $3, rounds_played = $4, champion_character_id = $5, runner_up_character_id = $6,
+			next_round_at = $7, ended_at = $8
+		WHERE id = $1
+	`
+
+	result, err := r.db.conn(ctx).Exec(ctx, query,
+		tournament.ID(),
+		tournament.Status(),
+		tournament.TotalRounds(),
+		tournament.RoundsPlayed(),
+		nullableTournamentID(tournament.ChampionID()),
+		nullableTournamentID(tournament.RunnerUpID()),
+		tournamentNextRoundAt(tournament),
+		tournament.EndedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to update tournament: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("tournament not found")
+	}
+
+	return nil
+}
+
+// FindByID retrieves a tournament by its ID
+func (r *PostgresTournamentRepository) FindByID(ctx context.Context, id string) (*entity.Tournament, error) {
+	query := `
+		SELECT ` + tournamentColumns + `
+		FROM tournaments
+		WHERE id = $1
+	`
+
+	return r.findOne(ctx, query, id)
+}
+
+// FindByIDForUpdate retrieves a tournament by its ID and locks its row until the transaction ends
+func (r *PostgresTournamentRepository) FindByIDForUpdate(ctx context.Context, id string) (*entity.Tournament, error) {
+	query := `
+		SELECT ` + tournamentColumns + `
+		FROM tournaments
+		WHERE id = $1
+		FOR UPDATE
+	`
+
+	return r.findOne(ctx, query, id)
+}
+
+// findOne runs a query for a single tournament, returning an error when there is none
+func (r *PostgresTournamentRepository) findOne(ctx context.Context, query string, id string) (*entity.Tournament, error) {
+	tournament, err := scanTournament(r.db.conn(ctx).QueryRow(ctx, query, id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, fmt.Errorf("tournament not found")
+		}
+		return nil, fmt.Errorf("failed to find tournament: %w", err)
+	}
+
+	return tournament, nil
+}
+
+// FindDueIDs retrieves the IDs of the tournaments with a bracket to seed or a round to fight at the given time
+func (r *PostgresTournamentRepository) FindDueIDs(ctx context.Context, at time.Time) ([]string, error) {
+	query := `
+		SELECT id
+		FROM tournaments
+		WHERE next_round_at <= $1
+		ORDER BY next_round_at, id
+	`
+
+	rows, err := r.db.conn(ctx).Query(ctx, query, at)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query due tournaments: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("failed to scan tournament id: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating due tournaments: %w", err)
+	}
+
+	return ids, nil
+}
+
+// AddEntrant persists a character joining a tournament
+// The primary key rejects a character joining twice
+func (r *PostgresTournamentRepository) AddEntrant(ctx context.Context, entrant *entity.TournamentEntrant) error {
+	query := `
+		INSERT INTO tournament_entrants (tournament_id, character_id, seed, seed_score, joined_at)
+		VALUES ($1, $2, $3, $4, $5)
+	`
+
+	_, err := r.db.conn(ctx).Exec(ctx, query, entrant.TournamentID(), entrant.CharacterID(), entrant.Seed(), entrant.SeedScore(), entrant.JoinedAt())
+	if err != nil {
+		return fmt.Errorf("failed to add tournament entrant: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateEntrant saves the seed of an entrant
+func (r *PostgresTournamentRepository) UpdateEntrant(ctx context.Context, entrant *entity.TournamentEntrant) error {
+	query := `
+		UPDATE tournament_entrants
+		SET seed = $3, seed_score = $4
+		WHERE tournament_id = $1 AND character_id = $2
+	`
+
+	result, err := r.db.conn(ctx).Exec(ctx, query, entrant.TournamentID(), entrant.CharacterID(), entrant.Seed(), entrant.SeedScore())
+	if err != nil {
+		return fmt.Errorf("failed to update tournament entrant: %w", err)
+	}
+
+	if result.RowsAffected() == 0 {
+		return fmt.Errorf("tournament entrant not found")
+	}
+
+	return nil
+}
+
+// FindEntrants retrieves the entrants of a tournament (by seed, then by join time)
+func (r *PostgresTournamentRepository) FindEntrants(ctx context.Context, tournamentID string) ([]*entity.TournamentEntrant, error) {
+	query := `
+		SELECT tournament_id, character_id, seed, seed_score, joined_at
+		FROM tournament_entrants
+		WHERE tournament_id = $1
+		ORDER BY seed, joined_at, character_id
+	`
+
+	rows, err := r.db.conn(ctx).Query(ctx, query, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tournament entrants: %w", err)
+	}
+	defer rows.Close()
+
+	var entrants []*entity.TournamentEntrant
+	for rows.Next() {
+		var (
+			characterID string
+			seed        int
+			seedScore   int
+			joinedAt    time.Time
+		)
+		if err := rows.Scan(&tournamentID, &characterID, &seed, &seedScore, &joinedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan tournament entrant: %w", err)
+		}
+		entrants = append(entrants, entity.ReconstituteTournamentEntrant(tournamentID, characterID, seed, seedScore, joinedAt))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating tournament entrants: %w", err)
+	}
+
+	return entrants, nil
+}
+
+// CreateMatch persists the result of a match
+// The primary key rejects a second result for the same round and slot
+func (r *PostgresTournamentRepository) CreateMatch(ctx context.Context, match *entity.TournamentMatch) error {
+	query := `
+		INSERT INTO tournament_matches (tournament_id, round, slot, character_id, opponent_character_id, battle_id, winner_character_id, played_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+	`
+
+	_, err := r.db.conn(ctx).Exec(ctx, query,
+		match.TournamentID(),
+		match.Round(),
+		match.Slot(),
+		match.CharacterID(),
+		nullableTournamentID(match.OpponentID()),
+		nullableTournamentID(match.BattleID()),
+		nullableTournamentID(match.WinnerID()),
+		match.PlayedAt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create tournament match: %w", err)
+	}
+
+	return nil
+}
+
+// FindMatches retrieves the matches of a tournament (by round, then by slot)
+func (r *PostgresTournamentRepository) FindMatches(ctx context.Context, tournamentID string) ([]*entity.TournamentMatch, error) {
+	query := `
+		SELECT tournament_id, round, slot, character_id, opponent_character_id, battle_id, winner_character_id, played_at
+		FROM tournament_matches
+		WHERE tournament_id = $1
+		ORDER BY round, slot
+	`
+
+	rows, err := r.db.conn(ctx).Query(ctx, query, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query tournament matches: %w", err)
+	}
+	defer rows.Close()
+
+	var matches []*entity.TournamentMatch
+	for rows.Next() {
+		var (
+			round       int
+			slot        int
+			characterID string
+			opponentID  *string
+			battleID    *string
+			winnerID    *string
+			playedAt    time.Time
+		)
+		if err := rows.Scan(&tournamentID, &round, &slot, &characterID, &opponentID, &battleID, &winnerID, &playedAt); err != nil {
+			return nil, fmt.Errorf("failed to scan tournament match: %w", err)
+		}
+		matches = append(matches, entity.ReconstituteTournamentMatch(tournamentID, round, slot, characterID,
+			tournamentIDOrEmpty(opponentID), tournamentIDOrEmpty(battleID), tournamentIDOrEmpty(winnerID), playedAt))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating tournament matches: %w", err)
+	}
+
+	return matches, nil
+}
+
+// nullableTournamentID returns the nullable column of an optional ID (nil when empty)
+func nullableTournamentID(id string) *string {
+	if id == "" {
+		return nil
+	}
+	return &id
+}
+
+// tournamentIDOrEmpty returns the ID of a nullable column (empty when NULL)
+func tournamentIDOrEmpty(id *string) string {
+	if id == nil {
+		return ""
+	}
+	return *id
+}
+
+// tournamentNextRoundAt returns when the round job has work to do on a tournament (nil once it is over)
+func tournamentNextRoundAt(tournament *entity.Tournament) *time.Time {
+	next := tournament.NextRoundAt()
+	if next.IsZero() {
+		return nil
+	}
+	return &next
+}
+
+// scanTournament scans a single row into a Tournament entity
+func scanTournament(row pgx.Row) (*entity.Tournament, error) {
+	var (
+		id                   string
+		name                 string
+		format               string
+		seeding              string
+		guildID              *string
+		organizerUserID      string
+		maxEntrants          int
+		prizeXp              int
+		joinDeadline         time.Time
+		roundIntervalSeconds int
+		status               string
+		totalRounds          int
+		roundsPlayed         int
+		championID           *string
+		runnerUpID           *string
+		createdAt            time.Time
+		endedAt              *time.Time
+	)
+
+	err := row.Scan(
+		&id,
+		&name,
+		&format,
+		&seeding,
+		&guildID,
+		&organizerUserID,
+		&maxEntrants,
+		&prizeXp,
+		&joinDeadline,
+		&roundIntervalSeconds,
+		&status,
+		&totalRounds,
+		&roundsPlayed,
+		&championID,
+		&runnerUpID,
+		&createdAt,
+		&endedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return entity.ReconstituteTournament(
+		id,
+		name,
+		format,
+		seeding,
+		tournamentIDOrEmpty(guildID),
+		organizerUserID,
+		maxEntrants,
+		prizeXp,
+		joinDeadline,
+		time.Duration(roundIntervalSeconds)*time.Second,
+		status,
+		totalRounds,
+		roundsPlayed,
+		tournamentIDOrEmpty(championID),
+		tournamentIDOrEmpty(runnerUpID),
+		createdAt,
+		endedAt,
+	), nil
+}
@@ -0,0 +1,129 @@
+package persistence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+	"github.com/igor/chronotask-api/internal/infrastructure/persistence"
+)
+
+func TestPostgresTournamentRepository_PlaysBracket(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+	defer db.Pool.Exec(context.Background(), "DELETE FROM battles")
+
+	ctx := context.Background()
+	userRepo := persistence.NewPostgresUserRepository(db)
+	charRepo := persistence.NewPostgresCharacterRepository(db)
+	battleRepo := persistence.NewPostgresBattleRepository(db)
+	tournamentRepo := persistence.NewPostgresTournamentRepository(db)
+
+	character := createTestCharacter(t, userRepo, charRepo)
+	rival, err := entity.NewCharacter("test-rival-id", "Test Rival", valueobject.DefaultCharacterClass(), character.UserID())
+	if err != nil {
+		t.Fatalf("Failed to create rival entity: %v", err)
+	}
+	if err := charRepo.Create(ctx, rival); err != nil {
+		t.Fatalf("Failed to save rival: %v", err)
+	}
+
+	now := time.Now().UTC().Truncate(time.Microsecond)
+	deadline := now.Add(time.Hour)
+	tournament, err := entity.NewTournament("test-tournament-id", "Copa do Hábito", entity.TournamentSingleElimination, entity.TournamentSeedByLevel,
+		"", character.UserID(), 8, 100, deadline, 30*time.Minute, now)
+	if err != nil {
+		t.Fatalf("Failed to create tournament entity: %v", err)
+	}
+	if err := tournamentRepo.Create(ctx, tournament); err != nil {
+		t.Fatalf("Create() error = %v, want nil", err)
+	}
+
+	// Characters join once
+	for i, characterID := range []string{character.ID(), rival.ID()} {
+		entrant, _ := entity.NewTournamentEntrant(tournament.ID(), characterID, now.Add(time.Duration(i)*time.Minute))
+		if err := tournamentRepo.AddEntrant(ctx, entrant); err != nil {
+			t.Fatalf("AddEntrant(%s) error = %v, want nil", characterID, err)
+		}
+	}
+	again, _ := entity.NewTournamentEntrant(tournament.ID(), rival.ID(), now)
+	if err := tournamentRepo.AddEntrant(ctx, again); err == nil {
+		t.Error("AddEntrant() error = nil, want error for a character joining twice")
+	}
+
+	// The bracket is due at the deadline
+	if ids, err := tournamentRepo.FindDueIDs(ctx, deadline.Add(-time.Second)); err != nil || len(ids) != 0 {
+		t.Errorf("FindDueIDs() before the deadline = %v, %v, want none", ids, err)
+	}
+	if ids, err := tournamentRepo.FindDueIDs(ctx, deadline); err != nil || len(ids) != 1 || ids[0] != tournament.ID() {
+		t.Fatalf("FindDueIDs() at the deadline = %v, %v, want the tournament", ids, err)
+	}
+
+	// Seed the bracket and fight its only round
+	locked, err := tournamentRepo.FindByIDForUpdate(ctx, tournament.ID())
+	if err != nil {
+		t.Fatalf("FindByIDForUpdate() error = %v, want nil", err)
+	}
+	entrants, err := tournamentRepo.FindEntrants(ctx, tournament.ID())
+	if err != nil || len(entrants) != 2 {
+		t.Fatalf("FindEntrants() = %d entrants, %v, want 2", len(entrants), err)
+	}
+	if err := locked.Start(entrants, map[string]int{character.ID(): 2, rival.ID(): 1}); err != nil {
+		t.Fatalf("Start() error = %v, want nil", err)
+	}
+	for _, entrant := range entrants {
+		if err := tournamentRepo.UpdateEntrant(ctx, entrant); err != nil {
+			t.Fatalf("UpdateEntrant() error = %v, want nil", err)
+		}
+	}
+
+	pairings, _ := locked.Pairings(entrants, nil)
+	battle, err := entity.NewBattle("test-battle-id", newTestCharacterCombatant(t, character, 40), newTestCharacterCombatant(t, rival, 1), 7, deadline)
+	if err != nil {
+		t.Fatalf("Failed to create battle entity: %v", err)
+	}
+	if err := battleRepo.Create(ctx, battle); err != nil {
+		t.Fatalf("battle Create() error = %v, want nil", err)
+	}
+	match, err := locked.RecordMatch(pairings[0], battle, deadline)
+	if err != nil {
+		t.Fatalf("RecordMatch() error = %v, want nil", err)
+	}
+	if err := tournamentRepo.CreateMatch(ctx, match); err != nil {
+		t.Fatalf("CreateMatch() error = %v, want nil", err)
+	}
+	if err := tournamentRepo.CreateMatch(ctx, match); err == nil {
+		t.Error("CreateMatch() error = nil, want error for a slot recorded twice")
+	}
+
+	matches, err := tournamentRepo.FindMatches(ctx, tournament.ID())
+	if err != nil || len(matches) != 1 || matches[0].WinnerID() != character.ID() || matches[0].BattleID() != battle.ID() {
+		t.Fatalf("FindMatches() = %v, %v, want the match won by %s", matches, err, character.ID())
+	}
+
+	locked.CompleteRound()
+	if err := locked.Finish(entrants, matches, deadline); err != nil {
+		t.Fatalf("Finish() error = %v, want nil", err)
+	}
+	if err := tournamentRepo.Update(ctx, locked); err != nil {
+		t.Fatalf("Update() error = %v, want nil", err)
+	}
+
+	// A finished tournament is no longer due and keeps its podium
+	if ids, _ := tournamentRepo.FindDueIDs(ctx, deadline.Add(24*time.Hour)); len(ids) != 0 {
+		t.Errorf("FindDueIDs() after the final = %v, want none", ids)
+	}
+	found, err := tournamentRepo.FindByID(ctx, tournament.ID())
+	if err != nil || found.Status() != entity.TournamentCompleted || found.ChampionID() != character.ID() || found.RunnerUpID() != rival.ID() || found.RoundInterval() != 30*time.Minute {
+		t.Errorf("FindByID() = %+v, %v, want the completed tournament won by %s", found, err, character.ID())
+	}
+	if seeded, _ := tournamentRepo.FindEntrants(ctx, tournament.ID()); seeded[0].CharacterID() != character.ID() || seeded[0].Seed() != 1 || seeded[1].SeedScore() != 1 {
+		t.Errorf("FindEntrants() = %+v, want %s seeded first", seeded, character.ID())
+	}
+
+	if _, err := tournamentRepo.FindByID(ctx, "unknown-tournament"); err == nil {
+		t.Error("FindByID() error = nil, want error for an unknown tournament")
+	}
+}
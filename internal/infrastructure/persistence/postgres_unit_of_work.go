@@ -0,0 +1,72 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// txContextKey is the context key under which the running unit of work keeps its transaction
+type txContextKey struct{}
+
+// querier is what repositories run their statements on: the pool, or the transaction of a unit of work
+type querier interface {
+	Exec(ctx context.Context, sql string, arguments ...any) (pgconn.CommandTag, error)
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+// conn returns the transaction of the unit of work running in ctx, or the pool outside of one
+func (db *PostgresDB) conn(ctx context.Context) querier {
+	if tx, ok := ctx.Value(txContextKey{}).(pgx.Tx); ok {
+		return tx
+	}
+	return db.Pool
+}
+
+// begin starts a transaction, or a savepoint when a unit of work is already running in ctx
+func (db *PostgresDB) begin(ctx context.Context) (pgx.Tx, error) {
+	if tx, ok := ctx.Value(txContextKey{}).(pgx.Tx); ok {
+		return tx.Begin(ctx)
+	}
+	return db.Pool.Begin(ctx)
+}
+
+// PostgresUnitOfWork implements the UnitOfWork interface with pgx transactions
+type PostgresUnitOfWork struct {
+	db *PostgresDB
+}
+
+// NewPostgresUnitOfWork creates a new PostgresUnitOfWork
+func NewPostgresUnitOfWork(db *PostgresDB) *PostgresUnitOfWork {
+	return &PostgresUnitOfWork{
+		db: db,
+	}
+}
+
+// Do runs fn in a transaction that every repository called with fn's context takes part in
+func (u *PostgresUnitOfWork) Do(ctx context.Context, fn func(ctx context.Context) error) error {
+	// Nested units of work join the enclosing transaction
+	if _, ok := ctx.Value(txContextKey{}).(pgx.Tx); ok {
+		return fn(ctx)
+	}
+
+	tx, err := u.db.Pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	// Rolls back on error or panic; a no-op once committed
+	defer tx.Rollback(ctx)
+
+	if err := fn(context.WithValue(ctx, txContextKey{}, tx)); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package persistence_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+	"github.com/igor/chronotask-api/internal/infrastructure/persistence"
+)
+
+func TestPostgresUnitOfWork_Do_CommitsAllWrites(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	userRepo := persistence.NewPostgresUserRepository(db)
+	charRepo := persistence.NewPostgresCharacterRepository(db)
+	attrRepo := persistence.NewPostgresCharacterAttributeRepository(db)
+	unitOfWork := persistence.NewPostgresUnitOfWork(db)
+
+	user := createTestUser(t, userRepo)
+	character, _ := entity.NewCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), user.ID())
+	attribute, _ := entity.NewCharacterAttribute("Força", 5, character.ID())
+
+	err := unitOfWork.Do(context.Background(), func(ctx context.Context) error {
+		if err := charRepo.Create(ctx, character); err != nil {
+			return err
+		}
+		return attrRepo.Create(ctx, attribute)
+	})
+	if err != nil {
+		t.Fatalf("Do() error = %v, want nil", err)
+	}
+
+	if _, err := attrRepo.FindByCharacterIDAndName(context.Background(), character.ID(), "Força"); err != nil {
+		t.Errorf("FindByCharacterIDAndName() error = %v, want committed attribute", err)
+	}
+}
+
+func TestPostgresUnitOfWork_Do_RollsBackOnError(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	userRepo := persistence.NewPostgresUserRepository(db)
+	charRepo := persistence.NewPostgresCharacterRepository(db)
+	unitOfWork := persistence.NewPostgresUnitOfWork(db)
+
+	user := createTestUser(t, userRepo)
+	character, _ := entity.NewCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), user.ID())
+
+	failure := errors.New("attribute insert failed")
+	err := unitOfWork.Do(context.Background(), func(ctx context.Context) error {
+		if err := charRepo.Create(ctx, character); err != nil {
+			return err
+		}
+		return failure
+	})
+	if !errors.Is(err, failure) {
+		t.Fatalf("Do() error = %v, want %v", err, failure)
+	}
+
+	// The user can retry: the character was never committed
+	exists, err := charRepo.ExistsByUserID(context.Background(), user.ID())
+	if err != nil {
+		t.Fatalf("ExistsByUserID() error = %v, want nil", err)
+	}
+	if exists {
+		t.Error("ExistsByUserID() = true, want false after rollback")
+	}
+}
+
+func TestPostgresUnitOfWork_Do_LockedCharacterKeepsConcurrentXp(t *testing.T) {
+	db, cleanup := setupTestDB(t)
+	defer cleanup()
+
+	userRepo := persistence.NewPostgresUserRepository(db)
+	charRepo := persistence.NewPostgresCharacterRepository(db)
+	unitOfWork := persistence.NewPostgresUnitOfWork(db)
+
+	user := createTestUser(t, userRepo)
+	character, _ := entity.NewCharacter("char-123", "Warrior King", valueobject.DefaultCharacterClass(), user.ID())
+	if err := charRepo.Create(context.Background(), character); err != nil {
+		t.Fatalf("Create() error = %v, want nil", err)
+	}
+
+	// Each award reads the character under its lock, so none overwrites another
+	const awards = 5
+	errs := make(chan error, awards)
+	for i := 0; i < awards; i++ {
+		go func() {
+			errs <- unitOfWork.Do(context.Background(), func(ctx context.Context) error {
+				locked, err := charRepo.FindByIDForUpdate(ctx, character.ID())
+				if err != nil {
+					return err
+				}
+				if _, err := locked.AddXp(10); err != nil {
+					return err
+				}
+				return charRepo.Update(ctx, locked)
+			})
+		}()
+	}
+	for i := 0; i < awards; i++ {
+		if err := <-errs; err != nil {
+			t.Fatalf("Do() error = %v, want nil", err)
+		}
+	}
+
+	found, err := charRepo.FindByID(context.Background(), character.ID())
+	if err != nil {
+		t.Fatalf("FindByID() error = %v, want nil", err)
+	}
+	if found.TotalXp() != awards*10 {
+		t.Errorf("TotalXp() = %v, want %v", found.TotalXp(), awards*10)
+	}
+}
@@ -0,0 +1,84 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+	"github.com/jackc/pgx/v5"
+)
+
+// PostgresUserPreferencesRepository implements the UserPreferencesRepository interface
+type PostgresUserPreferencesRepository struct {
+	db *PostgresDB
+}
+
+// NewPostgresUserPreferencesRepository creates a new PostgresUserPreferencesRepository
+func NewPostgresUserPreferencesRepository(db *PostgresDB) *PostgresUserPreferencesRepository {
+	return &PostgresUserPreferencesRepository{
+		db: db,
+	}
+}
+
+// FindByUserID retrieves the preferences of a user
+// Returns nil (without error) when the user never saved any
+func (r *PostgresUserPreferencesRepository) FindByUserID(ctx context.Context, userID string) (*entity.UserPreferences, error) {
+	query := `
+		SELECT user_id, timezone, week_start, day_rollover_hour, updated_at
+		FROM user_preferences
+		WHERE user_id = $1
+	`
+
+	var (
+		id              string
+		timezone        string
+		weekStart       int
+		dayRolloverHour int
+		updatedAt       time.Time
+	)
+
+	err := r.db.conn(ctx).QueryRow(ctx, query, userID).Scan(&id, &timezone, &weekStart, &dayRolloverHour, &updatedAt)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find user preferences: %w", err)
+	}
+
+	clock, err := valueobject.NewDayClock(timezone, dayRolloverHour, time.Weekday(weekStart))
+	if err != nil {
+		return nil, fmt.Errorf("invalid stored user preferences: %w", err)
+	}
+
+	return entity.ReconstituteUserPreferences(id, clock, updatedAt), nil
+}
+
+// Save creates or replaces the preferences of a user
+func (r *PostgresUserPreferencesRepository) Save(ctx context.Context, preferences *entity.UserPreferences) error {
+	query := `
+		INSERT INTO user_preferences (user_id, timezone, week_start, day_rollover_hour, updated_at)
+		VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (user_id) DO UPDATE
+		SET timezone = EXCLUDED.timezone,
+			week_start = EXCLUDED.week_start,
+			day_rollover_hour = EXCLUDED.day_rollover_hour,
+			updated_at = EXCLUDED.updated_at
+	`
+
+	clock := preferences.Clock()
+	_, err := r.db.conn(ctx).Exec(ctx, query,
+		preferences.UserID(),
+		clock.Timezone(),
+		int(clock.WeekStart()),
+		clock.RolloverHour(),
+		preferences.UpdatedAt(),
+	)
+
+	if err != nil {
+		return fmt.Errorf("failed to save user preferences: %w", err)
+	}
+
+	return nil
+}
@@ -29,7 +29,7 @@ func (r *PostgresUserRepository) Create(ctx context.Context, user *entity.User)
 		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
 	`
 
-	_, err := r.db.Pool.Exec(ctx, query,
+	_, err := r.db.conn(ctx).Exec(ctx, query,
 		user.ID(),
 		user.FullName(),
 		user.Email().Value(),
@@ -66,7 +66,7 @@ func (r *PostgresUserRepository) FindByID(ctx context.Context, id string) (*enti
 		updatedAt   time.Time
 	)
 
-	err := r.db.Pool.QueryRow(ctx, query, id).Scan(
+	err := r.db.conn(ctx).QueryRow(ctx, query, id).Scan(
 		&userID,
 		&fullName,
 		&emailStr,
@@ -122,7 +122,7 @@ func (r *PostgresUserRepository) FindByEmail(ctx context.Context, email valueobj
 		updatedAt   time.Time
 	)
 
-	err := r.db.Pool.QueryRow(ctx, query, email.Value()).Scan(
+	err := r.db.conn(ctx).QueryRow(ctx, query, email.Value()).Scan(
 		&userID,
 		&fullName,
 		&emailStr,
@@ -167,7 +167,7 @@ func (r *PostgresUserRepository) Update(ctx context.Context, user *entity.User)
 		WHERE id = $1
 	`
 
-	result, err := r.db.Pool.Exec(ctx, query,
+	result, err := r.db.conn(ctx).Exec(ctx, query,
 		user.ID(),
 		user.FullName(),
 		user.Email().Value(),
@@ -191,7 +191,7 @@ func (r *PostgresUserRepository) Update(ctx context.Context, user *entity.User)
 func (r *PostgresUserRepository) Delete(ctx context.Context, id string) error {
 	query := `DELETE FROM users WHERE id = $1`
 
-	result, err := r.db.Pool.Exec(ctx, query, id)
+	result, err := r.db.conn(ctx).Exec(ctx, query, id)
 	if err != nil {
 		return fmt.Errorf("failed to delete user: %w", err)
 	}
@@ -208,7 +208,7 @@ func (r *PostgresUserRepository) ExistsByEmail(ctx context.Context, email valueo
 	query := `SELECT EXISTS(SELECT 1 FROM users WHERE email = $1)`
 
 	var exists bool
-	err := r.db.Pool.QueryRow(ctx, query, email.Value()).Scan(&exists)
+	err := r.db.conn(ctx).QueryRow(ctx, query, email.Value()).Scan(&exists)
 	if err != nil {
 		return false, fmt.Errorf("failed to check if email exists: %w", err)
 	}
@@ -0,0 +1,145 @@
+package persistence
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/domain/entity"
+	"github.com/igor/chronotask-api/internal/domain/valueobject"
+	"github.com/jackc/pgx/v5"
+)
+
+// xpTransactionColumns lists the columns selected for every XP transaction query (prefixed for joins)
+const xpTransactionColumns = `xt.id, xt.character_id, xt.amount, xt.source_type, xt.source_id, xt.resulting_level, xt.resulting_total_xp, xt.created_at`
+
+// PostgresXpTransactionRepository implements the XpTransactionRepository interface
+type PostgresXpTransactionRepository struct {
+	db *PostgresDB
+}
+
+// NewPostgresXpTransactionRepository creates a new PostgresXpTransactionRepository
+func NewPostgresXpTransactionRepository(db *PostgresDB) *PostgresXpTransactionRepository {
+	return &PostgresXpTransactionRepository{
+		db: db,
+	}
+}
+
+// FindPageByCharacterID retrieves a page of a character's XP transactions (most recent first)
+func (r *PostgresXpTransactionRepository) FindPageByCharacterID(ctx context.Context, characterID string, limit int, offset int) ([]*entity.XpTransaction, error) {
+	query := `
+		SELECT ` + xpTransactionColumns + `
+		FROM xp_transactions xt
+		WHERE xt.character_id = $1
+		ORDER BY xt.id DESC
+		LIMIT $2 OFFSET $3
+	`
+
+	rows, err := r.db.conn(ctx).Query(ctx, query, characterID, limit, offset)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find xp transactions: %w", err)
+	}
+
+	return collectXpTransactions(rows)
+}
+
+// CountByCharacterID counts the XP transactions of a character
+func (r *PostgresXpTransactionRepository) CountByCharacterID(ctx context.Context, characterID string) (int, error) {
+	query := `SELECT COUNT(*) FROM xp_transactions WHERE character_id = $1`
+
+	var count int
+	if err := r.db.conn(ctx).QueryRow(ctx, query, characterID).Scan(&count); err != nil {
+		return 0, fmt.Errorf("failed to count xp transactions: %w", err)
+	}
+
+	return count, nil
+}
+
+// FindAllByCharacterID retrieves the whole XP ledger of a character (oldest first)
+func (r *PostgresXpTransactionRepository) FindAllByCharacterID(ctx context.Context, characterID string) ([]*entity.XpTransaction, error) {
+	query := `
+		SELECT ` + xpTransactionColumns + `
+		FROM xp_transactions xt
+		WHERE xt.character_id = $1
+		ORDER BY xt.id ASC
+	`
+
+	rows, err := r.db.conn(ctx).Query(ctx, query, characterID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to find xp transactions: %w", err)
+	}
+
+	return collectXpTransactions(rows)
+}
+
+// insertXpTransactions appends ledger entries inside the transaction that updates their character
+func insertXpTransactions(ctx context.Context, tx pgx.Tx, transactions []*entity.XpTransaction) error {
+	query := `
+		INSERT INTO xp_transactions (character_id, amount, source_type, source_id, resulting_level, resulting_total_xp, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+	`
+
+	for _, transaction := range transactions {
+		_, err := tx.Exec(ctx, query,
+			transaction.CharacterID(),
+			transaction.Amount(),
+			transaction.Source().Type(),
+			transaction.Source().ID(),
+			transaction.ResultingLevel(),
+			transaction.ResultingTotalXp(),
+			transaction.CreatedAt(),
+		)
+		if err != nil {
+			return fmt.Errorf("failed to create xp transaction: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// collectXpTransactions scans all rows into entities and closes them
+func collectXpTransactions(rows pgx.Rows) ([]*entity.XpTransaction, error) {
+	defer rows.Close()
+
+	var transactions []*entity.XpTransaction
+
+	for rows.Next() {
+		var (
+			id               int64
+			characterID      string
+			amount           int
+			sourceType       string
+			sourceID         string
+			resultingLevel   int
+			resultingTotalXp int
+			createdAt        time.Time
+		)
+
+		err := rows.Scan(
+			&id,
+			&characterID,
+			&amount,
+			&sourceType,
+			&sourceID,
+			&resultingLevel,
+			&resultingTotalXp,
+			&createdAt,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan xp transaction: %w", err)
+		}
+
+		source, err := valueobject.NewXpSource(sourceType, sourceID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid xp source in database: %w", err)
+		}
+
+		transactions = append(transactions, entity.ReconstituteXpTransaction(id, characterID, amount, source, resultingLevel, resultingTotalXp, createdAt))
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating xp transactions: %w", err)
+	}
+
+	return transactions, nil
+}
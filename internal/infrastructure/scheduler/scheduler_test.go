@@ -0,0 +1,48 @@
+package scheduler_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/igor/chronotask-api/internal/infrastructure/scheduler"
+)
+
+func TestScheduler_RunsJobUntilStopped(t *testing.T) {
+	var runs atomic.Int32
+	done := make(chan struct{}, 10)
+
+	s := scheduler.New()
+	s.Register(scheduler.Job{
+		Name:     "test",
+		Interval: 10 * time.Millisecond,
+		Run: func(ctx context.Context) error {
+			runs.Add(1)
+			select {
+			case done <- struct{}{}:
+			default:
+			}
+			return nil
+		},
+	})
+
+	s.Start(context.Background())
+
+	// The job runs on start and again on the next tick
+	for i := 0; i < 2; i++ {
+		select {
+		case <-done:
+		case <-time.After(time.Second):
+			t.Fatal("job did not run")
+		}
+	}
+
+	s.Stop()
+	stopped := runs.Load()
+
+	time.Sleep(30 * time.Millisecond)
+	if runs.Load() != stopped {
+		t.Errorf("job ran %d times after Stop(), want 0", runs.Load()-stopped)
+	}
+}